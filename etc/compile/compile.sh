@@ -9,8 +9,11 @@ BINARY="${1}"
 LD_FLAGS="${2}"
 PROFILE="${3}"
 
+# Target architecture for the binary and the image, e.g. amd64 or arm64.
+GOARCH="${GOARCH:-amd64}"
+
 mkdir -p _tmp
-CGO_ENABLED=0 GOOS=linux go build \
+CGO_ENABLED=0 GOOS=linux GOARCH="${GOARCH}" go build \
   -installsuffix netgo \
   -tags netgo \
   -o _tmp/${BINARY} \
@@ -31,6 +34,9 @@ then
     docker build ${DOCKER_BUILD_FLAGS} -t pachyderm_${BINARY} _tmp
     docker tag pachyderm_${BINARY}:latest pachyderm/${BINARY}:latest
     docker tag pachyderm_${BINARY}:latest pachyderm/${BINARY}:local
+    # Per-arch tag so release scripts can stitch the images into a manifest
+    # list.
+    docker tag pachyderm_${BINARY}:latest pachyderm/${BINARY}:latest-${GOARCH}
 else
     cd _tmp
     tar cf - ${BINARY}
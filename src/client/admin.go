@@ -6,6 +6,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
@@ -20,6 +21,83 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 	return clusterInfo, nil
 }
 
+// RotateKey re-encrypts the sensitive metadata stored in etcd under the
+// cluster's current KMS key, and returns the number of values re-encrypted.
+func (c APIClient) RotateKey() (int64, error) {
+	resp, err := c.AdminAPIClient.RotateKey(c.Ctx(), &admin.RotateKeyRequest{})
+	if err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Rotated, nil
+}
+
+// Diagnose returns cluster health details that can only be observed from
+// inside pachd, such as the server's clock and object store reachability.
+func (c APIClient) Diagnose() (*admin.DiagnoseResponse, error) {
+	resp, err := c.AdminAPIClient.Diagnose(c.Ctx(), &admin.DiagnoseRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp, nil
+}
+
+// RebalanceObjects re-chunks and re-uploads every object under the cluster's
+// current chunking settings and storage layout, calling 'cb' with each
+// progress report.
+func (c APIClient) RebalanceObjects(req *admin.RebalanceObjectsRequest, cb func(*admin.RebalanceObjectsResponse) error) error {
+	rebalanceClient, err := c.AdminAPIClient.RebalanceObjects(c.Ctx(), req)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := rebalanceClient.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// MigrateStorage copies every stored object to the object store named by
+// req.ToURL, calling 'cb' with each progress report.
+func (c APIClient) MigrateStorage(req *admin.MigrateStorageRequest, cb func(*admin.MigrateStorageResponse) error) error {
+	migrateClient, err := c.AdminAPIClient.MigrateStorage(c.Ctx(), req)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := migrateClient.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := cb(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// SetBandwidthLimit configures (or, with a BytesPerSec of 0, removes) a
+// per-principal or per-repo cap on PutFile/GetFile throughput.
+func (c APIClient) SetBandwidthLimit(limit *pfs.BandwidthLimit) error {
+	_, err := c.AdminAPIClient.SetBandwidthLimit(c.Ctx(), &admin.SetBandwidthLimitRequest{Limit: limit})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListBandwidthLimit returns the configured bandwidth limits.
+func (c APIClient) ListBandwidthLimit() ([]*pfs.BandwidthLimit, error) {
+	resp, err := c.AdminAPIClient.ListBandwidthLimit(c.Ctx(), &admin.ListBandwidthLimitRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Limit, nil
+}
+
 // Extract all cluster state, call f with each operation.
 func (c APIClient) Extract(objects bool, f func(op *admin.Op) error) error {
 	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), &admin.ExtractRequest{NoObjects: !objects})
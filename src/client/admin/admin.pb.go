@@ -13,6 +13,7 @@ import (
 	pps "github.com/pachyderm/pachyderm/src/client/admin/1_7/pps"
 	pfs1 "github.com/pachyderm/pachyderm/src/client/admin/1_8/pfs"
 	pps1 "github.com/pachyderm/pachyderm/src/client/admin/1_8/pps"
+	enterprise "github.com/pachyderm/pachyderm/src/client/enterprise"
 	pfs2 "github.com/pachyderm/pachyderm/src/client/pfs"
 	pps2 "github.com/pachyderm/pachyderm/src/client/pps"
 	grpc "google.golang.org/grpc"
@@ -536,10 +537,15 @@ func (m *RestoreRequest) GetURL() string {
 }
 
 type ClusterInfo struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// LicenseState and LicenseExpires describe the cluster's enterprise
+	// license, so that an expiring license is visible in 'pachctl inspect
+	// cluster' rather than only as failing enterprise RPCs.
+	LicenseState         enterprise.State `protobuf:"varint,2,opt,name=license_state,json=licenseState,proto3,enum=enterprise.State" json:"license_state,omitempty"`
+	LicenseExpires       *types.Timestamp `protobuf:"bytes,3,opt,name=license_expires,json=licenseExpires,proto3" json:"license_expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
@@ -582,979 +588,3350 @@ func (m *ClusterInfo) GetID() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Op1_7)(nil), "admin.Op1_7")
-	proto.RegisterType((*Op1_8)(nil), "admin.Op1_8")
-	proto.RegisterType((*Op1_9)(nil), "admin.Op1_9")
-	proto.RegisterType((*Op)(nil), "admin.Op")
-	proto.RegisterType((*ExtractRequest)(nil), "admin.ExtractRequest")
-	proto.RegisterType((*ExtractPipelineRequest)(nil), "admin.ExtractPipelineRequest")
-	proto.RegisterType((*RestoreRequest)(nil), "admin.RestoreRequest")
-	proto.RegisterType((*ClusterInfo)(nil), "admin.ClusterInfo")
+func (m *ClusterInfo) GetLicenseState() enterprise.State {
+	if m != nil {
+		return m.LicenseState
+	}
+	return enterprise.State_NONE
 }
 
-func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptor_6597bb2f2302afbd) }
+func (m *ClusterInfo) GetLicenseExpires() *types.Timestamp {
+	if m != nil {
+		return m.LicenseExpires
+	}
+	return nil
+}
 
-var fileDescriptor_6597bb2f2302afbd = []byte{
-	// 715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x6e, 0xd3, 0x3c,
-	0x18, 0x87, 0x9b, 0x74, 0xfd, 0x33, 0x6f, 0xdf, 0x3e, 0x64, 0x6d, 0x25, 0xeb, 0x58, 0x61, 0x91,
-	0x10, 0x03, 0x44, 0xb2, 0x6e, 0x88, 0xa6, 0x88, 0x21, 0xad, 0x65, 0x07, 0x95, 0x90, 0x36, 0x45,
-	0x70, 0xc2, 0x49, 0x94, 0xa6, 0x6e, 0x16, 0xd4, 0xc6, 0x26, 0x76, 0x25, 0x76, 0xc4, 0x6d, 0x70,
-	0x05, 0x5c, 0x06, 0xc7, 0x1c, 0x72, 0x05, 0x08, 0x95, 0x4b, 0xe0, 0x06, 0x50, 0x1c, 0xc7, 0x4b,
-	0xc2, 0xca, 0xb4, 0x1d, 0x64, 0xf2, 0xf2, 0x3e, 0x3f, 0xbf, 0x79, 0x9f, 0x28, 0x35, 0xd0, 0xbc,
-	0x49, 0x80, 0x42, 0x66, 0xba, 0xa3, 0x69, 0x10, 0x26, 0x7f, 0x0d, 0x12, 0x61, 0x86, 0x61, 0x85,
-	0xff, 0xd3, 0xdc, 0xf2, 0x31, 0xf6, 0x27, 0xc8, 0xe4, 0x37, 0x87, 0xb3, 0xb1, 0x89, 0xa6, 0x84,
-	0x9d, 0x27, 0x4c, 0x73, 0xdd, 0xc7, 0x3e, 0xe6, 0x4b, 0x33, 0x5e, 0x89, 0xbb, 0xad, 0xdc, 0x9e,
-	0x6d, 0xa7, 0x63, 0x92, 0x31, 0x8d, 0xaf, 0xc5, 0x75, 0x42, 0xe3, 0x6b, 0x41, 0xdd, 0xba, 0x22,
-	0x6f, 0x15, 0xf2, 0xeb, 0xa2, 0x9e, 0x4f, 0xc9, 0xbb, 0x59, 0x56, 0xff, 0xaa, 0x82, 0xca, 0x09,
-	0x69, 0x3b, 0x1d, 0xd8, 0x06, 0x55, 0x3c, 0x7c, 0x8f, 0x3c, 0xa6, 0xa9, 0xf7, 0x94, 0xdd, 0x95,
-	0xfd, 0x4d, 0x83, 0x8c, 0xa9, 0xd3, 0x76, 0x3a, 0xc6, 0xe9, 0x8c, 0x9d, 0xf0, 0x8a, 0x8d, 0x3e,
-	0xcc, 0x10, 0x65, 0xb6, 0x00, 0xe1, 0x63, 0x50, 0x66, 0xae, 0xaf, 0x95, 0x0b, 0xfc, 0x1b, 0xd7,
-	0xcf, 0xf3, 0x31, 0x05, 0x0d, 0xb0, 0x14, 0x21, 0x82, 0xb5, 0x25, 0x4e, 0x37, 0x25, 0xdd, 0x8f,
-	0x90, 0xcb, 0x90, 0x8d, 0x08, 0x4e, 0x71, 0xce, 0xc1, 0x03, 0x50, 0xf5, 0xf0, 0x74, 0x1a, 0x30,
-	0xad, 0xc2, 0x13, 0x5b, 0x32, 0xd1, 0x9b, 0x05, 0x93, 0x51, 0x9f, 0xd7, 0xe4, 0x13, 0x25, 0x28,
-	0x7c, 0x0a, 0xaa, 0xc3, 0xc8, 0x0d, 0xbd, 0x33, 0xad, 0xca, 0x43, 0x77, 0x0a, 0x6d, 0x7a, 0xbc,
-	0x28, 0x53, 0x09, 0x0b, 0x9f, 0x83, 0x3a, 0x09, 0x08, 0x9a, 0x04, 0x21, 0xd2, 0x6a, 0x3c, 0xd7,
-	0x32, 0x08, 0xc9, 0xe6, 0x4e, 0x45, 0x39, 0x4d, 0x4a, 0x5e, 0x0a, 0xb4, 0x16, 0x0a, 0xb4, 0xae,
-	0x29, 0xd0, 0xba, 0x96, 0x40, 0xeb, 0xda, 0x02, 0xad, 0x9b, 0x08, 0xb4, 0x6e, 0x28, 0xd0, 0xba,
-	0x52, 0xe0, 0x17, 0x21, 0xb0, 0x0b, 0x9f, 0x14, 0x04, 0x6e, 0xc4, 0xbd, 0x17, 0xcb, 0x7b, 0x90,
-	0x95, 0x97, 0xb0, 0x97, 0x8b, 0x7b, 0x94, 0x13, 0xd7, 0xe0, 0xe4, 0x22, 0x69, 0x66, 0x41, 0xda,
-	0x6d, 0x4e, 0xff, 0x43, 0xd8, 0x5e, 0x41, 0x98, 0x96, 0xd9, 0xfe, 0x72, 0x59, 0xcf, 0xfe, 0x92,
-	0xd5, 0x8c, 0x65, 0x5d, 0x29, 0x6a, 0x0a, 0xd4, 0x13, 0x02, 0x77, 0x40, 0x05, 0xc7, 0xdf, 0xab,
-	0xa6, 0xf0, 0xe8, 0xaa, 0x91, 0xfc, 0x66, 0xf1, 0x6f, 0xd8, 0x5e, 0xc2, 0xa4, 0xdd, 0x49, 0x11,
-	0x4b, 0x68, 0xcc, 0x22, 0x16, 0x47, 0xac, 0x14, 0xe9, 0x0a, 0x7b, 0x59, 0xa4, 0xcb, 0x91, 0xae,
-	0xfe, 0x09, 0xac, 0x1d, 0x7f, 0x64, 0x91, 0x2b, 0x65, 0xc2, 0x5b, 0xa0, 0xfc, 0xd6, 0x7e, 0xcd,
-	0x1b, 0x2f, 0xdb, 0xf1, 0x12, 0x6e, 0x03, 0x10, 0x62, 0x27, 0x79, 0x1f, 0x94, 0xb7, 0xab, 0xdb,
-	0xcb, 0x21, 0x4e, 0xde, 0x01, 0x85, 0x9b, 0xa0, 0x1e, 0x62, 0x27, 0xf6, 0x4a, 0x79, 0xa3, 0xba,
-	0x5d, 0x0b, 0x71, 0xec, 0x9c, 0xc2, 0x1d, 0xb0, 0x1a, 0x62, 0x27, 0x9d, 0x8d, 0xf2, 0x77, 0x53,
-	0xb7, 0x57, 0x42, 0x9c, 0xce, 0x4f, 0xf5, 0x3e, 0x68, 0x88, 0x07, 0x28, 0x38, 0x81, 0x0f, 0x33,
-	0x06, 0x13, 0x0d, 0xff, 0x71, 0x83, 0x92, 0xbb, 0x90, 0x76, 0x08, 0xd6, 0x6c, 0x44, 0x19, 0x8e,
-	0x64, 0x78, 0x13, 0xa8, 0x98, 0x88, 0xd8, 0xb2, 0x9c, 0xdb, 0x56, 0x31, 0x49, 0x07, 0x54, 0xe5,
-	0x80, 0xfa, 0x7d, 0xb0, 0xd2, 0x9f, 0xcc, 0x28, 0x43, 0xd1, 0x20, 0x1c, 0x63, 0xd8, 0x00, 0x6a,
-	0x30, 0x4a, 0x04, 0xf4, 0xaa, 0xf3, 0x1f, 0x77, 0xd5, 0xc1, 0x2b, 0x5b, 0x0d, 0x46, 0xfb, 0xbf,
-	0x15, 0x50, 0x3e, 0x3a, 0x1d, 0x40, 0x13, 0xd4, 0xc4, 0x23, 0xc3, 0x0d, 0xb1, 0x75, 0xde, 0x61,
-	0xf3, 0xa2, 0xa3, 0x5e, 0xda, 0x53, 0xe0, 0x21, 0xf8, 0xbf, 0x30, 0x23, 0xdc, 0xce, 0x07, 0x0b,
-	0xb3, 0xe7, 0x36, 0x80, 0x2f, 0x40, 0x4d, 0x4c, 0x27, 0xfb, 0xe5, 0xa7, 0x6d, 0x36, 0x8c, 0xe4,
-	0xfc, 0x32, 0xd2, 0xf3, 0xcb, 0x38, 0x8e, 0xcf, 0x2f, 0xbd, 0xb4, 0xab, 0xc0, 0x97, 0x60, 0x6d,
-	0x10, 0x52, 0x82, 0x3c, 0x26, 0x66, 0x84, 0x0b, 0xe8, 0x26, 0x14, 0x9b, 0x67, 0x5c, 0xe8, 0xa5,
-	0xde, 0xd1, 0xb7, 0x79, 0x4b, 0xf9, 0x3e, 0x6f, 0x29, 0x3f, 0xe7, 0x2d, 0xe5, 0xf3, 0xaf, 0x56,
-	0xe9, 0x9d, 0xe9, 0x07, 0xec, 0x6c, 0x36, 0x34, 0x3c, 0x3c, 0x35, 0x89, 0xeb, 0x9d, 0x9d, 0x8f,
-	0x50, 0x94, 0x5d, 0xd1, 0xc8, 0x33, 0xb3, 0x87, 0xd7, 0xb0, 0xca, 0x1b, 0x1d, 0xfc, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x4b, 0xc9, 0x56, 0xa5, 0x87, 0x07, 0x00, 0x00,
+type RotateKeyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *RotateKeyRequest) Reset()         { *m = RotateKeyRequest{} }
+func (m *RotateKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateKeyRequest) ProtoMessage()    {}
+func (*RotateKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{8}
+}
+func (m *RotateKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeyRequest.Merge(m, src)
+}
+func (m *RotateKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeyRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_RotateKeyRequest proto.InternalMessageInfo
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error)
-	ExtractPipeline(ctx context.Context, in *ExtractPipelineRequest, opts ...grpc.CallOption) (*Op, error)
-	Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error)
-	InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error)
+type RotateKeyResponse struct {
+	// Rotated is the number of stored values that were re-encrypted under the
+	// current KMS key.
+	Rotated              int64    `protobuf:"varint,1,opt,name=rotated,proto3" json:"rotated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *RotateKeyResponse) Reset()         { *m = RotateKeyResponse{} }
+func (m *RotateKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateKeyResponse) ProtoMessage()    {}
+func (*RotateKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{9}
 }
-
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *RotateKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/admin.API/Extract", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIExtractClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *RotateKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ExtractClient interface {
-	Recv() (*Op, error)
-	grpc.ClientStream
+func (m *RotateKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeyResponse.Merge(m, src)
 }
-
-type aPIExtractClient struct {
-	grpc.ClientStream
+func (m *RotateKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeyResponse.DiscardUnknown(m)
 }
 
-func (x *aPIExtractClient) Recv() (*Op, error) {
-	m := new(Op)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_RotateKeyResponse proto.InternalMessageInfo
+
+func (m *RotateKeyResponse) GetRotated() int64 {
+	if m != nil {
+		return m.Rotated
 	}
-	return m, nil
+	return 0
 }
 
-func (c *aPIClient) ExtractPipeline(ctx context.Context, in *ExtractPipelineRequest, opts ...grpc.CallOption) (*Op, error) {
-	out := new(Op)
-	err := c.cc.Invoke(ctx, "/admin.API/ExtractPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DiagnoseRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/admin.API/Restore", opts...)
-	if err != nil {
-		return nil, err
+func (m *DiagnoseRequest) Reset()         { *m = DiagnoseRequest{} }
+func (m *DiagnoseRequest) String() string { return proto.CompactTextString(m) }
+func (*DiagnoseRequest) ProtoMessage()    {}
+func (*DiagnoseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{10}
+}
+func (m *DiagnoseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiagnoseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiagnoseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &aPIRestoreClient{stream}
-	return x, nil
 }
-
-type API_RestoreClient interface {
-	Send(*RestoreRequest) error
-	CloseAndRecv() (*types.Empty, error)
-	grpc.ClientStream
+func (m *DiagnoseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiagnoseRequest.Merge(m, src)
 }
-
-type aPIRestoreClient struct {
-	grpc.ClientStream
+func (m *DiagnoseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiagnoseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiagnoseRequest.DiscardUnknown(m)
 }
 
-func (x *aPIRestoreClient) Send(m *RestoreRequest) error {
-	return x.ClientStream.SendMsg(m)
+var xxx_messageInfo_DiagnoseRequest proto.InternalMessageInfo
+
+type DiagnoseResponse struct {
+	// ServerTime is pachd's current clock, so callers can detect clock skew
+	// between their machine and the cluster.
+	ServerTime *types.Timestamp `protobuf:"bytes,1,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	// StorageOK indicates whether pachd could round-trip a probe object through
+	// the cluster's object store; if it couldn't, StorageError has the failure.
+	StorageOK            bool     `protobuf:"varint,2,opt,name=storage_ok,json=storageOk,proto3" json:"storage_ok,omitempty"`
+	StorageError         string   `protobuf:"bytes,3,opt,name=storage_error,json=storageError,proto3" json:"storage_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *aPIRestoreClient) CloseAndRecv() (*types.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *DiagnoseResponse) Reset()         { *m = DiagnoseResponse{} }
+func (m *DiagnoseResponse) String() string { return proto.CompactTextString(m) }
+func (*DiagnoseResponse) ProtoMessage()    {}
+func (*DiagnoseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{11}
+}
+func (m *DiagnoseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiagnoseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiagnoseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	m := new(types.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+}
+func (m *DiagnoseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiagnoseResponse.Merge(m, src)
+}
+func (m *DiagnoseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiagnoseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiagnoseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiagnoseResponse proto.InternalMessageInfo
+
+func (m *DiagnoseResponse) GetServerTime() *types.Timestamp {
+	if m != nil {
+		return m.ServerTime
 	}
-	return m, nil
+	return nil
 }
 
-func (c *aPIClient) InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error) {
-	out := new(ClusterInfo)
-	err := c.cc.Invoke(ctx, "/admin.API/InspectCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DiagnoseResponse) GetStorageOK() bool {
+	if m != nil {
+		return m.StorageOK
 	}
-	return out, nil
+	return false
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	Extract(*ExtractRequest, API_ExtractServer) error
-	ExtractPipeline(context.Context, *ExtractPipelineRequest) (*Op, error)
-	Restore(API_RestoreServer) error
-	InspectCluster(context.Context, *types.Empty) (*ClusterInfo, error)
+func (m *DiagnoseResponse) GetStorageError() string {
+	if m != nil {
+		return m.StorageError
+	}
+	return ""
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+type RebalanceObjectsRequest struct {
+	// Concurrency is the number of objects rewritten in parallel (default 10).
+	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// ThrottleBytesPerSec caps the rewrite throughput so a rebalance can run
+	// against a live cluster without starving user traffic (0 = unlimited).
+	ThrottleBytesPerSec int64 `protobuf:"varint,2,opt,name=throttle_bytes_per_sec,json=throttleBytesPerSec,proto3" json:"throttle_bytes_per_sec,omitempty"`
+	// DryRun only counts the objects and bytes that a rebalance would rewrite.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_Extract_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ExtractRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *RebalanceObjectsRequest) Reset()         { *m = RebalanceObjectsRequest{} }
+func (m *RebalanceObjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceObjectsRequest) ProtoMessage()    {}
+func (*RebalanceObjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{12}
+}
+func (m *RebalanceObjectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceObjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceObjectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(APIServer).Extract(m, &aPIExtractServer{stream})
 }
-
-type API_ExtractServer interface {
-	Send(*Op) error
-	grpc.ServerStream
+func (m *RebalanceObjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceObjectsRequest.Merge(m, src)
 }
-
-type aPIExtractServer struct {
-	grpc.ServerStream
+func (m *RebalanceObjectsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIExtractServer) Send(m *Op) error {
-	return x.ServerStream.SendMsg(m)
+func (m *RebalanceObjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceObjectsRequest.DiscardUnknown(m)
 }
 
-func _API_ExtractPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtractPipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ExtractPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/admin.API/ExtractPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ExtractPipeline(ctx, req.(*ExtractPipelineRequest))
+var xxx_messageInfo_RebalanceObjectsRequest proto.InternalMessageInfo
+
+func (m *RebalanceObjectsRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _API_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).Restore(&aPIRestoreServer{stream})
+func (m *RebalanceObjectsRequest) GetThrottleBytesPerSec() int64 {
+	if m != nil {
+		return m.ThrottleBytesPerSec
+	}
+	return 0
 }
 
-type API_RestoreServer interface {
-	SendAndClose(*types.Empty) error
-	Recv() (*RestoreRequest, error)
-	grpc.ServerStream
+func (m *RebalanceObjectsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
 
-type aPIRestoreServer struct {
-	grpc.ServerStream
+// RebalanceObjectsResponse reports the progress of a rebalance; one is sent
+// periodically while the rebalance runs and a final one when it finishes.
+type RebalanceObjectsResponse struct {
+	ObjectsRewritten     int64    `protobuf:"varint,1,opt,name=objects_rewritten,json=objectsRewritten,proto3" json:"objects_rewritten,omitempty"`
+	ObjectsTotal         int64    `protobuf:"varint,2,opt,name=objects_total,json=objectsTotal,proto3" json:"objects_total,omitempty"`
+	BytesRewritten       int64    `protobuf:"varint,3,opt,name=bytes_rewritten,json=bytesRewritten,proto3" json:"bytes_rewritten,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *aPIRestoreServer) SendAndClose(m *types.Empty) error {
-	return x.ServerStream.SendMsg(m)
+func (m *RebalanceObjectsResponse) Reset()         { *m = RebalanceObjectsResponse{} }
+func (m *RebalanceObjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceObjectsResponse) ProtoMessage()    {}
+func (*RebalanceObjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{13}
 }
-
-func (x *aPIRestoreServer) Recv() (*RestoreRequest, error) {
-	m := new(RestoreRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *RebalanceObjectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceObjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceObjectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *RebalanceObjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceObjectsResponse.Merge(m, src)
+}
+func (m *RebalanceObjectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceObjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceObjectsResponse.DiscardUnknown(m)
 }
 
-func _API_InspectCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectCluster(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/admin.API/InspectCluster",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectCluster(ctx, req.(*types.Empty))
+var xxx_messageInfo_RebalanceObjectsResponse proto.InternalMessageInfo
+
+func (m *RebalanceObjectsResponse) GetObjectsRewritten() int64 {
+	if m != nil {
+		return m.ObjectsRewritten
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "admin.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExtractPipeline",
-			Handler:    _API_ExtractPipeline_Handler,
-		},
-		{
-			MethodName: "InspectCluster",
-			Handler:    _API_InspectCluster_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Extract",
-			Handler:       _API_Extract_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Restore",
-			Handler:       _API_Restore_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "client/admin/admin.proto",
+func (m *RebalanceObjectsResponse) GetObjectsTotal() int64 {
+	if m != nil {
+		return m.ObjectsTotal
+	}
+	return 0
 }
 
-func (m *Op1_7) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RebalanceObjectsResponse) GetBytesRewritten() int64 {
+	if m != nil {
+		return m.BytesRewritten
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Op1_7) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
-		n1, err1 := m.Object.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
+type MigrateStorageRequest struct {
+	// FromURL is the object store to copy from ("" = the cluster's current
+	// storage backend).
+	FromURL string `protobuf:"bytes,1,opt,name=from_url,json=fromUrl,proto3" json:"from_url,omitempty"`
+	// ToURL is the object store to copy to.
+	ToURL string `protobuf:"bytes,2,opt,name=to_url,json=toUrl,proto3" json:"to_url,omitempty"`
+	// Concurrency is the number of objects copied in parallel (default 10).
+	Concurrency int64 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	// NoVerify skips reading each object back from the destination to check
+	// that its content matches the source.
+	NoVerify             bool     `protobuf:"varint,4,opt,name=no_verify,json=noVerify,proto3" json:"no_verify,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateStorageRequest) Reset()         { *m = MigrateStorageRequest{} }
+func (m *MigrateStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateStorageRequest) ProtoMessage()    {}
+func (*MigrateStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{14}
+}
+func (m *MigrateStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if m.Tag != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
-		n2, err2 := m.Tag.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
-		}
-		i += n2
+}
+func (m *MigrateStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateStorageRequest.Merge(m, src)
+}
+func (m *MigrateStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateStorageRequest proto.InternalMessageInfo
+
+func (m *MigrateStorageRequest) GetFromURL() string {
+	if m != nil {
+		return m.FromURL
 	}
-	if m.Repo != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
-		n3, err3 := m.Repo.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
-		}
-		i += n3
+	return ""
+}
+
+func (m *MigrateStorageRequest) GetToURL() string {
+	if m != nil {
+		return m.ToURL
 	}
-	if m.Commit != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
-		n4, err4 := m.Commit.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
-		}
-		i += n4
+	return ""
+}
+
+func (m *MigrateStorageRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
 	}
-	if m.Branch != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
-		n5, err5 := m.Branch.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
-		}
-		i += n5
+	return 0
+}
+
+func (m *MigrateStorageRequest) GetNoVerify() bool {
+	if m != nil {
+		return m.NoVerify
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
-		n6, err6 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
+	return false
+}
+
+// MigrateStorageResponse reports the progress of a storage migration; one is
+// sent periodically while the copy runs and a final one when it finishes.
+type MigrateStorageResponse struct {
+	ObjectsCopied int64 `protobuf:"varint,1,opt,name=objects_copied,json=objectsCopied,proto3" json:"objects_copied,omitempty"`
+	// ObjectsSkipped counts objects that already existed in the destination
+	// (e.g. from an earlier, interrupted run).
+	ObjectsSkipped       int64    `protobuf:"varint,2,opt,name=objects_skipped,json=objectsSkipped,proto3" json:"objects_skipped,omitempty"`
+	BytesCopied          int64    `protobuf:"varint,3,opt,name=bytes_copied,json=bytesCopied,proto3" json:"bytes_copied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateStorageResponse) Reset()         { *m = MigrateStorageResponse{} }
+func (m *MigrateStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateStorageResponse) ProtoMessage()    {}
+func (*MigrateStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{15}
+}
+func (m *MigrateStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n6
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *MigrateStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateStorageResponse.Merge(m, src)
+}
+func (m *MigrateStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateStorageResponse proto.InternalMessageInfo
+
+func (m *MigrateStorageResponse) GetObjectsCopied() int64 {
+	if m != nil {
+		return m.ObjectsCopied
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Op1_8) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MigrateStorageResponse) GetObjectsSkipped() int64 {
+	if m != nil {
+		return m.ObjectsSkipped
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Op1_8) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
-		n7, err7 := m.Object.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
-		}
-		i += n7
+func (m *MigrateStorageResponse) GetBytesCopied() int64 {
+	if m != nil {
+		return m.BytesCopied
 	}
-	if m.Tag != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
-		n8, err8 := m.Tag.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
+	return 0
+}
+
+type SetBandwidthLimitRequest struct {
+	// Limit caps the PutFile/GetFile throughput of a principal or a repo
+	// (exactly one of the two must be set); a bytes_per_sec of 0 removes the
+	// limit.
+	Limit                *pfs2.BandwidthLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SetBandwidthLimitRequest) Reset()         { *m = SetBandwidthLimitRequest{} }
+func (m *SetBandwidthLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBandwidthLimitRequest) ProtoMessage()    {}
+func (*SetBandwidthLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{16}
+}
+func (m *SetBandwidthLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBandwidthLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBandwidthLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	if m.Repo != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
-		n9, err9 := m.Repo.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
-		}
-		i += n9
+}
+func (m *SetBandwidthLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBandwidthLimitRequest.Merge(m, src)
+}
+func (m *SetBandwidthLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBandwidthLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBandwidthLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBandwidthLimitRequest proto.InternalMessageInfo
+
+func (m *SetBandwidthLimitRequest) GetLimit() *pfs2.BandwidthLimit {
+	if m != nil {
+		return m.Limit
 	}
-	if m.Commit != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
-		n10, err10 := m.Commit.MarshalTo(dAtA[i:])
-		if err10 != nil {
-			return 0, err10
-		}
-		i += n10
-	}
-	if m.Branch != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
-		n11, err11 := m.Branch.MarshalTo(dAtA[i:])
-		if err11 != nil {
-			return 0, err11
+	return nil
+}
+
+type ListBandwidthLimitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBandwidthLimitRequest) Reset()         { *m = ListBandwidthLimitRequest{} }
+func (m *ListBandwidthLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBandwidthLimitRequest) ProtoMessage()    {}
+func (*ListBandwidthLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{17}
+}
+func (m *ListBandwidthLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBandwidthLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBandwidthLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n11
+		return b[:n], nil
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
-		n12, err12 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err12 != nil {
-			return 0, err12
+}
+func (m *ListBandwidthLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBandwidthLimitRequest.Merge(m, src)
+}
+func (m *ListBandwidthLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBandwidthLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBandwidthLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBandwidthLimitRequest proto.InternalMessageInfo
+
+type ListBandwidthLimitResponse struct {
+	Limit                []*pfs2.BandwidthLimit `protobuf:"bytes,1,rep,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListBandwidthLimitResponse) Reset()         { *m = ListBandwidthLimitResponse{} }
+func (m *ListBandwidthLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBandwidthLimitResponse) ProtoMessage()    {}
+func (*ListBandwidthLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{18}
+}
+func (m *ListBandwidthLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBandwidthLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBandwidthLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i += n12
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *ListBandwidthLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBandwidthLimitResponse.Merge(m, src)
+}
+func (m *ListBandwidthLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBandwidthLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBandwidthLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBandwidthLimitResponse proto.InternalMessageInfo
+
+func (m *ListBandwidthLimitResponse) GetLimit() []*pfs2.BandwidthLimit {
+	if m != nil {
+		return m.Limit
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Op1_9) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func init() {
+	proto.RegisterType((*Op1_7)(nil), "admin.Op1_7")
+	proto.RegisterType((*Op1_8)(nil), "admin.Op1_8")
+	proto.RegisterType((*Op1_9)(nil), "admin.Op1_9")
+	proto.RegisterType((*Op)(nil), "admin.Op")
+	proto.RegisterType((*ExtractRequest)(nil), "admin.ExtractRequest")
+	proto.RegisterType((*ExtractPipelineRequest)(nil), "admin.ExtractPipelineRequest")
+	proto.RegisterType((*RestoreRequest)(nil), "admin.RestoreRequest")
+	proto.RegisterType((*ClusterInfo)(nil), "admin.ClusterInfo")
+	proto.RegisterType((*RotateKeyRequest)(nil), "admin.RotateKeyRequest")
+	proto.RegisterType((*RotateKeyResponse)(nil), "admin.RotateKeyResponse")
+	proto.RegisterType((*DiagnoseRequest)(nil), "admin.DiagnoseRequest")
+	proto.RegisterType((*DiagnoseResponse)(nil), "admin.DiagnoseResponse")
+	proto.RegisterType((*RebalanceObjectsRequest)(nil), "admin.RebalanceObjectsRequest")
+	proto.RegisterType((*RebalanceObjectsResponse)(nil), "admin.RebalanceObjectsResponse")
+	proto.RegisterType((*MigrateStorageRequest)(nil), "admin.MigrateStorageRequest")
+	proto.RegisterType((*MigrateStorageResponse)(nil), "admin.MigrateStorageResponse")
+	proto.RegisterType((*SetBandwidthLimitRequest)(nil), "admin.SetBandwidthLimitRequest")
+	proto.RegisterType((*ListBandwidthLimitRequest)(nil), "admin.ListBandwidthLimitRequest")
+	proto.RegisterType((*ListBandwidthLimitResponse)(nil), "admin.ListBandwidthLimitResponse")
+}
+
+func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptor_6597bb2f2302afbd) }
+
+var fileDescriptor_6597bb2f2302afbd = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x57,
+	0x4b, 0x6f, 0x1b, 0x55, 0x14, 0x8e, 0xed, 0xf8, 0x91, 0x93, 0xc4, 0x49,
+	0x6e, 0x1b, 0x67, 0x32, 0x69, 0xd2, 0x74, 0x10, 0xa2, 0x50, 0xd5, 0x6e,
+	0x53, 0x44, 0x1d, 0x20, 0x88, 0xda, 0x8d, 0xaa, 0x88, 0x40, 0xc2, 0x24,
+	0x05, 0x09, 0x16, 0x96, 0x3d, 0xbe, 0x71, 0x86, 0xd8, 0x73, 0x87, 0x3b,
+	0x63, 0x5a, 0xaf, 0xd8, 0x82, 0x58, 0x22, 0x24, 0xd6, 0x2c, 0xf8, 0x19,
+	0xac, 0xd9, 0x20, 0xf1, 0x0b, 0x10, 0x2a, 0x7f, 0x84, 0xfb, 0x1c, 0x8f,
+	0xc7, 0x76, 0xa2, 0x74, 0x31, 0xd1, 0xdc, 0x73, 0xbe, 0x73, 0xee, 0x39,
+	0xdf, 0x79, 0x8c, 0x03, 0x86, 0xd3, 0x75, 0xb1, 0x17, 0x56, 0x9a, 0xed,
+	0x9e, 0xeb, 0xc9, 0xbf, 0x65, 0x9f, 0x92, 0x90, 0xa0, 0xac, 0x38, 0x98,
+	0x1b, 0x1d, 0x42, 0x3a, 0x5d, 0x5c, 0x11, 0xc2, 0x56, 0xff, 0xac, 0x82,
+	0x7b, 0x7e, 0x38, 0x90, 0x18, 0xf3, 0x66, 0x87, 0x74, 0x88, 0x78, 0xad,
+	0xf0, 0x37, 0x25, 0xdd, 0x1a, 0xf1, 0xf9, 0xb0, 0xf1, 0xb8, 0xe2, 0x9f,
+	0x05, 0xfc, 0x99, 0xae, 0xf7, 0x03, 0xfe, 0x4c, 0xd1, 0x57, 0xaf, 0xb0,
+	0xaf, 0x26, 0xec, 0x6f, 0x2a, 0xfd, 0xa8, 0x55, 0x24, 0x8d, 0x63, 0xad,
+	0x3f, 0xd2, 0x90, 0x3d, 0xf2, 0x59, 0x0c, 0xe8, 0x21, 0xe4, 0x48, 0xeb,
+	0x1b, 0xec, 0x84, 0x46, 0x7a, 0x3b, 0x75, 0x77, 0x7e, 0x67, 0xbd, 0xcc,
+	0x6c, 0x1b, 0x4c, 0x53, 0x3e, 0xee, 0x87, 0x47, 0x42, 0x63, 0xe3, 0x6f,
+	0xfb, 0x38, 0x08, 0x6d, 0x05, 0x44, 0xf7, 0x20, 0x13, 0x36, 0x3b, 0x46,
+	0x26, 0x81, 0x3f, 0x6d, 0x76, 0x46, 0xf1, 0x1c, 0x85, 0xca, 0x30, 0x4b,
+	0xb1, 0x4f, 0x8c, 0x59, 0x81, 0x36, 0x23, 0x74, 0x9d, 0xe2, 0x66, 0x88,
+	0x6d, 0xa6, 0xd2, 0x70, 0x81, 0x43, 0x8f, 0x20, 0xe7, 0x90, 0x5e, 0xcf,
+	0x0d, 0x8d, 0xac, 0xb0, 0xd8, 0x88, 0x2c, 0x6a, 0x7d, 0xb7, 0xdb, 0xae,
+	0x0b, 0x5d, 0x14, 0x91, 0x84, 0xa2, 0x77, 0x21, 0xd7, 0xa2, 0x4d, 0xcf,
+	0x39, 0x37, 0x72, 0xc2, 0xe8, 0x56, 0xe2, 0x9a, 0x9a, 0x50, 0x46, 0x56,
+	0x12, 0x8b, 0xde, 0x87, 0x82, 0xef, 0xfa, 0xb8, 0xeb, 0x7a, 0xd8, 0xc8,
+	0x0b, 0xbb, 0xad, 0x32, 0xa3, 0x28, 0x66, 0x77, 0xac, 0xd4, 0xda, 0x32,
+	0xc2, 0x47, 0x04, 0x56, 0xa7, 0x12, 0x58, 0xbd, 0x26, 0x81, 0xd5, 0x6b,
+	0x11, 0x58, 0xbd, 0x36, 0x81, 0xd5, 0xd7, 0x21, 0xb0, 0xfa, 0x9a, 0x04,
+	0x56, 0xaf, 0x24, 0xf0, 0x77, 0x45, 0xe0, 0x2e, 0xba, 0x9f, 0x20, 0x70,
+	0x95, 0xdf, 0x3d, 0x9d, 0xbc, 0xb7, 0xe2, 0xe4, 0x49, 0xec, 0x64, 0xe2,
+	0xde, 0x19, 0x21, 0xae, 0x24, 0x90, 0xd3, 0x48, 0xab, 0x24, 0x48, 0x5b,
+	0x13, 0xe8, 0x4b, 0x08, 0x7b, 0x90, 0x20, 0xcc, 0x88, 0xb9, 0x9f, 0x4c,
+	0xd6, 0x7b, 0x63, 0x64, 0x99, 0x9c, 0xac, 0x2b, 0x89, 0xea, 0x41, 0xfa,
+	0xc8, 0x47, 0x77, 0x20, 0x4b, 0xf8, 0xbc, 0x1a, 0x29, 0x61, 0xba, 0x50,
+	0x96, 0x3b, 0x4b, 0xcc, 0xb0, 0x3d, 0xcb, 0x54, 0x8f, 0x35, 0xa4, 0xaa,
+	0x68, 0x8c, 0x43, 0xaa, 0x02, 0x52, 0xd5, 0x90, 0x5d, 0xc5, 0x5e, 0x1c,
+	0xb2, 0x2b, 0x20, 0xbb, 0xd6, 0xf7, 0x50, 0xdc, 0x7f, 0x19, 0xd2, 0x66,
+	0x44, 0x26, 0x5a, 0x86, 0xcc, 0x73, 0xfb, 0x50, 0x5c, 0x3c, 0x67, 0xf3,
+	0x57, 0xb4, 0x09, 0xe0, 0x91, 0x86, 0xac, 0x47, 0x20, 0xae, 0x2b, 0xd8,
+	0x73, 0x1e, 0x91, 0x35, 0x08, 0xd0, 0x3a, 0x14, 0x98, 0x9a, 0xf3, 0x1a,
+	0x88, 0x8b, 0x0a, 0x76, 0xde, 0x23, 0x9c, 0xf3, 0x80, 0x05, 0xb0, 0xc0,
+	0x54, 0x3a, 0xb7, 0x40, 0xd4, 0xa6, 0x60, 0xcf, 0x7b, 0x44, 0xe7, 0x1f,
+	0x58, 0x75, 0x28, 0xa9, 0x00, 0x12, 0x9c, 0xa0, 0xb7, 0x63, 0x0c, 0x4a,
+	0x1a, 0x16, 0x05, 0x83, 0x11, 0x6e, 0x48, 0xda, 0x1e, 0x14, 0x6d, 0x66,
+	0x42, 0x68, 0x64, 0xbc, 0x0e, 0x69, 0xe2, 0x2b, 0xb3, 0xb9, 0x28, 0x6f,
+	0x9b, 0x09, 0x75, 0x82, 0xe9, 0x28, 0x41, 0xeb, 0xb7, 0x14, 0xcc, 0xd7,
+	0xbb, 0xfd, 0x20, 0xc4, 0xf4, 0xc0, 0x3b, 0x23, 0xa8, 0x04, 0x69, 0xb7,
+	0x2d, 0x19, 0xa8, 0xe5, 0x5e, 0xfd, 0x73, 0x3b, 0x7d, 0xf0, 0xd4, 0x66,
+	0x12, 0x56, 0xd3, 0xc5, 0xae, 0xeb, 0x60, 0x2f, 0xc0, 0x8d, 0x20, 0x64,
+	0x55, 0x14, 0x3e, 0x8a, 0x3b, 0x2b, 0x65, 0xb6, 0x71, 0x31, 0xf5, 0xa9,
+	0x1b, 0xe0, 0xf2, 0x09, 0x57, 0xd8, 0x0b, 0x0a, 0x27, 0x4e, 0xa8, 0x0e,
+	0x4b, 0xda, 0x0e, 0xbf, 0xf4, 0x5d, 0x8a, 0x03, 0x55, 0x11, 0xb3, 0x2c,
+	0xbf, 0x3b, 0x65, 0xfd, 0xdd, 0x29, 0x9f, 0xba, 0x3d, 0x16, 0x7e, 0xb3,
+	0xe7, 0xdb, 0x45, 0x65, 0xb2, 0x2f, 0x2d, 0x2c, 0x04, 0xcb, 0x36, 0xe1,
+	0xee, 0x3e, 0xc1, 0x03, 0x95, 0xa5, 0x75, 0x1f, 0x56, 0x62, 0xb2, 0xc0,
+	0x27, 0x0c, 0x8f, 0x0c, 0xc8, 0x53, 0x21, 0x94, 0x29, 0x64, 0x6c, 0x7d,
+	0xb4, 0x56, 0x60, 0xe9, 0xa9, 0xdb, 0xec, 0x78, 0x24, 0xd0, 0x3c, 0x59,
+	0x3f, 0xa7, 0x60, 0x79, 0x28, 0x53, 0x1e, 0x3e, 0x80, 0xf9, 0x00, 0xd3,
+	0xef, 0x30, 0x6d, 0x84, 0x2c, 0x1c, 0xc5, 0xe2, 0x65, 0xb1, 0x82, 0x84,
+	0x73, 0x01, 0xef, 0x16, 0x5e, 0x89, 0x66, 0x07, 0x37, 0xc8, 0x85, 0xee,
+	0x16, 0x25, 0x39, 0xba, 0x40, 0x6f, 0xc0, 0xa2, 0x56, 0x63, 0x4a, 0x09,
+	0x15, 0x4c, 0xcc, 0xd9, 0x0b, 0x4a, 0xb8, 0xcf, 0x65, 0xd6, 0x8f, 0x29,
+	0x58, 0xb3, 0x71, 0xab, 0xd9, 0x65, 0xa3, 0x84, 0x55, 0x9f, 0xe9, 0xca,
+	0x6e, 0xc3, 0xbc, 0x43, 0x3c, 0xa7, 0x4f, 0x29, 0xf6, 0x9c, 0x81, 0x4a,
+	0x31, 0x2e, 0x62, 0x2b, 0xb1, 0x14, 0x9e, 0xb3, 0x20, 0xc3, 0x2e, 0x6e,
+	0xb4, 0x06, 0x21, 0x0e, 0x1a, 0x3e, 0x4b, 0x25, 0xc0, 0x8e, 0x88, 0x26,
+	0x63, 0xdf, 0xd0, 0xda, 0x1a, 0x57, 0x1e, 0x63, 0x7a, 0x82, 0x1d, 0xb4,
+	0x06, 0xf9, 0x36, 0x1d, 0x34, 0x68, 0xdf, 0x53, 0x4d, 0x9c, 0x63, 0x47,
+	0xbb, 0xef, 0x59, 0xbf, 0xa4, 0xc0, 0x18, 0x8f, 0x45, 0x31, 0x75, 0x0f,
+	0x56, 0xd4, 0x5c, 0xb0, 0x01, 0x78, 0x41, 0xdd, 0x30, 0xc4, 0x9e, 0x0a,
+	0x69, 0x99, 0x68, 0xac, 0x92, 0xf3, 0xd4, 0x35, 0x38, 0x64, 0x15, 0xe9,
+	0xaa, 0x70, 0x16, 0x94, 0xf0, 0x94, 0xcb, 0xd8, 0xbe, 0x5b, 0x92, 0x31,
+	0x0f, 0xfd, 0x65, 0x04, 0xac, 0x28, 0xc4, 0x91, 0x37, 0xeb, 0x87, 0x14,
+	0xac, 0x7e, 0xea, 0x76, 0x28, 0xab, 0xec, 0x89, 0xe4, 0x6e, 0xd8, 0xfb,
+	0x85, 0x33, 0x4a, 0x7a, 0x8d, 0x3e, 0xed, 0xaa, 0x31, 0xce, 0xf3, 0xf3,
+	0x73, 0xda, 0x45, 0xab, 0x90, 0x0b, 0x89, 0x50, 0xc8, 0xf6, 0xcf, 0x86,
+	0x84, 0x8b, 0x13, 0x9c, 0x66, 0xc6, 0x39, 0xdd, 0x00, 0x36, 0xf1, 0x0d,
+	0x56, 0x63, 0xf7, 0x6c, 0xa0, 0xc6, 0x98, 0x4d, 0xfd, 0x17, 0xe2, 0x6c,
+	0xfd, 0x94, 0x82, 0x52, 0x32, 0x14, 0x45, 0xd0, 0x9b, 0x50, 0xd4, 0x39,
+	0x3b, 0xc4, 0x77, 0xa3, 0x9e, 0xd4, 0x4c, 0xd4, 0x85, 0x90, 0x67, 0xad,
+	0x61, 0xc1, 0x85, 0xeb, 0xfb, 0x0c, 0x27, 0xc9, 0xd1, 0xd6, 0x27, 0x52,
+	0xca, 0x37, 0x8a, 0xa4, 0x47, 0x79, 0x53, 0xa1, 0x0a, 0x99, 0xf4, 0x65,
+	0xed, 0x83, 0x71, 0x82, 0xc3, 0x5a, 0xd3, 0x6b, 0xbf, 0x70, 0xdb, 0xe1,
+	0xf9, 0xa1, 0x3b, 0xdc, 0xe7, 0x6c, 0xa7, 0x64, 0xbb, 0xfc, 0xac, 0x7a,
+	0xfa, 0x86, 0xdc, 0xfb, 0xa3, 0x50, 0x89, 0xb0, 0x36, 0x60, 0xfd, 0xd0,
+	0x0d, 0x26, 0xfb, 0xb1, 0x9e, 0x81, 0x39, 0x49, 0xa9, 0x92, 0x8e, 0xdd,
+	0x92, 0xb9, 0xfc, 0x96, 0x9d, 0xbf, 0xb2, 0x90, 0x79, 0x72, 0x7c, 0xc0,
+	0xbe, 0x48, 0x79, 0xb5, 0x06, 0xd1, 0xaa, 0x5a, 0x57, 0xa3, 0x7b, 0xd9,
+	0x1c, 0x6e, 0x31, 0x6b, 0xe6, 0x41, 0x0a, 0xed, 0xc1, 0x52, 0x62, 0x6f,
+	0xa2, 0xcd, 0x51, 0xc3, 0xc4, 0x3e, 0x1d, 0x71, 0x80, 0x3e, 0x84, 0xbc,
+	0xda, 0x98, 0xd1, 0x7d, 0xa3, 0x1b, 0xd4, 0x2c, 0x8d, 0xcd, 0xfb, 0x3e,
+	0xff, 0x4d, 0x6c, 0xcd, 0xdc, 0x4d, 0xa1, 0x8f, 0xa0, 0x78, 0xe0, 0x05,
+	0x3e, 0x2b, 0x8c, 0x5a, 0x9b, 0x68, 0x0a, 0xda, 0x44, 0xca, 0x79, 0x6c,
+	0xbd, 0xb2, 0xdb, 0x3f, 0x86, 0xb9, 0x68, 0x6f, 0xa1, 0x35, 0x7d, 0x7f,
+	0x62, 0xbb, 0x99, 0xc6, 0xb8, 0x42, 0x12, 0xcc, 0x3c, 0xec, 0x41, 0x41,
+	0xaf, 0x2d, 0x76, 0xb7, 0xc4, 0x25, 0x76, 0x9b, 0xb9, 0x36, 0x26, 0x8f,
+	0xcc, 0xbf, 0x64, 0xcb, 0x34, 0x31, 0xd3, 0x68, 0x2b, 0xe2, 0x61, 0xe2,
+	0xe2, 0x31, 0x6f, 0x4f, 0xd5, 0x6b, 0xb7, 0xac, 0x2c, 0x9f, 0x43, 0x71,
+	0x74, 0x12, 0xd0, 0x2d, 0x65, 0x36, 0x71, 0x56, 0xcd, 0xcd, 0x29, 0xda,
+	0x98, 0xcb, 0xcf, 0x60, 0x65, 0xac, 0x9f, 0x91, 0x0e, 0x66, 0x5a, 0xa7,
+	0x4f, 0x2f, 0x1f, 0xfa, 0x1a, 0xd0, 0x78, 0xef, 0xa2, 0x6d, 0xe5, 0x70,
+	0x6a, 0xcf, 0x9b, 0x77, 0x2e, 0x41, 0xe8, 0x70, 0x6b, 0x4f, 0xfe, 0x7c,
+	0xb5, 0x95, 0xfa, 0x9b, 0x3d, 0xff, 0xb2, 0xe7, 0xd7, 0xff, 0xb6, 0x66,
+	0xbe, 0xaa, 0x74, 0xdc, 0xf0, 0xbc, 0xdf, 0x2a, 0xb3, 0x5f, 0x52, 0x15,
+	0xbf, 0xe9, 0x9c, 0x0f, 0xda, 0x98, 0xc6, 0xdf, 0x02, 0xea, 0x54, 0xe2,
+	0xff, 0xea, 0xb4, 0x72, 0x22, 0xe2, 0x47, 0xff, 0x03, 0x07, 0x6e, 0x69,
+	0xe0, 0xb5, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error)
+	ExtractPipeline(ctx context.Context, in *ExtractPipelineRequest, opts ...grpc.CallOption) (*Op, error)
+	Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error)
+	InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error)
+	// RotateKey re-encrypts the sensitive metadata stored in etcd under the
+	// cluster's current KMS key.
+	RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error)
+	// Diagnose reports cluster health details that pachctl can't observe from
+	// outside (server clock, object store reachability); 'pachctl doctor' uses
+	// it.
+	Diagnose(ctx context.Context, in *DiagnoseRequest, opts ...grpc.CallOption) (*DiagnoseResponse, error)
+	// RebalanceObjects re-chunks and re-uploads every object under the
+	// cluster's current chunking settings and storage layout, so clusters
+	// migrated from old versions (or whose settings changed) regain dedup and
+	// read performance. The blocks the objects used to live in become garbage,
+	// to be reclaimed by garbage collection.
+	RebalanceObjects(ctx context.Context, in *RebalanceObjectsRequest, opts ...grpc.CallOption) (API_RebalanceObjectsClient, error)
+	// MigrateStorage copies every stored object to another object store,
+	// verifying each copy, so a cluster can be moved between cloud providers.
+	// After the copy, redeploy pachd against the new backend with
+	// STORAGE_FALLBACK_URL pointing at the old one for a dual-read cutover
+	// window; see 'pachctl admin migrate-storage --help'.
+	MigrateStorage(ctx context.Context, in *MigrateStorageRequest, opts ...grpc.CallOption) (API_MigrateStorageClient, error)
+	// SetBandwidthLimit configures (or removes) a per-principal or per-repo
+	// cap on PutFile/GetFile throughput, enforced server-side with token
+	// buckets.
+	SetBandwidthLimit(ctx context.Context, in *SetBandwidthLimitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ListBandwidthLimit(ctx context.Context, in *ListBandwidthLimitRequest, opts ...grpc.CallOption) (*ListBandwidthLimitResponse, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/admin.API/Extract", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Op1_9) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
-		n13, err13 := m.Object.MarshalTo(dAtA[i:])
-		if err13 != nil {
-			return 0, err13
-		}
-		i += n13
+	x := &aPIExtractClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Tag != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
-		n14, err14 := m.Tag.MarshalTo(dAtA[i:])
-		if err14 != nil {
-			return 0, err14
-		}
-		i += n14
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.Repo != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
-		n15, err15 := m.Repo.MarshalTo(dAtA[i:])
-		if err15 != nil {
-			return 0, err15
-		}
-		i += n15
+	return x, nil
+}
+
+type API_ExtractClient interface {
+	Recv() (*Op, error)
+	grpc.ClientStream
+}
+
+type aPIExtractClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIExtractClient) Recv() (*Op, error) {
+	m := new(Op)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.Commit != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
-		n16, err16 := m.Commit.MarshalTo(dAtA[i:])
-		if err16 != nil {
-			return 0, err16
-		}
-		i += n16
+	return m, nil
+}
+
+func (c *aPIClient) ExtractPipeline(ctx context.Context, in *ExtractPipelineRequest, opts ...grpc.CallOption) (*Op, error) {
+	out := new(Op)
+	err := c.cc.Invoke(ctx, "/admin.API/ExtractPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Branch != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
-		n17, err17 := m.Branch.MarshalTo(dAtA[i:])
-		if err17 != nil {
-			return 0, err17
-		}
-		i += n17
+	return out, nil
+}
+
+func (c *aPIClient) Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/admin.API/Restore", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
-		n18, err18 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err18 != nil {
-			return 0, err18
-		}
-		i += n18
+	x := &aPIRestoreClient{stream}
+	return x, nil
+}
+
+type API_RestoreClient interface {
+	Send(*RestoreRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type aPIRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRestoreClient) Send(m *RestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIRestoreClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *Op) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error) {
+	out := new(ClusterInfo)
+	err := c.cc.Invoke(ctx, "/admin.API/InspectCluster", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Op) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Op1_7 != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_7.Size()))
-		n19, err19 := m.Op1_7.MarshalTo(dAtA[i:])
-		if err19 != nil {
-			return 0, err19
-		}
-		i += n19
-	}
-	if m.Op1_8 != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_8.Size()))
-		n20, err20 := m.Op1_8.MarshalTo(dAtA[i:])
-		if err20 != nil {
-			return 0, err20
-		}
-		i += n20
-	}
-	if m.Op1_9 != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_9.Size()))
-		n21, err21 := m.Op1_9.MarshalTo(dAtA[i:])
-		if err21 != nil {
-			return 0, err21
-		}
-		i += n21
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error) {
+	out := new(RotateKeyResponse)
+	err := c.cc.Invoke(ctx, "/admin.API/RotateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ExtractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) Diagnose(ctx context.Context, in *DiagnoseRequest, opts ...grpc.CallOption) (*DiagnoseResponse, error) {
+	out := new(DiagnoseResponse)
+	err := c.cc.Invoke(ctx, "/admin.API/Diagnose", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ExtractRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.URL) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.URL)))
-		i += copy(dAtA[i:], m.URL)
-	}
-	if m.NoObjects {
-		dAtA[i] = 0x10
-		i++
-		if m.NoObjects {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.NoRepos {
-		dAtA[i] = 0x18
-		i++
-		if m.NoRepos {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *aPIClient) RebalanceObjects(ctx context.Context, in *RebalanceObjectsRequest, opts ...grpc.CallOption) (API_RebalanceObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/admin.API/RebalanceObjects", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.NoPipelines {
-		dAtA[i] = 0x20
-		i++
-		if m.NoPipelines {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	x := &aPIRebalanceObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *ExtractPipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type API_RebalanceObjectsClient interface {
+	Recv() (*RebalanceObjectsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIRebalanceObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRebalanceObjectsClient) Recv() (*RebalanceObjectsResponse, error) {
+	m := new(RebalanceObjectsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ExtractPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
-		n22, err22 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err22 != nil {
-			return 0, err22
-		}
-		i += n22
+func (c *aPIClient) MigrateStorage(ctx context.Context, in *MigrateStorageRequest, opts ...grpc.CallOption) (API_MigrateStorageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/admin.API/MigrateStorage", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	x := &aPIMigrateStorageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type API_MigrateStorageClient interface {
+	Recv() (*MigrateStorageResponse, error)
+	grpc.ClientStream
+}
+
+type aPIMigrateStorageClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIMigrateStorageClient) Recv() (*MigrateStorageResponse, error) {
+	m := new(MigrateStorageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Op != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Op.Size()))
-		n23, err23 := m.Op.MarshalTo(dAtA[i:])
-		if err23 != nil {
-			return 0, err23
-		}
-		i += n23
-	}
-	if len(m.URL) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.URL)))
-		i += copy(dAtA[i:], m.URL)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) SetBandwidthLimit(ctx context.Context, in *SetBandwidthLimitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/admin.API/SetBandwidthLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) ListBandwidthLimit(ctx context.Context, in *ListBandwidthLimitRequest, opts ...grpc.CallOption) (*ListBandwidthLimitResponse, error) {
+	out := new(ListBandwidthLimitResponse)
+	err := c.cc.Invoke(ctx, "/admin.API/ListBandwidthLimit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// APIServer is the server API for API service.
+type APIServer interface {
+	Extract(*ExtractRequest, API_ExtractServer) error
+	ExtractPipeline(context.Context, *ExtractPipelineRequest) (*Op, error)
+	Restore(API_RestoreServer) error
+	InspectCluster(context.Context, *types.Empty) (*ClusterInfo, error)
+	// RotateKey re-encrypts the sensitive metadata stored in etcd under the
+	// cluster's current KMS key.
+	RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error)
+	// Diagnose reports cluster health details that pachctl can't observe from
+	// outside (server clock, object store reachability); 'pachctl doctor' uses
+	// it.
+	Diagnose(context.Context, *DiagnoseRequest) (*DiagnoseResponse, error)
+	// RebalanceObjects re-chunks and re-uploads every object under the
+	// cluster's current chunking settings and storage layout, so clusters
+	// migrated from old versions (or whose settings changed) regain dedup and
+	// read performance. The blocks the objects used to live in become garbage,
+	// to be reclaimed by garbage collection.
+	RebalanceObjects(*RebalanceObjectsRequest, API_RebalanceObjectsServer) error
+	// MigrateStorage copies every stored object to another object store,
+	// verifying each copy, so a cluster can be moved between cloud providers.
+	// After the copy, redeploy pachd against the new backend with
+	// STORAGE_FALLBACK_URL pointing at the old one for a dual-read cutover
+	// window; see 'pachctl admin migrate-storage --help'.
+	MigrateStorage(*MigrateStorageRequest, API_MigrateStorageServer) error
+	// SetBandwidthLimit configures (or removes) a per-principal or per-repo
+	// cap on PutFile/GetFile throughput, enforced server-side with token
+	// buckets.
+	SetBandwidthLimit(context.Context, *SetBandwidthLimitRequest) (*types.Empty, error)
+	ListBandwidthLimit(context.Context, *ListBandwidthLimitRequest) (*ListBandwidthLimitResponse, error)
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
 }
-func (m *Op1_7) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _API_Extract_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExtractRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	return srv.(APIServer).Extract(m, &aPIExtractServer{stream})
+}
+
+type API_ExtractServer interface {
+	Send(*Op) error
+	grpc.ServerStream
+}
+
+type aPIExtractServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIExtractServer) Send(m *Op) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ExtractPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Tag != nil {
-		l = m.Tag.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(APIServer).ExtractPipeline(ctx, in)
 	}
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/ExtractPipeline",
 	}
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExtractPipeline(ctx, req.(*ExtractPipelineRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Op1_8) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Tag != nil {
-		l = m.Tag.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func _API_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).Restore(&aPIRestoreServer{stream})
 }
 
-func (m *Op1_9) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Tag != nil {
-		l = m.Tag.Size()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+type API_RestoreServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*RestoreRequest, error)
+	grpc.ServerStream
+}
+
+type aPIRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRestoreServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIRestoreServer) Recv() (*RestoreRequest, error) {
+	m := new(RestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	return m, nil
+}
+
+func _API_InspectCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(APIServer).InspectCluster(ctx, in)
 	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/InspectCluster",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectCluster(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Op) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op1_7 != nil {
-		l = m.Op1_7.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+func _API_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Op1_8 != nil {
-		l = m.Op1_8.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(APIServer).RotateKey(ctx, in)
 	}
-	if m.Op1_9 != nil {
-		l = m.Op1_9.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/RotateKey",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RotateKey(ctx, req.(*RotateKeyRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExtractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _API_Diagnose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnoseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(APIServer).Diagnose(ctx, in)
 	}
-	if m.NoObjects {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/Diagnose",
 	}
-	if m.NoRepos {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Diagnose(ctx, req.(*DiagnoseRequest))
 	}
-	if m.NoPipelines {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RebalanceObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RebalanceObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return srv.(APIServer).RebalanceObjects(m, &aPIRebalanceObjectsServer{stream})
+}
+
+type API_RebalanceObjectsServer interface {
+	Send(*RebalanceObjectsResponse) error
+	grpc.ServerStream
+}
+
+type aPIRebalanceObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRebalanceObjectsServer) Send(m *RebalanceObjectsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_MigrateStorage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MigrateStorageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(APIServer).MigrateStorage(m, &aPIMigrateStorageServer{stream})
 }
 
-func (m *ExtractPipelineRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type API_MigrateStorageServer interface {
+	Send(*MigrateStorageResponse) error
+	grpc.ServerStream
+}
+
+type aPIMigrateStorageServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIMigrateStorageServer) Send(m *MigrateStorageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SetBandwidthLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBandwidthLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(APIServer).SetBandwidthLimit(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/SetBandwidthLimit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetBandwidthLimit(ctx, req.(*SetBandwidthLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RestoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _API_ListBandwidthLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBandwidthLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Op != nil {
-		l = m.Op.Size()
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(APIServer).ListBandwidthLimit(ctx, in)
 	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.API/ListBandwidthLimit",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListBandwidthLimit(ctx, req.(*ListBandwidthLimitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClusterInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExtractPipeline",
+			Handler:    _API_ExtractPipeline_Handler,
+		},
+		{
+			MethodName: "InspectCluster",
+			Handler:    _API_InspectCluster_Handler,
+		},
+		{
+			MethodName: "RotateKey",
+			Handler:    _API_RotateKey_Handler,
+		},
+		{
+			MethodName: "Diagnose",
+			Handler:    _API_Diagnose_Handler,
+		},
+		{
+			MethodName: "SetBandwidthLimit",
+			Handler:    _API_SetBandwidthLimit_Handler,
+		},
+		{
+			MethodName: "ListBandwidthLimit",
+			Handler:    _API_ListBandwidthLimit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Extract",
+			Handler:       _API_Extract_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _API_Restore_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RebalanceObjects",
+			Handler:       _API_RebalanceObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MigrateStorage",
+			Handler:       _API_MigrateStorage_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/admin/admin.proto",
+}
+
+func (m *Op1_7) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Op1_7) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
+		n1, err1 := m.Object.MarshalTo(dAtA[i:])
+		if err1 != nil {
+			return 0, err1
+		}
+		i += n1
+	}
+	if m.Tag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
+		n2, err2 := m.Tag.MarshalTo(dAtA[i:])
+		if err2 != nil {
+			return 0, err2
+		}
+		i += n2
+	}
+	if m.Repo != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
+		n3, err3 := m.Repo.MarshalTo(dAtA[i:])
+		if err3 != nil {
+			return 0, err3
+		}
+		i += n3
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
+		n4, err4 := m.Commit.MarshalTo(dAtA[i:])
+		if err4 != nil {
+			return 0, err4
+		}
+		i += n4
+	}
+	if m.Branch != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
+		n5, err5 := m.Branch.MarshalTo(dAtA[i:])
+		if err5 != nil {
+			return 0, err5
+		}
+		i += n5
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
+		n6, err6 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err6 != nil {
+			return 0, err6
+		}
+		i += n6
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Op1_8) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Op1_8) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
+		n7, err7 := m.Object.MarshalTo(dAtA[i:])
+		if err7 != nil {
+			return 0, err7
+		}
+		i += n7
+	}
+	if m.Tag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
+		n8, err8 := m.Tag.MarshalTo(dAtA[i:])
+		if err8 != nil {
+			return 0, err8
+		}
+		i += n8
+	}
+	if m.Repo != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
+		n9, err9 := m.Repo.MarshalTo(dAtA[i:])
+		if err9 != nil {
+			return 0, err9
+		}
+		i += n9
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
+		n10, err10 := m.Commit.MarshalTo(dAtA[i:])
+		if err10 != nil {
+			return 0, err10
+		}
+		i += n10
+	}
+	if m.Branch != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
+		n11, err11 := m.Branch.MarshalTo(dAtA[i:])
+		if err11 != nil {
+			return 0, err11
+		}
+		i += n11
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
+		n12, err12 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err12 != nil {
+			return 0, err12
+		}
+		i += n12
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Op1_9) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Op1_9) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Object.Size()))
+		n13, err13 := m.Object.MarshalTo(dAtA[i:])
+		if err13 != nil {
+			return 0, err13
+		}
+		i += n13
+	}
+	if m.Tag != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Tag.Size()))
+		n14, err14 := m.Tag.MarshalTo(dAtA[i:])
+		if err14 != nil {
+			return 0, err14
+		}
+		i += n14
+	}
+	if m.Repo != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Repo.Size()))
+		n15, err15 := m.Repo.MarshalTo(dAtA[i:])
+		if err15 != nil {
+			return 0, err15
+		}
+		i += n15
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Commit.Size()))
+		n16, err16 := m.Commit.MarshalTo(dAtA[i:])
+		if err16 != nil {
+			return 0, err16
+		}
+		i += n16
+	}
+	if m.Branch != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Branch.Size()))
+		n17, err17 := m.Branch.MarshalTo(dAtA[i:])
+		if err17 != nil {
+			return 0, err17
+		}
+		i += n17
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
+		n18, err18 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err18 != nil {
+			return 0, err18
+		}
+		i += n18
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Op) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Op) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Op1_7 != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_7.Size()))
+		n19, err19 := m.Op1_7.MarshalTo(dAtA[i:])
+		if err19 != nil {
+			return 0, err19
+		}
+		i += n19
+	}
+	if m.Op1_8 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_8.Size()))
+		n20, err20 := m.Op1_8.MarshalTo(dAtA[i:])
+		if err20 != nil {
+			return 0, err20
+		}
+		i += n20
+	}
+	if m.Op1_9 != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_9.Size()))
+		n21, err21 := m.Op1_9.MarshalTo(dAtA[i:])
+		if err21 != nil {
+			return 0, err21
+		}
+		i += n21
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExtractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if m.NoObjects {
+		dAtA[i] = 0x10
+		i++
+		if m.NoObjects {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NoRepos {
+		dAtA[i] = 0x18
+		i++
+		if m.NoRepos {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NoPipelines {
+		dAtA[i] = 0x20
+		i++
+		if m.NoPipelines {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ExtractPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtractPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
+		n22, err22 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err22 != nil {
+			return 0, err22
+		}
+		i += n22
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Op != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Op.Size()))
+		n23, err23 := m.Op.MarshalTo(dAtA[i:])
+		if err23 != nil {
+			return 0, err23
+		}
+		i += n23
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.LicenseState != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LicenseState))
+	}
+	if m.LicenseExpires != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LicenseExpires.Size()))
+		n24, err24 := m.LicenseExpires.MarshalTo(dAtA[i:])
+		if err24 != nil {
+			return 0, err24
+		}
+		i += n24
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RotateKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RotateKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Rotated != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Rotated))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiagnoseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiagnoseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiagnoseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiagnoseResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ServerTime != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ServerTime.Size()))
+		n25, err25 := m.ServerTime.MarshalTo(dAtA[i:])
+		if err25 != nil {
+			return 0, err25
+		}
+		i += n25
+	}
+	if m.StorageOK {
+		dAtA[i] = 0x10
+		i++
+		if m.StorageOK {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.StorageError) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.StorageError)))
+		i += copy(dAtA[i:], m.StorageError)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RebalanceObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Concurrency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Concurrency))
+	}
+	if m.ThrottleBytesPerSec != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ThrottleBytesPerSec))
+	}
+	if m.DryRun {
+		dAtA[i] = 0x18
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RebalanceObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ObjectsRewritten != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ObjectsRewritten))
+	}
+	if m.ObjectsTotal != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ObjectsTotal))
+	}
+	if m.BytesRewritten != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesRewritten))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MigrateStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromURL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.FromURL)))
+		i += copy(dAtA[i:], m.FromURL)
+	}
+	if len(m.ToURL) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ToURL)))
+		i += copy(dAtA[i:], m.ToURL)
+	}
+	if m.Concurrency != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Concurrency))
+	}
+	if m.NoVerify {
+		dAtA[i] = 0x20
+		i++
+		if m.NoVerify {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *MigrateStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ObjectsCopied != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ObjectsCopied))
+	}
+	if m.ObjectsSkipped != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ObjectsSkipped))
+	}
+	if m.BytesCopied != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesCopied))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SetBandwidthLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBandwidthLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit.Size()))
+		n26, err26 := m.Limit.MarshalTo(dAtA[i:])
+		if err26 != nil {
+			return 0, err26
+		}
+		i += n26
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListBandwidthLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBandwidthLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListBandwidthLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBandwidthLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		for _, msg := range m.Limit {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAdmin(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Op1_7) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Op1_8) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Op1_9) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Op) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op1_7 != nil {
+		l = m.Op1_7.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Op1_8 != nil {
+		l = m.Op1_8.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Op1_9 != nil {
+		l = m.Op1_9.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.NoObjects {
+		n += 2
+	}
+	if m.NoRepos {
+		n += 2
+	}
+	if m.NoPipelines {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExtractPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != nil {
+		l = m.Op.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LicenseState != 0 {
+		n += 1 + sovAdmin(uint64(m.LicenseState))
+	}
+	if m.LicenseExpires != nil {
+		l = m.LicenseExpires.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rotated != 0 {
+		n += 1 + sovAdmin(uint64(m.Rotated))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiagnoseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiagnoseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerTime != nil {
+		l = m.ServerTime.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.StorageOK {
+		n += 2
+	}
+	l = len(m.StorageError)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RebalanceObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Concurrency != 0 {
+		n += 1 + sovAdmin(uint64(m.Concurrency))
+	}
+	if m.ThrottleBytesPerSec != 0 {
+		n += 1 + sovAdmin(uint64(m.ThrottleBytesPerSec))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RebalanceObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectsRewritten != 0 {
+		n += 1 + sovAdmin(uint64(m.ObjectsRewritten))
+	}
+	if m.ObjectsTotal != 0 {
+		n += 1 + sovAdmin(uint64(m.ObjectsTotal))
+	}
+	if m.BytesRewritten != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesRewritten))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigrateStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ToURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovAdmin(uint64(m.Concurrency))
+	}
+	if m.NoVerify {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigrateStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectsCopied != 0 {
+		n += 1 + sovAdmin(uint64(m.ObjectsCopied))
+	}
+	if m.ObjectsSkipped != 0 {
+		n += 1 + sovAdmin(uint64(m.ObjectsSkipped))
+	}
+	if m.BytesCopied != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesCopied))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetBandwidthLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBandwidthLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBandwidthLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Op1_7) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Op1_7: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Op1_7: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &pfs.PutObjectRequest{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = &pfs.TagObjectRequest{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.CreateRepoRequest{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.BuildCommitRequest{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &pfs.CreateBranchRequest{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps.CreatePipelineRequest{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Op1_8) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Op1_8: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Op1_8: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &pfs1.PutObjectRequest{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = &pfs1.TagObjectRequest{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs1.CreateRepoRequest{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs1.BuildCommitRequest{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &pfs1.CreateBranchRequest{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps1.CreatePipelineRequest{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Op1_9) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Op1_9: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Op1_9: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &pfs2.PutObjectRequest{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = &pfs2.TagObjectRequest{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs2.CreateRepoRequest{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs2.BuildCommitRequest{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &pfs2.CreateBranchRequest{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps2.CreatePipelineRequest{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovAdmin(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Op1_7) Unmarshal(dAtA []byte) error {
+func (m *Op) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,15 +3954,15 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Op1_7: wiretype end group for non-group")
+			return fmt.Errorf("proto: Op: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Op1_7: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_7", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1612,16 +3989,16 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &pfs.PutObjectRequest{}
+			if m.Op1_7 == nil {
+				m.Op1_7 = &Op1_7{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Op1_7.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_8", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1648,16 +4025,16 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tag == nil {
-				m.Tag = &pfs.TagObjectRequest{}
+			if m.Op1_8 == nil {
+				m.Op1_8 = &Op1_8{}
 			}
-			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Op1_8.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_9", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1684,18 +4061,72 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &pfs.CreateRepoRequest{}
+			if m.Op1_9 == nil {
+				m.Op1_9 = &Op1_9{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Op1_9.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1705,33 +4136,49 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.BuildCommitRequest{}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoObjects", wireType)
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			m.NoObjects = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoRepos", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1741,29 +4188,87 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.NoRepos = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoPipelines", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoPipelines = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &pfs.CreateBranchRequest{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtractPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtractPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
@@ -1793,7 +4298,7 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pipeline == nil {
-				m.Pipeline = &pps.CreatePipelineRequest{}
+				m.Pipeline = &pps2.Pipeline{}
 			}
 			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1824,7 +4329,7 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Op1_8) Unmarshal(dAtA []byte) error {
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1847,15 +4352,15 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Op1_8: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Op1_8: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1882,18 +4387,18 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &pfs1.PutObjectRequest{}
+			if m.Op == nil {
+				m.Op = &Op{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Op.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1903,33 +4408,83 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tag == nil {
-				m.Tag = &pfs1.TagObjectRequest{}
-			}
-			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1939,33 +4494,29 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &pfs1.CreateRepoRequest{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseState", wireType)
 			}
-			var msglen int
+			m.LicenseState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1975,31 +4526,14 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LicenseState |= enterprise.State(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &pfs1.BuildCommitRequest{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenseExpires", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2026,18 +4560,127 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &pfs1.CreateBranchRequest{}
+			if m.LicenseExpires == nil {
+				m.LicenseExpires = &types.Timestamp{}
+			}
+			if err := m.LicenseExpires.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RotateKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotated", wireType)
 			}
-			var msglen int
+			m.Rotated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2047,28 +4690,66 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rotated |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &pps1.CreatePipelineRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DiagnoseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiagnoseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiagnoseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2094,7 +4775,8 @@ func (m *Op1_8) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Op1_9) Unmarshal(dAtA []byte) error {
+
+func (m *DiagnoseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2117,15 +4799,15 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Op1_9: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiagnoseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Op1_9: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiagnoseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2152,18 +4834,18 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &pfs2.PutObjectRequest{}
+			if m.ServerTime == nil {
+				m.ServerTime = &types.Timestamp{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageOK", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2173,33 +4855,17 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tag == nil {
-				m.Tag = &pfs2.TagObjectRequest{}
-			}
-			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.StorageOK = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2209,69 +4875,84 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &pfs2.CreateRepoRequest{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StorageError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs2.BuildCommitRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RebalanceObjectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebalanceObjectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebalanceObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2281,33 +4962,16 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Concurrency |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Branch == nil {
-				m.Branch = &pfs2.CreateBranchRequest{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottleBytesPerSec", wireType)
 			}
-			var msglen int
+			m.ThrottleBytesPerSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2317,28 +4981,31 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ThrottleBytesPerSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &pps2.CreatePipelineRequest{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2364,7 +5031,8 @@ func (m *Op1_9) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Op) Unmarshal(dAtA []byte) error {
+
+func (m *RebalanceObjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,17 +5055,17 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Op: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebalanceObjectsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebalanceObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_7", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsRewritten", wireType)
 			}
-			var msglen int
+			m.ObjectsRewritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2407,33 +5075,16 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObjectsRewritten |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Op1_7 == nil {
-				m.Op1_7 = &Op1_7{}
-			}
-			if err := m.Op1_7.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_8", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsTotal", wireType)
 			}
-			var msglen int
+			m.ObjectsTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2443,33 +5094,16 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObjectsTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Op1_8 == nil {
-				m.Op1_8 = &Op1_8{}
-			}
-			if err := m.Op1_8.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_9", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRewritten", wireType)
 			}
-			var msglen int
+			m.BytesRewritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2479,28 +5113,11 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BytesRewritten |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Op1_9 == nil {
-				m.Op1_9 = &Op1_9{}
-			}
-			if err := m.Op1_9.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2526,7 +5143,8 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
+
+func (m *MigrateStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2549,15 +5167,15 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2585,13 +5203,13 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.FromURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoObjects", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToURL", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2601,17 +5219,29 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoObjects = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
-			var v int
+			m.Concurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2621,15 +5251,14 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Concurrency |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoRepos = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoPipelines", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoVerify", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2646,7 +5275,7 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.NoPipelines = bool(v != 0)
+			m.NoVerify = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2672,7 +5301,8 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
+
+func (m *MigrateStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2695,17 +5325,17 @@ func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsCopied", wireType)
 			}
-			var msglen int
+			m.ObjectsCopied = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2715,28 +5345,49 @@ func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObjectsCopied |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsSkipped", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ObjectsSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectsSkipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &pps2.Pipeline{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesCopied", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BytesCopied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesCopied |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2762,7 +5413,8 @@ func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+
+func (m *SetBandwidthLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2785,15 +5437,15 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBandwidthLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBandwidthLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2820,45 +5472,68 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op == nil {
-				m.Op = &Op{}
+			if m.Limit == nil {
+				m.Limit = &pfs2.BandwidthLimit{}
 			}
-			if err := m.Op.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ListBandwidthLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBandwidthLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBandwidthLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2884,7 +5559,8 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+
+func (m *ListBandwidthLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2907,17 +5583,17 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBandwidthLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBandwidthLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2927,23 +5603,25 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Limit = append(m.Limit, &pfs2.BandwidthLimit{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2970,6 +5648,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipAdmin(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,6 +32,12 @@ const (
 	// (with this prefix) is a logical PPS pipeline (even though the pipeline may
 	// not exist).
 	PipelinePrefix = "pipeline:"
+
+	// KubernetesPrefix indicates that this Subject is a Kubernetes service
+	// account (authenticated by validating its token with the cluster's
+	// TokenReview API). The rest of the string is
+	// "<namespace>:<service account>".
+	KubernetesPrefix = "k8s:"
 )
 
 // ParseScope parses the string 's' to a scope (for example, parsing a command-
@@ -443,6 +443,7 @@ type AuthConfig struct {
 	// Pachyderm users (e.g. GitHub, Okta, etc)
 	IDProviders          []*IDProvider                  `protobuf:"bytes,2,rep,name=id_providers,json=idProviders,proto3" json:"id_providers,omitempty"`
 	SAMLServiceOptions   *AuthConfig_SAMLServiceOptions `protobuf:"bytes,3,opt,name=saml_svc_options,json=samlSvcOptions,proto3" json:"saml_svc_options,omitempty"`
+	GroupSyncOptions     *AuthConfig_GroupSyncOptions   `protobuf:"bytes,4,opt,name=group_sync_options,json=groupSyncOptions,proto3" json:"group_sync_options,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -502,6 +503,13 @@ func (m *AuthConfig) GetSAMLServiceOptions() *AuthConfig_SAMLServiceOptions {
 	return nil
 }
 
+func (m *AuthConfig) GetGroupSyncOptions() *AuthConfig_GroupSyncOptions {
+	if m != nil {
+		return m.GroupSyncOptions
+	}
+	return nil
+}
+
 // saml_svc_options configures the SAML services (Assertion Consumer Service
 // and Metadata Service) that Pachd can export.
 type AuthConfig_SAMLServiceOptions struct {
@@ -1091,7 +1099,12 @@ type AuthenticateRequest struct {
 	// This is a short-lived, one-time-use password generated by Pachyderm, for
 	// the purpose of propagating authentication to new clients (e.g. from the
 	// dash to pachd)
-	OneTimePassword      string   `protobuf:"bytes,2,opt,name=one_time_password,json=oneTimePassword,proto3" json:"one_time_password,omitempty"`
+	OneTimePassword string `protobuf:"bytes,2,opt,name=one_time_password,json=oneTimePassword,proto3" json:"one_time_password,omitempty"`
+	// This is a Kubernetes service account token, which pachd validates with
+	// the cluster's TokenReview API. It lets pods in the same cluster
+	// authenticate as the principal "k8s:<namespace>:<service account>" without
+	// being handed a long-lived Pachyderm token.
+	K8SToken             string   `protobuf:"bytes,3,opt,name=k8s_token,json=k8sToken,proto3" json:"k8s_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1144,6 +1157,13 @@ func (m *AuthenticateRequest) GetOneTimePassword() string {
 	return ""
 }
 
+func (m *AuthenticateRequest) GetK8SToken() string {
+	if m != nil {
+		return m.K8SToken
+	}
+	return ""
+}
+
 type AuthenticateResponse struct {
 	// pach_token authenticates the caller with Pachyderm (if you want to perform
 	// Pachyderm operations after auth has been activated as themselves, you must
@@ -2823,6 +2843,291 @@ func (m *GetOneTimePasswordResponse) GetCode() string {
 	return ""
 }
 
+type ExplainScopeRequest struct {
+	// username is the principal whose access is being explained; if unset, the
+	// caller's own access is explained. As with GetScopeRequest, robot users
+	// must be prefixed with "robot:".
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// repo is the object whose access is being explained
+	Repo                 string   `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainScopeRequest) Reset()         { *m = ExplainScopeRequest{} }
+func (m *ExplainScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainScopeRequest) ProtoMessage()    {}
+func (*ExplainScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15ace9a5d0179ff3, []int{50}
+}
+func (m *ExplainScopeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainScopeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainScopeRequest.Merge(m, src)
+}
+func (m *ExplainScopeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainScopeRequest proto.InternalMessageInfo
+
+func (m *ExplainScopeRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ExplainScopeRequest) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+// ExplainScopeResponse breaks a principal's effective access to a repo down
+// by binding, so that users can see not just what the access level is but
+// where it comes from (admin status, a direct ACL entry, or a group's ACL
+// entry).
+type ExplainScopeResponse struct {
+	// scope is the principal's effective access level to the repo, with all
+	// bindings taken into account.
+	Scope Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=auth.Scope" json:"scope,omitempty"`
+	// admin is set if the principal is a cluster admin, which makes them an
+	// effective owner of every repo regardless of the ACL.
+	Admin bool `protobuf:"varint,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// direct is the access level granted by the principal's own entry in the
+	// repo's ACL (NONE if there is no such entry).
+	Direct Scope `protobuf:"varint,3,opt,name=direct,proto3,enum=auth.Scope" json:"direct,omitempty"`
+	// groups maps each of the principal's groups that appears in the repo's
+	// ACL to the access level that group's entry grants.
+	Groups               map[string]Scope `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=auth.Scope"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExplainScopeResponse) Reset()         { *m = ExplainScopeResponse{} }
+func (m *ExplainScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainScopeResponse) ProtoMessage()    {}
+func (*ExplainScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15ace9a5d0179ff3, []int{51}
+}
+func (m *ExplainScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainScopeResponse.Merge(m, src)
+}
+func (m *ExplainScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainScopeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainScopeResponse proto.InternalMessageInfo
+
+func (m *ExplainScopeResponse) GetScope() Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return Scope_NONE
+}
+
+func (m *ExplainScopeResponse) GetAdmin() bool {
+	if m != nil {
+		return m.Admin
+	}
+	return false
+}
+
+func (m *ExplainScopeResponse) GetDirect() Scope {
+	if m != nil {
+		return m.Direct
+	}
+	return Scope_NONE
+}
+
+func (m *ExplainScopeResponse) GetGroups() map[string]Scope {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+// group_sync_options configures a background service in pachd that
+// periodically pulls group memberships from an external directory (LDAP or
+// a SCIM 2.0 endpoint) and applies them to Pachyderm's group principals, so
+// access management scales past individual-user ACL entries.
+type AuthConfig_GroupSyncOptions struct {
+	// ldap_url is the address of an LDAP server to pull group memberships
+	// from (e.g. "ldap://ldap.example.com:389"). Exactly one of ldap_url and
+	// scim_url must be set.
+	LDAPURL string `protobuf:"bytes,1,opt,name=ldap_url,json=ldapUrl,proto3" json:"ldap_url,omitempty"`
+	// ldap_bind_dn and ldap_bind_password are the credentials that pachd uses
+	// to search the directory. If ldap_bind_dn is unset, pachd binds
+	// anonymously.
+	LDAPBindDN       string `protobuf:"bytes,2,opt,name=ldap_bind_dn,json=ldapBindDn,proto3" json:"ldap_bind_dn,omitempty"`
+	LDAPBindPassword string `protobuf:"bytes,3,opt,name=ldap_bind_password,json=ldapBindPassword,proto3" json:"ldap_bind_password,omitempty"`
+	// ldap_group_base_dn is the subtree that's searched for group entries.
+	LDAPGroupBaseDN string `protobuf:"bytes,4,opt,name=ldap_group_base_dn,json=ldapGroupBaseDn,proto3" json:"ldap_group_base_dn,omitempty"`
+	// ldap_group_filter is a search filter matching group entries; only
+	// simple equality filters (e.g. "(objectClass=groupOfNames)", the
+	// default) are supported.
+	LDAPGroupFilter string `protobuf:"bytes,5,opt,name=ldap_group_filter,json=ldapGroupFilter,proto3" json:"ldap_group_filter,omitempty"`
+	// ldap_member_attribute is the group attribute that holds member names
+	// ("member" by default).
+	LDAPMemberAttribute string `protobuf:"bytes,6,opt,name=ldap_member_attribute,json=ldapMemberAttribute,proto3" json:"ldap_member_attribute,omitempty"`
+	// scim_url is the base URL of a SCIM 2.0 endpoint; pachd queries its
+	// /Groups resource.
+	SCIMURL string `protobuf:"bytes,7,opt,name=scim_url,json=scimUrl,proto3" json:"scim_url,omitempty"`
+	// scim_token is a bearer token that pachd presents to the SCIM endpoint.
+	SCIMToken string `protobuf:"bytes,8,opt,name=scim_token,json=scimToken,proto3" json:"scim_token,omitempty"`
+	// sync_interval is how often memberships are synced (a Golang duration,
+	// e.g. "30m"). If unset, memberships are synced every hour.
+	SyncInterval string `protobuf:"bytes,9,opt,name=sync_interval,json=syncInterval,proto3" json:"sync_interval,omitempty"`
+	// group_prefix is prepended to directory group names to form Pachyderm
+	// group principals (which can then be used in ACL entries). If unset, it
+	// defaults to "group/ldap:" or "group/scim:" depending on the source.
+	GroupPrefix          string   `protobuf:"bytes,10,opt,name=group_prefix,json=groupPrefix,proto3" json:"group_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthConfig_GroupSyncOptions) Reset()         { *m = AuthConfig_GroupSyncOptions{} }
+func (m *AuthConfig_GroupSyncOptions) String() string { return proto.CompactTextString(m) }
+func (*AuthConfig_GroupSyncOptions) ProtoMessage()    {}
+func (*AuthConfig_GroupSyncOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15ace9a5d0179ff3, []int{5, 1}
+}
+func (m *AuthConfig_GroupSyncOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthConfig_GroupSyncOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthConfig_GroupSyncOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthConfig_GroupSyncOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthConfig_GroupSyncOptions.Merge(m, src)
+}
+func (m *AuthConfig_GroupSyncOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthConfig_GroupSyncOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthConfig_GroupSyncOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthConfig_GroupSyncOptions proto.InternalMessageInfo
+
+func (m *AuthConfig_GroupSyncOptions) GetLDAPURL() string {
+	if m != nil {
+		return m.LDAPURL
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetLDAPBindDN() string {
+	if m != nil {
+		return m.LDAPBindDN
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetLDAPBindPassword() string {
+	if m != nil {
+		return m.LDAPBindPassword
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetLDAPGroupBaseDN() string {
+	if m != nil {
+		return m.LDAPGroupBaseDN
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetLDAPGroupFilter() string {
+	if m != nil {
+		return m.LDAPGroupFilter
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetLDAPMemberAttribute() string {
+	if m != nil {
+		return m.LDAPMemberAttribute
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetSCIMURL() string {
+	if m != nil {
+		return m.SCIMURL
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetSCIMToken() string {
+	if m != nil {
+		return m.SCIMToken
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetSyncInterval() string {
+	if m != nil {
+		return m.SyncInterval
+	}
+	return ""
+}
+
+func (m *AuthConfig_GroupSyncOptions) GetGroupPrefix() string {
+	if m != nil {
+		return m.GroupPrefix
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("auth.Scope", Scope_name, Scope_value)
 	proto.RegisterEnum("auth.TokenInfo_TokenSource", TokenInfo_TokenSource_name, TokenInfo_TokenSource_value)
@@ -2881,130 +3186,194 @@ func init() {
 	proto.RegisterType((*GetUsersResponse)(nil), "auth.GetUsersResponse")
 	proto.RegisterType((*GetOneTimePasswordRequest)(nil), "auth.GetOneTimePasswordRequest")
 	proto.RegisterType((*GetOneTimePasswordResponse)(nil), "auth.GetOneTimePasswordResponse")
+	proto.RegisterType((*ExplainScopeRequest)(nil), "auth.ExplainScopeRequest")
+	proto.RegisterType((*ExplainScopeResponse)(nil), "auth.ExplainScopeResponse")
+	proto.RegisterMapType((map[string]Scope)(nil), "auth.ExplainScopeResponse.GroupsEntry")
+	proto.RegisterType((*AuthConfig_GroupSyncOptions)(nil), "auth.AuthConfig.GroupSyncOptions")
 }
 
 func init() { proto.RegisterFile("client/auth/auth.proto", fileDescriptor_15ace9a5d0179ff3) }
 
 var fileDescriptor_15ace9a5d0179ff3 = []byte{
-	// 1876 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5b, 0x73, 0xe3, 0x48,
-	0x15, 0x8e, 0xed, 0xc4, 0x97, 0x63, 0x27, 0x76, 0x3a, 0x5e, 0xc7, 0xd1, 0xee, 0x24, 0x41, 0xa9,
-	0x62, 0xc3, 0x52, 0xe5, 0x0c, 0x19, 0x06, 0x96, 0x1d, 0x0a, 0x70, 0x12, 0xaf, 0xd7, 0x8b, 0x73,
-	0x41, 0xf2, 0xcc, 0x2c, 0xbc, 0xb8, 0x64, 0xa9, 0xc7, 0x11, 0x63, 0x5b, 0x46, 0x17, 0x33, 0xe1,
-	0x05, 0xfe, 0x05, 0x3c, 0xc1, 0xbf, 0xa1, 0x78, 0x84, 0x3f, 0x90, 0xa2, 0x4c, 0xf1, 0x3f, 0xb6,
-	0xfa, 0x26, 0xb7, 0x64, 0x39, 0x93, 0x9d, 0x97, 0xa4, 0xfb, 0x5c, 0xbe, 0x3e, 0x7d, 0xba, 0xfb,
-	0x7c, 0x47, 0x86, 0x9a, 0x39, 0xb2, 0xf1, 0xc4, 0x3f, 0x31, 0x02, 0xff, 0x96, 0xfe, 0x69, 0x4c,
-	0x5d, 0xc7, 0x77, 0xd0, 0x3a, 0x19, 0x2b, 0xd5, 0xa1, 0x33, 0x74, 0xa8, 0xe0, 0x84, 0x8c, 0x98,
-	0x4e, 0x39, 0x18, 0x3a, 0xce, 0x70, 0x84, 0x4f, 0xe8, 0x6c, 0x10, 0xbc, 0x39, 0xf1, 0xed, 0x31,
-	0xf6, 0x7c, 0x63, 0x3c, 0x65, 0x06, 0x6a, 0x1f, 0xca, 0x4d, 0xd3, 0xb7, 0x67, 0x86, 0x8f, 0x35,
-	0xfc, 0x87, 0x00, 0x7b, 0x3e, 0xaa, 0x43, 0xce, 0x0b, 0x06, 0xbf, 0xc7, 0xa6, 0x5f, 0x4f, 0x1f,
-	0xa6, 0x8e, 0x0b, 0x9a, 0x98, 0xa2, 0x53, 0x28, 0x0d, 0x6d, 0xff, 0x36, 0x18, 0xf4, 0x7d, 0xe7,
-	0x2d, 0x9e, 0xd4, 0x53, 0x44, 0x7d, 0x56, 0x9e, 0xdf, 0x1f, 0x14, 0xdb, 0xb6, 0xff, 0x55, 0x30,
-	0xe8, 0x11, 0xb1, 0x56, 0x64, 0x46, 0x74, 0xa2, 0xfe, 0x08, 0x2a, 0x8b, 0x05, 0xbc, 0xa9, 0x33,
-	0xf1, 0x30, 0x7a, 0x02, 0x30, 0x35, 0xcc, 0x5b, 0x19, 0x45, 0x2b, 0x10, 0x09, 0x73, 0xd9, 0x81,
-	0xed, 0x0b, 0x6c, 0x44, 0xa3, 0x52, 0xab, 0x80, 0x64, 0x21, 0x43, 0x52, 0xff, 0x91, 0x06, 0xe8,
-	0x5c, 0xdc, 0xb8, 0xce, 0xcc, 0xb6, 0xb0, 0x8b, 0x10, 0xac, 0x4f, 0x8c, 0x31, 0xe6, 0x90, 0x74,
-	0x8c, 0x0e, 0xa1, 0x68, 0x61, 0xcf, 0x74, 0xed, 0xa9, 0x6f, 0x3b, 0x13, 0xbe, 0x25, 0x59, 0x84,
-	0xbe, 0x80, 0x75, 0xcf, 0x18, 0x8f, 0xea, 0x99, 0xc3, 0xd4, 0x71, 0xf1, 0xf4, 0x93, 0x06, 0xcd,
-	0xed, 0x02, 0xb5, 0xa1, 0x37, 0x2f, 0xbb, 0xd7, 0xd4, 0xd4, 0x3b, 0xcb, 0xcf, 0xef, 0x0f, 0xd6,
-	0x89, 0x40, 0xa3, 0x3e, 0xca, 0xdf, 0x53, 0x50, 0x94, 0xf4, 0x24, 0x45, 0x63, 0xec, 0x1b, 0x96,
-	0xe1, 0x1b, 0xfd, 0xc0, 0x1d, 0xc9, 0x29, 0xba, 0xe4, 0xf2, 0x97, 0x5a, 0x57, 0x2b, 0x0a, 0xa3,
-	0x97, 0xee, 0x28, 0xe2, 0xf3, 0x6e, 0x3c, 0xa2, 0x21, 0x96, 0xa2, 0x3e, 0xdf, 0x5c, 0x4a, 0x3e,
-	0xdf, 0x8c, 0x47, 0xe8, 0x53, 0x28, 0x0f, 0x5d, 0x27, 0x98, 0xf6, 0x0d, 0xdf, 0x77, 0xed, 0x41,
-	0xe0, 0x63, 0x1a, 0x7e, 0x41, 0xdb, 0xa2, 0xe2, 0xa6, 0x90, 0xaa, 0xff, 0xc9, 0x00, 0x34, 0x03,
-	0xff, 0xf6, 0xdc, 0x99, 0xbc, 0xb1, 0x87, 0xa8, 0x01, 0x3b, 0x23, 0x7b, 0x86, 0xfb, 0x26, 0x9d,
-	0xf6, 0x67, 0xd8, 0xf5, 0x48, 0x56, 0x48, 0x98, 0x19, 0x6d, 0x9b, 0xa8, 0x98, 0xe1, 0x2b, 0xa6,
-	0x40, 0x17, 0x50, 0xb2, 0xad, 0xfe, 0x94, 0xa7, 0xc2, 0xab, 0xa7, 0x0f, 0x33, 0xc7, 0xc5, 0xd3,
-	0x4a, 0x3c, 0x47, 0x2c, 0xda, 0xc5, 0xdc, 0xd3, 0x8a, 0xb6, 0x15, 0x4e, 0x10, 0x86, 0x0a, 0xc9,
-	0x56, 0xdf, 0x9b, 0x99, 0x7d, 0x87, 0x65, 0x8a, 0x67, 0xfb, 0x88, 0x21, 0x2d, 0x22, 0xa4, 0xd9,
-	0xd6, 0xb1, 0x3b, 0xb3, 0x4d, 0x2c, 0x92, 0x5e, 0x9b, 0xdf, 0x1f, 0xa0, 0x65, 0xb9, 0xb6, 0x45,
-	0x40, 0xf5, 0x99, 0xc9, 0xe7, 0xca, 0xff, 0x53, 0x90, 0x60, 0x86, 0x8e, 0x20, 0x67, 0x98, 0x9e,
-	0x74, 0x1c, 0x30, 0xbf, 0x3f, 0xc8, 0x36, 0xcf, 0x75, 0x72, 0x12, 0x59, 0xc3, 0xf4, 0xe2, 0x87,
-	0x40, 0x2c, 0xd3, 0x8f, 0x38, 0xb8, 0xef, 0x43, 0xde, 0x32, 0xbc, 0x5b, 0x6a, 0x4f, 0xb3, 0x7f,
-	0x56, 0x9c, 0xdf, 0x1f, 0xe4, 0x2e, 0x0c, 0xef, 0x96, 0xd8, 0xe6, 0x88, 0x92, 0xd8, 0xfd, 0x00,
-	0x2a, 0x1e, 0xf6, 0x48, 0x3e, 0xfb, 0x56, 0xe0, 0x1a, 0xf4, 0x1e, 0xae, 0xd3, 0xd3, 0x2a, 0x73,
-	0xf9, 0x05, 0x17, 0xa3, 0x23, 0xd8, 0xb4, 0xf0, 0x20, 0x18, 0xf6, 0x47, 0xce, 0x70, 0x68, 0x4f,
-	0x86, 0xf5, 0x8d, 0xc3, 0xd4, 0x71, 0x5e, 0x2b, 0x51, 0x61, 0x97, 0xc9, 0xd4, 0x3d, 0xd8, 0x6d,
-	0x63, 0x9f, 0xe5, 0x8b, 0x3b, 0x8a, 0x67, 0xa2, 0x41, 0x7d, 0x59, 0xc5, 0x9f, 0xdd, 0x4f, 0x60,
-	0xd3, 0x94, 0x15, 0x34, 0x1b, 0xe1, 0x61, 0x2e, 0x8e, 0x40, 0x8b, 0x9a, 0xa9, 0xbf, 0x81, 0x5d,
-	0x3d, 0x79, 0xb9, 0x0f, 0x86, 0x54, 0xa0, 0xae, 0xaf, 0x08, 0x53, 0x45, 0x50, 0x69, 0x63, 0xbf,
-	0x69, 0x8d, 0xed, 0x89, 0x27, 0xb6, 0xf5, 0x43, 0xd8, 0x96, 0x64, 0x7c, 0x3f, 0x35, 0xc8, 0x1a,
-	0x54, 0x52, 0x4f, 0x1d, 0x66, 0x8e, 0x0b, 0x1a, 0x9f, 0xa9, 0xbf, 0x84, 0x9d, 0x4b, 0xc7, 0xb2,
-	0xdf, 0xdc, 0x45, 0x30, 0x50, 0x05, 0x32, 0x86, 0x65, 0x71, 0x5b, 0x32, 0x24, 0x00, 0x2e, 0x1e,
-	0x3b, 0x33, 0x4c, 0xaf, 0x75, 0x41, 0xe3, 0x33, 0xb5, 0x06, 0xd5, 0x28, 0x00, 0x8f, 0x6c, 0x02,
-	0xb9, 0xeb, 0xde, 0x4d, 0x67, 0xf2, 0xc6, 0x91, 0x8b, 0x64, 0x2a, 0x5a, 0x24, 0x3b, 0x80, 0xc4,
-	0x61, 0xe3, 0x77, 0x53, 0x9b, 0xe7, 0x25, 0x4d, 0xf3, 0xa2, 0x34, 0x58, 0x3d, 0x6e, 0x88, 0x7a,
-	0xdc, 0xe8, 0x89, 0x7a, 0xac, 0x6d, 0x73, 0xaf, 0x56, 0xe8, 0xa4, 0xfe, 0x35, 0x05, 0x05, 0x5a,
-	0x12, 0xdf, 0xb3, 0xe4, 0x33, 0xc8, 0x7a, 0x4e, 0xe0, 0x9a, 0x98, 0x2e, 0xb3, 0x75, 0xfa, 0x31,
-	0x4b, 0x7f, 0xe8, 0xca, 0x46, 0x3a, 0x35, 0xd1, 0xb8, 0xa9, 0xfa, 0x02, 0x8a, 0x92, 0x18, 0x15,
-	0x21, 0xd7, 0xb9, 0x7a, 0xd5, 0xec, 0x76, 0x2e, 0x2a, 0x6b, 0xa8, 0x02, 0xa5, 0xe6, 0xcb, 0xde,
-	0x57, 0xad, 0xab, 0x5e, 0xe7, 0xbc, 0xd9, 0x6b, 0x55, 0x52, 0x68, 0x13, 0x0a, 0xed, 0x56, 0xaf,
-	0xdf, 0xbb, 0xfe, 0x75, 0xeb, 0xaa, 0x92, 0x56, 0x03, 0xd8, 0x21, 0x87, 0x8b, 0x27, 0xbe, 0x6d,
-	0x4a, 0xd4, 0xf1, 0x01, 0x04, 0x81, 0x3e, 0x83, 0x6d, 0x67, 0x82, 0xfb, 0x84, 0x98, 0xfa, 0x53,
-	0xc3, 0xf3, 0xfe, 0xe8, 0xb8, 0x16, 0xaf, 0xd2, 0x65, 0x67, 0x82, 0x49, 0x82, 0x6e, 0xb8, 0x58,
-	0x7d, 0x0e, 0xd5, 0xe8, 0xb2, 0x8f, 0x23, 0x94, 0x32, 0x6c, 0xbe, 0xbe, 0x75, 0x9a, 0xe3, 0x8e,
-	0xb8, 0x4e, 0x03, 0xd8, 0x12, 0x02, 0x8e, 0xa0, 0x40, 0x3e, 0xf0, 0xb0, 0x2b, 0xb1, 0x47, 0x38,
-	0x47, 0x7b, 0x90, 0xb7, 0xbd, 0x3e, 0xbd, 0x5c, 0x34, 0xb0, 0xbc, 0x96, 0xb3, 0x3d, 0x7a, 0x35,
-	0xd0, 0x1e, 0x64, 0x7c, 0x9f, 0x3d, 0xfe, 0xcc, 0x59, 0x6e, 0x7e, 0x7f, 0x90, 0xe9, 0xf5, 0xba,
-	0x1a, 0x91, 0xa9, 0x7f, 0x49, 0x41, 0xa6, 0x79, 0xde, 0x45, 0x4f, 0x21, 0x87, 0x27, 0xbe, 0x6b,
-	0x63, 0x76, 0x4d, 0x8b, 0xa7, 0x35, 0xfe, 0x38, 0xce, 0xbb, 0x8d, 0x16, 0x53, 0x90, 0x7f, 0x77,
-	0x9a, 0x30, 0x53, 0xda, 0x50, 0x92, 0x15, 0xe4, 0xe2, 0xbe, 0xc5, 0x77, 0x3c, 0x2c, 0x32, 0x44,
-	0xdf, 0x83, 0x8d, 0x99, 0x31, 0x0a, 0xc4, 0x79, 0x17, 0x19, 0xa2, 0x6e, 0x3a, 0x53, 0xac, 0x31,
-	0xcd, 0x17, 0xe9, 0xcf, 0x53, 0xea, 0x9f, 0x61, 0xe3, 0xa5, 0x47, 0xea, 0xef, 0xe7, 0x50, 0x10,
-	0xbb, 0x11, 0x51, 0x28, 0xcc, 0x87, 0xea, 0xe9, 0x5f, 0xaa, 0x64, 0x91, 0x2c, 0x8c, 0x95, 0x9f,
-	0xc3, 0x56, 0x54, 0x99, 0x10, 0x4d, 0x55, 0x8e, 0x26, 0x2f, 0x07, 0x10, 0x40, 0xb6, 0x4d, 0xe8,
-	0xc8, 0x43, 0x4f, 0x21, 0x4b, 0x89, 0x49, 0x2c, 0x5f, 0x67, 0xcb, 0x33, 0x2d, 0xff, 0xc7, 0x16,
-	0xe7, 0x76, 0xca, 0xcf, 0xa0, 0x28, 0x89, 0xbf, 0xd3, 0xb2, 0x1d, 0xa8, 0x90, 0x6b, 0xe2, 0xb8,
-	0xf6, 0x9f, 0xc2, 0xab, 0x89, 0x60, 0xdd, 0xc5, 0x53, 0x47, 0xb4, 0x06, 0x64, 0x4c, 0xd2, 0xe8,
-	0x91, 0x9c, 0x25, 0xa6, 0x91, 0x6a, 0xd4, 0x67, 0xb0, 0x2d, 0x41, 0xf1, 0xcb, 0xb2, 0x0f, 0x60,
-	0x08, 0xa1, 0x45, 0x11, 0xf3, 0x9a, 0x24, 0x51, 0xcf, 0xa1, 0xdc, 0xc6, 0x3e, 0xc3, 0xe1, 0xcb,
-	0x3f, 0x74, 0xbf, 0xaa, 0xb0, 0x41, 0xc2, 0xf1, 0x78, 0x15, 0x62, 0x13, 0xf5, 0xa7, 0xb4, 0x0c,
-	0x72, 0x10, 0xbe, 0xf0, 0x11, 0x64, 0x69, 0x58, 0x2c, 0x8b, 0xb1, 0x88, 0xb9, 0x4a, 0xb5, 0xa0,
-	0xac, 0x7f, 0x87, 0xd5, 0x45, 0x62, 0xd2, 0x49, 0x89, 0xc9, 0xac, 0x4c, 0x0c, 0x82, 0x8a, 0x1e,
-	0x0b, 0x4f, 0x3d, 0x82, 0x4d, 0x52, 0xa5, 0xcf, 0xbb, 0x0f, 0x24, 0x5d, 0xed, 0x40, 0xbe, 0x79,
-	0xde, 0x65, 0x87, 0xfa, 0x50, 0x5c, 0x8f, 0x38, 0x1c, 0x07, 0xb6, 0xc4, 0x7a, 0x3c, 0x41, 0xc7,
-	0xf1, 0xc7, 0xb6, 0x15, 0x3e, 0xb6, 0xe8, 0x23, 0x43, 0xcf, 0x60, 0xd3, 0x75, 0x06, 0x8e, 0xdf,
-	0x17, 0xf6, 0xe9, 0x44, 0xfb, 0x12, 0x35, 0xe2, 0xcf, 0x51, 0xbd, 0x84, 0x4d, 0xfd, 0x7d, 0x1b,
-	0x94, 0x63, 0x48, 0x3f, 0x18, 0x83, 0x5a, 0x81, 0x2d, 0x3d, 0x12, 0xbf, 0xfa, 0x35, 0xec, 0x90,
-	0x1d, 0x05, 0x3e, 0xab, 0x5c, 0x09, 0x2d, 0x79, 0xac, 0xf4, 0xf3, 0x02, 0x94, 0x4e, 0x28, 0x40,
-	0x5f, 0x42, 0x35, 0x8a, 0xc5, 0x73, 0xb4, 0xba, 0xbf, 0xaf, 0xc2, 0x86, 0x5c, 0x41, 0xd9, 0x44,
-	0xed, 0x40, 0xad, 0xf5, 0xce, 0xc7, 0x13, 0x6b, 0x29, 0xac, 0x44, 0xfb, 0x87, 0x42, 0xda, 0x83,
-	0xdd, 0x25, 0x28, 0xbe, 0xf3, 0x06, 0xd4, 0x34, 0x3c, 0x73, 0xde, 0xe2, 0xc7, 0xad, 0x42, 0xa0,
-	0x96, 0xec, 0x39, 0xd4, 0x25, 0xed, 0x57, 0x58, 0xf1, 0xf8, 0xd2, 0x71, 0x49, 0xfd, 0x7a, 0xcc,
-	0x43, 0xa8, 0x85, 0x25, 0x8a, 0x77, 0x03, 0x6c, 0xc6, 0x7b, 0x95, 0x18, 0x1c, 0x5f, 0xea, 0x95,
-	0xe8, 0x14, 0x2e, 0xf1, 0x78, 0x40, 0xda, 0xde, 0x45, 0xcc, 0xd4, 0x5b, 0xc4, 0x4c, 0x27, 0xa2,
-	0x03, 0x49, 0x27, 0x75, 0x20, 0x99, 0x48, 0x07, 0xb2, 0x0b, 0x1f, 0xc5, 0x70, 0xc3, 0x34, 0x91,
-	0xaa, 0xc0, 0x82, 0x79, 0xc4, 0xa6, 0x78, 0xe3, 0x24, 0xec, 0x17, 0x8d, 0x93, 0x54, 0x8c, 0x17,
-	0x3b, 0xfd, 0x94, 0xd6, 0x2d, 0x4a, 0x09, 0x0f, 0x6e, 0x44, 0x7d, 0x4a, 0xa3, 0xe0, 0x86, 0x1c,
-	0xf4, 0x93, 0x38, 0xc7, 0x14, 0x24, 0x1e, 0x51, 0x9f, 0xc3, 0x5e, 0x1b, 0xfb, 0xd7, 0x51, 0x3e,
-	0x7f, 0xef, 0xf5, 0x56, 0x9f, 0x82, 0x92, 0xe4, 0xc6, 0x97, 0x44, 0xb0, 0x6e, 0x3a, 0x56, 0xf8,
-	0xb9, 0x47, 0xc6, 0x9f, 0xfd, 0x18, 0x36, 0x68, 0x8d, 0x40, 0x79, 0x58, 0xbf, 0xba, 0xbe, 0x6a,
-	0x55, 0xd6, 0x10, 0x40, 0x56, 0x6b, 0x35, 0x2f, 0x5a, 0x5a, 0x25, 0x45, 0xc6, 0xaf, 0xb5, 0x4e,
-	0xaf, 0xa5, 0x55, 0xd2, 0xa8, 0x00, 0x1b, 0xd7, 0xaf, 0xaf, 0x5a, 0x5a, 0x25, 0x73, 0xfa, 0xcf,
-	0x22, 0x64, 0x9a, 0x37, 0x1d, 0xf4, 0x02, 0xf2, 0xe2, 0x6b, 0x15, 0x7d, 0xc4, 0x9f, 0x6d, 0xf4,
-	0x43, 0x54, 0xa9, 0xc5, 0xc5, 0xfc, 0x64, 0xd6, 0x50, 0x13, 0x60, 0xf1, 0x89, 0x8a, 0x76, 0x99,
-	0xdd, 0xd2, 0x97, 0xac, 0x52, 0x5f, 0x56, 0x84, 0x10, 0x3a, 0x4d, 0x6c, 0xa4, 0x2f, 0x46, 0x4f,
-	0x38, 0x55, 0x26, 0xb7, 0xe0, 0xca, 0xfe, 0x2a, 0xb5, 0x0c, 0xaa, 0xaf, 0x00, 0xd5, 0x1f, 0x06,
-	0xd5, 0x57, 0x83, 0xfe, 0x02, 0x0a, 0x61, 0x47, 0x8e, 0x6a, 0x61, 0x0c, 0x91, 0x96, 0x5b, 0xd9,
-	0x5d, 0x92, 0x87, 0xfe, 0x6d, 0x28, 0xc9, 0x3d, 0x36, 0xda, 0x63, 0xa6, 0x09, 0x8d, 0xbb, 0xa2,
-	0x24, 0xa9, 0x64, 0x20, 0xb9, 0x27, 0x14, 0x40, 0x09, 0xed, 0xa9, 0x00, 0x4a, 0x6a, 0x21, 0xd9,
-	0x8e, 0x42, 0xaa, 0x17, 0x3b, 0x8a, 0xb7, 0x11, 0x62, 0x47, 0x4b, 0x3d, 0x81, 0xba, 0x86, 0x9e,
-	0x43, 0x96, 0x35, 0x95, 0x68, 0x87, 0x19, 0x45, 0x7a, 0x4e, 0xa5, 0x1a, 0x15, 0x86, 0x6e, 0x2f,
-	0x20, 0x2f, 0x78, 0x5e, 0x5c, 0xb9, 0x58, 0xf3, 0xa0, 0xd4, 0xe2, 0x62, 0xd9, 0x59, 0x8f, 0x39,
-	0xeb, 0xc9, 0xce, 0xfa, 0xb2, 0xf3, 0x73, 0xc8, 0x32, 0xfa, 0x14, 0x01, 0x47, 0xc8, 0x5b, 0x04,
-	0x1c, 0x65, 0x58, 0xe6, 0xa6, 0x47, 0xdc, 0xf4, 0x24, 0x37, 0x3d, 0xee, 0xd6, 0x86, 0x92, 0x4c,
-	0x47, 0xe2, 0x9c, 0x12, 0xe8, 0x4e, 0x9c, 0x53, 0x12, 0x7b, 0xa9, 0x6b, 0xe8, 0x06, 0xca, 0x31,
-	0x12, 0x41, 0xfc, 0x37, 0x9b, 0x64, 0x9a, 0x52, 0x9e, 0xac, 0xd0, 0xca, 0x88, 0x31, 0x2e, 0x11,
-	0x88, 0xc9, 0x94, 0x24, 0x10, 0x57, 0x11, 0x90, 0x78, 0x72, 0x11, 0xce, 0x90, 0x9e, 0x5c, 0x12,
-	0x35, 0x49, 0x4f, 0x2e, 0x99, 0x6a, 0xd6, 0xd0, 0xd7, 0xb0, 0x19, 0x21, 0x05, 0x14, 0x79, 0x18,
-	0x51, 0x06, 0x52, 0x3e, 0x4e, 0xd4, 0xc5, 0x9e, 0x2f, 0x6f, 0xce, 0x17, 0xf7, 0x2b, 0x42, 0x2c,
-	0xd2, 0xf3, 0x8d, 0x12, 0x48, 0x78, 0x6b, 0xd9, 0xd7, 0xc5, 0xe2, 0xd6, 0xca, 0xd4, 0x21, 0xdd,
-	0xda, 0x08, 0x51, 0xa8, 0x6b, 0xe8, 0xb7, 0x80, 0x96, 0xab, 0x3a, 0x3a, 0x08, 0xed, 0x93, 0x69,
-	0x42, 0x39, 0x5c, 0x6d, 0x20, 0xa0, 0xcf, 0x7e, 0xf5, 0xaf, 0xf9, 0x7e, 0xea, 0xdf, 0xf3, 0xfd,
-	0xd4, 0x7f, 0xe7, 0xfb, 0xa9, 0xbf, 0xfd, 0x6f, 0x7f, 0xed, 0x77, 0x0d, 0xf6, 0xb1, 0xd9, 0x30,
-	0x9d, 0xf1, 0x09, 0xf9, 0x24, 0xbc, 0xb3, 0xb0, 0x2b, 0x8f, 0x3c, 0xd7, 0x3c, 0x91, 0x7e, 0x59,
-	0x1d, 0x64, 0xe9, 0xb7, 0xf9, 0xb3, 0x6f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x95, 0x7a, 0x93, 0xa2,
-	0x6f, 0x15, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x59,
+	0x5b, 0x73, 0x23, 0x47, 0x15, 0x5e, 0x49, 0xb6, 0x25, 0x1d, 0xc9, 0xb6,
+	0xdc, 0xf6, 0xca, 0xda, 0xd9, 0x2b, 0xb3, 0x55, 0x49, 0x48, 0x28, 0x39,
+	0xec, 0x12, 0x58, 0x12, 0x2a, 0x20, 0xdb, 0x5a, 0x47, 0x89, 0x6f, 0xcc,
+	0x68, 0x77, 0x03, 0x2f, 0xaa, 0x91, 0xa6, 0x2d, 0x0f, 0x2b, 0x69, 0xc4,
+	0xcc, 0x48, 0xac, 0x79, 0x81, 0x1f, 0x40, 0x15, 0x55, 0xbc, 0xc0, 0x13,
+	0xbc, 0xf1, 0x5b, 0x78, 0xe4, 0x17, 0x50, 0x54, 0x28, 0xfe, 0x05, 0x0f,
+	0x9c, 0xbe, 0x8d, 0x7a, 0xa4, 0x91, 0xd7, 0x49, 0x1e, 0x6c, 0x77, 0x9f,
+	0x5b, 0x9f, 0x3e, 0x7d, 0xfa, 0x3b, 0xa7, 0xc7, 0x50, 0xed, 0x0d, 0x3c,
+	0x3a, 0x8a, 0xf6, 0x9c, 0x49, 0x74, 0xc9, 0x7f, 0xd5, 0xc7, 0x81, 0x1f,
+	0xf9, 0x64, 0x85, 0x8d, 0x8d, 0x9d, 0xbe, 0xdf, 0xf7, 0x39, 0x61, 0x8f,
+	0x8d, 0x04, 0xcf, 0x78, 0xd8, 0xf7, 0xfd, 0xfe, 0x80, 0xee, 0xf1, 0x59,
+	0x77, 0x72, 0xb1, 0x17, 0x79, 0x43, 0x1a, 0x46, 0xce, 0x70, 0x2c, 0x04,
+	0xcc, 0x0e, 0x6c, 0x36, 0x7a, 0x91, 0x37, 0x75, 0x22, 0x6a, 0xd1, 0x5f,
+	0x4f, 0x90, 0x47, 0x6a, 0x90, 0x0f, 0x27, 0xdd, 0x5f, 0xd1, 0x5e, 0x54,
+	0xcb, 0x3e, 0xca, 0xbc, 0x57, 0xb4, 0xd4, 0x94, 0x3c, 0x81, 0x72, 0xdf,
+	0x8b, 0x2e, 0x27, 0xdd, 0x4e, 0xe4, 0xbf, 0xa6, 0xa3, 0x5a, 0x86, 0xb1,
+	0xf7, 0x37, 0xbf, 0xfa, 0xd7, 0xc3, 0xd2, 0x91, 0x17, 0x7d, 0x36, 0xe9,
+	0xb6, 0x19, 0xd9, 0x2a, 0x09, 0x21, 0x3e, 0x31, 0xbf, 0x0f, 0x95, 0xd9,
+	0x02, 0xe1, 0xd8, 0x1f, 0x85, 0x94, 0xdc, 0x07, 0x18, 0x3b, 0xbd, 0x4b,
+	0xdd, 0x8a, 0x55, 0x64, 0x14, 0xa1, 0xb2, 0x0d, 0x5b, 0x87, 0xd4, 0x49,
+	0x7a, 0x65, 0xee, 0x00, 0xd1, 0x89, 0xc2, 0x92, 0xf9, 0xb7, 0x2c, 0x40,
+	0xeb, 0xf0, 0x3c, 0xf0, 0xa7, 0x9e, 0x4b, 0x03, 0x42, 0x60, 0x65, 0xe4,
+	0x0c, 0xa9, 0x34, 0xc9, 0xc7, 0xe4, 0x11, 0x94, 0x5c, 0x1a, 0xf6, 0x02,
+	0x6f, 0x1c, 0x79, 0xfe, 0x48, 0x6e, 0x49, 0x27, 0x91, 0x8f, 0x61, 0x25,
+	0x74, 0x86, 0x83, 0x5a, 0x0e, 0x59, 0xa5, 0x27, 0xf7, 0xea, 0x3c, 0xb6,
+	0x33, 0xab, 0x75, 0xbb, 0x71, 0x72, 0x7c, 0xc6, 0x45, 0xc3, 0xfd, 0x02,
+	0x6e, 0x76, 0x85, 0x11, 0x2c, 0xae, 0x63, 0xfc, 0x35, 0x03, 0x25, 0x8d,
+	0xcf, 0x42, 0x34, 0xa4, 0x91, 0xe3, 0x3a, 0x91, 0xd3, 0x99, 0x04, 0x03,
+	0x3d, 0x44, 0x27, 0x92, 0xfe, 0xc2, 0x3a, 0xb6, 0x4a, 0x4a, 0xe8, 0x45,
+	0x30, 0x48, 0xe8, 0xbc, 0x41, 0x3f, 0x98, 0x8b, 0xe5, 0xa4, 0xce, 0x97,
+	0x27, 0x9a, 0xce, 0x97, 0xc3, 0x01, 0x79, 0x17, 0x36, 0xfb, 0x81, 0x3f,
+	0x19, 0x77, 0x9c, 0x28, 0x0a, 0xbc, 0xee, 0x24, 0xa2, 0xdc, 0xfd, 0xa2,
+	0xb5, 0xc1, 0xc9, 0x0d, 0x45, 0x35, 0xff, 0x9e, 0x07, 0x68, 0xe0, 0x86,
+	0x0e, 0xfc, 0xd1, 0x85, 0xd7, 0x27, 0x75, 0xd8, 0x1e, 0x78, 0x53, 0xda,
+	0xe9, 0xf1, 0x69, 0x67, 0x4a, 0x83, 0x90, 0x45, 0x85, 0xb9, 0x99, 0xb3,
+	0xb6, 0x18, 0x4b, 0x08, 0xbe, 0x14, 0x0c, 0x72, 0x08, 0x65, 0xcf, 0xed,
+	0x8c, 0x65, 0x28, 0x42, 0xf4, 0x2d, 0x87, 0x31, 0xaa, 0xcc, 0xc7, 0x48,
+	0x78, 0x3b, 0x9b, 0x87, 0x56, 0xc9, 0x73, 0xe3, 0x09, 0xa1, 0x50, 0x61,
+	0xd1, 0xea, 0x84, 0xd3, 0x5e, 0xc7, 0x17, 0x91, 0x92, 0xd1, 0x7e, 0x2c,
+	0x2c, 0xcd, 0x3c, 0xe4, 0xd1, 0xb6, 0x69, 0x30, 0xf5, 0x7a, 0x54, 0x05,
+	0xbd, 0x8a, 0xc6, 0xc9, 0x22, 0xdd, 0xda, 0x60, 0x46, 0xed, 0x69, 0x4f,
+	0xce, 0x8d, 0xff, 0x66, 0x20, 0x45, 0x8c, 0x3c, 0x86, 0xbc, 0xd3, 0x0b,
+	0xb5, 0xe3, 0x00, 0xb4, 0xb7, 0xd6, 0x38, 0xb0, 0xd9, 0x49, 0xac, 0x21,
+	0x6b, 0xfe, 0x10, 0x98, 0x64, 0xf6, 0x06, 0x07, 0xf7, 0x0e, 0x14, 0x5c,
+	0x27, 0xbc, 0xe4, 0xf2, 0x3c, 0xfa, 0xfb, 0x25, 0x94, 0xcf, 0x1f, 0x22,
+	0x8d, 0xc9, 0xe6, 0x19, 0x93, 0xc9, 0x7d, 0x17, 0xb7, 0x4f, 0x43, 0x16,
+	0xcf, 0x8e, 0x3b, 0x09, 0x1c, 0x9e, 0x87, 0x2b, 0xfc, 0xb4, 0x36, 0x25,
+	0xfd, 0x50, 0x92, 0xd1, 0xd7, 0x75, 0x97, 0x76, 0x27, 0xfd, 0xce, 0xc0,
+	0xef, 0xf7, 0xbd, 0x51, 0xbf, 0xb6, 0x8a, 0x72, 0x05, 0xab, 0xcc, 0x89,
+	0xc7, 0x82, 0x86, 0x87, 0x42, 0xc4, 0xe1, 0x87, 0x57, 0xa3, 0x59, 0x40,
+	0x57, 0x78, 0x40, 0xab, 0x22, 0xa0, 0x47, 0x8c, 0x6f, 0x23, 0x5b, 0xc5,
+	0xaa, 0xd2, 0x9f, 0xa3, 0x18, 0x7f, 0xc8, 0x41, 0x65, 0x5e, 0x8c, 0xdc,
+	0x81, 0xc2, 0xc0, 0x75, 0xc6, 0xb3, 0x60, 0x59, 0x79, 0x36, 0x67, 0xbb,
+	0x78, 0x04, 0x65, 0xce, 0xea, 0x7a, 0x23, 0xb7, 0xe3, 0xaa, 0x9b, 0x04,
+	0x8c, 0xb6, 0x8f, 0xa4, 0xc3, 0x11, 0xf9, 0x1e, 0x90, 0x99, 0xc4, 0xd8,
+	0x09, 0xc3, 0xdf, 0xf8, 0x81, 0x2b, 0xf3, 0xb2, 0xa2, 0xe4, 0xce, 0x25,
+	0x9d, 0x7c, 0x20, 0xa5, 0xc5, 0x56, 0xba, 0x4e, 0x48, 0x99, 0x55, 0x19,
+	0x17, 0xc6, 0xe1, 0xce, 0xed, 0x23, 0x1d, 0x4d, 0xbf, 0x0f, 0x5b, 0x9a,
+	0xf0, 0x85, 0x37, 0x88, 0x68, 0xc0, 0x63, 0xa3, 0xcb, 0x3e, 0xe7, 0x64,
+	0x3c, 0xca, 0xdb, 0x5c, 0x76, 0x48, 0x87, 0x5d, 0x1a, 0x68, 0x37, 0x64,
+	0x8d, 0xcb, 0x6f, 0x33, 0xe6, 0x09, 0xe7, 0xc5, 0xd7, 0x84, 0xed, 0x3b,
+	0xec, 0x79, 0x43, 0xbe, 0xef, 0xbc, 0x44, 0x3d, 0x9c, 0xb3, 0x7d, 0x23,
+	0x5a, 0x71, 0x96, 0x40, 0xab, 0x82, 0x40, 0x2b, 0x46, 0xe1, 0x68, 0xc5,
+	0x4e, 0x8c, 0x1f, 0x83, 0x37, 0xc2, 0xb5, 0xa7, 0xce, 0xa0, 0x56, 0xe4,
+	0x12, 0x65, 0x46, 0x6c, 0x49, 0x1a, 0xf9, 0x0e, 0x22, 0x27, 0xf7, 0x7c,
+	0x1c, 0xd0, 0x0b, 0xef, 0x4d, 0x0d, 0x04, 0x0a, 0x71, 0xda, 0x39, 0x27,
+	0x99, 0x77, 0x60, 0xf7, 0x88, 0x46, 0xe2, 0x12, 0xc8, 0x6c, 0x50, 0xd8,
+	0x67, 0x41, 0x6d, 0x91, 0x25, 0xb1, 0xf4, 0x87, 0xb0, 0xde, 0xd3, 0x19,
+	0xfc, 0xd4, 0xe2, 0x1b, 0x3a, 0xbb, 0x57, 0x56, 0x52, 0xcc, 0xfc, 0x39,
+	0xec, 0xda, 0xe9, 0xcb, 0x7d, 0x63, 0x93, 0x06, 0xd4, 0xec, 0x25, 0x6e,
+	0x9a, 0x04, 0x73, 0x8d, 0x46, 0x0d, 0x77, 0xe8, 0x61, 0x2e, 0xca, 0x6d,
+	0x7d, 0x00, 0x5b, 0x1a, 0x4d, 0xee, 0xa7, 0x0a, 0x6b, 0x0e, 0xa7, 0xe0,
+	0xaa, 0x39, 0x8c, 0x91, 0x9c, 0x99, 0x3f, 0x85, 0xed, 0x13, 0xdf, 0xf5,
+	0x2e, 0xae, 0x12, 0x36, 0x48, 0x05, 0x72, 0x8e, 0xeb, 0x4a, 0x59, 0x36,
+	0x64, 0x06, 0x02, 0x3a, 0xf4, 0xa7, 0x94, 0x63, 0x15, 0x1a, 0x10, 0x33,
+	0xb3, 0x0a, 0x3b, 0x49, 0x03, 0xd2, 0xb3, 0x11, 0xe4, 0xcf, 0xda, 0xe7,
+	0xad, 0xd1, 0x85, 0xaf, 0x57, 0xbe, 0x4c, 0xb2, 0xf2, 0xb5, 0x80, 0xa8,
+	0x1b, 0x4c, 0xdf, 0x8c, 0x3d, 0x19, 0x97, 0x2c, 0x8f, 0x8b, 0x51, 0x17,
+	0x45, 0xb6, 0xae, 0x8a, 0x6c, 0xbd, 0xad, 0x8a, 0xac, 0xb5, 0x25, 0xb5,
+	0x9a, 0xb1, 0x92, 0xf9, 0xe7, 0x0c, 0x14, 0x79, 0xe6, 0xbc, 0x65, 0xc9,
+	0xa7, 0xb0, 0x16, 0xfa, 0x93, 0xa0, 0x47, 0xf9, 0x32, 0x1b, 0x4f, 0xee,
+	0x8a, 0xf0, 0xc7, 0xaa, 0x62, 0x64, 0x73, 0x11, 0x4b, 0x8a, 0x9a, 0x9f,
+	0x40, 0x49, 0x23, 0x93, 0x12, 0xe4, 0x5b, 0xa7, 0x2f, 0x1b, 0xc7, 0xad,
+	0xc3, 0xca, 0x2d, 0x0c, 0x55, 0xb9, 0xf1, 0xa2, 0xfd, 0x59, 0xf3, 0xb4,
+	0xdd, 0x3a, 0x68, 0xb4, 0x9b, 0x95, 0x0c, 0x59, 0x87, 0xe2, 0x51, 0xb3,
+	0xdd, 0x69, 0x9f, 0x7d, 0xd1, 0x3c, 0xad, 0x64, 0xcd, 0x3f, 0x65, 0x60,
+	0x9b, 0x9d, 0x2e, 0x36, 0x19, 0x5e, 0x4f, 0x6b, 0x08, 0xbe, 0x41, 0xd9,
+	0x67, 0xf7, 0xd5, 0x1f, 0xd1, 0x0e, 0x6b, 0x37, 0x66, 0x48, 0x20, 0x10,
+	0x63, 0x13, 0x19, 0x2c, 0x42, 0x31, 0x10, 0xdc, 0x85, 0xe2, 0xeb, 0x67,
+	0xa1, 0x34, 0x2e, 0xd0, 0xa2, 0x80, 0x04, 0xd1, 0x0c, 0x7c, 0x04, 0x3b,
+	0x49, 0x9f, 0x6e, 0xd6, 0x43, 0x6c, 0xc2, 0xfa, 0xab, 0x4b, 0xbf, 0x31,
+	0x6c, 0xa9, 0x64, 0xeb, 0xc2, 0x86, 0x22, 0x48, 0x0b, 0x06, 0x14, 0x26,
+	0x21, 0x0d, 0xb4, 0x86, 0x21, 0x9e, 0x33, 0x38, 0xf0, 0xc2, 0x0e, 0x4f,
+	0x3d, 0xee, 0x75, 0xc1, 0xca, 0x7b, 0x21, 0x4f, 0x1c, 0x64, 0xe5, 0xa2,
+	0x48, 0xe0, 0x7d, 0x6e, 0x3f, 0x8f, 0x41, 0xc8, 0xb5, 0xdb, 0xc7, 0x16,
+	0xa3, 0x99, 0xbf, 0xcf, 0x40, 0xae, 0x71, 0x70, 0x4c, 0x3e, 0x84, 0x3c,
+	0xfa, 0x1b, 0x78, 0x54, 0x24, 0x71, 0x0c, 0xca, 0xc8, 0xab, 0x37, 0x05,
+	0x83, 0xfd, 0xb9, 0xb2, 0x94, 0x98, 0x71, 0x04, 0x65, 0x9d, 0xc1, 0xd2,
+	0xfa, 0x35, 0xbd, 0x92, 0x6e, 0xb1, 0x21, 0x22, 0xc8, 0x2a, 0x02, 0xc9,
+	0x44, 0x65, 0x43, 0x49, 0x58, 0xb4, 0x7b, 0xfe, 0x98, 0x5a, 0x82, 0xf3,
+	0x71, 0xf6, 0x59, 0xc6, 0xfc, 0x1d, 0xac, 0xbe, 0x08, 0x59, 0xc9, 0x7d,
+	0x06, 0x45, 0xb5, 0x1b, 0xe5, 0x85, 0x21, 0x74, 0x38, 0x9f, 0xff, 0xe6,
+	0x4c, 0xe1, 0xc9, 0x4c, 0xd8, 0xf8, 0x09, 0x6c, 0x24, 0x99, 0x29, 0xde,
+	0xec, 0xe8, 0xde, 0x14, 0x74, 0x07, 0x26, 0xb0, 0xc6, 0xb1, 0x38, 0xc4,
+	0x28, 0xac, 0x71, 0x7c, 0x53, 0xcb, 0xd7, 0xb4, 0xca, 0x14, 0xca, 0x3f,
+	0x62, 0x71, 0x29, 0x67, 0xfc, 0x18, 0x4a, 0x1a, 0xf9, 0x6b, 0x2d, 0xdb,
+	0xc2, 0x36, 0x13, 0xad, 0xfb, 0x81, 0xf7, 0xdb, 0x38, 0x6f, 0xb1, 0x1b,
+	0x0c, 0xe8, 0xd8, 0x57, 0xdd, 0x20, 0x1b, 0xb3, 0x30, 0x86, 0x2c, 0x66,
+	0xa9, 0x61, 0xe4, 0x1c, 0xf3, 0x29, 0x6c, 0x69, 0xa6, 0x64, 0xb2, 0x3c,
+	0x00, 0x70, 0x14, 0xd1, 0xe5, 0x16, 0x0b, 0x96, 0x46, 0x31, 0x0f, 0x60,
+	0x13, 0xb1, 0x4c, 0xd8, 0x91, 0xcb, 0x5f, 0x97, 0x5f, 0xb8, 0x11, 0xe6,
+	0x4e, 0x28, 0x31, 0x4a, 0x4c, 0xcc, 0x1f, 0x71, 0x90, 0x94, 0x46, 0xe4,
+	0xc2, 0x8f, 0x11, 0x06, 0x18, 0x41, 0x44, 0x71, 0xce, 0x63, 0xc9, 0x32,
+	0x5d, 0xd8, 0xb4, 0xbf, 0xc6, 0xea, 0x2a, 0x30, 0xd9, 0xb4, 0xc0, 0xe4,
+	0x96, 0x06, 0x06, 0x31, 0xdc, 0x9e, 0x73, 0xcf, 0xc4, 0xea, 0xc7, 0x30,
+	0xfc, 0xe0, 0xf8, 0x9a, 0xa0, 0xe3, 0xe1, 0x14, 0x50, 0x42, 0x1c, 0xea,
+	0x75, 0x7e, 0xdd, 0xe0, 0x70, 0x7c, 0xd8, 0x50, 0xeb, 0xc9, 0x00, 0xbd,
+	0x37, 0x7f, 0xd9, 0x36, 0xe2, 0xcb, 0x96, 0xbc, 0x64, 0x88, 0xa8, 0xeb,
+	0x81, 0xdf, 0xf5, 0xa3, 0x8e, 0x92, 0xcf, 0xa6, 0xca, 0x97, 0xb9, 0x90,
+	0xbc, 0x8e, 0xe6, 0x09, 0xac, 0xdb, 0x6f, 0xdb, 0xa0, 0xee, 0x43, 0xf6,
+	0x5a, 0x1f, 0xcc, 0x0a, 0x6c, 0xd8, 0x09, 0xff, 0xcd, 0xcf, 0x61, 0x9b,
+	0xed, 0x08, 0xc5, 0x05, 0x8c, 0x2e, 0xbe, 0xc2, 0xe6, 0x0a, 0x83, 0x04,
+	0xa0, 0x6c, 0x0a, 0x00, 0x3d, 0x87, 0x9d, 0xa4, 0x2d, 0x19, 0xa3, 0xe5,
+	0x4f, 0x3a, 0x4c, 0x44, 0x1d, 0x41, 0xc5, 0x04, 0x0f, 0xac, 0xda, 0x7c,
+	0x13, 0xd1, 0x91, 0xbb, 0xe0, 0x56, 0xaa, 0xfc, 0x75, 0x2e, 0x61, 0x5b,
+	0xb3, 0x60, 0x4a, 0xee, 0xbc, 0x0e, 0x55, 0x8b, 0x4e, 0x91, 0x74, 0xb3,
+	0x55, 0x98, 0xa9, 0x05, 0x79, 0x69, 0xea, 0x84, 0x77, 0x33, 0x02, 0x3c,
+	0x9e, 0xfb, 0x01, 0xc3, 0xaf, 0x9b, 0x5c, 0x84, 0x6a, 0x0c, 0x51, 0xb2,
+	0x57, 0x10, 0x33, 0xd9, 0xc9, 0xcc, 0x99, 0x93, 0x4b, 0xbd, 0x54, 0x7d,
+	0x84, 0x68, 0x21, 0x43, 0xcd, 0x67, 0xae, 0xad, 0x7c, 0xe6, 0x13, 0xd5,
+	0x9f, 0x64, 0xd3, 0xfa, 0x93, 0x5c, 0xa2, 0x3f, 0xd9, 0x85, 0xdb, 0x73,
+	0x76, 0xe3, 0x30, 0x31, 0x54, 0x10, 0xce, 0xdc, 0x60, 0x53, 0xb2, 0xad,
+	0x52, 0xf2, 0xb3, 0xb6, 0x4a, 0x03, 0xe3, 0xd9, 0x4e, 0xdf, 0xe5, 0xb8,
+	0xc5, 0x4b, 0xc2, 0xb5, 0x1b, 0x31, 0x3f, 0xe4, 0x5e, 0x48, 0x41, 0x69,
+	0xf4, 0xde, 0x7c, 0x8d, 0x29, 0x6a, 0x75, 0x04, 0x2b, 0xf7, 0x1d, 0xd4,
+	0x38, 0x4b, 0x16, 0xfb, 0xb7, 0xa6, 0x37, 0x2e, 0x64, 0xa4, 0xa9, 0xc9,
+	0x25, 0xf1, 0xf6, 0xf5, 0x7c, 0x37, 0x7e, 0xe1, 0xb3, 0xb1, 0xd9, 0x84,
+	0x6d, 0xec, 0xaf, 0x06, 0x8e, 0x37, 0xfa, 0x36, 0x08, 0x68, 0xfe, 0x2f,
+	0x03, 0x3b, 0x49, 0x3b, 0x72, 0xcd, 0x18, 0x96, 0x32, 0xcb, 0x60, 0x89,
+	0xc5, 0x4c, 0x6f, 0x16, 0xc4, 0x84, 0x61, 0xb7, 0xeb, 0x05, 0x6c, 0x8f,
+	0x29, 0xa0, 0x2a, 0x59, 0xe4, 0xd3, 0xf8, 0x64, 0x56, 0x38, 0x74, 0xbc,
+	0x23, 0x84, 0xd2, 0x3c, 0x49, 0x2d, 0x9a, 0xcf, 0xdf, 0x56, 0x34, 0x6f,
+	0xd6, 0x39, 0xbc, 0xff, 0x03, 0x58, 0xe5, 0x34, 0x52, 0x80, 0x95, 0xd3,
+	0xb3, 0xd3, 0x26, 0x76, 0x8c, 0x00, 0x6b, 0x56, 0xb3, 0x71, 0xd8, 0xb4,
+	0xb0, 0x57, 0xc4, 0xf1, 0x2b, 0xab, 0xd5, 0xc6, 0x71, 0x96, 0x14, 0x61,
+	0xf5, 0xec, 0xd5, 0x29, 0x0e, 0x73, 0x4f, 0xfe, 0x58, 0xc6, 0x96, 0xe7,
+	0xbc, 0x45, 0x3e, 0x41, 0x88, 0x97, 0x1f, 0x67, 0xc8, 0x6d, 0x09, 0x7e,
+	0xc9, 0x2f, 0x38, 0x46, 0x75, 0x9e, 0x2c, 0xf3, 0xfb, 0x16, 0x69, 0x00,
+	0xcc, 0xbe, 0xed, 0x90, 0x5d, 0x21, 0xb7, 0xf0, 0x09, 0xc8, 0xa8, 0x2d,
+	0x32, 0x62, 0x13, 0x36, 0x4f, 0xcf, 0xc4, 0xdb, 0x83, 0xdc, 0x97, 0x0d,
+	0x47, 0xfa, 0x33, 0xc7, 0x78, 0xb0, 0x8c, 0xad, 0x1b, 0xb5, 0x97, 0x18,
+	0xb5, 0xaf, 0x37, 0x6a, 0x2f, 0x37, 0xfa, 0x29, 0x36, 0xdd, 0xea, 0xd5,
+	0x43, 0xaa, 0xb1, 0x0f, 0x89, 0x67, 0x8d, 0xb1, 0xbb, 0x40, 0x8f, 0xf5,
+	0xb1, 0x55, 0xd4, 0xdf, 0x31, 0xe4, 0x8e, 0x10, 0x4d, 0x79, 0x1c, 0x19,
+	0x46, 0x1a, 0x4b, 0x37, 0xa4, 0x77, 0xd6, 0xca, 0x50, 0xca, 0x0b, 0x40,
+	0x19, 0x4a, 0x6b, 0xc4, 0xc5, 0x8e, 0xe2, 0x86, 0x49, 0xed, 0x68, 0xbe,
+	0x19, 0x53, 0x3b, 0x5a, 0xe8, 0xac, 0x50, 0xff, 0x23, 0x4c, 0x2d, 0xde,
+	0x9a, 0x93, 0x6d, 0x21, 0x94, 0xe8, 0xdc, 0x8d, 0x9d, 0x24, 0x31, 0x56,
+	0xc3, 0x94, 0x53, 0xdd, 0x92, 0x4a, 0xb9, 0xb9, 0x16, 0xcc, 0xa8, 0xce,
+	0x93, 0x75, 0x65, 0x7b, 0x4e, 0xd9, 0x4e, 0x57, 0xb6, 0x17, 0x95, 0xd1,
+	0x61, 0xd1, 0x84, 0x28, 0x87, 0x13, 0x2d, 0x90, 0x72, 0x38, 0xd9, 0xa7,
+	0x08, 0x35, 0x3b, 0xa1, 0x66, 0xa7, 0xa9, 0xd9, 0xf3, 0x6a, 0x78, 0x4e,
+	0x7a, 0x51, 0x57, 0xe7, 0x94, 0xd2, 0x34, 0xa8, 0x73, 0x4a, 0xeb, 0x01,
+	0xd0, 0xd0, 0x39, 0x6c, 0xce, 0x95, 0x62, 0x72, 0x4f, 0x81, 0x4d, 0x5a,
+	0xb1, 0x37, 0xee, 0x2f, 0xe1, 0xea, 0x16, 0xe7, 0x2a, 0xb2, 0xb2, 0x98,
+	0x5e, 0xd8, 0x95, 0xc5, 0x65, 0x65, 0x5c, 0x5d, 0xb9, 0x44, 0xe5, 0xd5,
+	0xae, 0x5c, 0x5a, 0x81, 0xd7, 0xae, 0x5c, 0x7a, 0xc1, 0xbe, 0x45, 0x3e,
+	0x87, 0xf5, 0x44, 0x69, 0x25, 0x89, 0x8b, 0x91, 0xac, 0xe3, 0xc6, 0xdd,
+	0x54, 0xde, 0xdc, 0xf5, 0x95, 0x4f, 0x9c, 0x59, 0x7e, 0x25, 0xca, 0xb3,
+	0x76, 0x7d, 0x93, 0x65, 0x38, 0xce, 0x5a, 0xf1, 0x46, 0x9b, 0x65, 0xad,
+	0x5e, 0x80, 0xb5, 0xac, 0x4d, 0x94, 0x5b, 0x54, 0xfe, 0x05, 0x90, 0xc5,
+	0xda, 0x48, 0x1e, 0xc6, 0xf2, 0xe9, 0xc5, 0xd6, 0x78, 0xb4, 0x5c, 0x40,
+	0xcf, 0x32, 0xbd, 0xe4, 0xa8, 0x2c, 0x4b, 0x29, 0xac, 0x2a, 0xcb, 0xd2,
+	0x2a, 0x94, 0x79, 0x6b, 0xff, 0x67, 0xff, 0xf8, 0xea, 0x41, 0xe6, 0x9f,
+	0xf8, 0xf3, 0x6f, 0xfc, 0xf9, 0xcb, 0x7f, 0x1e, 0xdc, 0xfa, 0x65, 0x5d,
+	0x7c, 0x18, 0xa8, 0xf7, 0xfc, 0xe1, 0x1e, 0x7b, 0xa1, 0x5f, 0xb9, 0x34,
+	0xd0, 0x47, 0x61, 0xd0, 0xdb, 0xd3, 0xfe, 0xb7, 0xd1, 0x5d, 0xe3, 0x1f,
+	0x52, 0x9e, 0xfe, 0x1f, 0xb4, 0x2e, 0x41, 0x84, 0xf1, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3034,6 +3403,9 @@ type APIClient interface {
 	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
 	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
 	GetScope(ctx context.Context, in *GetScopeRequest, opts ...grpc.CallOption) (*GetScopeResponse, error)
+	// ExplainScope is like GetScope, but additionally explains which bindings
+	// (admin status, direct ACL entry, group ACL entries) produce the result
+	ExplainScope(ctx context.Context, in *ExplainScopeRequest, opts ...grpc.CallOption) (*ExplainScopeResponse, error)
 	SetScope(ctx context.Context, in *SetScopeRequest, opts ...grpc.CallOption) (*SetScopeResponse, error)
 	GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error)
 	SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error)
@@ -3145,6 +3517,15 @@ func (c *aPIClient) GetScope(ctx context.Context, in *GetScopeRequest, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) ExplainScope(ctx context.Context, in *ExplainScopeRequest, opts ...grpc.CallOption) (*ExplainScopeResponse, error) {
+	out := new(ExplainScopeResponse)
+	err := c.cc.Invoke(ctx, "/auth.API/ExplainScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) SetScope(ctx context.Context, in *SetScopeRequest, opts ...grpc.CallOption) (*SetScopeResponse, error) {
 	out := new(SetScopeResponse)
 	err := c.cc.Invoke(ctx, "/auth.API/SetScope", in, out, opts...)
@@ -3261,6 +3642,9 @@ type APIServer interface {
 	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
 	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
 	GetScope(context.Context, *GetScopeRequest) (*GetScopeResponse, error)
+	// ExplainScope is like GetScope, but additionally explains which bindings
+	// (admin status, direct ACL entry, group ACL entries) produce the result
+	ExplainScope(context.Context, *ExplainScopeRequest) (*ExplainScopeResponse, error)
 	SetScope(context.Context, *SetScopeRequest) (*SetScopeResponse, error)
 	GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error)
 	SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error)
@@ -3656,6 +4040,24 @@ func _API_GetOneTimePassword_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ExplainScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ExplainScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.API/ExplainScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ExplainScope(ctx, req.(*ExplainScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "auth.API",
 	HandlerType: (*APIServer)(nil),
@@ -3744,6 +4146,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetOneTimePassword",
 			Handler:    _API_GetOneTimePassword_Handler,
 		},
+		{
+			MethodName: "ExplainScope",
+			Handler:    _API_ExplainScope_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/auth/auth.proto",
@@ -3975,6 +4381,16 @@ func (m *AuthConfig) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.GroupSyncOptions != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.GroupSyncOptions.Size()))
+		n8, err8 := m.GroupSyncOptions.MarshalTo(dAtA[i:])
+		if err8 != nil {
+			return 0, err8
+		}
+		i += n8
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -4365,6 +4781,12 @@ func (m *AuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintAuth(dAtA, i, uint64(len(m.OneTimePassword)))
 		i += copy(dAtA[i:], m.OneTimePassword)
 	}
+	if len(m.K8SToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.K8SToken)))
+		i += copy(dAtA[i:], m.K8SToken)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -5435,46 +5857,217 @@ func (m *GetOneTimePasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExplainScopeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ActivateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExplainScopeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.GitHubToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Username) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ActivateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExplainScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PachToken)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.Scope != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.Scope))
 	}
-	if m.XXX_unrecognized != nil {
+	if m.Admin {
+		dAtA[i] = 0x10
+		i++
+		if m.Admin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Direct != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(m.Direct))
+	}
+	if len(m.Groups) > 0 {
+		for k, _ := range m.Groups {
+			dAtA[i] = 0x22
+			i++
+			v := m.Groups[k]
+			mapSize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + sovAuth(uint64(v))
+			i = encodeVarintAuth(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAuth(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintAuth(dAtA, i, uint64(v))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AuthConfig_GroupSyncOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthConfig_GroupSyncOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.LDAPURL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LDAPURL)))
+		i += copy(dAtA[i:], m.LDAPURL)
+	}
+	if len(m.LDAPBindDN) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LDAPBindDN)))
+		i += copy(dAtA[i:], m.LDAPBindDN)
+	}
+	if len(m.LDAPBindPassword) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LDAPBindPassword)))
+		i += copy(dAtA[i:], m.LDAPBindPassword)
+	}
+	if len(m.LDAPGroupBaseDN) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LDAPGroupBaseDN)))
+		i += copy(dAtA[i:], m.LDAPGroupBaseDN)
+	}
+	if len(m.LDAPGroupFilter) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LDAPGroupFilter)))
+		i += copy(dAtA[i:], m.LDAPGroupFilter)
+	}
+	if len(m.LDAPMemberAttribute) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.LDAPMemberAttribute)))
+		i += copy(dAtA[i:], m.LDAPMemberAttribute)
+	}
+	if len(m.SCIMURL) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.SCIMURL)))
+		i += copy(dAtA[i:], m.SCIMURL)
+	}
+	if len(m.SCIMToken) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.SCIMToken)))
+		i += copy(dAtA[i:], m.SCIMToken)
+	}
+	if len(m.SyncInterval) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.SyncInterval)))
+		i += copy(dAtA[i:], m.SyncInterval)
+	}
+	if len(m.GroupPrefix) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.GroupPrefix)))
+		i += copy(dAtA[i:], m.GroupPrefix)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ActivateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GitHubToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PachToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
@@ -5571,6 +6164,10 @@ func (m *AuthConfig) Size() (n int) {
 		l = m.SAMLServiceOptions.Size()
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	if m.GroupSyncOptions != nil {
+		l = m.GroupSyncOptions.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5783,6 +6380,10 @@ func (m *AuthenticateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
+	l = len(m.K8SToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6374,6 +6975,107 @@ func (m *GetOneTimePasswordResponse) Size() (n int) {
 	return n
 }
 
+func (m *ExplainScopeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExplainScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != 0 {
+		n += 1 + sovAuth(uint64(m.Scope))
+	}
+	if m.Admin {
+		n += 2
+	}
+	if m.Direct != 0 {
+		n += 1 + sovAuth(uint64(m.Direct))
+	}
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAuth(uint64(len(k))) + 1 + sovAuth(uint64(v))
+			n += mapEntrySize + 1 + sovAuth(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthConfig_GroupSyncOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LDAPURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.LDAPBindDN)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.LDAPBindPassword)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.LDAPGroupBaseDN)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.LDAPGroupFilter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.LDAPMemberAttribute)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.SCIMURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.SCIMToken)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.SyncInterval)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.GroupPrefix)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	for {
 		n++
@@ -7123,6 +7825,42 @@ func (m *AuthConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSyncOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupSyncOptions == nil {
+				m.GroupSyncOptions = &AuthConfig_GroupSyncOptions{}
+			}
+			if err := m.GroupSyncOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -8270,24 +9008,56 @@ func (m *AuthenticateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.OneTimePassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuth(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SToken", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -11577,6 +12347,726 @@ func (m *GetOneTimePasswordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExplainScopeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainScopeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainScopeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ExplainScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admin = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			}
+			m.Direct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direct |= Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[string]Scope)
+			}
+			var mapkey string
+			var mapvalue Scope
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Scope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAuth(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAuth
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AuthConfig_GroupSyncOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthConfig_GroupSyncOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthConfig_GroupSyncOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LDAPURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LDAPURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LDAPBindDN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LDAPBindDN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LDAPBindPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LDAPBindPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LDAPGroupBaseDN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LDAPGroupBaseDN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LDAPGroupFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LDAPGroupFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LDAPMemberAttribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LDAPMemberAttribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SCIMURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SCIMURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SCIMToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SCIMToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// This file implements the optional client-side response cache enabled by
+// WithResponseCache. Dashboards and shell completion call ListRepo,
+// ListPipeline and InspectCommit over and over; with the cache enabled those
+// reads are answered locally for the cache's TTL instead of hammering pachd.
+// InspectCommit responses for finished commits are special-cased: a commit ID
+// identifies immutable content (it acts as an etag), so those entries never
+// go stale and are served past the TTL.
+
+// cacheMaxEntries bounds the cache's size; when it's reached the whole cache
+// is dropped (blunt, but the cache is repopulated by the very reads it
+// serves, so this just costs one round of misses).
+const cacheMaxEntries = 10000
+
+// WithResponseCache instructs the New* functions to cache ListRepo,
+// ListPipeline and InspectCommit responses locally for 'ttl'. Cached
+// responses may be up to 'ttl' stale (except finished commits, which are
+// immutable and therefore never stale); mutations made through the same
+// client invalidate the affected entries immediately.
+func WithResponseCache(ttl time.Duration) Option {
+	return func(settings *clientSettings) error {
+		if ttl <= 0 {
+			return fmt.Errorf("response cache TTL must be positive, is %v", ttl)
+		}
+		settings.cacheTTL = ttl
+		return nil
+	}
+}
+
+type cacheEntry struct {
+	value proto.Message
+	// expires is when this entry stops being served; the zero value means the
+	// entry is immutable and never expires
+	expires time.Time
+}
+
+// responseCache holds cached responses. It's shared by all copies of an
+// APIClient (e.g. those created by WithCtx), hence the pointer field in
+// APIClient and the mutex here.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Cache keys. Commit entries append "<repo>@<commit id>".
+const (
+	cacheKeyListRepo     = "ListRepo"
+	cacheKeyListPipeline = "ListPipeline"
+	cacheKeyCommitPrefix = "InspectCommit:"
+)
+
+func commitCacheKey(repoName, commitID string) string {
+	return fmt.Sprintf("%s%s@%s", cacheKeyCommitPrefix, repoName, commitID)
+}
+
+// cacheGet returns the cached response under 'key', if there is one and it
+// hasn't expired. The result is a copy, so callers may modify it freely.
+func (c APIClient) cacheGet(key string) (proto.Message, bool) {
+	if c.respCache == nil {
+		return nil, false
+	}
+	c.respCache.mu.Lock()
+	defer c.respCache.mu.Unlock()
+	entry, ok := c.respCache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		delete(c.respCache.entries, key)
+		return nil, false
+	}
+	return proto.Clone(entry.value), true
+}
+
+// cachePut stores 'value' under 'key' until the cache's TTL elapses. It
+// stores a copy, so callers may modify 'value' after it returns.
+func (c APIClient) cachePut(key string, value proto.Message) {
+	c.cachePutEntry(key, value, false)
+}
+
+// cachePutImmutable is cachePut for responses that can never go stale (e.g.
+// finished commits, whose ID identifies immutable content); such entries are
+// served past the TTL.
+func (c APIClient) cachePutImmutable(key string, value proto.Message) {
+	c.cachePutEntry(key, value, true)
+}
+
+func (c APIClient) cachePutEntry(key string, value proto.Message, immutable bool) {
+	if c.respCache == nil {
+		return
+	}
+	c.respCache.mu.Lock()
+	defer c.respCache.mu.Unlock()
+	if len(c.respCache.entries) >= cacheMaxEntries {
+		c.respCache.entries = make(map[string]cacheEntry)
+	}
+	entry := cacheEntry{value: proto.Clone(value)}
+	if !immutable {
+		entry.expires = time.Now().Add(c.respCache.ttl)
+	}
+	c.respCache.entries[key] = entry
+}
+
+// cacheInvalidate drops the cached response under 'key' (if any). Mutating
+// wrappers call this so that e.g. a CreateRepo immediately shows up in the
+// next ListRepo made through the same client.
+func (c APIClient) cacheInvalidate(key string) {
+	if c.respCache == nil {
+		return
+	}
+	c.respCache.mu.Lock()
+	defer c.respCache.mu.Unlock()
+	delete(c.respCache.entries, key)
+}
@@ -9,14 +9,17 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	types "github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
@@ -26,6 +29,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/debug"
 	"github.com/pachyderm/pachyderm/src/client/deploy"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/event"
 	"github.com/pachyderm/pachyderm/src/client/health"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -33,6 +37,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 )
 
@@ -76,6 +81,9 @@ type AdminAPIClient admin.APIClient
 // DebugClient is an alias of debug.DebugClient
 type DebugClient debug.DebugClient
 
+// EventAPIClient is an alias of event.APIClient
+type EventAPIClient event.APIClient
+
 // An APIClient is a wrapper around pfs, pps and block APIClients.
 type APIClient struct {
 	PfsAPIClient
@@ -86,6 +94,7 @@ type APIClient struct {
 	VersionAPIClient
 	AdminAPIClient
 	DebugClient
+	EventAPIClient
 	Enterprise enterprise.APIClient // not embedded--method name conflicts with AuthAPIClient
 
 	// addr is a "host:port" string pointing at a pachd endpoint
@@ -121,6 +130,38 @@ type APIClient struct {
 	ctx context.Context
 
 	portForwarder *PortForwarder
+
+	// maxRetries is the automatic retry budget for idempotent RPCs (see
+	// WithRetries); 0 disables automatic retries.
+	maxRetries int
+
+	// rpcTimeout is the per-RPC deadline (inactivity timeout for streams) set
+	// via WithRPCTimeout; 0 means RPCs have no deadline.
+	rpcTimeout time.Duration
+
+	// respCache caches metadata-read responses if WithResponseCache was given
+	// (see cache.go); nil means no caching.
+	respCache *responseCache
+
+	// Connection-tuning knobs, set via WithConnectionPool, WithMaxMsgSize
+	// and WithKeepalive.
+	poolSize         int
+	maxMsgSize       int
+	keepaliveTime    time.Duration
+	keepaliveTimeout time.Duration
+
+	// streamConns are extra grpc connections that large parallel
+	// GetFile/PutFile workloads are multiplexed across (see streamClient);
+	// streamConns[0] == clientConn.
+	streamConns []*grpc.ClientConn
+	connCounter uint64
+
+	// serverVersion and serverCapabilities cache the result of the server's
+	// GetAPIVersion RPC (see ServerSupports); they're fetched on first use
+	// rather than at connect time so that commands that don't need them pay
+	// no extra round trip.
+	serverVersion      *versionpb.Version
+	serverCapabilities []string
 }
 
 // GetAddress returns the pachd host:port with which 'c' is communicating. If
@@ -137,9 +178,22 @@ const DefaultMaxConcurrentStreams = 100
 // for a connection to be established unless overridden by WithDialTimeout()
 const DefaultDialTimeout = 30 * time.Second
 
+// Default gRPC keepalive settings, overridable with WithKeepalive.
+const (
+	defaultKeepaliveTime    = 20 * time.Second
+	defaultKeepaliveTimeout = 20 * time.Second
+)
+
 type clientSettings struct {
 	maxConcurrentStreams int
+	maxRetries           int
+	poolSize             int
+	maxMsgSize           int
+	keepaliveTime        time.Duration
+	keepaliveTimeout     time.Duration
 	dialTimeout          time.Duration
+	rpcTimeout           time.Duration
+	cacheTTL             time.Duration
 	caCerts              *x509.CertPool
 }
 
@@ -148,6 +202,10 @@ func NewFromAddress(addr string, options ...Option) (*APIClient, error) {
 	// Apply creation options
 	settings := clientSettings{
 		maxConcurrentStreams: DefaultMaxConcurrentStreams,
+		poolSize:             1,
+		maxMsgSize:           grpcutil.MaxMsgSize,
+		keepaliveTime:        defaultKeepaliveTime,
+		keepaliveTimeout:     defaultKeepaliveTimeout,
 		dialTimeout:          DefaultDialTimeout,
 	}
 	for _, option := range options {
@@ -156,9 +214,18 @@ func NewFromAddress(addr string, options ...Option) (*APIClient, error) {
 		}
 	}
 	c := &APIClient{
-		addr:    addr,
-		caCerts: settings.caCerts,
-		limiter: limit.New(settings.maxConcurrentStreams),
+		addr:             addr,
+		caCerts:          settings.caCerts,
+		limiter:          limit.New(settings.maxConcurrentStreams),
+		maxRetries:       settings.maxRetries,
+		rpcTimeout:       settings.rpcTimeout,
+		poolSize:         settings.poolSize,
+		maxMsgSize:       settings.maxMsgSize,
+		keepaliveTime:    settings.keepaliveTime,
+		keepaliveTimeout: settings.keepaliveTimeout,
+	}
+	if settings.cacheTTL > 0 {
+		c.respCache = newResponseCache(settings.cacheTTL)
 	}
 	if err := c.connect(settings.dialTimeout); err != nil {
 		return nil, err
@@ -215,6 +282,45 @@ func WithAdditionalRootCAs(pemBytes []byte) Option {
 	}
 }
 
+// WithConnectionPool instructs the New* functions to open 'conns' HTTP/2
+// connections to pachd and multiplex GetFile/PutFile streams across them.
+// A single connection's throughput caps out well below object-store
+// bandwidth, so large parallel transfer workloads should set this to
+// roughly the number of concurrent streams divided by ten.
+func WithConnectionPool(conns int) Option {
+	return func(settings *clientSettings) error {
+		if conns < 1 {
+			return fmt.Errorf("connection pool size must be positive, is %d", conns)
+		}
+		settings.poolSize = conns
+		return nil
+	}
+}
+
+// WithMaxMsgSize instructs the New* functions to create a client that can
+// send and receive gRPC messages up to 'size' bytes (the default is
+// grpcutil.MaxMsgSize). It must match the server's limit for large
+// FileInfos and hashtree responses to get through.
+func WithMaxMsgSize(size int) Option {
+	return func(settings *clientSettings) error {
+		if size <= 0 {
+			return fmt.Errorf("max message size must be positive, is %d", size)
+		}
+		settings.maxMsgSize = size
+		return nil
+	}
+}
+
+// WithKeepalive instructs the New* functions to use the given gRPC keepalive
+// ping interval and timeout instead of the defaults (20s/20s).
+func WithKeepalive(interval time.Duration, timeout time.Duration) Option {
+	return func(settings *clientSettings) error {
+		settings.keepaliveTime = interval
+		settings.keepaliveTimeout = timeout
+		return nil
+	}
+}
+
 // WithDialTimeout instructs the New* functions to use 't' as the deadline to
 // connect to pachd
 func WithDialTimeout(t time.Duration) Option {
@@ -349,6 +455,12 @@ func NewOnUserMachine(reportMetrics bool, portForward bool, prefix string, optio
 		log.Warningf("error loading user config from ~/.pachyderm/config: %v", err)
 	}
 
+	// Apply the process-wide RPC timeout (pachctl's --timeout flag) unless an
+	// explicit WithRPCTimeout option overrides it
+	if DefaultRPCTimeout > 0 {
+		options = append([]Option{WithRPCTimeout(DefaultRPCTimeout)}, options...)
+	}
+
 	// create new pachctl client
 	var fw *PortForwarder
 	addr, cfgOptions, err := getUserMachineAddrAndOpts(cfg)
@@ -427,8 +539,10 @@ func NewInCluster(options ...Option) (*APIClient, error) {
 
 // Close the connection to gRPC
 func (c *APIClient) Close() error {
-	if err := c.clientConn.Close(); err != nil {
-		return err
+	for _, conn := range c.streamConns {
+		if err := conn.Close(); err != nil {
+			return err
+		}
 	}
 
 	if c.portForwarder != nil {
@@ -489,11 +603,15 @@ func DefaultDialOptions() []grpc.DialOption {
 
 func (c *APIClient) connect(timeout time.Duration) error {
 	keepaliveOpt := grpc.WithKeepaliveParams(keepalive.ClientParameters{
-		Time:                20 * time.Second, // if 20s since last msg (any kind), ping
-		Timeout:             20 * time.Second, // if no response to ping for 20s, reset
-		PermitWithoutStream: true,             // send ping even if no active RPCs
+		Time:                c.keepaliveTime,    // if this long since last msg (any kind), ping
+		Timeout:             c.keepaliveTimeout, // if no response to ping for this long, reset
+		PermitWithoutStream: true,               // send ping even if no active RPCs
 	})
 	dialOptions := append(DefaultDialOptions(), keepaliveOpt)
+	dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(c.maxMsgSize),
+		grpc.MaxCallSendMsgSize(c.maxMsgSize),
+	))
 	if c.caCerts == nil {
 		dialOptions = append(dialOptions, grpc.WithInsecure())
 	} else {
@@ -504,16 +622,37 @@ func (c *APIClient) connect(timeout time.Duration) error {
 		// TODO(msteffen) switch to grpc.DialContext instead
 		grpc.WithTimeout(timeout),
 	)
+	var unaryInterceptors []grpc.UnaryClientInterceptor
+	var streamInterceptors []grpc.StreamClientInterceptor
 	if tracing.IsActive() {
-		dialOptions = append(dialOptions,
-			grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
-			grpc.WithStreamInterceptor(tracing.StreamClientInterceptor()),
-		)
+		unaryInterceptors = append(unaryInterceptors, tracing.UnaryClientInterceptor())
+		streamInterceptors = append(streamInterceptors, tracing.StreamClientInterceptor())
+	}
+	if c.maxRetries > 0 {
+		unaryInterceptors = append(unaryInterceptors, newRetryInterceptor(c.maxRetries))
+	}
+	if c.rpcTimeout > 0 {
+		unaryInterceptors = append(unaryInterceptors, newTimeoutInterceptor(c.rpcTimeout))
+		streamInterceptors = append(streamInterceptors, newStreamTimeoutInterceptor(c.rpcTimeout))
+	}
+	if len(unaryInterceptors) > 0 {
+		dialOptions = append(dialOptions, grpc.WithUnaryInterceptor(chainUnaryInterceptors(unaryInterceptors)))
+	}
+	if len(streamInterceptors) > 0 {
+		dialOptions = append(dialOptions, grpc.WithStreamInterceptor(chainStreamInterceptors(streamInterceptors)))
 	}
 	clientConn, err := grpc.Dial(c.addr, dialOptions...)
 	if err != nil {
 		return err
 	}
+	c.streamConns = []*grpc.ClientConn{clientConn}
+	for i := 1; i < c.poolSize; i++ {
+		conn, err := grpc.Dial(c.addr, dialOptions...)
+		if err != nil {
+			return err
+		}
+		c.streamConns = append(c.streamConns, conn)
+	}
 	c.PfsAPIClient = pfs.NewAPIClient(clientConn)
 	c.PpsAPIClient = pps.NewAPIClient(clientConn)
 	c.ObjectAPIClient = pfs.NewObjectAPIClient(clientConn)
@@ -523,11 +662,60 @@ func (c *APIClient) connect(timeout time.Duration) error {
 	c.VersionAPIClient = versionpb.NewAPIClient(clientConn)
 	c.AdminAPIClient = admin.NewAPIClient(clientConn)
 	c.DebugClient = debug.NewDebugClient(clientConn)
+	c.EventAPIClient = event.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = health.NewHealthClient(clientConn)
 	return nil
 }
 
+// streamClient returns a pfs.APIClient bound to one of the pooled
+// connections, round-robin, so concurrent GetFile/PutFile streams spread
+// across connections instead of contending for a single HTTP/2 session.
+func (c *APIClient) streamClient() pfs.APIClient {
+	if len(c.streamConns) <= 1 {
+		return pfs.APIClient(c.PfsAPIClient)
+	}
+	conn := c.streamConns[atomic.AddUint64(&c.connCounter, 1)%uint64(len(c.streamConns))]
+	return pfs.NewAPIClient(conn)
+}
+
+// chainUnaryInterceptors combines multiple unary client interceptors into
+// one, since grpc.Dial only honors the last WithUnaryInterceptor option.
+func chainUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor) grpc.UnaryClientInterceptor {
+	if len(interceptors) == 1 {
+		return interceptors[0]
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		chained := invoker
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return interceptor(ctx, method, req, reply, cc, next, opts...)
+			}
+		}
+		return chained(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// chainStreamInterceptors is the streaming analogue of
+// chainUnaryInterceptors (grpc.Dial likewise only honors the last
+// WithStreamInterceptor option).
+func chainStreamInterceptors(interceptors []grpc.StreamClientInterceptor) grpc.StreamClientInterceptor {
+	if len(interceptors) == 1 {
+		return interceptors[0]
+	}
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		chained := streamer
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return interceptor(ctx, desc, cc, method, next, opts...)
+			}
+		}
+		return chained(ctx, desc, cc, method, opts...)
+	}
+}
+
 // AddMetadata adds necessary metadata (including authentication credentials)
 // to the context 'ctx', preserving any metadata that is present in either the
 // incoming or outgoing metadata of 'ctx'.
@@ -586,3 +774,37 @@ func (c *APIClient) WithCtx(ctx context.Context) *APIClient {
 func (c *APIClient) SetAuthToken(token string) {
 	c.authenticationToken = token
 }
+
+// ServerSupports returns nil if the connected pachd advertises the named API
+// capability (see the version package for the known capability names), and an
+// actionable error naming the server's version otherwise. Servers that
+// predate the GetAPIVersion RPC are assumed to support no optional
+// capabilities.
+func (c *APIClient) ServerSupports(capability string) error {
+	if c.serverCapabilities == nil {
+		apiVersion, err := c.VersionAPIClient.GetAPIVersion(c.Ctx(), &types.Empty{})
+		if err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				serverVersion, err := c.VersionAPIClient.GetVersion(c.Ctx(), &types.Empty{})
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				return fmt.Errorf("server %s does not support %q (client is %s; upgrade pachd or use a matching pachctl)",
+					version.PrettyPrintVersion(serverVersion), capability, version.PrettyVersion())
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		c.serverVersion = apiVersion.Version
+		c.serverCapabilities = apiVersion.Capabilities
+		if c.serverCapabilities == nil {
+			c.serverCapabilities = []string{}
+		}
+	}
+	for _, serverCapability := range c.serverCapabilities {
+		if serverCapability == capability {
+			return nil
+		}
+	}
+	return fmt.Errorf("server %s does not support %q (client is %s; upgrade pachd or use a matching pachctl)",
+		version.PrettyPrintVersion(c.serverVersion), capability, version.PrettyVersion())
+}
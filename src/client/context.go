@@ -0,0 +1,48 @@
+package client
+
+import (
+	"io"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// This file provides context-accepting variants of the client methods that
+// most commonly run long enough to need deadlines or cancellation. Each one
+// is shorthand for c.WithCtx(ctx).<Method>(...); every other client method
+// can be bounded the same way.
+
+// GetFileCtx is GetFile with a caller-provided context, so long downloads can
+// be cancelled without closing the whole client.
+func (c APIClient) GetFileCtx(ctx context.Context, repoName string, commitID string, path string, offset int64, size int64, writer io.Writer) error {
+	return c.WithCtx(ctx).GetFile(repoName, commitID, path, offset, size, writer)
+}
+
+// PutFileCtx is PutFile with a caller-provided context.
+func (c APIClient) PutFileCtx(ctx context.Context, repoName string, commitID string, path string, reader io.Reader) (int, error) {
+	return c.WithCtx(ctx).PutFile(repoName, commitID, path, reader)
+}
+
+// ListFileCtx is ListFile with a caller-provided context.
+func (c APIClient) ListFileCtx(ctx context.Context, repoName string, commitID string, path string) ([]*pfs.FileInfo, error) {
+	return c.WithCtx(ctx).ListFile(repoName, commitID, path)
+}
+
+// ListJobCtx is ListJob with a caller-provided context.
+func (c APIClient) ListJobCtx(ctx context.Context, pipelineName string, inputCommit []*pfs.Commit, outputCommit *pfs.Commit) ([]*pps.JobInfo, error) {
+	return c.WithCtx(ctx).ListJob(pipelineName, inputCommit, outputCommit)
+}
+
+// InspectCommitCtx is InspectCommit with a caller-provided context; with
+// BlockCommit semantics this is the usual way to wait on a commit with a
+// deadline.
+func (c APIClient) InspectCommitCtx(ctx context.Context, repoName string, commitID string) (*pfs.CommitInfo, error) {
+	return c.WithCtx(ctx).InspectCommit(repoName, commitID)
+}
+
+// FlushCommitAllCtx is FlushCommitAll with a caller-provided context.
+func (c APIClient) FlushCommitAllCtx(ctx context.Context, commits []*pfs.Commit, toRepos []*pfs.Repo) ([]*pfs.CommitInfo, error) {
+	return c.WithCtx(ctx).FlushCommitAll(commits, toRepos)
+}
@@ -18,6 +18,24 @@ func (c APIClient) Dump(w io.Writer) error {
 	return grpcutil.ScrubGRPC(grpcutil.WriteFromStreamingBytesClient(goroClient, w))
 }
 
+// DumpURL has pachd write its dump directly to an object storage URL (e.g.
+// s3://bucket/path), so multi-gigabyte dumps from large clusters don't have
+// to fit through the caller's connection.
+func (c APIClient) DumpURL(url string) error {
+	dumpClient, err := c.DebugClient.Dump(c.Ctx(), &debug.DumpRequest{URL: url})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		if _, err := dumpClient.Recv(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+}
+
 // Profile writes a pprof profile for pachd to w.
 func (c APIClient) Profile(profile string, duration time.Duration, w io.Writer) error {
 	var d *types.Duration
@@ -42,3 +60,24 @@ func (c APIClient) Binary(w io.Writer) error {
 	}
 	return grpcutil.ScrubGRPC(grpcutil.WriteFromStreamingBytesClient(binaryClient, w))
 }
+
+// SetLogLevel sets pachd's log level; if duration is nonzero the level
+// reverts to info once it elapses.
+func (c APIClient) SetLogLevel(level string, duration time.Duration) error {
+	var d *types.Duration
+	if duration != 0 {
+		d = types.DurationProto(duration)
+	}
+	_, err := c.DebugClient.SetLogLevel(c.Ctx(), &debug.SetLogLevelRequest{
+		Level:    level,
+		Duration: d,
+	})
+	return grpcutil.ScrubGRPC(err)
+}
+
+// EtcdUsage returns the size of pachd's etcd database and a breakdown of the
+// keyspace by collection.
+func (c APIClient) EtcdUsage() (*debug.EtcdUsageResponse, error) {
+	response, err := c.DebugClient.EtcdUsage(c.Ctx(), &debug.EtcdUsageRequest{})
+	return response, grpcutil.ScrubGRPC(err)
+}
@@ -28,7 +28,11 @@ type DumpRequest struct {
 	// Recursed is true if this request is a recursive call from another request.
 	// Callers should leave it unset, it's used to prevent infinite loops of
 	// recursive calls.
-	Recursed             bool     `protobuf:"varint,1,opt,name=recursed,proto3" json:"recursed,omitempty"`
+	Recursed bool `protobuf:"varint,1,opt,name=recursed,proto3" json:"recursed,omitempty"`
+	// URL is an object storage URL (e.g. s3://bucket/path); if set, the dump is
+	// written there by the server instead of being streamed back, so large
+	// dumps don't have to fit through the caller's connection.
+	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -74,6 +78,13 @@ func (m *DumpRequest) GetRecursed() bool {
 	return false
 }
 
+func (m *DumpRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
 type ProfileRequest struct {
 	Profile              string          `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	Duration             *types.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
@@ -168,36 +179,321 @@ func (m *BinaryRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BinaryRequest proto.InternalMessageInfo
 
+type SetLogLevelRequest struct {
+	// level is a logrus level name ("debug", "info", "warn", "error").
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// duration is how long to stay at this level before reverting to info; if
+	// unset the change lasts until the next call (or a restart).
+	Duration             *types.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d15a320d0127c22, []int{3}
+}
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+type SetLogLevelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelResponse) Reset()         { *m = SetLogLevelResponse{} }
+func (m *SetLogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelResponse) ProtoMessage()    {}
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d15a320d0127c22, []int{4}
+}
+func (m *SetLogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetLogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetLogLevelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetLogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelResponse.Merge(m, src)
+}
+func (m *SetLogLevelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetLogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelResponse proto.InternalMessageInfo
+
+type EtcdUsageRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EtcdUsageRequest) Reset()         { *m = EtcdUsageRequest{} }
+func (m *EtcdUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*EtcdUsageRequest) ProtoMessage()    {}
+func (*EtcdUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d15a320d0127c22, []int{5}
+}
+func (m *EtcdUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EtcdUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EtcdUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EtcdUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EtcdUsageRequest.Merge(m, src)
+}
+func (m *EtcdUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EtcdUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EtcdUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EtcdUsageRequest proto.InternalMessageInfo
+
+// CollectionUsage reports the logical size of one etcd collection (e.g.
+// pachyderm_pps/jobs): the number of keys under its prefix and the total
+// bytes of keys plus values.
+type CollectionUsage struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Keys                 int64    `protobuf:"varint,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bytes                int64    `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionUsage) Reset()         { *m = CollectionUsage{} }
+func (m *CollectionUsage) String() string { return proto.CompactTextString(m) }
+func (*CollectionUsage) ProtoMessage()    {}
+func (*CollectionUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d15a320d0127c22, []int{6}
+}
+func (m *CollectionUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectionUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectionUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionUsage.Merge(m, src)
+}
+func (m *CollectionUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectionUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionUsage proto.InternalMessageInfo
+
+func (m *CollectionUsage) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *CollectionUsage) GetKeys() int64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func (m *CollectionUsage) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type EtcdUsageResponse struct {
+	// db_size_bytes is the on-disk size of the etcd database (the largest
+	// member's). It includes old revisions and free pages, so it only shrinks
+	// when revisions are compacted and the member is defragmented.
+	DbSizeBytes          int64              `protobuf:"varint,1,opt,name=db_size_bytes,json=dbSizeBytes,proto3" json:"db_size_bytes,omitempty"`
+	Collections          []*CollectionUsage `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EtcdUsageResponse) Reset()         { *m = EtcdUsageResponse{} }
+func (m *EtcdUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*EtcdUsageResponse) ProtoMessage()    {}
+func (*EtcdUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d15a320d0127c22, []int{7}
+}
+func (m *EtcdUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EtcdUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EtcdUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EtcdUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EtcdUsageResponse.Merge(m, src)
+}
+func (m *EtcdUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EtcdUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EtcdUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EtcdUsageResponse proto.InternalMessageInfo
+
+func (m *EtcdUsageResponse) GetDbSizeBytes() int64 {
+	if m != nil {
+		return m.DbSizeBytes
+	}
+	return 0
+}
+
+func (m *EtcdUsageResponse) GetCollections() []*CollectionUsage {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DumpRequest)(nil), "debug.DumpRequest")
 	proto.RegisterType((*ProfileRequest)(nil), "debug.ProfileRequest")
 	proto.RegisterType((*BinaryRequest)(nil), "debug.BinaryRequest")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "debug.SetLogLevelRequest")
+	proto.RegisterType((*SetLogLevelResponse)(nil), "debug.SetLogLevelResponse")
+	proto.RegisterType((*EtcdUsageRequest)(nil), "debug.EtcdUsageRequest")
+	proto.RegisterType((*CollectionUsage)(nil), "debug.CollectionUsage")
+	proto.RegisterType((*EtcdUsageResponse)(nil), "debug.EtcdUsageResponse")
 }
 
 func init() { proto.RegisterFile("client/debug/debug.proto", fileDescriptor_6d15a320d0127c22) }
 
 var fileDescriptor_6d15a320d0127c22 = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
-	0x18, 0xc6, 0x17, 0x71, 0x7f, 0xcc, 0x50, 0x21, 0x28, 0xd4, 0x09, 0x65, 0xf4, 0x34, 0x2f, 0x89,
-	0x4c, 0x3c, 0x79, 0x90, 0x8d, 0x7e, 0x00, 0xe9, 0xc1, 0x83, 0xb7, 0xb4, 0x7d, 0xd7, 0x15, 0xba,
-	0x26, 0xa6, 0x09, 0xd2, 0x6f, 0xe2, 0x47, 0xda, 0xd1, 0x8f, 0x20, 0xf5, 0x8b, 0xc8, 0x9a, 0x76,
-	0x74, 0xec, 0xb0, 0x4b, 0xe9, 0xf3, 0xbe, 0x4f, 0x9e, 0xf7, 0xf7, 0x26, 0xd8, 0x89, 0xb2, 0x14,
-	0x72, 0xcd, 0x62, 0x08, 0x4d, 0x62, 0xbf, 0x54, 0x2a, 0xa1, 0x05, 0xe9, 0xd7, 0x62, 0xe2, 0x26,
-	0x42, 0x24, 0x19, 0xb0, 0xba, 0x18, 0x9a, 0x15, 0xfb, 0x52, 0x5c, 0x4a, 0x50, 0x85, 0xb5, 0x1d,
-	0xf7, 0x63, 0xa3, 0xb8, 0x4e, 0x45, 0x6e, 0xfb, 0xde, 0x03, 0x1e, 0xfb, 0x66, 0x23, 0x03, 0xf8,
-	0x34, 0x50, 0x68, 0x32, 0xc1, 0x23, 0x05, 0x91, 0x51, 0x05, 0xc4, 0x0e, 0x9a, 0xa2, 0xd9, 0x28,
-	0xd8, 0x6b, 0x8f, 0xe3, 0xab, 0x37, 0x25, 0x56, 0x69, 0x06, 0xad, 0xdb, 0xc1, 0x43, 0x69, 0x2b,
-	0xb5, 0xf9, 0x22, 0x68, 0x25, 0x79, 0xc6, 0xa3, 0x76, 0x90, 0x73, 0x36, 0x45, 0xb3, 0xf1, 0xfc,
-	0x8e, 0x5a, 0x12, 0xda, 0x92, 0x50, 0xbf, 0x31, 0x04, 0x7b, 0xab, 0x77, 0x8d, 0x2f, 0x97, 0x69,
-	0xce, 0x55, 0xd9, 0x4c, 0x98, 0x6f, 0x11, 0xee, 0xfb, 0xbb, 0x45, 0xc9, 0x0b, 0x3e, 0xdf, 0x81,
-	0x12, 0x42, 0xed, 0x2d, 0x74, 0xa8, 0x27, 0xf7, 0x47, 0xd9, 0xcb, 0x52, 0x43, 0xf1, 0xce, 0x33,
-	0x03, 0x5e, 0xef, 0x11, 0x91, 0x05, 0x1e, 0x36, 0xe8, 0xe4, 0xb6, 0x39, 0x7f, 0xb8, 0xca, 0xe9,
-	0x88, 0x57, 0x3c, 0xb0, 0x68, 0xe4, 0xa6, 0x49, 0x38, 0x20, 0x3d, 0x19, 0xb0, 0x5c, 0x6c, 0x2b,
-	0x17, 0xfd, 0x54, 0x2e, 0xfa, 0xad, 0x5c, 0xf4, 0xfd, 0xe7, 0xf6, 0x3e, 0x58, 0x92, 0xea, 0xb5,
-	0x09, 0x69, 0x24, 0x36, 0x4c, 0xf2, 0x68, 0x5d, 0xc6, 0xa0, 0xba, 0x7f, 0x85, 0x8a, 0x58, 0xf7,
-	0xfd, 0xc3, 0x41, 0x9d, 0xfd, 0xf4, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x93, 0x2d, 0xd0, 0x8a, 0x16,
-	0x02, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x53,
+	0x4d, 0x6f, 0xd3, 0x40, 0x10, 0x8d, 0xeb, 0xa6, 0x4d, 0xc6, 0x2a, 0x2d,
+	0x43, 0x5b, 0x5c, 0x23, 0x45, 0xc8, 0x27, 0x4e, 0x36, 0x2a, 0x42, 0x42,
+	0xea, 0x01, 0x9a, 0x06, 0x4e, 0x39, 0x20, 0x57, 0xe5, 0x00, 0x87, 0xca,
+	0x1f, 0x53, 0xd7, 0xc2, 0xcd, 0xba, 0xbb, 0x36, 0x28, 0xfc, 0x12, 0x7e,
+	0x12, 0x47, 0xc4, 0x2f, 0x40, 0xf4, 0x8f, 0xd4, 0xde, 0x5d, 0x07, 0xa7,
+	0x41, 0xaa, 0xc4, 0xc1, 0xd6, 0xce, 0x9b, 0x79, 0xf3, 0x66, 0x3d, 0xcf,
+	0x60, 0xc7, 0x79, 0x46, 0xb3, 0xd2, 0x4f, 0x28, 0xaa, 0x52, 0xf5, 0xf6,
+	0x0a, 0xce, 0x4a, 0x86, 0x7d, 0x19, 0x38, 0xa3, 0x94, 0xb1, 0x34, 0x27,
+	0x5f, 0x82, 0x51, 0x75, 0xe1, 0x7f, 0xe5, 0x61, 0x51, 0x10, 0x17, 0xaa,
+	0x6c, 0x35, 0x9f, 0x54, 0x3c, 0x2c, 0x33, 0x36, 0x53, 0x79, 0xf7, 0x08,
+	0xac, 0x49, 0x75, 0x55, 0x04, 0x74, 0x5d, 0x91, 0x28, 0xd1, 0x81, 0x01,
+	0xa7, 0xb8, 0xe2, 0x82, 0x12, 0xdb, 0x78, 0x6a, 0x3c, 0x1b, 0x04, 0x8b,
+	0x18, 0x77, 0xc0, 0x3c, 0x0b, 0xa6, 0xf6, 0x5a, 0x0d, 0x0f, 0x03, 0xb3,
+	0x0a, 0xa6, 0x6e, 0x08, 0x0f, 0xde, 0x73, 0x76, 0x91, 0xe5, 0xd4, 0xf2,
+	0x6d, 0xd8, 0x2c, 0x14, 0x22, 0xe9, 0xc3, 0xa0, 0x0d, 0xf1, 0x25, 0x0c,
+	0x5a, 0x69, 0xd9, 0xc2, 0x3a, 0x3c, 0xf0, 0xd4, 0x6c, 0x5e, 0x3b, 0x9b,
+	0x37, 0xd1, 0x05, 0xc1, 0xa2, 0xd4, 0xdd, 0x86, 0xad, 0x71, 0x36, 0x0b,
+	0xf9, 0x5c, 0x2b, 0xd4, 0x9a, 0x78, 0x4a, 0xe5, 0x94, 0xa5, 0x53, 0xfa,
+	0x42, 0x79, 0xab, 0xbb, 0x0b, 0xfd, 0xbc, 0x89, 0xb5, 0xaa, 0x0a, 0xfe,
+	0x57, 0x73, 0x0f, 0x1e, 0x2d, 0x49, 0x88, 0x82, 0xcd, 0x04, 0xb9, 0x08,
+	0x3b, 0x6f, 0xcb, 0x38, 0x39, 0x13, 0x61, 0xda, 0xde, 0xd7, 0xfd, 0x04,
+	0xdb, 0x27, 0x2c, 0xcf, 0x29, 0x6e, 0x88, 0x32, 0x83, 0x23, 0x80, 0x78,
+	0x01, 0xe9, 0x79, 0x3a, 0x08, 0x22, 0xac, 0x7f, 0xa6, 0xb9, 0x90, 0x03,
+	0x99, 0x81, 0x3c, 0x37, 0xe3, 0x47, 0xf3, 0x92, 0x84, 0x6d, 0x4a, 0x50,
+	0x05, 0xee, 0x35, 0x3c, 0xec, 0x08, 0xaa, 0x29, 0xd0, 0x85, 0xad, 0x24,
+	0x3a, 0x17, 0xd9, 0x37, 0x3a, 0x57, 0x14, 0x43, 0x52, 0xac, 0x24, 0x3a,
+	0xad, 0xb1, 0x71, 0x03, 0xe1, 0x2b, 0xb0, 0xfe, 0x0a, 0x36, 0x4a, 0x66,
+	0x7d, 0xf5, 0x7d, 0x4f, 0xd9, 0xe7, 0xce, 0xbc, 0x41, 0xb7, 0xf4, 0xf0,
+	0xd7, 0x1a, 0xf4, 0x27, 0x4d, 0x19, 0x1e, 0xc1, 0x7a, 0x63, 0x0c, 0x44,
+	0x4d, 0xeb, 0xb8, 0xc4, 0x79, 0xb2, 0xf2, 0x15, 0xa5, 0xee, 0x87, 0x30,
+	0xaf, 0xc8, 0xed, 0x3d, 0x37, 0xf0, 0x18, 0x36, 0xb5, 0x31, 0x70, 0x4f,
+	0xf3, 0x97, 0x8d, 0x72, 0x7f, 0x8b, 0xd7, 0xb0, 0xa1, 0x16, 0x8f, 0xbb,
+	0xba, 0xc3, 0x92, 0x0f, 0xee, 0x6f, 0xf0, 0x0e, 0xac, 0xce, 0x16, 0xf1,
+	0x40, 0x77, 0x59, 0x35, 0x8f, 0xe3, 0xfc, 0x2b, 0xa5, 0x97, 0xde, 0xc3,
+	0x37, 0x30, 0x5c, 0x6c, 0x01, 0x1f, 0xeb, 0xd2, 0xbb, 0x46, 0x70, 0xec,
+	0xd5, 0x44, 0xdb, 0x61, 0x7c, 0xfc, 0xe3, 0xcf, 0xc8, 0xf8, 0x59, 0x3f,
+	0xbf, 0xeb, 0xe7, 0xfb, 0xcd, 0xa8, 0xf7, 0xd1, 0x4f, 0xb3, 0xf2, 0xb2,
+	0x8a, 0xbc, 0x98, 0x5d, 0xf9, 0x45, 0x18, 0x5f, 0xce, 0x13, 0xe2, 0xdd,
+	0x93, 0xe0, 0xb1, 0xdf, 0xfd, 0xf3, 0xa3, 0x0d, 0x79, 0xcb, 0x17, 0xb7,
+	0x60, 0xff, 0x64, 0x6e, 0x10, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -215,6 +511,12 @@ type DebugClient interface {
 	Dump(ctx context.Context, in *DumpRequest, opts ...grpc.CallOption) (Debug_DumpClient, error)
 	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (Debug_ProfileClient, error)
 	Binary(ctx context.Context, in *BinaryRequest, opts ...grpc.CallOption) (Debug_BinaryClient, error)
+	// SetLogLevel changes the server's log level, optionally for a limited
+	// duration, so verbosity can be raised without a restart.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// EtcdUsage reports the size of the etcd database and a breakdown of the
+	// keyspace by collection.
+	EtcdUsage(ctx context.Context, in *EtcdUsageRequest, opts ...grpc.CallOption) (*EtcdUsageResponse, error)
 }
 
 type debugClient struct {
@@ -321,11 +623,35 @@ func (x *debugBinaryClient) Recv() (*types.BytesValue, error) {
 	return m, nil
 }
 
+func (c *debugClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/debug.Debug/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) EtcdUsage(ctx context.Context, in *EtcdUsageRequest, opts ...grpc.CallOption) (*EtcdUsageResponse, error) {
+	out := new(EtcdUsageResponse)
+	err := c.cc.Invoke(ctx, "/debug.Debug/EtcdUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	Dump(*DumpRequest, Debug_DumpServer) error
 	Profile(*ProfileRequest, Debug_ProfileServer) error
 	Binary(*BinaryRequest, Debug_BinaryServer) error
+	// SetLogLevel changes the server's log level, optionally for a limited
+	// duration, so verbosity can be raised without a restart.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// EtcdUsage reports the size of the etcd database and a breakdown of the
+	// keyspace by collection.
+	EtcdUsage(context.Context, *EtcdUsageRequest) (*EtcdUsageResponse, error)
 }
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
@@ -395,10 +721,55 @@ func (x *debugBinaryServer) Send(m *types.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Debug_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/debug.Debug/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_EtcdUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).EtcdUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/debug.Debug/EtcdUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).EtcdUsage(ctx, req.(*EtcdUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "debug.Debug",
 	HandlerType: (*DebugServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Debug_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "EtcdUsage",
+			Handler:    _Debug_EtcdUsage_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Dump",
@@ -444,6 +815,12 @@ func (m *DumpRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -508,65 +885,310 @@ func (m *BinaryRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *DumpRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Recursed {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ProfileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Profile)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	if len(m.Level) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Level)))
+		i += copy(dAtA[i:], m.Level)
 	}
 	if m.Duration != nil {
-		l = m.Duration.Size()
-		n += 1 + l + sovDebug(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(m.Duration.Size()))
+		n2, err2 := m.Duration.MarshalTo(dAtA[i:])
+		if err2 != nil {
+			return 0, err2
+		}
+		i += n2
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BinaryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetLogLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func sovDebug(x uint64) (n int) {
-	for {
-		n++
+func (m *EtcdUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EtcdUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CollectionUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collection) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Collection)))
+		i += copy(dAtA[i:], m.Collection)
+	}
+	if m.Keys != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(m.Keys))
+	}
+	if m.Bytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(m.Bytes))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *EtcdUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EtcdUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DbSizeBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDebug(dAtA, i, uint64(m.DbSizeBytes))
+	}
+	if len(m.Collections) > 0 {
+		for _, msg := range m.Collections {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDebug(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DumpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Recursed {
+		n += 2
+	}
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BinaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetLogLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EtcdUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CollectionUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovDebug(uint64(m.Keys))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovDebug(uint64(m.Bytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EtcdUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DbSizeBytes != 0 {
+		n += 1 + sovDebug(uint64(m.DbSizeBytes))
+	}
+	if len(m.Collections) > 0 {
+		for _, e := range m.Collections {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	for {
+		n++
 		x >>= 7
 		if x == 0 {
 			break
@@ -626,6 +1248,38 @@ func (m *DumpRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Recursed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -827,6 +1481,472 @@ func (m *BinaryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &types.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EtcdUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EtcdUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EtcdUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CollectionUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectionUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EtcdUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EtcdUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EtcdUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbSizeBytes", wireType)
+			}
+			m.DbSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collections = append(m.Collections, &CollectionUsage{})
+			if err := m.Collections[len(m.Collections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipDebug(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,16 @@
+package enterprise
+
+import "time"
+
+// GracePeriod is how long enterprise features keep working after the
+// cluster's enterprise token expires. During this window the cluster's state
+// is GRACE_PERIOD and pachd logs warnings, so that an expired license
+// surfaces as warnings rather than sudden RPC failures.
+const GracePeriod = 30 * 24 * time.Hour
+
+// Entitled returns true if a cluster in state 's' is entitled to use
+// enterprise features (i.e. its token is active or within the post-expiry
+// grace period).
+func (s State) Entitled() bool {
+	return s == State_ACTIVE || s == State_GRACE_PERIOD
+}
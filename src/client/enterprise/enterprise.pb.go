@@ -27,21 +27,24 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type State int32
 
 const (
-	State_NONE    State = 0
-	State_ACTIVE  State = 1
-	State_EXPIRED State = 2
+	State_NONE         State = 0
+	State_ACTIVE       State = 1
+	State_EXPIRED      State = 2
+	State_GRACE_PERIOD State = 3
 )
 
 var State_name = map[int32]string{
 	0: "NONE",
 	1: "ACTIVE",
 	2: "EXPIRED",
+	3: "GRACE_PERIOD",
 }
 
 var State_value = map[string]int32{
-	"NONE":    0,
-	"ACTIVE":  1,
-	"EXPIRED": 2,
+	"NONE":         0,
+	"ACTIVE":       1,
+	"EXPIRED":      2,
+	"GRACE_PERIOD": 3,
 }
 
 func (x State) String() string {
@@ -448,6 +451,110 @@ func (m *DeactivateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeactivateResponse proto.InternalMessageInfo
 
+type HeartbeatRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeartbeatRequest) Reset()         { *m = HeartbeatRequest{} }
+func (m *HeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatRequest) ProtoMessage()    {}
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88d07275108cec01, []int{8}
+}
+func (m *HeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatRequest.Merge(m, src)
+}
+func (m *HeartbeatRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatRequest proto.InternalMessageInfo
+
+type HeartbeatResponse struct {
+	State State      `protobuf:"varint,1,opt,name=state,proto3,enum=enterprise.State" json:"state,omitempty"`
+	Info  *TokenInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// grace_remaining is how much of the grace period is left (only set if
+	// 'state' is GRACE_PERIOD).
+	GraceRemaining       *types.Duration `protobuf:"bytes,3,opt,name=grace_remaining,json=graceRemaining,proto3" json:"grace_remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *HeartbeatResponse) Reset()         { *m = HeartbeatResponse{} }
+func (m *HeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*HeartbeatResponse) ProtoMessage()    {}
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88d07275108cec01, []int{9}
+}
+func (m *HeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatResponse.Merge(m, src)
+}
+func (m *HeartbeatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatResponse proto.InternalMessageInfo
+
+func (m *HeartbeatResponse) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State_NONE
+}
+
+func (m *HeartbeatResponse) GetInfo() *TokenInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *HeartbeatResponse) GetGraceRemaining() *types.Duration {
+	if m != nil {
+		return m.GraceRemaining
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("enterprise.State", State_name, State_value)
 	proto.RegisterType((*EnterpriseRecord)(nil), "enterprise.EnterpriseRecord")
@@ -458,40 +565,59 @@ func init() {
 	proto.RegisterType((*GetStateResponse)(nil), "enterprise.GetStateResponse")
 	proto.RegisterType((*DeactivateRequest)(nil), "enterprise.DeactivateRequest")
 	proto.RegisterType((*DeactivateResponse)(nil), "enterprise.DeactivateResponse")
+	proto.RegisterType((*HeartbeatRequest)(nil), "enterprise.HeartbeatRequest")
+	proto.RegisterType((*HeartbeatResponse)(nil), "enterprise.HeartbeatResponse")
 }
 
-func init() { proto.RegisterFile("client/enterprise/enterprise.proto", fileDescriptor_88d07275108cec01) }
+func init() {
+	proto.RegisterFile("client/enterprise/enterprise.proto", fileDescriptor_88d07275108cec01)
+}
 
 var fileDescriptor_88d07275108cec01 = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x3d, 0xe9, 0xff, 0xad, 0xd4, 0x38, 0x03, 0x48, 0x95, 0x01, 0x53, 0xcd, 0xa6, 0xa5,
-	0x0b, 0x5b, 0x0a, 0xdd, 0xb2, 0x30, 0xad, 0xa9, 0xbc, 0xa0, 0x54, 0x26, 0x42, 0x88, 0x0d, 0x72,
-	0xec, 0x9b, 0x64, 0x44, 0xe2, 0x71, 0x3c, 0x13, 0x04, 0x4f, 0xc1, 0x96, 0x47, 0x62, 0xc9, 0x23,
-	0xa0, 0x20, 0xf1, 0x1c, 0x28, 0x76, 0x1c, 0x4f, 0x7e, 0x10, 0x6c, 0xba, 0x1b, 0xdd, 0x7b, 0xf4,
-	0x9d, 0x33, 0x67, 0x6c, 0x60, 0xf1, 0x90, 0x63, 0xaa, 0x5c, 0x4c, 0x15, 0xe6, 0x59, 0xce, 0x25,
-	0x6a, 0x47, 0x27, 0xcb, 0x85, 0x12, 0x14, 0xea, 0x89, 0xf5, 0xa4, 0x2f, 0x44, 0x7f, 0x88, 0x6e,
-	0xb1, 0xe9, 0x4e, 0x7a, 0xae, 0xe2, 0x23, 0x94, 0x2a, 0x1a, 0x65, 0xa5, 0x98, 0x8d, 0xc1, 0xf4,
-	0x17, 0xf2, 0x10, 0x63, 0x91, 0x27, 0xf4, 0x14, 0x9a, 0x51, 0xac, 0xf8, 0xa7, 0x48, 0x71, 0x91,
-	0x7e, 0x88, 0x45, 0x82, 0xc7, 0xe4, 0x84, 0x9c, 0x1d, 0x84, 0x47, 0xf5, 0xf8, 0x52, 0x24, 0x48,
-	0x2f, 0x60, 0x0f, 0x3f, 0x67, 0x3c, 0x47, 0x79, 0xdc, 0x38, 0x21, 0x67, 0x87, 0x6d, 0xcb, 0x29,
-	0xfd, 0x9c, 0xca, 0xcf, 0xe9, 0x54, 0x7e, 0x61, 0x25, 0x65, 0x1e, 0x1c, 0x74, 0xc4, 0x47, 0x4c,
-	0x83, 0xb4, 0x27, 0x74, 0x04, 0xf9, 0x7f, 0x44, 0x06, 0x4d, 0xaf, 0x8c, 0x82, 0x21, 0x8e, 0x27,
-	0x28, 0xd5, 0x5d, 0x87, 0x7e, 0x0e, 0x66, 0xed, 0x28, 0x33, 0x91, 0x4a, 0xa4, 0x4f, 0x61, 0x9b,
-	0xa7, 0x3d, 0x31, 0x0f, 0xfe, 0xc0, 0xd1, 0x5e, 0x62, 0x71, 0xc1, 0xb0, 0x90, 0xb0, 0x16, 0x34,
-	0xaf, 0x51, 0xbd, 0x51, 0x75, 0x60, 0xf6, 0x95, 0x80, 0x59, 0xcf, 0xe6, 0xc8, 0x53, 0xd8, 0x91,
-	0xb3, 0x41, 0xc1, 0x3c, 0x6a, 0xb7, 0x74, 0x66, 0xa9, 0x2c, 0xf7, 0x0b, 0xef, 0xc6, 0x3f, 0xbd,
-	0x37, 0x35, 0xb3, 0xb5, 0xa9, 0x19, 0x76, 0x0f, 0x5a, 0x57, 0x18, 0x2d, 0xf7, 0xca, 0xee, 0x03,
-	0xd5, 0x87, 0x65, 0xce, 0xf3, 0x73, 0xd8, 0x29, 0xe2, 0xd0, 0x7d, 0xd8, 0xbe, 0x79, 0x7d, 0xe3,
-	0x9b, 0x06, 0x05, 0xd8, 0xf5, 0x2e, 0x3b, 0xc1, 0x5b, 0xdf, 0x24, 0xf4, 0x10, 0xf6, 0xfc, 0x77,
-	0xb7, 0x41, 0xe8, 0x5f, 0x99, 0x8d, 0xf6, 0x6f, 0x02, 0x5b, 0xde, 0x6d, 0x40, 0xaf, 0x61, 0xbf,
-	0xaa, 0x90, 0x3e, 0xd4, 0x03, 0xaf, 0x3c, 0xa5, 0xf5, 0x68, 0xf3, 0xb2, 0xb4, 0x66, 0xc6, 0x0c,
-	0x54, 0x15, 0xb7, 0x0c, 0x5a, 0xa9, 0x78, 0x19, 0xb4, 0xda, 0x35, 0x33, 0xe8, 0x2b, 0x80, 0xfa,
-	0x6e, 0xf4, 0xb1, 0xae, 0x5e, 0x2b, 0xc2, 0xb2, 0xff, 0xb6, 0xae, 0x70, 0x2f, 0x5e, 0x7e, 0x9f,
-	0xda, 0xe4, 0xc7, 0xd4, 0x26, 0x3f, 0xa7, 0x36, 0xf9, 0xf6, 0xcb, 0x36, 0xde, 0x5f, 0xf4, 0xb9,
-	0x1a, 0x4c, 0xba, 0x4e, 0x2c, 0x46, 0x6e, 0x16, 0xc5, 0x83, 0x2f, 0x09, 0xe6, 0xfa, 0x49, 0xe6,
-	0xb1, 0xbb, 0xf6, 0x47, 0x77, 0x77, 0x8b, 0x0f, 0xf1, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x79, 0x85, 0x28, 0x31, 0xed, 0x03, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x54,
+	0xcb, 0x6e, 0xd3, 0x40, 0x14, 0x8d, 0x93, 0xbe, 0x72, 0x83, 0x12, 0x67,
+	0x00, 0xa9, 0x18, 0x1a, 0x90, 0x37, 0x2d, 0x2c, 0x6c, 0x29, 0xed, 0x92,
+	0x2e, 0xdc, 0xc4, 0x04, 0x23, 0xd1, 0x46, 0x26, 0x42, 0x88, 0x4d, 0x34,
+	0x71, 0xa6, 0xee, 0xa8, 0x8d, 0xc7, 0x1d, 0x4f, 0x50, 0xfb, 0x15, 0xdd,
+	0xf2, 0x0f, 0xec, 0xf8, 0x0a, 0x96, 0x7c, 0x02, 0x82, 0x1f, 0xc1, 0xb5,
+	0x63, 0x7b, 0xf2, 0xa8, 0x60, 0xd3, 0xc5, 0x48, 0xa3, 0x7b, 0xcf, 0x9c,
+	0x73, 0xee, 0x43, 0x03, 0xba, 0x77, 0x41, 0x49, 0x20, 0xcc, 0xf8, 0x10,
+	0x1e, 0x72, 0x1a, 0x11, 0xe9, 0x6a, 0x84, 0x9c, 0x09, 0x86, 0xa0, 0x88,
+	0x68, 0xcf, 0x7d, 0xc6, 0xfc, 0x0b, 0x62, 0x26, 0x99, 0xd1, 0xf4, 0xd4,
+	0x14, 0x74, 0x42, 0x22, 0x81, 0x27, 0x61, 0x0a, 0xd6, 0x2f, 0x41, 0xb5,
+	0x73, 0xb8, 0x4b, 0x3c, 0xc6, 0xc7, 0x68, 0x17, 0x1a, 0xd8, 0x13, 0xf4,
+	0x0b, 0x16, 0x94, 0x05, 0x43, 0x8f, 0x8d, 0xc9, 0xb6, 0xf2, 0x42, 0xd9,
+	0xab, 0xba, 0xf5, 0x22, 0xdc, 0x89, 0xa3, 0xe8, 0x00, 0x36, 0xc9, 0x55,
+	0x48, 0x39, 0x89, 0xb6, 0xcb, 0x31, 0xa0, 0xd6, 0xd6, 0x8c, 0x54, 0xcf,
+	0xc8, 0xf4, 0x8c, 0x41, 0xa6, 0xe7, 0x66, 0x50, 0xdd, 0x82, 0xea, 0x80,
+	0x9d, 0x93, 0xc0, 0x09, 0x4e, 0x99, 0x4c, 0xa1, 0xfc, 0x3f, 0x45, 0x08,
+	0x0d, 0x2b, 0xb5, 0x12, 0x7b, 0xbe, 0x9c, 0xc6, 0xe9, 0xfb, 0x36, 0x7d,
+	0x08, 0x6a, 0xa1, 0x18, 0x85, 0x2c, 0x88, 0x08, 0x7a, 0x09, 0x6b, 0x34,
+	0xae, 0x61, 0x66, 0xfc, 0xb1, 0x21, 0x4d, 0x22, 0x2f, 0xd0, 0x4d, 0x20,
+	0x7a, 0x13, 0x1a, 0x3d, 0x22, 0x3e, 0x88, 0xc2, 0xb0, 0x7e, 0xa3, 0x80,
+	0x5a, 0xc4, 0x66, 0x94, 0xbb, 0xb0, 0x1e, 0xdd, 0x06, 0x12, 0xce, 0x7a,
+	0xbb, 0x29, 0x73, 0xa6, 0xc8, 0x34, 0x9f, 0x6b, 0x97, 0xff, 0xa9, 0xbd,
+	0xaa, 0x33, 0x95, 0x55, 0x9d, 0xd1, 0x1f, 0x42, 0xb3, 0x4b, 0xf0, 0x7c,
+	0x5f, 0xf5, 0x47, 0x80, 0xe4, 0x60, 0xea, 0x53, 0x47, 0xa0, 0xbe, 0x25,
+	0x98, 0x8b, 0x11, 0xc1, 0x22, 0x43, 0x7e, 0x53, 0xa0, 0x29, 0x05, 0xef,
+	0xb1, 0xa2, 0x23, 0x68, 0xf8, 0x1c, 0x7b, 0x64, 0xc8, 0xc9, 0x04, 0xd3,
+	0x80, 0x06, 0x7e, 0x52, 0x51, 0xad, 0xfd, 0x64, 0x69, 0x94, 0xdd, 0x29,
+	0x4f, 0x0a, 0x74, 0xeb, 0xc9, 0x0b, 0x37, 0x7b, 0xf0, 0xea, 0x35, 0xac,
+	0x27, 0xf2, 0x68, 0x0b, 0xd6, 0x8e, 0x4f, 0x8e, 0x6d, 0xb5, 0x84, 0x00,
+	0x36, 0xac, 0xce, 0xc0, 0xf9, 0x68, 0xab, 0x0a, 0xaa, 0xc1, 0xa6, 0xfd,
+	0xa9, 0xef, 0xb8, 0x76, 0x57, 0x2d, 0x23, 0x15, 0x1e, 0xf4, 0x5c, 0xab,
+	0x63, 0x0f, 0xfb, 0xb6, 0xeb, 0x9c, 0x74, 0xd5, 0x4a, 0xfb, 0x7b, 0x19,
+	0x2a, 0x56, 0xdf, 0x41, 0x3d, 0xd8, 0xca, 0xd6, 0x02, 0x3d, 0x95, 0x2d,
+	0x2f, 0xac, 0xa7, 0xf6, 0x6c, 0x75, 0x72, 0xd6, 0xce, 0xd2, 0x2d, 0x51,
+	0xb6, 0x0c, 0xf3, 0x44, 0x0b, 0x6b, 0x33, 0x4f, 0xb4, 0xb8, 0x3f, 0x31,
+	0xd1, 0x7b, 0x80, 0x62, 0x5e, 0x68, 0x47, 0x46, 0x2f, 0x0d, 0x57, 0x6b,
+	0xdd, 0x95, 0xce, 0xe9, 0xde, 0x41, 0x35, 0x9f, 0x29, 0x9a, 0xd3, 0x5e,
+	0x9c, 0xbf, 0xb6, 0x73, 0x47, 0x36, 0xe3, 0x3a, 0x7a, 0xf3, 0xe3, 0x77,
+	0x4b, 0xf9, 0x19, 0x9f, 0x5f, 0xf1, 0xf9, 0xfa, 0xa7, 0x55, 0xfa, 0x7c,
+	0xe0, 0x53, 0x71, 0x36, 0x1d, 0x19, 0x1e, 0x9b, 0x98, 0x21, 0xf6, 0xce,
+	0xae, 0xc7, 0x84, 0xcb, 0xb7, 0x88, 0x7b, 0xe6, 0xd2, 0x8f, 0x37, 0xda,
+	0x48, 0xa6, 0xbb, 0xff, 0x17, 0x85, 0xf1, 0xfb, 0x6a, 0x0d, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -510,6 +636,11 @@ type APIClient interface {
 	// features, such as the Pachyderm Dashboard and Auth system
 	Activate(ctx context.Context, in *ActivateRequest, opts ...grpc.CallOption) (*ActivateResponse, error)
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+	// Heartbeat is a lightweight version of GetState for monitoring: it returns
+	// the license state and expiration but not the activation code, and is
+	// intended to be polled (e.g. by 'pachctl license heartbeat' in a cron job)
+	// so that license expiry is noticed before enterprise features shut off.
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	// Deactivate is a testing API. It removes a cluster's enterprise activation
 	// token and sets its enterprise state to NONE (normally, once a cluster has
 	// been activated, the only reachable state is EXPIRED).
@@ -546,6 +677,15 @@ func (c *aPIClient) GetState(ctx context.Context, in *GetStateRequest, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/enterprise.API/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*DeactivateResponse, error) {
 	out := new(DeactivateResponse)
 	err := c.cc.Invoke(ctx, "/enterprise.API/Deactivate", in, out, opts...)
@@ -561,6 +701,11 @@ type APIServer interface {
 	// features, such as the Pachyderm Dashboard and Auth system
 	Activate(context.Context, *ActivateRequest) (*ActivateResponse, error)
 	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+	// Heartbeat is a lightweight version of GetState for monitoring: it returns
+	// the license state and expiration but not the activation code, and is
+	// intended to be polled (e.g. by 'pachctl license heartbeat' in a cron job)
+	// so that license expiry is noticed before enterprise features shut off.
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	// Deactivate is a testing API. It removes a cluster's enterprise activation
 	// token and sets its enterprise state to NONE (normally, once a cluster has
 	// been activated, the only reachable state is EXPIRED).
@@ -629,6 +774,24 @@ func _API_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enterprise.API/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "enterprise.API",
 	HandlerType: (*APIServer)(nil),
@@ -645,6 +808,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deactivate",
 			Handler:    _API_Deactivate_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _API_Heartbeat_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/enterprise/enterprise.proto",
@@ -891,6 +1058,73 @@ func (m *DeactivateResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.State))
+	}
+	if m.Info != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.Info.Size()))
+		n6, err6 := m.Info.MarshalTo(dAtA[i:])
+		if err6 != nil {
+			return 0, err6
+		}
+		i += n6
+	}
+	if m.GraceRemaining != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.GraceRemaining.Size()))
+		n7, err7 := m.GraceRemaining.MarshalTo(dAtA[i:])
+		if err7 != nil {
+			return 0, err7
+		}
+		i += n7
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintEnterprise(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1031,6 +1265,41 @@ func (m *DeactivateResponse) Size() (n int) {
 	return n
 }
 
+func (m *HeartbeatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovEnterprise(uint64(m.State))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.GraceRemaining != nil {
+		l = m.GraceRemaining.Size()
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovEnterprise(x uint64) (n int) {
 	for {
 		n++
@@ -1771,6 +2040,207 @@ func (m *DeactivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &TokenInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GraceRemaining == nil {
+				m.GraceRemaining = &types.Duration{}
+			}
+			if err := m.GraceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipEnterprise(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
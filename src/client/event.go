@@ -0,0 +1,32 @@
+package client
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/event"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+)
+
+// SubscribeEventsF calls f with each cluster activity event matching the
+// filters as it occurs. Pass nil slices to receive all events. It never
+// returns unless it encounters an error (or f returns an error).
+func (c APIClient) SubscribeEventsF(types []event.EventType, repos []string, pipelines []string, f func(*event.Event) error) error {
+	client, err := c.EventAPIClient.SubscribeEvents(
+		c.Ctx(),
+		&event.SubscribeEventsRequest{
+			Types:     types,
+			Repos:     repos,
+			Pipelines: pipelines,
+		},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		ev, err := client.Recv()
+		if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(ev); err != nil {
+			return err
+		}
+	}
+}
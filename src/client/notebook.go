@@ -0,0 +1,94 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// Helpers aimed at interactive exploration (e.g. Jupyter notebooks via
+// gophernotes): pinning branches to commit IDs so cells stay reproducible,
+// ranged readers for libraries that scan only parts of large files, and
+// plain-text tables for display.
+
+// PinCommit resolves a branch name (or a relative reference like "master^2")
+// to the ID of the commit it currently points to. Notebook cells that pin
+// once and pass the returned ID around keep reading the same data even as new
+// commits land on the branch.
+func (c APIClient) PinCommit(repoName string, branchOrCommit string) (string, error) {
+	commitInfo, err := c.InspectCommit(repoName, branchOrCommit)
+	if err != nil {
+		return "", err
+	}
+	return commitInfo.Commit.ID, nil
+}
+
+// GetFileReaderAt returns an io.ReaderAt over a file's contents along with
+// the file's size, so that libraries that do ranged reads (e.g. partial
+// parquet scans, zip listings) fetch only the byte ranges they need. Every
+// ReadAt call translates into one ranged GetFile RPC; nothing is buffered
+// between calls.
+func (c APIClient) GetFileReaderAt(repoName string, commitID string, path string) (io.ReaderAt, int64, error) {
+	fileInfo, err := c.InspectFile(repoName, commitID, path)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &fileReaderAt{
+		c:      c,
+		repo:   repoName,
+		commit: commitID,
+		path:   path,
+		size:   int64(fileInfo.SizeBytes),
+	}, int64(fileInfo.SizeBytes), nil
+}
+
+type fileReaderAt struct {
+	c      APIClient
+	repo   string
+	commit string
+	path   string
+	size   int64
+}
+
+func (r *fileReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	var buf bytes.Buffer
+	if err := r.c.GetFile(r.repo, r.commit, r.path, off, int64(len(p)), &buf); err != nil {
+		return 0, err
+	}
+	n := copy(p, buf.Bytes())
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// ListFileInfosAsTable renders the files under 'path' as a fixed-width text
+// table, handy for printing in a notebook without pulling in any
+// pretty-printing dependencies.
+func (c APIClient) ListFileInfosAsTable(repoName string, commitID string, path string) (string, error) {
+	fileInfos, err := c.ListFile(repoName, commitID, path)
+	if err != nil {
+		return "", err
+	}
+	var table strings.Builder
+	writer := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tTYPE\tSIZE")
+	for _, fileInfo := range fileInfos {
+		fileType := "file"
+		if fileInfo.FileType == pfs.FileType_DIR {
+			fileType = "dir"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\n", fileInfo.File.Path, fileType, fileInfo.SizeBytes)
+	}
+	if err := writer.Flush(); err != nil {
+		return "", err
+	}
+	return table.String(), nil
+}
@@ -0,0 +1,129 @@
+// Package orchestration provides high-level synchronous helpers for embedding
+// Pachyderm steps inside external orchestrators such as Airflow and Argo. All
+// helpers block until the work they describe has finished (or the context is
+// cancelled) and report intermediate progress through callbacks, so a single
+// orchestrator task can map onto a single helper call.
+package orchestration
+
+import (
+	"fmt"
+	"io"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+// JobProgress is called with a job's info each time the job is observed while
+// waiting; orchestrators typically forward this to their own logging.
+type JobProgress func(jobInfo *pps.JobInfo)
+
+// CommitProgress is called with each commit that finishes while waiting for a
+// set of downstream commits.
+type CommitProgress func(commitInfo *pfs.CommitInfo)
+
+// File is one file to upload in PutFilesAndWaitForDownstream.
+type File struct {
+	Path   string
+	Reader io.Reader
+}
+
+// ErrJobFailed is returned when a job reached a terminal state other than
+// success while one of the helpers was waiting on it.
+type ErrJobFailed struct {
+	JobID  string
+	State  pps.JobState
+	Reason string
+}
+
+func (e ErrJobFailed) Error() string {
+	return fmt.Sprintf("job %s finished in state %s: %s", e.JobID, e.State, e.Reason)
+}
+
+// RunPipelineAndWait re-runs 'pipeline' on the current head of its inputs and
+// blocks until the resulting job finishes. It returns the finished job's info,
+// or an ErrJobFailed if the job did not succeed. Cancelling ctx cancels the
+// wait (but not the job).
+func RunPipelineAndWait(ctx context.Context, c *client.APIClient, pipeline string, progress JobProgress) (*pps.JobInfo, error) {
+	c = c.WithCtx(ctx)
+	if err := c.RerunPipeline(pipeline, nil, nil); err != nil {
+		return nil, err
+	}
+	// The re-run job is the newest job for the pipeline.
+	var job *pps.Job
+	if err := c.ListJobF(pipeline, nil, nil, func(jobInfo *pps.JobInfo) error {
+		job = jobInfo.Job
+		return errutil.ErrBreak
+	}); err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, fmt.Errorf("no job was started for pipeline %s", pipeline)
+	}
+	return WaitJob(ctx, c, job.ID, progress)
+}
+
+// WaitJob blocks until the job finishes, reporting progress along the way. It
+// returns the finished job's info, or an ErrJobFailed if the job did not
+// succeed.
+func WaitJob(ctx context.Context, c *client.APIClient, jobID string, progress JobProgress) (*pps.JobInfo, error) {
+	c = c.WithCtx(ctx)
+	jobInfo, err := c.InspectJob(jobID, true)
+	if err != nil {
+		return nil, err
+	}
+	if progress != nil {
+		progress(jobInfo)
+	}
+	if jobInfo.State != pps.JobState_JOB_SUCCESS {
+		return jobInfo, ErrJobFailed{
+			JobID:  jobID,
+			State:  jobInfo.State,
+			Reason: jobInfo.Reason,
+		}
+	}
+	return jobInfo, nil
+}
+
+// PutFilesAndWaitForDownstream puts 'files' into repo@branch in a single
+// commit, then blocks until every downstream commit provenant on it has
+// finished. If 'toRepos' is non-nil only commits in those repos are waited
+// on. It returns the triggering commit.
+func PutFilesAndWaitForDownstream(ctx context.Context, c *client.APIClient, repo string, branch string, files []File, toRepos []string, progress CommitProgress) (*pfs.Commit, error) {
+	c = c.WithCtx(ctx)
+	commit, err := c.StartCommit(repo, branch)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if _, err := c.PutFile(repo, commit.ID, file.Path, file.Reader); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.FinishCommit(repo, commit.ID); err != nil {
+		return nil, err
+	}
+	if err := WaitCommitSet(ctx, c, commit, toRepos, progress); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// WaitCommitSet blocks until every commit downstream of 'commit' (restricted
+// to 'toRepos' if non-nil) has finished, calling 'progress' as each one does.
+func WaitCommitSet(ctx context.Context, c *client.APIClient, commit *pfs.Commit, toRepos []string, progress CommitProgress) error {
+	c = c.WithCtx(ctx)
+	var repos []*pfs.Repo
+	for _, repo := range toRepos {
+		repos = append(repos, client.NewRepo(repo))
+	}
+	return c.FlushCommitF([]*pfs.Commit{commit}, repos, func(commitInfo *pfs.CommitInfo) error {
+		if progress != nil {
+			progress(commitInfo)
+		}
+		return nil
+	})
+}
\ No newline at end of file
@@ -13,6 +13,39 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 )
 
+// Classification labels for repos (see CreateRepoRequest.classification).
+// Repos labeled with a restricted classification (confidential or PII) can't
+// be read through the S3 gateway, and pipelines can't egress them to
+// destinations outside the cluster's allowlist.
+const (
+	ClassificationPublic       = "public"
+	ClassificationInternal     = "internal"
+	ClassificationConfidential = "confidential"
+	ClassificationPII          = "pii"
+
+	// EgressAllowlistEnvVar is the pachd environment variable holding a
+	// comma-separated list of URL prefixes (e.g. "s3://corp-bucket/") that
+	// repos with a restricted classification may be egressed to. An empty
+	// list means restricted repos can't be egressed at all.
+	EgressAllowlistEnvVar = "CLASSIFICATION_EGRESS_ALLOWLIST"
+)
+
+// ValidClassification returns true if 'c' is one of the known classification
+// labels (or empty, meaning unclassified).
+func ValidClassification(c string) bool {
+	switch c {
+	case "", ClassificationPublic, ClassificationInternal, ClassificationConfidential, ClassificationPII:
+		return true
+	}
+	return false
+}
+
+// RestrictedClassification returns true if repos labeled 'c' are subject to
+// egress controls.
+func RestrictedClassification(c string) bool {
+	return c == ClassificationConfidential || c == ClassificationPII
+}
+
 // NewRepo creates a pfs.Repo.
 func NewRepo(repoName string) *pfs.Repo {
 	return &pfs.Repo{Name: repoName}
@@ -82,6 +115,7 @@ func (c APIClient) CreateRepo(repoName string) error {
 			Repo: NewRepo(repoName),
 		},
 	)
+	c.cacheInvalidate(cacheKeyListRepo)
 	return grpcutil.ScrubGRPC(err)
 }
 
@@ -104,6 +138,9 @@ func (c APIClient) InspectRepo(repoName string) (*pfs.RepoInfo, error) {
 // the specified repos as provenance will be returned unless provenance is nil
 // in which case it is ignored.
 func (c APIClient) ListRepo() ([]*pfs.RepoInfo, error) {
+	if resp, ok := c.cacheGet(cacheKeyListRepo); ok {
+		return resp.(*pfs.ListRepoResponse).RepoInfo, nil
+	}
 	request := &pfs.ListRepoRequest{}
 	repoInfos, err := c.PfsAPIClient.ListRepo(
 		c.Ctx(),
@@ -112,6 +149,7 @@ func (c APIClient) ListRepo() ([]*pfs.RepoInfo, error) {
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
+	c.cachePut(cacheKeyListRepo, repoInfos)
 	return repoInfos.RepoInfo, nil
 }
 
@@ -130,6 +168,7 @@ func (c APIClient) DeleteRepo(repoName string, force bool) error {
 			Force: force,
 		},
 	)
+	c.cacheInvalidate(cacheKeyListRepo)
 	return grpcutil.ScrubGRPC(err)
 }
 
@@ -224,6 +263,19 @@ func (c APIClient) FinishCommit(repoName string, commitID string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ResumeFinishCommit re-runs the finish of a commit whose finish was
+// interrupted (e.g. by a pachd crash mid-merge) or failed, so the commit
+// doesn't stay stuck in a finishing state.
+func (c APIClient) ResumeFinishCommit(repoName string, commitID string) error {
+	_, err := c.PfsAPIClient.ResumeFinish(
+		c.Ctx(),
+		&pfs.ResumeFinishRequest{
+			Commit: NewCommit(repoName, commitID),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // InspectCommit returns info about a specific Commit.
 func (c APIClient) InspectCommit(repoName string, commitID string) (*pfs.CommitInfo, error) {
 	return c.inspectCommit(repoName, commitID, pfs.CommitState_STARTED)
@@ -236,6 +288,11 @@ func (c APIClient) BlockCommit(repoName string, commitID string) (*pfs.CommitInf
 }
 
 func (c APIClient) inspectCommit(repoName string, commitID string, blockState pfs.CommitState) (*pfs.CommitInfo, error) {
+	// Only finished commits are cached (see cachePutImmutable below), so a
+	// cached entry satisfies any block state
+	if resp, ok := c.cacheGet(commitCacheKey(repoName, commitID)); ok {
+		return resp.(*pfs.CommitInfo), nil
+	}
 	commitInfo, err := c.PfsAPIClient.InspectCommit(
 		c.Ctx(),
 		&pfs.InspectCommitRequest{
@@ -246,6 +303,12 @@ func (c APIClient) inspectCommit(repoName string, commitID string, blockState pf
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
+	// A finished commit addressed by its own ID (rather than a branch name or
+	// ancestry reference, whose meaning changes over time) is immutable, so its
+	// ID serves as a permanent cache validator
+	if commitInfo.Finished != nil && commitInfo.Commit.ID == commitID {
+		c.cachePutImmutable(commitCacheKey(repoName, commitID), commitInfo)
+	}
 	return commitInfo, nil
 }
 
@@ -343,16 +406,44 @@ func (c APIClient) InspectBranch(repoName string, branch string) (*pfs.BranchInf
 
 // ListBranch lists the active branches on a Repo.
 func (c APIClient) ListBranch(repoName string) ([]*pfs.BranchInfo, error) {
-	branchInfos, err := c.PfsAPIClient.ListBranch(
+	var result []*pfs.BranchInfo
+	if err := c.ListBranchF(repoName, func(bi *pfs.BranchInfo) error {
+		result = append(result, bi)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListBranchF lists the active branches on a Repo, calling f with each
+// branch. It uses a streaming RPC, so the result isn't subject to the max
+// gRPC message size even in repos with very many branches.
+func (c APIClient) ListBranchF(repoName string, f func(*pfs.BranchInfo) error) error {
+	stream, err := c.PfsAPIClient.ListBranchStream(
 		c.Ctx(),
 		&pfs.ListBranchRequest{
 			Repo: NewRepo(repoName),
 		},
 	)
 	if err != nil {
-		return nil, grpcutil.ScrubGRPC(err)
+		return grpcutil.ScrubGRPC(err)
 	}
-	return branchInfos.BranchInfo, nil
+	for {
+		bi, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(bi); err != nil {
+			if err == errutil.ErrBreak {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
 }
 
 // SetBranch sets a commit and its ancestors as a branch.
@@ -375,6 +466,49 @@ func (c APIClient) DeleteBranch(repoName string, branch string, force bool) erro
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateCommitTag creates an immutable named reference ("tag") to a commit.
+// Unlike a branch head, a tag can never be re-pointed at a different commit,
+// so it gives a dataset version a stable name that's independent of branch
+// movement. 'commit' may itself be a branch or tag reference; it's resolved
+// when the tag is created. The tag can be used anywhere a commit ID is
+// accepted.
+func (c APIClient) CreateCommitTag(repoName string, commit string, name string) error {
+	_, err := c.PfsAPIClient.CreateCommitTag(
+		c.Ctx(),
+		&pfs.CreateCommitTagRequest{
+			Commit: NewCommit(repoName, commit),
+			Name:   name,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListCommitTag returns the tags in a repo.
+func (c APIClient) ListCommitTag(repoName string) ([]*pfs.CommitTagInfo, error) {
+	tagInfos, err := c.PfsAPIClient.ListCommitTag(
+		c.Ctx(),
+		&pfs.ListCommitTagRequest{
+			Repo: NewRepo(repoName),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return tagInfos.TagInfo, nil
+}
+
+// DeleteCommitTag deletes a tag; the commit it names is unaffected.
+func (c APIClient) DeleteCommitTag(repoName string, name string) error {
+	_, err := c.PfsAPIClient.DeleteCommitTag(
+		c.Ctx(),
+		&pfs.DeleteCommitTagRequest{
+			Repo: NewRepo(repoName),
+			Name: name,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // DeleteCommit deletes a commit.
 func (c APIClient) DeleteCommit(repoName string, commitID string) error {
 	_, err := c.PfsAPIClient.DeleteCommit(
@@ -471,6 +605,57 @@ func (c APIClient) FlushCommitAll(commits []*pfs.Commit, toRepos []*pfs.Repo) ([
 	return result, nil
 }
 
+// InspectCommitSet returns the set of commits produced across repos by the
+// commit with ID 'id': the triggering commit itself plus every commit that
+// has it in its provenance.
+func (c APIClient) InspectCommitSet(id string) (*pfs.CommitSetInfo, error) {
+	commitSetInfo, err := c.PfsAPIClient.InspectCommitSet(
+		c.Ctx(),
+		&pfs.InspectCommitSetRequest{ID: id},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commitSetInfo, nil
+}
+
+// ListCommitSetF calls f with info about each commit set, newest first.
+func (c APIClient) ListCommitSetF(f func(*pfs.CommitSetInfo) error) error {
+	stream, err := c.PfsAPIClient.ListCommitSet(c.Ctx(), &pfs.ListCommitSetRequest{})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		csi, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(csi); err != nil {
+			return err
+		}
+	}
+}
+
+// DeleteCommitSet deletes the commit with ID 'id' and all of its downstream
+// derived commits atomically, returning the set that was deleted. With
+// 'dryRun' nothing is deleted and the returned set is what would be.
+func (c APIClient) DeleteCommitSet(id string, dryRun bool) (*pfs.CommitSetInfo, error) {
+	commitSetInfo, err := c.PfsAPIClient.DeleteCommitSet(
+		c.Ctx(),
+		&pfs.DeleteCommitSetRequest{
+			ID:     id,
+			DryRun: dryRun,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commitSetInfo, nil
+}
+
 // CommitInfoIterator wraps a stream of commits and makes them easy to iterate.
 type CommitInfoIterator interface {
 	Next() (*pfs.CommitInfo, error)
@@ -544,6 +729,34 @@ func (c APIClient) SubscribeCommitF(repo, branch, from string, state pfs.CommitS
 	}
 }
 
+// SubscribeFilesF streams the FileInfo of every file matching the glob
+// 'pattern' that changed in each newly finished commit on 'branch', calling
+// 'f' with each one. It lets consumers react to changes to specific datasets
+// without diffing every commit themselves.
+func (c APIClient) SubscribeFilesF(repo, branch, pattern, from string, f func(*pfs.FileInfo) error) error {
+	req := &pfs.SubscribeFilesRequest{
+		Repo:    NewRepo(repo),
+		Branch:  branch,
+		Pattern: pattern,
+	}
+	if from != "" {
+		req.From = NewCommit(repo, from)
+	}
+	stream, err := c.PfsAPIClient.SubscribeFiles(c.Ctx(), req)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		fi, err := stream.Recv()
+		if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(fi); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+}
+
 // PutObjectAsync puts a value into the object store asynchronously.
 func (c APIClient) PutObjectAsync(tags []*pfs.Tag) (*PutObjectWriteCloserAsync, error) {
 	w, err := c.newPutObjectWriteCloserAsync(tags)
@@ -819,6 +1032,14 @@ type PutFileClient interface {
 	// delimiter is used to tell PFS how to break the input into blocks.
 	PutFileSplit(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwrite bool, reader io.Reader) (_ int, retErr error)
 
+	// PutFileWithMetadata is like PutFile but also records the file's POSIX
+	// mode bits, so downloads can restore them.
+	PutFileWithMetadata(repoName string, commitID string, path string, mode uint32, reader io.Reader) (_ int, retErr error)
+
+	// PutFileSymlink creates the file at path as a symbolic link pointing at
+	// symlinkTarget. A symlink has no content of its own.
+	PutFileSymlink(repoName string, commitID string, path string, symlinkTarget string) error
+
 	// PutFileURL puts a file using the content found at a URL.
 	// The URL is sent to the server which performs the request.
 	// recursive allows for recursive scraping of some types URLs. For example on s3:// urls.
@@ -837,7 +1058,7 @@ type putFileClient struct {
 
 // NewPutFileClient returns a new client for putting files into pfs in a single request.
 func (c APIClient) NewPutFileClient() (PutFileClient, error) {
-	pfc, err := c.PfsAPIClient.PutFile(c.Ctx())
+	pfc, err := c.streamClient().PutFile(c.Ctx())
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
@@ -845,7 +1066,7 @@ func (c APIClient) NewPutFileClient() (PutFileClient, error) {
 }
 
 func (c APIClient) newOneoffPutFileClient() (PutFileClient, error) {
-	pfc, err := c.PfsAPIClient.PutFile(c.Ctx())
+	pfc, err := c.streamClient().PutFile(c.Ctx())
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
@@ -856,7 +1077,7 @@ func (c APIClient) newOneoffPutFileClient() (PutFileClient, error) {
 // NOTE: PutFileWriter returns an io.WriteCloser you must call Close on it when
 // you are done writing.
 func (c *putFileClient) PutFileWriter(repoName, commitID, path string) (io.WriteCloser, error) {
-	return c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, nil)
+	return c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, nil, 0, "")
 }
 
 // PutFileSplitWriter writes a multiple files to PFS by splitting up the data
@@ -870,7 +1091,7 @@ func (c *putFileClient) PutFileSplitWriter(repoName string, commitID string, pat
 	if overwrite {
 		overwriteIndex = &pfs.OverwriteIndex{}
 	}
-	return c.newPutFileWriteCloser(repoName, commitID, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwriteIndex)
+	return c.newPutFileWriteCloser(repoName, commitID, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwriteIndex, 0, "")
 }
 
 // PutFile writes a file to PFS from a reader.
@@ -878,12 +1099,40 @@ func (c *putFileClient) PutFile(repoName string, commitID string, path string, r
 	return c.PutFileSplit(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, false, reader)
 }
 
+// PutFileWithMetadata is like PutFile but also records the file's POSIX mode
+// bits, so downloads can restore them.
+func (c *putFileClient) PutFileWithMetadata(repoName string, commitID string, path string, mode uint32, reader io.Reader) (_ int, retErr error) {
+	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, nil, mode, "")
+	if err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	written, err := io.Copy(writer, reader)
+	return int(written), grpcutil.ScrubGRPC(err)
+}
+
+// PutFileSymlink creates the file at path as a symbolic link pointing at
+// symlinkTarget. A symlink has no content of its own.
+func (c *putFileClient) PutFileSymlink(repoName string, commitID string, path string, symlinkTarget string) (retErr error) {
+	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, nil, 0, symlinkTarget)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	// No content is written; Close sends the one request carrying the
+	// symlink target.
+	return grpcutil.ScrubGRPC(writer.Close())
+}
+
 // PutFileOverwrite is like PutFile but it overwrites the file rather than
 // appending to it.  overwriteIndex allows you to specify the index of the
 // object starting from which you'd like to overwrite.  If you want to
 // overwrite the entire file, specify an index of 0.
 func (c *putFileClient) PutFileOverwrite(repoName string, commitID string, path string, reader io.Reader, overwriteIndex int64) (_ int, retErr error) {
-	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, &pfs.OverwriteIndex{Index: overwriteIndex})
+	writer, err := c.newPutFileWriteCloser(repoName, commitID, path, pfs.Delimiter_NONE, 0, 0, 0, &pfs.OverwriteIndex{Index: overwriteIndex}, 0, "")
 	if err != nil {
 		return 0, grpcutil.ScrubGRPC(err)
 	}
@@ -1005,6 +1254,26 @@ func (c APIClient) PutFileSplit(repoName string, commitID string, path string, d
 	return pfc.PutFileSplit(repoName, commitID, path, delimiter, targetFileDatums, targetFileBytes, headerRecords, overwrite, reader)
 }
 
+// PutFileWithMetadata is like PutFile but also records the file's POSIX mode
+// bits so that they survive a round trip through PFS.
+func (c APIClient) PutFileWithMetadata(repoName string, commitID string, path string, mode uint32, reader io.Reader) (_ int, retErr error) {
+	pfc, err := c.newOneoffPutFileClient()
+	if err != nil {
+		return 0, err
+	}
+	return pfc.PutFileWithMetadata(repoName, commitID, path, mode, reader)
+}
+
+// PutFileSymlink creates the file at path as a symbolic link pointing at
+// symlinkTarget. A symlink has no content of its own.
+func (c APIClient) PutFileSymlink(repoName string, commitID string, path string, symlinkTarget string) (retErr error) {
+	pfc, err := c.newOneoffPutFileClient()
+	if err != nil {
+		return err
+	}
+	return pfc.PutFileSymlink(repoName, commitID, path, symlinkTarget)
+}
+
 // PutFileURL puts a file using the content found at a URL.
 // The URL is sent to the server which performs the request.
 // recursive allow for recursive scraping of some types URLs for example on s3:// urls.
@@ -1085,7 +1354,7 @@ func (c APIClient) GetFileReadSeeker(repoName string, commitID string, path stri
 
 func (c APIClient) getFile(repoName string, commitID string, path string, offset int64,
 	size int64) (pfs.API_GetFileClient, error) {
-	return c.PfsAPIClient.GetFile(
+	return c.streamClient().GetFile(
 		c.Ctx(),
 		&pfs.GetFileRequest{
 			File:        NewFile(repoName, commitID, path),
@@ -1222,6 +1491,54 @@ func (c APIClient) GlobFileF(repoName string, commitID string, pattern string, f
 	}
 }
 
+// GlobPreview summarizes what a glob pattern matches in a commit: the match
+// count, a sample of at most 'sampleSize' matches (0 means the server
+// default), and size statistics for estimating datum sizes.
+func (c APIClient) GlobPreview(repoName string, commitID string, pattern string, sampleSize int64) (*pfs.GlobPreviewResponse, error) {
+	response, err := c.PfsAPIClient.GlobPreview(
+		c.Ctx(),
+		&pfs.GlobPreviewRequest{
+			Commit:     NewCommit(repoName, commitID),
+			Pattern:    pattern,
+			SampleSize: sampleSize,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
+// BatchInspectFileF inspects many paths in a single call, calling f with one
+// response per path (in request order). A path that doesn't exist doesn't
+// fail the batch; its response has Error set and FileInfo unset.
+func (c APIClient) BatchInspectFileF(repoName string, commitID string, paths []string, f func(resp *pfs.BatchInspectFileResponse) error) error {
+	fs, err := c.PfsAPIClient.BatchInspectFile(
+		c.Ctx(),
+		&pfs.BatchInspectFileRequest{
+			Commit: NewCommit(repoName, commitID),
+			Paths:  paths,
+		},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := fs.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(resp); err != nil {
+			if err == errutil.ErrBreak {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // DiffFile returns the difference between 2 paths, old path may be omitted in
 // which case the parent of the new path will be used. DiffFile return 2 values
 // (unless it returns an error) the first value is files present under new
@@ -1247,6 +1564,29 @@ func (c APIClient) DiffFile(newRepoName, newCommitID, newPath, oldRepoName,
 	return resp.NewFiles, resp.OldFiles, nil
 }
 
+// DiffTabularFile returns a row- and column-level summary of how a tabular
+// (CSV, TSV or Parquet) file changed between two commits. Like DiffFile, the
+// old file may be omitted (by passing an empty oldRepoName), in which case
+// the same path in the parent of the new file's commit is used.
+func (c APIClient) DiffTabularFile(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string) (*pfs.TabularDiff, error) {
+	var oldFile *pfs.File
+	if oldRepoName != "" {
+		oldFile = NewFile(oldRepoName, oldCommitID, oldPath)
+	}
+	diff, err := c.PfsAPIClient.DiffTabularFile(
+		c.Ctx(),
+		&pfs.DiffTabularFileRequest{
+			NewFile: NewFile(newRepoName, newCommitID, newPath),
+			OldFile: oldFile,
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return diff, nil
+}
+
 // WalkFn is the type of the function called for each file in Walk.
 // Returning a non-nil error from WalkFn will result in Walk aborting and
 // returning said error.
@@ -1278,6 +1618,14 @@ func (c APIClient) Walk(repoName string, commitID string, path string, f WalkFn)
 	}
 }
 
+// WalkF walks the pfs filesystem rooted at path, calling f for each file
+// found (in lexicographical order, regular files and directories alike). It's
+// the same as Walk, under the name that matches the client's other
+// streaming-callback helpers (ListFileF, GlobFileF etc).
+func (c APIClient) WalkF(repoName string, commitID string, path string, f WalkFn) error {
+	return c.Walk(repoName, commitID, path, f)
+}
+
 // DeleteFile deletes a file from a Commit.
 // DeleteFile leaves a tombstone in the Commit, assuming the file isn't written
 // to later attempting to get the file from the finished commit will result in
@@ -1299,7 +1647,7 @@ type putFileWriteCloser struct {
 	c       *putFileClient
 }
 
-func (c *putFileClient) newPutFileWriteCloser(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwriteIndex *pfs.OverwriteIndex) (*putFileWriteCloser, error) {
+func (c *putFileClient) newPutFileWriteCloser(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64, headerRecords int64, overwriteIndex *pfs.OverwriteIndex, mode uint32, symlinkTarget string) (*putFileWriteCloser, error) {
 	c.mu.Lock() // Unlocked in Close()
 	return &putFileWriteCloser{
 		request: &pfs.PutFileRequest{
@@ -1309,6 +1657,8 @@ func (c *putFileClient) newPutFileWriteCloser(repoName string, commitID string,
 			TargetFileBytes:  targetFileBytes,
 			HeaderRecords:    headerRecords,
 			OverwriteIndex:   overwriteIndex,
+			Mode:             mode,
+			SymlinkTarget:    symlinkTarget,
 		},
 		c: c,
 	}, nil
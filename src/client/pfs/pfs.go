@@ -6,6 +6,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+
+	"github.com/zeebo/blake3"
 )
 
 var (
@@ -18,9 +20,64 @@ func (c *Commit) FullID() string {
 	return fmt.Sprintf("%s/%s", c.Repo.Name, c.ID)
 }
 
-// NewHash returns a hash that PFS uses internally to compute checksums.
+const (
+	// SHA512 and BLAKE3 name the supported content-hash algorithms.
+	SHA512 = "sha512"
+	BLAKE3 = "blake3"
+)
+
+// hashAlgorithms maps algorithm names to constructors; it's what
+// SetHashAlgorithms selects from.
+var hashAlgorithms = map[string]func() hash.Hash{
+	SHA512: sha512.New,
+	BLAKE3: func() hash.Hash { return blake3.New() },
+}
+
+// newHash and newLegacyHash hold the process-wide algorithm selection made by
+// SetHashAlgorithms. Processes that never call it (e.g. pachctl) hash with
+// sha512 and have no legacy algorithm.
+var (
+	newHash       func() hash.Hash = sha512.New
+	newLegacyHash func() hash.Hash
+)
+
+// SetHashAlgorithms selects the content-hash algorithms that NewHash and
+// NewLegacyHash return, by name ("sha512" or "blake3"). An empty algorithm
+// means sha512; an empty legacyAlgorithm means no legacy hashing. It should
+// be called once at startup, before any hashing happens (serviceenv calls it
+// from the STORAGE_HASH_ALGORITHM/STORAGE_LEGACY_HASH_ALGORITHM config).
+func SetHashAlgorithms(algorithm string, legacyAlgorithm string) error {
+	if algorithm == "" {
+		algorithm = SHA512
+	}
+	constructor, ok := hashAlgorithms[algorithm]
+	if !ok {
+		return fmt.Errorf("unknown hash algorithm: %q", algorithm)
+	}
+	var legacyConstructor func() hash.Hash
+	if legacyAlgorithm != "" {
+		if legacyConstructor, ok = hashAlgorithms[legacyAlgorithm]; !ok {
+			return fmt.Errorf("unknown legacy hash algorithm: %q", legacyAlgorithm)
+		}
+	}
+	newHash = constructor
+	newLegacyHash = legacyConstructor
+	return nil
+}
+
+// NewHash returns a hash that PFS uses internally to compute checksums, using
+// the algorithm selected by SetHashAlgorithms (sha512 by default).
 func NewHash() hash.Hash {
-	return sha512.New()
+	return newHash()
+}
+
+// NewLegacyHash returns a hash using the legacy algorithm selected by
+// SetHashAlgorithms, or nil if no legacy algorithm is configured.
+func NewLegacyHash() hash.Hash {
+	if newLegacyHash == nil {
+		return nil
+	}
+	return newLegacyHash()
 }
 
 // EncodeHash encodes a hash into a readable format.
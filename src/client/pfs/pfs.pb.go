@@ -561,10 +561,34 @@ type RepoInfo struct {
 	// Set by ListRepo and InspectRepo if Pachyderm's auth system is active, but
 	// not stored in etcd. To set a user's auth scope for a repo, use the
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
-	AuthInfo             *RepoAuthInfo `protobuf:"bytes,6,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Quota *RepoQuota `protobuf:"bytes,8,opt,name=quota,proto3" json:"quota,omitempty"`
+	// extract_schemas enables columnar schema extraction on the repo: when a
+	// commit finishes, CSV/TSV/Parquet files get their schema (column names and
+	// types, row count) recorded in their FileInfo.
+	ExtractSchemas bool `protobuf:"varint,9,opt,name=extract_schemas,json=extractSchemas,proto3" json:"extract_schemas,omitempty"`
+	// retention, if set, puts the repo in WORM (write-once-read-many) mode for
+	// compliance: finished commits can't be deleted or squashed, nor the repo
+	// itself deleted, until they're older than the retention period. This is
+	// enforced server-side for every caller, including cluster admins. The
+	// retention period can be raised on an existing repo ('update repo') but
+	// never lowered or removed.
+	Retention *types.Duration `protobuf:"bytes,10,opt,name=retention,proto3" json:"retention,omitempty"`
+	// classification labels the sensitivity of the repo's data ("public",
+	// "internal", "confidential" or "pii"). Repos with a restricted
+	// classification ("confidential" or "pii") can't be read through the S3
+	// gateway, and pipelines can't egress them to destinations outside the
+	// cluster's allowlist (the CLASSIFICATION_EGRESS_ALLOWLIST pachd
+	// environment variable); violations are recorded in the audit log.
+	Classification string `protobuf:"bytes,11,opt,name=classification,proto3" json:"classification,omitempty"`
+	// get_file_filters configures server-side sanitization of the repo's data:
+	// GetFile responses for matching paths are rewritten by the named filter
+	// (e.g. masking columns in CSVs) unless the caller's access level grants
+	// them the raw content. See GetFileFilter.
+	GetFileFilters       []*GetFileFilter `protobuf:"bytes,12,rep,name=get_file_filters,json=getFileFilters,proto3" json:"get_file_filters,omitempty"`
+	AuthInfo             *RepoAuthInfo    `protobuf:"bytes,6,opt,name=auth_info,json=authInfo,proto3" json:"auth_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *RepoInfo) Reset()         { *m = RepoInfo{} }
@@ -635,6 +659,41 @@ func (m *RepoInfo) GetBranches() []*Branch {
 	return nil
 }
 
+func (m *RepoInfo) GetQuota() *RepoQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetExtractSchemas() bool {
+	if m != nil {
+		return m.ExtractSchemas
+	}
+	return false
+}
+
+func (m *RepoInfo) GetRetention() *types.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetClassification() string {
+	if m != nil {
+		return m.Classification
+	}
+	return ""
+}
+
+func (m *RepoInfo) GetGetFileFilters() []*GetFileFilter {
+	if m != nil {
+		return m.GetFileFilters
+	}
+	return nil
+}
+
 func (m *RepoInfo) GetAuthInfo() *RepoAuthInfo {
 	if m != nil {
 		return m.AuthInfo
@@ -891,12 +950,25 @@ type CommitInfo struct {
 	// If this is nil, then the commit is either open (in which case 'finished'
 	// will also be nil) or is the output commit of a failed job (in which case
 	// 'finished' will have a value -- the end time of the job)
-	Tree                 *Object   `protobuf:"bytes,7,opt,name=tree,proto3" json:"tree,omitempty"`
-	Trees                []*Object `protobuf:"bytes,13,rep,name=trees,proto3" json:"trees,omitempty"`
-	Datums               *Object   `protobuf:"bytes,14,opt,name=datums,proto3" json:"datums,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Tree   *Object   `protobuf:"bytes,7,opt,name=tree,proto3" json:"tree,omitempty"`
+	Trees  []*Object `protobuf:"bytes,13,rep,name=trees,proto3" json:"trees,omitempty"`
+	Datums *Object   `protobuf:"bytes,14,opt,name=datums,proto3" json:"datums,omitempty"`
+	// phase is where this commit is in its lifecycle; commits written before
+	// phases existed have PHASE_OPEN with a non-nil 'finished', and should be
+	// treated as finished.
+	Phase CommitPhase `protobuf:"varint,17,opt,name=phase,proto3,enum=pfs.CommitPhase" json:"phase,omitempty"`
+	// phase_reason is the error that interrupted the finish, if phase is
+	// PHASE_FAILED.
+	PhaseReason string `protobuf:"bytes,18,opt,name=phase_reason,json=phaseReason,proto3" json:"phase_reason,omitempty"`
+	// index_status is where this commit is in the content-indexing pipeline,
+	// if an indexer is configured.
+	IndexStatus IndexStatus `protobuf:"varint,19,opt,name=index_status,json=indexStatus,proto3,enum=pfs.IndexStatus" json:"index_status,omitempty"`
+	// index_reason is the error that failed the indexing, if index_status is
+	// INDEX_FAILED.
+	IndexReason          string   `protobuf:"bytes,20,opt,name=index_reason,json=indexReason,proto3" json:"index_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
@@ -1030,6 +1102,34 @@ func (m *CommitInfo) GetDatums() *Object {
 	return nil
 }
 
+func (m *CommitInfo) GetPhase() CommitPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return CommitPhase_PHASE_OPEN
+}
+
+func (m *CommitInfo) GetPhaseReason() string {
+	if m != nil {
+		return m.PhaseReason
+	}
+	return ""
+}
+
+func (m *CommitInfo) GetIndexStatus() IndexStatus {
+	if m != nil {
+		return m.IndexStatus
+	}
+	return IndexStatus_INDEX_NONE
+}
+
+func (m *CommitInfo) GetIndexReason() string {
+	if m != nil {
+		return m.IndexReason
+	}
+	return ""
+}
+
 type FileInfo struct {
 	File      *File            `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
 	FileType  FileType         `protobuf:"varint,2,opt,name=file_type,json=fileType,proto3,enum=pfs.FileType" json:"file_type,omitempty"`
@@ -1037,10 +1137,20 @@ type FileInfo struct {
 	Committed *types.Timestamp `protobuf:"bytes,10,opt,name=committed,proto3" json:"committed,omitempty"`
 	// the base names (i.e. just the filenames, not the full paths) of
 	// the children
-	Children             []string    `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
-	Objects              []*Object   `protobuf:"bytes,8,rep,name=objects,proto3" json:"objects,omitempty"`
-	BlockRefs            []*BlockRef `protobuf:"bytes,9,rep,name=blockRefs,proto3" json:"blockRefs,omitempty"`
-	Hash                 []byte      `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	Children  []string    `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	Objects   []*Object   `protobuf:"bytes,8,rep,name=objects,proto3" json:"objects,omitempty"`
+	BlockRefs []*BlockRef `protobuf:"bytes,9,rep,name=blockRefs,proto3" json:"blockRefs,omitempty"`
+	Hash      []byte      `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	// mode contains the POSIX mode bits (permissions) the file was uploaded
+	// with, or 0 if none were recorded.
+	Mode uint32 `protobuf:"varint,11,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target, if set, marks the file as a symbolic link pointing at the
+	// given target. A symlink's content is empty.
+	SymlinkTarget string `protobuf:"bytes,12,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// schema is the columnar schema extracted from the file when its commit
+	// finished, if the repo has schema extraction enabled (see
+	// RepoInfo.ExtractSchemas).
+	Schema               *FileSchema `protobuf:"bytes,13,opt,name=schema,proto3" json:"schema,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1135,6 +1245,27 @@ func (m *FileInfo) GetHash() []byte {
 	return nil
 }
 
+func (m *FileInfo) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileInfo) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *FileInfo) GetSchema() *FileSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 type ByteRange struct {
 	Lower                uint64   `protobuf:"varint,1,opt,name=lower,proto3" json:"lower,omitempty"`
 	Upper                uint64   `protobuf:"varint,2,opt,name=upper,proto3" json:"upper,omitempty"`
@@ -1301,12 +1432,27 @@ func (m *ObjectInfo) GetBlockRef() *BlockRef {
 }
 
 type CreateRepoRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Update               bool     `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Repo           *Repo      `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Description    string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Update         bool       `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
+	Quota          *RepoQuota `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	ExtractSchemas bool       `protobuf:"varint,6,opt,name=extract_schemas,json=extractSchemas,proto3" json:"extract_schemas,omitempty"`
+	// retention puts the repo in WORM mode for compliance (see
+	// RepoInfo.retention). On an update, leaving retention unset keeps the
+	// repo's current retention period; setting it to less than the current
+	// period is an error.
+	Retention *types.Duration `protobuf:"bytes,7,opt,name=retention,proto3" json:"retention,omitempty"`
+	// classification labels the sensitivity of the repo's data (see
+	// RepoInfo.classification). On an update, leaving it unset keeps the
+	// repo's current classification.
+	Classification string `protobuf:"bytes,8,opt,name=classification,proto3" json:"classification,omitempty"`
+	// get_file_filters configures server-side sanitization of GetFile responses
+	// (see RepoInfo.get_file_filters). On an update, leaving it unset keeps the
+	// repo's current filters.
+	GetFileFilters       []*GetFileFilter `protobuf:"bytes,9,rep,name=get_file_filters,json=getFileFilters,proto3" json:"get_file_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *CreateRepoRequest) Reset()         { *m = CreateRepoRequest{} }
@@ -1363,6 +1509,41 @@ func (m *CreateRepoRequest) GetUpdate() bool {
 	return false
 }
 
+func (m *CreateRepoRequest) GetQuota() *RepoQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *CreateRepoRequest) GetExtractSchemas() bool {
+	if m != nil {
+		return m.ExtractSchemas
+	}
+	return false
+}
+
+func (m *CreateRepoRequest) GetRetention() *types.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *CreateRepoRequest) GetClassification() string {
+	if m != nil {
+		return m.Classification
+	}
+	return ""
+}
+
+func (m *CreateRepoRequest) GetGetFileFilters() []*GetFileFilter {
+	if m != nil {
+		return m.GetFileFilters
+	}
+	return nil
+}
+
 type InspectRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2533,10 +2714,16 @@ type PutFileRequest struct {
 	HeaderRecords int64 `protobuf:"varint,11,opt,name=header_records,json=headerRecords,proto3" json:"header_records,omitempty"`
 	// overwrite_index is the object index where the write starts from.  All
 	// existing objects starting from the index are deleted.
-	OverwriteIndex       *OverwriteIndex `protobuf:"bytes,10,opt,name=overwrite_index,json=overwriteIndex,proto3" json:"overwrite_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	OverwriteIndex *OverwriteIndex `protobuf:"bytes,10,opt,name=overwrite_index,json=overwriteIndex,proto3" json:"overwrite_index,omitempty"`
+	// mode contains the file's POSIX mode bits (permissions); if it's 0, no
+	// mode is recorded.
+	Mode uint32 `protobuf:"varint,12,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target, if set, creates the file as a symbolic link pointing at
+	// the given target rather than a regular file; no value should be sent.
+	SymlinkTarget        string   `protobuf:"bytes,13,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PutFileRequest) Reset()         { *m = PutFileRequest{} }
@@ -2635,6 +2822,20 @@ func (m *PutFileRequest) GetOverwriteIndex() *OverwriteIndex {
 	return nil
 }
 
+func (m *PutFileRequest) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *PutFileRequest) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 // PutFileRecord is used to record PutFile requests in etcd temporarily.
 type PutFileRecord struct {
 	SizeBytes            int64           `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
@@ -2700,14 +2901,18 @@ func (m *PutFileRecord) GetOverwriteIndex() *OverwriteIndex {
 }
 
 type PutFileRecords struct {
-	Split                bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
-	Records              []*PutFileRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
-	Tombstone            bool             `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
-	Header               *PutFileRecord   `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
-	Footer               *PutFileRecord   `protobuf:"bytes,5,opt,name=footer,proto3" json:"footer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Split     bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
+	Records   []*PutFileRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	Tombstone bool             `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	Header    *PutFileRecord   `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	Footer    *PutFileRecord   `protobuf:"bytes,5,opt,name=footer,proto3" json:"footer,omitempty"`
+	// mode and symlink_target carry the file's POSIX metadata (see
+	// PutFileRequest); they're never set for split writes.
+	Mode                 uint32   `protobuf:"varint,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	SymlinkTarget        string   `protobuf:"bytes,7,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PutFileRecords) Reset()         { *m = PutFileRecords{} }
@@ -2778,6 +2983,20 @@ func (m *PutFileRecords) GetFooter() *PutFileRecord {
 	return nil
 }
 
+func (m *PutFileRecords) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *PutFileRecords) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
 type CopyFileRequest struct {
 	Src                  *File    `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
 	Dst                  *File    `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
@@ -4071,1877 +4290,2259 @@ func (m *ObjectIndex) GetTags() map[string]*Object {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
-	proto.RegisterEnum("pfs.CommitState", CommitState_name, CommitState_value)
-	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
-	proto.RegisterType((*Repo)(nil), "pfs.Repo")
-	proto.RegisterType((*Branch)(nil), "pfs.Branch")
-	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
-	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
-	proto.RegisterType((*File)(nil), "pfs.File")
-	proto.RegisterType((*Block)(nil), "pfs.Block")
-	proto.RegisterType((*Object)(nil), "pfs.Object")
-	proto.RegisterType((*Tag)(nil), "pfs.Tag")
-	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
-	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
-	proto.RegisterType((*Commit)(nil), "pfs.Commit")
-	proto.RegisterType((*CommitRange)(nil), "pfs.CommitRange")
-	proto.RegisterType((*CommitProvenance)(nil), "pfs.CommitProvenance")
-	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
-	proto.RegisterType((*FileInfo)(nil), "pfs.FileInfo")
-	proto.RegisterType((*ByteRange)(nil), "pfs.ByteRange")
-	proto.RegisterType((*BlockRef)(nil), "pfs.BlockRef")
-	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
-	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
-	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
-	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
-	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
-	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
-	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
-	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
-	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
-	proto.RegisterType((*InspectCommitRequest)(nil), "pfs.InspectCommitRequest")
-	proto.RegisterType((*ListCommitRequest)(nil), "pfs.ListCommitRequest")
-	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
-	proto.RegisterType((*CreateBranchRequest)(nil), "pfs.CreateBranchRequest")
-	proto.RegisterType((*InspectBranchRequest)(nil), "pfs.InspectBranchRequest")
-	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
-	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
-	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
-	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
-	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
-	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
-	proto.RegisterType((*OverwriteIndex)(nil), "pfs.OverwriteIndex")
-	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
-	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
-	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
-	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
-	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
-	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
-	proto.RegisterType((*WalkFileRequest)(nil), "pfs.WalkFileRequest")
-	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
-	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
-	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
-	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
-	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
-	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
-	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
-	proto.RegisterType((*GetBlocksRequest)(nil), "pfs.GetBlocksRequest")
-	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
-	proto.RegisterType((*ListObjectsRequest)(nil), "pfs.ListObjectsRequest")
-	proto.RegisterType((*ListTagsRequest)(nil), "pfs.ListTagsRequest")
-	proto.RegisterType((*ListTagsResponse)(nil), "pfs.ListTagsResponse")
-	proto.RegisterType((*DeleteObjectsRequest)(nil), "pfs.DeleteObjectsRequest")
-	proto.RegisterType((*DeleteObjectsResponse)(nil), "pfs.DeleteObjectsResponse")
-	proto.RegisterType((*DeleteTagsRequest)(nil), "pfs.DeleteTagsRequest")
-	proto.RegisterType((*DeleteTagsResponse)(nil), "pfs.DeleteTagsResponse")
-	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
-	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
-	proto.RegisterType((*Objects)(nil), "pfs.Objects")
-	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
-	proto.RegisterMapType((map[string]*BlockRef)(nil), "pfs.ObjectIndex.ObjectsEntry")
-	proto.RegisterMapType((map[string]*Object)(nil), "pfs.ObjectIndex.TagsEntry")
+// CommitPhase is the lifecycle state recorded on a CommitInfo. Unlike
+// CommitState (which callers use to block until a commit reaches a point in
+// its lifecycle), CommitPhase records where the commit currently is in that
+// lifecycle--in particular whether a finish is in progress (PHASE_FINISHING)
+// or was interrupted or failed partway (PHASE_FAILED), so such commits can be
+// found and resumed with ResumeFinish rather than looking permanently open.
+type CommitPhase int32
+
+const (
+	CommitPhase_PHASE_OPEN      CommitPhase = 0
+	CommitPhase_PHASE_FINISHING CommitPhase = 1
+	CommitPhase_PHASE_FINISHED  CommitPhase = 2
+	CommitPhase_PHASE_FAILED    CommitPhase = 3
+)
+
+var CommitPhase_name = map[int32]string{
+	0: "PHASE_OPEN",
+	1: "PHASE_FINISHING",
+	2: "PHASE_FINISHED",
+	3: "PHASE_FAILED",
 }
 
-func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptor_b48f014707f6595c) }
+var CommitPhase_value = map[string]int32{
+	"PHASE_OPEN":      0,
+	"PHASE_FINISHING": 1,
+	"PHASE_FINISHED":  2,
+	"PHASE_FAILED":    3,
+}
 
-var fileDescriptor_b48f014707f6595c = []byte{
-	// 3104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0x4b, 0x72, 0xf9, 0x28, 0x51, 0xeb, 0xb1, 0x2c, 0x33, 0x74, 0xfc, 0x91, 0xb5,
-	0x93, 0x3a, 0x4e, 0x22, 0x29, 0x72, 0x53, 0x7f, 0x25, 0x11, 0xac, 0x0f, 0x3b, 0x32, 0x0c, 0xdb,
-	0x5d, 0xaa, 0x29, 0x1a, 0xb4, 0x25, 0x96, 0xe4, 0x50, 0xdc, 0x78, 0xc9, 0x65, 0x76, 0x96, 0x56,
-	0x94, 0x7f, 0xa0, 0xbd, 0xf4, 0x1e, 0xa0, 0x97, 0x02, 0x45, 0xef, 0x45, 0xff, 0x81, 0x5e, 0x8b,
-	0x9e, 0x7a, 0xe8, 0xb9, 0x28, 0xdc, 0x7b, 0x0f, 0x3d, 0xe6, 0xd2, 0x62, 0xbe, 0x76, 0x67, 0x3f,
-	0x28, 0x92, 0x01, 0x7a, 0xb0, 0x39, 0x3b, 0xf3, 0xde, 0x9b, 0x37, 0xef, 0xe3, 0x37, 0xef, 0x8d,
-	0x0d, 0x6b, 0x5d, 0xcf, 0xc5, 0xa3, 0x70, 0x73, 0xdc, 0x27, 0xf4, 0xcf, 0xc6, 0x38, 0xf0, 0x43,
-	0x1f, 0x15, 0xc7, 0x7d, 0xd2, 0xbc, 0x74, 0xec, 0xfb, 0xc7, 0x1e, 0xde, 0x64, 0x53, 0x9d, 0x49,
-	0x7f, 0x13, 0x0f, 0xc7, 0xe1, 0x29, 0xa7, 0x68, 0x5e, 0x4d, 0x2f, 0x86, 0xee, 0x10, 0x93, 0xd0,
-	0x19, 0x8e, 0x05, 0xc1, 0x95, 0x34, 0xc1, 0x49, 0xe0, 0x8c, 0xc7, 0x38, 0x10, 0x5b, 0x34, 0xd7,
-	0x8e, 0xfd, 0x63, 0x9f, 0x0d, 0x37, 0xe9, 0x48, 0xcc, 0xae, 0x0b, 0x75, 0x9c, 0x49, 0x38, 0x60,
-	0x7f, 0xf1, 0x79, 0xab, 0x09, 0xba, 0x8d, 0xc7, 0x3e, 0x42, 0xa0, 0x8f, 0x9c, 0x21, 0x6e, 0x68,
-	0xd7, 0xb4, 0x9b, 0x55, 0x9b, 0x8d, 0xad, 0x07, 0x50, 0xde, 0x0d, 0x9c, 0x51, 0x77, 0x80, 0x2e,
-	0x83, 0x1e, 0xe0, 0xb1, 0xcf, 0x56, 0x6b, 0xdb, 0xd5, 0x0d, 0x7a, 0x20, 0xca, 0x66, 0xb3, 0xe9,
-	0x88, 0xb9, 0xa0, 0x30, 0x7f, 0xa7, 0x01, 0x70, 0xee, 0xc3, 0x51, 0xdf, 0x47, 0xd7, 0xa1, 0xdc,
-	0x61, 0x5f, 0x0d, 0x9d, 0xc9, 0xa8, 0x31, 0x19, 0x9c, 0xc0, 0x16, 0x4b, 0xe8, 0x2a, 0xe8, 0x03,
-	0xec, 0xf4, 0x98, 0x1c, 0x49, 0xb2, 0xe7, 0x0f, 0x87, 0x6e, 0x68, 0xb3, 0x05, 0xf4, 0x1e, 0xc0,
-	0x38, 0xf0, 0x5f, 0xe1, 0x91, 0x33, 0xea, 0xe2, 0x46, 0xf1, 0x5a, 0x31, 0x2d, 0x49, 0x59, 0xa6,
-	0xc4, 0x64, 0xd2, 0x91, 0xc4, 0xa5, 0x1c, 0xe2, 0x78, 0x19, 0xdd, 0x85, 0x73, 0x3d, 0x37, 0xc0,
-	0xdd, 0xb0, 0xad, 0x6c, 0x50, 0xce, 0xf2, 0x98, 0x9c, 0xea, 0x45, 0xbc, 0x4d, 0x9e, 0xe5, 0x76,
-	0xa0, 0x16, 0x9f, 0x9d, 0xa0, 0x2d, 0xa8, 0xf1, 0x13, 0xb6, 0xdd, 0x51, 0x9f, 0x5a, 0x91, 0x8a,
-	0x5d, 0x55, 0xc4, 0x52, 0x32, 0x1b, 0x3a, 0xd1, 0xd8, 0xda, 0x01, 0xfd, 0x91, 0xeb, 0x61, 0x6a,
-	0xb6, 0x2e, 0x33, 0x80, 0x30, 0x7d, 0xc2, 0x26, 0x62, 0x89, 0x6a, 0x30, 0x76, 0xc2, 0x81, 0x34,
-	0x3f, 0x1d, 0x5b, 0x97, 0xa0, 0xb4, 0xeb, 0xf9, 0xdd, 0x97, 0x74, 0x71, 0xe0, 0x90, 0x81, 0x54,
-	0x8f, 0x8e, 0xad, 0x37, 0xa1, 0xfc, 0xbc, 0xf3, 0x25, 0xee, 0x86, 0xb9, 0xab, 0x6f, 0x40, 0xf1,
-	0xc8, 0x39, 0xce, 0x3d, 0xd7, 0x7f, 0x35, 0x30, 0xa8, 0xdf, 0x99, 0x4b, 0x67, 0x04, 0xc5, 0x0f,
-	0xa1, 0xd2, 0x0d, 0xb0, 0x13, 0x62, 0xe9, 0xcf, 0xe6, 0x06, 0x8f, 0xdc, 0x0d, 0x19, 0xb9, 0x1b,
-	0x47, 0x32, 0xb4, 0x6d, 0x49, 0x8a, 0x2e, 0x03, 0x10, 0xf7, 0x1b, 0xdc, 0xee, 0x9c, 0x86, 0x98,
-	0x34, 0x8a, 0xd7, 0xb4, 0x9b, 0xba, 0x5d, 0xa5, 0x33, 0xbb, 0x74, 0x02, 0x5d, 0x83, 0x5a, 0x0f,
-	0x93, 0x6e, 0xe0, 0x8e, 0x43, 0xd7, 0x1f, 0x35, 0x4a, 0x4c, 0x37, 0x75, 0x0a, 0xfd, 0x00, 0x0c,
-	0x6e, 0x47, 0x4c, 0x1a, 0x95, 0xac, 0xff, 0xa2, 0x45, 0xb4, 0x01, 0x55, 0x9a, 0x07, 0xdc, 0x25,
-	0x65, 0xa6, 0xe1, 0xb9, 0xe8, 0x0c, 0x0f, 0x27, 0x21, 0x77, 0x8a, 0xe1, 0x88, 0xd1, 0x13, 0xdd,
-	0xd0, 0xcd, 0x92, 0xf5, 0x29, 0x2c, 0xab, 0xeb, 0x68, 0x03, 0x96, 0x9d, 0x6e, 0x17, 0x13, 0xd2,
-	0xf6, 0xf0, 0x2b, 0xec, 0x31, 0x63, 0xd4, 0xb7, 0x6b, 0x1b, 0x2c, 0xc5, 0x5a, 0x5d, 0x7f, 0x8c,
-	0xed, 0x1a, 0x27, 0x78, 0x4a, 0xd7, 0xad, 0x1d, 0x28, 0x73, 0xef, 0xcd, 0x32, 0xdf, 0x3a, 0x14,
-	0x5c, 0x6e, 0xb9, 0xea, 0x6e, 0xf9, 0xf5, 0x3f, 0xae, 0x16, 0x0e, 0xf7, 0xed, 0x82, 0xdb, 0xb3,
-	0x5a, 0x50, 0x13, 0xee, 0x77, 0x46, 0xc7, 0x18, 0xbd, 0x05, 0x25, 0xcf, 0x3f, 0xc1, 0x41, 0x5e,
-	0x7c, 0xf0, 0x15, 0x4a, 0x32, 0xa1, 0x00, 0x91, 0x97, 0x56, 0x7c, 0xc5, 0xfa, 0x39, 0x98, 0x7c,
-	0x42, 0x89, 0xeb, 0xb9, 0x42, 0x2f, 0x4e, 0xeb, 0xc2, 0xd4, 0xb4, 0xb6, 0xfe, 0xa3, 0x03, 0x70,
-	0x3e, 0x09, 0x05, 0x8b, 0x08, 0x5e, 0x9d, 0x8e, 0x17, 0xa9, 0x68, 0x30, 0xb2, 0xd1, 0xb0, 0x05,
-	0x2b, 0x63, 0x27, 0xc0, 0xa3, 0xb0, 0x2d, 0xb6, 0xcc, 0xb1, 0xc1, 0x32, 0xa7, 0x10, 0x6e, 0xd9,
-	0x82, 0x95, 0xee, 0xc0, 0xf5, 0x7a, 0x82, 0x81, 0x34, 0x6a, 0x4a, 0x10, 0x49, 0x0e, 0x46, 0xc1,
-	0x3f, 0x08, 0x0d, 0x74, 0x12, 0x3a, 0x01, 0x0d, 0xf4, 0xe2, 0xec, 0x40, 0x17, 0xa4, 0xe8, 0x47,
-	0x60, 0xf4, 0xdd, 0x91, 0x4b, 0x06, 0xb8, 0x27, 0x20, 0xf1, 0x2c, 0xb6, 0x88, 0x36, 0x95, 0x20,
-	0xa5, 0x74, 0x82, 0x7c, 0x94, 0x40, 0x48, 0x93, 0xe9, 0x7e, 0x41, 0xd1, 0x3d, 0x76, 0x70, 0x02,
-	0x2b, 0xdf, 0x05, 0x33, 0xc0, 0x4e, 0xef, 0x54, 0x45, 0xbf, 0xe5, 0x6b, 0xda, 0xcd, 0xa2, 0xbd,
-	0xca, 0xe6, 0x95, 0xb8, 0xd8, 0x4a, 0xc0, 0x6a, 0x95, 0xed, 0x60, 0xaa, 0xd6, 0xa1, 0x71, 0x99,
-	0xc0, 0xd6, 0xab, 0xa0, 0x87, 0x01, 0xc6, 0x8d, 0x8a, 0x62, 0x7b, 0x8e, 0x3f, 0x36, 0x5b, 0xa0,
-	0x11, 0x4a, 0x7f, 0x49, 0x63, 0x45, 0xb1, 0xb5, 0xa0, 0xe0, 0x2b, 0x34, 0x1e, 0x7a, 0x4e, 0x38,
-	0x19, 0x92, 0x46, 0x3d, 0x2b, 0x45, 0x2c, 0x3d, 0xd1, 0x8d, 0xb2, 0x59, 0x79, 0xa2, 0x1b, 0x60,
-	0xd6, 0xac, 0x3f, 0x15, 0xc0, 0xa0, 0x10, 0x2a, 0xa1, 0xaa, 0xef, 0x7a, 0x38, 0x91, 0x6b, 0x74,
-	0xd1, 0x66, 0xd3, 0xe8, 0x16, 0x54, 0xe9, 0x6f, 0x3b, 0x3c, 0x1d, 0xf3, 0x4b, 0xac, 0xbe, 0xbd,
-	0x12, 0xd1, 0x1c, 0x9d, 0x8e, 0x31, 0xb5, 0x3f, 0x1f, 0xcd, 0x02, 0xa8, 0xbb, 0x50, 0xe5, 0x81,
-	0x43, 0xc3, 0x01, 0x66, 0xfa, 0x35, 0x26, 0x46, 0x4d, 0x30, 0x58, 0x58, 0x05, 0x78, 0xc4, 0x2e,
-	0x9e, 0xaa, 0x1d, 0x7d, 0xa3, 0xb7, 0xa1, 0xe2, 0xb3, 0xa3, 0x92, 0x86, 0x91, 0x35, 0x91, 0x5c,
-	0x43, 0xef, 0x41, 0xb5, 0x43, 0x41, 0xdf, 0xc6, 0x7d, 0x22, 0x3c, 0xc3, 0xcf, 0xb1, 0x2b, 0x66,
-	0xed, 0x78, 0x3d, 0x82, 0x7e, 0xea, 0x95, 0x65, 0x01, 0xfd, 0x77, 0xa0, 0x4a, 0x8f, 0xc1, 0xa1,
-	0x65, 0x4d, 0x85, 0x16, 0x5d, 0xa2, 0xc9, 0x9a, 0x8a, 0x26, 0xba, 0x04, 0x10, 0x1b, 0x0c, 0xb9,
-	0x07, 0xba, 0x06, 0x25, 0xb6, 0x8b, 0xb0, 0x36, 0x28, 0x1a, 0xf0, 0x05, 0x74, 0x03, 0x4a, 0x01,
-	0xdd, 0x42, 0x64, 0x63, 0x9d, 0x53, 0xc8, 0x8d, 0x6d, 0xbe, 0x68, 0xfd, 0x02, 0x80, 0x1f, 0x50,
-	0xa2, 0x06, 0x3f, 0x66, 0x02, 0x35, 0x64, 0x00, 0xf0, 0x25, 0xea, 0x48, 0xb6, 0x43, 0x3b, 0xc0,
-	0x7d, 0x21, 0x3c, 0x65, 0x00, 0x43, 0x1a, 0xc0, 0x0a, 0xe0, 0xdc, 0x1e, 0xbb, 0x74, 0x18, 0xe8,
-	0xe2, 0xaf, 0x26, 0x98, 0xcc, 0x04, 0xe5, 0x14, 0xe0, 0x14, 0xb3, 0x80, 0xb3, 0x0e, 0xe5, 0xc9,
-	0xb8, 0xe7, 0x84, 0x98, 0x25, 0xb5, 0x61, 0x8b, 0xaf, 0x27, 0xba, 0x51, 0x30, 0x8b, 0xd6, 0x6d,
-	0x40, 0x87, 0x23, 0x32, 0xa6, 0x2a, 0xcf, 0xbd, 0xa9, 0x75, 0x11, 0x56, 0x9f, 0xba, 0x44, 0xe5,
-	0x78, 0xa2, 0x1b, 0x9a, 0x59, 0xb0, 0x3e, 0x05, 0x33, 0x5e, 0x20, 0x63, 0x7f, 0x44, 0x58, 0x28,
-	0x53, 0x26, 0xb5, 0xd0, 0x58, 0x89, 0x04, 0xf2, 0x1b, 0x2d, 0x10, 0x23, 0xeb, 0x0b, 0x38, 0xb7,
-	0x8f, 0x3d, 0xbc, 0x90, 0x05, 0xd6, 0xa0, 0xd4, 0xf7, 0x83, 0x2e, 0x77, 0x9d, 0x61, 0xf3, 0x0f,
-	0x64, 0x42, 0xd1, 0xf1, 0x3c, 0x66, 0x0f, 0xc3, 0xa6, 0x43, 0xeb, 0x8f, 0x1a, 0xa0, 0x16, 0x85,
-	0x3a, 0x01, 0x0a, 0x42, 0xfa, 0x75, 0x28, 0x73, 0xb4, 0xcd, 0xc5, 0x7e, 0xbe, 0x94, 0xb6, 0xb2,
-	0x9e, 0x6b, 0x65, 0x71, 0x3b, 0x70, 0x17, 0xc8, 0x0b, 0x21, 0x89, 0x7e, 0xa5, 0x39, 0xd1, 0x4f,
-	0x38, 0xe7, 0xcf, 0x1a, 0xa0, 0xdd, 0x49, 0x04, 0xec, 0x0b, 0xa9, 0xbc, 0x9e, 0x28, 0x6f, 0xa7,
-	0x29, 0x54, 0x9e, 0x17, 0x8e, 0x25, 0x62, 0x16, 0xa7, 0x21, 0x66, 0x1d, 0x0a, 0x87, 0xfb, 0xa2,
-	0xfc, 0x29, 0x1c, 0xee, 0x8b, 0x13, 0x7c, 0xa7, 0xc1, 0xf9, 0x47, 0xec, 0xa2, 0xc8, 0x1c, 0x61,
-	0xf6, 0x8d, 0x9b, 0xb2, 0x7a, 0x21, 0x6b, 0xf5, 0x99, 0x5a, 0x45, 0x38, 0x5e, 0x9a, 0x03, 0xc7,
-	0x2b, 0x53, 0x71, 0x3c, 0x85, 0xb1, 0xe5, 0x34, 0xc6, 0xae, 0x41, 0x89, 0x75, 0x4c, 0x22, 0xc5,
-	0xf8, 0x87, 0x35, 0x82, 0x35, 0x91, 0x5b, 0xdf, 0xe3, 0xf0, 0x1f, 0x42, 0x8d, 0x03, 0x07, 0x09,
-	0x69, 0xee, 0xf2, 0x3b, 0x40, 0xbd, 0xd5, 0x5a, 0x74, 0xde, 0x06, 0x46, 0xc4, 0xc6, 0xd6, 0xaf,
-	0x35, 0x38, 0x47, 0xd3, 0x2f, 0xb9, 0xdb, 0x8c, 0xf4, 0xb9, 0x0a, 0x7a, 0x3f, 0xf0, 0x87, 0xb9,
-	0x1d, 0x0e, 0x5d, 0x40, 0x97, 0xa0, 0x10, 0xfa, 0x09, 0x0b, 0x8b, 0xe5, 0x42, 0x48, 0x6b, 0xc2,
-	0xf2, 0x68, 0x32, 0xec, 0xe0, 0x80, 0x9d, 0x5c, 0xb7, 0xc5, 0x17, 0x6d, 0x37, 0xe2, 0xfa, 0x8a,
-	0xb5, 0x1b, 0xfc, 0x58, 0xd9, 0x76, 0x23, 0x26, 0xb3, 0xa1, 0x1b, 0x8d, 0xad, 0xdf, 0x6b, 0x70,
-	0x9e, 0x83, 0xa1, 0xa8, 0xb0, 0xc4, 0x69, 0x64, 0x43, 0xa6, 0x4d, 0x6b, 0xc8, 0xde, 0x00, 0x83,
-	0xb4, 0x95, 0x0a, 0xb0, 0x6a, 0x57, 0x88, 0xe8, 0x19, 0xaf, 0x27, 0x72, 0x74, 0x4a, 0x05, 0x97,
-	0x6c, 0xe8, 0xf4, 0x33, 0x1b, 0x3a, 0xeb, 0x41, 0xe4, 0xe1, 0xa4, 0x96, 0xf1, 0x4e, 0xda, 0xf4,
-	0x22, 0x74, 0x9b, 0x7b, 0x2b, 0xc9, 0x39, 0x03, 0x79, 0x5f, 0xc0, 0x79, 0x0e, 0x90, 0x8b, 0xef,
-	0x97, 0x0f, 0x94, 0xd6, 0x7d, 0x29, 0x71, 0xf1, 0x18, 0xb5, 0x1c, 0x40, 0x8f, 0xbc, 0x49, 0x3a,
-	0xb7, 0xdf, 0x86, 0x8a, 0xac, 0x54, 0xb5, 0x6c, 0xa5, 0x2a, 0xd7, 0xd0, 0x0d, 0x30, 0x42, 0xbf,
-	0x4d, 0x4f, 0x45, 0x1a, 0x05, 0x46, 0xa7, 0x9c, 0xb6, 0x12, 0xfa, 0xf4, 0x97, 0x58, 0xdf, 0x6a,
-	0xb0, 0xde, 0x9a, 0x74, 0x68, 0xca, 0x77, 0xf0, 0x42, 0x81, 0xbd, 0x9e, 0x68, 0x04, 0xaa, 0x6a,
-	0x4b, 0xcf, 0x02, 0xbe, 0x38, 0x2d, 0xe0, 0xdf, 0x81, 0x12, 0xcf, 0x39, 0x7d, 0x4a, 0xce, 0xf1,
-	0x65, 0xeb, 0x2b, 0xa8, 0x3f, 0xc6, 0x21, 0x2b, 0xda, 0x62, 0x8d, 0xce, 0x2a, 0xea, 0xde, 0x82,
-	0x65, 0xbf, 0xdf, 0x27, 0x38, 0x14, 0x30, 0x52, 0x60, 0xe5, 0x6c, 0x8d, 0xcf, 0x71, 0x20, 0xc9,
-	0xd6, 0x72, 0x45, 0x05, 0x67, 0xac, 0x77, 0xa0, 0xfe, 0xfc, 0x15, 0x0e, 0x4e, 0x02, 0x37, 0xc4,
-	0x87, 0xa3, 0x1e, 0xfe, 0x9a, 0x3a, 0xd5, 0xa5, 0x03, 0xb6, 0x67, 0xd1, 0xe6, 0x1f, 0xd6, 0xbf,
-	0x0b, 0x50, 0x7f, 0x31, 0x59, 0x44, 0xb7, 0x35, 0x28, 0xbd, 0x72, 0xbc, 0x09, 0x87, 0xd2, 0x65,
-	0x9b, 0x7f, 0xd0, 0x5b, 0x74, 0x12, 0x78, 0x02, 0xd5, 0xe9, 0x10, 0xbd, 0x49, 0x6f, 0xf3, 0xee,
-	0x24, 0x20, 0xee, 0x2b, 0xcc, 0x70, 0xd0, 0xb0, 0xe3, 0x09, 0xf4, 0x3e, 0x54, 0x7b, 0xd8, 0x73,
-	0x87, 0x6e, 0x88, 0x03, 0x06, 0xa7, 0x75, 0x51, 0x4a, 0xed, 0xcb, 0x59, 0x3b, 0x26, 0x40, 0xef,
-	0x03, 0x0a, 0x9d, 0xe0, 0x18, 0x87, 0x6d, 0x56, 0xeb, 0x0a, 0x14, 0x36, 0xd8, 0x41, 0x4c, 0xbe,
-	0x42, 0x35, 0xdc, 0xe7, 0x10, 0x7c, 0x0b, 0xce, 0xa9, 0xd4, 0xdc, 0x42, 0x55, 0xde, 0x11, 0xc4,
-	0xc4, 0xdc, 0x8c, 0x6f, 0x43, 0x9d, 0x82, 0x01, 0x0e, 0xda, 0x01, 0xee, 0xfa, 0x41, 0x8f, 0xf6,
-	0x4c, 0x94, 0x70, 0x85, 0xcf, 0xda, 0x7c, 0x12, 0x7d, 0x0c, 0xab, 0xbe, 0x34, 0x67, 0x9b, 0x9b,
-	0x91, 0x17, 0xc8, 0xe7, 0xf9, 0x1d, 0x90, 0x30, 0xb5, 0x5d, 0xf7, 0x13, 0xdf, 0xfc, 0x86, 0x13,
-	0x4d, 0xf8, 0x6f, 0x34, 0x58, 0x89, 0x0c, 0x4e, 0x85, 0xa7, 0x3c, 0xa9, 0xa5, 0x3c, 0x89, 0xae,
-	0x42, 0x8d, 0x57, 0x88, 0x6d, 0x56, 0xf2, 0xf2, 0x10, 0x05, 0x3e, 0xf5, 0x99, 0x43, 0x06, 0x79,
-	0xba, 0x15, 0xe7, 0xd6, 0xcd, 0xfa, 0xab, 0xa6, 0x04, 0x00, 0x3f, 0xec, 0x1a, 0x94, 0xc8, 0xd8,
-	0x13, 0x09, 0x6d, 0xd8, 0xfc, 0x03, 0xbd, 0x0f, 0x15, 0x69, 0x22, 0x9e, 0x84, 0x88, 0x89, 0x4f,
-	0xf0, 0xda, 0x92, 0x84, 0x7a, 0x3f, 0xf4, 0x87, 0x1d, 0x12, 0xfa, 0x23, 0x2c, 0x6a, 0xab, 0x78,
-	0x02, 0xdd, 0x82, 0x32, 0xb7, 0xaf, 0x68, 0x1f, 0xf3, 0x44, 0x09, 0x0a, 0x4a, 0xdb, 0xf7, 0x7d,
-	0x1a, 0x26, 0xa5, 0xe9, 0xb4, 0x9c, 0xc2, 0x72, 0x61, 0x75, 0xcf, 0x1f, 0x9f, 0xaa, 0xd1, 0x7c,
-	0x09, 0x8a, 0x24, 0xe8, 0x66, 0x83, 0x99, 0xce, 0xd2, 0xc5, 0x1e, 0x91, 0x8d, 0xb5, 0xba, 0xd8,
-	0x23, 0x21, 0x3d, 0x42, 0x64, 0x2b, 0x79, 0x84, 0x68, 0x42, 0x29, 0x87, 0xe7, 0xcf, 0x1d, 0xeb,
-	0x97, 0xbc, 0x1c, 0x5e, 0x20, 0xdb, 0x10, 0xe8, 0xfd, 0x89, 0xe7, 0x09, 0x24, 0x66, 0x63, 0xd4,
-	0x80, 0xca, 0xc0, 0x25, 0xa1, 0x1f, 0x9c, 0x8a, 0xbc, 0x97, 0x9f, 0xd6, 0x16, 0xac, 0xfe, 0xd4,
-	0xf1, 0x5e, 0x2e, 0xa0, 0xd1, 0x0b, 0x58, 0x7d, 0xec, 0xf9, 0x1d, 0x95, 0x63, 0xae, 0xa2, 0xa3,
-	0x01, 0x95, 0xb1, 0x13, 0x86, 0x38, 0x90, 0xd5, 0x96, 0xfc, 0xa4, 0x7d, 0x98, 0xec, 0x5d, 0x49,
-	0xd4, 0x9d, 0x66, 0x4a, 0x7a, 0x49, 0xc2, 0xbb, 0x53, 0x76, 0x91, 0x9f, 0xc0, 0xea, 0xbe, 0xdb,
-	0xef, 0xab, 0xaa, 0xdc, 0x00, 0x63, 0x84, 0x4f, 0xda, 0xf9, 0x07, 0xa8, 0x8c, 0xf0, 0x09, 0x7b,
-	0x68, 0xbc, 0x01, 0x86, 0xef, 0xf5, 0x38, 0x55, 0xc6, 0x95, 0x15, 0xdf, 0xeb, 0x31, 0xaa, 0x06,
-	0x54, 0xc8, 0xc0, 0xf1, 0x3c, 0xff, 0x44, 0x38, 0x53, 0x7e, 0x5a, 0x5f, 0x82, 0x19, 0x6f, 0x1c,
-	0xf7, 0x22, 0x72, 0x67, 0x32, 0x45, 0x71, 0xb1, 0x3d, 0x3b, 0xa4, 0xdc, 0x5f, 0xe6, 0x46, 0x9a,
-	0x56, 0x28, 0x41, 0xe8, 0x55, 0xce, 0x2f, 0xd1, 0x05, 0x7c, 0x34, 0x00, 0xf3, 0xc5, 0x24, 0x14,
-	0x75, 0xa6, 0x60, 0x89, 0x50, 0x58, 0x53, 0x51, 0xf8, 0x4d, 0xd0, 0x43, 0xe7, 0x58, 0x2a, 0x61,
-	0x30, 0x41, 0x47, 0xce, 0xb1, 0xcd, 0x66, 0xe3, 0xe6, 0xb6, 0x38, 0xa5, 0xb9, 0xb5, 0x7e, 0xab,
-	0xc1, 0xb9, 0xc7, 0x58, 0x6c, 0x45, 0x94, 0x6b, 0x5a, 0x76, 0xf0, 0xda, 0x19, 0x1d, 0x7c, 0xde,
-	0xa5, 0xa5, 0xcf, 0xba, 0xb4, 0x12, 0xc5, 0xf1, 0x65, 0x80, 0xd0, 0x0f, 0x1d, 0xaf, 0x4d, 0xa7,
-	0x44, 0x9d, 0x58, 0x65, 0x33, 0x2d, 0xf7, 0x1b, 0x6c, 0xfd, 0x4e, 0x03, 0xf3, 0x31, 0x0e, 0x99,
-	0xc6, 0x91, 0x72, 0x89, 0x77, 0x03, 0x6d, 0xc6, 0xbb, 0xc1, 0xff, 0x5d, 0xc5, 0x9f, 0x80, 0x79,
-	0xe4, 0x1c, 0x27, 0x5d, 0x35, 0x57, 0xf7, 0x7f, 0xa6, 0xe7, 0xac, 0x35, 0x40, 0x14, 0x37, 0x92,
-	0x7e, 0xa1, 0xb9, 0x4b, 0x67, 0x8f, 0x9c, 0xe3, 0xc8, 0x1a, 0xeb, 0x50, 0x1e, 0x07, 0xb8, 0xef,
-	0x7e, 0x2d, 0x9e, 0xbe, 0xc5, 0x17, 0xbd, 0xe6, 0xdc, 0x51, 0xd7, 0x9b, 0xf4, 0x70, 0x5b, 0xe8,
-	0xc2, 0x01, 0x65, 0x45, 0xcc, 0x72, 0xc9, 0x56, 0x8b, 0x77, 0xe5, 0x5c, 0xa2, 0xc8, 0x84, 0x26,
-	0x14, 0x43, 0xe7, 0x58, 0xe8, 0x1e, 0x2b, 0x46, 0x27, 0x95, 0xa3, 0x15, 0xa6, 0x1e, 0xcd, 0xfa,
-	0x04, 0xd6, 0x78, 0xc8, 0x7f, 0xaf, 0xb0, 0xb2, 0x2e, 0xc2, 0x85, 0x14, 0x3b, 0x57, 0xcc, 0xfa,
-	0x50, 0xa6, 0x92, 0x6a, 0x00, 0x69, 0x47, 0x6d, 0x9a, 0x1d, 0x55, 0x16, 0x21, 0xe8, 0x1e, 0xa0,
-	0xbd, 0x01, 0xee, 0xbe, 0x5c, 0xdc, 0x6d, 0xd6, 0x07, 0x70, 0x3e, 0xc1, 0x2a, 0x6c, 0xb6, 0x0e,
-	0x65, 0xfc, 0xb5, 0x4b, 0x42, 0x22, 0xae, 0x50, 0xf1, 0x65, 0x6d, 0x41, 0x45, 0x9c, 0x62, 0xde,
-	0xd3, 0xff, 0xaa, 0x00, 0x35, 0xf9, 0x92, 0x44, 0xab, 0xb8, 0x3b, 0x69, 0xb6, 0xcb, 0x0a, 0x1b,
-	0x23, 0x11, 0x63, 0x72, 0x30, 0x0a, 0x83, 0xd3, 0x38, 0x3b, 0x37, 0x12, 0x01, 0xd6, 0xcc, 0x70,
-	0x51, 0x8b, 0x70, 0x16, 0x46, 0xd7, 0x3c, 0x84, 0x65, 0x55, 0x10, 0x2d, 0xf0, 0x5e, 0xe2, 0x53,
-	0x11, 0x56, 0x74, 0x88, 0xae, 0x4b, 0x08, 0xca, 0x7d, 0xac, 0xe2, 0x6b, 0xf7, 0x0b, 0x77, 0xb5,
-	0xe6, 0x3e, 0x54, 0x23, 0xe9, 0x39, 0x72, 0xde, 0x4a, 0xca, 0x49, 0x76, 0xde, 0x91, 0x94, 0x5b,
-	0xef, 0xf1, 0x37, 0x51, 0xf6, 0x90, 0xb9, 0x0c, 0x86, 0x7d, 0xd0, 0x3a, 0xb0, 0x3f, 0x3f, 0xd8,
-	0x37, 0x97, 0x90, 0x01, 0xfa, 0xa3, 0xc3, 0xa7, 0x07, 0xa6, 0x86, 0x2a, 0x50, 0xdc, 0x3f, 0xb4,
-	0xcd, 0xc2, 0xad, 0xdb, 0xb2, 0xab, 0x64, 0x75, 0x38, 0xaa, 0x41, 0xa5, 0x75, 0xf4, 0xd0, 0x3e,
-	0x62, 0xe4, 0x55, 0x28, 0xd9, 0x07, 0x0f, 0xf7, 0x7f, 0x66, 0x6a, 0x54, 0xce, 0xa3, 0xc3, 0x67,
-	0x87, 0xad, 0xcf, 0x0e, 0xf6, 0xcd, 0xc2, 0xad, 0x07, 0x50, 0x8d, 0xaa, 0x4f, 0x2a, 0xf4, 0xd9,
-	0xf3, 0x67, 0x07, 0x5c, 0xfc, 0x93, 0xd6, 0xf3, 0x67, 0xa6, 0x46, 0x47, 0x4f, 0x0f, 0x9f, 0x1d,
-	0x98, 0x05, 0xba, 0x51, 0xeb, 0xc7, 0x4f, 0xcd, 0x22, 0x1d, 0xec, 0xb5, 0x3e, 0x37, 0xf5, 0xed,
-	0x3f, 0xd4, 0xa1, 0xf8, 0xf0, 0xc5, 0x21, 0xfa, 0x14, 0x20, 0x7e, 0x9a, 0x43, 0xeb, 0xfc, 0xee,
-	0x4c, 0xbf, 0xd5, 0x35, 0xd7, 0x33, 0xef, 0xaa, 0x07, 0xec, 0x21, 0x60, 0x09, 0xdd, 0x81, 0x9a,
-	0xf2, 0xcc, 0x86, 0x2e, 0x32, 0x01, 0xd9, 0x87, 0xb7, 0x66, 0xf2, 0x65, 0xcc, 0x5a, 0x42, 0xf7,
-	0xc0, 0x90, 0x2f, 0x6a, 0x68, 0x8d, 0x2d, 0xa6, 0x5e, 0xde, 0x9a, 0x17, 0x52, 0xb3, 0x22, 0xfc,
-	0x97, 0xa8, 0xce, 0xf1, 0x63, 0x9a, 0xd0, 0x39, 0xf3, 0xba, 0x76, 0x86, 0xce, 0x1f, 0x41, 0x4d,
-	0x79, 0x2f, 0x13, 0x3a, 0x67, 0x5f, 0xd0, 0x9a, 0x6a, 0x25, 0x61, 0x2d, 0xa1, 0x5d, 0x58, 0x56,
-	0x5f, 0x7c, 0x50, 0x43, 0x5c, 0x7c, 0x99, 0x47, 0xa0, 0x33, 0xb6, 0xfe, 0x04, 0x56, 0x12, 0x2f,
-	0x27, 0xe8, 0x0d, 0xd5, 0x60, 0x49, 0x29, 0xe9, 0x67, 0x04, 0x6b, 0x09, 0xdd, 0x05, 0x88, 0xdf,
-	0x41, 0xc4, 0xc9, 0x33, 0x0f, 0x23, 0x4d, 0x33, 0xc5, 0x48, 0xac, 0x25, 0xb4, 0xc3, 0xa1, 0x52,
-	0x46, 0x59, 0x80, 0x9d, 0xe1, 0x54, 0xfe, 0xec, 0xc6, 0x5b, 0x1a, 0x3d, 0xbd, 0xda, 0x4e, 0x8b,
-	0xd3, 0xe7, 0x74, 0xd8, 0x67, 0x9c, 0xfe, 0x01, 0xd4, 0x94, 0xb6, 0x5a, 0x18, 0x3e, 0xdb, 0x68,
-	0xe7, 0x2b, 0xb0, 0x07, 0xab, 0xa9, 0x7e, 0x19, 0x5d, 0xe2, 0x9e, 0xcb, 0xed, 0xa2, 0xf3, 0x85,
-	0x7c, 0x04, 0x35, 0xe5, 0xdd, 0x51, 0x68, 0x90, 0x7d, 0x89, 0xcc, 0x71, 0xbd, 0xfa, 0x66, 0x23,
-	0x0e, 0x9f, 0xf3, 0x8c, 0x33, 0x97, 0xeb, 0x85, 0x90, 0x84, 0xeb, 0x93, 0x52, 0xd2, 0xff, 0x60,
-	0x1d, 0xbb, 0x5e, 0xf0, 0xc6, 0xae, 0x4b, 0x32, 0x9a, 0x29, 0x46, 0xc2, 0x95, 0x57, 0x9f, 0x56,
-	0x12, 0x9e, 0x9b, 0x57, 0xf9, 0xfb, 0x50, 0x11, 0x2d, 0x0c, 0x3a, 0x9f, 0x6c, 0x68, 0x66, 0x70,
-	0xde, 0xd4, 0xd0, 0x7d, 0x30, 0x64, 0x97, 0x23, 0x32, 0x3d, 0xd5, 0xf4, 0x9c, 0xb1, 0xef, 0x0e,
-	0x54, 0xc4, 0x53, 0x84, 0xd8, 0x37, 0xf9, 0x30, 0xd1, 0xbc, 0x94, 0xe1, 0x64, 0x75, 0xcf, 0xe7,
-	0x14, 0x86, 0x99, 0xc3, 0x63, 0x7c, 0x62, 0x42, 0x12, 0xf8, 0xa4, 0x0a, 0x4a, 0x56, 0xc0, 0xd6,
-	0x12, 0xda, 0xe6, 0xf8, 0xa4, 0x68, 0x9d, 0x6a, 0x85, 0x9a, 0xf5, 0x04, 0x0b, 0x61, 0x98, 0x56,
-	0x97, 0x44, 0x22, 0xc5, 0xf2, 0x39, 0xd3, 0x9b, 0x6d, 0x69, 0xe8, 0x36, 0x18, 0xb2, 0x15, 0x12,
-	0x4c, 0xa9, 0xce, 0x28, 0x8f, 0x69, 0x1b, 0x0c, 0xd9, 0x0d, 0x09, 0xa6, 0x54, 0x73, 0x94, 0xaf,
-	0xa3, 0x24, 0x4a, 0xe8, 0x98, 0xe6, 0xcc, 0xd9, 0xee, 0x1e, 0x18, 0xb2, 0xf1, 0x10, 0x4c, 0xa9,
-	0x06, 0x48, 0x40, 0x76, 0xba, 0x3b, 0x51, 0x21, 0x9b, 0x31, 0xab, 0x90, 0x3d, 0x5f, 0x1c, 0x7c,
-	0xc2, 0xee, 0x3a, 0x1c, 0xe2, 0x87, 0x9e, 0x87, 0xa6, 0x90, 0x9d, 0xc1, 0x7e, 0x17, 0xf4, 0x47,
-	0xa4, 0xfb, 0x72, 0x71, 0xce, 0xed, 0xbf, 0x57, 0xa0, 0xca, 0x2f, 0x77, 0x7a, 0x5b, 0xde, 0x86,
-	0x6a, 0xd4, 0xda, 0xa0, 0x0b, 0x32, 0x11, 0x12, 0x85, 0x58, 0x53, 0x2d, 0x08, 0x58, 0xfc, 0xdf,
-	0x63, 0x2f, 0x16, 0x7c, 0xa2, 0xc5, 0xde, 0x26, 0xa6, 0x70, 0x2e, 0x2b, 0x9c, 0x84, 0xb1, 0xee,
-	0x00, 0x44, 0x54, 0x64, 0x1a, 0xdb, 0x59, 0xb9, 0x77, 0x0f, 0xaa, 0x51, 0x83, 0x84, 0x54, 0xcd,
-	0x66, 0x67, 0xce, 0x01, 0x40, 0xdc, 0x5b, 0x09, 0x97, 0x65, 0x9a, 0xad, 0xd9, 0x62, 0xf6, 0x98,
-	0x06, 0xbc, 0x09, 0x12, 0x27, 0x48, 0x37, 0x45, 0xb3, 0x85, 0x7c, 0xcc, 0x4a, 0xb2, 0x84, 0xdd,
-	0xd3, 0x7d, 0xcb, 0x19, 0xde, 0xdf, 0x8c, 0x90, 0x37, 0xcf, 0x10, 0xab, 0x89, 0xda, 0x92, 0xe5,
-	0xfe, 0x2e, 0xd4, 0x94, 0x32, 0x59, 0x80, 0x46, 0xb6, 0xe6, 0x6e, 0x36, 0xb2, 0x0b, 0x51, 0xc4,
-	0xdf, 0x81, 0x9a, 0xd2, 0x03, 0x09, 0x19, 0xd9, 0xae, 0x28, 0x15, 0x2e, 0x5b, 0x1a, 0xfa, 0x0c,
-	0x56, 0x12, 0x0d, 0x84, 0xb8, 0x27, 0xf2, 0x7a, 0x92, 0x66, 0x33, 0x6f, 0x29, 0x52, 0xe1, 0x36,
-	0x94, 0x1f, 0x63, 0xda, 0x1d, 0xa1, 0xa8, 0xb1, 0x98, 0x6d, 0xea, 0x77, 0x01, 0x84, 0xb1, 0x92,
-	0x8c, 0x39, 0x66, 0x7a, 0xc0, 0x21, 0x92, 0x16, 0xcb, 0x0a, 0xd0, 0x29, 0xed, 0x8d, 0x52, 0xc2,
-	0x25, 0x3a, 0x18, 0xba, 0xcf, 0x8e, 0x44, 0x04, 0xc6, 0xae, 0x22, 0x82, 0x2a, 0xe0, 0x62, 0x66,
-	0x3e, 0x3a, 0xdd, 0x03, 0xa8, 0xec, 0xf9, 0xc3, 0xb1, 0xd3, 0x0d, 0x17, 0x4f, 0xeb, 0xdd, 0x9d,
-	0xbf, 0xbc, 0xbe, 0xa2, 0xfd, 0xed, 0xf5, 0x15, 0xed, 0x9f, 0xaf, 0xaf, 0x68, 0xdf, 0xfe, 0xeb,
-	0xca, 0xd2, 0x17, 0x1f, 0x1c, 0xbb, 0xe1, 0x60, 0xd2, 0xd9, 0xe8, 0xfa, 0xc3, 0xcd, 0xb1, 0xd3,
-	0x1d, 0x9c, 0xf6, 0x70, 0xa0, 0x8e, 0x48, 0xd0, 0xdd, 0x8c, 0xff, 0x9f, 0x61, 0xa7, 0xcc, 0x44,
-	0xde, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xd6, 0xb8, 0xd7, 0x7c, 0x28, 0x00, 0x00,
+func (x CommitPhase) String() string {
+	return proto.EnumName(CommitPhase_name, int32(x))
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (CommitPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{3}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// IndexStatus reports whether a commit's contents have been pushed to the
+// cluster's content indexer, if one is configured (see the PACH_INDEXER_URL
+// pachd environment variable).
+type IndexStatus int32
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	// Repo rpcs
-	// CreateRepo creates a new repo.
-	// An error is returned if the repo already exists.
-	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// Commit rpcs
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
-	// ListCommit returns info about all commits. This is deprecated in favor of
-	// ListCommitStream.
-	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error)
-	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
-	ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error)
-	// DeleteCommit deletes a commit.
-	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// FlushCommit waits for downstream commits to finish
-	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
-	// SubscribeCommit subscribes for new commits on a given branch
-	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
-	// BuildCommit builds a commit that's backed by the given tree
-	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
-	// CreateBranch creates a new branch
-	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// File rpcs
-	// PutFile writes the specified file to pfs.
-	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
-	// CopyFile copies the contents of one file to another.
-	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// GetFile returns a byte stream of the contents of the file.
-	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
-	// InspectFile returns info about a file.
-	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
-	// ListFile returns info about all files. This is deprecated in favor of
-	// ListFileStream
-	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
-	// ListFileStream is a streaming version of ListFile
-	// TODO(msteffen): When the dash has been updated to use ListFileStream,
-	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error)
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
-	// GlobFile returns info about all files. This is deprecated in favor of
-	// GlobFileStream
-	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
-	// GlobFileStream is a streaming version of GlobFile
-	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
-	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
-	// DeleteFile deletes a file.
-	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-	// Fsck does a file system consistency check for pfs
-	Fsck(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-}
+const (
+	IndexStatus_INDEX_NONE    IndexStatus = 0
+	IndexStatus_INDEX_PENDING IndexStatus = 1
+	IndexStatus_INDEX_DONE    IndexStatus = 2
+	IndexStatus_INDEX_FAILED  IndexStatus = 3
+)
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+var IndexStatus_name = map[int32]string{
+	0: "INDEX_NONE",
+	1: "INDEX_PENDING",
+	2: "INDEX_DONE",
+	3: "INDEX_FAILED",
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+var IndexStatus_value = map[string]int32{
+	"INDEX_NONE":    0,
+	"INDEX_PENDING": 1,
+	"INDEX_DONE":    2,
+	"INDEX_FAILED":  3,
 }
 
-func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x IndexStatus) String() string {
+	return proto.EnumName(IndexStatus_name, int32(x))
 }
 
-func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
-	out := new(RepoInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (IndexStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{4}
 }
 
-func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error) {
-	out := new(ListRepoResponse)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RepoInfo is the main data structure representing a Repo in etcd
+// RepoQuota limits how much data a repo can hold. A zero value for any limit
+// means that limit is not enforced.
+type RepoQuota struct {
+	MaxSizeBytes         uint64   `protobuf:"varint,1,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	MaxFileCount         uint64   `protobuf:"varint,2,opt,name=max_file_count,json=maxFileCount,proto3" json:"max_file_count,omitempty"`
+	MaxCommits           uint64   `protobuf:"varint,3,opt,name=max_commits,json=maxCommits,proto3" json:"max_commits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RepoQuota) Reset()         { *m = RepoQuota{} }
+func (m *RepoQuota) String() string { return proto.CompactTextString(m) }
+func (*RepoQuota) ProtoMessage()    {}
+func (*RepoQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{65}
+}
+func (m *RepoQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RepoQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoQuota.Merge(m, src)
+}
+func (m *RepoQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoQuota.DiscardUnknown(m)
 }
 
-func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/pfs.API/StartCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RepoQuota proto.InternalMessageInfo
+
+func (m *RepoQuota) GetMaxSizeBytes() uint64 {
+	if m != nil {
+		return m.MaxSizeBytes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RepoQuota) GetMaxFileCount() uint64 {
+	if m != nil {
+		return m.MaxFileCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
-	out := new(CommitInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RepoQuota) GetMaxCommits() uint64 {
+	if m != nil {
+		return m.MaxCommits
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error) {
-	out := new(CommitInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// BandwidthLimit caps the PutFile/GetFile throughput of a principal or a
+// repo (exactly one of the two is set). Limits are configured over the admin
+// API ('pachctl admin set-bandwidth-limit') and enforced server-side with
+// token buckets, so a bulk backfill can't starve interactive users or
+// pipeline traffic.
+type BandwidthLimit struct {
+	Principal            string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Repo                 string   `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	BytesPerSec          int64    `protobuf:"varint,3,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs.API/ListCommitStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListCommitStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *BandwidthLimit) Reset()         { *m = BandwidthLimit{} }
+func (m *BandwidthLimit) String() string { return proto.CompactTextString(m) }
+func (*BandwidthLimit) ProtoMessage()    {}
+func (*BandwidthLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{66}
+}
+func (m *BandwidthLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BandwidthLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BandwidthLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_ListCommitStreamClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *BandwidthLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandwidthLimit.Merge(m, src)
 }
-
-type aPIListCommitStreamClient struct {
-	grpc.ClientStream
+func (m *BandwidthLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BandwidthLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandwidthLimit.DiscardUnknown(m)
 }
 
-func (x *aPIListCommitStreamClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_BandwidthLimit proto.InternalMessageInfo
+
+func (m *BandwidthLimit) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BandwidthLimit) GetRepo() string {
+	if m != nil {
+		return m.Repo
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs.API/FlushCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIFlushCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *BandwidthLimit) GetBytesPerSec() int64 {
+	if m != nil {
+		return m.BytesPerSec
 	}
-	return x, nil
+	return 0
 }
 
-type API_FlushCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+// SchemaColumn is one column of a columnar file's extracted schema.
+type SchemaColumn struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the column's type: "bool", "int", "float" or "string".
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIFlushCommitClient struct {
-	grpc.ClientStream
+func (m *SchemaColumn) Reset()         { *m = SchemaColumn{} }
+func (m *SchemaColumn) String() string { return proto.CompactTextString(m) }
+func (*SchemaColumn) ProtoMessage()    {}
+func (*SchemaColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{67}
 }
-
-func (x *aPIFlushCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *SchemaColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs.API/SubscribeCommit", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPISubscribeCommitClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *SchemaColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_SubscribeCommitClient interface {
-	Recv() (*CommitInfo, error)
-	grpc.ClientStream
+func (m *SchemaColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaColumn.Merge(m, src)
 }
-
-type aPISubscribeCommitClient struct {
-	grpc.ClientStream
+func (m *SchemaColumn) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaColumn.DiscardUnknown(m)
 }
 
-func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
-	m := new(CommitInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_SchemaColumn proto.InternalMessageInfo
+
+func (m *SchemaColumn) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
-	out := new(Commit)
-	err := c.cc.Invoke(ctx, "/pfs.API/BuildCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SchemaColumn) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/CreateBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// FileSchema is the schema extracted from a columnar file.
+type FileSchema struct {
+	// format is the detected file format: "csv", "tsv" or "parquet".
+	Format  string          `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Columns []*SchemaColumn `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	// row_count is the number of data rows in the file, or -1 if it wasn't
+	// determined (e.g. only the head of a large CSV file was sampled).
+	RowCount             int64    `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
-	out := new(BranchInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileSchema) Reset()         { *m = FileSchema{} }
+func (m *FileSchema) String() string { return proto.CompactTextString(m) }
+func (*FileSchema) ProtoMessage()    {}
+func (*FileSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{68}
+}
+func (m *FileSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *FileSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSchema.Merge(m, src)
+}
+func (m *FileSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSchema.DiscardUnknown(m)
 }
 
-func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error) {
-	out := new(BranchInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FileSchema proto.InternalMessageInfo
+
+func (m *FileSchema) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FileSchema) GetColumns() []*SchemaColumn {
+	if m != nil {
+		return m.Columns
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs.API/PutFile", opts...)
-	if err != nil {
-		return nil, err
+func (m *FileSchema) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
 	}
-	x := &aPIPutFileClient{stream}
-	return x, nil
+	return 0
 }
 
-type API_PutFileClient interface {
-	Send(*PutFileRequest) error
-	CloseAndRecv() (*types.Empty, error)
-	grpc.ClientStream
+// GetFileFilter configures a server-side content filter on GetFile: file data
+// is rewritten by the named filter before it's returned to the caller, so
+// restricted users work with a sanitized view of the repo while privileged
+// users still see the raw bytes. Note that offset and size in GetFileRequest
+// apply to the raw content, before filtering.
+type GetFileFilter struct {
+	// filter names a registered filter plugin
+	// (src/server/pkg/filefilter). "csv-mask", which replaces the values of the
+	// columns named in options["columns"] with options["replacement"] (default
+	// "[REDACTED]"), is built in.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// path_glob selects the files the filter applies to, e.g. "/**.csv".
+	PathGlob string `protobuf:"bytes,2,opt,name=path_glob,json=pathGlob,proto3" json:"path_glob,omitempty"`
+	// options holds filter-specific settings.
+	Options map[string]string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Callers whose access level on the repo is at least raw_scope (default
+	// OWNER) bypass the filter and read the raw content.
+	RawScope             auth.Scope `protobuf:"varint,4,opt,name=raw_scope,json=rawScope,proto3,enum=auth.Scope" json:"raw_scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-type aPIPutFileClient struct {
-	grpc.ClientStream
+func (m *GetFileFilter) Reset()         { *m = GetFileFilter{} }
+func (m *GetFileFilter) String() string { return proto.CompactTextString(m) }
+func (*GetFileFilter) ProtoMessage()    {}
+func (*GetFileFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{69}
 }
-
-func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *GetFileFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *aPIPutFileClient) CloseAndRecv() (*types.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(types.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetFileFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFileFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/CopyFile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetFileFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFileFilter.Merge(m, src)
 }
-
-func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs.API/GetFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *GetFileFilter) XXX_Size() int {
+	return m.Size()
 }
-
-type API_GetFileClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+func (m *GetFileFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFileFilter.DiscardUnknown(m)
 }
 
-type aPIGetFileClient struct {
-	grpc.ClientStream
-}
+var xxx_messageInfo_GetFileFilter proto.InternalMessageInfo
 
-func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetFileFilter) GetFilter() string {
+	if m != nil {
+		return m.Filter
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
-	out := new(FileInfo)
-	err := c.cc.Invoke(ctx, "/pfs.API/InspectFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetFileFilter) GetPathGlob() string {
+	if m != nil {
+		return m.PathGlob
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
-	out := new(FileInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/ListFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetFileFilter) GetOptions() map[string]string {
+	if m != nil {
+		return m.Options
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs.API/ListFileStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListFileStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GetFileFilter) GetRawScope() auth.Scope {
+	if m != nil {
+		return m.RawScope
 	}
-	return x, nil
+	return auth.Scope_NONE
 }
 
-type API_ListFileStreamClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+type ResumeFinishRequest struct {
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIListFileStreamClient struct {
-	grpc.ClientStream
+func (m *ResumeFinishRequest) Reset()         { *m = ResumeFinishRequest{} }
+func (m *ResumeFinishRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeFinishRequest) ProtoMessage()    {}
+func (*ResumeFinishRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{70}
 }
-
-func (x *aPIListFileStreamClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ResumeFinishRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeFinishRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeFinishRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ResumeFinishRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeFinishRequest.Merge(m, src)
+}
+func (m *ResumeFinishRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeFinishRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeFinishRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs.API/WalkFile", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIWalkFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ResumeFinishRequest proto.InternalMessageInfo
+
+func (m *ResumeFinishRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return x, nil
+	return nil
 }
 
-type API_WalkFileClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+// CommitSetInfo groups the commits produced across repos by one triggering
+// commit: the triggering commit itself plus every commit that has it in its
+// provenance.
+type CommitSetInfo struct {
+	// ID of the commit set, which is the ID of the commit that triggered it.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The triggering commit.
+	Commit *Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// All commits in the set, the triggering commit first.
+	Commits              []*CommitInfo `protobuf:"bytes,3,rep,name=commits,proto3" json:"commits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-type aPIWalkFileClient struct {
-	grpc.ClientStream
+func (m *CommitSetInfo) Reset()         { *m = CommitSetInfo{} }
+func (m *CommitSetInfo) String() string { return proto.CompactTextString(m) }
+func (*CommitSetInfo) ProtoMessage()    {}
+func (*CommitSetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{71}
 }
-
-func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *CommitSetInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitSetInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *CommitSetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitSetInfo.Merge(m, src)
+}
+func (m *CommitSetInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitSetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitSetInfo.DiscardUnknown(m)
 }
 
-func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
-	out := new(FileInfos)
-	err := c.cc.Invoke(ctx, "/pfs.API/GlobFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CommitSetInfo proto.InternalMessageInfo
+
+func (m *CommitSetInfo) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs.API/GlobFileStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGlobFileStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CommitSetInfo) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return x, nil
+	return nil
 }
 
-type API_GlobFileStreamClient interface {
-	Recv() (*FileInfo, error)
-	grpc.ClientStream
+func (m *CommitSetInfo) GetCommits() []*CommitInfo {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
 }
 
-type aPIGlobFileStreamClient struct {
-	grpc.ClientStream
+type InspectCommitSetRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *aPIGlobFileStreamClient) Recv() (*FileInfo, error) {
-	m := new(FileInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *InspectCommitSetRequest) Reset()         { *m = InspectCommitSetRequest{} }
+func (m *InspectCommitSetRequest) String() string { return proto.CompactTextString(m) }
+func (*InspectCommitSetRequest) ProtoMessage()    {}
+func (*InspectCommitSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{72}
+}
+func (m *InspectCommitSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InspectCommitSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InspectCommitSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *InspectCommitSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InspectCommitSetRequest.Merge(m, src)
+}
+func (m *InspectCommitSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InspectCommitSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InspectCommitSetRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
-	out := new(DiffFileResponse)
-	err := c.cc.Invoke(ctx, "/pfs.API/DiffFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InspectCommitSetRequest proto.InternalMessageInfo
+
+func (m *InspectCommitSetRequest) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ListCommitSetRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCommitSetRequest) Reset()         { *m = ListCommitSetRequest{} }
+func (m *ListCommitSetRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommitSetRequest) ProtoMessage()    {}
+func (*ListCommitSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{73}
+}
+func (m *ListCommitSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCommitSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCommitSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListCommitSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommitSetRequest.Merge(m, src)
+}
+func (m *ListCommitSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCommitSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommitSetRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListCommitSetRequest proto.InternalMessageInfo
+
+type DeleteCommitSetRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DryRun reports what would be deleted without deleting anything.
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCommitSetRequest) Reset()         { *m = DeleteCommitSetRequest{} }
+func (m *DeleteCommitSetRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommitSetRequest) ProtoMessage()    {}
+func (*DeleteCommitSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{74}
+}
+func (m *DeleteCommitSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCommitSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCommitSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteCommitSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommitSetRequest.Merge(m, src)
+}
+func (m *DeleteCommitSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCommitSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommitSetRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) Fsck(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.API/Fsck", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteCommitSetRequest proto.InternalMessageInfo
+
+func (m *DeleteCommitSetRequest) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return ""
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	// Repo rpcs
-	// CreateRepo creates a new repo.
-	// An error is returned if the repo already exists.
-	CreateRepo(context.Context, *CreateRepoRequest) (*types.Empty, error)
-	// InspectRepo returns info about a repo.
-	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
-	// ListRepo returns info about all repos.
-	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
-	// DeleteRepo deletes a repo.
-	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
-	// Commit rpcs
-	// StartCommit creates a new write commit from a parent commit.
-	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
-	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(context.Context, *FinishCommitRequest) (*types.Empty, error)
-	// InspectCommit returns the info about a commit.
-	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
-	// ListCommit returns info about all commits. This is deprecated in favor of
-	// ListCommitStream.
-	ListCommit(context.Context, *ListCommitRequest) (*CommitInfos, error)
-	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
-	ListCommitStream(*ListCommitRequest, API_ListCommitStreamServer) error
-	// DeleteCommit deletes a commit.
-	DeleteCommit(context.Context, *DeleteCommitRequest) (*types.Empty, error)
-	// FlushCommit waits for downstream commits to finish
-	FlushCommit(*FlushCommitRequest, API_FlushCommitServer) error
-	// SubscribeCommit subscribes for new commits on a given branch
-	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
-	// BuildCommit builds a commit that's backed by the given tree
-	BuildCommit(context.Context, *BuildCommitRequest) (*Commit, error)
-	// CreateBranch creates a new branch
-	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
-	// InspectBranch returns info about a branch.
-	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
-	// ListBranch returns info about the heads of branches.
-	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
-	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
-	// File rpcs
-	// PutFile writes the specified file to pfs.
-	PutFile(API_PutFileServer) error
-	// CopyFile copies the contents of one file to another.
-	CopyFile(context.Context, *CopyFileRequest) (*types.Empty, error)
-	// GetFile returns a byte stream of the contents of the file.
-	GetFile(*GetFileRequest, API_GetFileServer) error
-	// InspectFile returns info about a file.
-	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
-	// ListFile returns info about all files. This is deprecated in favor of
-	// ListFileStream
-	ListFile(context.Context, *ListFileRequest) (*FileInfos, error)
-	// ListFileStream is a streaming version of ListFile
-	// TODO(msteffen): When the dash has been updated to use ListFileStream,
-	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	ListFileStream(*ListFileRequest, API_ListFileStreamServer) error
-	// WalkFile walks over all the files under a directory, including children of children.
-	WalkFile(*WalkFileRequest, API_WalkFileServer) error
-	// GlobFile returns info about all files. This is deprecated in favor of
-	// GlobFileStream
-	GlobFile(context.Context, *GlobFileRequest) (*FileInfos, error)
-	// GlobFileStream is a streaming version of GlobFile
-	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
-	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
-	GlobFileStream(*GlobFileRequest, API_GlobFileStreamServer) error
-	// DiffFile returns the differences between 2 paths at 2 commits.
-	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
-	// DeleteFile deletes a file.
-	DeleteFile(context.Context, *DeleteFileRequest) (*types.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
-	// Fsck does a file system consistency check for pfs
-	Fsck(context.Context, *types.Empty) (*types.Empty, error)
+func (m *DeleteCommitSetRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+// CommitTagInfo is an immutable named reference ("tag") to a commit. Unlike
+// a branch head, a tag can never be re-pointed at a different commit, so it
+// gives a dataset version a stable name that's independent of branch
+// movement. A tag can be used anywhere a commit ID is accepted.
+type CommitTagInfo struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Commit               *Commit          `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Created              *types.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/CreateRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+func (m *CommitTagInfo) Reset()         { *m = CommitTagInfo{} }
+func (m *CommitTagInfo) String() string { return proto.CompactTextString(m) }
+func (*CommitTagInfo) ProtoMessage()    {}
+func (*CommitTagInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{75}
+}
+func (m *CommitTagInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitTagInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitTagInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CommitTagInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTagInfo.Merge(m, src)
+}
+func (m *CommitTagInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitTagInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTagInfo.DiscardUnknown(m)
 }
 
-func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+var xxx_messageInfo_CommitTagInfo proto.InternalMessageInfo
+
+func (m *CommitTagInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _API_ListRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListRepo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListRepo(ctx, req.(*ListRepoRequest))
+func (m *CommitTagInfo) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRepoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteRepo(ctx, in)
+func (m *CommitTagInfo) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteRepo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).StartCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/StartCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CommitTagInfos struct {
+	TagInfo              []*CommitTagInfo `protobuf:"bytes,1,rep,name=tag_info,json=tagInfo,proto3" json:"tag_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FinishCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).FinishCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/FinishCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
+func (m *CommitTagInfos) Reset()         { *m = CommitTagInfos{} }
+func (m *CommitTagInfos) String() string { return proto.CompactTextString(m) }
+func (*CommitTagInfos) ProtoMessage()    {}
+func (*CommitTagInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{76}
+}
+func (m *CommitTagInfos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitTagInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitTagInfos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CommitTagInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTagInfos.Merge(m, src)
+}
+func (m *CommitTagInfos) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitTagInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTagInfos.DiscardUnknown(m)
 }
 
-func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+var xxx_messageInfo_CommitTagInfos proto.InternalMessageInfo
+
+func (m *CommitTagInfos) GetTagInfo() []*CommitTagInfo {
+	if m != nil {
+		return m.TagInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_ListCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListCommit(ctx, req.(*ListCommitRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CreateCommitTagRequest struct {
+	// The commit being tagged. It may itself be named by a branch or a tag,
+	// which is resolved when the tag is created.
+	Commit               *Commit  `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_ListCommitStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *CreateCommitTagRequest) Reset()         { *m = CreateCommitTagRequest{} }
+func (m *CreateCommitTagRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCommitTagRequest) ProtoMessage()    {}
+func (*CreateCommitTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{77}
+}
+func (m *CreateCommitTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateCommitTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateCommitTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(APIServer).ListCommitStream(m, &aPIListCommitStreamServer{stream})
 }
-
-type API_ListCommitStreamServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+func (m *CreateCommitTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCommitTagRequest.Merge(m, src)
 }
-
-type aPIListCommitStreamServer struct {
-	grpc.ServerStream
+func (m *CreateCommitTagRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIListCommitStreamServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *CreateCommitTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCommitTagRequest.DiscardUnknown(m)
 }
 
-func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteCommit(ctx, req.(*DeleteCommitRequest))
+var xxx_messageInfo_CreateCommitTagRequest proto.InternalMessageInfo
+
+func (m *CreateCommitTagRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FlushCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *CreateCommitTagRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return srv.(APIServer).FlushCommit(m, &aPIFlushCommitServer{stream})
+	return ""
 }
 
-type API_FlushCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+type ListCommitTagRequest struct {
+	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIFlushCommitServer struct {
-	grpc.ServerStream
+func (m *ListCommitTagRequest) Reset()         { *m = ListCommitTagRequest{} }
+func (m *ListCommitTagRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommitTagRequest) ProtoMessage()    {}
+func (*ListCommitTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{78}
 }
-
-func (x *aPIFlushCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *ListCommitTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *ListCommitTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCommitTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
 }
-
-type API_SubscribeCommitServer interface {
-	Send(*CommitInfo) error
-	grpc.ServerStream
+func (m *ListCommitTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommitTagRequest.Merge(m, src)
+}
+func (m *ListCommitTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCommitTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommitTagRequest.DiscardUnknown(m)
 }
 
-type aPISubscribeCommitServer struct {
-	grpc.ServerStream
+var xxx_messageInfo_ListCommitTagRequest proto.InternalMessageInfo
+
+func (m *ListCommitTagRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
 }
 
-func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
-	return x.ServerStream.SendMsg(m)
+type DeleteCommitTagRequest struct {
+	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_BuildCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BuildCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).BuildCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/BuildCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).BuildCommit(ctx, req.(*BuildCommitRequest))
+func (m *DeleteCommitTagRequest) Reset()         { *m = DeleteCommitTagRequest{} }
+func (m *DeleteCommitTagRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCommitTagRequest) ProtoMessage()    {}
+func (*DeleteCommitTagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{79}
+}
+func (m *DeleteCommitTagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCommitTagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCommitTagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteCommitTagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCommitTagRequest.Merge(m, src)
+}
+func (m *DeleteCommitTagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCommitTagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCommitTagRequest.DiscardUnknown(m)
 }
 
-func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBranchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/CreateBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+var xxx_messageInfo_DeleteCommitTagRequest proto.InternalMessageInfo
+
+func (m *DeleteCommitTagRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectBranchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectBranch(ctx, in)
+func (m *DeleteCommitTagRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _API_ListBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBranchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListBranch(ctx, req.(*ListBranchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type SubscribeFilesRequest struct {
+	Repo   *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// pattern is a glob pattern; only files whose paths match it are streamed.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// only changes in commits created since this commit are returned
+	From                 *Commit  `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBranchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteBranch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteBranch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
+func (m *SubscribeFilesRequest) Reset()         { *m = SubscribeFilesRequest{} }
+func (m *SubscribeFilesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeFilesRequest) ProtoMessage()    {}
+func (*SubscribeFilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{80}
+}
+func (m *SubscribeFilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeFilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeFilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
+func (m *SubscribeFilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeFilesRequest.Merge(m, src)
 }
-
-type API_PutFileServer interface {
-	SendAndClose(*types.Empty) error
-	Recv() (*PutFileRequest, error)
-	grpc.ServerStream
+func (m *SubscribeFilesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type aPIPutFileServer struct {
-	grpc.ServerStream
+func (m *SubscribeFilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeFilesRequest.DiscardUnknown(m)
 }
 
-func (x *aPIPutFileServer) SendAndClose(m *types.Empty) error {
-	return x.ServerStream.SendMsg(m)
-}
+var xxx_messageInfo_SubscribeFilesRequest proto.InternalMessageInfo
 
-func (x *aPIPutFileServer) Recv() (*PutFileRequest, error) {
-	m := new(PutFileRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SubscribeFilesRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
 	}
-	return m, nil
+	return nil
 }
 
-func _API_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CopyFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).CopyFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/CopyFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CopyFile(ctx, req.(*CopyFileRequest))
+func (m *SubscribeFilesRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *SubscribeFilesRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
+	return ""
 }
 
-type API_GetFileServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+func (m *SubscribeFilesRequest) GetFrom() *Commit {
+	if m != nil {
+		return m.From
+	}
+	return nil
 }
 
-type aPIGetFileServer struct {
-	grpc.ServerStream
+type FindFileRequest struct {
+	// Commit is the commit to search.
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Path restricts the search to files under it ("" means the whole repo).
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Name is a glob pattern matched against each file's base name (e.g.
+	// "*.csv"); "" matches every name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// MinSizeBytes and MaxSizeBytes bound the size of returned files; 0 means
+	// no bound.
+	MinSizeBytes uint64 `protobuf:"varint,4,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	MaxSizeBytes uint64 `protobuf:"varint,5,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	// ModifiedSince, if set, restricts results to files added or modified by a
+	// commit that finished after it.
+	ModifiedSince        *types.Timestamp `protobuf:"bytes,6,opt,name=modified_since,json=modifiedSince,proto3" json:"modified_since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (x *aPIGetFileServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (m *FindFileRequest) Reset()         { *m = FindFileRequest{} }
+func (m *FindFileRequest) String() string { return proto.CompactTextString(m) }
+func (*FindFileRequest) ProtoMessage()    {}
+func (*FindFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{81}
 }
-
-func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/InspectFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
+func (m *FindFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *FindFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindFileRequest.Merge(m, src)
+}
+func (m *FindFileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindFileRequest.DiscardUnknown(m)
 }
 
-func _API_ListFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/ListFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListFile(ctx, req.(*ListFileRequest))
+var xxx_messageInfo_FindFileRequest proto.InternalMessageInfo
+
+func (m *FindFileRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_ListFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *FindFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return srv.(APIServer).ListFileStream(m, &aPIListFileStreamServer{stream})
+	return ""
 }
 
-type API_ListFileStreamServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (m *FindFileRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type aPIListFileStreamServer struct {
-	grpc.ServerStream
+func (m *FindFileRequest) GetMinSizeBytes() uint64 {
+	if m != nil {
+		return m.MinSizeBytes
+	}
+	return 0
 }
 
-func (x *aPIListFileStreamServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *FindFileRequest) GetMaxSizeBytes() uint64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
 }
 
-func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WalkFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *FindFileRequest) GetModifiedSince() *types.Timestamp {
+	if m != nil {
+		return m.ModifiedSince
 	}
-	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
+	return nil
 }
 
-type API_WalkFileServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+type BatchInspectFileRequest struct {
+	// Commit is the commit in which all of the requested paths are inspected.
+	Commit *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Paths are the file paths to inspect. Exactly one of paths and pattern
+	// must be set.
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Pattern is a glob pattern; every file matching it is inspected.
+	Pattern              string   `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type aPIWalkFileServer struct {
-	grpc.ServerStream
+func (m *BatchInspectFileRequest) Reset()         { *m = BatchInspectFileRequest{} }
+func (m *BatchInspectFileRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchInspectFileRequest) ProtoMessage()    {}
+func (*BatchInspectFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{82}
 }
-
-func (x *aPIWalkFileServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *BatchInspectFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _API_GlobFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GlobFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).GlobFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/GlobFile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GlobFile(ctx, req.(*GlobFileRequest))
+func (m *BatchInspectFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchInspectFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _API_GlobFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GlobFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).GlobFileStream(m, &aPIGlobFileStreamServer{stream})
+func (m *BatchInspectFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchInspectFileRequest.Merge(m, src)
 }
-
-type API_GlobFileStreamServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
+func (m *BatchInspectFileRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type aPIGlobFileStreamServer struct {
-	grpc.ServerStream
+func (m *BatchInspectFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchInspectFileRequest.DiscardUnknown(m)
 }
 
-func (x *aPIGlobFileStreamServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
+var xxx_messageInfo_BatchInspectFileRequest proto.InternalMessageInfo
 
-func _API_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiffFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchInspectFileRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DiffFile(ctx, in)
+	return nil
+}
+
+func (m *BatchInspectFileRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DiffFile",
+	return nil
+}
+
+func (m *BatchInspectFileRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DiffFile(ctx, req.(*DiffFileRequest))
+	return ""
+}
+
+type BatchInspectFileResponse struct {
+	// Path is the requested path this entry answers (for pattern requests,
+	// the matched file's path).
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// FileInfo is unset if the path doesn't exist (see error).
+	FileInfo *FileInfo `protobuf:"bytes,2,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	// Error is set (e.g. a file-not-found message) if the path couldn't be
+	// inspected; other paths in the batch are still returned.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchInspectFileResponse) Reset()         { *m = BatchInspectFileResponse{} }
+func (m *BatchInspectFileResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchInspectFileResponse) ProtoMessage()    {}
+func (*BatchInspectFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{83}
+}
+func (m *BatchInspectFileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchInspectFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchInspectFileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BatchInspectFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchInspectFileResponse.Merge(m, src)
+}
+func (m *BatchInspectFileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchInspectFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchInspectFileResponse.DiscardUnknown(m)
 }
 
-func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_BatchInspectFileResponse proto.InternalMessageInfo
+
+func (m *BatchInspectFileResponse) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteFile(ctx, in)
+	return ""
+}
+
+func (m *BatchInspectFileResponse) GetFileInfo() *FileInfo {
+	if m != nil {
+		return m.FileInfo
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteFile",
+	return nil
+}
+
+func (m *BatchInspectFileResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	return ""
+}
+
+type GlobPreviewRequest struct {
+	Commit  *Commit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	Pattern string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// SampleSize caps how many matching files are returned in sample. Zero
+	// means the server default (10).
+	SampleSize           int64    `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GlobPreviewRequest) Reset()         { *m = GlobPreviewRequest{} }
+func (m *GlobPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*GlobPreviewRequest) ProtoMessage()    {}
+func (*GlobPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{84}
+}
+func (m *GlobPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GlobPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobPreviewRequest.Merge(m, src)
+}
+func (m *GlobPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobPreviewRequest.DiscardUnknown(m)
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_GlobPreviewRequest proto.InternalMessageInfo
+
+func (m *GlobPreviewRequest) GetCommit() *Commit {
+	if m != nil {
+		return m.Commit
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
+	return nil
+}
+
+func (m *GlobPreviewRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/DeleteAll",
+	return ""
+}
+
+func (m *GlobPreviewRequest) GetSampleSize() int64 {
+	if m != nil {
+		return m.SampleSize
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	return 0
+}
+
+// GlobPreviewResponse summarizes what a glob pattern matches in a commit: how
+// many files match (the number of datums a pipeline with this glob and input
+// would see), a sample of the matches, and size statistics for estimating
+// datum sizes.
+type GlobPreviewResponse struct {
+	NumMatches           int64       `protobuf:"varint,1,opt,name=num_matches,json=numMatches,proto3" json:"num_matches,omitempty"`
+	Sample               []*FileInfo `protobuf:"bytes,2,rep,name=sample,proto3" json:"sample,omitempty"`
+	TotalSizeBytes       uint64      `protobuf:"varint,3,opt,name=total_size_bytes,json=totalSizeBytes,proto3" json:"total_size_bytes,omitempty"`
+	MinSizeBytes         uint64      `protobuf:"varint,4,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	MaxSizeBytes         uint64      `protobuf:"varint,5,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	AvgSizeBytes         uint64      `protobuf:"varint,6,opt,name=avg_size_bytes,json=avgSizeBytes,proto3" json:"avg_size_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GlobPreviewResponse) Reset()         { *m = GlobPreviewResponse{} }
+func (m *GlobPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*GlobPreviewResponse) ProtoMessage()    {}
+func (*GlobPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{85}
+}
+func (m *GlobPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GlobPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobPreviewResponse.Merge(m, src)
+}
+func (m *GlobPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobPreviewResponse.DiscardUnknown(m)
 }
 
-func _API_Fsck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_GlobPreviewResponse proto.InternalMessageInfo
+
+func (m *GlobPreviewResponse) GetNumMatches() int64 {
+	if m != nil {
+		return m.NumMatches
 	}
-	if interceptor == nil {
-		return srv.(APIServer).Fsck(ctx, in)
+	return 0
+}
+
+func (m *GlobPreviewResponse) GetSample() []*FileInfo {
+	if m != nil {
+		return m.Sample
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.API/Fsck",
+	return nil
+}
+
+func (m *GlobPreviewResponse) GetTotalSizeBytes() uint64 {
+	if m != nil {
+		return m.TotalSizeBytes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Fsck(ctx, req.(*types.Empty))
+	return 0
+}
+
+func (m *GlobPreviewResponse) GetMinSizeBytes() uint64 {
+	if m != nil {
+		return m.MinSizeBytes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pfs.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRepo",
-			Handler:    _API_CreateRepo_Handler,
-		},
-		{
-			MethodName: "InspectRepo",
-			Handler:    _API_InspectRepo_Handler,
-		},
-		{
-			MethodName: "ListRepo",
-			Handler:    _API_ListRepo_Handler,
-		},
-		{
-			MethodName: "DeleteRepo",
-			Handler:    _API_DeleteRepo_Handler,
-		},
-		{
-			MethodName: "StartCommit",
-			Handler:    _API_StartCommit_Handler,
-		},
-		{
-			MethodName: "FinishCommit",
-			Handler:    _API_FinishCommit_Handler,
-		},
-		{
-			MethodName: "InspectCommit",
-			Handler:    _API_InspectCommit_Handler,
-		},
-		{
-			MethodName: "ListCommit",
-			Handler:    _API_ListCommit_Handler,
-		},
-		{
-			MethodName: "DeleteCommit",
-			Handler:    _API_DeleteCommit_Handler,
-		},
-		{
-			MethodName: "BuildCommit",
-			Handler:    _API_BuildCommit_Handler,
-		},
-		{
-			MethodName: "CreateBranch",
-			Handler:    _API_CreateBranch_Handler,
-		},
-		{
-			MethodName: "InspectBranch",
-			Handler:    _API_InspectBranch_Handler,
-		},
-		{
-			MethodName: "ListBranch",
-			Handler:    _API_ListBranch_Handler,
-		},
-		{
-			MethodName: "DeleteBranch",
-			Handler:    _API_DeleteBranch_Handler,
-		},
-		{
-			MethodName: "CopyFile",
-			Handler:    _API_CopyFile_Handler,
-		},
-		{
-			MethodName: "InspectFile",
-			Handler:    _API_InspectFile_Handler,
-		},
-		{
-			MethodName: "ListFile",
-			Handler:    _API_ListFile_Handler,
-		},
-		{
-			MethodName: "GlobFile",
-			Handler:    _API_GlobFile_Handler,
-		},
-		{
-			MethodName: "DiffFile",
-			Handler:    _API_DiffFile_Handler,
-		},
-		{
-			MethodName: "DeleteFile",
-			Handler:    _API_DeleteFile_Handler,
-		},
-		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
-		},
-		{
-			MethodName: "Fsck",
-			Handler:    _API_Fsck_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ListCommitStream",
-			Handler:       _API_ListCommitStream_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "FlushCommit",
-			Handler:       _API_FlushCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "SubscribeCommit",
-			Handler:       _API_SubscribeCommit_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PutFile",
-			Handler:       _API_PutFile_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GetFile",
-			Handler:       _API_GetFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListFileStream",
-			Handler:       _API_ListFileStream_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "WalkFile",
-			Handler:       _API_WalkFile_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GlobFileStream",
-			Handler:       _API_GlobFileStream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "client/pfs/pfs.proto",
+func (m *GlobPreviewResponse) GetMaxSizeBytes() uint64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
 }
 
-// ObjectAPIClient is the client API for ObjectAPI service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ObjectAPIClient interface {
-	PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error)
-	PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error)
-	PutObjects(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectsClient, error)
-	GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error)
-	GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error)
-	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (ObjectAPI_GetBlocksClient, error)
-	TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error)
-	// CheckObject checks if an object exists in the blob store without
-	// actually reading the object.
-	CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error)
-	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error)
-	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error)
-	GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error)
-	InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error)
-	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error)
-	DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error)
-	Compact(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *GlobPreviewResponse) GetAvgSizeBytes() uint64 {
+	if m != nil {
+		return m.AvgSizeBytes
+	}
+	return 0
 }
 
-type objectAPIClient struct {
-	cc *grpc.ClientConn
+type DiffTabularFileRequest struct {
+	NewFile *File `protobuf:"bytes,1,opt,name=new_file,json=newFile,proto3" json:"new_file,omitempty"`
+	// OldFile may be left nil in which case the same path in the parent of
+	// NewFile's commit will be used.
+	OldFile              *File    `protobuf:"bytes,2,opt,name=old_file,json=oldFile,proto3" json:"old_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewObjectAPIClient(cc *grpc.ClientConn) ObjectAPIClient {
-	return &objectAPIClient{cc}
+func (m *DiffTabularFileRequest) Reset()         { *m = DiffTabularFileRequest{} }
+func (m *DiffTabularFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffTabularFileRequest) ProtoMessage()    {}
+func (*DiffTabularFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{86}
 }
-
-func (c *objectAPIClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[0], "/pfs.ObjectAPI/PutObject", opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffTabularFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffTabularFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffTabularFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &objectAPIPutObjectClient{stream}
-	return x, nil
 }
-
-type ObjectAPI_PutObjectClient interface {
-	Send(*PutObjectRequest) error
-	CloseAndRecv() (*Object, error)
-	grpc.ClientStream
+func (m *DiffTabularFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffTabularFileRequest.Merge(m, src)
 }
-
-type objectAPIPutObjectClient struct {
-	grpc.ClientStream
+func (m *DiffTabularFileRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *objectAPIPutObjectClient) Send(m *PutObjectRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *DiffTabularFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffTabularFileRequest.DiscardUnknown(m)
 }
 
-func (x *objectAPIPutObjectClient) CloseAndRecv() (*Object, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(Object)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_DiffTabularFileRequest proto.InternalMessageInfo
+
+func (m *DiffTabularFileRequest) GetNewFile() *File {
+	if m != nil {
+		return m.NewFile
 	}
-	return m, nil
+	return nil
 }
 
-func (c *objectAPIClient) PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[1], "/pfs.ObjectAPI/PutObjectSplit", opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffTabularFileRequest) GetOldFile() *File {
+	if m != nil {
+		return m.OldFile
 	}
-	x := &objectAPIPutObjectSplitClient{stream}
-	return x, nil
+	return nil
 }
 
-type ObjectAPI_PutObjectSplitClient interface {
-	Send(*PutObjectRequest) error
-	CloseAndRecv() (*Objects, error)
-	grpc.ClientStream
+// TabularDiff is a row- and column-level summary of how a tabular (CSV, TSV
+// or Parquet) file changed between two commits.
+type TabularDiff struct {
+	// format is the files' tabular format: "csv", "tsv" or "parquet".
+	Format         string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	RowsAdded      int64    `protobuf:"varint,2,opt,name=rows_added,json=rowsAdded,proto3" json:"rows_added,omitempty"`
+	RowsRemoved    int64    `protobuf:"varint,3,opt,name=rows_removed,json=rowsRemoved,proto3" json:"rows_removed,omitempty"`
+	ColumnsAdded   []string `protobuf:"bytes,4,rep,name=columns_added,json=columnsAdded,proto3" json:"columns_added,omitempty"`
+	ColumnsRemoved []string `protobuf:"bytes,5,rep,name=columns_removed,json=columnsRemoved,proto3" json:"columns_removed,omitempty"`
+	// columns_changed are the columns present in both versions whose values
+	// (or, for parquet, whose types) differ.
+	ColumnsChanged []string `protobuf:"bytes,6,rep,name=columns_changed,json=columnsChanged,proto3" json:"columns_changed,omitempty"`
+	// sampled is true if the files were too large to read entirely, in which
+	// case the row counts are estimates from a bounded prefix of each file.
+	Sampled              bool     `protobuf:"varint,7,opt,name=sampled,proto3" json:"sampled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type objectAPIPutObjectSplitClient struct {
-	grpc.ClientStream
+func (m *TabularDiff) Reset()         { *m = TabularDiff{} }
+func (m *TabularDiff) String() string { return proto.CompactTextString(m) }
+func (*TabularDiff) ProtoMessage()    {}
+func (*TabularDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48f014707f6595c, []int{87}
 }
-
-func (x *objectAPIPutObjectSplitClient) Send(m *PutObjectRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *TabularDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *objectAPIPutObjectSplitClient) CloseAndRecv() (*Objects, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(Objects)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TabularDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TabularDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *objectAPIClient) PutObjects(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[2], "/pfs.ObjectAPI/PutObjects", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &objectAPIPutObjectsClient{stream}
-	return x, nil
+func (m *TabularDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabularDiff.Merge(m, src)
 }
-
-type ObjectAPI_PutObjectsClient interface {
-	Send(*PutObjectRequest) error
-	CloseAndRecv() (*types.Empty, error)
-	grpc.ClientStream
+func (m *TabularDiff) XXX_Size() int {
+	return m.Size()
 }
-
-type objectAPIPutObjectsClient struct {
-	grpc.ClientStream
+func (m *TabularDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabularDiff.DiscardUnknown(m)
 }
 
-func (x *objectAPIPutObjectsClient) Send(m *PutObjectRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
+var xxx_messageInfo_TabularDiff proto.InternalMessageInfo
 
-func (x *objectAPIPutObjectsClient) CloseAndRecv() (*types.Empty, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(types.Empty)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TabularDiff) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	return m, nil
+	return ""
 }
 
-func (c *objectAPIClient) GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[3], "/pfs.ObjectAPI/GetObject", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &objectAPIGetObjectClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *TabularDiff) GetRowsAdded() int64 {
+	if m != nil {
+		return m.RowsAdded
 	}
-	return x, nil
-}
-
-type ObjectAPI_GetObjectClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+	return 0
 }
 
-type objectAPIGetObjectClient struct {
-	grpc.ClientStream
+func (m *TabularDiff) GetRowsRemoved() int64 {
+	if m != nil {
+		return m.RowsRemoved
+	}
+	return 0
 }
 
-func (x *objectAPIGetObjectClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *TabularDiff) GetColumnsAdded() []string {
+	if m != nil {
+		return m.ColumnsAdded
 	}
-	return m, nil
+	return nil
 }
 
-func (c *objectAPIClient) GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[4], "/pfs.ObjectAPI/GetObjects", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &objectAPIGetObjectsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *TabularDiff) GetColumnsRemoved() []string {
+	if m != nil {
+		return m.ColumnsRemoved
 	}
-	return x, nil
+	return nil
 }
 
-type ObjectAPI_GetObjectsClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+func (m *TabularDiff) GetColumnsChanged() []string {
+	if m != nil {
+		return m.ColumnsChanged
+	}
+	return nil
 }
 
-type objectAPIGetObjectsClient struct {
-	grpc.ClientStream
+func (m *TabularDiff) GetSampled() bool {
+	if m != nil {
+		return m.Sampled
+	}
+	return false
 }
 
-func (x *objectAPIGetObjectsClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func init() {
+	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs.CommitState", CommitState_name, CommitState_value)
+	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
+	proto.RegisterEnum("pfs.CommitPhase", CommitPhase_name, CommitPhase_value)
+	proto.RegisterEnum("pfs.IndexStatus", IndexStatus_name, IndexStatus_value)
+	proto.RegisterType((*Repo)(nil), "pfs.Repo")
+	proto.RegisterType((*Branch)(nil), "pfs.Branch")
+	proto.RegisterType((*BranchInfo)(nil), "pfs.BranchInfo")
+	proto.RegisterType((*BranchInfos)(nil), "pfs.BranchInfos")
+	proto.RegisterType((*File)(nil), "pfs.File")
+	proto.RegisterType((*Block)(nil), "pfs.Block")
+	proto.RegisterType((*Object)(nil), "pfs.Object")
+	proto.RegisterType((*Tag)(nil), "pfs.Tag")
+	proto.RegisterType((*RepoInfo)(nil), "pfs.RepoInfo")
+	proto.RegisterType((*RepoAuthInfo)(nil), "pfs.RepoAuthInfo")
+	proto.RegisterType((*Commit)(nil), "pfs.Commit")
+	proto.RegisterType((*CommitRange)(nil), "pfs.CommitRange")
+	proto.RegisterType((*CommitProvenance)(nil), "pfs.CommitProvenance")
+	proto.RegisterType((*CommitInfo)(nil), "pfs.CommitInfo")
+	proto.RegisterType((*FileInfo)(nil), "pfs.FileInfo")
+	proto.RegisterType((*ByteRange)(nil), "pfs.ByteRange")
+	proto.RegisterType((*BlockRef)(nil), "pfs.BlockRef")
+	proto.RegisterType((*ObjectInfo)(nil), "pfs.ObjectInfo")
+	proto.RegisterType((*CreateRepoRequest)(nil), "pfs.CreateRepoRequest")
+	proto.RegisterType((*InspectRepoRequest)(nil), "pfs.InspectRepoRequest")
+	proto.RegisterType((*ListRepoRequest)(nil), "pfs.ListRepoRequest")
+	proto.RegisterType((*ListRepoResponse)(nil), "pfs.ListRepoResponse")
+	proto.RegisterType((*DeleteRepoRequest)(nil), "pfs.DeleteRepoRequest")
+	proto.RegisterType((*StartCommitRequest)(nil), "pfs.StartCommitRequest")
+	proto.RegisterType((*BuildCommitRequest)(nil), "pfs.BuildCommitRequest")
+	proto.RegisterType((*FinishCommitRequest)(nil), "pfs.FinishCommitRequest")
+	proto.RegisterType((*InspectCommitRequest)(nil), "pfs.InspectCommitRequest")
+	proto.RegisterType((*ListCommitRequest)(nil), "pfs.ListCommitRequest")
+	proto.RegisterType((*CommitInfos)(nil), "pfs.CommitInfos")
+	proto.RegisterType((*CreateBranchRequest)(nil), "pfs.CreateBranchRequest")
+	proto.RegisterType((*InspectBranchRequest)(nil), "pfs.InspectBranchRequest")
+	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
+	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
+	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
+	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
+	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
+	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
+	proto.RegisterType((*OverwriteIndex)(nil), "pfs.OverwriteIndex")
+	proto.RegisterType((*PutFileRequest)(nil), "pfs.PutFileRequest")
+	proto.RegisterType((*PutFileRecord)(nil), "pfs.PutFileRecord")
+	proto.RegisterType((*PutFileRecords)(nil), "pfs.PutFileRecords")
+	proto.RegisterType((*CopyFileRequest)(nil), "pfs.CopyFileRequest")
+	proto.RegisterType((*InspectFileRequest)(nil), "pfs.InspectFileRequest")
+	proto.RegisterType((*ListFileRequest)(nil), "pfs.ListFileRequest")
+	proto.RegisterType((*WalkFileRequest)(nil), "pfs.WalkFileRequest")
+	proto.RegisterType((*GlobFileRequest)(nil), "pfs.GlobFileRequest")
+	proto.RegisterType((*FileInfos)(nil), "pfs.FileInfos")
+	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
+	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
+	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
+	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
+	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
+	proto.RegisterType((*GetBlocksRequest)(nil), "pfs.GetBlocksRequest")
+	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
+	proto.RegisterType((*ListObjectsRequest)(nil), "pfs.ListObjectsRequest")
+	proto.RegisterType((*ListTagsRequest)(nil), "pfs.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "pfs.ListTagsResponse")
+	proto.RegisterType((*DeleteObjectsRequest)(nil), "pfs.DeleteObjectsRequest")
+	proto.RegisterType((*DeleteObjectsResponse)(nil), "pfs.DeleteObjectsResponse")
+	proto.RegisterType((*DeleteTagsRequest)(nil), "pfs.DeleteTagsRequest")
+	proto.RegisterType((*DeleteTagsResponse)(nil), "pfs.DeleteTagsResponse")
+	proto.RegisterType((*CheckObjectRequest)(nil), "pfs.CheckObjectRequest")
+	proto.RegisterType((*CheckObjectResponse)(nil), "pfs.CheckObjectResponse")
+	proto.RegisterType((*Objects)(nil), "pfs.Objects")
+	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
+	proto.RegisterMapType((map[string]*BlockRef)(nil), "pfs.ObjectIndex.ObjectsEntry")
+	proto.RegisterMapType((map[string]*Object)(nil), "pfs.ObjectIndex.TagsEntry")
+	proto.RegisterType((*RepoQuota)(nil), "pfs.RepoQuota")
+	proto.RegisterType((*BandwidthLimit)(nil), "pfs.BandwidthLimit")
+	proto.RegisterType((*SchemaColumn)(nil), "pfs.SchemaColumn")
+	proto.RegisterType((*FileSchema)(nil), "pfs.FileSchema")
+	proto.RegisterType((*GetFileFilter)(nil), "pfs.GetFileFilter")
+	proto.RegisterMapType((map[string]string)(nil), "pfs.GetFileFilter.OptionsEntry")
+	proto.RegisterType((*ResumeFinishRequest)(nil), "pfs.ResumeFinishRequest")
+	proto.RegisterType((*CommitSetInfo)(nil), "pfs.CommitSetInfo")
+	proto.RegisterType((*InspectCommitSetRequest)(nil), "pfs.InspectCommitSetRequest")
+	proto.RegisterType((*ListCommitSetRequest)(nil), "pfs.ListCommitSetRequest")
+	proto.RegisterType((*DeleteCommitSetRequest)(nil), "pfs.DeleteCommitSetRequest")
+	proto.RegisterType((*CommitTagInfo)(nil), "pfs.CommitTagInfo")
+	proto.RegisterType((*CommitTagInfos)(nil), "pfs.CommitTagInfos")
+	proto.RegisterType((*CreateCommitTagRequest)(nil), "pfs.CreateCommitTagRequest")
+	proto.RegisterType((*ListCommitTagRequest)(nil), "pfs.ListCommitTagRequest")
+	proto.RegisterType((*DeleteCommitTagRequest)(nil), "pfs.DeleteCommitTagRequest")
+	proto.RegisterType((*SubscribeFilesRequest)(nil), "pfs.SubscribeFilesRequest")
+	proto.RegisterType((*FindFileRequest)(nil), "pfs.FindFileRequest")
+	proto.RegisterType((*BatchInspectFileRequest)(nil), "pfs.BatchInspectFileRequest")
+	proto.RegisterType((*BatchInspectFileResponse)(nil), "pfs.BatchInspectFileResponse")
+	proto.RegisterType((*GlobPreviewRequest)(nil), "pfs.GlobPreviewRequest")
+	proto.RegisterType((*GlobPreviewResponse)(nil), "pfs.GlobPreviewResponse")
+	proto.RegisterType((*DiffTabularFileRequest)(nil), "pfs.DiffTabularFileRequest")
+	proto.RegisterType((*TabularDiff)(nil), "pfs.TabularDiff")
 }
 
-func (c *objectAPIClient) GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (ObjectAPI_GetBlocksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[5], "/pfs.ObjectAPI/GetBlocks", opts...)
+func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptor_b48f014707f6595c) }
+
+var fileDescriptor_b48f014707f6595c = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x3b,
+	0x4d, 0x73, 0x1b, 0xd9, 0x71, 0xc2, 0x07, 0xf1, 0xd1, 0x00, 0x01, 0x68,
+	0x44, 0x51, 0x5c, 0x68, 0xbf, 0x3c, 0x76, 0xe2, 0x5d, 0x39, 0x4b, 0xc9,
+	0x92, 0xd7, 0x96, 0x56, 0x5e, 0xab, 0xf8, 0xa5, 0x15, 0x1d, 0x59, 0xe2,
+	0x0e, 0x94, 0x8d, 0xed, 0x72, 0x8c, 0x1a, 0x02, 0x03, 0x72, 0x2c, 0x00,
+	0x83, 0x9d, 0x19, 0x88, 0x62, 0x0e, 0xb9, 0xa5, 0x92, 0x1c, 0x7c, 0xb4,
+	0xcb, 0x55, 0xbe, 0xb8, 0xca, 0x55, 0xae, 0xca, 0x2d, 0xff, 0x20, 0xd7,
+	0x1c, 0x73, 0xc8, 0x39, 0xe5, 0x72, 0x2a, 0x47, 0xdf, 0x93, 0x9c, 0xd2,
+	0xef, 0x75, 0xbf, 0x99, 0x37, 0x1f, 0x20, 0xc0, 0xb5, 0x7d, 0x20, 0x39,
+	0xd3, 0xaf, 0x5f, 0xbf, 0x7e, 0xfd, 0xfa, 0xf5, 0xe7, 0x10, 0x36, 0x06,
+	0x63, 0xd7, 0x99, 0x86, 0xb7, 0x67, 0xa3, 0x40, 0xfc, 0x6c, 0xcf, 0x7c,
+	0x2f, 0xf4, 0x8c, 0x12, 0x3e, 0x76, 0x6f, 0x9e, 0x78, 0xde, 0xc9, 0xd8,
+	0xb9, 0x2d, 0x41, 0xc7, 0xf3, 0xd1, 0x6d, 0x67, 0x32, 0x0b, 0xcf, 0x09,
+	0xa3, 0xfb, 0x4e, 0x7a, 0x30, 0x74, 0x27, 0x4e, 0x10, 0xda, 0x93, 0x19,
+	0x23, 0xbc, 0x9d, 0x46, 0x38, 0xf3, 0xed, 0xd9, 0xcc, 0xf1, 0x79, 0x89,
+	0xee, 0xc6, 0x89, 0x77, 0xe2, 0xc9, 0xc7, 0xdb, 0xe2, 0x89, 0xa1, 0x9b,
+	0xcc, 0x8e, 0x3d, 0x0f, 0x4f, 0xe5, 0x2f, 0x82, 0x9b, 0x5d, 0x28, 0x5b,
+	0xce, 0xcc, 0x33, 0x0c, 0x28, 0x4f, 0xed, 0x89, 0xb3, 0x55, 0x78, 0xb7,
+	0xf0, 0x5e, 0xdd, 0x92, 0xcf, 0xe6, 0x43, 0xa8, 0xec, 0xfa, 0xf6, 0x74,
+	0x70, 0x6a, 0xbc, 0x05, 0x65, 0x1f, 0xb1, 0xe4, 0x68, 0xe3, 0x6e, 0x7d,
+	0x5b, 0x6c, 0x48, 0x4c, 0xb3, 0x24, 0x38, 0x9a, 0x5c, 0xd4, 0x26, 0xff,
+	0x5f, 0x01, 0x80, 0x66, 0x1f, 0x4e, 0x47, 0x9e, 0xf1, 0x65, 0xa8, 0x1c,
+	0xcb, 0xb7, 0xad, 0xb2, 0xa4, 0xd1, 0x90, 0x34, 0x08, 0xc1, 0xe2, 0x21,
+	0xe3, 0x1d, 0x28, 0x9f, 0x3a, 0xf6, 0x50, 0xd2, 0x51, 0x28, 0x7b, 0xde,
+	0x64, 0xe2, 0x86, 0x96, 0x1c, 0x30, 0xbe, 0x06, 0x80, 0x6c, 0xbf, 0x72,
+	0xa6, 0x88, 0xee, 0x6c, 0x95, 0xde, 0x2d, 0xa5, 0x29, 0x69, 0xc3, 0x02,
+	0x39, 0x98, 0x1f, 0x2b, 0xe4, 0xb5, 0x1c, 0xe4, 0x78, 0xd8, 0xb8, 0x0f,
+	0x57, 0x87, 0xae, 0xef, 0x0c, 0xc2, 0xbe, 0xb6, 0x40, 0x25, 0x3b, 0xa7,
+	0x43, 0x58, 0x47, 0xf1, 0x32, 0x79, 0x92, 0x7b, 0x04, 0x8d, 0x78, 0xef,
+	0x81, 0x71, 0x07, 0x1a, 0xb4, 0xc3, 0xbe, 0x8b, 0xef, 0x88, 0x29, 0xc8,
+	0xb6, 0x35, 0xb2, 0x02, 0xcd, 0x82, 0xe3, 0xe8, 0x19, 0x09, 0x94, 0x1f,
+	0xbb, 0x63, 0x47, 0x88, 0x6d, 0x20, 0x05, 0xc0, 0xa2, 0x4f, 0xc8, 0x84,
+	0x87, 0x04, 0x07, 0x33, 0x3b, 0x3c, 0x55, 0xe2, 0x17, 0xcf, 0xe6, 0x4d,
+	0x58, 0xdb, 0x1d, 0x7b, 0x83, 0x97, 0x62, 0xf0, 0xd4, 0x0e, 0x4e, 0x15,
+	0x7b, 0xe2, 0xd9, 0x7c, 0x13, 0x2a, 0xcf, 0x8f, 0x7f, 0x82, 0xdb, 0xc8,
+	0x1d, 0x7d, 0x03, 0x4a, 0x2f, 0xec, 0x93, 0xdc, 0x7d, 0xfd, 0xbe, 0x04,
+	0x35, 0x71, 0xee, 0xf2, 0x48, 0x97, 0x28, 0xc5, 0x37, 0xa0, 0x3a, 0xf0,
+	0x1d, 0x3b, 0x74, 0xd4, 0x79, 0x76, 0xb7, 0x49, 0x73, 0xb7, 0x95, 0xe6,
+	0x6e, 0xbf, 0x50, 0xaa, 0x6d, 0x29, 0x54, 0x24, 0x0a, 0x81, 0xfb, 0xb7,
+	0x4e, 0xff, 0xf8, 0x3c, 0x74, 0x02, 0x3c, 0xe1, 0xc2, 0x7b, 0x65, 0xab,
+	0x2e, 0x20, 0xbb, 0x02, 0x60, 0xbc, 0x0b, 0x8d, 0xa1, 0x13, 0x0c, 0x7c,
+	0x77, 0x16, 0xba, 0xde, 0x14, 0x0f, 0x55, 0xf0, 0xa6, 0x83, 0x8c, 0xaf,
+	0x42, 0x8d, 0xe4, 0x88, 0xd3, 0xab, 0xd9, 0xf3, 0x8b, 0x06, 0x8d, 0x6d,
+	0xa8, 0x8b, 0x7b, 0x40, 0x47, 0x52, 0x91, 0x1c, 0x5e, 0x8d, 0xf6, 0xb0,
+	0x83, 0x23, 0xf2, 0x50, 0x6a, 0x36, 0x3f, 0x7d, 0xb7, 0x5c, 0x2b, 0x77,
+	0xd6, 0x8c, 0xaf, 0xc0, 0xda, 0xe7, 0x73, 0x2f, 0xb4, 0xb7, 0x6a, 0x72,
+	0x46, 0x2b, 0x9a, 0xf1, 0xa9, 0x80, 0x5a, 0x34, 0x88, 0x4c, 0xb4, 0x9d,
+	0xd7, 0xa1, 0x6f, 0xa3, 0x3a, 0x05, 0xb8, 0xd8, 0xc4, 0x0e, 0xb6, 0xea,
+	0x88, 0x5f, 0xb3, 0x5a, 0x0c, 0xee, 0x11, 0xd4, 0xf8, 0x16, 0xd4, 0x7d,
+	0x27, 0xc4, 0x8b, 0x29, 0x76, 0x03, 0x92, 0xe4, 0x1b, 0x19, 0x31, 0xed,
+	0xcf, 0x7d, 0x5b, 0x20, 0x58, 0x31, 0xae, 0xf1, 0xe7, 0xd0, 0x1a, 0x8c,
+	0xed, 0x20, 0x70, 0x47, 0xee, 0x40, 0x0e, 0x6e, 0x35, 0xa4, 0x2c, 0x52,
+	0x50, 0xe3, 0xdb, 0xd0, 0x39, 0x71, 0xc2, 0xfe, 0x08, 0x95, 0x49, 0xfc,
+	0x0a, 0xd1, 0x4e, 0x6c, 0x35, 0xa5, 0x58, 0x0c, 0xc9, 0xfa, 0x27, 0x4e,
+	0x28, 0x14, 0xed, 0xb1, 0x1c, 0xb2, 0x5a, 0x27, 0xfa, 0x6b, 0x60, 0x7e,
+	0x07, 0x9a, 0xba, 0x34, 0x50, 0x66, 0x4d, 0x7b, 0x30, 0x70, 0x82, 0xa0,
+	0x3f, 0x76, 0x5e, 0x39, 0x63, 0x79, 0xf4, 0x2d, 0x14, 0xb0, 0x34, 0x28,
+	0xbd, 0x81, 0x37, 0x73, 0xac, 0x06, 0x21, 0x3c, 0x15, 0xe3, 0xa8, 0xc6,
+	0x15, 0xd2, 0xd5, 0x65, 0xca, 0xb2, 0x09, 0x45, 0x97, 0xf4, 0xa4, 0xbe,
+	0x5b, 0xf9, 0xdd, 0x7f, 0xbe, 0x53, 0x3c, 0xdc, 0xb7, 0x10, 0x62, 0xf6,
+	0xa0, 0xc1, 0xca, 0x6e, 0x4f, 0x4f, 0x1c, 0xe3, 0x4b, 0xb0, 0x36, 0xf6,
+	0xce, 0x1c, 0x3f, 0xef, 0x36, 0xd0, 0x88, 0x40, 0x99, 0x0b, 0x73, 0x98,
+	0x67, 0x44, 0x68, 0xc4, 0xfc, 0x11, 0x74, 0x08, 0xa0, 0xdd, 0xe2, 0x95,
+	0x2e, 0x5a, 0x6c, 0xc4, 0x8a, 0x0b, 0x8d, 0x98, 0xf9, 0xb3, 0x0a, 0x00,
+	0xcd, 0x53, 0x86, 0xef, 0x32, 0x84, 0xdb, 0x8b, 0xad, 0x63, 0x4a, 0xf7,
+	0x6b, 0x59, 0xdd, 0xbf, 0x03, 0xeb, 0x33, 0xdb, 0x47, 0x0d, 0xe9, 0xf3,
+	0x92, 0x39, 0x32, 0x68, 0x12, 0x06, 0x1f, 0x0b, 0xce, 0x18, 0x9c, 0xba,
+	0xe3, 0x21, 0x4f, 0x08, 0x50, 0x8b, 0x4a, 0x99, 0x19, 0x12, 0x83, 0x5e,
+	0x02, 0x71, 0xad, 0xf1, 0xca, 0xfa, 0xe2, 0x5a, 0x97, 0x96, 0x5f, 0x6b,
+	0x46, 0x35, 0xbe, 0x09, 0xb5, 0x91, 0x3b, 0x75, 0x83, 0x53, 0x9c, 0x56,
+	0x5e, 0x3a, 0x2d, 0xc2, 0x4d, 0x99, 0x83, 0xb5, 0xb4, 0x39, 0xf8, 0x30,
+	0xe1, 0x0f, 0x3a, 0x92, 0xf7, 0xeb, 0x1a, 0xef, 0xf1, 0x01, 0x27, 0x3c,
+	0xc3, 0xfb, 0xd0, 0x41, 0x73, 0x33, 0x3c, 0xd7, 0x6d, 0x7d, 0x13, 0x69,
+	0x97, 0xac, 0xb6, 0x84, 0x6b, 0x7a, 0x71, 0x27, 0xe1, 0x44, 0xea, 0x72,
+	0x85, 0x8e, 0x2e, 0x1d, 0xa1, 0x97, 0x09, 0x4f, 0x82, 0x4e, 0x2c, 0xf4,
+	0x1d, 0x07, 0x8d, 0x4f, 0x2c, 0x7b, 0xb2, 0xb6, 0x96, 0x1c, 0x10, 0x1a,
+	0x2a, 0xfe, 0x06, 0x5b, 0xeb, 0x9a, 0xac, 0x19, 0x83, 0x46, 0x84, 0x3e,
+	0x0c, 0xed, 0x70, 0x3e, 0x09, 0xb6, 0x5a, 0x59, 0x2a, 0x3c, 0x84, 0x06,
+	0xa9, 0xd2, 0xa9, 0xe2, 0x6f, 0xe8, 0x34, 0xd0, 0x1c, 0xac, 0xcd, 0xd0,
+	0x78, 0x3b, 0x5b, 0x57, 0xe5, 0x8d, 0xd4, 0x39, 0x3c, 0x12, 0x70, 0x8b,
+	0x86, 0x71, 0xed, 0xa6, 0x7c, 0xe8, 0xe3, 0x3e, 0x03, 0x54, 0x22, 0x83,
+	0x94, 0x48, 0xc2, 0x2c, 0x09, 0x32, 0xee, 0x41, 0xd3, 0x9d, 0x0e, 0x9d,
+	0xd7, 0x7d, 0x3c, 0x8b, 0x70, 0x1e, 0x6c, 0x5d, 0xd3, 0x28, 0x1e, 0x8a,
+	0x81, 0x9e, 0x84, 0x5b, 0x0d, 0x37, 0x7e, 0x11, 0x74, 0x69, 0x12, 0xd3,
+	0xdd, 0x20, 0xba, 0x12, 0x46, 0x74, 0xcd, 0x9f, 0xa3, 0xef, 0x10, 0xb6,
+	0x45, 0xf9, 0x0e, 0x61, 0x92, 0x12, 0xe6, 0x40, 0x0c, 0x5a, 0x12, 0x6c,
+	0xdc, 0x82, 0xba, 0xb4, 0x58, 0xe1, 0xf9, 0x8c, 0xa2, 0x8a, 0xd6, 0xdd,
+	0xf5, 0x08, 0xe7, 0x05, 0x02, 0x85, 0x8a, 0xd0, 0xd3, 0x32, 0x8f, 0x71,
+	0x1f, 0xea, 0xa4, 0xdb, 0x42, 0x63, 0x61, 0xa9, 0xea, 0xc5, 0xc8, 0x46,
+	0x17, 0x6a, 0x52, 0xf3, 0xf1, 0xba, 0xc8, 0x48, 0xa0, 0x6e, 0x45, 0xef,
+	0xc6, 0x9f, 0x41, 0xd5, 0x93, 0xa7, 0x11, 0xe0, 0x3d, 0xcc, 0x9c, 0xa2,
+	0x1a, 0xc3, 0x10, 0xa4, 0x7e, 0x2c, 0xbc, 0xb0, 0xe5, 0x8c, 0x02, 0x56,
+	0x1e, 0xda, 0xc7, 0x2e, 0x43, 0xad, 0x78, 0x3c, 0xf2, 0xc5, 0x42, 0x71,
+	0x9a, 0xe4, 0x8b, 0x05, 0x6c, 0xe2, 0x0d, 0x1d, 0x69, 0xdc, 0xd7, 0x2d,
+	0xf9, 0x8c, 0x6b, 0xb7, 0x82, 0xf3, 0xc9, 0xd8, 0x9d, 0xbe, 0xec, 0xe3,
+	0xed, 0x42, 0x8b, 0x2d, 0x75, 0xb7, 0x6e, 0xad, 0x33, 0xf4, 0x85, 0x04,
+	0xa2, 0x0f, 0xaa, 0x90, 0xef, 0x41, 0x3d, 0x2b, 0x44, 0xf1, 0x86, 0x10,
+	0x20, 0x39, 0x1f, 0x8b, 0x87, 0x4d, 0xf4, 0x41, 0x42, 0x54, 0x64, 0x61,
+	0x37, 0x74, 0x0b, 0x5b, 0x56, 0x46, 0x75, 0x43, 0x37, 0xaa, 0x65, 0x65,
+	0x47, 0x2d, 0xa8, 0xa9, 0x7d, 0xa0, 0x71, 0x5a, 0x93, 0x3b, 0xe1, 0x13,
+	0x05, 0x6d, 0x97, 0x34, 0x20, 0x3c, 0xa7, 0x2f, 0x96, 0x60, 0xa3, 0x44,
+	0x9e, 0x33, 0x5a, 0xd8, 0xa2, 0x41, 0xf3, 0x6f, 0x00, 0x48, 0x88, 0xca,
+	0x78, 0x92, 0x28, 0x13, 0xc6, 0x53, 0xdd, 0x03, 0x1a, 0x12, 0xca, 0x22,
+	0x57, 0x40, 0xdd, 0x1b, 0x31, 0xf1, 0x94, 0x90, 0x6b, 0x4a, 0xc8, 0xe6,
+	0x6f, 0x8b, 0x70, 0x75, 0x4f, 0x86, 0x1a, 0xd2, 0xf9, 0x38, 0x9f, 0xcf,
+	0xf1, 0xdc, 0x97, 0x39, 0xa7, 0x94, 0xe1, 0x2d, 0x65, 0x0d, 0xef, 0x26,
+	0x54, 0xe6, 0x33, 0xbc, 0x96, 0x8e, 0x34, 0x6e, 0x35, 0x8b, 0xdf, 0xf0,
+	0x72, 0x16, 0x3b, 0xa5, 0x38, 0x66, 0x58, 0xbb, 0x64, 0xcc, 0x50, 0x59,
+	0x1e, 0x33, 0x54, 0xff, 0xa0, 0x98, 0xa1, 0xb6, 0x72, 0xcc, 0x50, 0x5f,
+	0x39, 0x66, 0xb8, 0x07, 0xc6, 0xe1, 0x34, 0x98, 0x89, 0x13, 0x5a, 0x59,
+	0xc4, 0xe6, 0x0d, 0x68, 0x3f, 0x75, 0x03, 0x7d, 0x06, 0xca, 0xae, 0xd0,
+	0x29, 0x62, 0x04, 0xd2, 0x89, 0x07, 0x82, 0x99, 0x37, 0x0d, 0xa4, 0x75,
+	0x10, 0x93, 0xf4, 0x60, 0x7a, 0x3d, 0x22, 0x48, 0x51, 0x9b, 0xcf, 0x4f,
+	0xe6, 0x0f, 0xe1, 0xea, 0xbe, 0x33, 0x76, 0x2e, 0x75, 0xde, 0xa8, 0xed,
+	0x23, 0xcf, 0x1f, 0x90, 0xa6, 0xd6, 0x2c, 0x7a, 0x31, 0x3a, 0x50, 0xb2,
+	0xc7, 0x63, 0x79, 0xfa, 0x35, 0x4b, 0x3c, 0x9a, 0xff, 0x52, 0x00, 0xa3,
+	0x27, 0x1c, 0x1c, 0xbb, 0x02, 0xa6, 0x8e, 0x4a, 0x4b, 0x3e, 0x36, 0xd7,
+	0xe3, 0xd3, 0x50, 0x5a, 0xa7, 0xca, 0xb9, 0x3a, 0xc5, 0x31, 0x01, 0x29,
+	0x9c, 0x0a, 0x03, 0x92, 0x3e, 0x6f, 0x6d, 0x45, 0x9f, 0x47, 0xaa, 0x68,
+	0xfe, 0x2b, 0xb2, 0xbc, 0x3b, 0x8f, 0xdc, 0xf9, 0xa5, 0x58, 0xde, 0x4c,
+	0xa4, 0x70, 0x8b, 0x18, 0xaa, 0xac, 0xea, 0x84, 0x95, 0x9f, 0x2c, 0x2d,
+	0xf2, 0x93, 0x2d, 0xc0, 0x28, 0x90, 0x43, 0x7c, 0x7c, 0xe2, 0x1d, 0x60,
+	0x5e, 0x79, 0xed, 0xb1, 0x0c, 0x0f, 0x32, 0x5b, 0x58, 0x1e, 0x67, 0xa5,
+	0xa4, 0x5e, 0xcc, 0x4a, 0x7d, 0x29, 0x57, 0x91, 0xf7, 0x5e, 0x5b, 0xc1,
+	0x7b, 0x57, 0x17, 0x7a, 0xef, 0x94, 0xdb, 0xaa, 0xa4, 0xdd, 0x16, 0xea,
+	0xa0, 0xac, 0x0a, 0xb0, 0x41, 0xa1, 0x17, 0x73, 0x0a, 0x1b, 0x7c, 0xb7,
+	0xbe, 0xc0, 0xe6, 0xbf, 0x8e, 0x59, 0xa8, 0xb4, 0x93, 0xc2, 0xb1, 0x2b,
+	0xb7, 0xaa, 0x47, 0x0a, 0xc2, 0x97, 0xe3, 0x19, 0x49, 0x24, 0xf9, 0x6c,
+	0xfe, 0x63, 0x01, 0xae, 0x8a, 0xeb, 0x97, 0x5c, 0x6d, 0xc9, 0xf5, 0x41,
+	0x11, 0x8e, 0x7c, 0x6f, 0x92, 0x9b, 0xc5, 0x8b, 0x01, 0xe3, 0x26, 0x14,
+	0x43, 0x2f, 0x21, 0x61, 0x1e, 0x46, 0xb0, 0xd0, 0xb2, 0xe9, 0x7c, 0x72,
+	0x8c, 0xbe, 0xa6, 0x2c, 0x65, 0xc2, 0x6f, 0x22, 0xa5, 0x8e, 0xa3, 0x6a,
+	0x99, 0x52, 0xd3, 0xb6, 0xb2, 0x29, 0x75, 0x8c, 0x66, 0xc1, 0x20, 0x7a,
+	0x36, 0x7f, 0x8d, 0x8a, 0x43, 0xa6, 0x9f, 0xe3, 0x6a, 0xde, 0x8d, 0x2a,
+	0x3a, 0x14, 0x16, 0x15, 0x1d, 0xde, 0x80, 0x5a, 0xd0, 0xd7, 0xe2, 0xfe,
+	0x3a, 0x86, 0xb5, 0x5c, 0x17, 0xf9, 0x72, 0xe2, 0x8e, 0x2e, 0x88, 0xdb,
+	0x93, 0x45, 0x8b, 0xf2, 0x85, 0x45, 0x0b, 0xf3, 0x61, 0x74, 0xc2, 0x49,
+	0x2e, 0xe3, 0x95, 0x0a, 0x8b, 0x53, 0x8f, 0xbb, 0x74, 0x5a, 0xc9, 0x99,
+	0x4b, 0x2c, 0xef, 0x11, 0x5c, 0x23, 0x03, 0x79, 0xf9, 0xf5, 0xf2, 0x0d,
+	0xa5, 0xf9, 0x91, 0xa2, 0x78, 0x79, 0x1d, 0x35, 0x6d, 0x30, 0x1e, 0x8f,
+	0xe7, 0xe9, 0xbb, 0x8d, 0xd1, 0x96, 0xca, 0x4f, 0x0a, 0xd9, 0xfc, 0x44,
+	0x8d, 0xa1, 0x9f, 0xad, 0x85, 0x5e, 0x5f, 0xec, 0x2a, 0x40, 0x8e, 0x4a,
+	0xc9, 0xdd, 0x56, 0x43, 0x4f, 0xfc, 0x0d, 0xcc, 0x5f, 0x14, 0x60, 0xb3,
+	0x37, 0x3f, 0x16, 0x57, 0xfe, 0xd8, 0xb9, 0x94, 0x62, 0x6f, 0x26, 0xd2,
+	0xbf, 0xba, 0x5e, 0xb6, 0x92, 0x0a, 0x5f, 0x5a, 0xa4, 0xf0, 0x18, 0x9d,
+	0xd3, 0x9d, 0x2b, 0x2f, 0xb8, 0x73, 0x34, 0x6c, 0x7e, 0x0e, 0x2d, 0xf6,
+	0xad, 0x1a, 0x47, 0x17, 0xc5, 0xc9, 0x18, 0x76, 0x7b, 0xa3, 0x51, 0x80,
+	0xce, 0x9a, 0xcc, 0x48, 0x51, 0x26, 0x31, 0x0d, 0x82, 0x91, 0x21, 0xc9,
+	0x86, 0xc7, 0x25, 0xcd, 0xce, 0x98, 0x18, 0x13, 0x3c, 0x7f, 0xe5, 0xf8,
+	0x67, 0xbe, 0x1b, 0x3a, 0x32, 0xba, 0x17, 0x87, 0x2a, 0xc3, 0x76, 0xb9,
+	0x66, 0xc9, 0xa2, 0x17, 0xf3, 0x9f, 0x4b, 0xd0, 0x3a, 0x9a, 0x5f, 0x86,
+	0x37, 0xa4, 0xf3, 0xca, 0x1e, 0xcf, 0xc9, 0x94, 0x36, 0x2d, 0x7a, 0x11,
+	0x5e, 0x74, 0xee, 0x8f, 0xd9, 0xaa, 0x8b, 0x47, 0xe3, 0x4d, 0xe1, 0xcd,
+	0x07, 0x73, 0x3f, 0x70, 0x5f, 0x39, 0x1c, 0xf1, 0xc4, 0x00, 0xe3, 0x2f,
+	0xa0, 0x3e, 0x74, 0xc6, 0x2e, 0x4a, 0x0a, 0x2d, 0x42, 0x55, 0x8a, 0x8f,
+	0xe2, 0xa7, 0x7d, 0x05, 0xb5, 0x62, 0x04, 0xc4, 0x36, 0x28, 0x24, 0xa6,
+	0xe0, 0x85, 0xad, 0x70, 0x4d, 0x6e, 0xa4, 0x43, 0x23, 0x82, 0xc3, 0x7d,
+	0x32, 0xc1, 0xb7, 0xe0, 0xaa, 0x8e, 0x4d, 0x12, 0xaa, 0x53, 0x1e, 0x18,
+	0x23, 0x93, 0x18, 0x31, 0xe8, 0x16, 0xc6, 0xc0, 0xf1, 0x51, 0xbf, 0x06,
+	0x9e, 0x3f, 0x0c, 0x64, 0x48, 0x5e, 0xb2, 0xd6, 0x09, 0x6a, 0x11, 0x10,
+	0x43, 0xa7, 0xb6, 0xa7, 0xc4, 0xd9, 0x27, 0x31, 0x52, 0xce, 0x71, 0x8d,
+	0x7c, 0x40, 0x42, 0xd4, 0x56, 0xcb, 0x4b, 0xbc, 0x93, 0x87, 0xe3, 0x42,
+	0x93, 0x8a, 0xfc, 0x9b, 0x17, 0x46, 0xfe, 0xeb, 0x39, 0x91, 0xbf, 0xf9,
+	0xd3, 0x02, 0xac, 0x47, 0x67, 0x25, 0xf8, 0x4a, 0x29, 0x41, 0x21, 0xa5,
+	0x04, 0xa8, 0xc0, 0x0d, 0x8a, 0xa5, 0xfb, 0x32, 0x01, 0x21, 0xed, 0x06,
+	0x02, 0x3d, 0x11, 0x69, 0x48, 0xce, 0xb6, 0x4a, 0x2b, 0x6f, 0xcb, 0xfc,
+	0xfb, 0xa2, 0xa6, 0x3b, 0x24, 0x27, 0x54, 0x8e, 0x60, 0x36, 0x66, 0x5b,
+	0x80, 0x96, 0x43, 0xbe, 0xe0, 0xf1, 0x55, 0x95, 0x74, 0x8b, 0x5a, 0xbc,
+	0x99, 0x98, 0x6b, 0x29, 0x14, 0xa1, 0x38, 0xa1, 0x37, 0x39, 0x0e, 0x42,
+	0x6f, 0xea, 0x70, 0x58, 0x16, 0x03, 0xf0, 0x70, 0x2b, 0x74, 0x34, 0x5c,
+	0x6f, 0xc8, 0x23, 0xc5, 0x18, 0x02, 0x77, 0xe4, 0x79, 0x42, 0xc3, 0xd6,
+	0x16, 0xe3, 0x12, 0x46, 0x74, 0x2e, 0x95, 0x0b, 0xcf, 0xa5, 0x9a, 0x77,
+	0x2e, 0x2e, 0xb4, 0xf7, 0xbc, 0xd9, 0xb9, 0x7e, 0x87, 0x6e, 0x42, 0x29,
+	0xf0, 0x07, 0xd9, 0x2b, 0x24, 0xa0, 0x62, 0x70, 0x18, 0xa8, 0x22, 0x8e,
+	0x3e, 0x88, 0x50, 0xb1, 0xfb, 0x48, 0xcc, 0x6a, 0xf7, 0x11, 0x40, 0x0b,
+	0xc2, 0x57, 0xbf, 0xb1, 0xe6, 0x8f, 0x29, 0x08, 0xbf, 0xc4, 0x1d, 0x47,
+	0x61, 0x8c, 0xe6, 0x18, 0x14, 0x93, 0xfd, 0x97, 0xcf, 0xc6, 0x16, 0x54,
+	0x4f, 0x91, 0x8a, 0xe7, 0x9f, 0xb3, 0xb5, 0x51, 0xaf, 0xe6, 0x1d, 0x68,
+	0xff, 0xb5, 0x3d, 0x7e, 0x79, 0x09, 0x8e, 0x8e, 0xa0, 0xfd, 0xc9, 0xd8,
+	0x3b, 0xd6, 0x67, 0xac, 0x14, 0xea, 0x20, 0x0f, 0x33, 0x1b, 0x93, 0x78,
+	0x5f, 0xc5, 0x78, 0xea, 0x55, 0x24, 0xbb, 0xaa, 0x08, 0x11, 0x44, 0x65,
+	0x86, 0x4c, 0x22, 0xa1, 0x50, 0xa8, 0xcc, 0x20, 0xc3, 0x87, 0x33, 0x68,
+	0xef, 0xbb, 0xa3, 0x91, 0xce, 0x0a, 0xfa, 0x9b, 0xa9, 0x73, 0xd6, 0xcf,
+	0xdf, 0x40, 0x15, 0x87, 0x64, 0x09, 0x1f, 0xb1, 0xbc, 0xf1, 0x90, 0xb0,
+	0x32, 0x47, 0x59, 0xc5, 0x21, 0x89, 0x85, 0x1c, 0x07, 0xa7, 0x98, 0x54,
+	0x78, 0x67, 0x7c, 0x98, 0xea, 0xd5, 0xfc, 0x09, 0x74, 0xe2, 0x85, 0xe3,
+	0x0c, 0x48, 0xad, 0x1c, 0x2c, 0x60, 0x9c, 0x97, 0x97, 0x9b, 0x54, 0xeb,
+	0xab, 0x6b, 0x95, 0xc6, 0x65, 0x26, 0x02, 0x11, 0x40, 0x90, 0xeb, 0xbe,
+	0xc4, 0x19, 0x9d, 0x42, 0x07, 0x6f, 0x0a, 0x47, 0xb7, 0x3c, 0x25, 0xb2,
+	0xfd, 0x05, 0xdd, 0xf6, 0xbf, 0x89, 0xb1, 0xb5, 0x7d, 0xa2, 0x98, 0xa8,
+	0x49, 0x42, 0x2f, 0xec, 0x13, 0x4b, 0x42, 0xe3, 0x0a, 0x42, 0x69, 0x41,
+	0x05, 0xc1, 0xfc, 0x25, 0x46, 0xa3, 0xe8, 0x1f, 0x69, 0xa9, 0x40, 0x0b,
+	0x0e, 0x54, 0x29, 0xa6, 0x70, 0x41, 0x29, 0x26, 0xcf, 0x55, 0x96, 0x97,
+	0xb9, 0xca, 0x44, 0x48, 0x8e, 0xc3, 0x21, 0x26, 0xea, 0xe3, 0xbe, 0x00,
+	0x71, 0x74, 0x5a, 0x97, 0x90, 0x1e, 0x02, 0xcc, 0x5f, 0x15, 0xa0, 0x83,
+	0xdc, 0x49, 0x8e, 0x23, 0xe6, 0x12, 0x05, 0xa0, 0xc2, 0x92, 0x02, 0xd0,
+	0x9f, 0x9c, 0xc5, 0xbf, 0x82, 0x0e, 0xca, 0x3b, 0x79, 0x54, 0x2b, 0x95,
+	0x58, 0x2e, 0x3c, 0x39, 0x73, 0x03, 0x0c, 0x61, 0x37, 0x92, 0xe7, 0x22,
+	0xee, 0xae, 0x80, 0x22, 0x5a, 0x24, 0x0d, 0x0c, 0xa0, 0x66, 0xbe, 0x33,
+	0x72, 0x5f, 0x73, 0x53, 0x89, 0xdf, 0x84, 0xfd, 0x74, 0xa7, 0x83, 0xf1,
+	0x7c, 0xe8, 0xf4, 0x99, 0x17, 0x32, 0x28, 0xeb, 0x0c, 0x25, 0xca, 0x66,
+	0x8f, 0x6a, 0x01, 0x44, 0x91, 0x6f, 0x42, 0x17, 0x4a, 0xc8, 0x03, 0xf3,
+	0x1e, 0x33, 0x26, 0x80, 0xda, 0xd6, 0x8a, 0x0b, 0xb7, 0x66, 0x7e, 0x0c,
+	0x1b, 0xa4, 0xf2, 0x5f, 0x48, 0xad, 0xcc, 0x1b, 0x70, 0x3d, 0x35, 0x9d,
+	0x18, 0x33, 0xbf, 0xae, 0xae, 0x92, 0x2e, 0x00, 0x25, 0xc7, 0xc2, 0x22,
+	0x39, 0xea, 0x53, 0x98, 0xd0, 0x03, 0x30, 0xf6, 0x4e, 0x9d, 0xc1, 0xcb,
+	0xcb, 0x1f, 0x9b, 0xf9, 0x01, 0x66, 0x3c, 0xfa, 0x54, 0x96, 0x19, 0x1e,
+	0x83, 0xf3, 0x1a, 0x05, 0x19, 0xb0, 0xf7, 0xe5, 0x37, 0xb4, 0xcf, 0x55,
+	0xde, 0xc5, 0xaa, 0xbb, 0xff, 0x87, 0x22, 0x34, 0x54, 0xb9, 0x4e, 0xc4,
+	0x8e, 0xdf, 0x4a, 0x4f, 0x7b, 0x4b, 0x9b, 0x26, 0x51, 0xf8, 0x39, 0x38,
+	0x98, 0x86, 0xfe, 0x79, 0x7c, 0x3b, 0xb7, 0x13, 0x0a, 0xd6, 0xcd, 0xcc,
+	0x12, 0x12, 0xa1, 0x29, 0x12, 0xaf, 0x7b, 0x08, 0x4d, 0x9d, 0x90, 0x08,
+	0x2b, 0x5f, 0x3a, 0xe7, 0xac, 0x56, 0xe2, 0x11, 0x05, 0xc4, 0x26, 0x28,
+	0xb7, 0x22, 0x48, 0x63, 0x1f, 0x15, 0xef, 0x17, 0xba, 0xfb, 0x50, 0x8f,
+	0xa8, 0xe7, 0xd0, 0xf9, 0x52, 0x92, 0x4e, 0x32, 0xdf, 0x8f, 0xa8, 0x98,
+	0xaf, 0xa1, 0x1e, 0x55, 0xf4, 0xd0, 0xe4, 0xb7, 0x26, 0xf6, 0xeb, 0x7e,
+	0x2a, 0xe4, 0x2a, 0x5b, 0x4d, 0x84, 0xf6, 0xa2, 0xcb, 0xca, 0x58, 0xd2,
+	0x03, 0x0d, 0xbc, 0xf9, 0x34, 0xe4, 0x0b, 0x2f, 0xb0, 0x84, 0x7d, 0xdd,
+	0x13, 0x30, 0x11, 0x9b, 0x09, 0x2c, 0x95, 0xff, 0xd0, 0x95, 0x07, 0x04,
+	0x71, 0x43, 0xc6, 0x1c, 0x41, 0x6b, 0xd7, 0x9e, 0x0e, 0xcf, 0xdc, 0x61,
+	0x78, 0xfa, 0x54, 0xc4, 0xc1, 0x22, 0x34, 0x98, 0xf9, 0x78, 0x75, 0xdc,
+	0x99, 0x3d, 0xe6, 0xad, 0xc4, 0x00, 0xe1, 0xb3, 0x65, 0x92, 0xc3, 0xdd,
+	0x62, 0x99, 0xd9, 0x98, 0xb0, 0x2e, 0xf9, 0xec, 0xcf, 0x30, 0xc0, 0x0d,
+	0x9c, 0x01, 0x7b, 0xee, 0x86, 0x04, 0x1e, 0x39, 0x7e, 0xcf, 0x19, 0x98,
+	0xdf, 0x84, 0x26, 0x55, 0x20, 0xf7, 0xbc, 0xf1, 0x7c, 0x32, 0xcd, 0xeb,
+	0x0f, 0x0b, 0x58, 0x54, 0xb2, 0x47, 0x98, 0x78, 0x36, 0xa7, 0x00, 0x71,
+	0xd1, 0x59, 0xe8, 0x1e, 0x66, 0x89, 0x13, 0x3b, 0x54, 0x26, 0x80, 0xde,
+	0xd0, 0x50, 0x62, 0x1a, 0x27, 0xe8, 0x2a, 0x1d, 0xa0, 0x5e, 0xac, 0xbe,
+	0xa2, 0xa5, 0x30, 0x30, 0x30, 0xaa, 0xfb, 0xde, 0x19, 0x0b, 0x8d, 0x58,
+	0xad, 0x21, 0x40, 0x0a, 0xcc, 0xfc, 0x6f, 0x8c, 0x7e, 0x13, 0x15, 0x4a,
+	0xb9, 0xa6, 0x7c, 0x8a, 0xd6, 0x24, 0x38, 0x92, 0x11, 0xbd, 0xf2, 0xfe,
+	0x09, 0x86, 0x18, 0xcc, 0x72, 0x4d, 0x00, 0x44, 0xc8, 0x61, 0x3c, 0x40,
+	0x55, 0x96, 0x25, 0xa1, 0x80, 0xbf, 0x33, 0x78, 0x27, 0x5b, 0xfb, 0xdc,
+	0x7e, 0x4e, 0x18, 0x4a, 0x99, 0xe9, 0xcd, 0x78, 0x0f, 0xd9, 0xb3, 0xcf,
+	0xfa, 0x81, 0xe8, 0x87, 0x72, 0xca, 0x97, 0x68, 0x91, 0xd6, 0x70, 0x54,
+	0x3e, 0x75, 0x3f, 0x42, 0x35, 0xd6, 0x48, 0xe4, 0xa8, 0xdf, 0x86, 0xae,
+	0x7e, 0x75, 0x5d, 0xe3, 0x30, 0xcd, 0xc6, 0x1b, 0x3d, 0x9f, 0x38, 0x54,
+	0x0d, 0xbb, 0x54, 0x9a, 0xed, 0xc1, 0x3a, 0xa7, 0x9f, 0x0e, 0x15, 0xda,
+	0x5b, 0xb2, 0xff, 0x4a, 0xeb, 0xe2, 0x93, 0x46, 0xa5, 0xb8, 0x38, 0xca,
+	0x7a, 0x3f, 0x4e, 0xcb, 0x4b, 0xf9, 0xf5, 0x17, 0x35, 0x6e, 0xbe, 0x0f,
+	0x37, 0x12, 0x85, 0x2b, 0x5c, 0x57, 0x31, 0x9c, 0x5a, 0xda, 0xdc, 0x84,
+	0x8d, 0xb8, 0xe4, 0x14, 0xe3, 0x99, 0x3b, 0xb0, 0xa9, 0x97, 0x15, 0x16,
+	0x53, 0x30, 0x6e, 0x40, 0x75, 0xe8, 0x9f, 0xf7, 0xfd, 0xf9, 0x94, 0xfd,
+	0x48, 0x05, 0x5f, 0xad, 0xf9, 0xd4, 0xfc, 0x3b, 0xb5, 0x6d, 0xbc, 0xf0,
+	0x72, 0xdb, 0x79, 0x3a, 0xbc, 0xd2, 0xd6, 0xb5, 0x8f, 0x1b, 0x4a, 0x2b,
+	0x7f, 0xdc, 0x60, 0x3e, 0x82, 0x56, 0x62, 0xfd, 0xc0, 0xf8, 0x00, 0x6a,
+	0x68, 0xcf, 0xf4, 0x00, 0xd4, 0xd0, 0x96, 0x63, 0x34, 0xab, 0x1a, 0xd2,
+	0x83, 0xf9, 0x29, 0x6c, 0x52, 0x09, 0x2b, 0x1a, 0xbf, 0x54, 0x58, 0x9c,
+	0xf7, 0x9d, 0xce, 0x87, 0xba, 0xb8, 0x35, 0x82, 0x4b, 0xca, 0x46, 0x7f,
+	0x99, 0x3c, 0x8d, 0x95, 0x27, 0xe6, 0xf2, 0xf0, 0x4f, 0x05, 0xb8, 0x1e,
+	0x95, 0x64, 0x64, 0x24, 0xfa, 0x07, 0x56, 0x64, 0xb4, 0xf8, 0xbf, 0x94,
+	0x88, 0xff, 0xa3, 0x5a, 0x4d, 0x79, 0x41, 0xad, 0xc6, 0xfc, 0x7d, 0x01,
+	0xda, 0x78, 0xa3, 0x86, 0x97, 0xce, 0x39, 0x72, 0xbe, 0xc2, 0x89, 0x36,
+	0x5b, 0xd2, 0xf4, 0x4b, 0x98, 0x7d, 0x77, 0xaa, 0x3b, 0x87, 0x32, 0x9b,
+	0x7d, 0x77, 0x9a, 0x71, 0x0e, 0x99, 0xe6, 0x77, 0xd2, 0x85, 0xec, 0x20,
+	0x96, 0x37, 0x74, 0x47, 0xae, 0x33, 0x44, 0x54, 0x2a, 0xbf, 0x2f, 0xd3,
+	0xc6, 0x75, 0x35, 0xa3, 0x27, 0x26, 0xa0, 0x79, 0xbe, 0xb1, 0x6b, 0x87,
+	0xe2, 0xb3, 0xa3, 0x4c, 0xba, 0xb8, 0xd2, 0xb6, 0xd1, 0x40, 0x89, 0xad,
+	0x92, 0xd9, 0x46, 0x03, 0x25, 0x5f, 0x16, 0x1f, 0x80, 0x39, 0x83, 0xad,
+	0xec, 0x7a, 0x1c, 0x98, 0x28, 0x11, 0x16, 0x34, 0x11, 0x26, 0x72, 0x34,
+	0xdd, 0x97, 0x67, 0x73, 0x34, 0x59, 0x34, 0xf7, 0x7d, 0xcf, 0xe7, 0x35,
+	0xe9, 0xc5, 0x0c, 0xc1, 0x10, 0x16, 0xfd, 0xc8, 0x77, 0x5e, 0xb9, 0xce,
+	0xd9, 0x1f, 0x27, 0x8f, 0x14, 0x6e, 0x39, 0x40, 0x69, 0x22, 0x63, 0x32,
+	0xd4, 0x26, 0x27, 0x04, 0x04, 0x92, 0xb1, 0xf6, 0xff, 0x16, 0xe0, 0x5a,
+	0x62, 0x59, 0xde, 0x23, 0x4e, 0x9c, 0xce, 0x27, 0xfd, 0x89, 0x90, 0x41,
+	0x54, 0x8b, 0x01, 0x04, 0x7d, 0x8f, 0x20, 0x18, 0x7a, 0x55, 0x88, 0x4c,
+	0x7e, 0xb2, 0xc6, 0x83, 0xe8, 0x64, 0x3a, 0x71, 0xa8, 0x9f, 0xc8, 0x07,
+	0x5a, 0x51, 0xc0, 0x1f, 0xab, 0xd2, 0x1f, 0x4b, 0xe1, 0x10, 0xcb, 0x7e,
+	0x75, 0xd2, 0xcf, 0x74, 0x2e, 0x9a, 0x08, 0x8d, 0xb0, 0xcc, 0x21, 0x1a,
+	0x07, 0x4c, 0x59, 0x5f, 0xd8, 0xc7, 0xf3, 0xb1, 0xed, 0xff, 0x89, 0x52,
+	0x66, 0xf3, 0x7f, 0x0a, 0xd0, 0xe0, 0x25, 0xc4, 0x6a, 0x0b, 0x43, 0x0b,
+	0x4c, 0x8a, 0x30, 0x38, 0x08, 0xfa, 0xf6, 0x70, 0xc8, 0xdf, 0xa2, 0x95,
+	0x2c, 0x11, 0x3f, 0x04, 0x3b, 0x02, 0x20, 0xb2, 0x2e, 0x39, 0xec, 0x3b,
+	0x13, 0xef, 0x15, 0xdb, 0x73, 0x0c, 0x7d, 0x04, 0xcc, 0x22, 0x10, 0xea,
+	0xca, 0x3a, 0x87, 0x1e, 0x4c, 0xa4, 0x2c, 0x75, 0xbd, 0xc9, 0x40, 0xa2,
+	0xf3, 0x55, 0x68, 0x2b, 0x24, 0x45, 0x6a, 0x4d, 0xa2, 0xb5, 0x18, 0xac,
+	0xa8, 0x69, 0x88, 0x83, 0x53, 0xd1, 0xf4, 0x1e, 0xf2, 0xe7, 0x05, 0x0a,
+	0x71, 0x8f, 0xa0, 0xb2, 0x26, 0x20, 0x0f, 0x7b, 0x28, 0xeb, 0x49, 0xa2,
+	0x26, 0x40, 0xaf, 0xb7, 0xbe, 0x46, 0x9f, 0x52, 0xc8, 0xef, 0x1f, 0x9a,
+	0x50, 0xb3, 0x0e, 0x7a, 0x07, 0xd6, 0x67, 0x07, 0xfb, 0x9d, 0x2b, 0x46,
+	0x0d, 0xca, 0x8f, 0x0f, 0x9f, 0x1e, 0x74, 0x0a, 0x46, 0x15, 0x4a, 0xfb,
+	0x87, 0x56, 0xa7, 0x78, 0xeb, 0x9e, 0xea, 0x9c, 0xc8, 0x5a, 0xb3, 0xd1,
+	0x80, 0x6a, 0xef, 0xc5, 0x8e, 0xf5, 0x42, 0xa2, 0xd7, 0x61, 0xcd, 0x3a,
+	0xd8, 0xd9, 0xff, 0x01, 0xe2, 0x23, 0x9d, 0xc7, 0x87, 0xcf, 0x0e, 0x7b,
+	0x4f, 0x70, 0xa0, 0x78, 0xeb, 0x21, 0xd4, 0xa3, 0x0a, 0xab, 0x20, 0xfa,
+	0xec, 0xf9, 0xb3, 0x03, 0x22, 0xff, 0xdd, 0xde, 0xf3, 0x67, 0x88, 0x8e,
+	0x4f, 0x4f, 0x0f, 0x11, 0x56, 0x14, 0x0b, 0xf5, 0x3e, 0x7d, 0xda, 0x29,
+	0x89, 0x87, 0xbd, 0xde, 0x67, 0x9d, 0xf2, 0xad, 0xef, 0xab, 0x15, 0xe5,
+	0xb7, 0x27, 0xe8, 0x9f, 0xe1, 0xe8, 0xc9, 0x4e, 0xef, 0xa0, 0xff, 0xfc,
+	0xe8, 0xe0, 0x19, 0x12, 0xb9, 0x06, 0x6d, 0x7a, 0xa7, 0xf5, 0x0e, 0x9f,
+	0x7d, 0x82, 0xf4, 0x0c, 0x68, 0xe9, 0x40, 0xc1, 0x04, 0x86, 0x43, 0x4d,
+	0x86, 0xed, 0xe0, 0x9e, 0xf6, 0x3b, 0xa5, 0x5b, 0x16, 0x34, 0xb4, 0x6f,
+	0x50, 0x04, 0xe5, 0xc3, 0x67, 0xfb, 0x07, 0xdf, 0xef, 0x33, 0x7b, 0x57,
+	0x61, 0x9d, 0xde, 0x71, 0xa1, 0x7d, 0xa2, 0x1b, 0xa1, 0xec, 0x3f, 0x97,
+	0xdc, 0x22, 0x4d, 0x7a, 0x57, 0x34, 0xef, 0xfe, 0xe6, 0x3a, 0x94, 0x76,
+	0x8e, 0x0e, 0x8d, 0xef, 0x00, 0xc4, 0x9f, 0x06, 0x18, 0x9b, 0x64, 0x0f,
+	0xd2, 0xdf, 0x0a, 0x74, 0x37, 0x33, 0xa6, 0xf5, 0x40, 0xb6, 0xe6, 0xae,
+	0x60, 0xf2, 0xd3, 0xd0, 0x1a, 0xdf, 0xc6, 0x0d, 0xfe, 0x62, 0x26, 0xdd,
+	0x0a, 0xef, 0x26, 0x7b, 0xd5, 0x38, 0xf1, 0x01, 0xd4, 0x54, 0x8f, 0xdb,
+	0xd8, 0x90, 0x83, 0xa9, 0x5e, 0x78, 0xf7, 0x7a, 0x0a, 0xca, 0xa9, 0xe1,
+	0x15, 0xc1, 0x73, 0xdc, 0xde, 0x66, 0x9e, 0x33, 0xfd, 0xee, 0x0b, 0x78,
+	0xfe, 0x10, 0x1a, 0x5a, 0x07, 0x9b, 0x79, 0xce, 0xf6, 0xb4, 0xbb, 0xba,
+	0x75, 0xc4, 0x69, 0xbb, 0xd0, 0xd4, 0x7b, 0xb0, 0xc6, 0x16, 0x5f, 0xcf,
+	0x4c, 0x5b, 0xf6, 0x82, 0xa5, 0x3f, 0xc6, 0xb3, 0xd2, 0x43, 0x42, 0xe3,
+	0x0d, 0x5d, 0x60, 0x49, 0x2a, 0xe9, 0xc0, 0x12, 0xa7, 0xdf, 0x07, 0x88,
+	0xe3, 0x16, 0xde, 0x79, 0xa6, 0x55, 0xd9, 0xed, 0xa4, 0x26, 0x06, 0x38,
+	0xf3, 0x11, 0x95, 0x11, 0xd4, 0x9d, 0xc0, 0x13, 0x9e, 0x2c, 0x9c, 0x9f,
+	0x5d, 0xf8, 0x4e, 0x41, 0xec, 0x5e, 0x8f, 0x7d, 0x78, 0xf7, 0x39, 0x3d,
+	0xaf, 0x0b, 0x76, 0xff, 0x10, 0x1a, 0x5a, 0xa3, 0x8b, 0x05, 0x9f, 0x6d,
+	0x7d, 0xe5, 0x33, 0xb0, 0x07, 0xed, 0x54, 0x07, 0xcb, 0xb8, 0x49, 0x27,
+	0x97, 0xdb, 0xd7, 0xca, 0x27, 0x82, 0x47, 0xaf, 0x7d, 0x09, 0xc0, 0x1c,
+	0x64, 0xbf, 0x0d, 0xc8, 0x39, 0x7a, 0xbd, 0x8b, 0xca, 0x9b, 0xcf, 0x69,
+	0xac, 0xae, 0x74, 0xf4, 0x4c, 0x24, 0x71, 0xf4, 0x49, 0x2a, 0xe9, 0xcf,
+	0xa4, 0xe3, 0xa3, 0xe7, 0xb9, 0xf1, 0xd1, 0x25, 0x27, 0x76, 0x52, 0x13,
+	0x03, 0x62, 0x5e, 0x6f, 0x76, 0x26, 0x4e, 0x6e, 0x55, 0xe6, 0x3f, 0x82,
+	0x2a, 0x77, 0x06, 0x8c, 0x6b, 0xc9, 0x3e, 0xc1, 0x92, 0x99, 0xef, 0x15,
+	0x70, 0x6e, 0x4d, 0x75, 0x00, 0xf8, 0xa6, 0xa7, 0x1a, 0x02, 0x17, 0xac,
+	0xfb, 0x08, 0xaa, 0x9c, 0x7c, 0xf2, 0xba, 0xc9, 0x56, 0x61, 0xf7, 0x66,
+	0x66, 0xa6, 0x74, 0xc6, 0x9f, 0x89, 0x84, 0x51, 0x1e, 0x78, 0x6c, 0x9f,
+	0x24, 0x91, 0x84, 0x7d, 0xd2, 0x09, 0x25, 0x03, 0x0e, 0x5c, 0xf9, 0x2e,
+	0xd9, 0x27, 0x8d, 0xeb, 0x54, 0x9b, 0xa0, 0xdb, 0x4a, 0x4c, 0x09, 0xa4,
+	0x4d, 0x6b, 0x29, 0x24, 0xbe, 0x62, 0xf9, 0x33, 0xd3, 0x8b, 0x21, 0x9f,
+	0xf7, 0xa0, 0xa6, 0xda, 0x04, 0x3c, 0x29, 0xd5, 0x35, 0xc8, 0x9b, 0x84,
+	0x3c, 0xaa, 0x4e, 0x01, 0x4f, 0x4a, 0x35, 0x0e, 0xf2, 0x79, 0x54, 0x48,
+	0x09, 0x1e, 0xd3, 0x33, 0x73, 0x96, 0x43, 0x93, 0xad, 0x8a, 0xf2, 0x3c,
+	0x29, 0xd5, 0x1c, 0x60, 0x93, 0x9d, 0xae, 0xdc, 0xeb, 0x26, 0x5b, 0x4e,
+	0xd6, 0x4d, 0xf6, 0x6a, 0x7a, 0xf0, 0xb1, 0xf4, 0xcc, 0x88, 0xbe, 0x33,
+	0x1e, 0x1b, 0x0b, 0xd0, 0x2e, 0x98, 0x7e, 0x1f, 0x03, 0x84, 0x60, 0xf0,
+	0xf2, 0x0b, 0xcc, 0xdc, 0x15, 0x1f, 0x83, 0xc7, 0x05, 0x07, 0xbe, 0x3c,
+	0x39, 0x35, 0x88, 0x0b, 0x68, 0x3c, 0x86, 0x4e, 0xba, 0x0e, 0x60, 0xbc,
+	0x99, 0xb5, 0xfb, 0x71, 0x72, 0xdf, 0xd5, 0xf3, 0x61, 0xae, 0x56, 0x48,
+	0x5e, 0xd6, 0x13, 0x45, 0x02, 0xb6, 0x20, 0x79, 0x85, 0x83, 0x7c, 0x0a,
+	0x78, 0x86, 0xfb, 0xd0, 0x4e, 0x15, 0x14, 0xd8, 0x8a, 0xe6, 0x97, 0x19,
+	0x16, 0x70, 0x82, 0x39, 0x7d, 0x32, 0x75, 0x35, 0xba, 0x49, 0x53, 0xac,
+	0xe7, 0xb3, 0x79, 0xaa, 0xc4, 0xee, 0x88, 0xcc, 0x4f, 0xc6, 0x1d, 0x2d,
+	0x33, 0x86, 0x48, 0xe0, 0x09, 0xb4, 0x53, 0x45, 0x01, 0xde, 0x47, 0x7e,
+	0xa9, 0xe0, 0x82, 0xd3, 0xd9, 0xd1, 0xa5, 0x2a, 0xe8, 0xa4, 0xa5, 0xaa,
+	0x51, 0xb9, 0x96, 0xad, 0x53, 0x88, 0x3b, 0xf5, 0x24, 0x29, 0xd4, 0x98,
+	0x99, 0xfc, 0x6a, 0xc1, 0x05, 0xcc, 0xf4, 0xa0, 0x93, 0x4e, 0x14, 0x59,
+	0x55, 0x16, 0xe4, 0xab, 0xdd, 0xb7, 0x16, 0x8c, 0xaa, 0xab, 0x47, 0xb6,
+	0x45, 0x25, 0xf7, 0x7c, 0x6f, 0x53, 0xb9, 0x7e, 0xde, 0x09, 0xed, 0x42,
+	0x43, 0xcb, 0xe4, 0xd8, 0x70, 0x66, 0x53, 0xca, 0xee, 0x56, 0x76, 0x20,
+	0xba, 0xf5, 0xbb, 0xd4, 0x3e, 0xd4, 0x52, 0x22, 0x25, 0x97, 0xdc, 0x44,
+	0x89, 0xbd, 0x97, 0x96, 0xde, 0x98, 0x57, 0xee, 0xfe, 0x47, 0x15, 0xea,
+	0x54, 0x79, 0x16, 0xe1, 0xea, 0x3d, 0xa8, 0x47, 0x7d, 0x37, 0xe3, 0xba,
+	0xf2, 0x44, 0x89, 0x2e, 0x41, 0x57, 0xaf, 0x56, 0x4b, 0x07, 0xf4, 0x40,
+	0x76, 0xe2, 0x09, 0xd0, 0x93, 0x3d, 0xf7, 0x05, 0x33, 0x9b, 0xda, 0xcc,
+	0x40, 0x4e, 0x7d, 0x84, 0x51, 0xbc, 0xc2, 0x0a, 0x16, 0x4d, 0xbb, 0xc8,
+	0xf9, 0x3d, 0x80, 0x7a, 0xd4, 0xbd, 0x33, 0x74, 0xce, 0x96, 0xbb, 0xae,
+	0x03, 0x80, 0xb8, 0xf1, 0xc7, 0xb7, 0x23, 0xd3, 0x09, 0x5c, 0x4e, 0x66,
+	0x4f, 0x72, 0x40, 0x1d, 0x3a, 0xde, 0x41, 0xba, 0x63, 0xb7, 0x9c, 0xc8,
+	0xb7, 0x65, 0xbf, 0x20, 0x21, 0xf7, 0x74, 0x53, 0xed, 0x02, 0xad, 0xbe,
+	0x1d, 0x85, 0x3e, 0x79, 0x82, 0x68, 0x27, 0x1a, 0x1f, 0x6c, 0xe9, 0x1a,
+	0x5a, 0x0f, 0x87, 0x95, 0x2f, 0xdb, 0x10, 0x62, 0xe5, 0xcb, 0x69, 0xf7,
+	0x50, 0x66, 0xa2, 0x35, 0xe8, 0x98, 0x46, 0xb6, 0x65, 0x97, 0x52, 0x17,
+	0x69, 0x5a, 0xd6, 0x13, 0xdd, 0x2d, 0x36, 0x08, 0x79, 0x0d, 0xb3, 0x6e,
+	0x37, 0x6f, 0x28, 0x62, 0xe1, 0x1e, 0x54, 0x50, 0xd4, 0xc2, 0x1c, 0x44,
+	0x5d, 0xaf, 0xe5, 0xa2, 0x7e, 0x1f, 0x73, 0x37, 0x12, 0x56, 0x72, 0x62,
+	0x8e, 0x98, 0x1e, 0x52, 0x8c, 0x22, 0x3a, 0x39, 0x5a, 0xa4, 0xa1, 0xf5,
+	0xde, 0xb4, 0x1c, 0x2a, 0xd1, 0x5e, 0x23, 0x13, 0x0c, 0x71, 0xe3, 0x2d,
+	0xe1, 0x92, 0x75, 0x02, 0x37, 0x32, 0xf0, 0x68, 0x77, 0x0f, 0xa1, 0x8a,
+	0x96, 0x6d, 0x66, 0x0f, 0xc2, 0xcb, 0xfb, 0xd5, 0xdd, 0x47, 0xff, 0xf6,
+	0xbb, 0xb7, 0x0b, 0xff, 0x8e, 0x3f, 0xbf, 0xc5, 0x9f, 0x5f, 0xfc, 0xd7,
+	0xdb, 0x57, 0x7e, 0xf8, 0xc1, 0x89, 0x1b, 0x9e, 0xce, 0x8f, 0xb7, 0x07,
+	0xde, 0xe4, 0x36, 0x12, 0x3d, 0x3d, 0x1f, 0x3a, 0xbe, 0xfe, 0x14, 0xf8,
+	0x83, 0xdb, 0xf1, 0xbf, 0x97, 0x1e, 0x57, 0x24, 0xc9, 0x7b, 0xff, 0x0f,
+	0x05, 0x98, 0xeb, 0x57, 0x73, 0x3a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	// Repo rpcs
+	// CreateRepo creates a new repo.
+	// An error is returned if the repo already exists.
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
+	// DeleteRepo deletes a repo.
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Commit rpcs
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ResumeFinish re-runs the finish of a commit whose finish was interrupted
+	// (e.g. by a pachd crash mid-merge) or failed.
+	ResumeFinish(ctx context.Context, in *ResumeFinishRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
+	// ListCommit returns info about all commits. This is deprecated in favor of
+	// ListCommitStream.
+	ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error)
+	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
+	ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error)
+	// DeleteCommit deletes a commit.
+	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// FlushCommit waits for downstream commits to finish
+	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
+	// InspectCommitSet returns the set of commits produced across repos by one
+	// triggering commit.
+	InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (*CommitSetInfo, error)
+	// ListCommitSet returns info about all commit sets, newest first.
+	ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error)
+	// DeleteCommitSet deletes a triggering commit and all of its downstream
+	// derived commits atomically, returning the set that was deleted (or, with
+	// dry_run, the set that would be).
+	DeleteCommitSet(ctx context.Context, in *DeleteCommitSetRequest, opts ...grpc.CallOption) (*CommitSetInfo, error)
+	// SubscribeCommit subscribes for new commits on a given branch
+	SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error)
+	// SubscribeFiles streams the FileInfo of every file matching a glob pattern
+	// that changed in each newly finished commit on a branch.
+	SubscribeFiles(ctx context.Context, in *SubscribeFilesRequest, opts ...grpc.CallOption) (API_SubscribeFilesClient, error)
+	// BuildCommit builds a commit that's backed by the given tree
+	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
+	// CreateBranch creates a new branch
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
+	// ListBranchStream is like ListBranch, but returns its results in a GRPC
+	// stream, so the response isn't subject to the max gRPC message size.
+	ListBranchStream(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchStreamClient, error)
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// CreateCommitTag creates an immutable named reference ("tag") to a commit
+	// (see CommitTagInfo). Creating a tag whose name is already taken is an
+	// error; the existing tag must be deleted first to reuse the name.
+	CreateCommitTag(ctx context.Context, in *CreateCommitTagRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ListCommitTag returns the tags in a repo.
+	ListCommitTag(ctx context.Context, in *ListCommitTagRequest, opts ...grpc.CallOption) (*CommitTagInfos, error)
+	// DeleteCommitTag deletes a tag; the commit it names is unaffected.
+	DeleteCommitTag(ctx context.Context, in *DeleteCommitTagRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// File rpcs
+	// PutFile writes the specified file to pfs.
+	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
+	// CopyFile copies the contents of one file to another.
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetFile returns a byte stream of the contents of the file.
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
+	// InspectFile returns info about a file.
+	InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	// BatchInspectFile inspects many paths (or a glob pattern) in one call,
+	// streaming back one response per path, so that tools validating large
+	// manifests don't need a round trip per file.
+	BatchInspectFile(ctx context.Context, in *BatchInspectFileRequest, opts ...grpc.CallOption) (API_BatchInspectFileClient, error)
+	// FindFile searches a commit for regular files by name, size and
+	// modification date, using the hashtree metadata written at commit-finish
+	// time (predicates that globbing alone can't express).
+	FindFile(ctx context.Context, in *FindFileRequest, opts ...grpc.CallOption) (API_FindFileClient, error)
+	// ListFile returns info about all files. This is deprecated in favor of
+	// ListFileStream
+	ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// ListFileStream is a streaming version of ListFile
+	// TODO(msteffen): When the dash has been updated to use ListFileStream,
+	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error)
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error)
+	// GlobFile returns info about all files. This is deprecated in favor of
+	// GlobFileStream
+	GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error)
+	// GlobFileStream is a streaming version of GlobFile
+	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
+	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error)
+	// GlobPreview summarizes what a glob pattern matches in a commit, for
+	// debugging pipeline glob expressions without creating a pipeline
+	GlobPreview(ctx context.Context, in *GlobPreviewRequest, opts ...grpc.CallOption) (*GlobPreviewResponse, error)
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
+	// DiffTabularFile summarizes how a tabular (CSV, TSV or Parquet) file
+	// changed between 2 commits, at the row and column level.
+	DiffTabularFile(ctx context.Context, in *DiffTabularFileRequest, opts ...grpc.CallOption) (*TabularDiff, error)
+	// DeleteFile deletes a file.
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+	// Fsck does a file system consistency check for pfs
+	Fsck(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIGetBlocksClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error) {
+	out := new(RepoInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectRepo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error) {
+	out := new(ListRepoResponse)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListRepo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type ObjectAPI_GetBlocksClient interface {
-	Recv() (*types.BytesValue, error)
-	grpc.ClientStream
+func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type objectAPIGetBlocksClient struct {
-	grpc.ClientStream
+func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/pfs.API/StartCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *objectAPIGetBlocksClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *objectAPIClient) TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *aPIClient) ResumeFinish(ctx context.Context, in *ResumeFinishRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/TagObject", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pfs.API/ResumeFinish", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error) {
-	out := new(ObjectInfo)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/InspectObject", in, out, opts...)
+func (c *aPIClient) InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error) {
+	out := new(CommitInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectCommit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error) {
-	out := new(CheckObjectResponse)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/CheckObject", in, out, opts...)
+func (c *aPIClient) ListCommit(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (*CommitInfos, error) {
+	out := new(CommitInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListCommit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[6], "/pfs.ObjectAPI/ListObjects", opts...)
+func (c *aPIClient) ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pfs.API/ListCommitStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIListObjectsClient{stream}
+	x := &aPIListCommitStreamClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5951,38 +6552,38 @@ func (c *objectAPIClient) ListObjects(ctx context.Context, in *ListObjectsReques
 	return x, nil
 }
 
-type ObjectAPI_ListObjectsClient interface {
-	Recv() (*Object, error)
+type API_ListCommitStreamClient interface {
+	Recv() (*CommitInfo, error)
 	grpc.ClientStream
 }
 
-type objectAPIListObjectsClient struct {
+type aPIListCommitStreamClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIListObjectsClient) Recv() (*Object, error) {
-	m := new(Object)
+func (x *aPIListCommitStreamClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error) {
-	out := new(DeleteObjectsResponse)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/DeleteObjects", in, out, opts...)
+func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[7], "/pfs.ObjectAPI/GetTag", opts...)
+func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pfs.API/FlushCommit", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIGetTagClient{stream}
+	x := &aPIFlushCommitClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5992,38 +6593,38 @@ func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.Call
 	return x, nil
 }
 
-type ObjectAPI_GetTagClient interface {
-	Recv() (*types.BytesValue, error)
+type API_FlushCommitClient interface {
+	Recv() (*CommitInfo, error)
 	grpc.ClientStream
 }
 
-type objectAPIGetTagClient struct {
+type aPIFlushCommitClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetTagClient) Recv() (*types.BytesValue, error) {
-	m := new(types.BytesValue)
+func (x *aPIFlushCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error) {
-	out := new(ObjectInfo)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/InspectTag", in, out, opts...)
+func (c *aPIClient) InspectCommitSet(ctx context.Context, in *InspectCommitSetRequest, opts ...grpc.CallOption) (*CommitSetInfo, error) {
+	out := new(CommitSetInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectCommitSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[8], "/pfs.ObjectAPI/ListTags", opts...)
+func (c *aPIClient) ListCommitSet(ctx context.Context, in *ListCommitSetRequest, opts ...grpc.CallOption) (API_ListCommitSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[17], "/pfs.API/ListCommitSet", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &objectAPIListTagsClient{stream}
+	x := &aPIListCommitSetClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -6033,4852 +6634,12429 @@ func (c *objectAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opt
 	return x, nil
 }
 
-type ObjectAPI_ListTagsClient interface {
-	Recv() (*ListTagsResponse, error)
+type API_ListCommitSetClient interface {
+	Recv() (*CommitSetInfo, error)
 	grpc.ClientStream
 }
 
-type objectAPIListTagsClient struct {
+type aPIListCommitSetClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIListTagsClient) Recv() (*ListTagsResponse, error) {
-	m := new(ListTagsResponse)
+func (x *aPIListCommitSetClient) Recv() (*CommitSetInfo, error) {
+	m := new(CommitSetInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error) {
-	out := new(DeleteTagsResponse)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/DeleteTags", in, out, opts...)
+func (c *aPIClient) DeleteCommitSet(ctx context.Context, in *DeleteCommitSetRequest, opts ...grpc.CallOption) (*CommitSetInfo, error) {
+	out := new(CommitSetInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteCommitSet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *objectAPIClient) Compact(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/Compact", in, out, opts...)
+func (c *aPIClient) SubscribeCommit(ctx context.Context, in *SubscribeCommitRequest, opts ...grpc.CallOption) (API_SubscribeCommitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pfs.API/SubscribeCommit", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &aPISubscribeCommitClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// ObjectAPIServer is the server API for ObjectAPI service.
-type ObjectAPIServer interface {
-	PutObject(ObjectAPI_PutObjectServer) error
-	PutObjectSplit(ObjectAPI_PutObjectSplitServer) error
-	PutObjects(ObjectAPI_PutObjectsServer) error
-	GetObject(*Object, ObjectAPI_GetObjectServer) error
-	GetObjects(*GetObjectsRequest, ObjectAPI_GetObjectsServer) error
-	GetBlocks(*GetBlocksRequest, ObjectAPI_GetBlocksServer) error
-	TagObject(context.Context, *TagObjectRequest) (*types.Empty, error)
-	InspectObject(context.Context, *Object) (*ObjectInfo, error)
-	// CheckObject checks if an object exists in the blob store without
-	// actually reading the object.
-	CheckObject(context.Context, *CheckObjectRequest) (*CheckObjectResponse, error)
-	ListObjects(*ListObjectsRequest, ObjectAPI_ListObjectsServer) error
-	DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error)
-	GetTag(*Tag, ObjectAPI_GetTagServer) error
-	InspectTag(context.Context, *Tag) (*ObjectInfo, error)
-	ListTags(*ListTagsRequest, ObjectAPI_ListTagsServer) error
-	DeleteTags(context.Context, *DeleteTagsRequest) (*DeleteTagsResponse, error)
-	Compact(context.Context, *types.Empty) (*types.Empty, error)
+type API_SubscribeCommitClient interface {
+	Recv() (*CommitInfo, error)
+	grpc.ClientStream
 }
 
-func RegisterObjectAPIServer(s *grpc.Server, srv ObjectAPIServer) {
-	s.RegisterService(&_ObjectAPI_serviceDesc, srv)
+type aPISubscribeCommitClient struct {
+	grpc.ClientStream
 }
 
-func _ObjectAPI_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ObjectAPIServer).PutObject(&objectAPIPutObjectServer{stream})
+func (x *aPISubscribeCommitClient) Recv() (*CommitInfo, error) {
+	m := new(CommitInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type ObjectAPI_PutObjectServer interface {
-	SendAndClose(*Object) error
-	Recv() (*PutObjectRequest, error)
-	grpc.ServerStream
+func (c *aPIClient) SubscribeFiles(ctx context.Context, in *SubscribeFilesRequest, opts ...grpc.CallOption) (API_SubscribeFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[18], "/pfs.API/SubscribeFiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPISubscribeFilesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type objectAPIPutObjectServer struct {
-	grpc.ServerStream
+type API_SubscribeFilesClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-func (x *objectAPIPutObjectServer) SendAndClose(m *Object) error {
-	return x.ServerStream.SendMsg(m)
+type aPISubscribeFilesClient struct {
+	grpc.ClientStream
 }
 
-func (x *objectAPIPutObjectServer) Recv() (*PutObjectRequest, error) {
-	m := new(PutObjectRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *aPISubscribeFilesClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _ObjectAPI_PutObjectSplit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ObjectAPIServer).PutObjectSplit(&objectAPIPutObjectSplitServer{stream})
-}
-
-type ObjectAPI_PutObjectSplitServer interface {
-	SendAndClose(*Objects) error
-	Recv() (*PutObjectRequest, error)
-	grpc.ServerStream
-}
-
-type objectAPIPutObjectSplitServer struct {
-	grpc.ServerStream
+func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := c.cc.Invoke(ctx, "/pfs.API/BuildCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *objectAPIPutObjectSplitServer) SendAndClose(m *Objects) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CreateBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *objectAPIPutObjectSplitServer) Recv() (*PutObjectRequest, error) {
-	m := new(PutObjectRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *aPIClient) InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
+	out := new(BranchInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectBranch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _ObjectAPI_PutObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ObjectAPIServer).PutObjects(&objectAPIPutObjectsServer{stream})
+func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error) {
+	out := new(BranchInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type ObjectAPI_PutObjectsServer interface {
-	SendAndClose(*types.Empty) error
-	Recv() (*PutObjectRequest, error)
-	grpc.ServerStream
+func (c *aPIClient) ListBranchStream(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (API_ListBranchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[19], "/pfs.API/ListBranchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListBranchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type objectAPIPutObjectsServer struct {
-	grpc.ServerStream
+type API_ListBranchStreamClient interface {
+	Recv() (*BranchInfo, error)
+	grpc.ClientStream
 }
 
-func (x *objectAPIPutObjectsServer) SendAndClose(m *types.Empty) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListBranchStreamClient struct {
+	grpc.ClientStream
 }
 
-func (x *objectAPIPutObjectsServer) Recv() (*PutObjectRequest, error) {
-	m := new(PutObjectRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *aPIListBranchStreamClient) Recv() (*BranchInfo, error) {
+	m := new(BranchInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _ObjectAPI_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Object)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ObjectAPIServer).GetObject(m, &objectAPIGetObjectServer{stream})
+	return out, nil
 }
 
-type ObjectAPI_GetObjectServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+func (c *aPIClient) CreateCommitTag(ctx context.Context, in *CreateCommitTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CreateCommitTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type objectAPIGetObjectServer struct {
-	grpc.ServerStream
+func (c *aPIClient) ListCommitTag(ctx context.Context, in *ListCommitTagRequest, opts ...grpc.CallOption) (*CommitTagInfos, error) {
+	out := new(CommitTagInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListCommitTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *objectAPIGetObjectServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) DeleteCommitTag(ctx context.Context, in *DeleteCommitTagRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteCommitTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ObjectAPI_GetObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetObjectsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pfs.API/PutFile", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ObjectAPIServer).GetObjects(m, &objectAPIGetObjectsServer{stream})
+	x := &aPIPutFileClient{stream}
+	return x, nil
 }
 
-type ObjectAPI_GetObjectsServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+type API_PutFileClient interface {
+	Send(*PutFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
 }
 
-type objectAPIGetObjectsServer struct {
-	grpc.ServerStream
+type aPIPutFileClient struct {
+	grpc.ClientStream
 }
 
-func (x *objectAPIGetObjectsServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _ObjectAPI_GetBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetBlocksRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (x *aPIPutFileClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return srv.(ObjectAPIServer).GetBlocks(m, &objectAPIGetBlocksServer{stream})
-}
-
-type ObjectAPI_GetBlocksServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type objectAPIGetBlocksServer struct {
-	grpc.ServerStream
-}
-
-func (x *objectAPIGetBlocksServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/CopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ObjectAPI_TagObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagObjectRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pfs.API/GetFile", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).TagObject(ctx, in)
+	x := &aPIGetFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/TagObject",
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).TagObject(ctx, req.(*TagObjectRequest))
+	return x, nil
+}
+
+type API_GetFileClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
+}
+
+type aPIGetFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetFileClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _ObjectAPI_InspectObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Object)
-	if err := dec(in); err != nil {
+func (c *aPIClient) InspectFile(ctx context.Context, in *InspectFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, "/pfs.API/InspectFile", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).InspectObject(ctx, in)
+	return out, nil
+}
+
+func (c *aPIClient) BatchInspectFile(ctx context.Context, in *BatchInspectFileRequest, opts ...grpc.CallOption) (API_BatchInspectFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[20], "/pfs.API/BatchInspectFile", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/InspectObject",
+	x := &aPIBatchInspectFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).InspectObject(ctx, req.(*Object))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ObjectAPI_CheckObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckObjectRequest)
-	if err := dec(in); err != nil {
+type API_BatchInspectFileClient interface {
+	Recv() (*BatchInspectFileResponse, error)
+	grpc.ClientStream
+}
+
+type aPIBatchInspectFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIBatchInspectFileClient) Recv() (*BatchInspectFileResponse, error) {
+	m := new(BatchInspectFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).CheckObject(ctx, in)
+	return m, nil
+}
+
+func (c *aPIClient) FindFile(ctx context.Context, in *FindFileRequest, opts ...grpc.CallOption) (API_FindFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[21], "/pfs.API/FindFile", opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/CheckObject",
+	x := &aPIFindFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).CheckObject(ctx, req.(*CheckObjectRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ObjectAPI_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListObjectsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ObjectAPIServer).ListObjects(m, &objectAPIListObjectsServer{stream})
+type API_FindFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-type ObjectAPI_ListObjectsServer interface {
-	Send(*Object) error
-	grpc.ServerStream
+type aPIFindFileClient struct {
+	grpc.ClientStream
 }
 
-type objectAPIListObjectsServer struct {
-	grpc.ServerStream
+func (x *aPIFindFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *objectAPIListObjectsServer) Send(m *Object) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) ListFile(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/ListFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ObjectAPI_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteObjectsRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) ListFileStream(ctx context.Context, in *ListFileRequest, opts ...grpc.CallOption) (API_ListFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/pfs.API/ListFileStream", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).DeleteObjects(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/DeleteObjects",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
+	x := &aPIListFileStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ObjectAPI_GetTag_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Tag)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return srv.(ObjectAPIServer).GetTag(m, &objectAPIGetTagServer{stream})
+	return x, nil
 }
 
-type ObjectAPI_GetTagServer interface {
-	Send(*types.BytesValue) error
-	grpc.ServerStream
+type API_ListFileStreamClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-type objectAPIGetTagServer struct {
-	grpc.ServerStream
+type aPIListFileStreamClient struct {
+	grpc.ClientStream
 }
 
-func (x *objectAPIGetTagServer) Send(m *types.BytesValue) error {
-	return x.ServerStream.SendMsg(m)
+func (x *aPIListFileStreamClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func _ObjectAPI_InspectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tag)
-	if err := dec(in); err != nil {
+func (c *aPIClient) WalkFile(ctx context.Context, in *WalkFileRequest, opts ...grpc.CallOption) (API_WalkFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/pfs.API/WalkFile", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).InspectTag(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/InspectTag",
+	x := &aPIWalkFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).InspectTag(ctx, req.(*Tag))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ObjectAPI_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListTagsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ObjectAPIServer).ListTags(m, &objectAPIListTagsServer{stream})
+type API_WalkFileClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-type ObjectAPI_ListTagsServer interface {
-	Send(*ListTagsResponse) error
-	grpc.ServerStream
+type aPIWalkFileClient struct {
+	grpc.ClientStream
 }
 
-type objectAPIListTagsServer struct {
-	grpc.ServerStream
+func (x *aPIWalkFileClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *objectAPIListTagsServer) Send(m *ListTagsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *aPIClient) GlobFile(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (*FileInfos, error) {
+	out := new(FileInfos)
+	err := c.cc.Invoke(ctx, "/pfs.API/GlobFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ObjectAPI_DeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTagsRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) GlobFileStream(ctx context.Context, in *GlobFileRequest, opts ...grpc.CallOption) (API_GlobFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/pfs.API/GlobFileStream", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).DeleteTags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/DeleteTags",
+	x := &aPIGlobFileStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).DeleteTags(ctx, req.(*DeleteTagsRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ObjectAPIServer).Compact(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pfs.ObjectAPI/Compact",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).Compact(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type API_GlobFileStreamClient interface {
+	Recv() (*FileInfo, error)
+	grpc.ClientStream
 }
 
-var _ObjectAPI_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pfs.ObjectAPI",
-	HandlerType: (*ObjectAPIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TagObject",
-			Handler:    _ObjectAPI_TagObject_Handler,
-		},
-		{
-			MethodName: "InspectObject",
-			Handler:    _ObjectAPI_InspectObject_Handler,
-		},
-		{
-			MethodName: "CheckObject",
-			Handler:    _ObjectAPI_CheckObject_Handler,
-		},
-		{
-			MethodName: "DeleteObjects",
-			Handler:    _ObjectAPI_DeleteObjects_Handler,
-		},
-		{
-			MethodName: "InspectTag",
-			Handler:    _ObjectAPI_InspectTag_Handler,
-		},
-		{
-			MethodName: "DeleteTags",
-			Handler:    _ObjectAPI_DeleteTags_Handler,
-		},
-		{
-			MethodName: "Compact",
-			Handler:    _ObjectAPI_Compact_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "PutObject",
-			Handler:       _ObjectAPI_PutObject_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "PutObjectSplit",
-			Handler:       _ObjectAPI_PutObjectSplit_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "PutObjects",
-			Handler:       _ObjectAPI_PutObjects_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "GetObject",
-			Handler:       _ObjectAPI_GetObject_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetObjects",
-			Handler:       _ObjectAPI_GetObjects_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetBlocks",
-			Handler:       _ObjectAPI_GetBlocks_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListObjects",
-			Handler:       _ObjectAPI_ListObjects_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetTag",
-			Handler:       _ObjectAPI_GetTag_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListTags",
-			Handler:       _ObjectAPI_ListTags_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "client/pfs/pfs.proto",
+type aPIGlobFileStreamClient struct {
+	grpc.ClientStream
 }
 
-func (m *Repo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *aPIGlobFileStreamClient) Recv() (*FileInfo, error) {
+	m := new(FileInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) GlobPreview(ctx context.Context, in *GlobPreviewRequest, opts ...grpc.CallOption) (*GlobPreviewResponse, error) {
+	out := new(GlobPreviewResponse)
+	err := c.cc.Invoke(ctx, "/pfs.API/GlobPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Branch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
+	out := new(DiffFileResponse)
+	err := c.cc.Invoke(ctx, "/pfs.API/DiffFile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n1, err1 := m.Repo.MarshalTo(dAtA[i:])
-		if err1 != nil {
-			return 0, err1
-		}
-		i += n1
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) DiffTabularFile(ctx context.Context, in *DiffTabularFileRequest, opts ...grpc.CallOption) (*TabularDiff, error) {
+	out := new(TabularDiff)
+	err := c.cc.Invoke(ctx, "/pfs.API/DiffTabularFile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.Head != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
-		n2, err2 := m.Head.MarshalTo(dAtA[i:])
-		if err2 != nil {
-			return 0, err2
-		}
-		i += n2
-	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Branch != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
-		n3, err3 := m.Branch.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
-		}
-		i += n3
-	}
-	if len(m.Subvenance) > 0 {
-		for _, msg := range m.Subvenance {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.DirectProvenance) > 0 {
-		for _, msg := range m.DirectProvenance {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *BranchInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) Fsck(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.API/Fsck", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BranchInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BranchInfo) > 0 {
-		for _, msg := range m.BranchInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// APIServer is the server API for API service.
+type APIServer interface {
+	// Repo rpcs
+	// CreateRepo creates a new repo.
+	// An error is returned if the repo already exists.
+	CreateRepo(context.Context, *CreateRepoRequest) (*types.Empty, error)
+	// InspectRepo returns info about a repo.
+	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
+	// ListRepo returns info about all repos.
+	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
+	// DeleteRepo deletes a repo.
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*types.Empty, error)
+	// Commit rpcs
+	// StartCommit creates a new write commit from a parent commit.
+	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
+	// FinishCommit turns a write commit into a read commit.
+	FinishCommit(context.Context, *FinishCommitRequest) (*types.Empty, error)
+	// ResumeFinish re-runs the finish of a commit whose finish was interrupted
+	// (e.g. by a pachd crash mid-merge) or failed.
+	ResumeFinish(context.Context, *ResumeFinishRequest) (*types.Empty, error)
+	// InspectCommit returns the info about a commit.
+	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
+	// ListCommit returns info about all commits. This is deprecated in favor of
+	// ListCommitStream.
+	ListCommit(context.Context, *ListCommitRequest) (*CommitInfos, error)
+	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
+	ListCommitStream(*ListCommitRequest, API_ListCommitStreamServer) error
+	// DeleteCommit deletes a commit.
+	DeleteCommit(context.Context, *DeleteCommitRequest) (*types.Empty, error)
+	// FlushCommit waits for downstream commits to finish
+	FlushCommit(*FlushCommitRequest, API_FlushCommitServer) error
+	// InspectCommitSet returns the set of commits produced across repos by one
+	// triggering commit.
+	InspectCommitSet(context.Context, *InspectCommitSetRequest) (*CommitSetInfo, error)
+	// ListCommitSet returns info about all commit sets, newest first.
+	ListCommitSet(*ListCommitSetRequest, API_ListCommitSetServer) error
+	// DeleteCommitSet deletes a triggering commit and all of its downstream
+	// derived commits atomically, returning the set that was deleted (or, with
+	// dry_run, the set that would be).
+	DeleteCommitSet(context.Context, *DeleteCommitSetRequest) (*CommitSetInfo, error)
+	// SubscribeCommit subscribes for new commits on a given branch
+	SubscribeCommit(*SubscribeCommitRequest, API_SubscribeCommitServer) error
+	// SubscribeFiles streams the FileInfo of every file matching a glob pattern
+	// that changed in each newly finished commit on a branch.
+	SubscribeFiles(*SubscribeFilesRequest, API_SubscribeFilesServer) error
+	// BuildCommit builds a commit that's backed by the given tree
+	BuildCommit(context.Context, *BuildCommitRequest) (*Commit, error)
+	// CreateBranch creates a new branch
+	CreateBranch(context.Context, *CreateBranchRequest) (*types.Empty, error)
+	// InspectBranch returns info about a branch.
+	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
+	// ListBranch returns info about the heads of branches.
+	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
+	// ListBranchStream is like ListBranch, but returns its results in a GRPC
+	// stream, so the response isn't subject to the max gRPC message size.
+	ListBranchStream(*ListBranchRequest, API_ListBranchStreamServer) error
+	// DeleteBranch deletes a branch; note that the commits still exist.
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*types.Empty, error)
+	// CreateCommitTag creates an immutable named reference ("tag") to a commit
+	// (see CommitTagInfo). Creating a tag whose name is already taken is an
+	// error; the existing tag must be deleted first to reuse the name.
+	CreateCommitTag(context.Context, *CreateCommitTagRequest) (*types.Empty, error)
+	// ListCommitTag returns the tags in a repo.
+	ListCommitTag(context.Context, *ListCommitTagRequest) (*CommitTagInfos, error)
+	// DeleteCommitTag deletes a tag; the commit it names is unaffected.
+	DeleteCommitTag(context.Context, *DeleteCommitTagRequest) (*types.Empty, error)
+	// File rpcs
+	// PutFile writes the specified file to pfs.
+	PutFile(API_PutFileServer) error
+	// CopyFile copies the contents of one file to another.
+	CopyFile(context.Context, *CopyFileRequest) (*types.Empty, error)
+	// GetFile returns a byte stream of the contents of the file.
+	GetFile(*GetFileRequest, API_GetFileServer) error
+	// InspectFile returns info about a file.
+	InspectFile(context.Context, *InspectFileRequest) (*FileInfo, error)
+	// BatchInspectFile inspects many paths (or a glob pattern) in one call,
+	// streaming back one response per path, so that tools validating large
+	// manifests don't need a round trip per file.
+	BatchInspectFile(*BatchInspectFileRequest, API_BatchInspectFileServer) error
+	// FindFile searches a commit for regular files by name, size and
+	// modification date, using the hashtree metadata written at commit-finish
+	// time (predicates that globbing alone can't express).
+	FindFile(*FindFileRequest, API_FindFileServer) error
+	// ListFile returns info about all files. This is deprecated in favor of
+	// ListFileStream
+	ListFile(context.Context, *ListFileRequest) (*FileInfos, error)
+	// ListFileStream is a streaming version of ListFile
+	// TODO(msteffen): When the dash has been updated to use ListFileStream,
+	// replace ListFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	ListFileStream(*ListFileRequest, API_ListFileStreamServer) error
+	// WalkFile walks over all the files under a directory, including children of children.
+	WalkFile(*WalkFileRequest, API_WalkFileServer) error
+	// GlobFile returns info about all files. This is deprecated in favor of
+	// GlobFileStream
+	GlobFile(context.Context, *GlobFileRequest) (*FileInfos, error)
+	// GlobFileStream is a streaming version of GlobFile
+	// TODO(msteffen): When the dash has been updated to use GlobFileStream,
+	// replace GlobFile with this RPC (https://github.com/pachyderm/dash/issues/201)
+	GlobFileStream(*GlobFileRequest, API_GlobFileStreamServer) error
+	// GlobPreview summarizes what a glob pattern matches in a commit, for
+	// debugging pipeline glob expressions without creating a pipeline
+	GlobPreview(context.Context, *GlobPreviewRequest) (*GlobPreviewResponse, error)
+	// DiffFile returns the differences between 2 paths at 2 commits.
+	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
+	// DiffTabularFile summarizes how a tabular (CSV, TSV or Parquet) file
+	// changed between 2 commits, at the row and column level.
+	DiffTabularFile(context.Context, *DiffTabularFileRequest) (*TabularDiff, error)
+	// DeleteFile deletes a file.
+	DeleteFile(context.Context, *DeleteFileRequest) (*types.Empty, error)
+	// DeleteAll deletes everything
+	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	// Fsck does a file system consistency check for pfs
+	Fsck(context.Context, *types.Empty) (*types.Empty, error)
 }
 
-func (m *File) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
 }
 
-func (m *File) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n4, err4 := m.Commit.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
-		}
-		i += n4
+func _API_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
+	if interceptor == nil {
+		return srv.(APIServer).CreateRepo(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateRepo",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Block) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_InspectRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRepoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Block) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+	if interceptor == nil {
+		return srv.(APIServer).InspectRepo(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectRepo",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectRepo(ctx, req.(*InspectRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Object) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Object) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+	if interceptor == nil {
+		return srv.(APIServer).ListRepo(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListRepo",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListRepo(ctx, req.(*ListRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Tag) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if interceptor == nil {
+		return srv.(APIServer).DeleteRepo(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteRepo",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_StartCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCommitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).StartCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/StartCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartCommit(ctx, req.(*StartCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n5, err5 := m.Repo.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
-		}
-		i += n5
-	}
-	if m.Created != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
-		n6, err6 := m.Created.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
-		}
-		i += n6
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+func _API_FinishCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AuthInfo != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.AuthInfo.Size()))
-		n7, err7 := m.AuthInfo.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
-		}
-		i += n7
+	if interceptor == nil {
+		return srv.(APIServer).FinishCommit(ctx, in)
 	}
-	if len(m.Branches) > 0 {
-		for _, msg := range m.Branches {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/FinishCommit",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FinishCommit(ctx, req.(*FinishCommitRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_InspectCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.AccessLevel != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.AccessLevel))
+	if interceptor == nil {
+		return srv.(APIServer).InspectCommit(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectCommit",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectCommit(ctx, req.(*InspectCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Commit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n8, err8 := m.Repo.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
-		}
-		i += n8
+	if interceptor == nil {
+		return srv.(APIServer).ListCommit(ctx, in)
 	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListCommit",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListCommit(ctx, req.(*ListCommitRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_ListCommitStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListCommitStream(m, &aPIListCommitStreamServer{stream})
 }
 
-func (m *CommitRange) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Lower != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Lower.Size()))
-		n9, err9 := m.Lower.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
-		}
-		i += n9
-	}
-	if m.Upper != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Upper.Size()))
-		n10, err10 := m.Upper.MarshalTo(dAtA[i:])
-		if err10 != nil {
-			return 0, err10
-		}
-		i += n10
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type API_ListCommitStreamServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
 }
 
-func (m *CommitProvenance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIListCommitStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *CommitProvenance) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n11, err11 := m.Commit.MarshalTo(dAtA[i:])
-		if err11 != nil {
-			return 0, err11
-		}
-		i += n11
+func (x *aPIListCommitStreamServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Branch != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
-		n12, err12 := m.Branch.MarshalTo(dAtA[i:])
-		if err12 != nil {
-			return 0, err12
-		}
-		i += n12
+	if interceptor == nil {
+		return srv.(APIServer).DeleteCommit(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteCommit",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteCommit(ctx, req.(*DeleteCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlushCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).FlushCommit(m, &aPIFlushCommitServer{stream})
 }
 
-func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n13, err13 := m.Commit.MarshalTo(dAtA[i:])
-		if err13 != nil {
-			return 0, err13
-		}
-		i += n13
-	}
-	if m.ParentCommit != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.ParentCommit.Size()))
-		n14, err14 := m.ParentCommit.MarshalTo(dAtA[i:])
-		if err14 != nil {
-			return 0, err14
-		}
-		i += n14
-	}
-	if m.Started != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
-		n15, err15 := m.Started.MarshalTo(dAtA[i:])
-		if err15 != nil {
-			return 0, err15
-		}
-		i += n15
+type API_FlushCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPIFlushCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFlushCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SubscribeCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if m.Finished != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
-		n16, err16 := m.Finished.MarshalTo(dAtA[i:])
-		if err16 != nil {
-			return 0, err16
-		}
-		i += n16
+	return srv.(APIServer).SubscribeCommit(m, &aPISubscribeCommitServer{stream})
+}
+
+type API_SubscribeCommitServer interface {
+	Send(*CommitInfo) error
+	grpc.ServerStream
+}
+
+type aPISubscribeCommitServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISubscribeCommitServer) Send(m *CommitInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_BuildCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	if interceptor == nil {
+		return srv.(APIServer).BuildCommit(ctx, in)
 	}
-	if m.Tree != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n17, err17 := m.Tree.MarshalTo(dAtA[i:])
-		if err17 != nil {
-			return 0, err17
-		}
-		i += n17
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/BuildCommit",
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).BuildCommit(ctx, req.(*BuildCommitRequest))
 	}
-	if len(m.Subvenance) > 0 {
-		for _, msg := range m.Subvenance {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ChildCommits) > 0 {
-		for _, msg := range m.ChildCommits {
-			dAtA[i] = 0x5a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(APIServer).CreateBranch(ctx, in)
 	}
-	if m.ReadyProvenance != 0 {
-		dAtA[i] = 0x60
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.ReadyProvenance))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateBranch",
 	}
-	if len(m.Trees) > 0 {
-		for _, msg := range m.Trees {
-			dAtA[i] = 0x6a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
 	}
-	if m.Datums != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Datums.Size()))
-		n18, err18 := m.Datums.MarshalTo(dAtA[i:])
-		if err18 != nil {
-			return 0, err18
-		}
-		i += n18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Branch != nil {
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
-		n19, err19 := m.Branch.MarshalTo(dAtA[i:])
-		if err19 != nil {
-			return 0, err19
-		}
-		i += n19
+	if interceptor == nil {
+		return srv.(APIServer).InspectBranch(ctx, in)
 	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectBranch",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectBranch(ctx, req.(*InspectBranchRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FileInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).ListBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListBranch(ctx, req.(*ListBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n20, err20 := m.File.MarshalTo(dAtA[i:])
-		if err20 != nil {
-			return 0, err20
-		}
-		i += n20
+func _API_DeleteBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FileType != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
+	if interceptor == nil {
+		return srv.(APIServer).DeleteBranch(ctx, in)
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteBranch",
 	}
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			dAtA[i] = 0x32
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
-	}
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0x42
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.BlockRefs) > 0 {
-		for _, msg := range m.BlockRefs {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Committed != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Committed.Size()))
-		n21, err21 := m.Committed.MarshalTo(dAtA[i:])
-		if err21 != nil {
-			return 0, err21
-		}
-		i += n21
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteBranch(ctx, req.(*DeleteBranchRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ByteRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PutFile(&aPIPutFileServer{stream})
 }
 
-func (m *ByteRange) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Lower != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Lower))
-	}
-	if m.Upper != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Upper))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type API_PutFileServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*PutFileRequest, error)
+	grpc.ServerStream
 }
 
-func (m *BlockRef) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIPutFileServer struct {
+	grpc.ServerStream
 }
 
-func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
-		n22, err22 := m.Block.MarshalTo(dAtA[i:])
-		if err22 != nil {
-			return 0, err22
-		}
-		i += n22
-	}
-	if m.Range != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Range.Size()))
-		n23, err23 := m.Range.MarshalTo(dAtA[i:])
-		if err23 != nil {
-			return 0, err23
-		}
-		i += n23
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (x *aPIPutFileServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *aPIPutFileServer) Recv() (*PutFileRequest, error) {
+	m := new(PutFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n24, err24 := m.Object.MarshalTo(dAtA[i:])
-		if err24 != nil {
-			return 0, err24
-		}
-		i += n24
+func _API_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BlockRef != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.BlockRef.Size()))
-		n25, err25 := m.BlockRef.MarshalTo(dAtA[i:])
-		if err25 != nil {
-			return 0, err25
-		}
-		i += n25
+	if interceptor == nil {
+		return srv.(APIServer).CopyFile(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CopyFile",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CopyFile(ctx, req.(*CopyFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GetFile(m, &aPIGetFileServer{stream})
 }
 
-func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n26, err26 := m.Repo.MarshalTo(dAtA[i:])
-		if err26 != nil {
-			return 0, err26
-		}
-		i += n26
+type API_GetFileServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
+}
+
+type aPIGetFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetFileServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_InspectFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	if interceptor == nil {
+		return srv.(APIServer).InspectFile(ctx, in)
 	}
-	if m.Update {
-		dAtA[i] = 0x20
-		i++
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectFile",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectFile(ctx, req.(*InspectFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n27, err27 := m.Repo.MarshalTo(dAtA[i:])
-		if err27 != nil {
-			return 0, err27
-		}
-		i += n27
+	if interceptor == nil {
+		return srv.(APIServer).ListFile(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListFile",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListFile(ctx, req.(*ListFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_ListFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListFileStream(m, &aPIListFileStreamServer{stream})
 }
 
-func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type API_ListFileStreamServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *ListRepoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIListFileStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *ListRepoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.RepoInfo) > 0 {
-		for _, msg := range m.RepoInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func (x *aPIListFileStreamServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_WalkFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WalkFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).WalkFile(m, &aPIWalkFileServer{stream})
 }
 
-func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n28, err28 := m.Repo.MarshalTo(dAtA[i:])
-		if err28 != nil {
-			return 0, err28
-		}
-		i += n28
+type API_WalkFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIWalkFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWalkFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GlobFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlobFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Force {
-		dAtA[i] = 0x10
-		i++
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(APIServer).GlobFile(ctx, in)
 	}
-	if m.All {
-		dAtA[i] = 0x18
-		i++
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/GlobFile",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GlobFile(ctx, req.(*GlobFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_GlobFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GlobFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GlobFileStream(m, &aPIGlobFileStreamServer{stream})
 }
 
-func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Parent != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n29, err29 := m.Parent.MarshalTo(dAtA[i:])
-		if err29 != nil {
-			return 0, err29
-		}
-		i += n29
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+type API_GlobFileStreamServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIGlobFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGlobFileStreamServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	if interceptor == nil {
+		return srv.(APIServer).DiffFile(ctx, in)
 	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DiffFile",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DiffFile(ctx, req.(*DiffFileRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Parent != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
-		n30, err30 := m.Parent.MarshalTo(dAtA[i:])
-		if err30 != nil {
-			return 0, err30
-		}
-		i += n30
-	}
-	if m.Tree != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n31, err31 := m.Tree.MarshalTo(dAtA[i:])
-		if err31 != nil {
-			return 0, err31
-		}
-		i += n31
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
 	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteAll",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_Fsck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n32, err32 := m.Commit.MarshalTo(dAtA[i:])
-		if err32 != nil {
-			return 0, err32
-		}
-		i += n32
-	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	if interceptor == nil {
+		return srv.(APIServer).Fsck(ctx, in)
 	}
-	if m.Tree != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
-		n33, err33 := m.Tree.MarshalTo(dAtA[i:])
-		if err33 != nil {
-			return 0, err33
-		}
-		i += n33
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/Fsck",
 	}
-	if m.Empty {
-		dAtA[i] = 0x20
-		i++
-		if m.Empty {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Fsck(ctx, req.(*types.Empty))
 	}
-	if len(m.Trees) > 0 {
-		for _, msg := range m.Trees {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ResumeFinish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeFinishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	if interceptor == nil {
+		return srv.(APIServer).ResumeFinish(ctx, in)
 	}
-	if m.Datums != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Datums.Size()))
-		n34, err34 := m.Datums.MarshalTo(dAtA[i:])
-		if err34 != nil {
-			return 0, err34
-		}
-		i += n34
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ResumeFinish",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ResumeFinish(ctx, req.(*ResumeFinishRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_InspectCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectCommitSetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n35, err35 := m.Commit.MarshalTo(dAtA[i:])
-		if err35 != nil {
-			return 0, err35
-		}
-		i += n35
+	if interceptor == nil {
+		return srv.(APIServer).InspectCommitSet(ctx, in)
 	}
-	if m.BlockState != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.BlockState))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/InspectCommitSet",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectCommitSet(ctx, req.(*InspectCommitSetRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_ListCommitSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListCommitSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListCommitSet(m, &aPIListCommitSetServer{stream})
 }
 
-func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n36, err36 := m.Repo.MarshalTo(dAtA[i:])
-		if err36 != nil {
-			return 0, err36
-		}
-		i += n36
+type API_ListCommitSetServer interface {
+	Send(*CommitSetInfo) error
+	grpc.ServerStream
+}
+
+type aPIListCommitSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListCommitSetServer) Send(m *CommitSetInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteCommitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommitSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.From != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n37, err37 := m.From.MarshalTo(dAtA[i:])
-		if err37 != nil {
-			return 0, err37
-		}
-		i += n37
+	if interceptor == nil {
+		return srv.(APIServer).DeleteCommitSet(ctx, in)
 	}
-	if m.To != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
-		n38, err38 := m.To.MarshalTo(dAtA[i:])
-		if err38 != nil {
-			return 0, err38
-		}
-		i += n38
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteCommitSet",
 	}
-	if m.Number != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteCommitSet(ctx, req.(*DeleteCommitSetRequest))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SubscribeFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFilesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(APIServer).SubscribeFiles(m, &aPISubscribeFilesServer{stream})
 }
 
-func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type API_SubscribeFilesServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
 
-func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.CommitInfo) > 0 {
-		for _, msg := range m.CommitInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type aPISubscribeFilesServer struct {
+	grpc.ServerStream
 }
 
-func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *aPISubscribeFilesServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Head != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
-		n39, err39 := m.Head.MarshalTo(dAtA[i:])
-		if err39 != nil {
-			return 0, err39
-		}
-		i += n39
+func _API_ListBranchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBranchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if len(m.SBranch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.SBranch)))
-		i += copy(dAtA[i:], m.SBranch)
+	return srv.(APIServer).ListBranchStream(m, &aPIListBranchStreamServer{stream})
+}
+
+type API_ListBranchStreamServer interface {
+	Send(*BranchInfo) error
+	grpc.ServerStream
+}
+
+type aPIListBranchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListBranchStreamServer) Send(m *BranchInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_CreateCommitTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommitTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Branch != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
-		n40, err40 := m.Branch.MarshalTo(dAtA[i:])
-		if err40 != nil {
-			return 0, err40
-		}
-		i += n40
+	if interceptor == nil {
+		return srv.(APIServer).CreateCommitTag(ctx, in)
 	}
-	if len(m.Provenance) > 0 {
-		for _, msg := range m.Provenance {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateCommitTag",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateCommitTag(ctx, req.(*CreateCommitTagRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListCommitTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Branch != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
-		n41, err41 := m.Branch.MarshalTo(dAtA[i:])
-		if err41 != nil {
-			return 0, err41
-		}
-		i += n41
+	if interceptor == nil {
+		return srv.(APIServer).ListCommitTag(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListCommitTag",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListCommitTag(ctx, req.(*ListCommitTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DeleteCommitTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommitTagRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).DeleteCommitTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteCommitTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteCommitTag(ctx, req.(*DeleteCommitTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n42, err42 := m.Repo.MarshalTo(dAtA[i:])
-		if err42 != nil {
-			return 0, err42
-		}
-		i += n42
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _API_BatchInspectFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchInspectFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(APIServer).BatchInspectFile(m, &aPIBatchInspectFileServer{stream})
 }
 
-func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+type API_BatchInspectFileServer interface {
+	Send(*BatchInspectFileResponse) error
+	grpc.ServerStream
+}
+
+type aPIBatchInspectFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIBatchInspectFileServer) Send(m *BatchInspectFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_FindFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).FindFile(m, &aPIFindFileServer{stream})
 }
 
-func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Branch != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
-		n43, err43 := m.Branch.MarshalTo(dAtA[i:])
-		if err43 != nil {
-			return 0, err43
-		}
-		i += n43
+type API_FindFileServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
+}
+
+type aPIFindFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFindFileServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GlobPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlobPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Force {
-		dAtA[i] = 0x10
-		i++
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(APIServer).GlobPreview(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/GlobPreview",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GlobPreview(ctx, req.(*GlobPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_DiffTabularFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTabularFileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n44, err44 := m.Commit.MarshalTo(dAtA[i:])
-		if err44 != nil {
-			return 0, err44
-		}
-		i += n44
+	if interceptor == nil {
+		return srv.(APIServer).DiffTabularFile(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DiffTabularFile",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DiffTabularFile(ctx, req.(*DiffTabularFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pfs.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepo",
+			Handler:    _API_CreateRepo_Handler,
+		},
+		{
+			MethodName: "InspectRepo",
+			Handler:    _API_InspectRepo_Handler,
+		},
+		{
+			MethodName: "ListRepo",
+			Handler:    _API_ListRepo_Handler,
+		},
+		{
+			MethodName: "DeleteRepo",
+			Handler:    _API_DeleteRepo_Handler,
+		},
+		{
+			MethodName: "StartCommit",
+			Handler:    _API_StartCommit_Handler,
+		},
+		{
+			MethodName: "FinishCommit",
+			Handler:    _API_FinishCommit_Handler,
+		},
+		{
+			MethodName: "InspectCommit",
+			Handler:    _API_InspectCommit_Handler,
+		},
+		{
+			MethodName: "ListCommit",
+			Handler:    _API_ListCommit_Handler,
+		},
+		{
+			MethodName: "DeleteCommit",
+			Handler:    _API_DeleteCommit_Handler,
+		},
+		{
+			MethodName: "BuildCommit",
+			Handler:    _API_BuildCommit_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _API_CreateBranch_Handler,
+		},
+		{
+			MethodName: "InspectBranch",
+			Handler:    _API_InspectBranch_Handler,
+		},
+		{
+			MethodName: "ListBranch",
+			Handler:    _API_ListBranch_Handler,
+		},
+		{
+			MethodName: "DeleteBranch",
+			Handler:    _API_DeleteBranch_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _API_CopyFile_Handler,
+		},
+		{
+			MethodName: "InspectFile",
+			Handler:    _API_InspectFile_Handler,
+		},
+		{
+			MethodName: "ListFile",
+			Handler:    _API_ListFile_Handler,
+		},
+		{
+			MethodName: "GlobFile",
+			Handler:    _API_GlobFile_Handler,
+		},
+		{
+			MethodName: "DiffFile",
+			Handler:    _API_DiffFile_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _API_DeleteFile_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
+		},
+		{
+			MethodName: "Fsck",
+			Handler:    _API_Fsck_Handler,
+		},
+		{
+			MethodName: "ResumeFinish",
+			Handler:    _API_ResumeFinish_Handler,
+		},
+		{
+			MethodName: "InspectCommitSet",
+			Handler:    _API_InspectCommitSet_Handler,
+		},
+		{
+			MethodName: "DeleteCommitSet",
+			Handler:    _API_DeleteCommitSet_Handler,
+		},
+		{
+			MethodName: "CreateCommitTag",
+			Handler:    _API_CreateCommitTag_Handler,
+		},
+		{
+			MethodName: "ListCommitTag",
+			Handler:    _API_ListCommitTag_Handler,
+		},
+		{
+			MethodName: "DeleteCommitTag",
+			Handler:    _API_DeleteCommitTag_Handler,
+		},
+		{
+			MethodName: "GlobPreview",
+			Handler:    _API_GlobPreview_Handler,
+		},
+		{
+			MethodName: "DiffTabularFile",
+			Handler:    _API_DiffTabularFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListCommitStream",
+			Handler:       _API_ListCommitStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FlushCommit",
+			Handler:       _API_FlushCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCommit",
+			Handler:       _API_SubscribeCommit_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PutFile",
+			Handler:       _API_PutFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetFile",
+			Handler:       _API_GetFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFileStream",
+			Handler:       _API_ListFileStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WalkFile",
+			Handler:       _API_WalkFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GlobFileStream",
+			Handler:       _API_GlobFileStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListCommitSet",
+			Handler:       _API_ListCommitSet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeFiles",
+			Handler:       _API_SubscribeFiles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListBranchStream",
+			Handler:       _API_ListBranchStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchInspectFile",
+			Handler:       _API_BatchInspectFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindFile",
+			Handler:       _API_FindFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/pfs/pfs.proto",
 }
 
-func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commits) > 0 {
-		for _, msg := range m.Commits {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.ToRepos) > 0 {
-		for _, msg := range m.ToRepos {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ObjectAPIClient is the client API for ObjectAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ObjectAPIClient interface {
+	PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error)
+	PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error)
+	PutObjects(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectsClient, error)
+	GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error)
+	GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error)
+	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (ObjectAPI_GetBlocksClient, error)
+	TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error)
+	// CheckObject checks if an object exists in the blob store without
+	// actually reading the object.
+	CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error)
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error)
+	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error)
+	GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error)
+	InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error)
+	DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error)
+	Compact(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
-func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+type objectAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewObjectAPIClient(cc *grpc.ClientConn) ObjectAPIClient {
+	return &objectAPIClient{cc}
+}
+
+func (c *objectAPIClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[0], "/pfs.ObjectAPI/PutObject", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &objectAPIPutObjectClient{stream}
+	return x, nil
 }
 
-func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Repo != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n45, err45 := m.Repo.MarshalTo(dAtA[i:])
-		if err45 != nil {
-			return 0, err45
-		}
-		i += n45
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
-	}
-	if m.From != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n46, err46 := m.From.MarshalTo(dAtA[i:])
-		if err46 != nil {
-			return 0, err46
-		}
-		i += n46
-	}
-	if m.State != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.State))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type ObjectAPI_PutObjectClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*Object, error)
+	grpc.ClientStream
 }
 
-func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type objectAPIPutObjectClient struct {
+	grpc.ClientStream
 }
 
-func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n47, err47 := m.File.MarshalTo(dAtA[i:])
-		if err47 != nil {
-			return 0, err47
-		}
-		i += n47
-	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+func (x *objectAPIPutObjectClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectClient) CloseAndRecv() (*Object, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	m := new(Object)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *OverwriteIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[1], "/pfs.ObjectAPI/PutObjectSplit", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &objectAPIPutObjectSplitClient{stream}
+	return x, nil
 }
 
-func (m *OverwriteIndex) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Index))
+type ObjectAPI_PutObjectSplitClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*Objects, error)
+	grpc.ClientStream
+}
+
+type objectAPIPutObjectSplitClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIPutObjectSplitClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectSplitClient) CloseAndRecv() (*Objects, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	m := new(Objects)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) PutObjects(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[2], "/pfs.ObjectAPI/PutObjects", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &objectAPIPutObjectsClient{stream}
+	return x, nil
 }
 
-func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n48, err48 := m.File.MarshalTo(dAtA[i:])
-		if err48 != nil {
-			return 0, err48
-		}
-		i += n48
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Url) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
-		i += copy(dAtA[i:], m.Url)
-	}
-	if m.Recursive {
-		dAtA[i] = 0x30
-		i++
-		if m.Recursive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Delimiter != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
-	}
-	if m.TargetFileDatums != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
+type ObjectAPI_PutObjectsClient interface {
+	Send(*PutObjectRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type objectAPIPutObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIPutObjectsClient) Send(m *PutObjectRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectsClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.TargetFileBytes != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.OverwriteIndex != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n49, err49 := m.OverwriteIndex.MarshalTo(dAtA[i:])
-		if err49 != nil {
-			return 0, err49
-		}
-		i += n49
+	return m, nil
+}
+
+func (c *objectAPIClient) GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[3], "/pfs.ObjectAPI/GetObject", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.HeaderRecords != 0 {
-		dAtA[i] = 0x58
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.HeaderRecords))
+	x := &objectAPIGetObjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *PutFileRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type ObjectAPI_GetObjectClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
+}
+
+type objectAPIGetObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIGetObjectClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *PutFileRecord) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if len(m.ObjectHash) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.ObjectHash)))
-		i += copy(dAtA[i:], m.ObjectHash)
+func (c *objectAPIClient) GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[4], "/pfs.ObjectAPI/GetObjects", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.OverwriteIndex != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n50, err50 := m.OverwriteIndex.MarshalTo(dAtA[i:])
-		if err50 != nil {
-			return 0, err50
-		}
-		i += n50
+	x := &objectAPIGetObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *PutFileRecords) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type ObjectAPI_GetObjectsClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
+}
+
+type objectAPIGetObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIGetObjectsClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Split {
-		dAtA[i] = 0x8
-		i++
-		if m.Split {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Records) > 0 {
-		for _, msg := range m.Records {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Tombstone {
-		dAtA[i] = 0x18
-		i++
-		if m.Tombstone {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *objectAPIClient) GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (ObjectAPI_GetBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[5], "/pfs.ObjectAPI/GetBlocks", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Header != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Header.Size()))
-		n51, err51 := m.Header.MarshalTo(dAtA[i:])
-		if err51 != nil {
-			return 0, err51
-		}
-		i += n51
+	x := &objectAPIGetBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Footer != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Footer.Size()))
-		n52, err52 := m.Footer.MarshalTo(dAtA[i:])
-		if err52 != nil {
-			return 0, err52
-		}
-		i += n52
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return x, nil
+}
+
+type ObjectAPI_GetBlocksClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
+}
+
+type objectAPIGetBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIGetBlocksClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
 
-func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/TagObject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Src != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
-		n53, err53 := m.Src.MarshalTo(dAtA[i:])
-		if err53 != nil {
-			return 0, err53
-		}
-		i += n53
-	}
-	if m.Dst != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
-		n54, err54 := m.Dst.MarshalTo(dAtA[i:])
-		if err54 != nil {
-			return 0, err54
-		}
-		i += n54
-	}
-	if m.Overwrite {
-		dAtA[i] = 0x18
-		i++
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *objectAPIClient) InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error) {
+	out := new(ObjectInfo)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/InspectObject", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) CheckObject(ctx context.Context, in *CheckObjectRequest, opts ...grpc.CallOption) (*CheckObjectResponse, error) {
+	out := new(CheckObjectResponse)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/CheckObject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n55, err55 := m.File.MarshalTo(dAtA[i:])
-		if err55 != nil {
-			return 0, err55
-		}
-		i += n55
+func (c *objectAPIClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_ListObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[6], "/pfs.ObjectAPI/ListObjects", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	x := &objectAPIListObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+type ObjectAPI_ListObjectsClient interface {
+	Recv() (*Object, error)
+	grpc.ClientStream
+}
+
+type objectAPIListObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *objectAPIListObjectsClient) Recv() (*Object, error) {
+	m := new(Object)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *objectAPIClient) DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error) {
+	out := new(DeleteObjectsResponse)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/DeleteObjects", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n56, err56 := m.File.MarshalTo(dAtA[i:])
-		if err56 != nil {
-			return 0, err56
-		}
-		i += n56
-	}
-	if m.Full {
-		dAtA[i] = 0x10
-		i++
-		if m.Full {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (ObjectAPI_GetTagClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[7], "/pfs.ObjectAPI/GetTag", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.History != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.History))
+	x := &objectAPIGetTagClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetTagClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
 }
 
-func (m *WalkFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n57, err57 := m.File.MarshalTo(dAtA[i:])
-		if err57 != nil {
-			return 0, err57
-		}
-		i += n57
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIGetTagClient struct {
+	grpc.ClientStream
 }
 
-func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIGetTagClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n58, err58 := m.Commit.MarshalTo(dAtA[i:])
-		if err58 != nil {
-			return 0, err58
-		}
-		i += n58
-	}
-	if len(m.Pattern) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
-		i += copy(dAtA[i:], m.Pattern)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *objectAPIClient) InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error) {
+	out := new(ObjectInfo)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/InspectTag", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *FileInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ObjectAPI_serviceDesc.Streams[8], "/pfs.ObjectAPI/ListTags", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, msg := range m.FileInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	x := &objectAPIListTagsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_ListTagsClient interface {
+	Recv() (*ListTagsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NewFile != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
-		n59, err59 := m.NewFile.MarshalTo(dAtA[i:])
-		if err59 != nil {
-			return 0, err59
-		}
-		i += n59
-	}
-	if m.OldFile != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
-		n60, err60 := m.OldFile.MarshalTo(dAtA[i:])
-		if err60 != nil {
-			return 0, err60
-		}
-		i += n60
-	}
-	if m.Shallow {
-		dAtA[i] = 0x18
-		i++
-		if m.Shallow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIListTagsClient struct {
+	grpc.ClientStream
 }
 
-func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIListTagsClient) Recv() (*ListTagsResponse, error) {
+	m := new(ListTagsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, msg := range m.NewFiles {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.OldFiles) > 0 {
-		for _, msg := range m.OldFiles {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *objectAPIClient) DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error) {
+	out := new(DeleteTagsResponse)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/DeleteTags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *objectAPIClient) Compact(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pfs.ObjectAPI/Compact", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.File != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n61, err61 := m.File.MarshalTo(dAtA[i:])
-		if err61 != nil {
-			return 0, err61
-		}
-		i += n61
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// ObjectAPIServer is the server API for ObjectAPI service.
+type ObjectAPIServer interface {
+	PutObject(ObjectAPI_PutObjectServer) error
+	PutObjectSplit(ObjectAPI_PutObjectSplitServer) error
+	PutObjects(ObjectAPI_PutObjectsServer) error
+	GetObject(*Object, ObjectAPI_GetObjectServer) error
+	GetObjects(*GetObjectsRequest, ObjectAPI_GetObjectsServer) error
+	GetBlocks(*GetBlocksRequest, ObjectAPI_GetBlocksServer) error
+	TagObject(context.Context, *TagObjectRequest) (*types.Empty, error)
+	InspectObject(context.Context, *Object) (*ObjectInfo, error)
+	// CheckObject checks if an object exists in the blob store without
+	// actually reading the object.
+	CheckObject(context.Context, *CheckObjectRequest) (*CheckObjectResponse, error)
+	ListObjects(*ListObjectsRequest, ObjectAPI_ListObjectsServer) error
+	DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error)
+	GetTag(*Tag, ObjectAPI_GetTagServer) error
+	InspectTag(context.Context, *Tag) (*ObjectInfo, error)
+	ListTags(*ListTagsRequest, ObjectAPI_ListTagsServer) error
+	DeleteTags(context.Context, *DeleteTagsRequest) (*DeleteTagsResponse, error)
+	Compact(context.Context, *types.Empty) (*types.Empty, error)
 }
 
-func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func RegisterObjectAPIServer(s *grpc.Server, srv ObjectAPIServer) {
+	s.RegisterService(&_ObjectAPI_serviceDesc, srv)
 }
 
-func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Block != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
-		n62, err62 := m.Block.MarshalTo(dAtA[i:])
-		if err62 != nil {
-			return 0, err62
-		}
-		i += n62
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func _ObjectAPI_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectAPIServer).PutObject(&objectAPIPutObjectServer{stream})
 }
 
-func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_PutObjectServer interface {
+	SendAndClose(*Object) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
 }
 
-func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIPutObjectServer struct {
+	grpc.ServerStream
 }
 
-func (m *GetBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIPutObjectServer) SendAndClose(m *Object) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GetBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRefs) > 0 {
-		for _, msg := range m.BlockRefs {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func _ObjectAPI_PutObjectSplit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectAPIServer).PutObjectSplit(&objectAPIPutObjectSplitServer{stream})
 }
 
-func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_PutObjectSplitServer interface {
+	SendAndClose(*Objects) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
 }
 
-func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n63, err63 := m.Object.MarshalTo(dAtA[i:])
-		if err63 != nil {
-			return 0, err63
-		}
-		i += n63
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIPutObjectSplitServer struct {
+	grpc.ServerStream
 }
 
-func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIPutObjectSplitServer) SendAndClose(m *Objects) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectSplitServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+func _ObjectAPI_PutObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectAPIServer).PutObjects(&objectAPIPutObjectsServer{stream})
 }
 
-func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_PutObjectsServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*PutObjectRequest, error)
+	grpc.ServerStream
 }
 
-func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prefix) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
-		i += copy(dAtA[i:], m.Prefix)
-	}
-	if m.IncludeObject {
-		dAtA[i] = 0x10
-		i++
-		if m.IncludeObject {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIPutObjectsServer struct {
+	grpc.ServerStream
 }
 
-func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func (x *objectAPIPutObjectsServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *objectAPIPutObjectsServer) Recv() (*PutObjectRequest, error) {
+	m := new(PutObjectRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Tag != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tag.Size()))
-		n64, err64 := m.Tag.MarshalTo(dAtA[i:])
-		if err64 != nil {
-			return 0, err64
-		}
-		i += n64
-	}
-	if m.Object != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n65, err65 := m.Object.MarshalTo(dAtA[i:])
-		if err65 != nil {
-			return 0, err65
-		}
-		i += n65
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _ObjectAPI_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Object)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ObjectAPIServer).GetObject(m, &objectAPIGetObjectServer{stream})
 }
 
-func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetObjectServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
 }
 
-func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIGetObjectServer struct {
+	grpc.ServerStream
 }
 
-func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *objectAPIGetObjectServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _ObjectAPI_GetObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ObjectAPIServer).GetObjects(m, &objectAPIGetObjectsServer{stream})
 }
 
-func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetObjectsServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
 }
 
-func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIGetObjectsServer struct {
+	grpc.ServerStream
 }
 
-func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *objectAPIGetObjectsServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _ObjectAPI_GetBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(ObjectAPIServer).GetBlocks(m, &objectAPIGetBlocksServer{stream})
 }
 
-func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ObjectAPI_GetBlocksServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
 }
 
-func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n66, err66 := m.Object.MarshalTo(dAtA[i:])
-		if err66 != nil {
-			return 0, err66
-		}
-		i += n66
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type objectAPIGetBlocksServer struct {
+	grpc.ServerStream
 }
 
-func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *objectAPIGetBlocksServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Exists {
-		dAtA[i] = 0x8
-		i++
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func _ObjectAPI_TagObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).TagObject(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/TagObject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).TagObject(ctx, req.(*TagObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Objects) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ObjectAPI_InspectObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Object)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).InspectObject(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/InspectObject",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).InspectObject(ctx, req.(*Object))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ObjectAPI_CheckObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckObjectRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for k, _ := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			v := m.Objects[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n67, err67 := v.MarshalTo(dAtA[i:])
-				if err67 != nil {
-					return 0, err67
-				}
-				i += n67
-			}
-		}
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).CheckObject(ctx, in)
 	}
-	if len(m.Tags) > 0 {
-		for k, _ := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			v := m.Tags[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n68, err68 := v.MarshalTo(dAtA[i:])
-				if err68 != nil {
-					return 0, err68
-				}
-				i += n68
-			}
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/CheckObject",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).CheckObject(ctx, req.(*CheckObjectRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _ObjectAPI_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return srv.(ObjectAPIServer).ListObjects(m, &objectAPIListObjectsServer{stream})
 }
-func (m *Repo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+type ObjectAPI_ListObjectsServer interface {
+	Send(*Object) error
+	grpc.ServerStream
 }
 
-func (m *Branch) Size() (n int) {
-	if m == nil {
-		return 0
+type objectAPIListObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectAPIListObjectsServer) Send(m *Object) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).DeleteObjects(ctx, in)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/DeleteObjects",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BranchInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func _ObjectAPI_GetTag_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Tag)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	return srv.(ObjectAPIServer).GetTag(m, &objectAPIGetTagServer{stream})
+}
+
+type ObjectAPI_GetTagServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
+}
+
+type objectAPIGetTagServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectAPIGetTagServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_InspectTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tag)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).InspectTag(ctx, in)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/InspectTag",
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).InspectTag(ctx, req.(*Tag))
 	}
-	if len(m.Subvenance) > 0 {
-		for _, e := range m.Subvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if len(m.DirectProvenance) > 0 {
-		for _, e := range m.DirectProvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectAPI_ListTags_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTagsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(ObjectAPIServer).ListTags(m, &objectAPIListTagsServer{stream})
 }
 
-func (m *BranchInfos) Size() (n int) {
-	if m == nil {
-		return 0
+type ObjectAPI_ListTagsServer interface {
+	Send(*ListTagsResponse) error
+	grpc.ServerStream
+}
+
+type objectAPIListTagsServer struct {
+	grpc.ServerStream
+}
+
+func (x *objectAPIListTagsServer) Send(m *ListTagsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ObjectAPI_DeleteTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.BranchInfo) > 0 {
-		for _, e := range m.BranchInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).DeleteTags(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/DeleteTags",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).DeleteTags(ctx, req.(*DeleteTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *File) Size() (n int) {
-	if m == nil {
-		return 0
+func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if interceptor == nil {
+		return srv.(ObjectAPIServer).Compact(ctx, in)
 	}
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.ObjectAPI/Compact",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectAPIServer).Compact(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Block) Size() (n int) {
-	if m == nil {
-		return 0
+var _ObjectAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pfs.ObjectAPI",
+	HandlerType: (*ObjectAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TagObject",
+			Handler:    _ObjectAPI_TagObject_Handler,
+		},
+		{
+			MethodName: "InspectObject",
+			Handler:    _ObjectAPI_InspectObject_Handler,
+		},
+		{
+			MethodName: "CheckObject",
+			Handler:    _ObjectAPI_CheckObject_Handler,
+		},
+		{
+			MethodName: "DeleteObjects",
+			Handler:    _ObjectAPI_DeleteObjects_Handler,
+		},
+		{
+			MethodName: "InspectTag",
+			Handler:    _ObjectAPI_InspectTag_Handler,
+		},
+		{
+			MethodName: "DeleteTags",
+			Handler:    _ObjectAPI_DeleteTags_Handler,
+		},
+		{
+			MethodName: "Compact",
+			Handler:    _ObjectAPI_Compact_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PutObject",
+			Handler:       _ObjectAPI_PutObject_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PutObjectSplit",
+			Handler:       _ObjectAPI_PutObjectSplit_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PutObjects",
+			Handler:       _ObjectAPI_PutObjects_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetObject",
+			Handler:       _ObjectAPI_GetObject_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetObjects",
+			Handler:       _ObjectAPI_GetObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetBlocks",
+			Handler:       _ObjectAPI_GetBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListObjects",
+			Handler:       _ObjectAPI_ListObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTag",
+			Handler:       _ObjectAPI_GetTag_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListTags",
+			Handler:       _ObjectAPI_ListTags_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/pfs/pfs.proto",
+}
+
+func (m *Repo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Repo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Object) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Branch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Branch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n1, err1 := m.Repo.MarshalTo(dAtA[i:])
+		if err1 != nil {
+			return 0, err1
+		}
+		i += n1
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Tag) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BranchInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RepoInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Head != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
+		n2, err2 := m.Head.MarshalTo(dAtA[i:])
+		if err2 != nil {
+			return 0, err2
+		}
+		i += n2
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Branch != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n3, err3 := m.Branch.MarshalTo(dAtA[i:])
+		if err3 != nil {
+			return 0, err3
+		}
+		i += n3
 	}
-	if m.AuthInfo != nil {
-		l = m.AuthInfo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Subvenance) > 0 {
+		for _, msg := range m.Subvenance {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Branches) > 0 {
-		for _, e := range m.Branches {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.DirectProvenance) > 0 {
+		for _, msg := range m.DirectProvenance {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RepoAuthInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BranchInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BranchInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.AccessLevel != 0 {
-		n += 1 + sovPfs(uint64(m.AccessLevel))
+	if len(m.BranchInfo) > 0 {
+		for _, msg := range m.BranchInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Commit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *File) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *File) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n4, err4 := m.Commit.MarshalTo(dAtA[i:])
+		if err4 != nil {
+			return 0, err4
+		}
+		i += n4
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CommitRange) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Lower != nil {
-		l = m.Lower.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Upper != nil {
-		l = m.Upper.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CommitProvenance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Object) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Object) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CommitInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.ParentCommit != nil {
-		l = m.ParentCommit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	return i, nil
+}
+
+func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n5, err5 := m.Repo.MarshalTo(dAtA[i:])
+		if err5 != nil {
+			return 0, err5
+		}
+		i += n5
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Created != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
+		n6, err6 := m.Created.MarshalTo(dAtA[i:])
+		if err6 != nil {
+			return 0, err6
+		}
+		i += n6
 	}
-	if len(m.Subvenance) > 0 {
-		for _, e := range m.Subvenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.AuthInfo != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AuthInfo.Size()))
+		n7, err7 := m.AuthInfo.MarshalTo(dAtA[i:])
+		if err7 != nil {
+			return 0, err7
 		}
+		i += n7
 	}
-	if len(m.ChildCommits) > 0 {
-		for _, e := range m.ChildCommits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branches) > 0 {
+		for _, msg := range m.Branches {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.ReadyProvenance != 0 {
-		n += 1 + sovPfs(uint64(m.ReadyProvenance))
+	if m.Quota != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n69, err69 := m.Quota.MarshalTo(dAtA[i:])
+		if err69 != nil {
+			return 0, err69
+		}
+		i += n69
 	}
-	if len(m.Trees) > 0 {
-		for _, e := range m.Trees {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.ExtractSchemas {
+		dAtA[i] = 0x48
+		i++
+		if m.ExtractSchemas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.Datums != nil {
-		l = m.Datums.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Retention != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Retention.Size()))
+		n70, err70 := m.Retention.MarshalTo(dAtA[i:])
+		if err70 != nil {
+			return 0, err70
+		}
+		i += n70
 	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Classification) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Classification)))
+		i += copy(dAtA[i:], m.Classification)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 2 + l + sovPfs(uint64(l))
+	if len(m.GetFileFilters) > 0 {
+		for _, msg := range m.GetFileFilters {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *FileInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RepoAuthInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoAuthInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.FileType != 0 {
-		n += 1 + sovPfs(uint64(m.FileType))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if len(m.Children) > 0 {
-		for _, s := range m.Children {
-			l = len(s)
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if len(m.BlockRefs) > 0 {
-		for _, e := range m.BlockRefs {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.Committed != nil {
-		l = m.Committed.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.AccessLevel != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AccessLevel))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ByteRange) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Commit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Commit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Lower != 0 {
-		n += 1 + sovPfs(uint64(m.Lower))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n8, err8 := m.Repo.MarshalTo(dAtA[i:])
+		if err8 != nil {
+			return 0, err8
+		}
+		i += n8
 	}
-	if m.Upper != 0 {
-		n += 1 + sovPfs(uint64(m.Upper))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BlockRef) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Lower != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Lower.Size()))
+		n9, err9 := m.Lower.MarshalTo(dAtA[i:])
+		if err9 != nil {
+			return 0, err9
+		}
+		i += n9
 	}
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Upper != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Upper.Size()))
+		n10, err10 := m.Upper.MarshalTo(dAtA[i:])
+		if err10 != nil {
+			return 0, err10
+		}
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ObjectInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitProvenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitProvenance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n11, err11 := m.Commit.MarshalTo(dAtA[i:])
+		if err11 != nil {
+			return 0, err11
+		}
+		i += n11
 	}
-	if m.BlockRef != nil {
-		l = m.BlockRef.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Branch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n12, err12 := m.Branch.MarshalTo(dAtA[i:])
+		if err12 != nil {
+			return 0, err12
+		}
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n13, err13 := m.Commit.MarshalTo(dAtA[i:])
+		if err13 != nil {
+			return 0, err13
+		}
+		i += n13
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.ParentCommit != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ParentCommit.Size()))
+		n14, err14 := m.ParentCommit.MarshalTo(dAtA[i:])
+		if err14 != nil {
+			return 0, err14
+		}
+		i += n14
 	}
-	if m.Update {
-		n += 2
+	if m.Started != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Started.Size()))
+		n15, err15 := m.Started.MarshalTo(dAtA[i:])
+		if err15 != nil {
+			return 0, err15
+		}
+		i += n15
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Finished != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Finished.Size()))
+		n16, err16 := m.Finished.MarshalTo(dAtA[i:])
+		if err16 != nil {
+			return 0, err16
+		}
+		i += n16
 	}
-	return n
-}
-
-func (m *InspectRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Tree != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n17, err17 := m.Tree.MarshalTo(dAtA[i:])
+		if err17 != nil {
+			return 0, err17
+		}
+		i += n17
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	return n
-}
-
-func (m *ListRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Subvenance) > 0 {
+		for _, msg := range m.Subvenance {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ChildCommits) > 0 {
+		for _, msg := range m.ChildCommits {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
-}
-
-func (m *ListRepoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ReadyProvenance != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ReadyProvenance))
 	}
-	var l int
-	_ = l
-	if len(m.RepoInfo) > 0 {
-		for _, e := range m.RepoInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Trees) > 0 {
+		for _, msg := range m.Trees {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Datums != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Datums.Size()))
+		n18, err18 := m.Datums.MarshalTo(dAtA[i:])
+		if err18 != nil {
+			return 0, err18
+		}
+		i += n18
 	}
-	return n
-}
-
-func (m *DeleteRepoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Branch != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n19, err19 := m.Branch.MarshalTo(dAtA[i:])
+		if err19 != nil {
+			return 0, err19
+		}
+		i += n19
 	}
-	var l int
-	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Force {
-		n += 2
+	if m.Phase != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Phase))
 	}
-	if m.All {
-		n += 2
+	if len(m.PhaseReason) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.PhaseReason)))
+		i += copy(dAtA[i:], m.PhaseReason)
+	}
+	if m.IndexStatus != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.IndexStatus))
+	}
+	if len(m.IndexReason) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.IndexReason)))
+		i += copy(dAtA[i:], m.IndexReason)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StartCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n20, err20 := m.File.MarshalTo(dAtA[i:])
+		if err20 != nil {
+			return 0, err20
+		}
+		i += n20
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.FileType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileType))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
-	return n
-}
-
-func (m *BuildCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	var l int
-	_ = l
-	if m.Parent != nil {
-		l = m.Parent.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.BlockRefs) > 0 {
+		for _, msg := range m.BlockRefs {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Committed != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Committed.Size()))
+		n21, err21 := m.Committed.MarshalTo(dAtA[i:])
+		if err21 != nil {
+			return 0, err21
+		}
+		i += n21
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Mode != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
 	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Schema != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Schema.Size()))
+		n71, err71 := m.Schema.MarshalTo(dAtA[i:])
+		if err71 != nil {
+			return 0, err71
 		}
+		i += n71
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *FinishCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ByteRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ByteRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Lower != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Lower))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Upper != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Upper))
 	}
-	if m.Tree != nil {
-		l = m.Tree.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Empty {
-		n += 2
+	return i, nil
+}
+
+func (m *BlockRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Trees) > 0 {
-		for _, e := range m.Trees {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
+		n22, err22 := m.Block.MarshalTo(dAtA[i:])
+		if err22 != nil {
+			return 0, err22
 		}
+		i += n22
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if m.Datums != nil {
-		l = m.Datums.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Range != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Range.Size()))
+		n23, err23 := m.Range.MarshalTo(dAtA[i:])
+		if err23 != nil {
+			return 0, err23
+		}
+		i += n23
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n24, err24 := m.Object.MarshalTo(dAtA[i:])
+		if err24 != nil {
+			return 0, err24
+		}
+		i += n24
 	}
-	if m.BlockState != 0 {
-		n += 1 + sovPfs(uint64(m.BlockState))
+	if m.BlockRef != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.BlockRef.Size()))
+		n25, err25 := m.BlockRef.MarshalTo(dAtA[i:])
+		if err25 != nil {
+			return 0, err25
+		}
+		i += n25
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n26, err26 := m.Repo.MarshalTo(dAtA[i:])
+		if err26 != nil {
+			return 0, err26
+		}
+		i += n26
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Update {
+		dAtA[i] = 0x20
+		i++
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Number != 0 {
-		n += 1 + sovPfs(uint64(m.Number))
+	if m.Quota != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Quota.Size()))
+		n72, err72 := m.Quota.MarshalTo(dAtA[i:])
+		if err72 != nil {
+			return 0, err72
+		}
+		i += n72
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ExtractSchemas {
+		dAtA[i] = 0x30
+		i++
+		if m.ExtractSchemas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *CommitInfos) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Retention != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Retention.Size()))
+		n73, err73 := m.Retention.MarshalTo(dAtA[i:])
+		if err73 != nil {
+			return 0, err73
+		}
+		i += n73
 	}
-	var l int
-	_ = l
-	if len(m.CommitInfo) > 0 {
-		for _, e := range m.CommitInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Classification) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Classification)))
+		i += copy(dAtA[i:], m.Classification)
+	}
+	if len(m.GetFileFilters) > 0 {
+		for _, msg := range m.GetFileFilters {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.SBranch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Provenance) > 0 {
-		for _, e := range m.Provenance {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n27, err27 := m.Repo.MarshalTo(dAtA[i:])
+		if err27 != nil {
+			return 0, err27
 		}
+		i += n27
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListRepoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRepoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.RepoInfo) > 0 {
+		for _, msg := range m.RepoInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteBranchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRepoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRepoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Branch != nil {
-		l = m.Branch.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n28, err28 := m.Repo.MarshalTo(dAtA[i:])
+		if err28 != nil {
+			return 0, err28
+		}
+		i += n28
 	}
 	if m.Force {
-		n += 2
+		dAtA[i] = 0x10
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.All {
+		dAtA[i] = 0x18
+		i++
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *StartCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FlushCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Parent != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
+		n29, err29 := m.Parent.MarshalTo(dAtA[i:])
+		if err29 != nil {
+			return 0, err29
 		}
+		i += n29
 	}
-	if len(m.ToRepos) > 0 {
-		for _, e := range m.ToRepos {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SubscribeCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BuildCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BuildCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Parent != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Parent.Size()))
+		n30, err30 := m.Parent.MarshalTo(dAtA[i:])
+		if err30 != nil {
+			return 0, err30
+		}
+		i += n30
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Tree != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n31, err31 := m.Tree.MarshalTo(dAtA[i:])
+		if err31 != nil {
+			return 0, err31
+		}
+		i += n31
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.State != 0 {
-		n += 1 + sovPfs(uint64(m.State))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinishCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinishCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n32, err32 := m.Commit.MarshalTo(dAtA[i:])
+		if err32 != nil {
+			return 0, err32
+		}
+		i += n32
 	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.Tree != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tree.Size()))
+		n33, err33 := m.Tree.MarshalTo(dAtA[i:])
+		if err33 != nil {
+			return 0, err33
+		}
+		i += n33
+	}
+	if m.Empty {
+		dAtA[i] = 0x20
+		i++
+		if m.Empty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Trees) > 0 {
+		for _, msg := range m.Trees {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.Datums != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Datums.Size()))
+		n34, err34 := m.Datums.MarshalTo(dAtA[i:])
+		if err34 != nil {
+			return 0, err34
+		}
+		i += n34
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *OverwriteIndex) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovPfs(uint64(m.Index))
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n35, err35 := m.Commit.MarshalTo(dAtA[i:])
+		if err35 != nil {
+			return 0, err35
+		}
+		i += n35
+	}
+	if m.BlockState != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.BlockState))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Recursive {
-		n += 2
-	}
-	if m.Delimiter != 0 {
-		n += 1 + sovPfs(uint64(m.Delimiter))
-	}
-	if m.TargetFileDatums != 0 {
-		n += 1 + sovPfs(uint64(m.TargetFileDatums))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n36, err36 := m.Repo.MarshalTo(dAtA[i:])
+		if err36 != nil {
+			return 0, err36
+		}
+		i += n36
 	}
-	if m.TargetFileBytes != 0 {
-		n += 1 + sovPfs(uint64(m.TargetFileBytes))
+	if m.From != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n37, err37 := m.From.MarshalTo(dAtA[i:])
+		if err37 != nil {
+			return 0, err37
+		}
+		i += n37
 	}
-	if m.OverwriteIndex != nil {
-		l = m.OverwriteIndex.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.To != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
+		n38, err38 := m.To.MarshalTo(dAtA[i:])
+		if err38 != nil {
+			return 0, err38
+		}
+		i += n38
 	}
-	if m.HeaderRecords != 0 {
-		n += 1 + sovPfs(uint64(m.HeaderRecords))
+	if m.Number != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Number))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutFileRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	l = len(m.ObjectHash)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.OverwriteIndex != nil {
-		l = m.OverwriteIndex.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.CommitInfo) > 0 {
+		for _, msg := range m.CommitInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutFileRecords) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Split {
-		n += 2
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Head != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Head.Size()))
+		n39, err39 := m.Head.MarshalTo(dAtA[i:])
+		if err39 != nil {
+			return 0, err39
 		}
+		i += n39
 	}
-	if m.Tombstone {
-		n += 2
+	if len(m.SBranch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SBranch)))
+		i += copy(dAtA[i:], m.SBranch)
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Branch != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n40, err40 := m.Branch.MarshalTo(dAtA[i:])
+		if err40 != nil {
+			return 0, err40
+		}
+		i += n40
 	}
-	if m.Footer != nil {
-		l = m.Footer.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Provenance) > 0 {
+		for _, msg := range m.Provenance {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CopyFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Src != nil {
-		l = m.Src.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Dst != nil {
-		l = m.Dst.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Overwrite {
-		n += 2
+	if m.Branch != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n41, err41 := m.Branch.MarshalTo(dAtA[i:])
+		if err41 != nil {
+			return 0, err41
+		}
+		i += n41
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n42, err42 := m.Repo.MarshalTo(dAtA[i:])
+		if err42 != nil {
+			return 0, err42
+		}
+		i += n42
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteBranchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteBranchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.Full {
-		n += 2
-	}
-	if m.History != 0 {
-		n += 1 + sovPfs(uint64(m.History))
+	if m.Branch != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n43, err43 := m.Branch.MarshalTo(dAtA[i:])
+		if err43 != nil {
+			return 0, err43
+		}
+		i += n43
+	}
+	if m.Force {
+		dAtA[i] = 0x10
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *WalkFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DeleteCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GlobFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n44, err44 := m.Commit.MarshalTo(dAtA[i:])
+		if err44 != nil {
+			return 0, err44
+		}
+		i += n44
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *FileInfos) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.FileInfo) > 0 {
-		for _, e := range m.FileInfo {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ToRepos) > 0 {
+		for _, msg := range m.ToRepos {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DiffFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubscribeCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NewFile != nil {
-		l = m.NewFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n45, err45 := m.Repo.MarshalTo(dAtA[i:])
+		if err45 != nil {
+			return 0, err45
+		}
+		i += n45
 	}
-	if m.OldFile != nil {
-		l = m.OldFile.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.Shallow {
-		n += 2
+	if m.From != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n46, err46 := m.From.MarshalTo(dAtA[i:])
+		if err46 != nil {
+			return 0, err46
+		}
+		i += n46
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.State))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DiffFileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.NewFiles) > 0 {
-		for _, e := range m.NewFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n47, err47 := m.File.MarshalTo(dAtA[i:])
+		if err47 != nil {
+			return 0, err47
 		}
+		i += n47
 	}
-	if len(m.OldFiles) > 0 {
-		for _, e := range m.OldFiles {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteFileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OverwriteIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OverwriteIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Index))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PutFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n48, err48 := m.File.MarshalTo(dAtA[i:])
+		if err48 != nil {
+			return 0, err48
 		}
+		i += n48
 	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
-	return n
-}
-
-func (m *GetObjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Url) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Url)))
+		i += copy(dAtA[i:], m.Url)
 	}
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Recursive {
+		dAtA[i] = 0x30
+		i++
+		if m.Recursive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
-	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovPfs(uint64(m.TotalSize))
+	if m.Delimiter != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Delimiter))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TargetFileDatums != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileDatums))
 	}
-	return n
-}
-
-func (m *GetBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TargetFileBytes != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TargetFileBytes))
 	}
-	var l int
-	_ = l
-	if len(m.BlockRefs) > 0 {
-		for _, e := range m.BlockRefs {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.OverwriteIndex != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
+		n49, err49 := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		if err49 != nil {
+			return 0, err49
 		}
+		i += n49
 	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	if m.HeaderRecords != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.HeaderRecords))
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	if m.Mode != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
 	}
-	if m.TotalSize != 0 {
-		n += 1 + sovPfs(uint64(m.TotalSize))
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TagObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PutFileRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PutFileRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if len(m.ObjectHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ObjectHash)))
+		i += copy(dAtA[i:], m.ObjectHash)
+	}
+	if m.OverwriteIndex != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
+		n50, err50 := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		if err50 != nil {
+			return 0, err50
 		}
+		i += n50
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListObjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PutFileRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListTagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Prefix)
-	if l > 0 {
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Split {
+		dAtA[i] = 0x8
+		i++
+		if m.Split {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.IncludeObject {
-		n += 2
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Tombstone {
+		dAtA[i] = 0x18
+		i++
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *ListTagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Header != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Header.Size()))
+		n51, err51 := m.Header.MarshalTo(dAtA[i:])
+		if err51 != nil {
+			return 0, err51
+		}
+		i += n51
 	}
-	var l int
-	_ = l
-	if m.Tag != nil {
-		l = m.Tag.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Footer != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Footer.Size()))
+		n52, err52 := m.Footer.MarshalTo(dAtA[i:])
+		if err52 != nil {
+			return 0, err52
+		}
+		i += n52
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
+	if m.Mode != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Mode))
+	}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteObjectsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CopyFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.Src != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
+		n53, err53 := m.Src.MarshalTo(dAtA[i:])
+		if err53 != nil {
+			return 0, err53
+		}
+		i += n53
+	}
+	if m.Dst != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
+		n54, err54 := m.Dst.MarshalTo(dAtA[i:])
+		if err54 != nil {
+			return 0, err54
+		}
+		i += n54
+	}
+	if m.Overwrite {
+		dAtA[i] = 0x18
+		i++
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteObjectsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n55, err55 := m.File.MarshalTo(dAtA[i:])
+		if err55 != nil {
+			return 0, err55
+		}
+		i += n55
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteTagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n56, err56 := m.File.MarshalTo(dAtA[i:])
+		if err56 != nil {
+			return 0, err56
+		}
+		i += n56
+	}
+	if m.Full {
+		dAtA[i] = 0x10
+		i++
+		if m.Full {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.History != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.History))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WalkFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalkFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n57, err57 := m.File.MarshalTo(dAtA[i:])
+		if err57 != nil {
+			return 0, err57
+		}
+		i += n57
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GlobFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n58, err58 := m.Commit.MarshalTo(dAtA[i:])
+		if err58 != nil {
+			return 0, err58
+		}
+		i += n58
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FileInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, msg := range m.FileInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiffFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
+		n59, err59 := m.NewFile.MarshalTo(dAtA[i:])
+		if err59 != nil {
+			return 0, err59
+		}
+		i += n59
+	}
+	if m.OldFile != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
+		n60, err60 := m.OldFile.MarshalTo(dAtA[i:])
+		if err60 != nil {
+			return 0, err60
+		}
+		i += n60
+	}
+	if m.Shallow {
+		dAtA[i] = 0x18
+		i++
+		if m.Shallow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiffFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, msg := range m.NewFiles {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OldFiles) > 0 {
+		for _, msg := range m.OldFiles {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
+		n61, err61 := m.File.MarshalTo(dAtA[i:])
+		if err61 != nil {
+			return 0, err61
+		}
+		i += n61
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Block != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Block.Size()))
+		n62, err62 := m.Block.MarshalTo(dAtA[i:])
+		if err62 != nil {
+			return 0, err62
+		}
+		i += n62
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockRefs) > 0 {
+		for _, msg := range m.BlockRefs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n63, err63 := m.Object.MarshalTo(dAtA[i:])
+		if err63 != nil {
+			return 0, err63
+		}
+		i += n63
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.IncludeObject {
+		dAtA[i] = 0x10
+		i++
+		if m.IncludeObject {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tag != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tag.Size()))
+		n64, err64 := m.Tag.MarshalTo(dAtA[i:])
+		if err64 != nil {
+			return 0, err64
+		}
+		i += n64
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n65, err65 := m.Object.MarshalTo(dAtA[i:])
+		if err65 != nil {
+			return 0, err65
+		}
+		i += n65
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n66, err66 := m.Object.MarshalTo(dAtA[i:])
+		if err66 != nil {
+			return 0, err66
+		}
+		i += n66
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		dAtA[i] = 0x8
+		i++
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Objects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, _ := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			v := m.Objects[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n67, err67 := v.MarshalTo(dAtA[i:])
+				if err67 != nil {
+					return 0, err67
+				}
+				i += n67
+			}
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			v := m.Tags[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n68, err68 := v.MarshalTo(dAtA[i:])
+				if err68 != nil {
+					return 0, err68
+				}
+				i += n68
+			}
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RepoQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoQuota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSizeBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxSizeBytes))
+	}
+	if m.MaxFileCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxFileCount))
+	}
+	if m.MaxCommits != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxCommits))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BandwidthLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BandwidthLimit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Principal) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Principal)))
+		i += copy(dAtA[i:], m.Principal)
+	}
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if m.BytesPerSec != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.BytesPerSec))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SchemaColumn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaColumn) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FileSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSchema) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Format) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.RowCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.RowCount))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetFileFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFileFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
+	}
+	if len(m.PathGlob) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.PathGlob)))
+		i += copy(dAtA[i:], m.PathGlob)
+	}
+	if len(m.Options) > 0 {
+		for k, _ := range m.Options {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Options[k]
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.RawScope != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.RawScope))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ResumeFinishRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeFinishRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n74, err74 := m.Commit.MarshalTo(dAtA[i:])
+		if err74 != nil {
+			return 0, err74
+		}
+		i += n74
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n75, err75 := m.Commit.MarshalTo(dAtA[i:])
+		if err75 != nil {
+			return 0, err75
+		}
+		i += n75
+	}
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *InspectCommitSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListCommitSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteCommitSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCommitSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.DryRun {
+		dAtA[i] = 0x10
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitTagInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitTagInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n76, err76 := m.Commit.MarshalTo(dAtA[i:])
+		if err76 != nil {
+			return 0, err76
+		}
+		i += n76
+	}
+	if m.Created != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
+		n77, err77 := m.Created.MarshalTo(dAtA[i:])
+		if err77 != nil {
+			return 0, err77
+		}
+		i += n77
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitTagInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitTagInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagInfo) > 0 {
+		for _, msg := range m.TagInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CreateCommitTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateCommitTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n78, err78 := m.Commit.MarshalTo(dAtA[i:])
+		if err78 != nil {
+			return 0, err78
+		}
+		i += n78
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListCommitTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCommitTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n79, err79 := m.Repo.MarshalTo(dAtA[i:])
+		if err79 != nil {
+			return 0, err79
+		}
+		i += n79
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DeleteCommitTagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCommitTagRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n80, err80 := m.Repo.MarshalTo(dAtA[i:])
+		if err80 != nil {
+			return 0, err80
+		}
+		i += n80
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SubscribeFilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeFilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n81, err81 := m.Repo.MarshalTo(dAtA[i:])
+		if err81 != nil {
+			return 0, err81
+		}
+		i += n81
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if m.From != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n82, err82 := m.From.MarshalTo(dAtA[i:])
+		if err82 != nil {
+			return 0, err82
+		}
+		i += n82
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FindFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n83, err83 := m.Commit.MarshalTo(dAtA[i:])
+		if err83 != nil {
+			return 0, err83
+		}
+		i += n83
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.MinSizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxSizeBytes))
+	}
+	if m.ModifiedSince != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ModifiedSince.Size()))
+		n84, err84 := m.ModifiedSince.MarshalTo(dAtA[i:])
+		if err84 != nil {
+			return 0, err84
+		}
+		i += n84
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BatchInspectFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchInspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n85, err85 := m.Commit.MarshalTo(dAtA[i:])
+		if err85 != nil {
+			return 0, err85
+		}
+		i += n85
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BatchInspectFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchInspectFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if m.FileInfo != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.FileInfo.Size()))
+		n86, err86 := m.FileInfo.MarshalTo(dAtA[i:])
+		if err86 != nil {
+			return 0, err86
+		}
+		i += n86
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GlobPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
+		n87, err87 := m.Commit.MarshalTo(dAtA[i:])
+		if err87 != nil {
+			return 0, err87
+		}
+		i += n87
+	}
+	if len(m.Pattern) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Pattern)))
+		i += copy(dAtA[i:], m.Pattern)
+	}
+	if m.SampleSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SampleSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GlobPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NumMatches != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NumMatches))
+	}
+	if len(m.Sample) > 0 {
+		for _, msg := range m.Sample {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.TotalSizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSizeBytes))
+	}
+	if m.MinSizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.MaxSizeBytes))
+	}
+	if m.AvgSizeBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.AvgSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DiffTabularFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffTabularFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
+		n88, err88 := m.NewFile.MarshalTo(dAtA[i:])
+		if err88 != nil {
+			return 0, err88
+		}
+		i += n88
+	}
+	if m.OldFile != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
+		n89, err89 := m.OldFile.MarshalTo(dAtA[i:])
+		if err89 != nil {
+			return 0, err89
+		}
+		i += n89
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TabularDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TabularDiff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Format) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if m.RowsAdded != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.RowsAdded))
+	}
+	if m.RowsRemoved != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.RowsRemoved))
+	}
+	if len(m.ColumnsAdded) > 0 {
+		for _, s := range m.ColumnsAdded {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ColumnsRemoved) > 0 {
+		for _, s := range m.ColumnsRemoved {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ColumnsChanged) > 0 {
+		for _, s := range m.ColumnsChanged {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Sampled {
+		dAtA[i] = 0x38
+		i++
+		if m.Sampled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintPfs(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Repo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Branch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Subvenance) > 0 {
+		for _, e := range m.Subvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.DirectProvenance) > 0 {
+		for _, e := range m.DirectProvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BranchInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BranchInfo) > 0 {
+		for _, e := range m.BranchInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *File) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Object) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.AuthInfo != nil {
+		l = m.AuthInfo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ExtractSchemas {
+		n += 2
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Classification)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.GetFileFilters) > 0 {
+		for _, e := range m.GetFileFilters {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAuthInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessLevel != 0 {
+		n += 1 + sovPfs(uint64(m.AccessLevel))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lower != nil {
+		l = m.Lower.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Upper != nil {
+		l = m.Upper.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitProvenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ParentCommit != nil {
+		l = m.ParentCommit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Subvenance) > 0 {
+		for _, e := range m.Subvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ChildCommits) > 0 {
+		for _, e := range m.ChildCommits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.ReadyProvenance != 0 {
+		n += 1 + sovPfs(uint64(m.ReadyProvenance))
+	}
+	if len(m.Trees) > 0 {
+		for _, e := range m.Trees {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Datums != nil {
+		l = m.Datums.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 2 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Phase != 0 {
+		n += 2 + sovPfs(uint64(m.Phase))
+	}
+	l = len(m.PhaseReason)
+	if l > 0 {
+		n += 2 + l + sovPfs(uint64(l))
+	}
+	if m.IndexStatus != 0 {
+		n += 2 + sovPfs(uint64(m.IndexStatus))
+	}
+	l = len(m.IndexReason)
+	if l > 0 {
+		n += 2 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileType != 0 {
+		n += 1 + sovPfs(uint64(m.FileType))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if len(m.Children) > 0 {
+		for _, s := range m.Children {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.BlockRefs) > 0 {
+		for _, e := range m.BlockRefs {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Committed != nil {
+		l = m.Committed.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ByteRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lower != 0 {
+		n += 1 + sovPfs(uint64(m.Lower))
+	}
+	if m.Upper != 0 {
+		n += 1 + sovPfs(uint64(m.Upper))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BlockRef != nil {
+		l = m.BlockRef.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.ExtractSchemas {
+		n += 2
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Classification)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.GetFileFilters) > 0 {
+		for _, e := range m.GetFileFilters {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRepoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RepoInfo) > 0 {
+		for _, e := range m.RepoInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRepoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BuildCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Parent != nil {
+		l = m.Parent.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinishCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Empty {
+		n += 2
+	}
+	if len(m.Trees) > 0 {
+		for _, e := range m.Trees {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.Datums != nil {
+		l = m.Datums.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BlockState != 0 {
+		n += 1 + sovPfs(uint64(m.BlockState))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPfs(uint64(m.Number))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommitInfo) > 0 {
+		for _, e := range m.CommitInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.SBranch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Provenance) > 0 {
+		for _, e := range m.Provenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteBranchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ToRepos) > 0 {
+		for _, e := range m.ToRepos {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPfs(uint64(m.State))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OverwriteIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovPfs(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Recursive {
+		n += 2
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovPfs(uint64(m.Delimiter))
+	}
+	if m.TargetFileDatums != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileDatums))
+	}
+	if m.TargetFileBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TargetFileBytes))
+	}
+	if m.OverwriteIndex != nil {
+		l = m.OverwriteIndex.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.HeaderRecords != 0 {
+		n += 1 + sovPfs(uint64(m.HeaderRecords))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutFileRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	l = len(m.ObjectHash)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OverwriteIndex != nil {
+		l = m.OverwriteIndex.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutFileRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Split {
+		n += 2
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Tombstone {
+		n += 2
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Footer != nil {
+		l = m.Footer.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPfs(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CopyFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Dst != nil {
+		l = m.Dst.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Full {
+		n += 2
+	}
+	if m.History != 0 {
+		n += 1 + sovPfs(uint64(m.History))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WalkFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FileInfo) > 0 {
+		for _, e := range m.FileInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Shallow {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NewFiles) > 0 {
+		for _, e := range m.NewFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.OldFiles) > 0 {
+		for _, e := range m.OldFiles {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovPfs(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockRefs) > 0 {
+		for _, e := range m.BlockRefs {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovPfs(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TagObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.IncludeObject {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteObjectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteObjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckObjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckObjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Objects) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ObjectIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, v := range m.Objects {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPfs(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxSizeBytes))
+	}
+	if m.MaxFileCount != 0 {
+		n += 1 + sovPfs(uint64(m.MaxFileCount))
+	}
+	if m.MaxCommits != 0 {
+		n += 1 + sovPfs(uint64(m.MaxCommits))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BandwidthLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Principal)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.BytesPerSec != 0 {
+		n += 1 + sovPfs(uint64(m.BytesPerSec))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaColumn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.RowCount != 0 {
+		n += 1 + sovPfs(uint64(m.RowCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetFileFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.PathGlob)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for k, v := range m.Options {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + 1 + len(v) + sovPfs(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+		}
+	}
+	if m.RawScope != 0 {
+		n += 1 + sovPfs(uint64(m.RawScope))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResumeFinishRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteCommitSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitTagInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitTagInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TagInfo) > 0 {
+		for _, e := range m.TagInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateCommitTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListCommitTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteCommitTagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeFilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxSizeBytes))
+	}
+	if m.ModifiedSince != nil {
+		l = m.ModifiedSince.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchInspectFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchInspectFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.FileInfo != nil {
+		l = m.FileInfo.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.SampleSize != 0 {
+		n += 1 + sovPfs(uint64(m.SampleSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumMatches != 0 {
+		n += 1 + sovPfs(uint64(m.NumMatches))
+	}
+	if len(m.Sample) > 0 {
+		for _, e := range m.Sample {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.TotalSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.TotalSizeBytes))
+	}
+	if m.MinSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MinSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.MaxSizeBytes))
+	}
+	if m.AvgSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.AvgSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffTabularFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewFile != nil {
+		l = m.NewFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.OldFile != nil {
+		l = m.OldFile.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TabularDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.RowsAdded != 0 {
+		n += 1 + sovPfs(uint64(m.RowsAdded))
+	}
+	if m.RowsRemoved != 0 {
+		n += 1 + sovPfs(uint64(m.RowsRemoved))
+	}
+	if len(m.ColumnsAdded) > 0 {
+		for _, s := range m.ColumnsAdded {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ColumnsRemoved) > 0 {
+		for _, s := range m.ColumnsRemoved {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.ColumnsChanged) > 0 {
+		for _, s := range m.ColumnsChanged {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.Sampled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPfs(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPfs(x uint64) (n int) {
+	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Repo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Branch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &Commit{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subvenance = append(m.Subvenance, &Branch{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
+			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
+			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *File) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: File: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &Branch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &RepoQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractSchemas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExtractSchemas = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &types.Duration{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetFileFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GetFileFilters = append(m.GetFileFilters, &GetFileFilter{})
+			if err := m.GetFileFilters[len(m.GetFileFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			}
+			m.AccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessLevel |= auth.Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lower == nil {
+				m.Lower = &Commit{}
+			}
+			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upper == nil {
+				m.Upper = &Commit{}
+			}
+			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitProvenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitProvenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subvenance = append(m.Subvenance, &CommitRange{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildCommits = append(m.ChildCommits, &Commit{})
+			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyProvenance", wireType)
+			}
+			m.ReadyProvenance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyProvenance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trees = append(m.Trees, &Object{})
+			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datums == nil {
+				m.Datums = &Object{}
+			}
+			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &CommitProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= CommitPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhaseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexStatus", wireType)
+			}
+			m.IndexStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexStatus |= IndexStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			}
+			m.FileType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileType |= FileType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRefs = append(m.BlockRefs, &BlockRef{})
+			if err := m.BlockRefs[len(m.BlockRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Committed == nil {
+				m.Committed = &types.Timestamp{}
+			}
+			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &FileSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			m.Lower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			m.Upper = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upper |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &ByteRange{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
+			}
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Update = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &RepoQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractSchemas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExtractSchemas = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retention == nil {
+				m.Retention = &types.Duration{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetFileFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GetFileFilters = append(m.GetFileFilters, &GetFileFilter{})
+			if err := m.GetFileFilters[len(m.GetFileFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DeleteTagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *CheckObjectRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
-		n += 1 + l + sovPfs(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CheckObjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Exists {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *Objects) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
+	return nil
+}
+func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
+			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *ObjectIndex) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Objects) > 0 {
-		for k, v := range m.Objects {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+	return nil
+}
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
 		}
-	}
-	if len(m.Tags) > 0 {
-		for k, v := range m.Tags {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPfs(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			mapEntrySize := 1 + len(k) + sovPfs(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPfs(uint64(mapEntrySize))
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovPfs(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPfs(x uint64) (n int) {
-	return sovPfs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Repo) Unmarshal(dAtA []byte) error {
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10901,15 +19079,83 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
+			}
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10937,7 +19183,41 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &CommitProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10964,7 +19244,7 @@ func (m *Repo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Branch) Unmarshal(dAtA []byte) error {
+func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10987,15 +19267,15 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Branch: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Branch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11022,16 +19302,52 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11059,7 +19375,73 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &CommitProvenance{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11086,7 +19468,7 @@ func (m *Branch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfo) Unmarshal(dAtA []byte) error {
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11109,17 +19491,17 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11129,29 +19511,33 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11161,31 +19547,27 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11212,14 +19594,36 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Empty = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11246,18 +19650,16 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trees = append(m.Trees, &Object{})
+			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var msglen int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11267,29 +19669,14 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SizeBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subvenance = append(m.Subvenance, &Branch{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DirectProvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11316,8 +19703,10 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DirectProvenance = append(m.DirectProvenance, &Branch{})
-			if err := m.DirectProvenance[len(m.DirectProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Datums == nil {
+				m.Datums = &Object{}
+			}
+			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11346,7 +19735,7 @@ func (m *BranchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BranchInfos) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11369,15 +19758,15 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BranchInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BranchInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11404,11 +19793,32 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchInfo = append(m.BranchInfo, &BranchInfo{})
-			if err := m.BranchInfo[len(m.BranchInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
+			}
+			m.BlockState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockState |= CommitState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11434,7 +19844,7 @@ func (m *BranchInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *File) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11457,15 +19867,15 @@ func (m *File) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: File: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: File: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11492,18 +19902,18 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11513,83 +19923,33 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Block) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Block: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11599,83 +19959,33 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Object) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var stringLen uint64
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11685,24 +19995,11 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Number |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11728,7 +20025,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tag) Unmarshal(dAtA []byte) error {
+func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11751,17 +20048,17 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11771,23 +20068,25 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
+			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11814,7 +20113,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11837,15 +20136,15 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11872,71 +20171,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Head == nil {
+				m.Head = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11964,11 +20208,11 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11995,16 +20239,16 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12031,8 +20275,8 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branches = append(m.Branches, &Branch{})
-			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12061,7 +20305,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12084,17 +20328,17 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			m.AccessLevel = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12104,11 +20348,28 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessLevel |= auth.Scope(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12134,7 +20395,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12157,10 +20418,10 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12199,38 +20460,6 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12256,7 +20485,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRange) Unmarshal(dAtA []byte) error {
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12279,15 +20508,15 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12314,18 +20543,18 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lower == nil {
-				m.Lower = &Commit{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12335,28 +20564,12 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Upper == nil {
-				m.Upper = &Commit{}
-			}
-			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12382,7 +20595,7 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12402,54 +20615,18 @@ func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitProvenance: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitProvenance: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12476,10 +20653,10 @@ func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12508,7 +20685,7 @@ func (m *CommitProvenance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12531,15 +20708,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12566,16 +20743,14 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12602,107 +20777,68 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
-			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ToRepos = append(m.ToRepos, &Repo{})
+			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
-			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12729,16 +20865,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12766,11 +20902,11 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12797,16 +20933,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subvenance = append(m.Subvenance, &CommitRange{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12816,48 +20954,68 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= CommitState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChildCommits = append(m.ChildCommits, &Commit{})
-			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyProvenance", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ReadyProvenance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadyProvenance |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12884,16 +21042,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trees = append(m.Trees, &Object{})
-			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
 			}
-			var msglen int
+			m.OffsetBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12903,33 +21063,16 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OffsetBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Datums == nil {
-				m.Datums = &Object{}
-			}
-			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var msglen int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12939,33 +21082,70 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12975,26 +21155,11 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &CommitProvenance{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -13020,7 +21185,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13043,10 +21208,10 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13085,11 +21250,11 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.FileType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13099,33 +21264,29 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= FileType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13153,13 +21314,13 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13169,31 +21330,36 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Recursive = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.Delimiter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delimiter |= Delimiter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
 			}
-			var msglen int
+			m.TargetFileDatums = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13203,31 +21369,16 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TargetFileDatums |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
 			}
-			var msglen int
+			m.TargetFileBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13237,29 +21388,14 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TargetFileBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRefs = append(m.BlockRefs, &BlockRef{})
-			if err := m.BlockRefs[len(m.BlockRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13286,72 +21422,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Committed == nil {
-				m.Committed = &types.Timestamp{}
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
 			}
-			if err := m.Committed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ByteRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRecords", wireType)
 			}
-			m.Lower = 0
+			m.HeaderRecords = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13361,89 +21443,16 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lower |= uint64(b&0x7F) << shift
+				m.HeaderRecords |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
-			}
-			m.Upper = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Upper |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13453,33 +21462,16 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Mode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13489,27 +21481,23 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &ByteRange{}
-			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13536,7 +21524,7 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13559,17 +21547,36 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13579,31 +21586,27 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
-			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13630,10 +21633,10 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
-			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
+			}
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13662,7 +21665,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13685,15 +21688,35 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Split = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13720,18 +21743,16 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &PutFileRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13741,29 +21762,17 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Tombstone = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13773,69 +21782,31 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Update = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Header == nil {
+				m.Header = &PutFileRecord{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13862,126 +21833,37 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Footer == nil {
+				m.Footer = &PutFileRecord{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Footer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13991,25 +21873,23 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
-			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14036,7 +21916,7 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14059,15 +21939,15 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14094,18 +21974,18 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Src == nil {
+				m.Src = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14115,15 +21995,31 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dst == nil {
+				m.Dst = &File{}
+			}
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14140,7 +22036,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.All = bool(v != 0)
+			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14166,7 +22062,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14189,15 +22085,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14224,18 +22120,72 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14245,29 +22195,33 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14277,29 +22231,17 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			m.Full = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var msglen int
+			m.History = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14309,26 +22251,11 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.History |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &CommitProvenance{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14354,7 +22281,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14377,15 +22304,15 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WalkFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WalkFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14412,16 +22339,70 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14448,16 +22429,16 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14485,43 +22466,65 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14548,8 +22551,8 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &CommitProvenance{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FileInfo = append(m.FileInfo, &FileInfo{})
+			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14578,7 +22581,7 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14601,15 +22604,15 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14636,48 +22639,16 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.NewFile == nil {
+				m.NewFile = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14704,16 +22675,16 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.OldFile == nil {
+				m.OldFile = &File{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14730,10 +22701,64 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Empty = bool(v != 0)
-		case 5:
+			m.Shallow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14760,33 +22785,14 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trees = append(m.Trees, &Object{})
-			if err := m.Trees[len(m.Trees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewFiles = append(m.NewFiles, &FileInfo{})
+			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14813,10 +22819,8 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datums == nil {
-				m.Datums = &Object{}
-			}
-			if err := m.Datums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OldFiles = append(m.OldFiles, &FileInfo{})
+			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14845,7 +22849,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14868,15 +22872,15 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14903,32 +22907,13 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
-			}
-			m.BlockState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockState |= CommitState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14954,7 +22939,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutObjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14977,17 +22962,17 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutObjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -14997,31 +22982,29 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15048,16 +23031,14 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, &Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15084,32 +23065,13 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Commit{}
+			if m.Block == nil {
+				m.Block = &Block{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			m.Number = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Number |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15135,7 +23097,7 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfos) Unmarshal(dAtA []byte) error {
+func (m *GetObjectsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15158,15 +23120,15 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetObjectsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15193,11 +23155,68 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
-			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+			}
+			m.OffsetBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15223,7 +23242,7 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *GetBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15246,15 +23265,15 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15281,18 +23300,16 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockRefs = append(m.BlockRefs, &BlockRef{})
+			if err := m.BlockRefs[len(m.BlockRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SBranch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
 			}
-			var stringLen uint64
+			m.OffsetBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15302,27 +23319,106 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OffsetBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TagObjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TagObjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TagObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15349,16 +23445,16 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Object == nil {
+				m.Object = &Object{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15385,8 +23481,8 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, &Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15415,7 +23511,7 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *ListObjectsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15438,48 +23534,12 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListObjectsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15505,7 +23565,7 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15528,17 +23588,17 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15548,28 +23608,44 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeObject", wireType)
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IncludeObject = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15595,7 +23671,7 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15618,15 +23694,15 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15653,18 +23729,18 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
+			if m.Tag == nil {
+				m.Tag = &Tag{}
 			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -15674,12 +23750,28 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &Object{}
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15705,7 +23797,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteObjectsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15728,15 +23820,15 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteObjectsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15763,10 +23855,8 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15795,7 +23885,7 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteObjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15814,84 +23904,16 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commits = append(m.Commits, &Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToRepos = append(m.ToRepos, &Repo{})
-			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteObjectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -15917,7 +23939,7 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteTagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15940,15 +23962,15 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteTagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15975,100 +23997,65 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, &Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= CommitState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16094,7 +24081,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckObjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16117,15 +24104,15 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckObjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16152,37 +24139,72 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Object == nil {
+				m.Object = &Object{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OffsetBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffsetBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
 			}
-		case 3:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckObjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckObjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			m.SizeBytes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16192,11 +24214,12 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Exists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16222,7 +24245,7 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
+func (m *Objects) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16245,17 +24268,17 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: Objects: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Objects: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16265,11 +24288,26 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16295,7 +24333,7 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16318,15 +24356,15 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16353,159 +24391,109 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Recursive = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
-			}
-			m.Delimiter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delimiter |= Delimiter(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
-			}
-			m.TargetFileDatums = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileDatums |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+			if m.Objects == nil {
+				m.Objects = make(map[string]*BlockRef)
 			}
-			m.TargetFileBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *BlockRef
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &BlockRef{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 10:
+			m.Objects[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16532,32 +24520,106 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
-			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRecords", wireType)
+			if m.Tags == nil {
+				m.Tags = make(map[string]*Object)
 			}
-			m.HeaderRecords = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *Object
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HeaderRecords |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Object{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16583,7 +24645,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
+func (m *RepoQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16606,17 +24668,17 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
 			}
-			m.SizeBytes = 0
+			m.MaxSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16626,16 +24688,16 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				m.MaxSizeBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileCount", wireType)
 			}
-			var stringLen uint64
+			m.MaxFileCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16645,29 +24707,16 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxFileCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommits", wireType)
 			}
-			var msglen int
+			m.MaxCommits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16677,28 +24726,11 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxCommits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
-			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16724,7 +24756,8 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
+
+func (m *BandwidthLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16745,93 +24778,19 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Split = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, &PutFileRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Tombstone = bool(v != 0)
-		case 4:
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandwidthLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandwidthLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16841,33 +24800,29 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &PutFileRecord{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Principal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Footer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16877,28 +24832,43 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Footer == nil {
-				m.Footer = &PutFileRecord{}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSec", wireType)
 			}
-			if err := m.Footer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BytesPerSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -16924,7 +24894,8 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *SchemaColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16947,17 +24918,17 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -16967,33 +24938,29 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &File{}
-			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17003,48 +24970,24 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Dst == nil {
-				m.Dst = &File{}
-			}
-			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17070,7 +25013,8 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *FileSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17093,15 +25037,47 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17128,13 +25104,30 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Columns = append(m.Columns, &SchemaColumn{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			}
+			m.RowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17160,7 +25153,8 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *GetFileFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17183,17 +25177,17 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFileFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFileFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17203,33 +25197,61 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathGlob", wireType)
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathGlob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17237,19 +25259,126 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPfs(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPfs
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.Full = bool(v != 0)
-		case 3:
+			m.Options[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawScope", wireType)
 			}
-			m.History = 0
+			m.RawScope = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17259,7 +25388,7 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.History |= int64(b&0x7F) << shift
+				m.RawScope |= auth.Scope(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -17289,7 +25418,8 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *ResumeFinishRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17312,15 +25442,15 @@ func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WalkFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeFinishRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WalkFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeFinishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17347,10 +25477,10 @@ func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17379,7 +25509,8 @@ func (m *WalkFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *CommitSetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17402,13 +25533,45 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitSetInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
@@ -17444,11 +25607,11 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17458,23 +25621,25 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.Commits = append(m.Commits, &CommitInfo{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17501,7 +25666,8 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfos) Unmarshal(dAtA []byte) error {
+
+func (m *InspectCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17524,17 +25690,17 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17544,25 +25710,23 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileInfo = append(m.FileInfo, &FileInfo{})
-			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17589,7 +25753,8 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *ListCommitSetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17612,53 +25777,72 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitSetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFile == nil {
-				m.NewFile = &File{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeleteCommitSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCommitSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCommitSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -17668,31 +25852,27 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldFile == nil {
-				m.OldFile = &File{}
-			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -17709,7 +25889,7 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Shallow = bool(v != 0)
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -17735,7 +25915,8 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+
+func (m *CommitTagInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17758,15 +25939,47 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitTagInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitTagInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17793,14 +26006,16 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFiles = append(m.NewFiles, &FileInfo{})
-			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17827,8 +26042,10 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldFiles = append(m.OldFiles, &FileInfo{})
-			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17857,7 +26074,8 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
+
+func (m *CommitTagInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17880,15 +26098,15 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitTagInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitTagInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17915,10 +26133,8 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TagInfo = append(m.TagInfo, &CommitTagInfo{})
+			if err := m.TagInfo[len(m.TagInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17947,7 +26163,8 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutObjectRequest) Unmarshal(dAtA []byte) error {
+
+func (m *CreateCommitTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17970,49 +26187,15 @@ func (m *PutObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateCommitTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateCommitTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18039,16 +26222,18 @@ func (m *PutObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, &Tag{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18058,27 +26243,23 @@ func (m *PutObjectRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18105,7 +26286,8 @@ func (m *PutObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetObjectsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *ListCommitTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18128,15 +26310,15 @@ func (m *GetObjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetObjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18163,68 +26345,13 @@ func (m *GetObjectsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
-			}
-			m.OffsetBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffsetBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
-			}
-			m.TotalSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18250,7 +26377,8 @@ func (m *GetObjectsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlocksRequest) Unmarshal(dAtA []byte) error {
+
+func (m *DeleteCommitTagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18273,15 +26401,15 @@ func (m *GetBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCommitTagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCommitTagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18308,54 +26436,18 @@ func (m *GetBlocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRefs = append(m.BlockRefs, &BlockRef{})
-			if err := m.BlockRefs[len(m.BlockRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
-			}
-			m.OffsetBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffsetBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.TotalSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18365,11 +26457,24 @@ func (m *GetBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18395,7 +26500,8 @@ func (m *GetBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TagObjectRequest) Unmarshal(dAtA []byte) error {
+
+func (m *SubscribeFilesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18418,15 +26524,15 @@ func (m *TagObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TagObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeFilesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TagObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeFilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18453,16 +26559,80 @@ func (m *TagObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18489,8 +26659,10 @@ func (m *TagObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, &Tag{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18519,7 +26691,8 @@ func (m *TagObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListObjectsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *FindFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18542,69 +26715,83 @@ func (m *ListObjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListObjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTagsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTagsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18632,13 +26819,13 @@ func (m *ListTagsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
 			}
-			var v int
+			m.MinSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18648,12 +26835,66 @@ func (m *ListTagsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MinSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
+			}
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeObject = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedSince == nil {
+				m.ModifiedSince = &types.Timestamp{}
+			}
+			if err := m.ModifiedSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18679,7 +26920,8 @@ func (m *ListTagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTagsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *BatchInspectFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18702,15 +26944,15 @@ func (m *ListTagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchInspectFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchInspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18737,18 +26979,18 @@ func (m *ListTagsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tag == nil {
-				m.Tag = &Tag{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -18758,27 +27000,55 @@ func (m *ListTagsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18805,7 +27075,8 @@ func (m *ListTagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteObjectsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *BatchInspectFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18828,15 +27099,47 @@ func (m *DeleteObjectsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteObjectsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchInspectFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteObjectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchInspectFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18863,65 +27166,45 @@ func (m *DeleteObjectsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FileInfo == nil {
+				m.FileInfo = &FileInfo{}
+			}
+			if err := m.FileInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteObjectsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteObjectsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteObjectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -18947,7 +27230,8 @@ func (m *DeleteObjectsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteTagsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *GlobPreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18970,15 +27254,15 @@ func (m *DeleteTagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobPreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19005,65 +27289,64 @@ func (m *DeleteTagsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, &Tag{})
-			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteTagsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
+			}
+			m.SampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTagsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19089,7 +27372,8 @@ func (m *DeleteTagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckObjectRequest) Unmarshal(dAtA []byte) error {
+
+func (m *GlobPreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19112,15 +27396,34 @@ func (m *CheckObjectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckObjectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobPreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckObjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMatches", wireType)
+			}
+			m.NumMatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMatches |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sample", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19147,13 +27450,87 @@ func (m *CheckObjectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
-			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sample = append(m.Sample, &FileInfo{})
+			if err := m.Sample[len(m.Sample)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSizeBytes", wireType)
+			}
+			m.TotalSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSizeBytes", wireType)
+			}
+			m.MinSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
+			}
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgSizeBytes", wireType)
+			}
+			m.AvgSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvgSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19179,7 +27556,8 @@ func (m *CheckObjectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckObjectResponse) Unmarshal(dAtA []byte) error {
+
+func (m *DiffTabularFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19202,17 +27580,17 @@ func (m *CheckObjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckObjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffTabularFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckObjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffTabularFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19222,69 +27600,31 @@ func (m *CheckObjectResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exists = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Objects) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NewFile == nil {
+				m.NewFile = &File{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Objects: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Objects: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19311,8 +27651,10 @@ func (m *Objects) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OldFile == nil {
+				m.OldFile = &File{}
+			}
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19341,7 +27683,8 @@ func (m *Objects) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
+
+func (m *TabularDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19364,17 +27707,87 @@ func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabularDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabularDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsAdded", wireType)
+			}
+			m.RowsAdded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsAdded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsRemoved", wireType)
+			}
+			m.RowsRemoved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsRemoved |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnsAdded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19384,126 +27797,61 @@ func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Objects == nil {
-				m.Objects = make(map[string]*BlockRef)
+			m.ColumnsAdded = append(m.ColumnsAdded, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnsRemoved", wireType)
 			}
-			var mapkey string
-			var mapvalue *BlockRef
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPfs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPfs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPfs
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPfs
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPfs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPfs
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPfs
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &BlockRef{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPfs(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPfs
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Objects[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnsRemoved = append(m.ColumnsRemoved, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnsChanged", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -19513,121 +27861,44 @@ func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPfs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = make(map[string]*Object)
+			m.ColumnsChanged = append(m.ColumnsChanged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampled", wireType)
 			}
-			var mapkey string
-			var mapvalue *Object
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPfs
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPfs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPfs
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPfs
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPfs
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPfs
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPfs
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Object{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPfs(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPfs
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Tags[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Sampled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19653,6 +27924,7 @@ func (m *ObjectIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipPfs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,9 +12,18 @@ import (
 
 const configEnvVar = "PACH_CONFIG"
 
-var defaultConfigDir = filepath.Join(os.Getenv("HOME"), ".pachyderm")
+var defaultConfigDir = filepath.Join(HomeDir(), ".pachyderm")
 var defaultConfigPath = filepath.Join(defaultConfigDir, "config.json")
 
+// HomeDir returns the current user's home directory. On Windows HOME is
+// usually unset, so it falls back to USERPROFILE there.
+func HomeDir() string {
+	if home := os.Getenv("HOME"); home != "" {
+		return home
+	}
+	return os.Getenv("USERPROFILE")
+}
+
 func configPath() string {
 	if env, ok := os.LookupEnv(configEnvVar); ok {
 		return env
@@ -0,0 +1,176 @@
+package grpcutil
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// contextTokenKey is the metadata key under which clients send their auth
+// token (it mirrors auth.ContextTokenKey, which we can't import here without
+// creating an import cycle). The token identifies the calling principal for
+// rate-limiting purposes; unauthenticated callers share one bucket.
+const contextTokenKey = "authn-token"
+
+// anonymousPrincipal is the bucket used for calls that carry no auth token.
+const anonymousPrincipal = "anonymous"
+
+// expensiveMethods contains the full names of RPCs that can be arbitrarily
+// expensive for pachd to serve (they may read entire hashtrees or block on
+// many commits/jobs). Calls to these methods are subject to the admission
+// controller below; everything else is admitted unconditionally.
+var expensiveMethods = map[string]bool{
+	"/pfs.API/GlobFile":        true,
+	"/pfs.API/GlobFileStream":  true,
+	"/pfs.API/DiffFile":        true,
+	"/pfs.API/FlushCommit":     true,
+	"/pps.API/ListDatum":       true,
+	"/pps.API/ListDatumStream": true,
+	"/pps.API/FlushJob":        true,
+}
+
+type principalState struct {
+	limiter  *rate.Limiter
+	inflight int
+}
+
+// admissionController bounds the damage a single caller can do with expensive
+// RPCs. It enforces three limits:
+//  1. a global cap on concurrently-running expensive RPCs (callers over the
+//     cap wait in line, so pachd's memory use stays bounded)
+//  2. a per-principal cap on concurrently-running-or-queued expensive RPCs,
+//     set below the global cap so that one principal can never occupy every
+//     slot--this is what keeps the queue fair
+//  3. a per-principal rate limit (token bucket) on expensive RPCs
+//
+// Limits 2 and 3 fail fast with RESOURCE_EXHAUSTED rather than queueing, so
+// a misbehaving script gets an immediate, retryable error instead of slowing
+// everyone down.
+type admissionController struct {
+	mu              sync.Mutex
+	sem             chan struct{}
+	perPrincipal    map[string]*principalState
+	maxPerPrincipal int
+	ratePerSecond   float64
+}
+
+var admission = newAdmissionController(32, 8, 10)
+
+func newAdmissionController(maxConcurrent, maxPerPrincipal int, ratePerSecond float64) *admissionController {
+	return &admissionController{
+		sem:             make(chan struct{}, maxConcurrent),
+		perPrincipal:    make(map[string]*principalState),
+		maxPerPrincipal: maxPerPrincipal,
+		ratePerSecond:   ratePerSecond,
+	}
+}
+
+// SetAdmissionLimits reconfigures the limits that the admission interceptors
+// apply to expensive RPCs. Pass zero for any argument to disable that limit.
+// It should be called before Serve (pachd calls it at startup, from env
+// configuration).
+func SetAdmissionLimits(maxConcurrent, maxPerPrincipal int, ratePerSecond float64) {
+	admission = newAdmissionController(maxConcurrent, maxPerPrincipal, ratePerSecond)
+}
+
+func errResourceExhausted(method, reason string) error {
+	return status.Error(codes.ResourceExhausted, fmt.Sprintf(
+		"rpc %q was not admitted: %s (this protects pachd from overload; wait and retry)", method, reason))
+}
+
+// principalKey extracts the caller's auth token from 'ctx' to use as their
+// rate-limiting bucket. The raw token (rather than the resolved username) is
+// good enough here: each user's tokens map to that user, and resolving the
+// username would require a call to the auth service on every RPC.
+func principalKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return anonymousPrincipal
+	}
+	if tokens := md.Get(contextTokenKey); len(tokens) > 0 && tokens[0] != "" {
+		return tokens[0]
+	}
+	return anonymousPrincipal
+}
+
+// admit decides whether a call to 'method' by the principal in 'ctx' may
+// proceed. If it returns a nil error, the caller must call the returned
+// release function when the RPC finishes.
+func (a *admissionController) admit(ctx context.Context, method string) (release func(), err error) {
+	if !expensiveMethods[method] {
+		return func() {}, nil
+	}
+	principal := principalKey(ctx)
+	a.mu.Lock()
+	ps, ok := a.perPrincipal[principal]
+	if !ok {
+		// prune idle principals so the map doesn't grow without bound
+		if len(a.perPrincipal) >= 1024 {
+			for k, v := range a.perPrincipal {
+				if v.inflight == 0 {
+					delete(a.perPrincipal, k)
+				}
+			}
+		}
+		ps = &principalState{
+			limiter: rate.NewLimiter(rate.Limit(a.ratePerSecond), int(2*a.ratePerSecond)),
+		}
+		a.perPrincipal[principal] = ps
+	}
+	if a.ratePerSecond > 0 && !ps.limiter.Allow() {
+		a.mu.Unlock()
+		return nil, errResourceExhausted(method, fmt.Sprintf("rate limit of %v expensive rpcs/s exceeded", a.ratePerSecond))
+	}
+	if a.maxPerPrincipal > 0 && ps.inflight >= a.maxPerPrincipal {
+		a.mu.Unlock()
+		return nil, errResourceExhausted(method, fmt.Sprintf("limit of %d concurrent expensive rpcs per caller exceeded", a.maxPerPrincipal))
+	}
+	ps.inflight++
+	a.mu.Unlock()
+	if cap(a.sem) > 0 {
+		select {
+		case a.sem <- struct{}{}:
+		case <-ctx.Done():
+			a.mu.Lock()
+			ps.inflight--
+			a.mu.Unlock()
+			return nil, ctx.Err()
+		}
+	}
+	return func() {
+		if cap(a.sem) > 0 {
+			<-a.sem
+		}
+		a.mu.Lock()
+		ps.inflight--
+		a.mu.Unlock()
+	}, nil
+}
+
+// AdmissionUnaryServerInterceptor rejects or queues calls to expensive RPCs
+// per the limits set with SetAdmissionLimits.
+func AdmissionUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	release, err := admission.admit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+// AdmissionStreamServerInterceptor rejects or queues calls to expensive
+// streaming RPCs per the limits set with SetAdmissionLimits.
+func AdmissionStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := admission.admit(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, stream)
+}
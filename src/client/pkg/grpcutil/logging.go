@@ -0,0 +1,150 @@
+package grpcutil
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PrincipalResolver resolves an auth token to the principal it belongs to.
+// pachd points this at its local auth API once that's serving; until then
+// (and when resolution fails) RPC log lines simply omit the principal.
+type PrincipalResolver func(ctx context.Context, token string) (string, error)
+
+var (
+	principalMu       sync.RWMutex
+	principalResolver PrincipalResolver
+	principalCache    = make(map[string]string)
+)
+
+// SetPrincipalResolver installs the resolver that the logging interceptors
+// use to attach principals to RPC log lines.
+func SetPrincipalResolver(resolver PrincipalResolver) {
+	principalMu.Lock()
+	defer principalMu.Unlock()
+	principalResolver = resolver
+	principalCache = make(map[string]string)
+}
+
+// rpcPrincipal returns the principal behind the auth token on 'ctx', or ""
+// if there's no token or it can't be resolved. Resolved tokens are cached so
+// busy principals don't cost a WhoAmI per RPC.
+func rpcPrincipal(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	tokens := md.Get(auth.ContextTokenKey)
+	if len(tokens) == 0 {
+		return ""
+	}
+	token := tokens[0]
+	principalMu.RLock()
+	resolver := principalResolver
+	principal, ok := principalCache[token]
+	principalMu.RUnlock()
+	if ok {
+		return principal
+	}
+	if resolver == nil {
+		return ""
+	}
+	principal, err := resolver(ctx, token)
+	if err != nil {
+		return ""
+	}
+	principalMu.Lock()
+	principalCache[token] = principal
+	principalMu.Unlock()
+	return principal
+}
+
+type requestIDKey struct{}
+
+// RequestID returns the request ID that the logging interceptor attached to
+// 'ctx', or "" if there is none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func newRequestID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
+
+func logRPC(ctx context.Context, fullMethod string, requestID string, start time.Time, err error) {
+	fields := log.Fields{
+		"request-id": requestID,
+		"rpc":        fullMethod,
+		"duration":   time.Since(start).Seconds(),
+	}
+	if principal := rpcPrincipal(ctx); principal != "" {
+		fields["principal"] = principal
+	}
+	entry := log.WithFields(fields)
+	if err != nil {
+		entry.Errorf("rpc failed: %v", err)
+	} else {
+		entry.Info("rpc finished")
+	}
+}
+
+// appendRequestID tags 'err' with the request ID that was logged for the
+// failed RPC, so users can report "request <ID> failed" and operators can
+// find the exact server-side log lines. The gRPC status code is preserved.
+func appendRequestID(err error, requestID string) error {
+	if err == nil || requestID == "" {
+		return err
+	}
+	if st, ok := status.FromError(err); ok {
+		return status.Errorf(st.Code(), "%s (request ID %s)", st.Message(), requestID)
+	}
+	return fmt.Errorf("%v (request ID %s)", err, requestID)
+}
+
+// LoggingUnaryServerInterceptor emits one structured log line per unary RPC,
+// with a generated request ID, the RPC name, the authenticated principal (if
+// it can be resolved) and the call's duration.
+func LoggingUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := newRequestID()
+	ctx = context.WithValue(ctx, requestIDKey{}, requestID)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRPC(ctx, info.FullMethod, requestID, start, err)
+	return resp, appendRequestID(err, requestID)
+}
+
+// LoggingStreamServerInterceptor is LoggingUnaryServerInterceptor for
+// streaming RPCs; the duration it logs covers the whole stream.
+func LoggingStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	requestID := newRequestID()
+	ctx := context.WithValue(stream.Context(), requestIDKey{}, requestID)
+	start := time.Now()
+	err := handler(srv, streamWithContext{stream, ctx})
+	logRPC(ctx, info.FullMethod, requestID, start, err)
+	return appendRequestID(err, requestID)
+}
+
+// streamWithContext overrides a ServerStream's context, so the request ID is
+// visible to handlers.
+type streamWithContext struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s streamWithContext) Context() context.Context {
+	return s.ctx
+}
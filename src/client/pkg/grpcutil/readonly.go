@@ -0,0 +1,137 @@
+package grpcutil
+
+import (
+	"sync/atomic"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readOnly is non-zero while pachd is in read-only mode.
+var readOnly int32
+
+// SetReadOnlyMode turns read-only mode on or off. While it's on, mutating
+// RPCs fail with a FailedPrecondition error and read RPCs keep working; it's
+// used during migrations, incident response, and on clusters serving as DR
+// replicas.
+func SetReadOnlyMode(on bool) {
+	if on {
+		atomic.StoreInt32(&readOnly, 1)
+	} else {
+		atomic.StoreInt32(&readOnly, 0)
+	}
+}
+
+// ReadOnlyMode returns whether read-only mode is on.
+func ReadOnlyMode() bool {
+	return atomic.LoadInt32(&readOnly) != 0
+}
+
+// readOnlyMethods is the set of RPCs that are still allowed in read-only
+// mode: everything that doesn't write to etcd or the object store (inspects,
+// lists, gets, subscriptions, version and health checks, etc.). Any RPC not
+// listed here is rejected, so a newly added RPC is blocked until someone
+// deliberately marks it read-only -- forgetting one fails closed instead of
+// letting a mutation through. Note that auth RPCs that mint or extend tokens
+// count as mutations: they write the token to etcd.
+var readOnlyMethods = map[string]bool{
+	"/pfs.API/BatchInspectFile":     true,
+	"/pfs.API/DiffFile":             true,
+	"/pfs.API/DiffTabularFile":      true,
+	"/pfs.API/FindFile":             true,
+	"/pfs.API/FlushCommit":          true,
+	"/pfs.API/GetFile":              true,
+	"/pfs.API/GlobFile":             true,
+	"/pfs.API/GlobFileStream":       true,
+	"/pfs.API/GlobPreview":          true,
+	"/pfs.API/InspectBranch":        true,
+	"/pfs.API/InspectCommit":        true,
+	"/pfs.API/InspectCommitSet":     true,
+	"/pfs.API/InspectFile":          true,
+	"/pfs.API/InspectRepo":          true,
+	"/pfs.API/ListBranch":           true,
+	"/pfs.API/ListBranchStream":     true,
+	"/pfs.API/ListCommit":           true,
+	"/pfs.API/ListCommitSet":        true,
+	"/pfs.API/ListCommitStream":     true,
+	"/pfs.API/ListCommitTag":        true,
+	"/pfs.API/ListFile":             true,
+	"/pfs.API/ListFileStream":       true,
+	"/pfs.API/ListRepo":             true,
+	"/pfs.API/SubscribeCommit":      true,
+	"/pfs.API/SubscribeFiles":       true,
+	"/pfs.API/WalkFile":             true,
+	"/pfs.ObjectAPI/CheckObject":    true,
+	"/pfs.ObjectAPI/GetBlocks":      true,
+	"/pfs.ObjectAPI/GetObject":      true,
+	"/pfs.ObjectAPI/GetObjects":     true,
+	"/pfs.ObjectAPI/GetTag":         true,
+	"/pfs.ObjectAPI/InspectObject":  true,
+	"/pfs.ObjectAPI/InspectTag":     true,
+	"/pfs.ObjectAPI/ListObjects":    true,
+	"/pfs.ObjectAPI/ListTags":       true,
+	"/pps.API/FlushJob":             true,
+	"/pps.API/GetKubeEvents":        true,
+	"/pps.API/GetLogs":              true,
+	"/pps.API/InspectDatum":         true,
+	"/pps.API/InspectJob":           true,
+	"/pps.API/InspectPipeline":      true,
+	"/pps.API/ListDatum":            true,
+	"/pps.API/ListDatumStream":      true,
+	"/pps.API/ListJob":              true,
+	"/pps.API/ListJobStream":        true,
+	"/pps.API/ListPipeline":         true,
+	"/pps.API/ValidateDAG":          true,
+	"/auth.API/Authorize":           true,
+	"/auth.API/ExplainScope":        true,
+	"/auth.API/GetACL":              true,
+	"/auth.API/GetAdmins":           true,
+	"/auth.API/GetConfiguration":    true,
+	"/auth.API/GetGroups":           true,
+	"/auth.API/GetScope":            true,
+	"/auth.API/GetUsers":            true,
+	"/auth.API/WhoAmI":              true,
+	"/enterprise.API/GetState":      true,
+	"/admin.API/Diagnose":           true,
+	"/admin.API/Extract":            true,
+	"/admin.API/ExtractPipeline":    true,
+	"/admin.API/InspectCluster":     true,
+	"/admin.API/ListBandwidthLimit": true,
+	"/debug.Debug/Binary":           true,
+	"/debug.Debug/Dump":             true,
+	"/debug.Debug/EtcdUsage":        true,
+	"/debug.Debug/Profile":          true,
+	// SetLogLevel changes in-process state only, and incident response (when
+	// read-only mode is most likely on) is exactly when it's needed.
+	"/debug.Debug/SetLogLevel":     true,
+	"/event.API/SubscribeEvents":   true,
+	"/health.Health/Health":        true,
+	"/health.Health/State":         true,
+	"/versionpb.API/GetAPIVersion": true,
+	"/versionpb.API/GetVersion":    true,
+}
+
+// errReadOnly returns the error mutating RPCs fail with in read-only mode.
+func errReadOnly(method string) error {
+	return status.Errorf(codes.FailedPrecondition, "cannot call %s: pachd is in read-only mode, so all mutating RPCs are rejected (reads still work); retry once the cluster administrator has turned read-only mode off", method)
+}
+
+// ReadOnlyUnaryServerInterceptor rejects mutating unary RPCs while read-only
+// mode is on.
+func ReadOnlyUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if ReadOnlyMode() && !readOnlyMethods[info.FullMethod] {
+		return nil, errReadOnly(info.FullMethod)
+	}
+	return handler(ctx, req)
+}
+
+// ReadOnlyStreamServerInterceptor rejects mutating streaming RPCs while
+// read-only mode is on.
+func ReadOnlyStreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if ReadOnlyMode() && !readOnlyMethods[info.FullMethod] {
+		return errReadOnly(info.FullMethod)
+	}
+	return handler(srv, stream)
+}
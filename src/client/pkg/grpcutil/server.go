@@ -9,6 +9,7 @@ import (
 	"path"
 	"time"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -78,8 +79,8 @@ func Serve(
 				MinTime:             5 * time.Second,
 				PermitWithoutStream: true,
 			}),
-			grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()),
-			grpc.StreamInterceptor(tracing.StreamServerInterceptor()),
+			grpc.UnaryInterceptor(chainUnaryServerInterceptors(tracing.UnaryServerInterceptor(), LoggingUnaryServerInterceptor, ReadOnlyUnaryServerInterceptor, AdmissionUnaryServerInterceptor)),
+			grpc.StreamInterceptor(chainStreamServerInterceptors(tracing.StreamServerInterceptor(), LoggingStreamServerInterceptor, ReadOnlyStreamServerInterceptor, AdmissionStreamServerInterceptor)),
 		}
 		if server.PublicPortTLSAllowed {
 			// Validate environment
@@ -125,3 +126,33 @@ func Serve(
 	}
 	return nil
 }
+
+// chainUnaryServerInterceptors combines several unary server interceptors
+// into one (earlier interceptors see the call first), since
+// grpc.UnaryInterceptor can only be passed once.
+func chainUnaryServerInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+// chainStreamServerInterceptors is chainUnaryServerInterceptors for streams.
+func chainStreamServerInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, stream grpc.ServerStream) error {
+				return interceptor(srv, stream, info, next)
+			}
+		}
+		return chained(srv, stream)
+	}
+}
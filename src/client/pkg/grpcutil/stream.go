@@ -13,6 +13,15 @@ var (
 	MaxMsgSize = 20 * 1024 * 1024
 )
 
+// SetMaxMsgSize overrides the default max gRPC message size, and resizes the
+// streaming chunk buffers (which are derived from it) to match. pachd calls
+// this at startup, from the GRPC_MAX_MSG_SIZE env var; it must be called
+// before any servers or clients are created.
+func SetMaxMsgSize(size int) {
+	MaxMsgSize = size
+	bufPool = NewBufPool(size / 10)
+}
+
 // Chunk splits a piece of data up, this is useful for splitting up data that's
 // bigger than MaxMsgSize
 func Chunk(data []byte, chunkSize int) [][]byte {
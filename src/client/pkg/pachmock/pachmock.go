@@ -0,0 +1,936 @@
+// Package pachmock provides an in-memory mock of the Pachyderm API for unit
+// testing code that uses the Pachyderm client, without a real cluster or
+// minikube. Commit and branch semantics are realistic for straightforward
+// usage (parents, branch heads, open vs finished commits); job handling is a
+// simplistic simulation in which finishing a commit in a pipeline's input
+// repo immediately produces a successful job and a finished output commit.
+package pachmock
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+// Mock is an in-memory pachd serving the PFS and PPS APIs over a real gRPC
+// connection on localhost.
+type Mock struct {
+	Addr string
+
+	server *grpc.Server
+	state  *state
+}
+
+// New starts a mock pachd on a random localhost port. Callers should call
+// Close when done.
+func New() (*Mock, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	mock := &Mock{
+		Addr:   listener.Addr().String(),
+		server: grpc.NewServer(grpc.MaxRecvMsgSize(grpcutil.MaxMsgSize), grpc.MaxSendMsgSize(grpcutil.MaxMsgSize)),
+		state:  newState(),
+	}
+	pfs.RegisterAPIServer(mock.server, &pfsServer{state: mock.state})
+	pps.RegisterAPIServer(mock.server, &ppsServer{state: mock.state})
+	go mock.server.Serve(listener)
+	return mock, nil
+}
+
+// Client returns a client connected to the mock.
+func (m *Mock) Client() (*client.APIClient, error) {
+	return client.NewFromAddress(m.Addr)
+}
+
+// Close shuts the mock down.
+func (m *Mock) Close() error {
+	m.server.Stop()
+	return nil
+}
+
+type repo struct {
+	info     *pfs.RepoInfo
+	branches map[string]string // branch name -> head commit ID
+	commits  map[string]*commit
+}
+
+type commit struct {
+	info  *pfs.CommitInfo
+	files map[string][]byte // cumulative view of the repo at this commit
+}
+
+type state struct {
+	mu        sync.Mutex
+	repos     map[string]*repo
+	pipelines map[string]*pps.PipelineInfo
+	jobs      map[string]*pps.JobInfo
+}
+
+func newState() *state {
+	return &state{
+		repos:     make(map[string]*repo),
+		pipelines: make(map[string]*pps.PipelineInfo),
+		jobs:      make(map[string]*pps.JobInfo),
+	}
+}
+
+func errNotFound(format string, args ...interface{}) error {
+	return status.Errorf(codes.NotFound, format, args...)
+}
+
+func errUnimplemented(method string) error {
+	return status.Errorf(codes.Unimplemented, "%s is not implemented by the mock pachd", method)
+}
+
+// createRepo creates 'name' if it doesn't exist; the caller must hold s.mu.
+func (s *state) createRepo(name string, description string) *repo {
+	if r, ok := s.repos[name]; ok {
+		return r
+	}
+	r := &repo{
+		info: &pfs.RepoInfo{
+			Repo:        &pfs.Repo{Name: name},
+			Created:     types.TimestampNow(),
+			Description: description,
+		},
+		branches: make(map[string]string),
+		commits:  make(map[string]*commit),
+	}
+	s.repos[name] = r
+	return r
+}
+
+// startCommit opens a new commit; the caller must hold s.mu.
+func (s *state) startCommit(r *repo, branch string, parentID string, description string) *commit {
+	if parentID == "" && branch != "" {
+		parentID = r.branches[branch]
+	}
+	c := &commit{
+		info: &pfs.CommitInfo{
+			Commit:      &pfs.Commit{Repo: r.info.Repo, ID: uuid.NewWithoutDashes()},
+			Description: description,
+			Started:     types.TimestampNow(),
+		},
+		files: make(map[string][]byte),
+	}
+	if branch != "" {
+		c.info.Branch = &pfs.Branch{Repo: r.info.Repo, Name: branch}
+	}
+	if parent, ok := r.commits[parentID]; ok {
+		c.info.ParentCommit = parent.info.Commit
+		for p, data := range parent.files {
+			c.files[p] = data
+		}
+		parent.info.ChildCommits = append(parent.info.ChildCommits, c.info.Commit)
+	}
+	r.commits[c.info.Commit.ID] = c
+	if branch != "" {
+		r.branches[branch] = c.info.Commit.ID
+	}
+	return c
+}
+
+// resolveCommit maps a branch name or commit ID to a commit; the caller must
+// hold s.mu.
+func (s *state) resolveCommit(repoName string, id string) (*repo, *commit, error) {
+	r, ok := s.repos[repoName]
+	if !ok {
+		return nil, nil, errNotFound("repo %q not found", repoName)
+	}
+	if head, ok := r.branches[id]; ok {
+		id = head
+	}
+	c, ok := r.commits[id]
+	if !ok {
+		return nil, nil, errNotFound("commit %q not found in repo %q", id, repoName)
+	}
+	return r, c, nil
+}
+
+// finishCommit closes 'c' and triggers the simplistic job simulation for any
+// pipeline that takes 'r' as an input; the caller must hold s.mu.
+func (s *state) finishCommit(r *repo, c *commit) {
+	if c.info.Finished != nil {
+		return
+	}
+	c.info.Finished = types.TimestampNow()
+	var size uint64
+	for _, data := range c.files {
+		size += uint64(len(data))
+	}
+	c.info.SizeBytes = size
+	r.info.SizeBytes = size
+	for _, pipelineInfo := range s.pipelines {
+		if inputUsesRepo(pipelineInfo.Input, r.info.Repo.Name) {
+			s.simulateJob(pipelineInfo, c)
+		}
+	}
+}
+
+// simulateJob runs the mock's job simulation: an immediately successful job
+// whose output commit is an empty finished commit on the pipeline's output
+// branch; the caller must hold s.mu.
+func (s *state) simulateJob(pipelineInfo *pps.PipelineInfo, input *commit) {
+	outputRepo := s.createRepo(pipelineInfo.Pipeline.Name, fmt.Sprintf("Output repo for pipeline %s.", pipelineInfo.Pipeline.Name))
+	outputBranch := pipelineInfo.OutputBranch
+	if outputBranch == "" {
+		outputBranch = "master"
+	}
+	outputCommit := s.startCommit(outputRepo, outputBranch, "", "")
+	s.finishCommit(outputRepo, outputCommit)
+	jobInfo := &pps.JobInfo{
+		Job:          &pps.Job{ID: uuid.NewWithoutDashes()},
+		Pipeline:     pipelineInfo.Pipeline,
+		Transform:    pipelineInfo.Transform,
+		Started:      types.TimestampNow(),
+		Finished:     types.TimestampNow(),
+		OutputCommit: outputCommit.info.Commit,
+		OutputRepo:   outputRepo.info.Repo,
+		State:        pps.JobState_JOB_SUCCESS,
+	}
+	s.jobs[jobInfo.Job.ID] = jobInfo
+}
+
+func inputUsesRepo(input *pps.Input, repoName string) bool {
+	if input == nil {
+		return false
+	}
+	result := false
+	pps.VisitInput(input, func(input *pps.Input) {
+		if input.Pfs != nil && input.Pfs.Repo == repoName {
+			result = true
+		}
+	})
+	return result
+}
+
+func fileInfo(c *commit, filePath string, data []byte) *pfs.FileInfo {
+	return &pfs.FileInfo{
+		File:      &pfs.File{Commit: c.info.Commit, Path: filePath},
+		FileType:  pfs.FileType_FILE,
+		SizeBytes: uint64(len(data)),
+		Committed: c.info.Finished,
+	}
+}
+
+//
+// PFS
+//
+
+type pfsServer struct {
+	state *state
+}
+
+func (a *pfsServer) CreateRepo(ctx context.Context, request *pfs.CreateRepoRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	if _, ok := a.state.repos[request.Repo.Name]; ok && !request.Update {
+		return nil, status.Errorf(codes.AlreadyExists, "repo %q already exists", request.Repo.Name)
+	}
+	a.state.createRepo(request.Repo.Name, request.Description)
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoRequest) (*pfs.RepoInfo, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Repo.Name)
+	}
+	return r.info, nil
+}
+
+func (a *pfsServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest) (*pfs.ListRepoResponse, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	response := &pfs.ListRepoResponse{}
+	var names []string
+	for name := range a.state.repos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		response.RepoInfo = append(response.RepoInfo, a.state.repos[name].info)
+	}
+	return response, nil
+}
+
+func (a *pfsServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	if request.All {
+		a.state.repos = make(map[string]*repo)
+		return &types.Empty{}, nil
+	}
+	if _, ok := a.state.repos[request.Repo.Name]; !ok {
+		return nil, errNotFound("repo %q not found", request.Repo.Name)
+	}
+	delete(a.state.repos, request.Repo.Name)
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) StartCommit(ctx context.Context, request *pfs.StartCommitRequest) (*pfs.Commit, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Parent.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Parent.Repo.Name)
+	}
+	c := a.state.startCommit(r, request.Branch, request.Parent.ID, request.Description)
+	return c.info.Commit, nil
+}
+
+func (a *pfsServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, c, err := a.state.resolveCommit(request.Commit.Repo.Name, request.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	if c.info.Finished != nil {
+		return nil, fmt.Errorf("commit %q has already been finished", c.info.Commit.ID)
+	}
+	if request.Description != "" {
+		c.info.Description = request.Description
+	}
+	a.state.finishCommit(r, c)
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) ResumeFinish(ctx context.Context, request *pfs.ResumeFinishRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("ResumeFinish")
+}
+
+func (a *pfsServer) InspectCommit(ctx context.Context, request *pfs.InspectCommitRequest) (*pfs.CommitInfo, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	_, c, err := a.state.resolveCommit(request.Commit.Repo.Name, request.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	return c.info, nil
+}
+
+// listCommits returns the commits in 'r', newest first.
+func (a *pfsServer) listCommits(r *repo, request *pfs.ListCommitRequest) []*pfs.CommitInfo {
+	var commitInfos []*pfs.CommitInfo
+	for _, c := range r.commits {
+		commitInfos = append(commitInfos, c.info)
+	}
+	sort.Slice(commitInfos, func(i, j int) bool {
+		ti, _ := types.TimestampFromProto(commitInfos[i].Started)
+		tj, _ := types.TimestampFromProto(commitInfos[j].Started)
+		return ti.After(tj)
+	})
+	if request.Number != 0 && uint64(len(commitInfos)) > request.Number {
+		commitInfos = commitInfos[:request.Number]
+	}
+	return commitInfos
+}
+
+func (a *pfsServer) ListCommit(ctx context.Context, request *pfs.ListCommitRequest) (*pfs.CommitInfos, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Repo.Name)
+	}
+	return &pfs.CommitInfos{CommitInfo: a.listCommits(r, request)}, nil
+}
+
+func (a *pfsServer) ListCommitStream(request *pfs.ListCommitRequest, server pfs.API_ListCommitStreamServer) error {
+	a.state.mu.Lock()
+	r, ok := a.state.repos[request.Repo.Name]
+	if !ok {
+		a.state.mu.Unlock()
+		return errNotFound("repo %q not found", request.Repo.Name)
+	}
+	commitInfos := a.listCommits(r, request)
+	a.state.mu.Unlock()
+	for _, commitInfo := range commitInfos {
+		if err := server.Send(commitInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *pfsServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, c, err := a.state.resolveCommit(request.Commit.Repo.Name, request.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	delete(r.commits, c.info.Commit.ID)
+	for branch, head := range r.branches {
+		if head == c.info.Commit.ID {
+			if c.info.ParentCommit != nil {
+				r.branches[branch] = c.info.ParentCommit.ID
+			} else {
+				delete(r.branches, branch)
+			}
+		}
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) FlushCommit(request *pfs.FlushCommitRequest, server pfs.API_FlushCommitServer) error {
+	// All mock commits are finished synchronously, so flushing is a no-op.
+	return nil
+}
+
+func (a *pfsServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, server pfs.API_SubscribeCommitServer) error {
+	return errUnimplemented("SubscribeCommit")
+}
+
+func (a *pfsServer) BuildCommit(ctx context.Context, request *pfs.BuildCommitRequest) (*pfs.Commit, error) {
+	return nil, errUnimplemented("BuildCommit")
+}
+
+func (a *pfsServer) InspectCommitSet(ctx context.Context, request *pfs.InspectCommitSetRequest) (*pfs.CommitSetInfo, error) {
+	return nil, errUnimplemented("InspectCommitSet")
+}
+
+func (a *pfsServer) ListCommitSet(request *pfs.ListCommitSetRequest, server pfs.API_ListCommitSetServer) error {
+	return errUnimplemented("ListCommitSet")
+}
+
+func (a *pfsServer) DeleteCommitSet(ctx context.Context, request *pfs.DeleteCommitSetRequest) (*pfs.CommitSetInfo, error) {
+	return nil, errUnimplemented("DeleteCommitSet")
+}
+
+func (a *pfsServer) SubscribeFiles(request *pfs.SubscribeFilesRequest, server pfs.API_SubscribeFilesServer) error {
+	return errUnimplemented("SubscribeFiles")
+}
+
+func (a *pfsServer) CreateBranch(ctx context.Context, request *pfs.CreateBranchRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Branch.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Branch.Repo.Name)
+	}
+	if request.Head != nil {
+		_, c, err := a.state.resolveCommit(request.Branch.Repo.Name, request.Head.ID)
+		if err != nil {
+			return nil, err
+		}
+		r.branches[request.Branch.Name] = c.info.Commit.ID
+	} else {
+		r.branches[request.Branch.Name] = ""
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) InspectBranch(ctx context.Context, request *pfs.InspectBranchRequest) (*pfs.BranchInfo, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Branch.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Branch.Repo.Name)
+	}
+	head, ok := r.branches[request.Branch.Name]
+	if !ok {
+		return nil, errNotFound("branch %q not found in repo %q", request.Branch.Name, request.Branch.Repo.Name)
+	}
+	branchInfo := &pfs.BranchInfo{
+		Branch: request.Branch,
+		Name:   request.Branch.Name,
+	}
+	if head != "" {
+		branchInfo.Head = &pfs.Commit{Repo: r.info.Repo, ID: head}
+	}
+	return branchInfo, nil
+}
+
+func (a *pfsServer) ListBranch(ctx context.Context, request *pfs.ListBranchRequest) (*pfs.BranchInfos, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Repo.Name)
+	}
+	response := &pfs.BranchInfos{}
+	var names []string
+	for name := range r.branches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		branchInfo := &pfs.BranchInfo{
+			Branch: &pfs.Branch{Repo: r.info.Repo, Name: name},
+			Name:   name,
+		}
+		if head := r.branches[name]; head != "" {
+			branchInfo.Head = &pfs.Commit{Repo: r.info.Repo, ID: head}
+		}
+		response.BranchInfo = append(response.BranchInfo, branchInfo)
+	}
+	return response, nil
+}
+
+func (a *pfsServer) ListBranchStream(request *pfs.ListBranchRequest, server pfs.API_ListBranchStreamServer) error {
+	return errUnimplemented("ListBranchStream")
+}
+
+func (a *pfsServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	r, ok := a.state.repos[request.Branch.Repo.Name]
+	if !ok {
+		return nil, errNotFound("repo %q not found", request.Branch.Repo.Name)
+	}
+	delete(r.branches, request.Branch.Name)
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) CreateCommitTag(ctx context.Context, request *pfs.CreateCommitTagRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("CreateCommitTag")
+}
+
+func (a *pfsServer) ListCommitTag(ctx context.Context, request *pfs.ListCommitTagRequest) (*pfs.CommitTagInfos, error) {
+	return nil, errUnimplemented("ListCommitTag")
+}
+
+func (a *pfsServer) DeleteCommitTag(ctx context.Context, request *pfs.DeleteCommitTagRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("DeleteCommitTag")
+}
+
+func (a *pfsServer) PutFile(server pfs.API_PutFileServer) error {
+	var current *pfs.File
+	var buf bytes.Buffer
+	flush := func() error {
+		if current == nil {
+			return nil
+		}
+		a.state.mu.Lock()
+		defer a.state.mu.Unlock()
+		_, c, err := a.state.resolveCommit(current.Commit.Repo.Name, current.Commit.ID)
+		if err != nil {
+			return err
+		}
+		if c.info.Finished != nil {
+			return fmt.Errorf("commit %q has already been finished", c.info.Commit.ID)
+		}
+		cleanPath := path.Clean("/" + current.Path)
+		c.files[cleanPath] = append(c.files[cleanPath], buf.Bytes()...)
+		current = nil
+		buf.Reset()
+		return nil
+	}
+	for {
+		request, err := server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if request.File != nil {
+			if err := flush(); err != nil {
+				return err
+			}
+			current = request.File
+		}
+		buf.Write(request.Value)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return server.SendAndClose(&types.Empty{})
+}
+
+func (a *pfsServer) CopyFile(ctx context.Context, request *pfs.CopyFileRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("CopyFile")
+}
+
+func (a *pfsServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileServer) error {
+	a.state.mu.Lock()
+	_, c, err := a.state.resolveCommit(request.File.Commit.Repo.Name, request.File.Commit.ID)
+	if err != nil {
+		a.state.mu.Unlock()
+		return err
+	}
+	data, ok := c.files[path.Clean("/"+request.File.Path)]
+	a.state.mu.Unlock()
+	if !ok {
+		return errNotFound("file %q not found in commit %q", request.File.Path, request.File.Commit.ID)
+	}
+	if request.OffsetBytes > int64(len(data)) {
+		data = nil
+	} else {
+		data = data[request.OffsetBytes:]
+	}
+	if request.SizeBytes != 0 && request.SizeBytes < int64(len(data)) {
+		data = data[:request.SizeBytes]
+	}
+	return grpcutil.WriteToStreamingBytesServer(bytes.NewReader(data), server)
+}
+
+func (a *pfsServer) InspectFile(ctx context.Context, request *pfs.InspectFileRequest) (*pfs.FileInfo, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	_, c, err := a.state.resolveCommit(request.File.Commit.Repo.Name, request.File.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	cleanPath := path.Clean("/" + request.File.Path)
+	data, ok := c.files[cleanPath]
+	if !ok {
+		return nil, errNotFound("file %q not found in commit %q", request.File.Path, request.File.Commit.ID)
+	}
+	return fileInfo(c, cleanPath, data), nil
+}
+
+func (a *pfsServer) BatchInspectFile(request *pfs.BatchInspectFileRequest, server pfs.API_BatchInspectFileServer) error {
+	return errUnimplemented("BatchInspectFile")
+}
+
+// listFiles returns FileInfos for the direct children of 'dir' in 'c'; the
+// caller must hold the state lock.
+func (a *pfsServer) listFiles(c *commit, dir string) []*pfs.FileInfo {
+	dir = path.Clean("/" + dir)
+	seen := make(map[string]bool)
+	var fileInfos []*pfs.FileInfo
+	var paths []string
+	for p := range c.files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		if dir != "/" && !strings.HasPrefix(p, dir+"/") {
+			continue
+		}
+		relative := strings.TrimPrefix(strings.TrimPrefix(p, dir), "/")
+		if relative == "" {
+			continue
+		}
+		if idx := strings.Index(relative, "/"); idx >= 0 {
+			// 'p' is under a subdirectory of 'dir'
+			child := path.Join(dir, relative[:idx])
+			if !seen[child] {
+				seen[child] = true
+				fileInfos = append(fileInfos, &pfs.FileInfo{
+					File:     &pfs.File{Commit: c.info.Commit, Path: child},
+					FileType: pfs.FileType_DIR,
+				})
+			}
+			continue
+		}
+		fileInfos = append(fileInfos, fileInfo(c, p, c.files[p]))
+	}
+	return fileInfos
+}
+
+func (a *pfsServer) ListFile(ctx context.Context, request *pfs.ListFileRequest) (*pfs.FileInfos, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	_, c, err := a.state.resolveCommit(request.File.Commit.Repo.Name, request.File.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.FileInfos{FileInfo: a.listFiles(c, request.File.Path)}, nil
+}
+
+func (a *pfsServer) ListFileStream(request *pfs.ListFileRequest, server pfs.API_ListFileStreamServer) error {
+	a.state.mu.Lock()
+	_, c, err := a.state.resolveCommit(request.File.Commit.Repo.Name, request.File.Commit.ID)
+	if err != nil {
+		a.state.mu.Unlock()
+		return err
+	}
+	fileInfos := a.listFiles(c, request.File.Path)
+	a.state.mu.Unlock()
+	for _, fi := range fileInfos {
+		if err := server.Send(fi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *pfsServer) WalkFile(request *pfs.WalkFileRequest, server pfs.API_WalkFileServer) error {
+	return errUnimplemented("WalkFile")
+}
+
+func (a *pfsServer) GlobFile(ctx context.Context, request *pfs.GlobFileRequest) (*pfs.FileInfos, error) {
+	return nil, errUnimplemented("GlobFile")
+}
+
+func (a *pfsServer) GlobFileStream(request *pfs.GlobFileRequest, server pfs.API_GlobFileStreamServer) error {
+	return errUnimplemented("GlobFileStream")
+}
+
+func (a *pfsServer) GlobPreview(ctx context.Context, request *pfs.GlobPreviewRequest) (*pfs.GlobPreviewResponse, error) {
+	return nil, errUnimplemented("GlobPreview")
+}
+
+func (a *pfsServer) FindFile(request *pfs.FindFileRequest, server pfs.API_FindFileServer) error {
+	return errUnimplemented("FindFile")
+}
+
+func (a *pfsServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest) (*pfs.DiffFileResponse, error) {
+	return nil, errUnimplemented("DiffFile")
+}
+
+func (a *pfsServer) DiffTabularFile(ctx context.Context, request *pfs.DiffTabularFileRequest) (*pfs.TabularDiff, error) {
+	return nil, errUnimplemented("DiffTabularFile")
+}
+
+func (a *pfsServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	_, c, err := a.state.resolveCommit(request.File.Commit.Repo.Name, request.File.Commit.ID)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.files, path.Clean("/"+request.File.Path))
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) DeleteAll(ctx context.Context, request *types.Empty) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	a.state.repos = make(map[string]*repo)
+	a.state.pipelines = make(map[string]*pps.PipelineInfo)
+	a.state.jobs = make(map[string]*pps.JobInfo)
+	return &types.Empty{}, nil
+}
+
+func (a *pfsServer) Fsck(ctx context.Context, request *types.Empty) (*types.Empty, error) {
+	return &types.Empty{}, nil
+}
+
+//
+// PPS
+//
+
+type ppsServer struct {
+	state *state
+}
+
+func (a *ppsServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest) (*pps.Job, error) {
+	return nil, errUnimplemented("CreateJob")
+}
+
+func (a *ppsServer) InspectJob(ctx context.Context, request *pps.InspectJobRequest) (*pps.JobInfo, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	jobInfo, ok := a.state.jobs[request.Job.ID]
+	if !ok {
+		return nil, errNotFound("job %q not found", request.Job.ID)
+	}
+	return jobInfo, nil
+}
+
+// listJobs returns jobs matching 'request', newest first; the caller must
+// hold the state lock.
+func (a *ppsServer) listJobs(request *pps.ListJobRequest) []*pps.JobInfo {
+	var jobInfos []*pps.JobInfo
+	for _, jobInfo := range a.state.jobs {
+		if request.Pipeline != nil && jobInfo.Pipeline.Name != request.Pipeline.Name {
+			continue
+		}
+		if request.OutputCommit != nil && jobInfo.OutputCommit.ID != request.OutputCommit.ID {
+			continue
+		}
+		jobInfos = append(jobInfos, jobInfo)
+	}
+	sort.Slice(jobInfos, func(i, j int) bool {
+		ti, _ := types.TimestampFromProto(jobInfos[i].Started)
+		tj, _ := types.TimestampFromProto(jobInfos[j].Started)
+		return ti.After(tj)
+	})
+	return jobInfos
+}
+
+func (a *ppsServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (*pps.JobInfos, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	return &pps.JobInfos{JobInfo: a.listJobs(request)}, nil
+}
+
+func (a *ppsServer) ListJobStream(request *pps.ListJobRequest, server pps.API_ListJobStreamServer) error {
+	a.state.mu.Lock()
+	jobInfos := a.listJobs(request)
+	a.state.mu.Unlock()
+	for _, jobInfo := range jobInfos {
+		if err := server.Send(jobInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *ppsServer) FlushJob(request *pps.FlushJobRequest, server pps.API_FlushJobServer) error {
+	// All mock jobs finish synchronously, so flushing is a no-op.
+	return nil
+}
+
+func (a *ppsServer) DeleteJob(ctx context.Context, request *pps.DeleteJobRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	delete(a.state.jobs, request.Job.ID)
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) StopJob(ctx context.Context, request *pps.StopJobRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	jobInfo, ok := a.state.jobs[request.Job.ID]
+	if !ok {
+		return nil, errNotFound("job %q not found", request.Job.ID)
+	}
+	jobInfo.State = pps.JobState_JOB_KILLED
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) InspectDatum(ctx context.Context, request *pps.InspectDatumRequest) (*pps.DatumInfo, error) {
+	return nil, errUnimplemented("InspectDatum")
+}
+
+func (a *ppsServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest) (*pps.ListDatumResponse, error) {
+	return nil, errUnimplemented("ListDatum")
+}
+
+func (a *ppsServer) ListDatumStream(request *pps.ListDatumRequest, server pps.API_ListDatumStreamServer) error {
+	return errUnimplemented("ListDatumStream")
+}
+
+func (a *ppsServer) RestartDatum(ctx context.Context, request *pps.RestartDatumRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("RestartDatum")
+}
+
+func (a *ppsServer) CreatePipeline(ctx context.Context, request *pps.CreatePipelineRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	if _, ok := a.state.pipelines[request.Pipeline.Name]; ok && !request.Update {
+		return nil, status.Errorf(codes.AlreadyExists, "pipeline %q already exists", request.Pipeline.Name)
+	}
+	pipelineInfo := &pps.PipelineInfo{
+		Pipeline:     request.Pipeline,
+		Version:      1,
+		Transform:    request.Transform,
+		Input:        request.Input,
+		OutputBranch: request.OutputBranch,
+		CreatedAt:    types.TimestampNow(),
+		State:        pps.PipelineState_PIPELINE_RUNNING,
+	}
+	if existing, ok := a.state.pipelines[request.Pipeline.Name]; ok {
+		pipelineInfo.Version = existing.Version + 1
+	}
+	a.state.pipelines[request.Pipeline.Name] = pipelineInfo
+	a.state.createRepo(request.Pipeline.Name, fmt.Sprintf("Output repo for pipeline %s.", request.Pipeline.Name))
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (*pps.PipelineInfo, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	pipelineInfo, ok := a.state.pipelines[request.Pipeline.Name]
+	if !ok {
+		return nil, errNotFound("pipeline %q not found", request.Pipeline.Name)
+	}
+	return pipelineInfo, nil
+}
+
+func (a *ppsServer) ListPipeline(ctx context.Context, request *pps.ListPipelineRequest) (*pps.PipelineInfos, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	response := &pps.PipelineInfos{}
+	var names []string
+	for name := range a.state.pipelines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		response.PipelineInfo = append(response.PipelineInfo, a.state.pipelines[name])
+	}
+	return response, nil
+}
+
+func (a *ppsServer) DeletePipeline(ctx context.Context, request *pps.DeletePipelineRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	delete(a.state.pipelines, request.Pipeline.Name)
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) StartPipeline(ctx context.Context, request *pps.StartPipelineRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	pipelineInfo, ok := a.state.pipelines[request.Pipeline.Name]
+	if !ok {
+		return nil, errNotFound("pipeline %q not found", request.Pipeline.Name)
+	}
+	pipelineInfo.Stopped = false
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	pipelineInfo, ok := a.state.pipelines[request.Pipeline.Name]
+	if !ok {
+		return nil, errNotFound("pipeline %q not found", request.Pipeline.Name)
+	}
+	pipelineInfo.Stopped = true
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) RerunPipeline(ctx context.Context, request *pps.RerunPipelineRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("RerunPipeline")
+}
+
+func (a *ppsServer) PromotePipeline(ctx context.Context, request *pps.PromotePipelineRequest) (*types.Empty, error) {
+	return nil, errUnimplemented("PromotePipeline")
+}
+
+func (a *ppsServer) ValidateDAG(ctx context.Context, request *pps.ValidateDAGRequest) (*pps.ValidateDAGResponse, error) {
+	return nil, errUnimplemented("ValidateDAG")
+}
+
+func (a *ppsServer) DeleteAll(ctx context.Context, request *types.Empty) (*types.Empty, error) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	a.state.pipelines = make(map[string]*pps.PipelineInfo)
+	a.state.jobs = make(map[string]*pps.JobInfo)
+	return &types.Empty{}, nil
+}
+
+func (a *ppsServer) GetLogs(request *pps.GetLogsRequest, server pps.API_GetLogsServer) error {
+	return errUnimplemented("GetLogs")
+}
+
+func (a *ppsServer) GetKubeEvents(request *pps.GetKubeEventsRequest, server pps.API_GetKubeEventsServer) error {
+	return errUnimplemented("GetKubeEvents")
+}
+
+func (a *ppsServer) GarbageCollect(ctx context.Context, request *pps.GarbageCollectRequest) (*pps.GarbageCollectResponse, error) {
+	return &pps.GarbageCollectResponse{}, nil
+}
+
+func (a *ppsServer) ActivateAuth(ctx context.Context, request *pps.ActivateAuthRequest) (*pps.ActivateAuthResponse, error) {
+	return nil, errUnimplemented("ActivateAuth")
+}
@@ -0,0 +1,112 @@
+package pachmock
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// newMockClient starts a mock pachd and returns a client connected to it,
+// cleaning both up when the test ends.
+func newMockClient(t *testing.T) *client.APIClient {
+	t.Helper()
+	mock, err := New()
+	require.NoError(t, err)
+	t.Cleanup(func() { mock.Close() })
+	c, err := mock.Client()
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestPFSRoundTrip(t *testing.T) {
+	c := newMockClient(t)
+	require.NoError(t, c.CreateRepo("test"))
+	repoInfos, err := c.ListRepo()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(repoInfos))
+	require.Equal(t, "test", repoInfos[0].Repo.Name)
+
+	commit, err := c.StartCommit("test", "master")
+	require.NoError(t, err)
+	_, err = c.PutFile("test", commit.ID, "/file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("test", commit.ID))
+
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile("test", commit.ID, "/file", 0, 0, &buf))
+	require.Equal(t, "foo", buf.String())
+
+	fileInfo, err := c.InspectFile("test", commit.ID, "/file")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), fileInfo.SizeBytes)
+
+	// the branch head should have advanced to the finished commit
+	commitInfo, err := c.InspectCommit("test", "master")
+	require.NoError(t, err)
+	require.Equal(t, commit.ID, commitInfo.Commit.ID)
+	require.NotNil(t, commitInfo.Finished)
+}
+
+func TestCommitParentage(t *testing.T) {
+	c := newMockClient(t)
+	require.NoError(t, c.CreateRepo("test"))
+	commit1, err := c.StartCommit("test", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("test", commit1.ID))
+	commit2, err := c.StartCommit("test", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("test", commit2.ID))
+
+	commitInfo, err := c.InspectCommit("test", commit2.ID)
+	require.NoError(t, err)
+	require.NotNil(t, commitInfo.ParentCommit)
+	require.Equal(t, commit1.ID, commitInfo.ParentCommit.ID)
+	// finishing twice is an error
+	require.YesError(t, c.FinishCommit("test", commit2.ID))
+}
+
+func TestJobSimulation(t *testing.T) {
+	c := newMockClient(t)
+	require.NoError(t, c.CreateRepo("input"))
+	require.NoError(t, c.CreatePipeline(
+		"pipeline",
+		"image",
+		[]string{"cp", "-r", "/pfs/input/*", "/pfs/out/"},
+		nil,
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput("input", "/*"),
+		"master",
+		false,
+	))
+	// creating the pipeline creates its output repo
+	_, err := c.InspectRepo("pipeline")
+	require.NoError(t, err)
+
+	// finishing a commit in the input repo immediately yields a successful
+	// job with a finished output commit
+	commit, err := c.StartCommit("input", "master")
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit("input", commit.ID))
+	jobInfos, err := c.ListJob("pipeline", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfos[0].State)
+	outputCommitInfo, err := c.InspectCommit("pipeline", jobInfos[0].OutputCommit.ID)
+	require.NoError(t, err)
+	require.NotNil(t, outputCommitInfo.Finished)
+}
+
+func TestUnimplementedRPCsAreExplicit(t *testing.T) {
+	c := newMockClient(t)
+	require.NoError(t, c.CreateRepo("test"))
+	// RPCs the mock doesn't simulate fail loudly rather than returning empty
+	// results
+	_, err := c.GlobFile("test", "master", "/*")
+	require.YesError(t, err)
+	require.Matches(t, "not implemented by the mock pachd", err.Error())
+}
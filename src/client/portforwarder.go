@@ -6,11 +6,12 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"net/http"
-	"os"
-	"path"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/facebookgo/pidfile"
+	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	log "github.com/sirupsen/logrus"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,6 +32,10 @@ const (
 	dashWebSocketLocalPort = 30081
 	pfsLocalPort           = 30652
 	s3gatewayLocalPort     = 30600
+
+	// reconnectInterval is how long service pipeline tunnels wait before
+	// reconnecting after the tunnel dies (e.g. because the pod restarted)
+	reconnectInterval = 5 * time.Second
 )
 
 // PortForwarder handles proxying local traffic to a kubernetes pod
@@ -85,7 +90,14 @@ func (f *PortForwarder) Run(appName string, localPort, remotePort uint16) error
 		"suite": "pachyderm",
 		"app":   appName,
 	}
+	_, err := f.run(podNameSelector, localPort, remotePort)
+	return err
+}
 
+// run starts a forwarder to a random pod matching 'podNameSelector'. It
+// returns once the tunnel is ready; the returned channel receives the error
+// (possibly nil) that eventually ends the tunnel, so callers can reconnect.
+func (f *PortForwarder) run(podNameSelector map[string]string, localPort, remotePort uint16) (<-chan error, error) {
 	podList, err := f.core.Pods(f.namespace).List(metav1.ListOptions{
 		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(podNameSelector)),
 		TypeMeta: metav1.TypeMeta{
@@ -94,10 +106,10 @@ func (f *PortForwarder) Run(appName string, localPort, remotePort uint16) error
 		},
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if len(podList.Items) == 0 {
-		return fmt.Errorf("No pods found for app %s", appName)
+		return nil, fmt.Errorf("No pods found matching %s", metav1.FormatLabelSelector(metav1.SetAsLabelSelector(podNameSelector)))
 	}
 
 	// Choose a random pod
@@ -112,7 +124,7 @@ func (f *PortForwarder) Run(appName string, localPort, remotePort uint16) error
 
 	transport, upgrader, err := spdy.RoundTripperFor(f.config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
@@ -125,14 +137,14 @@ func (f *PortForwarder) Run(appName string, localPort, remotePort uint16) error
 	f.stopChansLock.Lock()
 	if f.shutdown {
 		f.stopChansLock.Unlock()
-		return fmt.Errorf("port forwarder is shutdown")
+		return nil, fmt.Errorf("port forwarder is shutdown")
 	}
 	f.stopChans = append(f.stopChans, stopChan)
 	f.stopChansLock.Unlock()
 
 	fw, err := portforward.New(dialer, ports, stopChan, readyChan, ioutil.Discard, f.logger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	errChan := make(chan error, 1)
@@ -140,10 +152,47 @@ func (f *PortForwarder) Run(appName string, localPort, remotePort uint16) error
 
 	select {
 	case err = <-errChan:
-		return fmt.Errorf("port forwarding failed: %v", err)
+		return nil, fmt.Errorf("port forwarding failed: %v", err)
 	case <-fw.Ready:
-		return nil
+		return errChan, nil
+	}
+}
+
+// RunForServicePipeline creates a port forwarder to a service pipeline's
+// worker pod, discovered by the pipeline name label. Unlike Run, the tunnel is
+// kept alive: if it dies (the pod restarted, the connection dropped), the
+// forwarder reconnects until it is closed.
+func (f *PortForwarder) RunForServicePipeline(pipelineName string, localPort, remotePort uint16) error {
+	podNameSelector := map[string]string{
+		"suite":        "pachyderm",
+		"pipelineName": pipelineName,
+	}
+	errChan, err := f.run(podNameSelector, localPort, remotePort)
+	if err != nil {
+		return err
 	}
+	go func() {
+		for {
+			if err := <-errChan; err != nil {
+				log.Errorf("port forwarding to pipeline %q failed: %v", pipelineName, err)
+			}
+			for {
+				f.stopChansLock.Lock()
+				shutdown := f.shutdown
+				f.stopChansLock.Unlock()
+				if shutdown {
+					return
+				}
+				time.Sleep(reconnectInterval)
+				errChan, err = f.run(podNameSelector, localPort, remotePort)
+				if err == nil {
+					break
+				}
+				log.Errorf("error reconnecting to pipeline %q: %v", pipelineName, err)
+			}
+		}
+	}()
+	return nil
 }
 
 // RunForDaemon creates a port forwarder for the pachd daemon.
@@ -200,7 +249,7 @@ func (f *PortForwarder) RunForS3Gateway(localPort uint16) error {
 // Lock uses pidfiles to ensure that only one port forwarder is running across
 // one or more `pachctl` instances
 func (f *PortForwarder) Lock() error {
-	pidfile.SetPidfilePath(path.Join(os.Getenv("HOME"), ".pachyderm/port-forward.pid"))
+	pidfile.SetPidfilePath(filepath.Join(config.HomeDir(), ".pachyderm", "port-forward.pid"))
 	return pidfile.Write()
 }
 
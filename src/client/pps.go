@@ -43,6 +43,9 @@ const (
 	// PPSScratchSpace is where pps workers store data while it's waiting to be
 	// processed.
 	PPSScratchSpace = "/pfs/.scratch"
+	// PPSWorkerSockPath is the path of the Unix socket on which workers serve
+	// their API to user code running in the same pod (e.g. PutOutputFile).
+	PPSWorkerSockPath = "/pfs/.worker.sock"
 	// PPSWorkerPortEnv is environment variable name for the port that workers
 	// use for their gRPC server
 	PPSWorkerPortEnv = "PPS_WORKER_GRPC_PORT"
@@ -64,6 +67,11 @@ const (
 	// OutputCommitIDEnv is an env var that is added to the environment of user
 	// pipelined code and indicates the id of the output commit.
 	OutputCommitIDEnv = "PACH_OUTPUT_COMMIT_ID"
+	// PPSPrePullEnv is the env var that tells a worker binary that it's
+	// running in an image pre-pull pod rather than a real worker pod; it
+	// just idles so that the pod (and thus the pulled image) stays on the
+	// node.
+	PPSPrePullEnv = "PPS_PRE_PULL"
 	// PProfPortEnv is the env var that sets a custom pprof port
 	PProfPortEnv = "PPROF_PORT"
 	// PeerPortEnv is the env var that sets a custom peer port
@@ -446,23 +454,25 @@ func (l *LogsIter) Err() error {
 	return grpcutil.ScrubGRPC(l.err)
 }
 
-// GetLogs gets logs from a job (logs includes stdout and stderr). 'pipelineName',
-// 'jobID', 'data', and 'datumID', are all filters. To forego any filter,
-// simply pass an empty value, though one of 'pipelineName' and 'jobID'
-// must be set. Responses are written to 'messages'
+// GetLogs gets logs from a job (logs includes stdout and stderr).
+// 'pipelineName', 'jobID', 'data', 'datumID' and 'workerID' are all filters.
+// To forego any filter, simply pass an empty value, though one of
+// 'pipelineName' and 'jobID' must be set. Responses are written to 'messages'
 func (c APIClient) GetLogs(
 	pipelineName string,
 	jobID string,
 	data []string,
 	datumID string,
+	workerID string,
 	master bool,
 	follow bool,
 	tail int64,
 ) *LogsIter {
 	request := pps.GetLogsRequest{
-		Master: master,
-		Follow: follow,
-		Tail:   tail,
+		WorkerID: workerID,
+		Master:   master,
+		Follow:   follow,
+		Tail:     tail,
 	}
 	resp := &LogsIter{}
 	if pipelineName != "" {
@@ -483,6 +493,34 @@ func (c APIClient) GetLogs(
 	return resp
 }
 
+// GetKubeEvents returns recent kubernetes events for a pipeline's worker
+// pods (image pull failures, scheduling failures, OOM kills, ...), calling f
+// with each one. They're proxied through pachd, so no kubectl access is
+// needed.
+func (c APIClient) GetKubeEvents(pipelineName string, f func(*pps.KubeEvent) error) error {
+	client, err := c.PpsAPIClient.GetKubeEvents(
+		c.Ctx(),
+		&pps.GetKubeEventsRequest{Pipeline: NewPipeline(pipelineName)},
+	)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		event, err := client.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(event); err != nil {
+			if err == errutil.ErrBreak {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
 // CreatePipeline creates a new pipeline, pipelines are the main computation
 // object in PPS they create a flow of data from a set of input Repos to an
 // output Repo (which has the same name as the pipeline). Whenever new data is
@@ -527,6 +565,7 @@ func (c APIClient) CreatePipeline(
 			Update:          update,
 		},
 	)
+	c.cacheInvalidate(cacheKeyListPipeline)
 	return grpcutil.ScrubGRPC(err)
 }
 
@@ -543,6 +582,9 @@ func (c APIClient) InspectPipeline(pipelineName string) (*pps.PipelineInfo, erro
 
 // ListPipeline returns info about all pipelines.
 func (c APIClient) ListPipeline() ([]*pps.PipelineInfo, error) {
+	if resp, ok := c.cacheGet(cacheKeyListPipeline); ok {
+		return resp.(*pps.PipelineInfos).PipelineInfo, nil
+	}
 	pipelineInfos, err := c.PpsAPIClient.ListPipeline(
 		c.Ctx(),
 		&pps.ListPipelineRequest{},
@@ -550,6 +592,7 @@ func (c APIClient) ListPipeline() ([]*pps.PipelineInfo, error) {
 	if err != nil {
 		return nil, grpcutil.ScrubGRPC(err)
 	}
+	c.cachePut(cacheKeyListPipeline, pipelineInfos)
 	return pipelineInfos.PipelineInfo, nil
 }
 
@@ -562,6 +605,7 @@ func (c APIClient) DeletePipeline(name string, force bool) error {
 			Force:    force,
 		},
 	)
+	c.cacheInvalidate(cacheKeyListPipeline)
 	return grpcutil.ScrubGRPC(err)
 }
 
@@ -588,6 +632,18 @@ func (c APIClient) StopPipeline(name string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// PromotePipeline replaces the pipeline 'name' with the spec of its canary
+// (created by a canary update) and deletes the canary.
+func (c APIClient) PromotePipeline(name string) error {
+	_, err := c.PpsAPIClient.PromotePipeline(
+		c.Ctx(),
+		&pps.PromotePipelineRequest{
+			Pipeline: NewPipeline(name),
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // RerunPipeline reruns a pipeline over a given set of commits. Exclude and
 // include are filters that either include or exclude the ancestors of the
 // given commits.  A commit is considered the ancestor of itself. The behavior
@@ -637,6 +693,20 @@ func (c APIClient) CreatePipelineService(
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ValidateDAG analyzes the whole pipeline DAG server-side and returns
+// anything broken (input cycles, missing input repos, globs that can never
+// match) or suspect (pipelines that will never see new data).
+func (c APIClient) ValidateDAG() (*pps.ValidateDAGResponse, error) {
+	response, err := c.PpsAPIClient.ValidateDAG(
+		c.Ctx(),
+		&pps.ValidateDAGRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return response, nil
+}
+
 // GarbageCollect garbage collects unused data.  Currently GC needs to be run
 // while no data is being added or removed (which, among other things, implies
 // that there shouldn't be jobs actively running).  Pfs Garbage collection uses
@@ -30,12 +30,13 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type JobState int32
 
 const (
-	JobState_JOB_STARTING JobState = 0
-	JobState_JOB_RUNNING  JobState = 1
-	JobState_JOB_FAILURE  JobState = 2
-	JobState_JOB_SUCCESS  JobState = 3
-	JobState_JOB_KILLED   JobState = 4
-	JobState_JOB_MERGING  JobState = 5
+	JobState_JOB_STARTING  JobState = 0
+	JobState_JOB_RUNNING   JobState = 1
+	JobState_JOB_FAILURE   JobState = 2
+	JobState_JOB_SUCCESS   JobState = 3
+	JobState_JOB_KILLED    JobState = 4
+	JobState_JOB_MERGING   JobState = 5
+	JobState_JOB_TIMED_OUT JobState = 6
 )
 
 var JobState_name = map[int32]string{
@@ -45,15 +46,17 @@ var JobState_name = map[int32]string{
 	3: "JOB_SUCCESS",
 	4: "JOB_KILLED",
 	5: "JOB_MERGING",
+	6: "JOB_TIMED_OUT",
 }
 
 var JobState_value = map[string]int32{
-	"JOB_STARTING": 0,
-	"JOB_RUNNING":  1,
-	"JOB_FAILURE":  2,
-	"JOB_SUCCESS":  3,
-	"JOB_KILLED":   4,
-	"JOB_MERGING":  5,
+	"JOB_STARTING":  0,
+	"JOB_RUNNING":   1,
+	"JOB_FAILURE":   2,
+	"JOB_SUCCESS":   3,
+	"JOB_KILLED":    4,
+	"JOB_MERGING":   5,
+	"JOB_TIMED_OUT": 6,
 }
 
 func (x JobState) String() string {
@@ -249,22 +252,34 @@ func (m *Secret) GetEnvVar() string {
 }
 
 type Transform struct {
-	Image                string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Cmd                  []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
-	ErrCmd               []string          `protobuf:"bytes,13,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
-	Env                  map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Secrets              []*Secret         `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	ImagePullSecrets     []string          `protobuf:"bytes,9,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
-	Stdin                []string          `protobuf:"bytes,5,rep,name=stdin,proto3" json:"stdin,omitempty"`
-	ErrStdin             []string          `protobuf:"bytes,14,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
-	AcceptReturnCode     []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
-	Debug                bool              `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
-	User                 string            `protobuf:"bytes,10,opt,name=user,proto3" json:"user,omitempty"`
-	WorkingDir           string            `protobuf:"bytes,11,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
-	Dockerfile           string            `protobuf:"bytes,12,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Image            string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Cmd              []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
+	ErrCmd           []string          `protobuf:"bytes,13,rep,name=err_cmd,json=errCmd,proto3" json:"err_cmd,omitempty"`
+	Env              map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Secrets          []*Secret         `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	ImagePullSecrets []string          `protobuf:"bytes,9,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
+	Stdin            []string          `protobuf:"bytes,5,rep,name=stdin,proto3" json:"stdin,omitempty"`
+	ErrStdin         []string          `protobuf:"bytes,14,rep,name=err_stdin,json=errStdin,proto3" json:"err_stdin,omitempty"`
+	AcceptReturnCode []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode,proto3" json:"accept_return_code,omitempty"`
+	Debug            bool              `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
+	User             string            `protobuf:"bytes,10,opt,name=user,proto3" json:"user,omitempty"`
+	WorkingDir       string            `protobuf:"bytes,11,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"`
+	Dockerfile       string            `protobuf:"bytes,12,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+	// init_cmd, if set, is run once per worker at the start of each job,
+	// before the worker processes any of the job's datums. It's meant for
+	// expensive one-time setup (loading a model, checking out a license) that
+	// shouldn't be repeated per datum. If it fails, the worker retries it
+	// (with backoff) before processing datums.
+	InitCmd   []string `protobuf:"bytes,15,rep,name=init_cmd,json=initCmd,proto3" json:"init_cmd,omitempty"`
+	InitStdin []string `protobuf:"bytes,16,rep,name=init_stdin,json=initStdin,proto3" json:"init_stdin,omitempty"`
+	// teardown_cmd, if set, is run once per worker after the job ends,
+	// whether it succeeded or failed. Failures are logged but don't affect
+	// the job's outcome.
+	TeardownCmd          []string `protobuf:"bytes,17,rep,name=teardown_cmd,json=teardownCmd,proto3" json:"teardown_cmd,omitempty"`
+	TeardownStdin        []string `protobuf:"bytes,18,rep,name=teardown_stdin,json=teardownStdin,proto3" json:"teardown_stdin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Transform) Reset()         { *m = Transform{} }
@@ -391,6 +406,34 @@ func (m *Transform) GetDockerfile() string {
 	return ""
 }
 
+func (m *Transform) GetInitCmd() []string {
+	if m != nil {
+		return m.InitCmd
+	}
+	return nil
+}
+
+func (m *Transform) GetInitStdin() []string {
+	if m != nil {
+		return m.InitStdin
+	}
+	return nil
+}
+
+func (m *Transform) GetTeardownCmd() []string {
+	if m != nil {
+		return m.TeardownCmd
+	}
+	return nil
+}
+
+func (m *Transform) GetTeardownStdin() []string {
+	if m != nil {
+		return m.TeardownStdin
+	}
+	return nil
+}
+
 type Egress struct {
 	URL                  string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1233,14 +1276,18 @@ func (m *Datum) GetJob() *Job {
 }
 
 type DatumInfo struct {
-	Datum                *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
-	State                DatumState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps.DatumState" json:"state,omitempty"`
-	Stats                *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
-	PfsState             *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
-	Data                 []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Datum    *Datum          `protobuf:"bytes,1,opt,name=datum,proto3" json:"datum,omitempty"`
+	State    DatumState      `protobuf:"varint,2,opt,name=state,proto3,enum=pps.DatumState" json:"state,omitempty"`
+	Stats    *ProcessStats   `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	PfsState *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState,proto3" json:"pfs_state,omitempty"`
+	Data     []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`
+	// reason explains why a failed datum failed: the user code's exit code or
+	// the signal that killed it (with a note when it looks like an OOM kill),
+	// whether the datum timed out, and the last few lines of stderr
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
@@ -1311,6 +1358,13 @@ func (m *DatumInfo) GetData() []*pfs.FileInfo {
 	return nil
 }
 
+func (m *DatumInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type Aggregate struct {
 	Count                 int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Mean                  float64  `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
@@ -1553,12 +1607,21 @@ type WorkerStatus struct {
 	JobID    string       `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	Data     []*InputFile `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	// Started is the time processing on the current datum began.
-	Started              *types.Timestamp `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
-	Stats                *ProcessStats    `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	QueueSize            int64            `protobuf:"varint,6,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Started   *types.Timestamp `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	Stats     *ProcessStats    `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	QueueSize int64            `protobuf:"varint,6,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// Metrics for the job's datum queue: chunks that no worker has claimed
+	// yet, chunks being processed, and chunks that are done (complete or
+	// failed).
+	QueuedChunks   int64 `protobuf:"varint,7,opt,name=queued_chunks,json=queuedChunks,proto3" json:"queued_chunks,omitempty"`
+	RunningChunks  int64 `protobuf:"varint,8,opt,name=running_chunks,json=runningChunks,proto3" json:"running_chunks,omitempty"`
+	FinishedChunks int64 `protobuf:"varint,9,opt,name=finished_chunks,json=finishedChunks,proto3" json:"finished_chunks,omitempty"`
+	// ChunksProcessed is the number of chunks this worker has taken off the
+	// queue and processed so far in the current job.
+	ChunksProcessed      int64    `protobuf:"varint,10,opt,name=chunks_processed,json=chunksProcessed,proto3" json:"chunks_processed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
@@ -1636,6 +1699,34 @@ func (m *WorkerStatus) GetQueueSize() int64 {
 	return 0
 }
 
+func (m *WorkerStatus) GetQueuedChunks() int64 {
+	if m != nil {
+		return m.QueuedChunks
+	}
+	return 0
+}
+
+func (m *WorkerStatus) GetRunningChunks() int64 {
+	if m != nil {
+		return m.RunningChunks
+	}
+	return 0
+}
+
+func (m *WorkerStatus) GetFinishedChunks() int64 {
+	if m != nil {
+		return m.FinishedChunks
+	}
+	return 0
+}
+
+func (m *WorkerStatus) GetChunksProcessed() int64 {
+	if m != nil {
+		return m.ChunksProcessed
+	}
+	return 0
+}
+
 // ResourceSpec describes the amount of resources that pipeline pods should
 // request from kubernetes, for scheduling.
 type ResourceSpec struct {
@@ -1939,48 +2030,54 @@ func (m *EtcdJobInfo) GetFinished() *types.Timestamp {
 }
 
 type JobInfo struct {
-	Job                  *Job             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Transform            *Transform       `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
-	Pipeline             *Pipeline        `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	PipelineVersion      uint64           `protobuf:"varint,13,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
-	ParallelismSpec      *ParallelismSpec `protobuf:"bytes,12,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
-	Egress               *Egress          `protobuf:"bytes,15,opt,name=egress,proto3" json:"egress,omitempty"`
-	ParentJob            *Job             `protobuf:"bytes,6,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,7,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,8,opt,name=finished,proto3" json:"finished,omitempty"`
-	OutputCommit         *pfs.Commit      `protobuf:"bytes,9,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
-	State                JobState         `protobuf:"varint,10,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,35,opt,name=reason,proto3" json:"reason,omitempty"`
-	Service              *Service         `protobuf:"bytes,14,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout           `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
-	OutputRepo           *pfs.Repo        `protobuf:"bytes,18,opt,name=output_repo,json=outputRepo,proto3" json:"output_repo,omitempty"`
-	OutputBranch         string           `protobuf:"bytes,17,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
-	Restart              uint64           `protobuf:"varint,20,opt,name=restart,proto3" json:"restart,omitempty"`
-	DataProcessed        int64            `protobuf:"varint,22,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped          int64            `protobuf:"varint,30,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataFailed           int64            `protobuf:"varint,40,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
-	DataRecovered        int64            `protobuf:"varint,46,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
-	DataTotal            int64            `protobuf:"varint,23,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	Stats                *ProcessStats    `protobuf:"bytes,31,opt,name=stats,proto3" json:"stats,omitempty"`
-	WorkerStatus         []*WorkerStatus  `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
-	ResourceRequests     *ResourceSpec    `protobuf:"bytes,25,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
-	ResourceLimits       *ResourceSpec    `protobuf:"bytes,36,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
-	Input                *Input           `protobuf:"bytes,26,opt,name=input,proto3" json:"input,omitempty"`
-	NewBranch            *pfs.BranchInfo  `protobuf:"bytes,27,opt,name=new_branch,json=newBranch,proto3" json:"new_branch,omitempty"`
-	StatsCommit          *pfs.Commit      `protobuf:"bytes,29,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
-	EnableStats          bool             `protobuf:"varint,32,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
-	Salt                 string           `protobuf:"bytes,33,opt,name=salt,proto3" json:"salt,omitempty"`
-	Batch                bool             `protobuf:"varint,34,opt,name=batch,proto3" json:"batch,omitempty"`
-	ChunkSpec            *ChunkSpec       `protobuf:"bytes,37,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration  `protobuf:"bytes,38,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration  `protobuf:"bytes,39,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	DatumTries           int64            `protobuf:"varint,41,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec  `protobuf:"bytes,42,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string           `protobuf:"bytes,43,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string           `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Job              *Job             `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Transform        *Transform       `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
+	Pipeline         *Pipeline        `protobuf:"bytes,3,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	PipelineVersion  uint64           `protobuf:"varint,13,opt,name=pipeline_version,json=pipelineVersion,proto3" json:"pipeline_version,omitempty"`
+	ParallelismSpec  *ParallelismSpec `protobuf:"bytes,12,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+	Egress           *Egress          `protobuf:"bytes,15,opt,name=egress,proto3" json:"egress,omitempty"`
+	ParentJob        *Job             `protobuf:"bytes,6,opt,name=parent_job,json=parentJob,proto3" json:"parent_job,omitempty"`
+	Started          *types.Timestamp `protobuf:"bytes,7,opt,name=started,proto3" json:"started,omitempty"`
+	Finished         *types.Timestamp `protobuf:"bytes,8,opt,name=finished,proto3" json:"finished,omitempty"`
+	OutputCommit     *pfs.Commit      `protobuf:"bytes,9,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	State            JobState         `protobuf:"varint,10,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
+	Reason           string           `protobuf:"bytes,35,opt,name=reason,proto3" json:"reason,omitempty"`
+	Service          *Service         `protobuf:"bytes,14,opt,name=service,proto3" json:"service,omitempty"`
+	Spout            *Spout           `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
+	OutputRepo       *pfs.Repo        `protobuf:"bytes,18,opt,name=output_repo,json=outputRepo,proto3" json:"output_repo,omitempty"`
+	OutputBranch     string           `protobuf:"bytes,17,opt,name=output_branch,json=outputBranch,proto3" json:"output_branch,omitempty"`
+	Restart          uint64           `protobuf:"varint,20,opt,name=restart,proto3" json:"restart,omitempty"`
+	DataProcessed    int64            `protobuf:"varint,22,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped      int64            `protobuf:"varint,30,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataFailed       int64            `protobuf:"varint,40,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	DataRecovered    int64            `protobuf:"varint,46,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataTotal        int64            `protobuf:"varint,23,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	Stats            *ProcessStats    `protobuf:"bytes,31,opt,name=stats,proto3" json:"stats,omitempty"`
+	WorkerStatus     []*WorkerStatus  `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
+	ResourceRequests *ResourceSpec    `protobuf:"bytes,25,opt,name=resource_requests,json=resourceRequests,proto3" json:"resource_requests,omitempty"`
+	ResourceLimits   *ResourceSpec    `protobuf:"bytes,36,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`
+	Input            *Input           `protobuf:"bytes,26,opt,name=input,proto3" json:"input,omitempty"`
+	NewBranch        *pfs.BranchInfo  `protobuf:"bytes,27,opt,name=new_branch,json=newBranch,proto3" json:"new_branch,omitempty"`
+	StatsCommit      *pfs.Commit      `protobuf:"bytes,29,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
+	EnableStats      bool             `protobuf:"varint,32,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
+	Salt             string           `protobuf:"bytes,33,opt,name=salt,proto3" json:"salt,omitempty"`
+	Batch            bool             `protobuf:"varint,34,opt,name=batch,proto3" json:"batch,omitempty"`
+	ChunkSpec        *ChunkSpec       `protobuf:"bytes,37,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout     *types.Duration  `protobuf:"bytes,38,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout       *types.Duration  `protobuf:"bytes,39,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	DatumTries       int64            `protobuf:"varint,41,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec   *SchedulingSpec  `protobuf:"bytes,42,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec          string           `protobuf:"bytes,43,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch         string           `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	TransformCaching bool             `protobuf:"varint,47,opt,name=transform_caching,json=transformCaching,proto3" json:"transform_caching,omitempty"`
+	// pending_reason explains why a job that's in JOB_STARTING hasn't started
+	// running yet (e.g. an unfinished upstream commit, unschedulable worker
+	// pods, or a paused pipeline).
+	PendingReason        string          `protobuf:"bytes,48,opt,name=pending_reason,json=pendingReason,proto3" json:"pending_reason,omitempty"`
+	JobTimeoutWarning    *types.Duration `protobuf:"bytes,49,opt,name=job_timeout_warning,json=jobTimeoutWarning,proto3" json:"job_timeout_warning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *JobInfo) Reset()         { *m = JobInfo{} }
@@ -2289,6 +2386,27 @@ func (m *JobInfo) GetPodPatch() string {
 	return ""
 }
 
+func (m *JobInfo) GetTransformCaching() bool {
+	if m != nil {
+		return m.TransformCaching
+	}
+	return false
+}
+
+func (m *JobInfo) GetPendingReason() string {
+	if m != nil {
+		return m.PendingReason
+	}
+	return ""
+}
+
+func (m *JobInfo) GetJobTimeoutWarning() *types.Duration {
+	if m != nil {
+		return m.JobTimeoutWarning
+	}
+	return nil
+}
+
 type Worker struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
@@ -2529,15 +2647,18 @@ func (m *PipelineInput) GetFrom() *pfs.Commit {
 // tracks the state of the pipeline, and points to its metadata in PFS (and,
 // by pointing to a PFS commit, de facto tracks the pipeline's version)
 type EtcdPipelineInfo struct {
-	State                PipelineState   `protobuf:"varint,1,opt,name=state,proto3,enum=pps.PipelineState" json:"state,omitempty"`
-	Reason               string          `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,2,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	JobCounts            map[int32]int32 `protobuf:"bytes,3,rep,name=job_counts,json=jobCounts,proto3" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	AuthToken            string          `protobuf:"bytes,5,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
-	LastJobState         JobState        `protobuf:"varint,6,opt,name=last_job_state,json=lastJobState,proto3,enum=pps.JobState" json:"last_job_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	State        PipelineState   `protobuf:"varint,1,opt,name=state,proto3,enum=pps.PipelineState" json:"state,omitempty"`
+	Reason       string          `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	SpecCommit   *pfs.Commit     `protobuf:"bytes,2,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	JobCounts    map[int32]int32 `protobuf:"bytes,3,rep,name=job_counts,json=jobCounts,proto3" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AuthToken    string          `protobuf:"bytes,5,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	LastJobState JobState        `protobuf:"varint,6,opt,name=last_job_state,json=lastJobState,proto3,enum=pps.JobState" json:"last_job_state,omitempty"`
+	// For pipelines with versioned_output set: the spec version whose output
+	// branch ("v<version>") the pipeline's master branch follows
+	PromotedVersion      uint64   `protobuf:"varint,7,opt,name=promoted_version,json=promotedVersion,proto3" json:"promoted_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EtcdPipelineInfo) Reset()         { *m = EtcdPipelineInfo{} }
@@ -2615,6 +2736,13 @@ func (m *EtcdPipelineInfo) GetLastJobState() JobState {
 	return JobState_JOB_STARTING
 }
 
+func (m *EtcdPipelineInfo) GetPromotedVersion() uint64 {
+	if m != nil {
+		return m.PromotedVersion
+	}
+	return 0
+}
+
 type PipelineInfo struct {
 	ID              string           `protobuf:"bytes,17,opt,name=id,proto3" json:"id,omitempty"`
 	Pipeline        *Pipeline        `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
@@ -2648,23 +2776,62 @@ type PipelineInfo struct {
 	Salt               string          `protobuf:"bytes,25,opt,name=salt,proto3" json:"salt,omitempty"`
 	Batch              bool            `protobuf:"varint,27,opt,name=batch,proto3" json:"batch,omitempty"`
 	// reason includes any error messages associated with a failed pipeline
-	Reason               string          `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,30,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	GithookURL           string          `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Standby              bool            `protobuf:"varint,37,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,39,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,40,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,41,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reason           string            `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxQueueSize     int64             `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service          *Service          `protobuf:"bytes,30,opt,name=service,proto3" json:"service,omitempty"`
+	Spout            *Spout            `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec        *ChunkSpec        `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout     *types.Duration   `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout       *types.Duration   `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	GithookURL       string            `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
+	SpecCommit       *pfs.Commit       `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Standby          bool              `protobuf:"varint,37,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries       int64             `protobuf:"varint,39,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec   *SchedulingSpec   `protobuf:"bytes,40,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec          string            `protobuf:"bytes,41,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch         string            `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	Notification     *NotificationSpec `protobuf:"bytes,46,opt,name=notification,proto3" json:"notification,omitempty"`
+	TransformCaching bool              `protobuf:"varint,47,opt,name=transform_caching,json=transformCaching,proto3" json:"transform_caching,omitempty"`
+	MaxQueuedJobs    int64             `protobuf:"varint,48,opt,name=max_queued_jobs,json=maxQueuedJobs,proto3" json:"max_queued_jobs,omitempty"`
+	// Set on canary pipelines (see CreatePipelineRequest.canary); workers
+	// process only this percentage of datums when it's nonzero
+	CanarySamplePercent int64 `protobuf:"varint,49,opt,name=canary_sample_percent,json=canarySamplePercent,proto3" json:"canary_sample_percent,omitempty"`
+	// Set on canary pipelines whose PFS inputs were pointed at a sample branch,
+	// so PromotePipeline can point them back at the live branches
+	CanarySampleBranch string `protobuf:"bytes,50,opt,name=canary_sample_branch,json=canarySampleBranch,proto3" json:"canary_sample_branch,omitempty"`
+	// VersionedOutput (see CreatePipelineRequest.versioned_output)
+	VersionedOutput bool `protobuf:"varint,51,opt,name=versioned_output,json=versionedOutput,proto3" json:"versioned_output,omitempty"`
+	// The spec version whose output branch the pipeline's master branch
+	// follows. Only set for pipelines with versioned_output; not stored in PFS
+	// with the rest of this data structure--PPS.InspectPipeline fills it in
+	PromotedVersion   uint64 `protobuf:"varint,52,opt,name=promoted_version,json=promotedVersion,proto3" json:"promoted_version,omitempty"`
+	UploadConcurrency int64  `protobuf:"varint,53,opt,name=upload_concurrency,json=uploadConcurrency,proto3" json:"upload_concurrency,omitempty"`
+	// The named group (if any) that the pipeline belongs to, for bulk
+	// start/stop.
+	Group             string          `protobuf:"bytes,54,opt,name=group,proto3" json:"group,omitempty"`
+	PauseWindow       *PauseWindow    `protobuf:"bytes,55,opt,name=pause_window,json=pauseWindow,proto3" json:"pause_window,omitempty"`
+	JobTimeoutWarning *types.Duration `protobuf:"bytes,56,opt,name=job_timeout_warning,json=jobTimeoutWarning,proto3" json:"job_timeout_warning,omitempty"`
+	// The auth principal that the pipeline acts as ("pipeline:<name>"), which
+	// is granted read access to the pipeline's inputs and write access to its
+	// output and nothing else. Set by InspectPipeline iff auth is activated.
+	AuthPrincipal string            `protobuf:"bytes,57,opt,name=auth_principal,json=authPrincipal,proto3" json:"auth_principal,omitempty"`
+	MLflow        *MLflowSpec       `protobuf:"bytes,58,opt,name=mlflow,proto3" json:"mlflow,omitempty"`
+	StatsService  *StatsServiceSpec `protobuf:"bytes,59,opt,name=stats_service,json=statsService,proto3" json:"stats_service,omitempty"`
+	// The URL that the pipeline's stats service (if any) is reachable at. Not
+	// stored in PFS with the rest of this data structure--PPS.InspectPipeline
+	// fills it in.
+	StatsServiceURL string `protobuf:"bytes,60,opt,name=stats_service_url,json=statsServiceUrl,proto3" json:"stats_service_url,omitempty"`
+	// LogRetention (see CreatePipelineRequest.log_retention)
+	LogRetention *types.Duration `protobuf:"bytes,61,opt,name=log_retention,json=logRetention,proto3" json:"log_retention,omitempty"`
+	// ExtraOutputs (see CreatePipelineRequest.extra_outputs)
+	ExtraOutputs []string `protobuf:"bytes,62,rep,name=extra_outputs,json=extraOutputs,proto3" json:"extra_outputs,omitempty"`
+	// SharedCache (see CreatePipelineRequest.shared_cache)
+	SharedCache *SharedCacheSpec `protobuf:"bytes,63,opt,name=shared_cache,json=sharedCache,proto3" json:"shared_cache,omitempty"`
+	// DatumTimeoutSpec (see CreatePipelineRequest.datum_timeout_spec)
+	DatumTimeoutSpec     *DatumTimeoutSpec `protobuf:"bytes,64,opt,name=datum_timeout_spec,json=datumTimeoutSpec,proto3" json:"datum_timeout_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
@@ -2959,6 +3126,139 @@ func (m *PipelineInfo) GetPodPatch() string {
 	return ""
 }
 
+func (m *PipelineInfo) GetNotification() *NotificationSpec {
+	if m != nil {
+		return m.Notification
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetTransformCaching() bool {
+	if m != nil {
+		return m.TransformCaching
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetMaxQueuedJobs() int64 {
+	if m != nil {
+		return m.MaxQueuedJobs
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetCanarySamplePercent() int64 {
+	if m != nil {
+		return m.CanarySamplePercent
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetCanarySampleBranch() string {
+	if m != nil {
+		return m.CanarySampleBranch
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetVersionedOutput() bool {
+	if m != nil {
+		return m.VersionedOutput
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetPromotedVersion() uint64 {
+	if m != nil {
+		return m.PromotedVersion
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetUploadConcurrency() int64 {
+	if m != nil {
+		return m.UploadConcurrency
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetPauseWindow() *PauseWindow {
+	if m != nil {
+		return m.PauseWindow
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetJobTimeoutWarning() *types.Duration {
+	if m != nil {
+		return m.JobTimeoutWarning
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetAuthPrincipal() string {
+	if m != nil {
+		return m.AuthPrincipal
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetMLflow() *MLflowSpec {
+	if m != nil {
+		return m.MLflow
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetStatsService() *StatsServiceSpec {
+	if m != nil {
+		return m.StatsService
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetStatsServiceURL() string {
+	if m != nil {
+		return m.StatsServiceURL
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetLogRetention() *types.Duration {
+	if m != nil {
+		return m.LogRetention
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetExtraOutputs() []string {
+	if m != nil {
+		return m.ExtraOutputs
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetSharedCache() *SharedCacheSpec {
+	if m != nil {
+		return m.SharedCache
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetDatumTimeoutSpec() *DatumTimeoutSpec {
+	if m != nil {
+		return m.DatumTimeoutSpec
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -3244,10 +3544,19 @@ func (m *FlushJobRequest) GetToPipelines() []*Pipeline {
 }
 
 type DeleteJobRequest struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Bulk selection. If 'job' is nil, every job matching all of the filters
+	// below is deleted (at least one filter must be set). 'pipeline' restricts
+	// the deletion to jobs created by that pipeline, 'states' to jobs currently
+	// in one of those states, and 'older_than' to jobs that started more than
+	// 'older_than' ago. Only jobs in a terminal state (success, failure or
+	// killed) are deleted in bulk.
+	Pipeline             *Pipeline       `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	States               []JobState      `protobuf:"varint,3,rep,packed,name=states,proto3,enum=pps.JobState" json:"states,omitempty"`
+	OlderThan            *types.Duration `protobuf:"bytes,4,opt,name=older_than,json=olderThan,proto3" json:"older_than,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
@@ -3290,11 +3599,36 @@ func (m *DeleteJobRequest) GetJob() *Job {
 	return nil
 }
 
+func (m *DeleteJobRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *DeleteJobRequest) GetStates() []JobState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *DeleteJobRequest) GetOlderThan() *types.Duration {
+	if m != nil {
+		return m.OlderThan
+	}
+	return nil
+}
+
 type StopJobRequest struct {
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Bulk selection. If 'job' is nil, every non-terminal job matching all of
+	// the filters below is stopped (at least one filter must be set).
+	Pipeline             *Pipeline  `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	States               []JobState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=pps.JobState" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
@@ -3337,6 +3671,20 @@ func (m *StopJobRequest) GetJob() *Job {
 	return nil
 }
 
+func (m *StopJobRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *StopJobRequest) GetStates() []JobState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
 type GetLogsRequest struct {
 	// The pipeline from which we want to get logs (required if the job in 'job'
 	// was created as part of a pipeline. To get logs from a non-orphan job
@@ -3357,7 +3705,10 @@ type GetLogsRequest struct {
 	// If nonzero, the number of lines from the end of the logs to return.  Note:
 	// tail applies per container, so you will get tail * <number of pods> total
 	// lines back.
-	Tail                 int64    `protobuf:"varint,8,opt,name=tail,proto3" json:"tail,omitempty"`
+	Tail int64 `protobuf:"varint,8,opt,name=tail,proto3" json:"tail,omitempty"`
+	// If nonempty, only return log lines from this worker (accepts a worker pod
+	// name, as reported in LogMessage.worker_id).
+	WorkerID             string   `protobuf:"bytes,9,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3445,6 +3796,13 @@ func (m *GetLogsRequest) GetTail() int64 {
 	return 0
 }
 
+func (m *GetLogsRequest) GetWorkerID() string {
+	if m != nil {
+		return m.WorkerID
+	}
+	return ""
+}
+
 // LogMessage is a log line from a PPS worker, annotated with metadata
 // indicating when and why the line was logged.
 type LogMessage struct {
@@ -3923,11 +4281,17 @@ func (m *ChunkSpec) GetSizeBytes() int64 {
 }
 
 type SchedulingSpec struct {
-	NodeSelector         map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	PriorityClassName    string            `protobuf:"bytes,2,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	NodeSelector      map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PriorityClassName string            `protobuf:"bytes,2,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+	// Arch restricts the pipeline's workers to nodes with the given CPU
+	// architecture ("amd64" or "arm64"). The worker images themselves are
+	// manifest lists and run on either architecture; the constraint exists for
+	// mixed-architecture clusters, where user code built for one architecture
+	// must be kept off the other's nodes.
+	Arch                 string   `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
@@ -3977,10 +4341,17 @@ func (m *SchedulingSpec) GetPriorityClassName() string {
 	return ""
 }
 
-type CreatePipelineRequest struct {
-	Pipeline           *Pipeline        `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	Transform          *Transform       `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
-	ParallelismSpec    *ParallelismSpec `protobuf:"bytes,7,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
+func (m *SchedulingSpec) GetArch() string {
+	if m != nil {
+		return m.Arch
+	}
+	return ""
+}
+
+type CreatePipelineRequest struct {
+	Pipeline           *Pipeline        `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Transform          *Transform       `protobuf:"bytes,2,opt,name=transform,proto3" json:"transform,omitempty"`
+	ParallelismSpec    *ParallelismSpec `protobuf:"bytes,7,opt,name=parallelism_spec,json=parallelismSpec,proto3" json:"parallelism_spec,omitempty"`
 	HashtreeSpec       *HashtreeSpec    `protobuf:"bytes,31,opt,name=hashtree_spec,json=hashtreeSpec,proto3" json:"hashtree_spec,omitempty"`
 	Egress             *Egress          `protobuf:"bytes,9,opt,name=egress,proto3" json:"egress,omitempty"`
 	Update             bool             `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
@@ -3994,23 +4365,95 @@ type CreatePipelineRequest struct {
 	EnableStats        bool             `protobuf:"varint,17,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	Batch                bool            `protobuf:"varint,19,opt,name=batch,proto3" json:"batch,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
-	Standby              bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool              `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	Batch          bool              `protobuf:"varint,19,opt,name=batch,proto3" json:"batch,omitempty"`
+	MaxQueueSize   int64             `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service        *Service          `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout            `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec      *ChunkSpec        `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout   *types.Duration   `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration   `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string            `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
+	Standby        bool              `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries     int64             `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	SchedulingSpec *SchedulingSpec   `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string            `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string            `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	Notification   *NotificationSpec `protobuf:"bytes,34,opt,name=notification,proto3" json:"notification,omitempty"`
+	// TransformCaching keys datum results by the transform (image, cmd and
+	// stdin) instead of the pipeline's salt, so updates that don't change the
+	// transform (e.g. resource limits or parallelism) reuse results from prior
+	// pipeline versions. Pin images by digest (image@sha256:...) when using
+	// this; results cached under a mutable tag go stale when the tag moves.
+	TransformCaching bool `protobuf:"varint,35,opt,name=transform_caching,json=transformCaching,proto3" json:"transform_caching,omitempty"`
+	// MaxQueuedJobs caps how many triggering commits may wait for a job at
+	// once; beyond the cap, older queued commits are coalesced into the newest
+	// queued job instead of each getting a job of their own. Zero means no cap.
+	MaxQueuedJobs int64 `protobuf:"varint,36,opt,name=max_queued_jobs,json=maxQueuedJobs,proto3" json:"max_queued_jobs,omitempty"`
+	// Canary runs this updated spec as a canary alongside the current version
+	// of the pipeline instead of replacing it. The canary reads the same inputs
+	// (or a sample of them, see below) and writes to its own output repo
+	// ("<pipeline>-canary") so its results can be compared against live output.
+	// PromotePipeline replaces the real pipeline with the canary's spec. Only
+	// valid with update set.
+	Canary bool `protobuf:"varint,37,opt,name=canary,proto3" json:"canary,omitempty"`
+	// CanarySampleBranch points the canary's PFS inputs at this branch instead
+	// of the branches the live pipeline reads, so the canary can be fed a
+	// curated sample of data. Only valid with canary set.
+	CanarySampleBranch string `protobuf:"bytes,38,opt,name=canary_sample_branch,json=canarySampleBranch,proto3" json:"canary_sample_branch,omitempty"`
+	// CanarySamplePercent makes the canary process only this percentage of
+	// datums (selected deterministically, so the sample is stable across jobs).
+	// Zero means all datums. Only valid with canary set.
+	CanarySamplePercent int64 `protobuf:"varint,39,opt,name=canary_sample_percent,json=canarySamplePercent,proto3" json:"canary_sample_percent,omitempty"`
+	// VersionedOutput makes each spec version of the pipeline write its output
+	// to its own branch ("v1", "v2", ...) instead of output_branch, with the
+	// pipeline's master branch following the promoted version (the first
+	// version is promoted automatically; after that, use PromotePipeline). This
+	// lets a new version's output be compared against the old version's on
+	// identical inputs before promoting it.
+	VersionedOutput bool `protobuf:"varint,40,opt,name=versioned_output,json=versionedOutput,proto3" json:"versioned_output,omitempty"`
+	// UploadConcurrency is how many output files each worker uploads in
+	// parallel at the end of a datum. Zero means the default (1). Raising it
+	// helps datums that emit many files saturate the available bandwidth to
+	// the object store.
+	UploadConcurrency int64 `protobuf:"varint,41,opt,name=upload_concurrency,json=uploadConcurrency,proto3" json:"upload_concurrency,omitempty"`
+	// Group optionally assigns the pipeline to a named group, so that
+	// maintenance operations ('pachctl start/stop pipeline --group') can
+	// operate on all of the group's pipelines at once.
+	Group string `protobuf:"bytes,42,opt,name=group,proto3" json:"group,omitempty"`
+	// PauseWindow optionally gives the pipeline a recurring window (e.g.
+	// 01:00-03:00 UTC) during which no jobs are started.
+	PauseWindow *PauseWindow `protobuf:"bytes,43,opt,name=pause_window,json=pauseWindow,proto3" json:"pause_window,omitempty"`
+	// JobTimeoutWarning is a soft runtime budget: when a job runs longer than
+	// this, a warning is logged and a job_timeout_warning notification is
+	// emitted, but the job keeps running. job_timeout remains the hard budget
+	// that kills the job and marks it JOB_TIMED_OUT.
+	JobTimeoutWarning *types.Duration `protobuf:"bytes,44,opt,name=job_timeout_warning,json=jobTimeoutWarning,proto3" json:"job_timeout_warning,omitempty"`
+	// MLflow optionally registers each of the pipeline's jobs as an MLflow run
+	// (see MLflowSpec).
+	MLflow *MLflowSpec `protobuf:"bytes,45,opt,name=mlflow,proto3" json:"mlflow,omitempty"`
+	// StatsService runs a companion service pipeline that serves this
+	// pipeline's stats branch (or an output subdirectory) over HTTP/TensorBoard
+	// (see StatsServiceSpec).
+	StatsService *StatsServiceSpec `protobuf:"bytes,46,opt,name=stats_service,json=statsService,proto3" json:"stats_service,omitempty"`
+	// LogRetention is how long the pipeline's job logs are kept in the object
+	// store after a job finishes. Logs are persisted there (compressed,
+	// indexed by job and datum) so 'pachctl logs' works after the worker pods
+	// are gone; unset means they're kept indefinitely.
+	LogRetention *types.Duration `protobuf:"bytes,47,opt,name=log_retention,json=logRetention,proto3" json:"log_retention,omitempty"`
+	// ExtraOutputs declares additional named output repos beyond the main
+	// output repo. An entry "models" creates a repo "<pipeline>-models" and
+	// mounts it at /pfs/out-models in the workers; each job produces a commit
+	// in every extra output repo, with the same provenance as the main output
+	// commit. Names must match [a-zA-Z0-9_-]+.
+	ExtraOutputs []string         `protobuf:"bytes,48,rep,name=extra_outputs,json=extraOutputs,proto3" json:"extra_outputs,omitempty"`
+	SharedCache  *SharedCacheSpec `protobuf:"bytes,49,opt,name=shared_cache,json=sharedCache,proto3" json:"shared_cache,omitempty"`
+	// DatumTimeoutSpec scales the per-datum timeout with the datum's input
+	// size; it can't be combined with datum_timeout.
+	DatumTimeoutSpec     *DatumTimeoutSpec `protobuf:"bytes,50,opt,name=datum_timeout_spec,json=datumTimeoutSpec,proto3" json:"datum_timeout_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
@@ -4242,6 +4685,125 @@ func (m *CreatePipelineRequest) GetPodPatch() string {
 	return ""
 }
 
+func (m *CreatePipelineRequest) GetNotification() *NotificationSpec {
+	if m != nil {
+		return m.Notification
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetTransformCaching() bool {
+	if m != nil {
+		return m.TransformCaching
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetMaxQueuedJobs() int64 {
+	if m != nil {
+		return m.MaxQueuedJobs
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetCanary() bool {
+	if m != nil {
+		return m.Canary
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetCanarySampleBranch() string {
+	if m != nil {
+		return m.CanarySampleBranch
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetCanarySamplePercent() int64 {
+	if m != nil {
+		return m.CanarySamplePercent
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetVersionedOutput() bool {
+	if m != nil {
+		return m.VersionedOutput
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetUploadConcurrency() int64 {
+	if m != nil {
+		return m.UploadConcurrency
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetPauseWindow() *PauseWindow {
+	if m != nil {
+		return m.PauseWindow
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetJobTimeoutWarning() *types.Duration {
+	if m != nil {
+		return m.JobTimeoutWarning
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetMLflow() *MLflowSpec {
+	if m != nil {
+		return m.MLflow
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetStatsService() *StatsServiceSpec {
+	if m != nil {
+		return m.StatsService
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetLogRetention() *types.Duration {
+	if m != nil {
+		return m.LogRetention
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetExtraOutputs() []string {
+	if m != nil {
+		return m.ExtraOutputs
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetSharedCache() *SharedCacheSpec {
+	if m != nil {
+		return m.SharedCache
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetDatumTimeoutSpec() *DatumTimeoutSpec {
+	if m != nil {
+		return m.DatumTimeoutSpec
+	}
+	return nil
+}
+
 type InspectPipelineRequest struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -4392,10 +4954,13 @@ func (m *DeletePipelineRequest) GetForce() bool {
 }
 
 type StartPipelineRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// If 'group' is set instead of 'pipeline', every pipeline in the group is
+	// started.
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
@@ -4438,11 +5003,21 @@ func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *StartPipelineRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 type StopPipelineRequest struct {
-	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// If 'group' is set instead of 'pipeline', every pipeline in the group is
+	// stopped.
+	Group                string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
@@ -4485,6 +5060,13 @@ func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	return nil
 }
 
+func (m *StopPipelineRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 type RerunPipelineRequest struct {
 	Pipeline             *Pipeline     `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	Exclude              []*pfs.Commit `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
@@ -4715,2400 +5297,2300 @@ func (m *ActivateAuthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ActivateAuthResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
-	proto.RegisterEnum("pps.DatumState", DatumState_name, DatumState_value)
-	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
-	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
-	proto.RegisterType((*Secret)(nil), "pps.Secret")
-	proto.RegisterType((*Transform)(nil), "pps.Transform")
-	proto.RegisterMapType((map[string]string)(nil), "pps.Transform.EnvEntry")
-	proto.RegisterType((*Egress)(nil), "pps.Egress")
-	proto.RegisterType((*Job)(nil), "pps.Job")
-	proto.RegisterType((*Service)(nil), "pps.Service")
-	proto.RegisterType((*Spout)(nil), "pps.Spout")
-	proto.RegisterType((*PFSInput)(nil), "pps.PFSInput")
-	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
-	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
-	proto.RegisterType((*Input)(nil), "pps.Input")
-	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
-	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
-	proto.RegisterType((*HashtreeSpec)(nil), "pps.HashtreeSpec")
-	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
-	proto.RegisterType((*Datum)(nil), "pps.Datum")
-	proto.RegisterType((*DatumInfo)(nil), "pps.DatumInfo")
-	proto.RegisterType((*Aggregate)(nil), "pps.Aggregate")
-	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
-	proto.RegisterType((*AggregateProcessStats)(nil), "pps.AggregateProcessStats")
-	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
-	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
-	proto.RegisterType((*GPUSpec)(nil), "pps.GPUSpec")
-	proto.RegisterType((*EtcdJobInfo)(nil), "pps.EtcdJobInfo")
-	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
-	proto.RegisterType((*Worker)(nil), "pps.Worker")
-	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
-	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
-	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
-	proto.RegisterType((*EtcdPipelineInfo)(nil), "pps.EtcdPipelineInfo")
-	proto.RegisterMapType((map[int32]int32)(nil), "pps.EtcdPipelineInfo.JobCountsEntry")
-	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
-	proto.RegisterMapType((map[int32]int32)(nil), "pps.PipelineInfo.JobCountsEntry")
-	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
-	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
-	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
-	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
-	proto.RegisterType((*FlushJobRequest)(nil), "pps.FlushJobRequest")
-	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
-	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
-	proto.RegisterType((*GetLogsRequest)(nil), "pps.GetLogsRequest")
-	proto.RegisterType((*LogMessage)(nil), "pps.LogMessage")
-	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
-	proto.RegisterType((*InspectDatumRequest)(nil), "pps.InspectDatumRequest")
-	proto.RegisterType((*ListDatumRequest)(nil), "pps.ListDatumRequest")
-	proto.RegisterType((*ListDatumResponse)(nil), "pps.ListDatumResponse")
-	proto.RegisterType((*ListDatumStreamResponse)(nil), "pps.ListDatumStreamResponse")
-	proto.RegisterType((*ChunkSpec)(nil), "pps.ChunkSpec")
-	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
-	proto.RegisterMapType((map[string]string)(nil), "pps.SchedulingSpec.NodeSelectorEntry")
-	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
-	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
-	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
-	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
-	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
-	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
-	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
-	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
-	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
-	proto.RegisterType((*ActivateAuthRequest)(nil), "pps.ActivateAuthRequest")
-	proto.RegisterType((*ActivateAuthResponse)(nil), "pps.ActivateAuthResponse")
+type GetKubeEventsRequest struct {
+	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptor_dbf57f97f56369c0) }
+func (m *GetKubeEventsRequest) Reset()         { *m = GetKubeEventsRequest{} }
+func (m *GetKubeEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKubeEventsRequest) ProtoMessage()    {}
+func (*GetKubeEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{57}
+}
+func (m *GetKubeEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetKubeEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetKubeEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetKubeEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKubeEventsRequest.Merge(m, src)
+}
+func (m *GetKubeEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetKubeEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKubeEventsRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_dbf57f97f56369c0 = []byte{
-	// 4386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0x4d, 0x6c, 0xdb, 0xd8,
-	0x76, 0xb6, 0x24, 0x4a, 0x22, 0x8f, 0x7e, 0x4c, 0x5f, 0xff, 0x44, 0x56, 0x12, 0xdb, 0xe1, 0x4c,
-	0x32, 0x49, 0x5e, 0xc6, 0x99, 0xe7, 0xbc, 0x97, 0xbe, 0x4e, 0xa7, 0x33, 0xf5, 0x5f, 0x52, 0x6b,
-	0xfc, 0x32, 0x2e, 0x65, 0x4f, 0xd1, 0x6e, 0x04, 0x9a, 0xbc, 0x92, 0x18, 0x53, 0x24, 0x1f, 0x49,
-	0x39, 0xe3, 0x01, 0xba, 0xe9, 0xa2, 0xdb, 0x02, 0x5d, 0x14, 0x45, 0x81, 0xae, 0xba, 0xec, 0xa2,
-	0xed, 0xbe, 0x8b, 0x2e, 0xdf, 0xa6, 0xc0, 0xdb, 0x74, 0xd1, 0x4d, 0x50, 0xa4, 0x40, 0x77, 0x5d,
-	0x77, 0x51, 0x14, 0x28, 0xee, 0xb9, 0x97, 0x14, 0x29, 0xc9, 0xb2, 0x1d, 0x77, 0x61, 0xe0, 0xde,
-	0x73, 0xce, 0xfd, 0x3b, 0xf7, 0xdc, 0x73, 0xce, 0x77, 0x28, 0xc3, 0x92, 0xe9, 0xd8, 0xd4, 0x8d,
-	0x9e, 0xfb, 0x7e, 0xc8, 0xfe, 0x36, 0xfd, 0xc0, 0x8b, 0x3c, 0x52, 0xf0, 0xfd, 0xb0, 0x79, 0xb7,
-	0xe7, 0x79, 0x3d, 0x87, 0x3e, 0x47, 0xd2, 0xe9, 0xb0, 0xfb, 0x9c, 0x0e, 0xfc, 0xe8, 0x82, 0x4b,
-	0x34, 0xd7, 0xc7, 0x99, 0x91, 0x3d, 0xa0, 0x61, 0x64, 0x0c, 0x7c, 0x21, 0xb0, 0x36, 0x2e, 0x60,
-	0x0d, 0x03, 0x23, 0xb2, 0x3d, 0x57, 0xf0, 0x97, 0x7a, 0x5e, 0xcf, 0xc3, 0xe6, 0x73, 0xd6, 0x8a,
-	0xa9, 0xf1, 0x76, 0xba, 0x21, 0xfb, 0xe3, 0x54, 0xad, 0x0b, 0xa5, 0x36, 0x35, 0x03, 0x1a, 0x11,
-	0x02, 0x92, 0x6b, 0x0c, 0x68, 0x23, 0xb7, 0x91, 0x7b, 0xac, 0xe8, 0xd8, 0x26, 0x2a, 0x14, 0xce,
-	0xe8, 0x45, 0x43, 0x42, 0x12, 0x6b, 0x92, 0xfb, 0x00, 0x03, 0x6f, 0xe8, 0x46, 0x1d, 0xdf, 0x88,
-	0xfa, 0x8d, 0x3c, 0x32, 0x14, 0xa4, 0x1c, 0x19, 0x51, 0x9f, 0xdc, 0x81, 0x32, 0x75, 0xcf, 0x3b,
-	0xe7, 0x46, 0xd0, 0x28, 0x20, 0xaf, 0x44, 0xdd, 0xf3, 0xef, 0x8d, 0x40, 0xfb, 0x4d, 0x01, 0x94,
-	0xe3, 0xc0, 0x70, 0xc3, 0xae, 0x17, 0x0c, 0xc8, 0x12, 0x14, 0xed, 0x81, 0xd1, 0x8b, 0x17, 0xe3,
-	0x1d, 0xb6, 0x9a, 0x39, 0xb0, 0x1a, 0xf9, 0x8d, 0x02, 0x5b, 0xcd, 0x1c, 0x58, 0x38, 0x5d, 0x10,
-	0x74, 0x18, 0xb5, 0x86, 0xd4, 0x12, 0x0d, 0x82, 0xdd, 0x81, 0x45, 0x9e, 0x40, 0x81, 0xba, 0xe7,
-	0x8d, 0xc2, 0x46, 0xe1, 0x71, 0x65, 0xeb, 0xce, 0x26, 0x53, 0x6f, 0x32, 0xfb, 0xe6, 0xbe, 0x7b,
-	0xbe, 0xef, 0x46, 0xc1, 0x85, 0xce, 0x64, 0xc8, 0x43, 0x28, 0x87, 0x78, 0xc2, 0xb0, 0x21, 0xa1,
-	0x78, 0x05, 0xc5, 0xf9, 0xa9, 0xf5, 0x98, 0x47, 0x9e, 0x01, 0xc1, 0x5d, 0x74, 0xfc, 0xa1, 0xe3,
-	0x74, 0xe2, 0x11, 0x0a, 0xae, 0xaa, 0x22, 0xe7, 0x68, 0xe8, 0x38, 0x6d, 0x21, 0xbd, 0x04, 0xc5,
-	0x30, 0xb2, 0x6c, 0xb7, 0x51, 0x44, 0x01, 0xde, 0x21, 0x77, 0x41, 0x61, 0xdb, 0xe5, 0x9c, 0x3a,
-	0x72, 0x64, 0x1a, 0x04, 0x6d, 0x64, 0x3e, 0x03, 0x62, 0x98, 0x26, 0xf5, 0xa3, 0x4e, 0x40, 0xa3,
-	0x61, 0xe0, 0x76, 0x4c, 0xcf, 0xa2, 0x8d, 0xd2, 0x46, 0xe1, 0x71, 0x41, 0x57, 0x39, 0x47, 0x47,
-	0xc6, 0xae, 0x67, 0x51, 0xb6, 0x80, 0x45, 0x4f, 0x87, 0xbd, 0x46, 0x79, 0x23, 0xf7, 0x58, 0xd6,
-	0x79, 0x87, 0xdd, 0xd1, 0x30, 0xa4, 0x41, 0x03, 0xf8, 0x1d, 0xb1, 0x36, 0x59, 0x87, 0xca, 0x3b,
-	0x2f, 0x38, 0xb3, 0xdd, 0x5e, 0xc7, 0xb2, 0x83, 0x46, 0x05, 0x59, 0x20, 0x48, 0x7b, 0x76, 0x40,
-	0xd6, 0x00, 0x2c, 0xcf, 0x3c, 0xa3, 0x41, 0xd7, 0x76, 0x68, 0xa3, 0xca, 0xf9, 0x23, 0x4a, 0xf3,
-	0x25, 0xc8, 0xb1, 0xc6, 0xe2, 0x0b, 0xcf, 0x8d, 0x2e, 0x7c, 0x09, 0x8a, 0xe7, 0x86, 0x33, 0xa4,
-	0xe2, 0xae, 0x79, 0xe7, 0xcb, 0xfc, 0x2f, 0x72, 0x5a, 0x13, 0x4a, 0xfb, 0xbd, 0x80, 0x86, 0x21,
-	0x1b, 0x75, 0xa2, 0x1f, 0xc6, 0xa3, 0x4e, 0xf4, 0x43, 0xed, 0x3e, 0x14, 0x5a, 0xde, 0x29, 0x59,
-	0x81, 0xbc, 0x6d, 0x71, 0xfa, 0x4e, 0xe9, 0xc3, 0xfb, 0xf5, 0xfc, 0xc1, 0x9e, 0x9e, 0xb7, 0x2d,
-	0xed, 0x0c, 0xca, 0x6d, 0x1a, 0x9c, 0xdb, 0x26, 0x25, 0x9f, 0x40, 0xcd, 0x76, 0x23, 0x1a, 0xb8,
-	0x86, 0xd3, 0xf1, 0xbd, 0x20, 0x42, 0xe9, 0xa2, 0x5e, 0x8d, 0x89, 0x47, 0x5e, 0x10, 0x31, 0x21,
-	0xfa, 0x43, 0x5a, 0x28, 0xcf, 0x85, 0x62, 0x22, 0x0a, 0xb1, 0xc5, 0x7c, 0x6e, 0x76, 0x62, 0xb1,
-	0x23, 0x3d, 0x6f, 0xfb, 0xda, 0x43, 0x28, 0xb6, 0x7d, 0x6f, 0x18, 0x91, 0x7b, 0xa0, 0x78, 0xe7,
-	0x34, 0x78, 0x17, 0xd8, 0x11, 0xb7, 0x3c, 0x59, 0x1f, 0x11, 0xb4, 0xbf, 0xcb, 0x81, 0x7c, 0xf4,
-	0xaa, 0x7d, 0xe0, 0xfa, 0xc3, 0xe9, 0x8f, 0x81, 0x80, 0x14, 0x50, 0xdf, 0x13, 0x8a, 0xc0, 0x36,
-	0x59, 0x81, 0xd2, 0x69, 0x60, 0xb8, 0x66, 0x3f, 0x36, 0x77, 0xde, 0x63, 0x74, 0xd3, 0x1b, 0x0c,
-	0xec, 0x48, 0xbc, 0x1d, 0xd1, 0x63, 0x73, 0xf4, 0x1c, 0xef, 0xb4, 0x51, 0xe4, 0x73, 0xb0, 0x36,
-	0xa3, 0x39, 0xc6, 0x8f, 0x17, 0x8d, 0x12, 0xee, 0x08, 0xdb, 0xec, 0x52, 0xd1, 0x25, 0x74, 0xd8,
-	0x0d, 0x85, 0xc2, 0x08, 0x00, 0x49, 0xaf, 0x18, 0x45, 0xfb, 0xfb, 0x1c, 0x28, 0xbb, 0x81, 0xe7,
-	0xde, 0x78, 0xbb, 0x62, 0x5b, 0x85, 0xf1, 0x6d, 0x85, 0x3e, 0x35, 0xc5, 0x66, 0xb1, 0x9d, 0xd5,
-	0x56, 0x69, 0x4c, 0x5b, 0xe4, 0x0b, 0xf6, 0x00, 0x8c, 0x20, 0xc2, 0x93, 0x54, 0xb6, 0x9a, 0x9b,
-	0xdc, 0x27, 0x6d, 0xc6, 0x3e, 0x69, 0xf3, 0x38, 0x76, 0x5a, 0x3a, 0x17, 0xd4, 0x6c, 0x90, 0x5f,
-	0xdb, 0xd1, 0xe5, 0xfb, 0x5d, 0x85, 0xc2, 0x30, 0x70, 0xf8, 0x76, 0x77, 0xca, 0x1f, 0xde, 0xaf,
-	0x33, 0x43, 0xd2, 0x19, 0xed, 0xa6, 0x5a, 0xd6, 0xfe, 0x31, 0x07, 0x45, 0xbe, 0xd0, 0x3a, 0x14,
-	0xfc, 0x6e, 0x88, 0xdb, 0xaf, 0x6c, 0xd5, 0xf0, 0xe1, 0xc7, 0x77, 0xac, 0x33, 0x0e, 0xd9, 0x80,
-	0xa2, 0x19, 0x78, 0x61, 0x88, 0x5e, 0xa7, 0xb2, 0x05, 0x28, 0xc2, 0xf9, 0x9c, 0xc1, 0x24, 0x86,
-	0xae, 0xed, 0xb9, 0xc2, 0xd9, 0x64, 0x24, 0x90, 0x41, 0x34, 0x90, 0xcc, 0xc0, 0x73, 0x71, 0x13,
-	0x95, 0xad, 0x3a, 0x0a, 0x24, 0x77, 0xa3, 0x23, 0x8f, 0x6d, 0xa4, 0x67, 0xc7, 0xda, 0xe2, 0x1b,
-	0x89, 0xb5, 0xa1, 0x33, 0x8e, 0x76, 0x06, 0x72, 0xcb, 0x3b, 0xcd, 0xaa, 0x47, 0x4a, 0xa9, 0xe7,
-	0x93, 0xe4, 0xac, 0x39, 0x9c, 0xa3, 0xb2, 0xc9, 0x9c, 0xf8, 0x2e, 0x92, 0x26, 0xcc, 0x2b, 0x3f,
-	0xc5, 0xbc, 0x0a, 0x23, 0xf3, 0xd2, 0x4e, 0x60, 0xfe, 0xc8, 0x08, 0x0c, 0xc7, 0xa1, 0x8e, 0x1d,
-	0x0e, 0xda, 0xec, 0xba, 0x9b, 0x20, 0x9b, 0x9e, 0x1b, 0x46, 0x86, 0xcb, 0x5f, 0x97, 0xa4, 0x27,
-	0x7d, 0xb2, 0x01, 0x15, 0xd3, 0xa3, 0xdd, 0xae, 0x6d, 0xb2, 0x08, 0x82, 0x33, 0xe5, 0xf4, 0x34,
-	0xa9, 0x25, 0xc9, 0x39, 0x35, 0xaf, 0x3d, 0x85, 0xea, 0xef, 0x1b, 0x61, 0x3f, 0x0a, 0x28, 0x9d,
-	0x98, 0x33, 0x97, 0x9d, 0x53, 0x7b, 0x01, 0x0a, 0x1e, 0x96, 0x99, 0x33, 0xdb, 0x23, 0xc6, 0x13,
-	0x71, 0x60, 0xd6, 0x66, 0xb4, 0xbe, 0x11, 0xf6, 0x51, 0x65, 0x55, 0x1d, 0xdb, 0xda, 0xef, 0x40,
-	0x71, 0xcf, 0x88, 0x86, 0x83, 0xcb, 0x1c, 0x0b, 0x69, 0x42, 0xe1, 0xad, 0x38, 0x7f, 0x65, 0x4b,
-	0x46, 0x35, 0xb7, 0xbc, 0x53, 0x9d, 0x11, 0xb5, 0x5f, 0xe7, 0x40, 0xc1, 0xd1, 0x07, 0x6e, 0xd7,
-	0x63, 0xd7, 0x6a, 0xb1, 0x8e, 0x50, 0x27, 0xbf, 0x56, 0x64, 0xeb, 0x9c, 0x41, 0x1e, 0xa2, 0x89,
-	0x47, 0xdc, 0xf3, 0xd5, 0xb7, 0xe6, 0x47, 0x12, 0x6d, 0x46, 0xd6, 0x39, 0x97, 0x7c, 0xc6, 0xc5,
-	0x42, 0x54, 0x4b, 0x65, 0x6b, 0x81, 0x1b, 0x59, 0xe0, 0x99, 0x34, 0x0c, 0x99, 0x60, 0xc8, 0x05,
-	0x43, 0xf2, 0x08, 0x14, 0xbf, 0x1b, 0x76, 0xf8, 0x9c, 0xdc, 0x56, 0x14, 0xbc, 0x44, 0xa6, 0x02,
-	0x5d, 0xf6, 0xbb, 0x28, 0x4e, 0xc9, 0x03, 0x90, 0x2c, 0x23, 0x32, 0x30, 0xb4, 0xa0, 0xad, 0x08,
-	0x11, 0xb6, 0x6d, 0x1d, 0x59, 0xda, 0x3f, 0xe4, 0x40, 0xd9, 0xee, 0xf5, 0x02, 0xda, 0x63, 0x03,
-	0x96, 0xa0, 0x68, 0xb2, 0x08, 0x8c, 0x47, 0x29, 0xe8, 0xbc, 0xc3, 0xf4, 0x37, 0xa0, 0x86, 0x8b,
-	0xbb, 0xcf, 0xe9, 0xd8, 0x66, 0x0f, 0x26, 0x8c, 0x2c, 0x8b, 0x9e, 0x8b, 0x3b, 0x14, 0x3d, 0xf2,
-	0x04, 0xd4, 0xae, 0xdd, 0x8d, 0xfa, 0x1d, 0x9f, 0x06, 0x26, 0x75, 0x23, 0x16, 0x28, 0x24, 0x94,
-	0x98, 0x47, 0xfa, 0x51, 0x42, 0x26, 0x2f, 0xe1, 0x8e, 0x6b, 0xbb, 0x14, 0x5d, 0xd3, 0xd8, 0x88,
-	0x22, 0x8e, 0x58, 0xe6, 0xec, 0x57, 0xd9, 0x71, 0xda, 0x5f, 0xe4, 0xa1, 0x9a, 0xd6, 0x0a, 0xf9,
-	0x1a, 0x6a, 0x96, 0xf7, 0xce, 0x75, 0x3c, 0xc3, 0xea, 0xb0, 0x0c, 0x47, 0x5c, 0xc4, 0xea, 0x84,
-	0x27, 0xd9, 0x13, 0xd9, 0x8d, 0x5e, 0x8d, 0xe5, 0x99, 0x6f, 0x21, 0x5f, 0x41, 0xd5, 0xe7, 0xf3,
-	0xf1, 0xe1, 0xf9, 0xab, 0x86, 0x57, 0x84, 0x38, 0x8e, 0xfe, 0x12, 0x2a, 0x43, 0x7f, 0xb4, 0x76,
-	0xe1, 0xaa, 0xc1, 0xc0, 0xa5, 0x71, 0xec, 0x43, 0xa8, 0x27, 0x3b, 0x3f, 0xbd, 0x88, 0x68, 0x88,
-	0xba, 0x92, 0xf4, 0xe4, 0x3c, 0x3b, 0x8c, 0x48, 0x1e, 0x40, 0x55, 0x2c, 0xc1, 0x85, 0x8a, 0x28,
-	0x24, 0x96, 0x45, 0x11, 0xed, 0xaf, 0xf3, 0xb0, 0x9c, 0xdc, 0x63, 0x46, 0x3b, 0x2f, 0xa6, 0x6b,
-	0x87, 0x3b, 0x97, 0x64, 0xc8, 0x98, 0x4a, 0x7e, 0x3a, 0x55, 0x25, 0xe3, 0x63, 0x32, 0x7a, 0x78,
-	0x3e, 0x4d, 0x0f, 0xe3, 0x23, 0xd2, 0x87, 0xff, 0xf9, 0xd4, 0xc3, 0x4f, 0x8e, 0x19, 0x53, 0xc6,
-	0x4f, 0xa7, 0x28, 0x63, 0xca, 0xd6, 0xd2, 0xca, 0xf9, 0xdf, 0x1c, 0x54, 0xff, 0xd0, 0x0b, 0xce,
-	0x68, 0xc0, 0x54, 0x32, 0x0c, 0xc9, 0x13, 0x50, 0xde, 0x61, 0xbf, 0x93, 0xbc, 0xfd, 0xea, 0x87,
-	0xf7, 0xeb, 0x32, 0x17, 0x3a, 0xd8, 0xd3, 0x65, 0xce, 0x3e, 0xb0, 0xc8, 0x06, 0x94, 0xde, 0x7a,
-	0xa7, 0x4c, 0x8e, 0xc7, 0x13, 0xe5, 0xc3, 0xfb, 0xf5, 0x22, 0xf3, 0xaf, 0x7b, 0x7a, 0xf1, 0xad,
-	0x77, 0x7a, 0x60, 0x31, 0xa7, 0x8d, 0xaf, 0x8c, 0x7b, 0xf5, 0xfa, 0xc8, 0xab, 0xe3, 0x6b, 0x44,
-	0x1e, 0xf9, 0x19, 0x94, 0x31, 0x76, 0x51, 0x4b, 0x1c, 0x72, 0x56, 0x98, 0x8b, 0x45, 0x47, 0x0e,
-	0xa1, 0x78, 0x85, 0x43, 0xb8, 0x0f, 0xf0, 0xab, 0x21, 0x1d, 0xd2, 0x4e, 0x68, 0xff, 0xc8, 0x43,
-	0x6c, 0x41, 0x57, 0x90, 0xd2, 0xb6, 0x7f, 0xa4, 0x5a, 0x00, 0x55, 0x9d, 0x86, 0xde, 0x30, 0x30,
-	0xb9, 0x37, 0x65, 0xe9, 0xb1, 0x3f, 0xc4, 0x83, 0xe7, 0x75, 0xd6, 0x64, 0xcf, 0x79, 0x40, 0x07,
-	0x5e, 0x70, 0x21, 0x1c, 0xbe, 0xe8, 0x91, 0x35, 0x28, 0xf4, 0xfc, 0xa1, 0x58, 0xbf, 0xca, 0x83,
-	0xcd, 0xd1, 0x09, 0x9b, 0x44, 0x67, 0x0c, 0xe6, 0x1a, 0x2c, 0x3b, 0x3c, 0x8b, 0xdd, 0x2d, 0x6b,
-	0xb7, 0x24, 0xb9, 0xa0, 0x4a, 0xda, 0xcf, 0xa1, 0x2c, 0x24, 0x99, 0x50, 0x74, 0xe1, 0x27, 0x31,
-	0x9a, 0xb5, 0xd9, 0x82, 0xee, 0x70, 0x70, 0x4a, 0x03, 0x5c, 0xb0, 0xa0, 0x8b, 0x9e, 0xf6, 0xaf,
-	0x12, 0x54, 0xf6, 0x23, 0xd3, 0xc2, 0x08, 0xd6, 0xf5, 0x62, 0x37, 0x9c, 0x9b, 0xe2, 0x86, 0xc9,
-	0x13, 0x90, 0x7d, 0xdb, 0xa7, 0x8e, 0xed, 0xc6, 0x06, 0x2a, 0xe2, 0xb2, 0x20, 0xea, 0x09, 0x9b,
-	0x7c, 0x01, 0x35, 0x6f, 0x18, 0xf9, 0xc3, 0xa8, 0x93, 0xca, 0x5a, 0xc6, 0x42, 0x5f, 0x95, 0x4b,
-	0xf0, 0x1e, 0x69, 0x40, 0x39, 0xa0, 0x3c, 0x31, 0xe1, 0x6f, 0x32, 0xee, 0xe2, 0xa3, 0x35, 0x22,
-	0xa3, 0x23, 0x8c, 0x9f, 0x5a, 0xa8, 0x9e, 0x82, 0x5e, 0x63, 0xd4, 0xa3, 0x98, 0xc8, 0x1e, 0x2d,
-	0x8a, 0x85, 0x67, 0xb6, 0xef, 0x53, 0x4b, 0xdc, 0x4a, 0x85, 0xd1, 0xda, 0x9c, 0xc4, 0xae, 0x0d,
-	0x45, 0x22, 0x2f, 0x32, 0x1c, 0x4c, 0xcd, 0x0a, 0xba, 0xc2, 0x28, 0xc7, 0x8c, 0xc0, 0x52, 0x37,
-	0x64, 0x77, 0x0d, 0xdb, 0xa1, 0x56, 0x43, 0x46, 0x3e, 0x8e, 0x78, 0x85, 0x94, 0x64, 0x27, 0x01,
-	0x35, 0x59, 0x3e, 0x45, 0xad, 0xc6, 0xfc, 0x68, 0x27, 0x7a, 0x4c, 0x1c, 0x99, 0x91, 0x72, 0x85,
-	0x19, 0x6d, 0x42, 0x15, 0x1b, 0xb1, 0x92, 0x60, 0x52, 0x49, 0x15, 0x14, 0x10, 0x3a, 0xfa, 0x24,
-	0x8e, 0x6b, 0x15, 0x8c, 0x6b, 0xb5, 0xf8, 0x7a, 0x32, 0x51, 0x6d, 0x05, 0x4a, 0x01, 0x35, 0x42,
-	0xcf, 0x15, 0x80, 0x41, 0xf4, 0xd2, 0x4f, 0xa2, 0x76, 0xfd, 0x27, 0xf1, 0x12, 0xe4, 0xae, 0xed,
-	0xda, 0x61, 0x9f, 0x5a, 0x8d, 0xfa, 0x95, 0xc3, 0x12, 0x59, 0xed, 0x7f, 0xaa, 0x50, 0xbe, 0x8e,
-	0x4d, 0x3d, 0x03, 0x25, 0x8a, 0xe1, 0x5f, 0xc6, 0xeb, 0x25, 0xa0, 0x50, 0x1f, 0x09, 0x64, 0x2c,
-	0xb0, 0x30, 0xdb, 0x02, 0x9f, 0x80, 0x1a, 0xb7, 0x3b, 0xe7, 0x34, 0x08, 0x59, 0x1e, 0x58, 0x43,
-	0xc3, 0x9a, 0x8f, 0xe9, 0xdf, 0x73, 0x32, 0xf9, 0x06, 0x54, 0x7f, 0x94, 0x53, 0x75, 0x30, 0x9f,
-	0xae, 0xe2, 0xec, 0x4b, 0x7c, 0xf6, 0x6c, 0xc2, 0xa5, 0xcf, 0xfb, 0x63, 0x19, 0xd8, 0x27, 0x50,
-	0xa2, 0x88, 0xa7, 0xd0, 0x1e, 0x62, 0x9c, 0xca, 0x21, 0x96, 0x2e, 0x58, 0xe4, 0x33, 0x00, 0xdf,
-	0x08, 0xa8, 0x1b, 0x75, 0x98, 0x32, 0x4a, 0x63, 0xca, 0x50, 0x38, 0x8f, 0x41, 0xaf, 0xd4, 0x45,
-	0x95, 0x3f, 0xee, 0xa2, 0xe4, 0xeb, 0x5f, 0xd4, 0xe4, 0x4b, 0x55, 0xae, 0x7a, 0xa9, 0x89, 0x15,
-	0xc2, 0xb5, 0xac, 0xf0, 0x93, 0x8c, 0x15, 0x3e, 0x62, 0x98, 0x1e, 0xf1, 0xa3, 0x30, 0xa7, 0xaa,
-	0xc0, 0xf4, 0x48, 0xd3, 0x63, 0x26, 0x4b, 0xf2, 0x42, 0x06, 0xfd, 0x1a, 0x9f, 0xa7, 0x92, 0x3c,
-	0x04, 0x83, 0x3a, 0x67, 0x90, 0xa7, 0x50, 0x11, 0x1b, 0x47, 0xb0, 0x44, 0x52, 0x69, 0x99, 0x4e,
-	0x7d, 0x4f, 0x07, 0xce, 0x65, 0x6d, 0x86, 0x42, 0x85, 0xac, 0x40, 0x23, 0x0b, 0xb8, 0x29, 0x71,
-	0xae, 0x1d, 0x8e, 0x49, 0x52, 0x1e, 0x68, 0xe9, 0x2a, 0x0f, 0xb4, 0x72, 0x1d, 0x0f, 0xb4, 0x36,
-	0xe9, 0x81, 0xc6, 0x5c, 0xcc, 0xe3, 0x6b, 0xb8, 0x98, 0xcd, 0x69, 0x2e, 0x26, 0xeb, 0xc9, 0xee,
-	0x8c, 0x7b, 0xb2, 0xc4, 0x03, 0xad, 0x5f, 0xe1, 0x81, 0x5e, 0x42, 0x4d, 0x04, 0xe6, 0x10, 0x23,
-	0x75, 0xa3, 0x81, 0x41, 0x95, 0x0f, 0x48, 0x87, 0x70, 0xbd, 0xfa, 0x2e, 0x1d, 0xd0, 0xbf, 0x86,
-	0x85, 0x40, 0x44, 0xb8, 0x4e, 0x40, 0x7f, 0x35, 0xa4, 0x61, 0x14, 0x36, 0x56, 0x53, 0x8b, 0xa5,
-	0xe3, 0x9f, 0xae, 0xc6, 0xb2, 0xba, 0x10, 0x25, 0x5f, 0xc2, 0x7c, 0x32, 0xde, 0xb1, 0x07, 0x76,
-	0x14, 0x36, 0x3e, 0xbd, 0x6c, 0x74, 0x3d, 0x96, 0x3c, 0x44, 0x41, 0x66, 0x1a, 0x36, 0x0b, 0xf7,
-	0x8d, 0x66, 0xca, 0x34, 0x04, 0xac, 0x43, 0x06, 0xd9, 0x04, 0x70, 0xe9, 0xbb, 0xf8, 0xae, 0xef,
-	0xa2, 0xd8, 0x3c, 0x5a, 0x06, 0xbf, 0x6a, 0xcc, 0xc7, 0x15, 0x97, 0xbe, 0x13, 0x37, 0x3f, 0xee,
-	0x87, 0xef, 0x5f, 0xe1, 0x87, 0x1f, 0x40, 0x95, 0xba, 0xc6, 0xa9, 0x43, 0x3b, 0x5c, 0xcb, 0x1b,
-	0x08, 0xd0, 0x2a, 0x9c, 0xc6, 0xb3, 0x40, 0x86, 0xcb, 0x0d, 0x27, 0x6a, 0x3c, 0x10, 0xb8, 0xdc,
-	0x70, 0x22, 0x96, 0xed, 0x9f, 0x1a, 0x91, 0xd9, 0x6f, 0x68, 0xbc, 0x32, 0x84, 0x1d, 0xf2, 0x39,
-	0x80, 0xd9, 0x1f, 0xba, 0x67, 0xdc, 0xef, 0x3c, 0x4c, 0x23, 0x51, 0x46, 0x46, 0x15, 0x28, 0x66,
-	0xdc, 0xc4, 0xe4, 0x9b, 0x21, 0x19, 0xcc, 0xfa, 0xd8, 0x03, 0x79, 0x74, 0x75, 0xf2, 0xcd, 0xe4,
-	0x8f, 0xb9, 0x38, 0x4b, 0x9f, 0x59, 0x7e, 0x15, 0x8f, 0xfe, 0xec, 0xca, 0xf4, 0xf9, 0xad, 0x77,
-	0x1a, 0x8f, 0xe5, 0xd6, 0xcb, 0xd6, 0x0e, 0x6c, 0x1a, 0x36, 0x9e, 0x24, 0xd6, 0x3b, 0x1c, 0x1c,
-	0x33, 0x0a, 0xf9, 0x0a, 0xe6, 0x43, 0xb3, 0x4f, 0xad, 0xa1, 0x63, 0xbb, 0x3d, 0x7e, 0xa0, 0xa7,
-	0xb8, 0xc0, 0x22, 0x7f, 0xbf, 0x09, 0x8f, 0x5f, 0x6c, 0x98, 0xe9, 0x93, 0x55, 0x90, 0x7d, 0xcf,
-	0xe2, 0xc3, 0x7e, 0x82, 0x7a, 0x2b, 0xfb, 0x9e, 0x85, 0xac, 0xbb, 0xa0, 0x30, 0x96, 0x8f, 0xea,
-	0x7b, 0x86, 0x3c, 0x26, 0x7b, 0xc4, 0xfa, 0x2d, 0x49, 0x96, 0xd4, 0x62, 0x4b, 0x92, 0x8b, 0x6a,
-	0xa9, 0x25, 0xc9, 0xf7, 0xd4, 0xfb, 0xda, 0x1e, 0x94, 0xb8, 0xf1, 0x4e, 0xad, 0x57, 0x3c, 0xca,
-	0xc2, 0x43, 0x75, 0xcc, 0xd8, 0x63, 0x1f, 0xa6, 0xbd, 0x10, 0xc0, 0xbe, 0xeb, 0x31, 0xef, 0x2d,
-	0x63, 0x5a, 0xea, 0x76, 0xbd, 0x46, 0x0e, 0xdf, 0x48, 0x35, 0xf6, 0x7b, 0x68, 0x4d, 0xe5, 0xb7,
-	0xbc, 0xa1, 0xad, 0x81, 0x1c, 0x47, 0xa3, 0x69, 0x8b, 0x6b, 0x7f, 0x9b, 0x83, 0x5a, 0x2c, 0x90,
-	0xad, 0x19, 0x14, 0x53, 0x5b, 0xbc, 0x2f, 0x4a, 0x40, 0xb9, 0x71, 0xaf, 0x36, 0x5e, 0xbc, 0xca,
-	0x67, 0xca, 0x2a, 0x71, 0x15, 0xa1, 0x30, 0xa5, 0x8a, 0x20, 0x65, 0x8a, 0x54, 0x52, 0x37, 0xf0,
-	0x06, 0x22, 0x0a, 0x65, 0x0c, 0x1d, 0x19, 0xda, 0xbf, 0xe5, 0x41, 0x65, 0x69, 0xe1, 0x68, 0xa7,
-	0x5d, 0x8f, 0x3c, 0x8e, 0xf5, 0x96, 0x43, 0xbd, 0x91, 0x4c, 0xe8, 0xbd, 0xc4, 0xfb, 0x4b, 0x19,
-	0xef, 0xff, 0x0c, 0x2a, 0xec, 0x76, 0xe3, 0x77, 0x96, 0x9f, 0x5c, 0x1e, 0x18, 0x5f, 0x3c, 0xb3,
-	0x5d, 0x60, 0xc6, 0xd7, 0x41, 0x50, 0x1c, 0x8a, 0x74, 0xff, 0x53, 0x1e, 0x5a, 0xc7, 0xb6, 0xc6,
-	0xae, 0x61, 0x17, 0xc5, 0x78, 0xf9, 0x58, 0x79, 0x1b, 0xf7, 0x99, 0xa7, 0x34, 0x86, 0x51, 0xbf,
-	0x13, 0x79, 0x67, 0xd4, 0x15, 0x3a, 0x56, 0x18, 0xe5, 0x98, 0x11, 0xc8, 0x0b, 0xa8, 0x3b, 0x46,
-	0x88, 0x31, 0x59, 0xe0, 0xfb, 0xd2, 0xb4, 0xa8, 0x56, 0x65, 0x42, 0x71, 0xaf, 0xf9, 0x15, 0xd4,
-	0xb3, 0x0b, 0xa6, 0xab, 0xaf, 0xc5, 0x29, 0xd5, 0xd7, 0x62, 0xba, 0xfa, 0xfa, 0x67, 0x35, 0xa8,
-	0x66, 0xf4, 0xca, 0x4b, 0x22, 0x0b, 0x13, 0x25, 0x91, 0x74, 0xb6, 0x93, 0x9b, 0x9d, 0xed, 0x34,
-	0xa0, 0x1c, 0x27, 0x39, 0x15, 0x1e, 0xbb, 0x44, 0xf7, 0x86, 0x09, 0xd6, 0xb4, 0x54, 0x08, 0x6e,
-	0x92, 0x0a, 0xbd, 0x84, 0x5a, 0x5f, 0x14, 0x92, 0xd2, 0xef, 0x9f, 0xbb, 0xf5, 0x74, 0x89, 0x49,
-	0xaf, 0xf6, 0xd3, 0x05, 0xa7, 0x6b, 0xa5, 0x50, 0xbf, 0x0d, 0x60, 0x06, 0xd4, 0x88, 0xa8, 0xd5,
-	0x31, 0x22, 0x61, 0xbc, 0xb3, 0xb2, 0x1c, 0x45, 0x48, 0x6f, 0x47, 0x23, 0xdb, 0x2d, 0x5f, 0x65,
-	0xbb, 0x0d, 0x96, 0x7e, 0x79, 0x18, 0xc0, 0x1f, 0xe1, 0x93, 0x89, 0xbb, 0xcc, 0xed, 0x07, 0xd4,
-	0x64, 0x19, 0x1c, 0x0d, 0x02, 0x2f, 0xc0, 0x34, 0x4b, 0xd1, 0x2b, 0x9c, 0xb6, 0xcf, 0x48, 0xe4,
-	0x9b, 0x8c, 0xc9, 0x2a, 0x68, 0xb2, 0x1b, 0x99, 0xb5, 0xae, 0x30, 0xd7, 0x49, 0x7b, 0xfc, 0xc9,
-	0x95, 0xf6, 0x38, 0x99, 0xde, 0xa8, 0x53, 0xd2, 0x9b, 0x6f, 0x61, 0x29, 0x34, 0x0d, 0x87, 0x76,
-	0x18, 0xbc, 0xef, 0x44, 0xfd, 0x80, 0x86, 0x7d, 0xcf, 0xb1, 0x44, 0xe2, 0x34, 0x23, 0x02, 0x10,
-	0x1c, 0xb6, 0xe7, 0xbd, 0x73, 0x8f, 0xe3, 0x41, 0xd3, 0xe3, 0xff, 0xe2, 0xad, 0xe2, 0xff, 0xfa,
-	0x8d, 0xe3, 0xff, 0xd2, 0x65, 0xf1, 0x7f, 0x03, 0x2a, 0x16, 0x0d, 0xcd, 0xc0, 0xf6, 0xd9, 0x01,
-	0x1a, 0xcb, 0xfc, 0x9e, 0x52, 0x24, 0xe6, 0x15, 0x4c, 0xc3, 0xec, 0x0b, 0x00, 0x7f, 0x87, 0x7b,
-	0x05, 0xa4, 0x30, 0x00, 0x3f, 0x11, 0xe0, 0x1b, 0x97, 0x07, 0xf8, 0xd5, 0x69, 0x01, 0xfe, 0x6e,
-	0x3a, 0xc0, 0x8f, 0x9c, 0xe1, 0xbd, 0x8c, 0x33, 0xfc, 0x14, 0xea, 0x03, 0xe3, 0x87, 0x4e, 0xaa,
-	0x90, 0x70, 0x1f, 0x03, 0x6a, 0x75, 0x60, 0xfc, 0xf0, 0x07, 0x71, 0x2d, 0x21, 0x9d, 0x30, 0xaf,
-	0xdd, 0x2e, 0x61, 0xce, 0x26, 0x1a, 0x1b, 0x37, 0x4e, 0x34, 0x1e, 0xdc, 0x2a, 0xd1, 0xd0, 0x6e,
-	0x92, 0x68, 0x3c, 0x87, 0x4a, 0xcf, 0x8e, 0xfa, 0x9e, 0x77, 0xd6, 0x19, 0x06, 0x0e, 0x87, 0x10,
-	0x3b, 0xf5, 0x0f, 0xef, 0xd7, 0xe1, 0x35, 0x27, 0x9f, 0xe8, 0x87, 0x3a, 0x08, 0x91, 0x93, 0xc0,
-	0x19, 0x0f, 0x2c, 0x9f, 0xce, 0x0e, 0x2c, 0xf8, 0xc4, 0x0d, 0xd7, 0x3a, 0xbd, 0xc0, 0x7c, 0x0b,
-	0x9f, 0x38, 0x76, 0xc7, 0x33, 0x9c, 0xcf, 0xae, 0x93, 0xe1, 0x3c, 0xfe, 0xb8, 0x0c, 0xe7, 0xc9,
-	0xf5, 0x33, 0x9c, 0xdb, 0x05, 0x1c, 0x5e, 0x20, 0x4a, 0xb2, 0xa4, 0x15, 0xf5, 0x4e, 0x4b, 0x92,
-	0x9b, 0xea, 0x5d, 0xed, 0x75, 0x3a, 0x13, 0x61, 0x49, 0xce, 0x4b, 0xa8, 0x25, 0x98, 0x39, 0x95,
-	0xe9, 0x2c, 0x4c, 0x38, 0x30, 0xbd, 0xea, 0xa7, 0x7a, 0xda, 0x7f, 0xe5, 0x40, 0xdd, 0x45, 0x57,
-	0xcb, 0xa0, 0x2c, 0x7f, 0xe3, 0xb7, 0xaa, 0x16, 0xad, 0x5e, 0x81, 0x41, 0xc7, 0x8e, 0x94, 0x53,
-	0xf3, 0x2d, 0x49, 0x06, 0xb5, 0xd2, 0x92, 0xe4, 0xb2, 0x2a, 0xb7, 0x24, 0x59, 0x51, 0xa1, 0x25,
-	0xc9, 0xb2, 0xaa, 0xb4, 0x24, 0xb9, 0xaa, 0xd6, 0x5a, 0x92, 0x5c, 0x51, 0xab, 0x2d, 0x49, 0xae,
-	0xa9, 0xf5, 0x96, 0x24, 0xd7, 0xd5, 0xf9, 0x96, 0x24, 0x2f, 0xab, 0x2b, 0x2d, 0x49, 0x9e, 0x57,
-	0xd5, 0x96, 0x24, 0xab, 0xea, 0x42, 0x4b, 0x92, 0x17, 0x54, 0xd2, 0x92, 0x64, 0xa2, 0x2e, 0xb6,
-	0x24, 0x79, 0x51, 0x5d, 0x6a, 0x49, 0xf2, 0x92, 0xba, 0x9c, 0xa8, 0xec, 0x8e, 0xda, 0x68, 0x49,
-	0x72, 0x43, 0x5d, 0xd5, 0xfe, 0x34, 0x07, 0x0b, 0x07, 0x2e, 0xbb, 0xc5, 0x28, 0x75, 0xe0, 0x59,
-	0x65, 0x8e, 0x9b, 0xd7, 0xc3, 0xd6, 0xa1, 0x72, 0xea, 0x78, 0xe6, 0x59, 0x67, 0x94, 0xaa, 0xca,
-	0x3a, 0x20, 0x09, 0x9d, 0xbe, 0xf6, 0x37, 0x39, 0xa8, 0x1f, 0xda, 0x61, 0x74, 0x89, 0xca, 0xaf,
-	0x48, 0x18, 0x36, 0xa1, 0x8a, 0xbe, 0x72, 0x94, 0x8a, 0x15, 0x26, 0x20, 0x0f, 0x0a, 0x88, 0xed,
-	0xdc, 0xf8, 0x00, 0xda, 0x5b, 0x98, 0x7f, 0xe5, 0x0c, 0xc3, 0x7e, 0x6a, 0x7f, 0x0f, 0xa1, 0xcc,
-	0x47, 0x87, 0xc2, 0xb2, 0x32, 0xc3, 0x63, 0x1e, 0xf9, 0x02, 0xaa, 0x91, 0xd7, 0x89, 0xb7, 0x1a,
-	0x7f, 0xe0, 0x1b, 0x3b, 0x4a, 0x25, 0xf2, 0xe2, 0x76, 0xa8, 0x6d, 0x82, 0xba, 0x47, 0x1d, 0x9a,
-	0xb1, 0xbf, 0x19, 0xd7, 0xa1, 0x3d, 0x83, 0x7a, 0x3b, 0xf2, 0xfc, 0x6b, 0x4a, 0xff, 0x67, 0x0e,
-	0xea, 0xaf, 0x69, 0x74, 0xe8, 0xf5, 0xc2, 0x8f, 0x30, 0xee, 0x59, 0x66, 0x11, 0x57, 0x0c, 0xba,
-	0xb6, 0x13, 0xd1, 0x80, 0xe7, 0xb8, 0x0a, 0xaf, 0x18, 0xbc, 0xe2, 0xa4, 0xd1, 0xd7, 0xae, 0xd2,
-	0x65, 0x5f, 0xbb, 0x56, 0xa0, 0x34, 0x30, 0xc2, 0x88, 0x06, 0x98, 0xdd, 0xca, 0xba, 0xe8, 0x31,
-	0x7a, 0xd7, 0x73, 0x1c, 0xef, 0x9d, 0xf8, 0x08, 0x2d, 0x7a, 0x58, 0x1e, 0x36, 0x6c, 0x47, 0xd4,
-	0x37, 0xb1, 0xcd, 0x5f, 0x92, 0xf6, 0x4f, 0x79, 0x80, 0x43, 0xaf, 0xf7, 0x4b, 0x1a, 0x86, 0x46,
-	0x0f, 0xd3, 0x8a, 0xc4, 0x1d, 0xa4, 0x70, 0x4c, 0xf2, 0xf6, 0xdf, 0x30, 0xa4, 0x32, 0xaa, 0xd7,
-	0x17, 0x2e, 0xa9, 0xd7, 0x67, 0x8a, 0xff, 0xe5, 0x99, 0xc5, 0xff, 0x47, 0x20, 0x73, 0xf7, 0x6b,
-	0x5b, 0x58, 0x87, 0x52, 0x76, 0x2a, 0x1f, 0xde, 0xaf, 0x97, 0xf9, 0xb7, 0xbf, 0x3d, 0xbd, 0x8c,
-	0xcc, 0x03, 0x2b, 0x75, 0x64, 0xc8, 0x1c, 0x39, 0xfe, 0x34, 0x20, 0xcd, 0xf8, 0x34, 0x10, 0xff,
-	0x12, 0x43, 0xe6, 0x78, 0x08, 0x7f, 0x89, 0xf1, 0x14, 0xf2, 0x49, 0xd5, 0x7f, 0x56, 0x42, 0x99,
-	0x8f, 0x42, 0x16, 0x3c, 0x06, 0x5c, 0x41, 0x78, 0x25, 0x8a, 0x1e, 0x77, 0xb5, 0x63, 0x58, 0xd4,
-	0x79, 0xc5, 0x88, 0xdf, 0xcf, 0x35, 0xfc, 0xc2, 0xb8, 0x01, 0xe4, 0x27, 0x0c, 0x40, 0xfb, 0x2d,
-	0x58, 0x14, 0xbe, 0x26, 0x33, 0xeb, 0x95, 0x5f, 0x41, 0xb5, 0x0e, 0xa8, 0xcc, 0x3f, 0x5c, 0x7b,
-	0x2f, 0x2c, 0x02, 0x19, 0x3d, 0x91, 0x8a, 0xf0, 0xaf, 0x04, 0x32, 0x23, 0x60, 0x1a, 0x82, 0xdf,
-	0x79, 0x7b, 0xbc, 0xea, 0x5a, 0xd0, 0xb1, 0xad, 0x5d, 0xc0, 0x42, 0x6a, 0x81, 0xd0, 0xf7, 0xdc,
-	0x10, 0x3f, 0x4b, 0x89, 0x2b, 0x64, 0x21, 0x45, 0xbc, 0xf3, 0xfa, 0x68, 0x77, 0x18, 0x3e, 0x78,
-	0x44, 0xe5, 0x41, 0x67, 0x1d, 0x2a, 0x58, 0xc5, 0xea, 0xb0, 0x39, 0x43, 0xb1, 0x30, 0x20, 0xe9,
-	0x88, 0x51, 0xa6, 0x2e, 0xfd, 0x27, 0x70, 0x27, 0x59, 0xba, 0x1d, 0x05, 0xd4, 0x18, 0x6d, 0xe0,
-	0x73, 0x80, 0xd1, 0x06, 0x32, 0x1f, 0xdf, 0x46, 0xeb, 0x2b, 0xc9, 0xfa, 0x1f, 0xb7, 0xfc, 0x0e,
-	0x28, 0x49, 0xce, 0x94, 0xfa, 0xb4, 0x92, 0x4b, 0x7f, 0x5a, 0x61, 0x39, 0x26, 0x53, 0xa5, 0xf8,
-	0x6c, 0xc6, 0x27, 0x56, 0x18, 0x85, 0x7f, 0x24, 0xfb, 0x97, 0x1c, 0xd4, 0xb3, 0xe9, 0x02, 0x69,
-	0x41, 0xcd, 0xf5, 0x2c, 0xda, 0x09, 0xa9, 0x43, 0xcd, 0xc8, 0x0b, 0x84, 0xf6, 0x1e, 0x4e, 0x49,
-	0x2d, 0x36, 0xdf, 0x78, 0x16, 0x6d, 0x0b, 0x39, 0x8e, 0x22, 0xaa, 0x6e, 0x8a, 0x44, 0x36, 0x61,
-	0xd1, 0x0f, 0x6c, 0x2f, 0xb0, 0xa3, 0x8b, 0x8e, 0xe9, 0x18, 0x61, 0xc8, 0x9f, 0x30, 0xaf, 0x17,
-	0x2c, 0xc4, 0xac, 0x5d, 0xc6, 0x61, 0xef, 0xb8, 0xf9, 0x0d, 0x2c, 0x4c, 0x4c, 0x79, 0xa3, 0x1f,
-	0x15, 0xfd, 0xb3, 0x02, 0xcb, 0x3c, 0x09, 0x48, 0x9c, 0xe0, 0xcd, 0xc3, 0xd2, 0xed, 0xd1, 0x6a,
-	0xf9, 0x56, 0x68, 0x75, 0xfd, 0xa6, 0x68, 0x55, 0xb9, 0x1c, 0xad, 0xae, 0x40, 0x69, 0xe8, 0x5b,
-	0x2c, 0x78, 0x0b, 0xbf, 0xcc, 0x7b, 0x93, 0x68, 0x0d, 0x6e, 0x80, 0xd6, 0x2a, 0xff, 0x6f, 0x68,
-	0xad, 0x7a, 0x2b, 0xb4, 0xb6, 0x72, 0x63, 0xb4, 0x56, 0xbb, 0x26, 0x5a, 0xab, 0x5f, 0x85, 0xd6,
-	0xd4, 0xab, 0xd0, 0xda, 0xc2, 0x24, 0x5a, 0xbb, 0x07, 0x4a, 0x40, 0x45, 0xf9, 0x1e, 0x11, 0xaf,
-	0xac, 0x8f, 0x08, 0x23, 0xdc, 0xb6, 0x98, 0xc6, 0x6d, 0x93, 0xf8, 0x6c, 0x69, 0x36, 0x3e, 0x5b,
-	0xbe, 0x16, 0x3e, 0x7b, 0x70, 0x3d, 0x7c, 0x76, 0xe7, 0xc6, 0xf8, 0xac, 0x71, 0x2b, 0x7c, 0xb6,
-	0x7a, 0x13, 0x7c, 0x16, 0x83, 0xdf, 0x66, 0x0a, 0xfc, 0xa6, 0x40, 0xd5, 0xdd, 0x99, 0xa0, 0xea,
-	0xde, 0x75, 0x40, 0xd5, 0xfd, 0x8f, 0x03, 0x55, 0x6b, 0x33, 0x40, 0xd5, 0xc6, 0x44, 0xd9, 0x38,
-	0x8d, 0x21, 0xe6, 0x55, 0x55, 0xdb, 0x85, 0x15, 0x11, 0x6a, 0x3f, 0xde, 0x85, 0x69, 0xcb, 0xb0,
-	0xc8, 0x42, 0xd3, 0xd8, 0x0c, 0xda, 0x39, 0x2c, 0xf3, 0x14, 0xf5, 0x16, 0xde, 0x51, 0x85, 0x82,
-	0xe1, 0x38, 0xa2, 0x92, 0xcb, 0x9a, 0xcc, 0x72, 0xbb, 0x5e, 0x60, 0xc6, 0x2e, 0x86, 0x77, 0x5a,
-	0x92, 0x9c, 0x57, 0x0b, 0xe2, 0x77, 0x01, 0xdb, 0xb0, 0xd4, 0x66, 0x29, 0xc9, 0x2d, 0x4e, 0xf4,
-	0x7b, 0xb0, 0xc8, 0xb2, 0xe5, 0x5b, 0xcc, 0xf0, 0xe7, 0x39, 0x58, 0xd2, 0x69, 0x30, 0x74, 0x6f,
-	0x71, 0xf8, 0x87, 0x50, 0xa6, 0x3f, 0x98, 0xce, 0xd0, 0xa2, 0xd3, 0xc0, 0x4a, 0xcc, 0x63, 0x62,
-	0xb6, 0xcb, 0xc5, 0x0a, 0x53, 0xc4, 0x04, 0x4f, 0xfb, 0x12, 0x96, 0x5f, 0x1b, 0xc1, 0xa9, 0xd1,
-	0xa3, 0xbb, 0x9e, 0xc3, 0x42, 0x5e, 0xbc, 0xa3, 0x07, 0x50, 0xe5, 0xbf, 0xc5, 0x10, 0x71, 0x9b,
-	0xc7, 0xf4, 0x0a, 0xa7, 0xf1, 0xc8, 0xdd, 0x80, 0x95, 0xf1, 0xb1, 0x3c, 0xf7, 0x60, 0x77, 0xbf,
-	0x6d, 0x46, 0xf6, 0xb9, 0x11, 0xd1, 0xed, 0x61, 0xd4, 0x8f, 0xef, 0x7e, 0x05, 0x96, 0xb2, 0x64,
-	0x2e, 0xfe, 0xd4, 0xc7, 0x0f, 0x0c, 0xbc, 0x86, 0xa7, 0x42, 0xb5, 0xf5, 0xdd, 0x4e, 0xa7, 0x7d,
-	0xbc, 0xad, 0x1f, 0x1f, 0xbc, 0x79, 0xad, 0xce, 0x91, 0x79, 0xa8, 0x30, 0x8a, 0x7e, 0xf2, 0xe6,
-	0x0d, 0x23, 0xe4, 0x62, 0xc2, 0xab, 0xed, 0x83, 0xc3, 0x13, 0x7d, 0x5f, 0xcd, 0xc7, 0x84, 0xf6,
-	0xc9, 0xee, 0xee, 0x7e, 0xbb, 0xad, 0x16, 0x48, 0x1d, 0x80, 0x11, 0xbe, 0x3d, 0x38, 0x3c, 0xdc,
-	0xdf, 0x53, 0xa5, 0x58, 0xe0, 0x97, 0xfb, 0xfa, 0x6b, 0x36, 0x45, 0xf1, 0xe9, 0x77, 0x00, 0xa3,
-	0xdf, 0xc1, 0x11, 0x80, 0x12, 0x9b, 0x6c, 0x7f, 0x4f, 0x9d, 0x23, 0x15, 0x28, 0xc7, 0xf3, 0xe4,
-	0xb0, 0xf3, 0xed, 0xc1, 0xd1, 0xd1, 0xfe, 0x9e, 0x9a, 0x27, 0x55, 0x90, 0x93, 0x5d, 0x15, 0x48,
-	0x0d, 0x14, 0x7d, 0x7f, 0xf7, 0xbb, 0xef, 0xf7, 0x75, 0xb6, 0xc2, 0xd3, 0x6f, 0xa0, 0x92, 0xfa,
-	0x72, 0xc2, 0x16, 0x3c, 0xfa, 0x6e, 0x2f, 0xd9, 0xf3, 0x5c, 0x4c, 0x18, 0x4d, 0x5d, 0x07, 0x60,
-	0x04, 0xb1, 0x6e, 0xfe, 0xe9, 0x5f, 0xa6, 0xbe, 0x87, 0xf0, 0x39, 0x96, 0x61, 0xe1, 0xe8, 0xe0,
-	0x68, 0xff, 0xf0, 0xe0, 0xcd, 0x7e, 0x5a, 0x1d, 0x4b, 0xa0, 0x26, 0xe4, 0x91, 0x4e, 0xee, 0xc0,
-	0xe2, 0x88, 0xba, 0x9f, 0x88, 0xe7, 0x33, 0xe2, 0xb1, 0xc6, 0x0a, 0x64, 0x11, 0xe6, 0x13, 0xea,
-	0xd1, 0xf6, 0x49, 0x1b, 0xb5, 0x94, 0x16, 0x6d, 0x1f, 0x6f, 0xbf, 0xd9, 0xdb, 0xf9, 0x23, 0xb5,
-	0xb8, 0xf5, 0xdf, 0x00, 0x85, 0xed, 0xa3, 0x03, 0xb2, 0x09, 0x4a, 0x52, 0xdb, 0x20, 0xcb, 0xe2,
-	0x27, 0xa2, 0xd9, 0x5a, 0x47, 0x33, 0xc9, 0xa4, 0xb5, 0x39, 0xf2, 0x33, 0x80, 0x51, 0x6d, 0x80,
-	0xac, 0x88, 0x78, 0x37, 0x56, 0x2c, 0x68, 0x66, 0xbe, 0x1e, 0x69, 0x73, 0xe4, 0x39, 0x94, 0x05,
-	0x98, 0x27, 0xdc, 0xe9, 0x65, 0xa1, 0x7d, 0xb3, 0x96, 0x96, 0x0f, 0xb5, 0x39, 0x96, 0xba, 0x08,
-	0x11, 0x9e, 0xff, 0x4e, 0x1f, 0x36, 0xb6, 0xcc, 0x17, 0x39, 0xb2, 0x05, 0x72, 0x0c, 0xcb, 0x09,
-	0xcf, 0x92, 0xc6, 0x50, 0xfa, 0x94, 0x31, 0x5f, 0x81, 0x92, 0xc0, 0x6b, 0xa1, 0x82, 0x71, 0xb8,
-	0xdd, 0x5c, 0x99, 0x88, 0x1c, 0xfb, 0x03, 0x3f, 0xba, 0xd0, 0xe6, 0xc8, 0x2f, 0xa0, 0x2c, 0xc0,
-	0xb6, 0xd8, 0x63, 0x16, 0x7a, 0xcf, 0x18, 0xf9, 0x25, 0x54, 0xd3, 0xd0, 0x87, 0x34, 0xd2, 0xca,
-	0x4c, 0xe3, 0x9a, 0xe6, 0x58, 0x82, 0xaf, 0xcd, 0xb1, 0x3d, 0x27, 0x08, 0x41, 0xec, 0x79, 0x1c,
-	0x0d, 0x35, 0x57, 0xc6, 0xc9, 0xe2, 0x19, 0xcf, 0x91, 0x16, 0xcc, 0x8f, 0xe1, 0x8b, 0xcb, 0xe6,
-	0xb8, 0x97, 0x25, 0x67, 0xc1, 0x08, 0x6a, 0x6f, 0x07, 0x7f, 0x0d, 0x96, 0xc0, 0x42, 0x71, 0x8a,
-	0x29, 0x48, 0x71, 0x86, 0x26, 0x5e, 0x41, 0x3d, 0x9b, 0x5b, 0x93, 0x66, 0xca, 0x12, 0xc7, 0xbc,
-	0xea, 0x8c, 0x79, 0x76, 0x61, 0x7e, 0x2c, 0xc2, 0x91, 0xbb, 0x69, 0xa5, 0x8e, 0xcf, 0x34, 0x59,
-	0xfa, 0xd3, 0xe6, 0xc8, 0xd7, 0x50, 0x4d, 0x47, 0x38, 0x71, 0xa0, 0x29, 0x41, 0xaf, 0x49, 0x26,
-	0x86, 0x87, 0xfc, 0x30, 0xd9, 0x50, 0x28, 0x0e, 0x33, 0x35, 0x3e, 0xce, 0x38, 0xcc, 0x1e, 0xd4,
-	0x32, 0xa1, 0x8d, 0xac, 0x0a, 0xf3, 0x9a, 0x0c, 0x77, 0x33, 0x66, 0xd9, 0x81, 0x6a, 0x3a, 0xba,
-	0x89, 0xd3, 0x4c, 0x09, 0x78, 0xb3, 0x77, 0x92, 0x09, 0x6f, 0x62, 0x27, 0xd3, 0x42, 0xde, 0x8c,
-	0x59, 0x7e, 0x37, 0x7e, 0x66, 0xdb, 0x8e, 0x43, 0x2e, 0x11, 0x9b, 0x31, 0xfc, 0x05, 0x94, 0x45,
-	0x95, 0x4a, 0xbc, 0xb3, 0x6c, 0xcd, 0xaa, 0xc9, 0x7f, 0x06, 0x3d, 0xaa, 0xef, 0xa0, 0x71, 0x7e,
-	0x0b, 0xf5, 0x6c, 0x2c, 0x13, 0x77, 0x31, 0x35, 0x38, 0x36, 0xef, 0x4e, 0xe5, 0x25, 0xaf, 0x66,
-	0x1f, 0xaa, 0xe9, 0x38, 0x27, 0x54, 0x39, 0x25, 0x22, 0x36, 0x57, 0xa7, 0x70, 0xe2, 0x69, 0x76,
-	0xbe, 0xf9, 0xf5, 0x87, 0xb5, 0xdc, 0x6f, 0x3e, 0xac, 0xe5, 0xfe, 0xfd, 0xc3, 0x5a, 0xee, 0xaf,
-	0xfe, 0x63, 0x6d, 0xee, 0x8f, 0x3f, 0xef, 0xd9, 0x51, 0x7f, 0x78, 0xba, 0x69, 0x7a, 0x83, 0xe7,
-	0xbe, 0x61, 0xf6, 0x2f, 0x2c, 0x1a, 0xa4, 0x5b, 0x61, 0x60, 0x3e, 0x1f, 0xfd, 0xcf, 0xd6, 0x69,
-	0x09, 0x75, 0xf3, 0xe2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc2, 0x8d, 0x17, 0xc8, 0x35,
-	0x00, 0x00,
+var xxx_messageInfo_GetKubeEventsRequest proto.InternalMessageInfo
+
+func (m *GetKubeEventsRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// KubeEvent is one kubernetes event concerning a pipeline's worker pods
+// (e.g. an image pull failure, a scheduling failure, or an OOM kill),
+// proxied through pachd so that users without kubectl access can see why
+// workers aren't starting.
+type KubeEvent struct {
+	LastSeen *types.Timestamp `protobuf:"bytes,1,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// Type is "Normal" or "Warning".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Reason is kubernetes' short machine-readable reason, e.g. "Failed",
+	// "FailedScheduling", or "OOMKilling".
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Object names the pod (or replication controller) the event is about.
+	Object  string `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Count is how many times the event has occurred.
+	Count                int64    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *KubeEvent) Reset()         { *m = KubeEvent{} }
+func (m *KubeEvent) String() string { return proto.CompactTextString(m) }
+func (*KubeEvent) ProtoMessage()    {}
+func (*KubeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{58}
+}
+func (m *KubeEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KubeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KubeEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KubeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeEvent.Merge(m, src)
+}
+func (m *KubeEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *KubeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeEvent.DiscardUnknown(m)
+}
 
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error)
-	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
-	// ListJob returns information about current and past Pachyderm jobs. This is
-	// deprecated in favor of ListJobStream
-	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error)
-	// ListJobStream returns information about current and past Pachyderm jobs.
-	ListJobStream(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobStreamClient, error)
-	FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error)
-	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error)
-	// ListDatum returns information about each datum fed to a Pachyderm job. This
-	// is deprecated in favor of ListDatumStream
-	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error)
-	// ListDatumStream returns information about each datum fed to a Pachyderm job
-	ListDatumStream(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumStreamClient, error)
-	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
-	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
-	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
-	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
-	// Garbage collection
-	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
-	// An internal call that causes PPS to put itself into an auth-enabled state
-	// (all pipeline have tokens, correct permissions, etcd)
-	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
-}
+var xxx_messageInfo_KubeEvent proto.InternalMessageInfo
 
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *KubeEvent) GetLastSeen() *types.Timestamp {
+	if m != nil {
+		return m.LastSeen
+	}
+	return nil
 }
 
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *KubeEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (c *aPIClient) CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error) {
-	out := new(Job)
-	err := c.cc.Invoke(ctx, "/pps.API/CreateJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *KubeEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
-	out := new(JobInfo)
-	err := c.cc.Invoke(ctx, "/pps.API/InspectJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *KubeEvent) GetObject() string {
+	if m != nil {
+		return m.Object
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error) {
-	out := new(JobInfos)
-	err := c.cc.Invoke(ctx, "/pps.API/ListJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *KubeEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListJobStream(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pps.API/ListJobStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListJobStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *KubeEvent) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return x, nil
+	return 0
 }
 
-type API_ListJobStreamClient interface {
-	Recv() (*JobInfo, error)
-	grpc.ClientStream
+// DatumTimeoutSpec scales the per-datum timeout with the datum's input
+// size, for pipelines whose processing time tracks their input: a uniform
+// datum_timeout is either too tight for big datums or useless for small
+// ones. The timeout for a datum is base + per_gb * (input size in GB),
+// pro-rated, where input size is the total size of the datum's input files.
+type DatumTimeoutSpec struct {
+	// Base is the timeout for an empty datum.
+	Base *types.Duration `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// PerGB is added to base for every gigabyte of input the datum has.
+	PerGB                *types.Duration `protobuf:"bytes,2,opt,name=per_gb,json=perGb,proto3" json:"per_gb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-type aPIListJobStreamClient struct {
-	grpc.ClientStream
+func (m *DatumTimeoutSpec) Reset()         { *m = DatumTimeoutSpec{} }
+func (m *DatumTimeoutSpec) String() string { return proto.CompactTextString(m) }
+func (*DatumTimeoutSpec) ProtoMessage()    {}
+func (*DatumTimeoutSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{59}
 }
-
-func (x *aPIListJobStreamClient) Recv() (*JobInfo, error) {
-	m := new(JobInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DatumTimeoutSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatumTimeoutSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatumTimeoutSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *DatumTimeoutSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatumTimeoutSpec.Merge(m, src)
+}
+func (m *DatumTimeoutSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatumTimeoutSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatumTimeoutSpec.DiscardUnknown(m)
 }
 
-func (c *aPIClient) FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pps.API/FlushJob", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIFlushJobClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_DatumTimeoutSpec proto.InternalMessageInfo
+
+func (m *DatumTimeoutSpec) GetBase() *types.Duration {
+	if m != nil {
+		return m.Base
 	}
-	return x, nil
+	return nil
 }
 
-type API_FlushJobClient interface {
-	Recv() (*JobInfo, error)
-	grpc.ClientStream
+func (m *DatumTimeoutSpec) GetPerGB() *types.Duration {
+	if m != nil {
+		return m.PerGB
+	}
+	return nil
 }
 
-type aPIFlushJobClient struct {
-	grpc.ClientStream
+// SharedCacheSpec requests a managed cache volume mounted into the
+// pipeline's user containers, for data that's expensive to fetch but not
+// versioned in PFS: pip/conda package caches, pretrained model weights, and
+// the like. The cache is backed by a PersistentVolumeClaim that PPS creates
+// on first use; pipelines that name the same cache share the volume.
+// Declaring pipelines mount it read-write; set read_only on pipelines that
+// only consume another pipeline's cache.
+type SharedCacheSpec struct {
+	// Name identifies the cache; the backing PVC is named
+	// "pachyderm-cache-<name>". Must be a valid kubernetes resource name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// MountPath is where the cache appears in the user container. Defaults to
+	// "/cache/<name>".
+	MountPath string `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	// Size is the capacity requested when the backing PVC is first created
+	// (e.g. "10Gi"); defaults to "1Gi". Ignored once the PVC exists.
+	Size_ string `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	// StorageClass of the backing PVC; empty uses the cluster default. The
+	// class must support ReadWriteMany for the volume to be shared across
+	// nodes.
+	StorageClass string `protobuf:"bytes,4,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`
+	// ReadOnly mounts the cache read-only in this pipeline's workers.
+	ReadOnly             bool     `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *aPIFlushJobClient) Recv() (*JobInfo, error) {
-	m := new(JobInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SharedCacheSpec) Reset()         { *m = SharedCacheSpec{} }
+func (m *SharedCacheSpec) String() string { return proto.CompactTextString(m) }
+func (*SharedCacheSpec) ProtoMessage()    {}
+func (*SharedCacheSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{60}
+}
+func (m *SharedCacheSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharedCacheSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharedCacheSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *SharedCacheSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedCacheSpec.Merge(m, src)
+}
+func (m *SharedCacheSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharedCacheSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedCacheSpec.DiscardUnknown(m)
 }
 
-func (c *aPIClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/DeleteJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SharedCacheSpec proto.InternalMessageInfo
+
+func (m *SharedCacheSpec) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/StopJob", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SharedCacheSpec) GetMountPath() string {
+	if m != nil {
+		return m.MountPath
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error) {
-	out := new(DatumInfo)
-	err := c.cc.Invoke(ctx, "/pps.API/InspectDatum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SharedCacheSpec) GetSize_() string {
+	if m != nil {
+		return m.Size_
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error) {
-	out := new(ListDatumResponse)
-	err := c.cc.Invoke(ctx, "/pps.API/ListDatum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SharedCacheSpec) GetStorageClass() string {
+	if m != nil {
+		return m.StorageClass
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) ListDatumStream(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pps.API/ListDatumStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIListDatumStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *SharedCacheSpec) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
 	}
-	return x, nil
+	return false
 }
 
-type API_ListDatumStreamClient interface {
-	Recv() (*ListDatumStreamResponse, error)
-	grpc.ClientStream
+// PauseWindow is a recurring maintenance window during which the pipeline
+// doesn't start jobs. Commits that arrive while the window is open stay
+// queued and their jobs start once the window closes.
+type PauseWindow struct {
+	// Start is a cron spec (interpreted in UTC) for the beginning of the
+	// window, e.g. "0 1 * * *" for 01:00 every day.
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Duration is how long the window stays open after each start.
+	Duration             *types.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-type aPIListDatumStreamClient struct {
-	grpc.ClientStream
+func (m *PauseWindow) Reset()         { *m = PauseWindow{} }
+func (m *PauseWindow) String() string { return proto.CompactTextString(m) }
+func (*PauseWindow) ProtoMessage()    {}
+func (*PauseWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{61}
 }
-
-func (x *aPIListDatumStreamClient) Recv() (*ListDatumStreamResponse, error) {
-	m := new(ListDatumStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *PauseWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/RestartDatum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PauseWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PauseWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWindow.Merge(m, src)
+}
+func (m *PauseWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWindow.DiscardUnknown(m)
 }
 
-func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/CreatePipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PauseWindow proto.InternalMessageInfo
+
+func (m *PauseWindow) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error) {
-	out := new(PipelineInfo)
-	err := c.cc.Invoke(ctx, "/pps.API/InspectPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PauseWindow) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error) {
-	out := new(PipelineInfos)
-	err := c.cc.Invoke(ctx, "/pps.API/ListPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MLflowSpec configures MLflow experiment tracking for a pipeline: every job
+// that finishes is registered as an MLflow run, with the pipeline spec's
+// essentials logged as params, the job's output commit logged as the
+// artifact location, and any metrics the user code wrote to
+// /pfs/out/metrics.json (a flat JSON object of numbers) logged as metrics. So
+// model training pipelines get experiment tracking without custom glue code
+// in every image.
+type MLflowSpec struct {
+	// TrackingURI is the MLflow tracking server, e.g. "http://mlflow:5000".
+	TrackingURI string `protobuf:"bytes,1,opt,name=tracking_uri,json=trackingUri,proto3" json:"tracking_uri,omitempty"`
+	// Experiment is the name of the MLflow experiment that runs are created
+	// under; it defaults to the pipeline name and is created if missing.
+	Experiment           string   `protobuf:"bytes,2,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/DeletePipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MLflowSpec) Reset()         { *m = MLflowSpec{} }
+func (m *MLflowSpec) String() string { return proto.CompactTextString(m) }
+func (*MLflowSpec) ProtoMessage()    {}
+func (*MLflowSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{62}
 }
-
-func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/StartPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MLflowSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MLflowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MLflowSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MLflowSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MLflowSpec.Merge(m, src)
+}
+func (m *MLflowSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MLflowSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MLflowSpec.DiscardUnknown(m)
 }
 
-func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/StopPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MLflowSpec proto.InternalMessageInfo
+
+func (m *MLflowSpec) GetTrackingURI() string {
+	if m != nil {
+		return m.TrackingURI
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/RerunPipeline", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MLflowSpec) GetExperiment() string {
+	if m != nil {
+		return m.Experiment
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/pps.API/DeleteAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// StatsServiceSpec makes the PPS master run a companion service pipeline
+// ("<pipeline>-stats") that serves the pipeline's stats branch, or the output
+// subdirectory named by 'path', over HTTP. With tensor_board set the
+// companion runs TensorBoard pointed at the served directory instead of a
+// plain file server, so training pipelines that write event files get a
+// dashboard without any extra deployment. InspectPipeline reports the URL
+// that the companion is reachable at.
+type StatsServiceSpec struct {
+	// Path optionally names a subdirectory of the pipeline's output to serve
+	// instead of the stats branch (serving the stats branch requires
+	// enable_stats).
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// TensorBoard serves the directory with TensorBoard instead of a plain
+	// HTTP file server.
+	TensorBoard bool `protobuf:"varint,2,opt,name=tensor_board,json=tensorBoard,proto3" json:"tensor_board,omitempty"`
+	// ExternalPort is the NodePort that the companion's k8s service is exposed
+	// on (30000-32767); it defaults to 30606.
+	ExternalPort         int32    `protobuf:"varint,3,opt,name=external_port,json=externalPort,proto3" json:"external_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps.API/GetLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIGetLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *StatsServiceSpec) Reset()         { *m = StatsServiceSpec{} }
+func (m *StatsServiceSpec) String() string { return proto.CompactTextString(m) }
+func (*StatsServiceSpec) ProtoMessage()    {}
+func (*StatsServiceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{63}
+}
+func (m *StatsServiceSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatsServiceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatsServiceSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type API_GetLogsClient interface {
-	Recv() (*LogMessage, error)
-	grpc.ClientStream
+func (m *StatsServiceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsServiceSpec.Merge(m, src)
 }
-
-type aPIGetLogsClient struct {
-	grpc.ClientStream
+func (m *StatsServiceSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatsServiceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsServiceSpec.DiscardUnknown(m)
 }
 
-func (x *aPIGetLogsClient) Recv() (*LogMessage, error) {
-	m := new(LogMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_StatsServiceSpec proto.InternalMessageInfo
+
+func (m *StatsServiceSpec) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error) {
-	out := new(GarbageCollectResponse)
-	err := c.cc.Invoke(ctx, "/pps.API/GarbageCollect", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatsServiceSpec) GetTensorBoard() bool {
+	if m != nil {
+		return m.TensorBoard
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
-	out := new(ActivateAuthResponse)
-	err := c.cc.Invoke(ctx, "/pps.API/ActivateAuth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatsServiceSpec) GetExternalPort() int32 {
+	if m != nil {
+		return m.ExternalPort
 	}
-	return out, nil
+	return 0
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	CreateJob(context.Context, *CreateJobRequest) (*Job, error)
-	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
-	// ListJob returns information about current and past Pachyderm jobs. This is
-	// deprecated in favor of ListJobStream
-	ListJob(context.Context, *ListJobRequest) (*JobInfos, error)
-	// ListJobStream returns information about current and past Pachyderm jobs.
-	ListJobStream(*ListJobRequest, API_ListJobStreamServer) error
-	FlushJob(*FlushJobRequest, API_FlushJobServer) error
-	DeleteJob(context.Context, *DeleteJobRequest) (*types.Empty, error)
-	StopJob(context.Context, *StopJobRequest) (*types.Empty, error)
-	InspectDatum(context.Context, *InspectDatumRequest) (*DatumInfo, error)
-	// ListDatum returns information about each datum fed to a Pachyderm job. This
-	// is deprecated in favor of ListDatumStream
-	ListDatum(context.Context, *ListDatumRequest) (*ListDatumResponse, error)
-	// ListDatumStream returns information about each datum fed to a Pachyderm job
-	ListDatumStream(*ListDatumRequest, API_ListDatumStreamServer) error
-	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
-	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
-	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
-	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
-	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
-	StartPipeline(context.Context, *StartPipelineRequest) (*types.Empty, error)
-	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
-	RerunPipeline(context.Context, *RerunPipelineRequest) (*types.Empty, error)
-	// DeleteAll deletes everything
-	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
-	GetLogs(*GetLogsRequest, API_GetLogsServer) error
-	// Garbage collection
-	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
-	// An internal call that causes PPS to put itself into an auth-enabled state
-	// (all pipeline have tokens, correct permissions, etcd)
-	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+// NotificationSpec configures webhook notifications for a pipeline,
+// overriding the cluster-level NOTIFICATION_WEBHOOK configuration.
+type NotificationSpec struct {
+	// URL is a Slack incoming webhook or generic HTTP endpoint that receives a
+	// JSON payload for each notification.
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// On lists the events that trigger a notification: "job_failure",
+	// "pipeline_crash_loop", "commit_finish". An empty list means job failures
+	// only.
+	On []string `protobuf:"bytes,2,rep,name=on,proto3" json:"on,omitempty"`
+	// Template optionally overrides the default message text. It's rendered
+	// with Go's text/template against .Event, .Pipeline, .JobID, .State and
+	// .Reason.
+	Template             string   `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+func (m *NotificationSpec) Reset()         { *m = NotificationSpec{} }
+func (m *NotificationSpec) String() string { return proto.CompactTextString(m) }
+func (*NotificationSpec) ProtoMessage()    {}
+func (*NotificationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{64}
 }
-
-func _API_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).CreateJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/CreateJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreateJob(ctx, req.(*CreateJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *NotificationSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _API_InspectJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/InspectJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectJob(ctx, req.(*InspectJobRequest))
+func (m *NotificationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *NotificationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationSpec.Merge(m, src)
+}
+func (m *NotificationSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationSpec.DiscardUnknown(m)
 }
 
-func _API_ListJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/ListJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListJob(ctx, req.(*ListJobRequest))
+var xxx_messageInfo_NotificationSpec proto.InternalMessageInfo
+
+func (m *NotificationSpec) GetURL() string {
+	if m != nil {
+		return m.URL
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _API_ListJobStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListJobRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *NotificationSpec) GetOn() []string {
+	if m != nil {
+		return m.On
 	}
-	return srv.(APIServer).ListJobStream(m, &aPIListJobStreamServer{stream})
+	return nil
 }
 
-type API_ListJobStreamServer interface {
-	Send(*JobInfo) error
-	grpc.ServerStream
+func (m *NotificationSpec) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
 }
 
-type aPIListJobStreamServer struct {
-	grpc.ServerStream
+type PromotePipelineRequest struct {
+	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (x *aPIListJobStreamServer) Send(m *JobInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *PromotePipelineRequest) Reset()         { *m = PromotePipelineRequest{} }
+func (m *PromotePipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*PromotePipelineRequest) ProtoMessage()    {}
+func (*PromotePipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{65}
 }
-
-func _API_FlushJob_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FlushJobRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(APIServer).FlushJob(m, &aPIFlushJobServer{stream})
+func (m *PromotePipelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type API_FlushJobServer interface {
-	Send(*JobInfo) error
-	grpc.ServerStream
+func (m *PromotePipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromotePipelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type aPIFlushJobServer struct {
-	grpc.ServerStream
+func (m *PromotePipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotePipelineRequest.Merge(m, src)
 }
-
-func (x *aPIFlushJobServer) Send(m *JobInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *PromotePipelineRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _API_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/DeleteJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteJob(ctx, req.(*DeleteJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PromotePipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotePipelineRequest.DiscardUnknown(m)
 }
 
-func _API_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopJobRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).StopJob(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/StopJob",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StopJob(ctx, req.(*StopJobRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_PromotePipelineRequest proto.InternalMessageInfo
 
-func _API_InspectDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectDatumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectDatum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/InspectDatum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectDatum(ctx, req.(*InspectDatumRequest))
+func (m *PromotePipelineRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_ListDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDatumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListDatum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/ListDatum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListDatum(ctx, req.(*ListDatumRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ValidateDAGRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_ListDatumStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListDatumRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *ValidateDAGRequest) Reset()         { *m = ValidateDAGRequest{} }
+func (m *ValidateDAGRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateDAGRequest) ProtoMessage()    {}
+func (*ValidateDAGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{66}
+}
+func (m *ValidateDAGRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateDAGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateDAGRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(APIServer).ListDatumStream(m, &aPIListDatumStreamServer{stream})
 }
-
-type API_ListDatumStreamServer interface {
-	Send(*ListDatumStreamResponse) error
-	grpc.ServerStream
+func (m *ValidateDAGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDAGRequest.Merge(m, src)
 }
-
-type aPIListDatumStreamServer struct {
-	grpc.ServerStream
+func (m *ValidateDAGRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *aPIListDatumStreamServer) Send(m *ListDatumStreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *ValidateDAGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDAGRequest.DiscardUnknown(m)
 }
 
-func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartDatumRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).RestartDatum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/RestartDatum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RestartDatum(ctx, req.(*RestartDatumRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_ValidateDAGRequest proto.InternalMessageInfo
 
-func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).CreatePipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/CreatePipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ValidateDAGResponse reports problems in the pipeline DAG. Errors break the
+// DAG (input cycles, inputs that reference repos that don't exist, glob
+// patterns that can never match anything); warnings flag pipelines that will
+// never get new data (e.g. reading a branch their upstream pipeline no longer
+// writes to).
+type ValidateDAGResponse struct {
+	Errors               []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	Warnings             []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _API_InspectPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectPipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).InspectPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/InspectPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).InspectPipeline(ctx, req.(*InspectPipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ValidateDAGResponse) Reset()         { *m = ValidateDAGResponse{} }
+func (m *ValidateDAGResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateDAGResponse) ProtoMessage()    {}
+func (*ValidateDAGResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{67}
 }
-
-func _API_ListPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).ListPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/ListPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListPipeline(ctx, req.(*ListPipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ValidateDAGResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).DeletePipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/DeletePipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
+func (m *ValidateDAGResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateDAGResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ValidateDAGResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDAGResponse.Merge(m, src)
+}
+func (m *ValidateDAGResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateDAGResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDAGResponse.DiscardUnknown(m)
 }
 
-func _API_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartPipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).StartPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/StartPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StartPipeline(ctx, req.(*StartPipelineRequest))
+var xxx_messageInfo_ValidateDAGResponse proto.InternalMessageInfo
+
+func (m *ValidateDAGResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_StopPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopPipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).StopPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/StopPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).StopPipeline(ctx, req.(*StopPipelineRequest))
+func (m *ValidateDAGResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _API_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RerunPipelineRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(APIServer).RerunPipeline(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/RerunPipeline",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RerunPipeline(ctx, req.(*RerunPipelineRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("pps.DatumState", DatumState_name, DatumState_value)
+	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
+	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterType((*Secret)(nil), "pps.Secret")
+	proto.RegisterType((*Transform)(nil), "pps.Transform")
+	proto.RegisterMapType((map[string]string)(nil), "pps.Transform.EnvEntry")
+	proto.RegisterType((*Egress)(nil), "pps.Egress")
+	proto.RegisterType((*Job)(nil), "pps.Job")
+	proto.RegisterType((*Service)(nil), "pps.Service")
+	proto.RegisterType((*Spout)(nil), "pps.Spout")
+	proto.RegisterType((*PFSInput)(nil), "pps.PFSInput")
+	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
+	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
+	proto.RegisterType((*Input)(nil), "pps.Input")
+	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
+	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
+	proto.RegisterType((*HashtreeSpec)(nil), "pps.HashtreeSpec")
+	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
+	proto.RegisterType((*Datum)(nil), "pps.Datum")
+	proto.RegisterType((*DatumInfo)(nil), "pps.DatumInfo")
+	proto.RegisterType((*Aggregate)(nil), "pps.Aggregate")
+	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
+	proto.RegisterType((*AggregateProcessStats)(nil), "pps.AggregateProcessStats")
+	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
+	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
+	proto.RegisterType((*GPUSpec)(nil), "pps.GPUSpec")
+	proto.RegisterType((*EtcdJobInfo)(nil), "pps.EtcdJobInfo")
+	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
+	proto.RegisterType((*Worker)(nil), "pps.Worker")
+	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
+	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
+	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
+	proto.RegisterType((*EtcdPipelineInfo)(nil), "pps.EtcdPipelineInfo")
+	proto.RegisterMapType((map[int32]int32)(nil), "pps.EtcdPipelineInfo.JobCountsEntry")
+	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
+	proto.RegisterMapType((map[int32]int32)(nil), "pps.PipelineInfo.JobCountsEntry")
+	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
+	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
+	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
+	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
+	proto.RegisterType((*FlushJobRequest)(nil), "pps.FlushJobRequest")
+	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
+	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
+	proto.RegisterType((*GetLogsRequest)(nil), "pps.GetLogsRequest")
+	proto.RegisterType((*LogMessage)(nil), "pps.LogMessage")
+	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
+	proto.RegisterType((*InspectDatumRequest)(nil), "pps.InspectDatumRequest")
+	proto.RegisterType((*ListDatumRequest)(nil), "pps.ListDatumRequest")
+	proto.RegisterType((*ListDatumResponse)(nil), "pps.ListDatumResponse")
+	proto.RegisterType((*ListDatumStreamResponse)(nil), "pps.ListDatumStreamResponse")
+	proto.RegisterType((*ChunkSpec)(nil), "pps.ChunkSpec")
+	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
+	proto.RegisterMapType((map[string]string)(nil), "pps.SchedulingSpec.NodeSelectorEntry")
+	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
+	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
+	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
+	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
+	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
+	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
+	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
+	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
+	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
+	proto.RegisterType((*ActivateAuthRequest)(nil), "pps.ActivateAuthRequest")
+	proto.RegisterType((*ActivateAuthResponse)(nil), "pps.ActivateAuthResponse")
+	proto.RegisterType((*GetKubeEventsRequest)(nil), "pps.GetKubeEventsRequest")
+	proto.RegisterType((*KubeEvent)(nil), "pps.KubeEvent")
+	proto.RegisterType((*DatumTimeoutSpec)(nil), "pps.DatumTimeoutSpec")
+	proto.RegisterType((*SharedCacheSpec)(nil), "pps.SharedCacheSpec")
+	proto.RegisterType((*PauseWindow)(nil), "pps.PauseWindow")
+	proto.RegisterType((*MLflowSpec)(nil), "pps.MLflowSpec")
+	proto.RegisterType((*StatsServiceSpec)(nil), "pps.StatsServiceSpec")
+	proto.RegisterType((*NotificationSpec)(nil), "pps.NotificationSpec")
+	proto.RegisterType((*PromotePipelineRequest)(nil), "pps.PromotePipelineRequest")
+	proto.RegisterType((*ValidateDAGRequest)(nil), "pps.ValidateDAGRequest")
+	proto.RegisterType((*ValidateDAGResponse)(nil), "pps.ValidateDAGResponse")
 }
 
-func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptor_dbf57f97f56369c0) }
+
+var fileDescriptor_dbf57f97f56369c0 = []byte{
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x5c,
+	0xcd, 0x73, 0x1b, 0x57, 0x72, 0x17, 0x00, 0x82, 0xc0, 0x34, 0x3e, 0x38,
+	0x1c, 0x7e, 0x08, 0x82, 0x3e, 0x3d, 0xb2, 0x64, 0x59, 0xb6, 0x49, 0x9b,
+	0xf2, 0xd7, 0x7a, 0xbd, 0x56, 0xf8, 0x25, 0x2d, 0x6d, 0x59, 0x62, 0x06,
+	0x94, 0x5d, 0xd9, 0x0b, 0x6a, 0x08, 0x0c, 0xc9, 0xb1, 0xc0, 0x19, 0x64,
+	0x66, 0x20, 0x99, 0x5b, 0x95, 0x1c, 0xb2, 0xa9, 0x5c, 0x53, 0x95, 0x43,
+	0x2a, 0xe5, 0xaa, 0x3d, 0xed, 0x31, 0x87, 0x64, 0xf7, 0x94, 0x4a, 0xe5,
+	0x9a, 0xaa, 0x1c, 0x73, 0x48, 0xae, 0xa9, 0xcd, 0xe6, 0x9c, 0xff, 0x21,
+	0xdd, 0xfd, 0xde, 0x1b, 0xcc, 0x00, 0x43, 0x80, 0x14, 0x5d, 0x95, 0x03,
+	0x51, 0xf3, 0xfa, 0xf5, 0xfb, 0x98, 0x7e, 0xfd, 0xba, 0x7f, 0xdd, 0xef,
+	0x0d, 0x61, 0xb1, 0xd3, 0x73, 0x1d, 0x2f, 0x5a, 0xed, 0xf7, 0x43, 0xfa,
+	0x5b, 0xe9, 0x07, 0x7e, 0xe4, 0x1b, 0x05, 0x7c, 0x6c, 0x5e, 0x3d, 0xf4,
+	0xfd, 0xc3, 0x9e, 0xb3, 0xca, 0xa4, 0xfd, 0xc1, 0xc1, 0xaa, 0x73, 0xdc,
+	0x8f, 0x4e, 0x04, 0x47, 0xf3, 0xe6, 0x68, 0x65, 0xe4, 0x1e, 0x3b, 0x61,
+	0x64, 0x1f, 0xf7, 0x25, 0xc3, 0x8d, 0x51, 0x86, 0xee, 0x20, 0xb0, 0x23,
+	0xd7, 0xf7, 0x64, 0xfd, 0xe2, 0xa1, 0x7f, 0xe8, 0xf3, 0xe3, 0x2a, 0x3d,
+	0x29, 0xaa, 0x9a, 0xce, 0x41, 0x48, 0x7f, 0x82, 0x6a, 0x1e, 0xc0, 0x6c,
+	0xcb, 0xe9, 0x04, 0x4e, 0x64, 0x18, 0x30, 0xe3, 0xd9, 0xc7, 0x4e, 0x23,
+	0x77, 0x2b, 0x77, 0x4f, 0xb3, 0xf8, 0xd9, 0xd0, 0xa1, 0xf0, 0xc2, 0x39,
+	0x69, 0xcc, 0x30, 0x89, 0x1e, 0x8d, 0xeb, 0x00, 0xc7, 0xfe, 0xc0, 0x8b,
+	0xda, 0x7d, 0x3b, 0x3a, 0x6a, 0xe4, 0xb9, 0x42, 0x63, 0xca, 0x2e, 0x12,
+	0x8c, 0xcb, 0x50, 0x72, 0xbc, 0x97, 0xed, 0x97, 0x76, 0xd0, 0x28, 0x70,
+	0xdd, 0x2c, 0x16, 0xbf, 0xb1, 0x03, 0xf3, 0x3f, 0x66, 0x40, 0xdb, 0x0b,
+	0x6c, 0x2f, 0x3c, 0xf0, 0x83, 0x63, 0x63, 0x11, 0x8a, 0xee, 0xb1, 0x7d,
+	0xa8, 0x06, 0x13, 0x05, 0x1a, 0xad, 0x73, 0xdc, 0xc5, 0x4e, 0x0b, 0x34,
+	0x1a, 0x3e, 0x72, 0x77, 0x41, 0xd0, 0x26, 0x6a, 0x8d, 0xa9, 0xb3, 0x58,
+	0xdc, 0xc4, 0x8a, 0xb7, 0xa1, 0x80, 0x1d, 0xe3, 0x18, 0x85, 0x7b, 0x95,
+	0xb5, 0xcb, 0x2b, 0x24, 0xde, 0xb8, 0xf7, 0x95, 0x6d, 0xef, 0xe5, 0xb6,
+	0x17, 0x05, 0x27, 0x16, 0xf1, 0x18, 0x77, 0xa0, 0x14, 0xf2, 0x1b, 0x86,
+	0xf8, 0x1e, 0xc4, 0x5e, 0x61, 0x76, 0xf1, 0xd6, 0x96, 0xaa, 0x33, 0xde,
+	0x05, 0x83, 0x67, 0xd1, 0xee, 0x0f, 0x7a, 0xbd, 0xb6, 0x6a, 0xa1, 0xf1,
+	0xa8, 0x3a, 0xd7, 0xec, 0x62, 0x45, 0x4b, 0x72, 0xe3, 0x0b, 0x84, 0x51,
+	0xd7, 0xf5, 0x1a, 0x45, 0x66, 0x10, 0x05, 0xe3, 0x2a, 0x68, 0x34, 0x5d,
+	0x51, 0x53, 0xe7, 0x9a, 0x32, 0x12, 0x5a, 0x5c, 0x89, 0x03, 0xd8, 0x9d,
+	0x8e, 0xd3, 0x8f, 0xda, 0xd8, 0xc3, 0x20, 0xf0, 0xda, 0x1d, 0xbf, 0xeb,
+	0x34, 0x66, 0x91, 0xab, 0x60, 0xe9, 0xa2, 0xc6, 0xe2, 0x8a, 0x4d, 0xa4,
+	0xd3, 0x00, 0x5d, 0x67, 0x7f, 0x70, 0xd8, 0x28, 0xa1, 0x84, 0xca, 0x96,
+	0x28, 0xd0, 0x1a, 0x0d, 0x42, 0x27, 0x68, 0x80, 0x58, 0x23, 0x7a, 0x36,
+	0x6e, 0x42, 0xe5, 0x95, 0x1f, 0xbc, 0x70, 0xbd, 0xc3, 0x76, 0xd7, 0x0d,
+	0x1a, 0x15, 0xae, 0x02, 0x49, 0xda, 0x72, 0x03, 0xe3, 0x06, 0x40, 0xd7,
+	0xef, 0xbc, 0x70, 0x82, 0x03, 0xb7, 0xe7, 0x34, 0xaa, 0xa2, 0x7e, 0x48,
+	0x69, 0x7e, 0x0c, 0x65, 0x25, 0x31, 0xb5, 0xe0, 0xb9, 0xe1, 0x82, 0xe3,
+	0x44, 0x5e, 0xda, 0xbd, 0x81, 0x23, 0xd7, 0x5a, 0x14, 0x3e, 0xcb, 0x7f,
+	0x9a, 0x33, 0xae, 0x40, 0xd9, 0xf5, 0xdc, 0x88, 0x57, 0x67, 0x8e, 0x5f,
+	0xb6, 0x44, 0x65, 0x5a, 0x1e, 0xd4, 0x12, 0xae, 0x12, 0x92, 0xd0, 0xb9,
+	0x52, 0x23, 0x8a, 0x10, 0xc5, 0x1b, 0x50, 0x8d, 0x1c, 0x3b, 0xe8, 0xfa,
+	0xaf, 0x3c, 0x6e, 0x3d, 0xcf, 0x0c, 0x15, 0x45, 0xa3, 0x1e, 0xee, 0x40,
+	0x3d, 0x66, 0x11, 0xbd, 0x18, 0xcc, 0x54, 0x53, 0x54, 0xee, 0xc9, 0x6c,
+	0xc2, 0xec, 0xf6, 0x61, 0xe0, 0x84, 0x21, 0xcd, 0xfc, 0xb9, 0xf5, 0x44,
+	0xcd, 0x1c, 0x1f, 0xcd, 0xeb, 0x50, 0xf8, 0xd2, 0xdf, 0x37, 0x96, 0x21,
+	0xef, 0x76, 0x05, 0x7d, 0x63, 0xf6, 0x0f, 0xff, 0x75, 0x33, 0xbf, 0xb3,
+	0x65, 0x21, 0xc5, 0x7c, 0x01, 0xa5, 0x96, 0x13, 0xbc, 0x74, 0x3b, 0x8e,
+	0x71, 0x1b, 0x6a, 0xae, 0x17, 0x39, 0x81, 0x67, 0xf7, 0xda, 0x7d, 0x3f,
+	0x88, 0x98, 0xbb, 0x68, 0x55, 0x15, 0x71, 0x17, 0x69, 0xc4, 0xe4, 0x7c,
+	0x9f, 0x64, 0xca, 0x0b, 0x26, 0x45, 0x64, 0x26, 0x1a, 0xac, 0x2f, 0x54,
+	0x5f, 0x0e, 0xb6, 0x8b, 0x83, 0xf5, 0xcd, 0x3b, 0x50, 0x6c, 0xf5, 0xfd,
+	0x41, 0x64, 0x5c, 0x03, 0xcd, 0x7f, 0xe9, 0x04, 0xaf, 0x02, 0x37, 0x12,
+	0xda, 0x5f, 0xb6, 0x86, 0x04, 0xf3, 0xef, 0x73, 0x50, 0xde, 0x7d, 0xd4,
+	0xda, 0xf1, 0xfa, 0x83, 0xec, 0x0d, 0x89, 0xb4, 0xc0, 0xe9, 0xfb, 0x72,
+	0x31, 0xf8, 0x19, 0xc7, 0x9c, 0xdd, 0x47, 0xdd, 0xef, 0x1c, 0xa9, 0x2d,
+	0x27, 0x4a, 0x44, 0xef, 0xf8, 0xc7, 0xc7, 0x6e, 0x24, 0xf7, 0xaf, 0x2c,
+	0x51, 0x1f, 0x87, 0x3d, 0x7f, 0x1f, 0x55, 0x97, 0xfb, 0xa0, 0x67, 0xa2,
+	0xf5, 0xec, 0x5f, 0x9e, 0xa0, 0x3a, 0xd2, 0x8c, 0xf8, 0x99, 0x14, 0x8b,
+	0xcd, 0x52, 0x9b, 0xb4, 0x24, 0x94, 0x8a, 0x08, 0x4c, 0x7a, 0x44, 0x14,
+	0xf3, 0x1f, 0x72, 0xa0, 0x6d, 0x06, 0xbe, 0x77, 0xee, 0xe9, 0xca, 0x69,
+	0x15, 0x46, 0xa7, 0x15, 0xf6, 0x9d, 0x8e, 0x9c, 0x2c, 0x3f, 0xa7, 0xa5,
+	0x35, 0x3b, 0x22, 0x2d, 0xe3, 0x7d, 0xda, 0x84, 0x36, 0xae, 0x04, 0xbd,
+	0x49, 0x65, 0xad, 0xb9, 0x22, 0xec, 0xe2, 0x8a, 0xb2, 0x8b, 0x2b, 0x7b,
+	0xca, 0x70, 0x5a, 0x82, 0xd1, 0x74, 0xa1, 0xfc, 0xd8, 0x8d, 0x4e, 0x9f,
+	0xef, 0x15, 0x28, 0x0c, 0x82, 0x9e, 0x98, 0xee, 0x46, 0x09, 0xd7, 0x8f,
+	0x14, 0xc9, 0x22, 0xda, 0x79, 0xa5, 0x6c, 0xfe, 0x36, 0x07, 0x45, 0x31,
+	0xd0, 0x4d, 0x28, 0xa0, 0xbd, 0xe5, 0xe9, 0x57, 0xd6, 0x6a, 0x6c, 0x7c,
+	0xd4, 0x1a, 0x5b, 0x54, 0x63, 0xdc, 0x82, 0x62, 0x27, 0xf0, 0xc3, 0x90,
+	0x2d, 0x5f, 0x65, 0x0d, 0x98, 0x45, 0xd4, 0x8b, 0x0a, 0xe2, 0x18, 0x78,
+	0x68, 0xe0, 0xa5, 0xc1, 0x4b, 0x71, 0x70, 0x85, 0x61, 0xc2, 0x0c, 0xb2,
+	0x7a, 0x3c, 0x89, 0xca, 0x5a, 0x9d, 0x19, 0xe2, 0xb5, 0xb1, 0xb8, 0x8e,
+	0x26, 0x72, 0xe8, 0x2a, 0x69, 0x89, 0x89, 0x28, 0x69, 0x58, 0x54, 0x83,
+	0x5b, 0xa2, 0x8c, 0x3b, 0x26, 0x2d, 0x9e, 0x99, 0x84, 0x78, 0x6e, 0xc7,
+	0xef, 0x9a, 0xe3, 0x3e, 0xd0, 0x92, 0xa2, 0x23, 0xd9, 0x64, 0xd2, 0x98,
+	0x7a, 0xe5, 0x33, 0xd4, 0xab, 0x30, 0x54, 0x2f, 0xf3, 0x39, 0xcc, 0xed,
+	0xda, 0x81, 0xdd, 0xeb, 0x39, 0x3d, 0x37, 0x3c, 0x6e, 0xd1, 0x72, 0x37,
+	0xa1, 0xdc, 0xf1, 0x3d, 0x5c, 0x2a, 0x4f, 0xec, 0xae, 0x19, 0x2b, 0x2e,
+	0xa3, 0x08, 0x2a, 0x1d, 0xdf, 0x39, 0x38, 0x70, 0x3b, 0xe4, 0xc5, 0xb8,
+	0xa7, 0x9c, 0x95, 0x24, 0x7d, 0x39, 0x53, 0xce, 0xe9, 0x79, 0xf3, 0x3e,
+	0x54, 0x7f, 0x6e, 0x87, 0x47, 0x51, 0xe0, 0x38, 0x63, 0x7d, 0xe6, 0xd2,
+	0x7d, 0x9a, 0x0f, 0x40, 0xe3, 0x97, 0x25, 0x75, 0xa6, 0x39, 0xb2, 0x4f,
+	0x93, 0x2f, 0x4c, 0xcf, 0x44, 0x3b, 0xc2, 0xce, 0x58, 0x64, 0x55, 0x8b,
+	0x9f, 0xcd, 0x9f, 0x42, 0x71, 0xcb, 0x8e, 0x06, 0xc7, 0xa7, 0x19, 0x16,
+	0x1c, 0xb1, 0xf0, 0x9d, 0x7c, 0xff, 0xca, 0x5a, 0x99, 0xc5, 0x8c, 0x52,
+	0xb5, 0x88, 0x68, 0xfe, 0x1e, 0xb7, 0x0c, 0xb7, 0xde, 0xf1, 0x0e, 0x7c,
+	0x5a, 0xd6, 0x2e, 0x15, 0xa4, 0x38, 0xc5, 0xb2, 0x72, 0xb5, 0x25, 0x2a,
+	0xd0, 0x0c, 0x92, 0xe6, 0x46, 0xc2, 0xfa, 0xd6, 0xd7, 0xe6, 0x86, 0x1c,
+	0x2d, 0x22, 0x5b, 0xa2, 0xd6, 0x78, 0x4b, 0xb0, 0x85, 0x2c, 0x96, 0xca,
+	0xda, 0xbc, 0x50, 0xb2, 0xc0, 0xef, 0xa0, 0x65, 0x24, 0xc6, 0x50, 0x30,
+	0x86, 0xc6, 0x5d, 0xd0, 0x70, 0xc9, 0xda, 0xa2, 0x4f, 0xa1, 0x2b, 0x1a,
+	0x2f, 0x22, 0x89, 0xc0, 0x2a, 0xe3, 0x13, 0xf7, 0x8b, 0x16, 0x7a, 0x06,
+	0x27, 0x60, 0xb3, 0x7b, 0x63, 0x5d, 0x91, 0x2c, 0x34, 0x6d, 0x8b, 0xab,
+	0x48, 0xf1, 0x03, 0xc7, 0x0e, 0x51, 0xe7, 0x66, 0x85, 0xe2, 0x8b, 0x92,
+	0xf9, 0x8f, 0xf8, 0x8a, 0xeb, 0x87, 0x68, 0x94, 0x0f, 0xa9, 0x23, 0x74,
+	0x1f, 0x1d, 0x42, 0x07, 0xfc, 0x8a, 0x05, 0x4b, 0x14, 0x48, 0xae, 0xc7,
+	0x8e, 0xed, 0xf1, 0x5b, 0xe5, 0x2c, 0x7e, 0xa6, 0xfe, 0xd0, 0xd0, 0x77,
+	0x9d, 0x97, 0x72, 0x6d, 0x65, 0x09, 0x5d, 0xbd, 0x7e, 0xe0, 0x1e, 0x44,
+	0x47, 0xed, 0xbe, 0x13, 0x74, 0x70, 0x9d, 0xc9, 0x89, 0xcd, 0x30, 0xc7,
+	0x1c, 0xd3, 0x77, 0x63, 0xb2, 0xf1, 0x31, 0x5c, 0xf6, 0x5c, 0xcf, 0x61,
+	0x93, 0x35, 0xd2, 0xa2, 0xc8, 0x2d, 0x96, 0x44, 0xf5, 0xa3, 0x74, 0x3b,
+	0xf3, 0x6f, 0xf2, 0x50, 0x4d, 0x4a, 0xcb, 0xf8, 0x02, 0x6a, 0xe4, 0x63,
+	0x7a, 0xbe, 0xdd, 0x6d, 0x13, 0xfa, 0x92, 0x0b, 0x74, 0x65, 0xcc, 0xc2,
+	0x6c, 0x49, 0xe4, 0x65, 0x55, 0x15, 0x3f, 0xd9, 0x1c, 0xe3, 0x73, 0xa8,
+	0xf6, 0x45, 0x7f, 0xa2, 0x79, 0x7e, 0x5a, 0xf3, 0x8a, 0x64, 0xe7, 0xd6,
+	0x9f, 0x41, 0x65, 0xd0, 0x1f, 0x8e, 0x5d, 0x98, 0xd6, 0x18, 0x04, 0x37,
+	0xb7, 0x45, 0xbf, 0x19, 0xcf, 0x7c, 0xff, 0x24, 0x72, 0x42, 0x96, 0xd5,
+	0x8c, 0x15, 0xbf, 0xcf, 0x06, 0x11, 0xc9, 0x03, 0xcb, 0x21, 0x04, 0x53,
+	0x91, 0x99, 0xe4, 0xb0, 0xcc, 0x62, 0xfe, 0x90, 0x87, 0xa5, 0x78, 0x1d,
+	0x53, 0xd2, 0x79, 0x90, 0x2d, 0x1d, 0x61, 0x74, 0xe2, 0x26, 0x23, 0x22,
+	0xf9, 0x20, 0x53, 0x24, 0xa3, 0x6d, 0x52, 0x72, 0x58, 0xcd, 0x92, 0xc3,
+	0x68, 0x8b, 0xe4, 0xcb, 0x7f, 0x94, 0xf9, 0xf2, 0xe3, 0x6d, 0x46, 0x84,
+	0xf1, 0x41, 0x86, 0x30, 0x32, 0xa6, 0x96, 0x14, 0xce, 0xaf, 0x0b, 0x50,
+	0xfd, 0x16, 0x21, 0x96, 0x13, 0x90, 0x48, 0x06, 0x21, 0x6a, 0xa9, 0xf6,
+	0x8a, 0xcb, 0xed, 0xd8, 0x26, 0x54, 0xd1, 0x26, 0x94, 0x05, 0x13, 0x5a,
+	0x86, 0xb2, 0xa8, 0xde, 0xe9, 0xe2, 0xae, 0x9f, 0x45, 0x53, 0x40, 0x7c,
+	0xc2, 0xcf, 0x68, 0xc8, 0x57, 0x24, 0xbb, 0xbb, 0x65, 0x15, 0xb1, 0x02,
+	0x39, 0x4c, 0xb9, 0xfb, 0x84, 0xb5, 0xaf, 0x0f, 0xad, 0x3d, 0xef, 0x52,
+	0xb1, 0xfd, 0x3e, 0x44, 0x58, 0x4b, 0x3e, 0xcd, 0xe9, 0xca, 0x97, 0x9c,
+	0xe4, 0xfe, 0x14, 0xeb, 0xd0, 0x50, 0x14, 0xa7, 0x18, 0x0a, 0x44, 0x70,
+	0x7f, 0x3a, 0x70, 0x06, 0x4e, 0x3b, 0x74, 0x7f, 0x29, 0x5c, 0x6f, 0xc1,
+	0xd2, 0x98, 0xd2, 0x42, 0x02, 0x81, 0x21, 0x2e, 0x74, 0xdb, 0x9d, 0xa3,
+	0x81, 0xf7, 0x42, 0xa0, 0x83, 0x82, 0x55, 0x15, 0xc4, 0x4d, 0xa6, 0x91,
+	0x2e, 0x06, 0x03, 0xcf, 0x23, 0x64, 0x2a, 0xb9, 0xca, 0xcc, 0x55, 0x93,
+	0x54, 0xc9, 0xf6, 0x16, 0xe0, 0x46, 0xf6, 0xdc, 0xf0, 0x68, 0xd8, 0x9b,
+	0xc6, 0x7c, 0x75, 0x45, 0x96, 0x8c, 0x68, 0x09, 0x44, 0x7d, 0x5b, 0x6a,
+	0x09, 0xbe, 0x3b, 0x30, 0xe7, 0x9c, 0xa0, 0xef, 0x2a, 0xb2, 0x19, 0x40,
+	0xd5, 0x72, 0x42, 0x7f, 0x80, 0x5b, 0x9c, 0xbd, 0x00, 0x85, 0x16, 0xfd,
+	0x01, 0x2f, 0x4c, 0xde, 0xa2, 0x47, 0x32, 0x37, 0xc7, 0xce, 0xb1, 0x1f,
+	0x9c, 0x48, 0x47, 0x25, 0x4b, 0x88, 0x96, 0x0b, 0x87, 0xc8, 0x29, 0xe4,
+	0x53, 0x15, 0x4e, 0x72, 0xf7, 0x39, 0x75, 0x62, 0x51, 0x05, 0x99, 0xae,
+	0xae, 0x1b, 0xbe, 0x50, 0x6e, 0x82, 0x9e, 0xd1, 0xf3, 0x14, 0xf4, 0x19,
+	0xf3, 0x23, 0x28, 0x49, 0x4e, 0x62, 0x8a, 0x4e, 0xfa, 0x31, 0xb6, 0xa0,
+	0x67, 0x1a, 0xd0, 0x1b, 0x1c, 0xef, 0x23, 0x7a, 0xcf, 0xf3, 0x9c, 0x65,
+	0xc9, 0xfc, 0xcf, 0x19, 0xa8, 0x6c, 0x47, 0x9d, 0x2e, 0x7b, 0x5e, 0x74,
+	0x0a, 0xd2, 0x7d, 0xe4, 0x32, 0xdc, 0x07, 0x4a, 0xa0, 0xdc, 0x77, 0xfb,
+	0xe8, 0x30, 0x3d, 0xb5, 0x81, 0x24, 0x9e, 0x90, 0x44, 0x2b, 0xae, 0x46,
+	0x70, 0x54, 0x43, 0xbc, 0x89, 0x3a, 0xd3, 0x4e, 0xa0, 0xad, 0x11, 0x97,
+	0x5d, 0x15, 0x1c, 0xa2, 0x64, 0x34, 0xa0, 0x14, 0x38, 0x02, 0x50, 0x09,
+	0x9b, 0xa1, 0x8a, 0x6c, 0x54, 0x50, 0xe7, 0x12, 0x62, 0x2f, 0x8a, 0x85,
+	0x24, 0x6a, 0x2c, 0x74, 0x32, 0x2a, 0xcc, 0x16, 0xbe, 0x70, 0xfb, 0x7d,
+	0x64, 0x12, 0x5a, 0x53, 0x21, 0x5a, 0x4b, 0x90, 0x48, 0xad, 0x98, 0x25,
+	0xf2, 0x23, 0xbb, 0x27, 0x95, 0x46, 0x23, 0xca, 0x1e, 0x11, 0x08, 0x72,
+	0x72, 0xf5, 0x81, 0x8d, 0x8a, 0xde, 0x95, 0xea, 0xc2, 0x2d, 0x1e, 0x31,
+	0x25, 0x9e, 0x49, 0xe0, 0x74, 0x08, 0x07, 0x3a, 0x14, 0x79, 0xc4, 0x33,
+	0xb1, 0x14, 0x71, 0xa8, 0xe6, 0xda, 0x14, 0x35, 0x5f, 0x81, 0x2a, 0x3f,
+	0x28, 0x21, 0xc1, 0xb8, 0x90, 0x2a, 0xcc, 0x20, 0x65, 0x74, 0x5b, 0xf9,
+	0xe3, 0x0a, 0xfb, 0xe3, 0x9a, 0x5a, 0x9e, 0x94, 0x37, 0x1e, 0x7a, 0xc6,
+	0x6a, 0xd2, 0x33, 0x26, 0xb7, 0x6c, 0xed, 0xec, 0x5b, 0x16, 0xc3, 0x33,
+	0xb5, 0x0f, 0x30, 0xa6, 0x9c, 0xd6, 0x2c, 0xe6, 0x35, 0x7f, 0xa8, 0x43,
+	0xe9, 0x2c, 0x3a, 0xf5, 0x2e, 0x68, 0x91, 0x0a, 0x9d, 0x53, 0x56, 0x39,
+	0x0e, 0xa8, 0xad, 0x21, 0x43, 0x4a, 0x03, 0x0b, 0x93, 0x35, 0x10, 0xb7,
+	0xab, 0x7a, 0x6e, 0xe3, 0xb2, 0x84, 0x84, 0x5f, 0x6b, 0xac, 0x58, 0x73,
+	0x8a, 0xfe, 0x8d, 0x20, 0x1b, 0x0f, 0x91, 0x75, 0x88, 0x05, 0xdb, 0x1c,
+	0x07, 0x54, 0xb9, 0xf7, 0x45, 0xd1, 0x7b, 0x1a, 0x28, 0x62, 0x07, 0x23,
+	0xc8, 0x11, 0x91, 0xa9, 0xc3, 0x71, 0x20, 0xeb, 0x83, 0x8a, 0xf1, 0x45,
+	0x68, 0x68, 0xc9, 0x2a, 0xd4, 0x0a, 0xc0, 0x76, 0xe8, 0xf3, 0xdb, 0x24,
+	0x8c, 0xd9, 0x11, 0x61, 0x68, 0xa2, 0x8e, 0x42, 0xc6, 0xc4, 0x42, 0x95,
+	0x5e, 0x6f, 0xa1, 0xca, 0x67, 0x5f, 0xa8, 0xf1, 0x9d, 0xaa, 0x4d, 0xdb,
+	0xa9, 0xb1, 0x16, 0xc2, 0x99, 0xb4, 0xf0, 0x76, 0x4a, 0x0b, 0xef, 0x52,
+	0x3e, 0x84, 0xe3, 0x5e, 0xa9, 0x4e, 0x55, 0x99, 0x0f, 0x61, 0x9a, 0xa5,
+	0x2a, 0x09, 0x9c, 0x86, 0x14, 0xb2, 0x36, 0xde, 0x4b, 0x80, 0x53, 0x0e,
+	0x62, 0x2d, 0x51, 0x61, 0xdc, 0x87, 0x8a, 0x9c, 0x38, 0x07, 0x79, 0x46,
+	0x02, 0x4e, 0x5a, 0x48, 0xb0, 0x40, 0xd4, 0xd2, 0x33, 0x39, 0x0c, 0xc9,
+	0x2b, 0xa3, 0xa8, 0x79, 0x9e, 0x94, 0x7c, 0xaf, 0x0d, 0x11, 0x4b, 0x25,
+	0x2c, 0xd0, 0xe2, 0x34, 0x0b, 0xb4, 0x7c, 0x16, 0x0b, 0x74, 0x63, 0xdc,
+	0x02, 0x8d, 0x98, 0x98, 0x7b, 0x67, 0x30, 0x31, 0x2b, 0x59, 0x26, 0x26,
+	0x6d, 0xc9, 0x2e, 0x8f, 0x5a, 0xb2, 0xd8, 0x02, 0xdd, 0x9c, 0x62, 0x81,
+	0x3e, 0x86, 0x9a, 0x04, 0x0e, 0x21, 0x23, 0x89, 0x46, 0x83, 0x9d, 0xbe,
+	0x68, 0x90, 0x84, 0x18, 0x56, 0xf5, 0x55, 0x12, 0x70, 0x7c, 0x01, 0xf3,
+	0x81, 0xf4, 0x70, 0x38, 0x55, 0xf4, 0xbb, 0x21, 0x0e, 0x76, 0x25, 0x31,
+	0x58, 0xd2, 0xff, 0x59, 0xba, 0xe2, 0xb5, 0x24, 0x2b, 0x82, 0xcc, 0xb9,
+	0xb8, 0x7d, 0xcf, 0x45, 0xad, 0x0a, 0x1b, 0x6f, 0x9e, 0xd6, 0xba, 0xae,
+	0x38, 0x9f, 0x30, 0x23, 0xa9, 0x86, 0x4b, 0x70, 0xa4, 0xd1, 0x4c, 0xa8,
+	0x86, 0x0c, 0x47, 0xb9, 0x02, 0xed, 0x2a, 0x78, 0xce, 0x2b, 0xb5, 0xd6,
+	0x57, 0x99, 0x6d, 0x8e, 0x35, 0x43, 0x2c, 0x35, 0xc7, 0x11, 0x1a, 0xb2,
+	0xc8, 0x95, 0x1f, 0xb5, 0xc3, 0xd7, 0xa7, 0xd8, 0x61, 0x5c, 0x68, 0xc7,
+	0xb3, 0xf7, 0x7b, 0x4e, 0x5b, 0x48, 0xf9, 0x16, 0x07, 0x96, 0x15, 0x41,
+	0x13, 0x28, 0x95, 0xf2, 0x09, 0x76, 0x2f, 0x6a, 0xbc, 0x21, 0xf3, 0x09,
+	0xf8, 0x4c, 0xd1, 0xc8, 0xbe, 0x1d, 0xe1, 0x8c, 0x4c, 0x91, 0x55, 0xe3,
+	0x82, 0xf1, 0x1e, 0x00, 0xe3, 0x07, 0x61, 0x77, 0xee, 0x24, 0x23, 0x68,
+	0x22, 0xb3, 0x08, 0xb4, 0x8e, 0x7a, 0xe4, 0xe0, 0x80, 0x22, 0x30, 0x46,
+	0xa5, 0xb4, 0x41, 0xee, 0x4e, 0x0f, 0x0e, 0x88, 0x7f, 0x4f, 0xb0, 0x13,
+	0xbc, 0x27, 0xfc, 0xa7, 0x5a, 0xbf, 0x35, 0x15, 0xde, 0x23, 0xb7, 0x6a,
+	0x2b, 0xb4, 0x97, 0xc6, 0x0e, 0x5c, 0x44, 0xaa, 0x6f, 0xc7, 0xda, 0x8b,
+	0xdd, 0x13, 0x05, 0x23, 0x8f, 0xb9, 0xb0, 0x83, 0x56, 0x65, 0xd0, 0x23,
+	0xd8, 0xc5, 0x2f, 0x74, 0x9f, 0x07, 0x58, 0x10, 0xfb, 0x37, 0xae, 0x13,
+	0x0b, 0x1b, 0xa6, 0xca, 0x94, 0xd2, 0xeb, 0xfb, 0x5d, 0xd1, 0xec, 0x1d,
+	0x96, 0x5b, 0x09, 0xcb, 0x5c, 0x75, 0x15, 0x23, 0x47, 0xac, 0xea, 0xb3,
+	0xf8, 0xde, 0xe5, 0x3a, 0xe2, 0xdd, 0xa5, 0x32, 0x02, 0xa0, 0x19, 0xbd,
+	0x88, 0xbf, 0x45, 0x7d, 0x16, 0x7f, 0xaf, 0xe9, 0xd7, 0x8d, 0x77, 0x60,
+	0x3e, 0x76, 0x1a, 0xed, 0x8e, 0xdd, 0x39, 0xc2, 0x21, 0x1a, 0xab, 0x2c,
+	0x75, 0x3d, 0xae, 0xd8, 0x14, 0x74, 0xda, 0x72, 0x7d, 0xc7, 0xeb, 0xd2,
+	0x8c, 0xa5, 0xc9, 0x7a, 0x9f, 0x07, 0xa8, 0x49, 0xaa, 0x25, 0x2c, 0xd7,
+	0x0e, 0x2c, 0x24, 0x04, 0xd7, 0x7e, 0x65, 0x07, 0x84, 0x22, 0x1b, 0x1f,
+	0x4c, 0x13, 0xe0, 0xfc, 0x50, 0x80, 0xdf, 0x8a, 0x36, 0xe6, 0x16, 0xcc,
+	0x8a, 0xbd, 0x95, 0x99, 0x06, 0xba, 0x9b, 0x8e, 0xba, 0xf5, 0x91, 0xbd,
+	0xa8, 0x4c, 0xac, 0xf9, 0x40, 0xe6, 0x4b, 0x0e, 0x7c, 0x72, 0x2e, 0x65,
+	0x46, 0xf5, 0x58, 0xc0, 0xbe, 0x0a, 0xb1, 0x5d, 0x95, 0x0c, 0x56, 0xe9,
+	0x3b, 0xf1, 0x60, 0xde, 0x80, 0xb2, 0x72, 0x96, 0x59, 0x83, 0x9b, 0xbf,
+	0xc9, 0x41, 0x4d, 0x31, 0xa4, 0x53, 0x31, 0xc5, 0xc4, 0x14, 0xaf, 0xcb,
+	0xcc, 0x5a, 0x6e, 0xd4, 0xe8, 0x8e, 0xe6, 0x04, 0xf3, 0xa9, 0x6c, 0x95,
+	0x4a, 0xce, 0x14, 0x32, 0x92, 0x33, 0x33, 0xa9, 0xdc, 0xdf, 0xcc, 0x41,
+	0xe0, 0x1f, 0x4b, 0x27, 0x99, 0xda, 0x87, 0x5c, 0x61, 0xfe, 0x65, 0x01,
+	0x74, 0x42, 0xad, 0xc3, 0x99, 0x22, 0xcc, 0xb8, 0xa7, 0xe4, 0x96, 0x63,
+	0xb9, 0x19, 0x29, 0x64, 0x70, 0x8a, 0x73, 0x9a, 0x49, 0x39, 0xa7, 0x77,
+	0xa1, 0x42, 0xca, 0xa7, 0xcc, 0x40, 0x7e, 0x7c, 0x78, 0xa0, 0x7a, 0x69,
+	0x05, 0x36, 0x81, 0xf6, 0x46, 0x9b, 0x73, 0x0a, 0xa1, 0x8c, 0x96, 0xde,
+	0x14, 0x9e, 0x7f, 0x64, 0x6a, 0xb4, 0x0c, 0x9b, 0xcc, 0x26, 0x4e, 0x06,
+	0xb4, 0xef, 0x54, 0x99, 0x0c, 0xb9, 0x3d, 0x88, 0x8e, 0xd0, 0x90, 0xbf,
+	0x70, 0x3c, 0x29, 0x63, 0x8d, 0x28, 0x7b, 0x44, 0xc0, 0x60, 0xb7, 0xde,
+	0xb3, 0x43, 0x86, 0x0c, 0x32, 0x6d, 0x32, 0x9b, 0xe5, 0x74, 0xab, 0xc4,
+	0xa4, 0x4a, 0xcd, 0xcf, 0xa1, 0x9e, 0x1e, 0x30, 0x99, 0x58, 0x2f, 0x66,
+	0x24, 0xd6, 0x8b, 0xc9, 0xc4, 0x3a, 0x01, 0x27, 0x94, 0xb1, 0x8f, 0xa0,
+	0x22, 0x06, 0x4e, 0x25, 0x09, 0x9c, 0x24, 0x5d, 0x02, 0x27, 0xf3, 0x77,
+	0x8b, 0x50, 0x4d, 0x2d, 0x81, 0x48, 0x4a, 0xcd, 0x8f, 0x25, 0xa5, 0x92,
+	0xb8, 0x2d, 0x37, 0x19, 0xb7, 0xa1, 0x17, 0x56, 0xa3, 0x56, 0x84, 0x17,
+	0x96, 0xc5, 0x73, 0x42, 0xc5, 0x2c, 0x50, 0x07, 0xe7, 0x01, 0x75, 0xe8,
+	0x1a, 0x8f, 0x64, 0x2a, 0x2f, 0x69, 0xc9, 0x84, 0x83, 0x4a, 0x26, 0xf9,
+	0xac, 0xea, 0x51, 0x32, 0xe5, 0x77, 0x26, 0x30, 0xf8, 0x13, 0x34, 0xfa,
+	0xa8, 0x74, 0x24, 0x63, 0x3b, 0x92, 0x7a, 0x3e, 0x09, 0xaf, 0x69, 0x92,
+	0x7b, 0x3d, 0x1a, 0xaa, 0x79, 0x69, 0x9a, 0x9a, 0x37, 0x08, 0x48, 0xfa,
+	0x0c, 0x45, 0xee, 0xf2, 0xee, 0x52, 0x45, 0x72, 0x60, 0x08, 0x30, 0x08,
+	0x8b, 0x3a, 0x41, 0xe0, 0x07, 0x0c, 0x18, 0x35, 0xab, 0x22, 0x68, 0xdb,
+	0x44, 0x42, 0xf9, 0x25, 0xb5, 0x5b, 0x63, 0xed, 0xbe, 0x95, 0x1a, 0x6b,
+	0x8a, 0x66, 0x8f, 0xab, 0xee, 0x3b, 0x53, 0x55, 0x77, 0x1c, 0xa8, 0xe9,
+	0x19, 0x40, 0xed, 0x2b, 0x58, 0x0c, 0x3b, 0x36, 0x7a, 0x5f, 0x3e, 0x9f,
+	0x89, 0x8e, 0x50, 0xa2, 0x47, 0x7e, 0xaf, 0x2b, 0x21, 0xe0, 0x04, 0x53,
+	0x6c, 0x70, 0xb3, 0x2d, 0x6c, 0xb5, 0xa7, 0x1a, 0x65, 0x23, 0x99, 0x85,
+	0x0b, 0x21, 0x99, 0x9b, 0xe7, 0x46, 0x32, 0x8b, 0xa7, 0x21, 0x99, 0x5b,
+	0xe8, 0x71, 0x9d, 0xb0, 0x13, 0xb8, 0x7d, 0x7a, 0x81, 0xc6, 0x92, 0x58,
+	0xa7, 0x04, 0x89, 0x0c, 0x08, 0x39, 0x38, 0x99, 0x2a, 0xb9, 0x2c, 0x0c,
+	0x08, 0x53, 0x38, 0x55, 0x32, 0x0a, 0x55, 0x1a, 0xa7, 0x43, 0x95, 0x2b,
+	0x59, 0x50, 0xe5, 0x6a, 0x12, 0xaa, 0x0c, 0xed, 0xe6, 0xb5, 0x94, 0xdd,
+	0x7c, 0x13, 0xea, 0xc7, 0xf6, 0xf7, 0xed, 0x44, 0xca, 0xe6, 0xba, 0x48,
+	0xc8, 0x20, 0xf5, 0x8f, 0xe3, 0xac, 0x4d, 0x02, 0xfa, 0xdf, 0xb8, 0x18,
+	0xf4, 0x4f, 0x43, 0xa6, 0x5b, 0xe7, 0x86, 0x4c, 0x6f, 0x5c, 0x08, 0x32,
+	0x99, 0xe7, 0x81, 0x4c, 0xab, 0x50, 0x39, 0x74, 0xa3, 0x23, 0xdf, 0x7f,
+	0xd1, 0xa6, 0xb3, 0x1d, 0x0e, 0x86, 0x36, 0xea, 0x68, 0x1a, 0xe1, 0xb1,
+	0x20, 0xd3, 0x11, 0x0f, 0x48, 0x96, 0xe7, 0x41, 0x6f, 0xd4, 0x07, 0xbd,
+	0x39, 0xd9, 0x07, 0xf1, 0x16, 0xb7, 0xbd, 0xee, 0xfe, 0x09, 0x23, 0x47,
+	0xde, 0xe2, 0x5c, 0x1c, 0xc5, 0x6a, 0x6f, 0x9d, 0x05, 0xab, 0xdd, 0x7b,
+	0x3d, 0xac, 0xf6, 0xf6, 0xd9, 0xb1, 0xda, 0xc5, 0x7c, 0x93, 0x48, 0x75,
+	0xc5, 0x78, 0x6f, 0x59, 0xbf, 0x8c, 0xbf, 0x4d, 0xfd, 0x2a, 0x9a, 0xd3,
+	0xaa, 0xe7, 0x47, 0xee, 0x81, 0xdb, 0xe1, 0x15, 0xe0, 0x98, 0xa9, 0xb2,
+	0xb6, 0xc4, 0x2f, 0xf2, 0x34, 0x51, 0x21, 0xcc, 0x75, 0x92, 0xf5, 0x7c,
+	0x50, 0xf1, 0x2e, 0xcc, 0xc5, 0x8a, 0xde, 0x25, 0xcb, 0x16, 0x32, 0x56,
+	0xc4, 0xf0, 0x4c, 0x69, 0x3a, 0x25, 0xd2, 0x42, 0x63, 0x0d, 0x96, 0x3a,
+	0xb6, 0x67, 0x07, 0x27, 0xed, 0x10, 0xad, 0x37, 0x6e, 0x3e, 0x79, 0x16,
+	0xc0, 0x68, 0xb1, 0x60, 0x2d, 0x88, 0xca, 0x16, 0xd7, 0xc9, 0x93, 0x00,
+	0x0c, 0xc4, 0x17, 0xd3, 0x6d, 0xa4, 0x05, 0x5c, 0x63, 0x09, 0x1a, 0xc9,
+	0x26, 0xd2, 0x0e, 0xa2, 0xa7, 0x96, 0xbe, 0x11, 0x27, 0x23, 0x2c, 0x64,
+	0xe3, 0x01, 0xcf, 0x7c, 0x2e, 0xa6, 0x3f, 0x63, 0x72, 0xa6, 0x53, 0xff,
+	0x30, 0xd3, 0xa9, 0xe3, 0xe6, 0x32, 0x64, 0x3e, 0xba, 0xe3, 0x7b, 0x9d,
+	0x41, 0x10, 0x38, 0x5e, 0xe7, 0xa4, 0xf1, 0x11, 0x4f, 0x7c, 0x5e, 0xd4,
+	0x6c, 0x0e, 0x2b, 0x68, 0xb1, 0x0e, 0x03, 0x7f, 0xd0, 0x6f, 0x7c, 0x2c,
+	0x4e, 0xe8, 0xb9, 0x80, 0xc6, 0xbf, 0xda, 0xb7, 0x07, 0xa1, 0xd3, 0x7e,
+	0xe5, 0x7a, 0x68, 0xa5, 0x1b, 0x9f, 0xf0, 0x82, 0xe8, 0xd2, 0xf3, 0x62,
+	0xc5, 0xb7, 0x4c, 0xb7, 0x2a, 0xfd, 0x61, 0xe1, 0x34, 0x84, 0xfd, 0xe9,
+	0xf9, 0x11, 0x36, 0x61, 0x7a, 0x86, 0x55, 0xfd, 0xc0, 0xf5, 0x3a, 0x6e,
+	0x1f, 0x63, 0xe4, 0x9f, 0x08, 0x4c, 0x4f, 0xd4, 0x5d, 0x45, 0x44, 0xd8,
+	0x3c, 0x7b, 0xdc, 0x3b, 0xe8, 0xe1, 0x04, 0x3f, 0x53, 0x41, 0x22, 0x4e,
+	0xf0, 0xeb, 0x27, 0x44, 0x62, 0x5d, 0x91, 0xd5, 0x68, 0x02, 0x6a, 0x22,
+	0x42, 0x54, 0x16, 0xec, 0xa7, 0x09, 0x0d, 0x63, 0x33, 0x2a, 0xcd, 0x98,
+	0xd0, 0xb0, 0x30, 0x41, 0x31, 0xee, 0xc3, 0x7c, 0xaa, 0x2d, 0x1b, 0x82,
+	0xcf, 0x79, 0x3a, 0x73, 0x49, 0x46, 0xda, 0xfd, 0x68, 0xaa, 0x7a, 0x3e,
+	0xc5, 0x21, 0x11, 0x1d, 0x0e, 0xe1, 0x22, 0xfd, 0x6c, 0xaa, 0xa9, 0x42,
+	0x7e, 0x4b, 0xb1, 0xcb, 0x6b, 0x02, 0x81, 0x2d, 0xd5, 0x21, 0x6c, 0x7c,
+	0xc1, 0xf7, 0x16, 0xaa, 0x4c, 0x14, 0xba, 0x10, 0x1a, 0x9f, 0x60, 0xb8,
+	0x7b, 0x64, 0x07, 0x94, 0xf0, 0x26, 0x3f, 0xd1, 0x78, 0x98, 0x80, 0x45,
+	0x2d, 0xae, 0xd8, 0x64, 0xff, 0x41, 0xaf, 0x52, 0x09, 0x87, 0x04, 0x44,
+	0xbc, 0x46, 0xca, 0x90, 0x8a, 0xed, 0xff, 0x47, 0x09, 0x51, 0x6c, 0x25,
+	0xec, 0xa6, 0x70, 0x98, 0xdd, 0x11, 0x8a, 0xf9, 0x38, 0x19, 0x60, 0x50,
+	0xec, 0x82, 0x40, 0x2b, 0xce, 0xd4, 0x25, 0x02, 0x98, 0xf9, 0x31, 0xb0,
+	0x61, 0x55, 0xfb, 0x89, 0x92, 0xf9, 0xbf, 0x39, 0xd0, 0x37, 0x19, 0x16,
+	0x51, 0x02, 0x4d, 0xf8, 0xe3, 0x0b, 0xe5, 0xa8, 0xaf, 0x4c, 0xc9, 0x7c,
+	0x8d, 0x98, 0x9f, 0x9c, 0x9e, 0xc7, 0x5f, 0xd0, 0x2b, 0xf8, 0x5b, 0xd2,
+	0xcb, 0xf8, 0xab, 0xe9, 0x80, 0xbf, 0x65, 0x5d, 0xc3, 0xdf, 0xaa, 0x5e,
+	0xc3, 0xdf, 0x8a, 0x5e, 0xc5, 0xdf, 0x9a, 0x5e, 0xc7, 0xdf, 0xba, 0x3e,
+	0x87, 0xbf, 0x4b, 0xfa, 0x32, 0xfe, 0xce, 0xe9, 0x3a, 0xfe, 0xea, 0xfa,
+	0x3c, 0xfe, 0xce, 0xeb, 0x06, 0xfe, 0x1a, 0xfa, 0x02, 0xfe, 0x2e, 0xe8,
+	0x8b, 0xf8, 0xbb, 0xa8, 0x2f, 0xc5, 0xe6, 0xed, 0xb2, 0xde, 0xc0, 0xdf,
+	0x86, 0x7e, 0xc5, 0xfc, 0x8b, 0x1c, 0xcc, 0xef, 0x78, 0x24, 0xf2, 0x28,
+	0xf1, 0xc2, 0x93, 0x92, 0xab, 0xe7, 0xcf, 0xc2, 0xa3, 0xd7, 0xd8, 0xef,
+	0xf9, 0x9d, 0x17, 0xed, 0x61, 0x04, 0x5a, 0xb6, 0x80, 0x49, 0x0c, 0xd0,
+	0xcc, 0x5f, 0xe7, 0xa0, 0xfe, 0xc4, 0x0d, 0xa3, 0x53, 0x44, 0x3e, 0x05,
+	0xdc, 0xaf, 0x40, 0x95, 0x71, 0xcd, 0x30, 0xc2, 0x2a, 0x8c, 0x25, 0x5a,
+	0x98, 0x41, 0x4e, 0xe7, 0xdc, 0x2f, 0x60, 0x7e, 0x07, 0x73, 0x8f, 0x7a,
+	0x83, 0xf0, 0x28, 0x31, 0xbf, 0x3b, 0x50, 0x12, 0xad, 0x43, 0xa9, 0x59,
+	0xa9, 0xe6, 0xaa, 0x0e, 0xc7, 0xaa, 0x46, 0x7e, 0x5b, 0x4d, 0x55, 0x5d,
+	0x87, 0x18, 0x79, 0x95, 0x4a, 0xe4, 0xab, 0xe7, 0xd0, 0xfc, 0x67, 0x54,
+	0xc0, 0x2d, 0xa7, 0xe7, 0xa4, 0x14, 0xf0, 0x47, 0x3a, 0x40, 0xb9, 0x43,
+	0xe7, 0xd1, 0x36, 0x9d, 0x07, 0x52, 0x60, 0x39, 0x86, 0x9a, 0x65, 0xa5,
+	0xf1, 0x29, 0x00, 0x82, 0x58, 0x27, 0x40, 0x14, 0x6c, 0xab, 0xeb, 0x17,
+	0x13, 0x8c, 0x85, 0xc6, 0xcc, 0x7b, 0xc8, 0x6b, 0xfe, 0x39, 0xd4, 0x5b,
+	0x18, 0x0d, 0xfc, 0x7f, 0xcd, 0xdc, 0xfc, 0x55, 0x1e, 0xea, 0x8f, 0x9d,
+	0xe8, 0x89, 0x7f, 0x18, 0xbe, 0xc6, 0xde, 0x9d, 0x34, 0x57, 0x95, 0x86,
+	0x3d, 0x70, 0x7b, 0x11, 0xfa, 0x34, 0x9e, 0x86, 0x26, 0xd2, 0xb0, 0x8f,
+	0x04, 0x69, 0x78, 0xf5, 0x61, 0xf6, 0xb4, 0xab, 0x0f, 0x74, 0x40, 0x87,
+	0x81, 0x89, 0x13, 0x70, 0x4c, 0x5e, 0xb6, 0x64, 0x89, 0xe8, 0x07, 0x7e,
+	0x8f, 0x3c, 0x86, 0xb8, 0x91, 0x24, 0x4b, 0x7c, 0xe6, 0x66, 0xbb, 0x3d,
+	0x79, 0x68, 0xc4, 0xcf, 0xc2, 0x50, 0x10, 0x1c, 0x1a, 0x9e, 0xcd, 0x6a,
+	0x02, 0x0e, 0xa9, 0xd3, 0x58, 0xf3, 0x5f, 0xf2, 0x00, 0x28, 0x82, 0xaf,
+	0x31, 0x26, 0xa4, 0x3b, 0x88, 0xb7, 0x13, 0xa6, 0x30, 0x91, 0x9a, 0x89,
+	0xed, 0xde, 0x53, 0x4a, 0xbe, 0x0c, 0x4f, 0x70, 0x0b, 0xa7, 0x9c, 0xe0,
+	0xa6, 0x8e, 0x83, 0x4b, 0x13, 0x8f, 0x83, 0xef, 0x42, 0x59, 0x98, 0x74,
+	0x35, 0xb9, 0x8d, 0x0a, 0x72, 0x96, 0xc4, 0x2d, 0x91, 0x2d, 0xab, 0xc4,
+	0x95, 0xc8, 0x37, 0x94, 0x07, 0xa4, 0xe4, 0xa1, 0x0e, 0x8b, 0x67, 0x26,
+	0x1c, 0x16, 0xab, 0x7b, 0x83, 0x65, 0x91, 0xe2, 0xe1, 0x7b, 0x83, 0xf7,
+	0x21, 0x1f, 0x9f, 0x03, 0x4f, 0x0a, 0x7c, 0x91, 0x8b, 0x40, 0xee, 0xb1,
+	0x10, 0x90, 0xbc, 0xec, 0xa1, 0x8a, 0xe6, 0x1e, 0x2c, 0x58, 0x22, 0x47,
+	0x2f, 0x16, 0xef, 0x0c, 0x9a, 0x3c, 0xaa, 0x1d, 0xf9, 0x31, 0xed, 0x30,
+	0x3f, 0x81, 0x05, 0x69, 0x67, 0x53, 0xbd, 0x4e, 0xbd, 0x2f, 0x63, 0xb6,
+	0x41, 0x27, 0xdb, 0x78, 0xe6, 0xb9, 0x10, 0x52, 0xa6, 0x4b, 0x9f, 0x1c,
+	0x32, 0x89, 0x73, 0xd9, 0x32, 0x11, 0x38, 0x5c, 0xe2, 0x1b, 0x41, 0x87,
+	0xe2, 0x9c, 0xab, 0x60, 0xf1, 0xb3, 0x79, 0x02, 0xf3, 0x89, 0x01, 0x30,
+	0x18, 0xf2, 0x42, 0xbe, 0xa8, 0x20, 0x97, 0x90, 0xdc, 0xa9, 0xb4, 0x71,
+	0xf5, 0xe1, 0xec, 0xd8, 0x75, 0x0a, 0xe4, 0x2f, 0x1c, 0x2e, 0x1a, 0x79,
+	0x3e, 0x37, 0x68, 0x53, 0x9f, 0xa1, 0x1c, 0x18, 0x98, 0xb4, 0x4b, 0x94,
+	0xcc, 0xa1, 0xff, 0x0c, 0x2e, 0xc7, 0x43, 0xb7, 0x22, 0x74, 0xbb, 0xc3,
+	0x09, 0xbc, 0x07, 0x30, 0x9c, 0x40, 0xea, 0x3a, 0xc6, 0x70, 0x7c, 0x2d,
+	0x1e, 0xff, 0xf5, 0x86, 0xdf, 0x00, 0x2d, 0x8e, 0xed, 0x12, 0x87, 0xd9,
+	0xb9, 0xe4, 0x61, 0x36, 0xc5, 0xc2, 0x24, 0x4a, 0x79, 0x91, 0x42, 0x74,
+	0xac, 0x11, 0x45, 0x5c, 0x9b, 0xf8, 0x6f, 0xf4, 0x5d, 0xe9, 0xb0, 0xc6,
+	0xf8, 0x12, 0x6a, 0x9e, 0xdf, 0xc5, 0x15, 0x40, 0x33, 0xde, 0x89, 0xfc,
+	0x40, 0x4a, 0xef, 0x4e, 0x46, 0x08, 0x84, 0x81, 0x44, 0xd7, 0x69, 0x49,
+	0x3e, 0x91, 0xed, 0xa8, 0x7a, 0x09, 0x12, 0x3a, 0xb7, 0x05, 0x84, 0x9b,
+	0x7e, 0xe0, 0x46, 0x27, 0xed, 0x4e, 0xcf, 0x0e, 0x43, 0xb1, 0x85, 0x45,
+	0x0a, 0x74, 0x5e, 0x55, 0x6d, 0x52, 0x0d, 0xed, 0xe3, 0xe6, 0x43, 0x98,
+	0x1f, 0xeb, 0xf2, 0x5c, 0x57, 0x60, 0x51, 0x4e, 0x76, 0x10, 0x5f, 0x09,
+	0xe4, 0x67, 0xf3, 0xaf, 0x74, 0x58, 0x12, 0xa0, 0x28, 0xb6, 0x9a, 0xe7,
+	0x77, 0xd3, 0x17, 0xcf, 0xb4, 0x95, 0x2e, 0x94, 0x69, 0xbb, 0x79, 0xde,
+	0x4c, 0x9b, 0x76, 0x7a, 0xa6, 0x0d, 0x75, 0x65, 0xd0, 0xef, 0x12, 0x98,
+	0x91, 0x86, 0x5c, 0x94, 0xc6, 0x33, 0x4d, 0x70, 0x8e, 0x4c, 0x53, 0xe5,
+	0x47, 0xcb, 0x34, 0x55, 0x2f, 0x94, 0x69, 0x5a, 0x3e, 0x77, 0xa6, 0xa9,
+	0x76, 0xc6, 0x4c, 0x53, 0x7d, 0x5a, 0xa6, 0x49, 0x9f, 0x96, 0x69, 0x9a,
+	0x1f, 0xcf, 0x34, 0x5d, 0x03, 0x2d, 0x70, 0xe4, 0x21, 0x2a, 0x67, 0xeb,
+	0xca, 0xd6, 0x90, 0x30, 0xcc, 0x39, 0x2d, 0x24, 0x73, 0x4e, 0xe3, 0xb9,
+	0xa5, 0xc5, 0xc9, 0xb9, 0xa5, 0xa5, 0x33, 0xe5, 0x96, 0xde, 0x38, 0x5b,
+	0x6e, 0xe9, 0xf2, 0xb9, 0x73, 0x4b, 0x8d, 0x0b, 0xe5, 0x96, 0xae, 0x9c,
+	0x27, 0xb7, 0xa4, 0x12, 0x77, 0xcd, 0x44, 0xe2, 0x2e, 0x91, 0x10, 0xba,
+	0x3a, 0x31, 0x21, 0x74, 0xed, 0x2c, 0x09, 0xa1, 0xeb, 0xaf, 0x97, 0x10,
+	0xba, 0x31, 0x21, 0x21, 0x74, 0x6b, 0xec, 0xf0, 0x2e, 0x19, 0x53, 0x61,
+	0x5c, 0x34, 0x96, 0xcc, 0x31, 0x2f, 0x98, 0xcc, 0xb9, 0x7d, 0xf6, 0x64,
+	0xce, 0x9b, 0x59, 0xc9, 0x1c, 0xba, 0x63, 0xcd, 0xc9, 0x17, 0x99, 0x62,
+	0x93, 0xa5, 0x53, 0x13, 0x36, 0x77, 0x4f, 0x4d, 0xd8, 0x9c, 0x9a, 0x16,
+	0x7a, 0xeb, 0xf4, 0xb4, 0x50, 0x56, 0x92, 0xe7, 0x5e, 0x76, 0x92, 0x27,
+	0x3b, 0x73, 0xf3, 0xf6, 0xd4, 0xcc, 0xcd, 0xfd, 0x49, 0x99, 0x9b, 0x77,
+	0x2e, 0x90, 0xb9, 0x79, 0xf7, 0x35, 0x32, 0x37, 0xc3, 0x94, 0xcc, 0x7b,
+	0xe7, 0x4c, 0xc9, 0xac, 0x9c, 0x3d, 0x25, 0x33, 0x96, 0x66, 0x59, 0xbd,
+	0x60, 0x9a, 0xe5, 0xfd, 0x33, 0xa4, 0x59, 0x3e, 0xb8, 0x58, 0x9a, 0x65,
+	0xed, 0x7c, 0x69, 0x96, 0x4d, 0x58, 0x96, 0x20, 0xf6, 0xf5, 0x81, 0x80,
+	0xb9, 0x04, 0x0b, 0x04, 0xfa, 0x46, 0x7a, 0x30, 0x5f, 0xc2, 0x92, 0x88,
+	0x7b, 0x2f, 0x80, 0x31, 0x10, 0xe8, 0x20, 0x08, 0x90, 0xc7, 0xbe, 0xf4,
+	0x48, 0xfa, 0x88, 0xdb, 0xb3, 0xa3, 0x1c, 0xb5, 0x28, 0xa0, 0x4d, 0xc8,
+	0xeb, 0x05, 0x79, 0xc7, 0xf1, 0x5b, 0x58, 0x6c, 0x11, 0xd8, 0xbf, 0xc0,
+	0xb0, 0xb1, 0xd2, 0xe7, 0x13, 0x4a, 0x6f, 0x7e, 0x03, 0x0b, 0x14, 0x0c,
+	0xff, 0xe8, 0xfd, 0xfe, 0x75, 0x0e, 0x16, 0x2d, 0x27, 0x18, 0x78, 0x17,
+	0xe8, 0xf9, 0x0e, 0x94, 0x9c, 0xef, 0x3b, 0xbd, 0x41, 0xd7, 0xc9, 0x4a,
+	0x97, 0xa8, 0x3a, 0x62, 0x73, 0x3d, 0xc1, 0x56, 0xc8, 0x60, 0x93, 0x75,
+	0xe6, 0x67, 0xb0, 0xf4, 0xd8, 0x0e, 0xf6, 0x11, 0x52, 0x6f, 0x62, 0xe0,
+	0x8a, 0xda, 0xa1, 0x66, 0x84, 0xee, 0x5b, 0xdc, 0x41, 0x95, 0xe8, 0x59,
+	0x20, 0xeb, 0x8a, 0xa0, 0x09, 0xfc, 0xdc, 0x80, 0xe5, 0xd1, 0xb6, 0x22,
+	0x02, 0x20, 0x3d, 0x59, 0xef, 0x44, 0xee, 0x4b, 0x04, 0x56, 0xeb, 0x83,
+	0xe8, 0x48, 0xe9, 0xc9, 0x32, 0x2c, 0xa6, 0xc9, 0x92, 0x7d, 0x1d, 0x16,
+	0x31, 0xf4, 0xff, 0x6a, 0xb0, 0xef, 0x6c, 0xbf, 0xc4, 0x1d, 0x15, 0xbe,
+	0x86, 0x66, 0xfe, 0x53, 0x0e, 0xb4, 0xb8, 0x03, 0xdc, 0x6a, 0x1a, 0x9f,
+	0x35, 0x86, 0x8e, 0xe3, 0xc9, 0x96, 0x13, 0x6f, 0xbf, 0x11, 0x73, 0x0b,
+	0x79, 0xe3, 0xab, 0xb2, 0xf9, 0xf4, 0x55, 0x59, 0x79, 0xca, 0x55, 0x48,
+	0x9d, 0x72, 0x21, 0xdd, 0xdf, 0xff, 0x0e, 0x5f, 0x5b, 0xdd, 0x1a, 0x10,
+	0xa5, 0x64, 0x78, 0x5a, 0x4c, 0x85, 0xa7, 0xc3, 0xcf, 0x0f, 0x66, 0x13,
+	0x9f, 0x1f, 0x98, 0x21, 0xe8, 0xa3, 0xfb, 0x17, 0x4d, 0xf7, 0xcc, 0xbe,
+	0x1d, 0x9e, 0xe1, 0xa6, 0x3f, 0xb3, 0xa1, 0xeb, 0x99, 0x45, 0xd7, 0xd1,
+	0x3e, 0xdc, 0x9f, 0x7e, 0xb7, 0xbf, 0x88, 0x8c, 0x8f, 0xf7, 0xcd, 0x1f,
+	0x72, 0x30, 0x37, 0x62, 0x74, 0x32, 0x2f, 0x9f, 0x4c, 0xf9, 0xc2, 0x92,
+	0x20, 0x07, 0x61, 0x30, 0x19, 0x72, 0x84, 0xf2, 0x36, 0x76, 0x88, 0xf1,
+	0x0b, 0x05, 0xb2, 0x1c, 0xf6, 0x48, 0xf1, 0x54, 0x25, 0x91, 0x03, 0x1e,
+	0xc2, 0x00, 0x28, 0x46, 0xf4, 0x60, 0x5e, 0xef, 0x44, 0x6e, 0xf0, 0x32,
+	0x11, 0x9e, 0x61, 0xd9, 0xfc, 0x05, 0x54, 0x12, 0xae, 0x45, 0x7c, 0xde,
+	0x68, 0xcb, 0x0f, 0xe1, 0x34, 0xf9, 0xf5, 0x94, 0xf1, 0x11, 0x94, 0xd5,
+	0x97, 0xa6, 0xd3, 0xdf, 0x3a, 0x66, 0x35, 0x9f, 0x01, 0x0c, 0x9d, 0x07,
+	0x7f, 0xfb, 0x17, 0xd8, 0x1d, 0xfe, 0x5e, 0x71, 0x10, 0xb8, 0x72, 0x84,
+	0x8a, 0xa2, 0x3d, 0x0f, 0x5c, 0xfa, 0x60, 0xd1, 0xf9, 0x1e, 0x85, 0x86,
+	0xab, 0x23, 0x3f, 0x04, 0xd2, 0xac, 0x04, 0xc5, 0xf4, 0x40, 0x1f, 0xf5,
+	0x2e, 0xf1, 0xd7, 0x3b, 0xb9, 0xc4, 0xd7, 0x3b, 0xfc, 0x99, 0xa1, 0x17,
+	0xfa, 0x41, 0x7b, 0xdf, 0xb7, 0x83, 0xae, 0xcc, 0xa5, 0x56, 0x04, 0x6d,
+	0x83, 0x48, 0xe3, 0x1f, 0xf5, 0x15, 0xc6, 0x3f, 0xea, 0x33, 0x77, 0x41,
+	0x1f, 0x45, 0x3d, 0x64, 0x3c, 0xe9, 0x10, 0x41, 0x46, 0x89, 0xf4, 0x81,
+	0x58, 0x1d, 0xf2, 0x2c, 0x17, 0x72, 0x43, 0xf8, 0x44, 0x1f, 0x1e, 0x45,
+	0x0e, 0xe2, 0x08, 0x0a, 0x7c, 0xc4, 0x62, 0xc5, 0x65, 0x72, 0x0d, 0xbb,
+	0xe2, 0xd0, 0xe7, 0x02, 0xae, 0x61, 0x11, 0x8c, 0x6f, 0xec, 0x9e, 0x4b,
+	0xb1, 0xd4, 0xd6, 0xfa, 0x63, 0xb5, 0xe3, 0x11, 0x08, 0xa4, 0xa8, 0x32,
+	0x43, 0x80, 0x5b, 0x87, 0x2f, 0x1a, 0x88, 0xec, 0x84, 0xf8, 0x90, 0x16,
+	0x4b, 0x34, 0x4b, 0x89, 0x15, 0x54, 0x22, 0x26, 0x2e, 0xdf, 0xff, 0x55,
+	0x8e, 0xef, 0x37, 0x89, 0x7b, 0x01, 0x3a, 0x54, 0xbf, 0x7c, 0xb6, 0xd1,
+	0x6e, 0xed, 0xad, 0x5b, 0x7b, 0x3b, 0x4f, 0x1f, 0xeb, 0x97, 0x8c, 0x39,
+	0xa8, 0x10, 0xc5, 0x7a, 0xfe, 0xf4, 0x29, 0x11, 0x72, 0x8a, 0xf0, 0x68,
+	0x7d, 0xe7, 0xc9, 0x73, 0x6b, 0x5b, 0xcf, 0x2b, 0x42, 0xeb, 0xf9, 0xe6,
+	0xe6, 0x76, 0xab, 0xa5, 0x17, 0x50, 0x46, 0x40, 0x84, 0xaf, 0x76, 0x9e,
+	0x3c, 0xd9, 0xde, 0xd2, 0x67, 0x14, 0xc3, 0xd7, 0xdb, 0xd6, 0x63, 0xea,
+	0xa2, 0x68, 0xcc, 0x43, 0x8d, 0x08, 0x7b, 0x3b, 0x5f, 0x6f, 0x6f, 0xb5,
+	0x9f, 0x3d, 0xdf, 0xd3, 0x67, 0xef, 0xa3, 0xfa, 0x0c, 0x3f, 0x78, 0x32,
+	0x00, 0x66, 0xa9, 0x7f, 0x6c, 0x7d, 0xc9, 0xa8, 0x40, 0x49, 0x75, 0x9d,
+	0xe3, 0xc2, 0x57, 0x3b, 0xbb, 0xbb, 0x58, 0x93, 0x37, 0xaa, 0x50, 0x8e,
+	0x27, 0x5a, 0x30, 0x6a, 0xa0, 0x59, 0xdb, 0x9b, 0xcf, 0xbe, 0xd9, 0xb6,
+	0x68, 0xd0, 0xfb, 0x0f, 0xa1, 0x92, 0xb8, 0xcb, 0x45, 0x73, 0xd8, 0x7d,
+	0xb6, 0x15, 0xbf, 0xc6, 0x25, 0x45, 0x18, 0x76, 0x8d, 0xb3, 0x26, 0x82,
+	0x1c, 0x37, 0x7f, 0xff, 0x6f, 0x13, 0x37, 0xb4, 0x44, 0x1f, 0x4b, 0x30,
+	0xbf, 0xbb, 0xb3, 0xbb, 0xfd, 0x64, 0xe7, 0xe9, 0x76, 0x52, 0x42, 0x8b,
+	0xa0, 0xc7, 0xe4, 0xa1, 0x98, 0x2e, 0xc3, 0xc2, 0x90, 0xba, 0x1d, 0xb3,
+	0xe7, 0x53, 0xec, 0x4a, 0x88, 0x05, 0x63, 0x01, 0xe6, 0x62, 0xea, 0xee,
+	0xfa, 0xf3, 0x16, 0x0b, 0x2e, 0xc9, 0x8a, 0x3d, 0x3c, 0xdd, 0xda, 0xf8,
+	0x13, 0xbd, 0xb8, 0xf6, 0xaf, 0x55, 0x28, 0xac, 0xef, 0xee, 0x18, 0x2b,
+	0xf4, 0x5d, 0xa6, 0x3c, 0x96, 0x31, 0x96, 0xe4, 0xb7, 0x80, 0xe9, 0x63,
+	0x9a, 0x66, 0x9c, 0x08, 0x33, 0x2f, 0x19, 0x1f, 0x02, 0x0c, 0x8f, 0x35,
+	0x8c, 0x65, 0x19, 0x9a, 0x8e, 0x9c, 0x73, 0x34, 0x53, 0xf7, 0xd9, 0xb0,
+	0xd5, 0x2a, 0x94, 0xe4, 0x39, 0x84, 0x21, 0xe2, 0x93, 0xf4, 0xa9, 0x44,
+	0xb3, 0x96, 0xe4, 0x0f, 0xb1, 0xc1, 0xc7, 0x50, 0x93, 0x2c, 0x22, 0x7d,
+	0x95, 0xdd, 0x6c, 0x64, 0x98, 0xf7, 0x73, 0x08, 0xda, 0xcb, 0xea, 0x44,
+	0xc1, 0x10, 0xe0, 0x6d, 0xe4, 0x80, 0x21, 0xa3, 0xcd, 0xe7, 0xa0, 0xc5,
+	0x07, 0x03, 0x52, 0x04, 0xa3, 0x07, 0x05, 0xcd, 0xe5, 0x31, 0xeb, 0xb5,
+	0x4d, 0x1f, 0xb7, 0xe2, 0x4c, 0x3f, 0x45, 0x65, 0x12, 0xa9, 0x79, 0x39,
+	0xc7, 0x74, 0xa2, 0x7e, 0x42, 0xcb, 0xcf, 0xa0, 0x9a, 0xcc, 0x5c, 0x1a,
+	0x8d, 0xa4, 0x30, 0x93, 0x69, 0xc9, 0xe6, 0x48, 0x7e, 0x0e, 0xdb, 0xe2,
+	0x9c, 0xe3, 0x04, 0x9f, 0x9c, 0xf3, 0x68, 0x32, 0xb3, 0xb9, 0x3c, 0x4a,
+	0x96, 0x0e, 0xfd, 0x92, 0xf1, 0x25, 0xcc, 0x8d, 0xa4, 0x07, 0x4f, 0xeb,
+	0xe3, 0x5a, 0x9a, 0x9c, 0xce, 0x25, 0xb2, 0xf4, 0x36, 0xf8, 0xf3, 0x99,
+	0x38, 0xab, 0x2b, 0xdf, 0x22, 0x23, 0xd1, 0x3b, 0x41, 0x12, 0x8f, 0xa0,
+	0x9e, 0x4e, 0x83, 0x19, 0xcd, 0x84, 0x26, 0x8e, 0xd8, 0xbd, 0x09, 0xfd,
+	0x6c, 0xc2, 0xdc, 0x08, 0x8c, 0x36, 0xae, 0x26, 0x85, 0x3a, 0xda, 0xd3,
+	0xf8, 0xa9, 0x25, 0x76, 0xf2, 0x05, 0x54, 0x93, 0x30, 0x5a, 0xbe, 0x50,
+	0x06, 0xb2, 0x6e, 0x1a, 0x63, 0xcd, 0x43, 0xf1, 0x32, 0x69, 0xbc, 0x2d,
+	0x5f, 0x26, 0x13, 0x84, 0x4f, 0x78, 0x99, 0x2d, 0xa8, 0xa5, 0xf0, 0xb3,
+	0x71, 0x45, 0x05, 0x4b, 0x63, 0x98, 0x7a, 0x42, 0x2f, 0xb8, 0x3c, 0x49,
+	0xb0, 0x2c, 0xdf, 0x26, 0x03, 0x3f, 0x4f, 0x9e, 0x49, 0x0a, 0x17, 0xcb,
+	0x99, 0x64, 0x61, 0xe5, 0x09, 0xbd, 0xfc, 0x4c, 0x6d, 0xb3, 0x75, 0x0c,
+	0x1f, 0x4e, 0x61, 0x9b, 0xd0, 0xfc, 0x01, 0x94, 0xe4, 0x09, 0x94, 0xdc,
+	0x67, 0xe9, 0xf3, 0xa8, 0xa6, 0x08, 0x3d, 0x87, 0xc7, 0x33, 0xac, 0x9c,
+	0x5f, 0x41, 0x3d, 0x0d, 0x82, 0xe5, 0x5a, 0x64, 0xa2, 0xea, 0xe6, 0xd5,
+	0xcc, 0xba, 0x78, 0xd7, 0x6c, 0x43, 0x35, 0x09, 0x90, 0xa5, 0x28, 0x33,
+	0xa0, 0x74, 0xf3, 0x4a, 0x46, 0x4d, 0xdc, 0xcd, 0xcf, 0xd1, 0x48, 0xa7,
+	0x1d, 0xba, 0x54, 0xd2, 0x6c, 0x37, 0x3f, 0x71, 0x6d, 0x2b, 0x09, 0xff,
+	0x6d, 0x88, 0xff, 0x6d, 0x31, 0xee, 0xe7, 0x9b, 0x8d, 0xf1, 0x8a, 0x78,
+	0x36, 0x18, 0x5c, 0xa7, 0xd0, 0xbd, 0x5c, 0xdb, 0x2c, 0xc4, 0x2f, 0xcd,
+	0x50, 0x4c, 0x27, 0x09, 0x6f, 0x3c, 0xfc, 0xb7, 0x3f, 0xdc, 0xc8, 0xfd,
+	0x3b, 0xfe, 0xfd, 0x1e, 0xff, 0xfe, 0xee, 0x7f, 0x6e, 0x5c, 0xfa, 0xc5,
+	0x7b, 0x74, 0x3f, 0x6a, 0xb0, 0xbf, 0xd2, 0xf1, 0x8f, 0x57, 0xfb, 0x08,
+	0x5f, 0x4f, 0xba, 0x4e, 0x90, 0x7c, 0x0a, 0x83, 0xce, 0xea, 0xf0, 0xdf,
+	0x9d, 0xec, 0xcf, 0xf2, 0x6b, 0x3d, 0xf8, 0x3f, 0xe6, 0xe2, 0x4d, 0xb6,
+	0x03, 0x45, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error)
+	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
+	// ListJob returns information about current and past Pachyderm jobs. This is
+	// deprecated in favor of ListJobStream
+	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error)
+	// ListJobStream returns information about current and past Pachyderm jobs.
+	ListJobStream(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobStreamClient, error)
+	FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error)
+	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error)
+	// ListDatum returns information about each datum fed to a Pachyderm job. This
+	// is deprecated in favor of ListDatumStream
+	ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error)
+	// ListDatumStream returns information about each datum fed to a Pachyderm job
+	ListDatumStream(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumStreamClient, error)
+	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
+	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
+	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// PromotePipeline replaces a pipeline with its canary (see
+	// CreatePipelineRequest.canary) and deletes the canary
+	PromotePipeline(ctx context.Context, in *PromotePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// ValidateDAG analyzes the whole pipeline DAG and reports anything broken
+	// or suspect
+	ValidateDAG(ctx context.Context, in *ValidateDAGRequest, opts ...grpc.CallOption) (*ValidateDAGResponse, error)
+	// DeleteAll deletes everything
+	DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
+	// GetKubeEvents returns recent kubernetes events for a pipeline's worker
+	// pods, so that scheduling and image problems are visible without kubectl
+	GetKubeEvents(ctx context.Context, in *GetKubeEventsRequest, opts ...grpc.CallOption) (API_GetKubeEventsClient, error)
+	// Garbage collection
+	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
+	// An internal call that causes PPS to put itself into an auth-enabled state
+	// (all pipeline have tokens, correct permissions, etcd)
+	ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/pps.API/CreateJob", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).DeleteAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/DeleteAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	return out, nil
+}
+
+func (c *aPIClient) InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, "/pps.API/InspectJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _API_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetLogsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *aPIClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error) {
+	out := new(JobInfos)
+	err := c.cc.Invoke(ctx, "/pps.API/ListJob", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).GetLogs(m, &aPIGetLogsServer{stream})
+	return out, nil
 }
 
-type API_GetLogsServer interface {
-	Send(*LogMessage) error
-	grpc.ServerStream
+func (c *aPIClient) ListJobStream(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/pps.API/ListJobStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIListJobStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-type aPIGetLogsServer struct {
-	grpc.ServerStream
+type API_ListJobStreamClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
 }
 
-func (x *aPIGetLogsServer) Send(m *LogMessage) error {
-	return x.ServerStream.SendMsg(m)
+type aPIListJobStreamClient struct {
+	grpc.ClientStream
 }
 
-func _API_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GarbageCollectRequest)
-	if err := dec(in); err != nil {
+func (x *aPIListJobStreamClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).GarbageCollect(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/GarbageCollect",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).GarbageCollect(ctx, req.(*GarbageCollectRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateAuthRequest)
-	if err := dec(in); err != nil {
+func (c *aPIClient) FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pps.API/FlushJob", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(APIServer).ActivateAuth(ctx, in)
+	x := &aPIFlushJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pps.API/ActivateAuth",
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
+	return x, nil
+}
+
+type API_FlushJobClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
+}
+
+type aPIFlushJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFlushJobClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-var _API_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pps.API",
-	HandlerType: (*APIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateJob",
-			Handler:    _API_CreateJob_Handler,
-		},
-		{
-			MethodName: "InspectJob",
-			Handler:    _API_InspectJob_Handler,
-		},
-		{
-			MethodName: "ListJob",
-			Handler:    _API_ListJob_Handler,
-		},
-		{
-			MethodName: "DeleteJob",
-			Handler:    _API_DeleteJob_Handler,
-		},
-		{
-			MethodName: "StopJob",
-			Handler:    _API_StopJob_Handler,
-		},
-		{
-			MethodName: "InspectDatum",
-			Handler:    _API_InspectDatum_Handler,
-		},
-		{
-			MethodName: "ListDatum",
-			Handler:    _API_ListDatum_Handler,
-		},
-		{
-			MethodName: "RestartDatum",
-			Handler:    _API_RestartDatum_Handler,
-		},
-		{
-			MethodName: "CreatePipeline",
-			Handler:    _API_CreatePipeline_Handler,
-		},
-		{
-			MethodName: "InspectPipeline",
-			Handler:    _API_InspectPipeline_Handler,
-		},
-		{
-			MethodName: "ListPipeline",
-			Handler:    _API_ListPipeline_Handler,
-		},
-		{
-			MethodName: "DeletePipeline",
-			Handler:    _API_DeletePipeline_Handler,
-		},
-		{
-			MethodName: "StartPipeline",
-			Handler:    _API_StartPipeline_Handler,
-		},
-		{
-			MethodName: "StopPipeline",
-			Handler:    _API_StopPipeline_Handler,
-		},
-		{
-			MethodName: "RerunPipeline",
-			Handler:    _API_RerunPipeline_Handler,
-		},
-		{
-			MethodName: "DeleteAll",
-			Handler:    _API_DeleteAll_Handler,
-		},
-		{
-			MethodName: "GarbageCollect",
-			Handler:    _API_GarbageCollect_Handler,
-		},
-		{
-			MethodName: "ActivateAuth",
-			Handler:    _API_ActivateAuth_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ListJobStream",
-			Handler:       _API_ListJobStream_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "FlushJob",
-			Handler:       _API_FlushJob_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ListDatumStream",
-			Handler:       _API_ListDatumStream_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetLogs",
-			Handler:       _API_GetLogs_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "client/pps/pps.proto",
+func (c *aPIClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/DeleteJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Secret) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/StopJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+func (c *aPIClient) InspectDatum(ctx context.Context, in *InspectDatumRequest, opts ...grpc.CallOption) (*DatumInfo, error) {
+	out := new(DatumInfo)
+	err := c.cc.Invoke(ctx, "/pps.API/InspectDatum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MountPath) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.MountPath)))
-		i += copy(dAtA[i:], m.MountPath)
+	return out, nil
+}
+
+func (c *aPIClient) ListDatum(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (*ListDatumResponse, error) {
+	out := new(ListDatumResponse)
+	err := c.cc.Invoke(ctx, "/pps.API/ListDatum", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EnvVar) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.EnvVar)))
-		i += copy(dAtA[i:], m.EnvVar)
+	return out, nil
+}
+
+func (c *aPIClient) ListDatumStream(ctx context.Context, in *ListDatumRequest, opts ...grpc.CallOption) (API_ListDatumStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/pps.API/ListDatumStream", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	x := &aPIListDatumStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
 
-func (m *Transform) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+type API_ListDatumStreamClient interface {
+	Recv() (*ListDatumStreamResponse, error)
+	grpc.ClientStream
+}
+
+type aPIListDatumStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIListDatumStreamClient) Recv() (*ListDatumStreamResponse, error) {
+	m := new(ListDatumStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/RestartDatum", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Image) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
-		i += copy(dAtA[i:], m.Image)
+func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/CreatePipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return out, nil
+}
+
+func (c *aPIClient) InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error) {
+	out := new(PipelineInfo)
+	err := c.cc.Invoke(ctx, "/pps.API/InspectPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Env) > 0 {
-		for k, _ := range m.Env {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Env[k]
-			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			i = encodeVarintPps(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	return out, nil
+}
+
+func (c *aPIClient) ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error) {
+	out := new(PipelineInfos)
+	err := c.cc.Invoke(ctx, "/pps.API/ListPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Secrets) > 0 {
-		for _, msg := range m.Secrets {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Stdin) > 0 {
-		for _, s := range m.Stdin {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.AcceptReturnCode) > 0 {
-		dAtA2 := make([]byte, len(m.AcceptReturnCode)*10)
-		var j1 int
-		for _, num1 := range m.AcceptReturnCode {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(j1))
-		i += copy(dAtA[i:], dAtA2[:j1])
-	}
-	if m.Debug {
-		dAtA[i] = 0x38
-		i++
-		if m.Debug {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, s := range m.ImagePullSecrets {
-			dAtA[i] = 0x4a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.User) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.User)))
-		i += copy(dAtA[i:], m.User)
-	}
-	if len(m.WorkingDir) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkingDir)))
-		i += copy(dAtA[i:], m.WorkingDir)
-	}
-	if len(m.Dockerfile) > 0 {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Dockerfile)))
-		i += copy(dAtA[i:], m.Dockerfile)
-	}
-	if len(m.ErrCmd) > 0 {
-		for _, s := range m.ErrCmd {
-			dAtA[i] = 0x6a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.ErrStdin) > 0 {
-		for _, s := range m.ErrStdin {
-			dAtA[i] = 0x72
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Egress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/DeletePipeline", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.URL) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
-		i += copy(dAtA[i:], m.URL)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/StartPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Job) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/StopPipeline", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Job) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/RerunPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Service) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) PromotePipeline(ctx context.Context, in *PromotePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/PromotePipeline", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Service) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.InternalPort != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
-	}
-	if m.ExternalPort != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
-	}
-	if len(m.IP) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.IP)))
-		i += copy(dAtA[i:], m.IP)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *aPIClient) ValidateDAG(ctx context.Context, in *ValidateDAGRequest, opts ...grpc.CallOption) (*ValidateDAGResponse, error) {
+	out := new(ValidateDAGResponse)
+	err := c.cc.Invoke(ctx, "/pps.API/ValidateDAG", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *Spout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) DeleteAll(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/pps.API/DeleteAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Spout) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Overwrite {
-		dAtA[i] = 0x8
-		i++
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/pps.API/GetLogs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	x := &aPIGetLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
-}
-
-func (m *PFSInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return x, nil
 }
 
-func (m *PFSInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Repo) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
-		i += copy(dAtA[i:], m.Repo)
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+type API_GetLogsClient interface {
+	Recv() (*LogMessage, error)
+	grpc.ClientStream
+}
+
+type aPIGetLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetLogsClient) Recv() (*LogMessage, error) {
+	m := new(LogMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.Commit) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i += copy(dAtA[i:], m.Commit)
+	return m, nil
+}
+
+func (c *aPIClient) GetKubeEvents(ctx context.Context, in *GetKubeEventsRequest, opts ...grpc.CallOption) (API_GetKubeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/pps.API/GetKubeEvents", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Glob) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i += copy(dAtA[i:], m.Glob)
+	x := &aPIGetKubeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Lazy {
-		dAtA[i] = 0x30
-		i++
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.EmptyFiles {
-		dAtA[i] = 0x38
-		i++
-		if m.EmptyFiles {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return x, nil
+}
+
+type API_GetKubeEventsClient interface {
+	Recv() (*KubeEvent, error)
+	grpc.ClientStream
+}
+
+type aPIGetKubeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIGetKubeEventsClient) Recv() (*KubeEvent, error) {
+	m := new(KubeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return m, nil
+}
+
+func (c *aPIClient) GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error) {
+	out := new(GarbageCollectResponse)
+	err := c.cc.Invoke(ctx, "/pps.API/GarbageCollect", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *CronInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *aPIClient) ActivateAuth(ctx context.Context, in *ActivateAuthRequest, opts ...grpc.CallOption) (*ActivateAuthResponse, error) {
+	out := new(ActivateAuthResponse)
+	err := c.cc.Invoke(ctx, "/pps.API/ActivateAuth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+// APIServer is the server API for API service.
+type APIServer interface {
+	CreateJob(context.Context, *CreateJobRequest) (*Job, error)
+	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
+	// ListJob returns information about current and past Pachyderm jobs. This is
+	// deprecated in favor of ListJobStream
+	ListJob(context.Context, *ListJobRequest) (*JobInfos, error)
+	// ListJobStream returns information about current and past Pachyderm jobs.
+	ListJobStream(*ListJobRequest, API_ListJobStreamServer) error
+	FlushJob(*FlushJobRequest, API_FlushJobServer) error
+	DeleteJob(context.Context, *DeleteJobRequest) (*types.Empty, error)
+	StopJob(context.Context, *StopJobRequest) (*types.Empty, error)
+	InspectDatum(context.Context, *InspectDatumRequest) (*DatumInfo, error)
+	// ListDatum returns information about each datum fed to a Pachyderm job. This
+	// is deprecated in favor of ListDatumStream
+	ListDatum(context.Context, *ListDatumRequest) (*ListDatumResponse, error)
+	// ListDatumStream returns information about each datum fed to a Pachyderm job
+	ListDatumStream(*ListDatumRequest, API_ListDatumStreamServer) error
+	RestartDatum(context.Context, *RestartDatumRequest) (*types.Empty, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*types.Empty, error)
+	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
+	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
+	DeletePipeline(context.Context, *DeletePipelineRequest) (*types.Empty, error)
+	StartPipeline(context.Context, *StartPipelineRequest) (*types.Empty, error)
+	StopPipeline(context.Context, *StopPipelineRequest) (*types.Empty, error)
+	RerunPipeline(context.Context, *RerunPipelineRequest) (*types.Empty, error)
+	// PromotePipeline replaces a pipeline with its canary (see
+	// CreatePipelineRequest.canary) and deletes the canary
+	PromotePipeline(context.Context, *PromotePipelineRequest) (*types.Empty, error)
+	// ValidateDAG analyzes the whole pipeline DAG and reports anything broken
+	// or suspect
+	ValidateDAG(context.Context, *ValidateDAGRequest) (*ValidateDAGResponse, error)
+	// DeleteAll deletes everything
+	DeleteAll(context.Context, *types.Empty) (*types.Empty, error)
+	GetLogs(*GetLogsRequest, API_GetLogsServer) error
+	// GetKubeEvents returns recent kubernetes events for a pipeline's worker
+	// pods, so that scheduling and image problems are visible without kubectl
+	GetKubeEvents(*GetKubeEventsRequest, API_GetKubeEventsServer) error
+	// Garbage collection
+	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
+	// An internal call that causes PPS to put itself into an auth-enabled state
+	// (all pipeline have tokens, correct permissions, etcd)
+	ActivateAuth(context.Context, *ActivateAuthRequest) (*ActivateAuthResponse, error)
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Repo) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
-		i += copy(dAtA[i:], m.Repo)
+	if interceptor == nil {
+		return srv.(APIServer).CreateJob(ctx, in)
 	}
-	if len(m.Commit) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i += copy(dAtA[i:], m.Commit)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/CreateJob",
 	}
-	if len(m.Spec) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
-		i += copy(dAtA[i:], m.Spec)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateJob(ctx, req.(*CreateJobRequest))
 	}
-	if m.Start != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Start.Size()))
-		n3, err3 := m.Start.MarshalTo(dAtA[i:])
-		if err3 != nil {
-			return 0, err3
-		}
-		i += n3
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Overwrite {
-		dAtA[i] = 0x30
-		i++
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(APIServer).InspectJob(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/InspectJob",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectJob(ctx, req.(*InspectJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GitInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GitInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if interceptor == nil {
+		return srv.(APIServer).ListJob(ctx, in)
 	}
-	if len(m.URL) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
-		i += copy(dAtA[i:], m.URL)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListJob",
 	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListJob(ctx, req.(*ListJobRequest))
 	}
-	if len(m.Commit) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i += copy(dAtA[i:], m.Commit)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_ListJobStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).ListJobStream(m, &aPIListJobStreamServer{stream})
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cross) > 0 {
-		for _, msg := range m.Cross {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Union) > 0 {
-		for _, msg := range m.Union {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Cron != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Cron.Size()))
-		n4, err4 := m.Cron.MarshalTo(dAtA[i:])
-		if err4 != nil {
-			return 0, err4
-		}
-		i += n4
-	}
-	if m.Git != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Git.Size()))
-		n5, err5 := m.Git.MarshalTo(dAtA[i:])
-		if err5 != nil {
-			return 0, err5
-		}
-		i += n5
-	}
-	if m.Pfs != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pfs.Size()))
-		n6, err6 := m.Pfs.MarshalTo(dAtA[i:])
-		if err6 != nil {
-			return 0, err6
-		}
-		i += n6
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type API_ListJobStreamServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
 }
 
-func (m *JobInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type aPIListJobStreamServer struct {
+	grpc.ServerStream
 }
 
-func (m *JobInput) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Commit != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Commit.Size()))
-		n7, err7 := m.Commit.MarshalTo(dAtA[i:])
-		if err7 != nil {
-			return 0, err7
-		}
-		i += n7
+func (x *aPIListJobStreamServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_FlushJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlushJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	if len(m.Glob) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i += copy(dAtA[i:], m.Glob)
+	return srv.(APIServer).FlushJob(m, &aPIFlushJobServer{stream})
+}
+
+type API_FlushJobServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
+}
+
+type aPIFlushJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFlushJobServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Lazy {
-		dAtA[i] = 0x18
-		i++
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(APIServer).DeleteJob(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/DeleteJob",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteJob(ctx, req.(*DeleteJobRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParallelismSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_StopJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParallelismSpec) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Constant != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
+	if interceptor == nil {
+		return srv.(APIServer).StopJob(ctx, in)
 	}
-	if m.Coefficient != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Coefficient))))
-		i += 8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/StopJob",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StopJob(ctx, req.(*StopJobRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HashtreeSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_InspectDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectDatumRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HashtreeSpec) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Constant != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
+	if interceptor == nil {
+		return srv.(APIServer).InspectDatum(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/InspectDatum",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectDatum(ctx, req.(*InspectDatumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InputFile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatumRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).ListDatum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListDatum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListDatum(ctx, req.(*ListDatumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *InputFile) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Path) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
-	}
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func _API_ListDatumStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDatumRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(APIServer).ListDatumStream(m, &aPIListDatumStreamServer{stream})
 }
 
-func (m *Datum) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type API_ListDatumStreamServer interface {
+	Send(*ListDatumStreamResponse) error
+	grpc.ServerStream
 }
 
-func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+type aPIListDatumStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIListDatumStreamServer) Send(m *ListDatumStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartDatumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Job != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n8, err8 := m.Job.MarshalTo(dAtA[i:])
-		if err8 != nil {
-			return 0, err8
-		}
-		i += n8
+	if interceptor == nil {
+		return srv.(APIServer).RestartDatum(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/RestartDatum",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RestartDatum(ctx, req.(*RestartDatumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DatumInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Datum != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n9, err9 := m.Datum.MarshalTo(dAtA[i:])
-		if err9 != nil {
-			return 0, err9
-		}
-		i += n9
+	if interceptor == nil {
+		return srv.(APIServer).CreatePipeline(ctx, in)
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/CreatePipeline",
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n10, err10 := m.Stats.MarshalTo(dAtA[i:])
-		if err10 != nil {
-			return 0, err10
-		}
-		i += n10
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
 	}
-	if m.PfsState != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.PfsState.Size()))
-		n11, err11 := m.PfsState.MarshalTo(dAtA[i:])
-		if err11 != nil {
-			return 0, err11
-		}
-		i += n11
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_InspectPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for _, msg := range m.Data {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if interceptor == nil {
+		return srv.(APIServer).InspectPipeline(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/InspectPipeline",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InspectPipeline(ctx, req.(*InspectPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Aggregate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_ListPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+	if interceptor == nil {
+		return srv.(APIServer).ListPipeline(ctx, in)
 	}
-	if m.Mean != 0 {
-		dAtA[i] = 0x11
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
-		i += 8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListPipeline",
 	}
-	if m.Stddev != 0 {
-		dAtA[i] = 0x19
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Stddev))))
-		i += 8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPipeline(ctx, req.(*ListPipelineRequest))
 	}
-	if m.FifthPercentile != 0 {
-		dAtA[i] = 0x21
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FifthPercentile))))
-		i += 8
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.NinetyFifthPercentile != 0 {
-		dAtA[i] = 0x29
-		i++
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NinetyFifthPercentile))))
-		i += 8
+	if interceptor == nil {
+		return srv.(APIServer).DeletePipeline(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/DeletePipeline",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeletePipeline(ctx, req.(*DeletePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_StartPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartPipelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).StartPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/StartPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartPipeline(ctx, req.(*StartPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.DownloadTime != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
-		n12, err12 := m.DownloadTime.MarshalTo(dAtA[i:])
-		if err12 != nil {
-			return 0, err12
-		}
-		i += n12
-	}
-	if m.ProcessTime != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
-		n13, err13 := m.ProcessTime.MarshalTo(dAtA[i:])
-		if err13 != nil {
-			return 0, err13
-		}
-		i += n13
-	}
-	if m.UploadTime != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
-		n14, err14 := m.UploadTime.MarshalTo(dAtA[i:])
-		if err14 != nil {
-			return 0, err14
-		}
-		i += n14
+func _API_StopPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DownloadBytes != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
+	if interceptor == nil {
+		return srv.(APIServer).StopPipeline(ctx, in)
 	}
-	if m.UploadBytes != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/StopPipeline",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StopPipeline(ctx, req.(*StopPipelineRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AggregateProcessStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunPipelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AggregateProcessStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.DownloadTime != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
-		n15, err15 := m.DownloadTime.MarshalTo(dAtA[i:])
-		if err15 != nil {
-			return 0, err15
-		}
-		i += n15
+	if interceptor == nil {
+		return srv.(APIServer).RerunPipeline(ctx, in)
 	}
-	if m.ProcessTime != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
-		n16, err16 := m.ProcessTime.MarshalTo(dAtA[i:])
-		if err16 != nil {
-			return 0, err16
-		}
-		i += n16
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/RerunPipeline",
 	}
-	if m.UploadTime != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
-		n17, err17 := m.UploadTime.MarshalTo(dAtA[i:])
-		if err17 != nil {
-			return 0, err17
-		}
-		i += n17
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RerunPipeline(ctx, req.(*RerunPipelineRequest))
 	}
-	if m.DownloadBytes != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes.Size()))
-		n18, err18 := m.DownloadBytes.MarshalTo(dAtA[i:])
-		if err18 != nil {
-			return 0, err18
-		}
-		i += n18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UploadBytes != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes.Size()))
-		n19, err19 := m.UploadBytes.MarshalTo(dAtA[i:])
-		if err19 != nil {
-			return 0, err19
-		}
-		i += n19
+	if interceptor == nil {
+		return srv.(APIServer).DeleteAll(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/DeleteAll",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteAll(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func _API_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(APIServer).GetLogs(m, &aPIGetLogsServer{stream})
 }
 
-func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerID) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
-		i += copy(dAtA[i:], m.WorkerID)
+type API_GetLogsServer interface {
+	Send(*LogMessage) error
+	grpc.ServerStream
+}
+
+type aPIGetLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetLogsServer) Send(m *LogMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GarbageCollectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
-		i += copy(dAtA[i:], m.JobID)
+	if interceptor == nil {
+		return srv.(APIServer).GarbageCollect(ctx, in)
 	}
-	if len(m.Data) > 0 {
-		for _, msg := range m.Data {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/GarbageCollect",
 	}
-	if m.Started != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n20, err20 := m.Started.MarshalTo(dAtA[i:])
-		if err20 != nil {
-			return 0, err20
-		}
-		i += n20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GarbageCollect(ctx, req.(*GarbageCollectRequest))
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n21, err21 := m.Stats.MarshalTo(dAtA[i:])
-		if err21 != nil {
-			return 0, err21
-		}
-		i += n21
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ActivateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.QueueSize != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.QueueSize))
+	if interceptor == nil {
+		return srv.(APIServer).ActivateAuth(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ActivateAuth",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ActivateAuth(ctx, req.(*ActivateAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _API_PromotePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotePipelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(APIServer).PromotePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/PromotePipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PromotePipeline(ctx, req.(*PromotePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Cpu != 0 {
-		dAtA[i] = 0xd
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Cpu))))
-		i += 4
+func _API_ValidateDAG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateDAGRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Memory) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
-		i += copy(dAtA[i:], m.Memory)
+	if interceptor == nil {
+		return srv.(APIServer).ValidateDAG(ctx, in)
 	}
-	if len(m.Disk) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Disk)))
-		i += copy(dAtA[i:], m.Disk)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ValidateDAG",
 	}
-	if m.Gpu != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Gpu.Size()))
-		n22, err22 := m.Gpu.MarshalTo(dAtA[i:])
-		if err22 != nil {
-			return 0, err22
-		}
-		i += n22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ValidateDAG(ctx, req.(*ValidateDAGRequest))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetKubeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetKubeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return i, nil
+	return srv.(APIServer).GetKubeEvents(m, &aPIGetKubeEventsServer{stream})
 }
 
-func (m *GPUSpec) Marshal() (dAtA []byte, err error) {
+type API_GetKubeEventsServer interface {
+	Send(*KubeEvent) error
+	grpc.ServerStream
+}
+
+type aPIGetKubeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIGetKubeEventsServer) Send(m *KubeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pps.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateJob",
+			Handler:    _API_CreateJob_Handler,
+		},
+		{
+			MethodName: "InspectJob",
+			Handler:    _API_InspectJob_Handler,
+		},
+		{
+			MethodName: "ListJob",
+			Handler:    _API_ListJob_Handler,
+		},
+		{
+			MethodName: "DeleteJob",
+			Handler:    _API_DeleteJob_Handler,
+		},
+		{
+			MethodName: "StopJob",
+			Handler:    _API_StopJob_Handler,
+		},
+		{
+			MethodName: "InspectDatum",
+			Handler:    _API_InspectDatum_Handler,
+		},
+		{
+			MethodName: "ListDatum",
+			Handler:    _API_ListDatum_Handler,
+		},
+		{
+			MethodName: "RestartDatum",
+			Handler:    _API_RestartDatum_Handler,
+		},
+		{
+			MethodName: "CreatePipeline",
+			Handler:    _API_CreatePipeline_Handler,
+		},
+		{
+			MethodName: "InspectPipeline",
+			Handler:    _API_InspectPipeline_Handler,
+		},
+		{
+			MethodName: "ListPipeline",
+			Handler:    _API_ListPipeline_Handler,
+		},
+		{
+			MethodName: "DeletePipeline",
+			Handler:    _API_DeletePipeline_Handler,
+		},
+		{
+			MethodName: "StartPipeline",
+			Handler:    _API_StartPipeline_Handler,
+		},
+		{
+			MethodName: "StopPipeline",
+			Handler:    _API_StopPipeline_Handler,
+		},
+		{
+			MethodName: "RerunPipeline",
+			Handler:    _API_RerunPipeline_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _API_DeleteAll_Handler,
+		},
+		{
+			MethodName: "GarbageCollect",
+			Handler:    _API_GarbageCollect_Handler,
+		},
+		{
+			MethodName: "ActivateAuth",
+			Handler:    _API_ActivateAuth_Handler,
+		},
+		{
+			MethodName: "PromotePipeline",
+			Handler:    _API_PromotePipeline_Handler,
+		},
+		{
+			MethodName: "ValidateDAG",
+			Handler:    _API_ValidateDAG_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListJobStream",
+			Handler:       _API_ListJobStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FlushJob",
+			Handler:       _API_FlushJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDatumStream",
+			Handler:       _API_ListDatumStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLogs",
+			Handler:       _API_GetLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetKubeEvents",
+			Handler:       _API_GetKubeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "client/pps/pps.proto",
+}
+
+func (m *Secret) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7118,21 +7600,34 @@ func (m *GPUSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GPUSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
-		i += copy(dAtA[i:], m.Type)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Number != 0 {
-		dAtA[i] = 0x10
+	if len(m.MountPath) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.MountPath)))
+		i += copy(dAtA[i:], m.MountPath)
+	}
+	if len(m.EnvVar) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.EnvVar)))
+		i += copy(dAtA[i:], m.EnvVar)
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7140,7 +7635,7 @@ func (m *GPUSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *EtcdJobInfo) Marshal() (dAtA []byte, err error) {
+func (m *Transform) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7150,121 +7645,232 @@ func (m *EtcdJobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EtcdJobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Image) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n23, err23 := m.Job.MarshalTo(dAtA[i:])
-		if err23 != nil {
-			return 0, err23
-		}
-		i += n23
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
+		i += copy(dAtA[i:], m.Image)
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n24, err24 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err24 != nil {
-			return 0, err24
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n24
 	}
-	if m.OutputCommit != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n25, err25 := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err25 != nil {
-			return 0, err25
+	if len(m.Env) > 0 {
+		for k, _ := range m.Env {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Env[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n25
-	}
-	if m.Restart != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
 	}
-	if m.DataProcessed != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
+	if len(m.Secrets) > 0 {
+		for _, msg := range m.Secrets {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.DataSkipped != 0 {
-		dAtA[i] = 0x30
+	if len(m.Stdin) > 0 {
+		for _, s := range m.Stdin {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AcceptReturnCode) > 0 {
+		dAtA2 := make([]byte, len(m.AcceptReturnCode)*10)
+		var j1 int
+		for _, num1 := range m.AcceptReturnCode {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+		i = encodeVarintPps(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
 	}
-	if m.DataTotal != 0 {
+	if m.Debug {
 		dAtA[i] = 0x38
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
-	}
-	if m.DataFailed != 0 {
-		dAtA[i] = 0x40
+		if m.Debug {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n26, err26 := m.Stats.MarshalTo(dAtA[i:])
-		if err26 != nil {
-			return 0, err26
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n26
 	}
-	if m.StatsCommit != nil {
+	if len(m.User) > 0 {
 		dAtA[i] = 0x52
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
-		n27, err27 := m.StatsCommit.MarshalTo(dAtA[i:])
-		if err27 != nil {
-			return 0, err27
-		}
-		i += n27
+		i = encodeVarintPps(dAtA, i, uint64(len(m.User)))
+		i += copy(dAtA[i:], m.User)
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x58
+	if len(m.WorkingDir) > 0 {
+		dAtA[i] = 0x5a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkingDir)))
+		i += copy(dAtA[i:], m.WorkingDir)
 	}
-	if len(m.Reason) > 0 {
+	if len(m.Dockerfile) > 0 {
 		dAtA[i] = 0x62
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Dockerfile)))
+		i += copy(dAtA[i:], m.Dockerfile)
 	}
-	if m.Started != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n28, err28 := m.Started.MarshalTo(dAtA[i:])
-		if err28 != nil {
-			return 0, err28
+	if len(m.ErrCmd) > 0 {
+		for _, s := range m.ErrCmd {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n28
 	}
-	if m.Finished != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
-		n29, err29 := m.Finished.MarshalTo(dAtA[i:])
-		if err29 != nil {
-			return 0, err29
+	if len(m.ErrStdin) > 0 {
+		for _, s := range m.ErrStdin {
+			dAtA[i] = 0x72
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n29
 	}
-	if m.DataRecovered != 0 {
-		dAtA[i] = 0x78
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
+	if len(m.InitCmd) > 0 {
+		for _, s := range m.InitCmd {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.InitStdin) > 0 {
+		for _, s := range m.InitStdin {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.TeardownCmd) > 0 {
+		for _, s := range m.TeardownCmd {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.TeardownStdin) > 0 {
+		for _, s := range m.TeardownStdin {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7272,7 +7878,7 @@ func (m *EtcdJobInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+func (m *Egress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7282,399 +7888,255 @@ func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.URL) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n30, err30 := m.Job.MarshalTo(dAtA[i:])
-		if err30 != nil {
-			return 0, err30
-		}
-		i += n30
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
 	}
-	if m.Transform != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n31, err31 := m.Transform.MarshalTo(dAtA[i:])
-		if err31 != nil {
-			return 0, err31
-		}
-		i += n31
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n32, err32 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err32 != nil {
-			return 0, err32
-		}
-		i += n32
+	return i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ParentJob != nil {
-		dAtA[i] = 0x32
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
-		n33, err33 := m.ParentJob.MarshalTo(dAtA[i:])
-		if err33 != nil {
-			return 0, err33
-		}
-		i += n33
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if m.Started != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n34, err34 := m.Started.MarshalTo(dAtA[i:])
-		if err34 != nil {
-			return 0, err34
-		}
-		i += n34
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Finished != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
-		n35, err35 := m.Finished.MarshalTo(dAtA[i:])
-		if err35 != nil {
-			return 0, err35
-		}
-		i += n35
+	return i, nil
+}
+
+func (m *Service) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.OutputCommit != nil {
-		dAtA[i] = 0x4a
+	return dAtA[:n], nil
+}
+
+func (m *Service) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.InternalPort != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n36, err36 := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err36 != nil {
-			return 0, err36
-		}
-		i += n36
+		i = encodeVarintPps(dAtA, i, uint64(m.InternalPort))
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x50
+	if m.ExternalPort != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
 	}
-	if m.ParallelismSpec != nil {
-		dAtA[i] = 0x62
+	if len(m.IP) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n37, err37 := m.ParallelismSpec.MarshalTo(dAtA[i:])
-		if err37 != nil {
-			return 0, err37
-		}
-		i += n37
+		i = encodeVarintPps(dAtA, i, uint64(len(m.IP)))
+		i += copy(dAtA[i:], m.IP)
 	}
-	if m.PipelineVersion != 0 {
-		dAtA[i] = 0x68
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Service != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n38, err38 := m.Service.MarshalTo(dAtA[i:])
-		if err38 != nil {
-			return 0, err38
-		}
-		i += n38
+	return i, nil
+}
+
+func (m *Spout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Egress != nil {
-		dAtA[i] = 0x7a
+	return dAtA[:n], nil
+}
+
+func (m *Spout) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Overwrite {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n39, err39 := m.Egress.MarshalTo(dAtA[i:])
-		if err39 != nil {
-			return 0, err39
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n39
-	}
-	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *PFSInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PFSInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
-		i += copy(dAtA[i:], m.OutputBranch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.OutputRepo != nil {
-		dAtA[i] = 0x92
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
-		n40, err40 := m.OutputRepo.MarshalTo(dAtA[i:])
-		if err40 != nil {
-			return 0, err40
-		}
-		i += n40
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.Restart != 0 {
-		dAtA[i] = 0xa0
+	if len(m.Commit) > 0 {
+		dAtA[i] = 0x22
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i += copy(dAtA[i:], m.Commit)
+	}
+	if len(m.Glob) > 0 {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i += copy(dAtA[i:], m.Glob)
 	}
-	if m.DataProcessed != 0 {
-		dAtA[i] = 0xb0
+	if m.Lazy {
+		dAtA[i] = 0x30
 		i++
-		dAtA[i] = 0x1
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
 	}
-	if m.DataTotal != 0 {
-		dAtA[i] = 0xb8
+	if m.EmptyFiles {
+		dAtA[i] = 0x38
 		i++
-		dAtA[i] = 0x1
+		if m.EmptyFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
 	}
-	if len(m.WorkerStatus) > 0 {
-		for _, msg := range m.WorkerStatus {
-			dAtA[i] = 0xc2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ResourceRequests != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n41, err41 := m.ResourceRequests.MarshalTo(dAtA[i:])
-		if err41 != nil {
-			return 0, err41
-		}
-		i += n41
+	return i, nil
+}
+
+func (m *CronInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Input != nil {
-		dAtA[i] = 0xd2
+	return dAtA[:n], nil
+}
+
+func (m *CronInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n42, err42 := m.Input.MarshalTo(dAtA[i:])
-		if err42 != nil {
-			return 0, err42
-		}
-		i += n42
-	}
-	if m.NewBranch != nil {
-		dAtA[i] = 0xda
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
-		n43, err43 := m.NewBranch.MarshalTo(dAtA[i:])
-		if err43 != nil {
-			return 0, err43
-		}
-		i += n43
-	}
-	if m.StatsCommit != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
-		n44, err44 := m.StatsCommit.MarshalTo(dAtA[i:])
-		if err44 != nil {
-			return 0, err44
-		}
-		i += n44
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
 	}
-	if m.DataSkipped != 0 {
-		dAtA[i] = 0xf0
-		i++
-		dAtA[i] = 0x1
+	if len(m.Commit) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i += copy(dAtA[i:], m.Commit)
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0xfa
-		i++
-		dAtA[i] = 0x1
+	if len(m.Spec) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n45, err45 := m.Stats.MarshalTo(dAtA[i:])
-		if err45 != nil {
-			return 0, err45
-		}
-		i += n45
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Spec)))
+		i += copy(dAtA[i:], m.Spec)
 	}
-	if m.EnableStats {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x2
+	if m.Start != nil {
+		dAtA[i] = 0x2a
 		i++
-		if m.EnableStats {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.Start.Size()))
+		n3, err3 := m.Start.MarshalTo(dAtA[i:])
+		if err3 != nil {
+			return 0, err3
 		}
-		i++
-	}
-	if len(m.Salt) > 0 {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i += copy(dAtA[i:], m.Salt)
+		i += n3
 	}
-	if m.Batch {
-		dAtA[i] = 0x90
-		i++
-		dAtA[i] = 0x2
+	if m.Overwrite {
+		dAtA[i] = 0x30
 		i++
-		if m.Batch {
+		if m.Overwrite {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
-	}
-	if m.ResourceLimits != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n46, err46 := m.ResourceLimits.MarshalTo(dAtA[i:])
-		if err46 != nil {
-			return 0, err46
-		}
-		i += n46
-	}
-	if m.ChunkSpec != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n47, err47 := m.ChunkSpec.MarshalTo(dAtA[i:])
-		if err47 != nil {
-			return 0, err47
-		}
-		i += n47
-	}
-	if m.DatumTimeout != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n48, err48 := m.DatumTimeout.MarshalTo(dAtA[i:])
-		if err48 != nil {
-			return 0, err48
-		}
-		i += n48
-	}
-	if m.JobTimeout != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n49, err49 := m.JobTimeout.MarshalTo(dAtA[i:])
-		if err49 != nil {
-			return 0, err49
-		}
-		i += n49
-	}
-	if m.DataFailed != 0 {
-		dAtA[i] = 0xc0
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
-	}
-	if m.DatumTries != 0 {
-		dAtA[i] = 0xc8
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
-	}
-	if m.SchedulingSpec != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
-		n50, err50 := m.SchedulingSpec.MarshalTo(dAtA[i:])
-		if err50 != nil {
-			return 0, err50
-		}
-		i += n50
-	}
-	if len(m.PodSpec) > 0 {
-		dAtA[i] = 0xda
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
-		i += copy(dAtA[i:], m.PodSpec)
-	}
-	if len(m.PodPatch) > 0 {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
-		i += copy(dAtA[i:], m.PodPatch)
-	}
-	if m.Spout != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
-		n51, err51 := m.Spout.MarshalTo(dAtA[i:])
-		if err51 != nil {
-			return 0, err51
-		}
-		i += n51
-	}
-	if m.DataRecovered != 0 {
-		dAtA[i] = 0xf0
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *Worker) Marshal() (dAtA []byte, err error) {
+func (m *GitInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7684,7 +8146,7 @@ func (m *Worker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitInput) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7695,10 +8157,23 @@ func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
-	if m.State != 0 {
-		dAtA[i] = 0x10
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if len(m.Commit) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i += copy(dAtA[i:], m.Commit)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7706,7 +8181,7 @@ func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *JobInfos) Marshal() (dAtA []byte, err error) {
+func (m *Input) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7716,14 +8191,14 @@ func (m *JobInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobInfo) > 0 {
-		for _, msg := range m.JobInfo {
-			dAtA[i] = 0xa
+	if len(m.Cross) > 0 {
+		for _, msg := range m.Cross {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -7733,32 +8208,47 @@ func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.Union) > 0 {
+		for _, msg := range m.Union {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return i, nil
-}
-
-func (m *Pipeline) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Cron != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Cron.Size()))
+		n4, err4 := m.Cron.MarshalTo(dAtA[i:])
+		if err4 != nil {
+			return 0, err4
+		}
+		i += n4
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+	if m.Git != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(m.Git.Size()))
+		n5, err5 := m.Git.MarshalTo(dAtA[i:])
+		if err5 != nil {
+			return 0, err5
+		}
+		i += n5
+	}
+	if m.Pfs != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pfs.Size()))
+		n6, err6 := m.Pfs.MarshalTo(dAtA[i:])
+		if err6 != nil {
+			return 0, err6
+		}
+		i += n6
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7766,7 +8256,7 @@ func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PipelineInput) Marshal() (dAtA []byte, err error) {
+func (m *JobInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7776,35 +8266,29 @@ func (m *PipelineInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInput) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
+	if m.Commit != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
-		n52, err52 := m.Repo.MarshalTo(dAtA[i:])
-		if err52 != nil {
-			return 0, err52
+		i = encodeVarintPps(dAtA, i, uint64(m.Commit.Size()))
+		n7, err7 := m.Commit.MarshalTo(dAtA[i:])
+		if err7 != nil {
+			return 0, err7
 		}
-		i += n52
-	}
-	if len(m.Branch) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i += copy(dAtA[i:], m.Branch)
+		i += n7
 	}
 	if len(m.Glob) > 0 {
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
 		i += copy(dAtA[i:], m.Glob)
 	}
 	if m.Lazy {
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 		i++
 		if m.Lazy {
 			dAtA[i] = 1
@@ -7814,20 +8298,42 @@ func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 		i++
 	}
 	if len(m.Name) > 0 {
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
 		i += copy(dAtA[i:], m.Name)
 	}
-	if m.From != nil {
-		dAtA[i] = 0x32
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ParallelismSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParallelismSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Constant != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.From.Size()))
-		n53, err53 := m.From.MarshalTo(dAtA[i:])
-		if err53 != nil {
-			return 0, err53
-		}
-		i += n53
+		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
+	}
+	if m.Coefficient != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Coefficient))))
+		i += 8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7835,7 +8341,7 @@ func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *EtcdPipelineInfo) Marshal() (dAtA []byte, err error) {
+func (m *HashtreeSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7845,57 +8351,48 @@ func (m *EtcdPipelineInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EtcdPipelineInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *HashtreeSpec) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
+	if m.Constant != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
+		i = encodeVarintPps(dAtA, i, uint64(m.Constant))
 	}
-	if m.SpecCommit != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
-		n54, err54 := m.SpecCommit.MarshalTo(dAtA[i:])
-		if err54 != nil {
-			return 0, err54
-		}
-		i += n54
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.JobCounts) > 0 {
-		for k, _ := range m.JobCounts {
-			dAtA[i] = 0x1a
-			i++
-			v := m.JobCounts[k]
-			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			i = encodeVarintPps(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(k))
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(v))
-		}
+	return i, nil
+}
+
+func (m *InputFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Reason) > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *InputFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	if len(m.AuthToken) > 0 {
+	if len(m.Hash) > 0 {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.AuthToken)))
-		i += copy(dAtA[i:], m.AuthToken)
-	}
-	if m.LastJobState != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7903,7 +8400,7 @@ func (m *EtcdPipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
+func (m *Datum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7913,376 +8410,282 @@ func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if len(m.ID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n55, err55 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err55 != nil {
-			return 0, err55
-		}
-		i += n55
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	if m.Transform != nil {
+	if m.Job != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n56, err56 := m.Transform.MarshalTo(dAtA[i:])
-		if err56 != nil {
-			return 0, err56
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n8, err8 := m.Job.MarshalTo(dAtA[i:])
+		if err8 != nil {
+			return 0, err8
 		}
-		i += n56
+		i += n8
 	}
-	if m.CreatedAt != nil {
-		dAtA[i] = 0x32
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DatumInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Datum != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
-		n57, err57 := m.CreatedAt.MarshalTo(dAtA[i:])
-		if err57 != nil {
-			return 0, err57
+		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
+		n9, err9 := m.Datum.MarshalTo(dAtA[i:])
+		if err9 != nil {
+			return 0, err9
 		}
-		i += n57
+		i += n9
 	}
 	if m.State != 0 {
-		dAtA[i] = 0x38
+		dAtA[i] = 0x10
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
-	if len(m.RecentError) > 0 {
-		dAtA[i] = 0x42
+	if m.Stats != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
-		i += copy(dAtA[i:], m.RecentError)
+		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
+		n10, err10 := m.Stats.MarshalTo(dAtA[i:])
+		if err10 != nil {
+			return 0, err10
+		}
+		i += n10
 	}
-	if len(m.JobCounts) > 0 {
-		for k, _ := range m.JobCounts {
-			dAtA[i] = 0x4a
-			i++
-			v := m.JobCounts[k]
-			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			i = encodeVarintPps(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0x8
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(k))
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(v))
+	if m.PfsState != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PfsState.Size()))
+		n11, err11 := m.PfsState.MarshalTo(dAtA[i:])
+		if err11 != nil {
+			return 0, err11
 		}
+		i += n11
 	}
-	if m.ParallelismSpec != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n58, err58 := m.ParallelismSpec.MarshalTo(dAtA[i:])
-		if err58 != nil {
-			return 0, err58
+	if len(m.Data) > 0 {
+		for _, msg := range m.Data {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n58
 	}
-	if m.Version != 0 {
-		dAtA[i] = 0x58
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
-	if m.Egress != nil {
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n59, err59 := m.Egress.MarshalTo(dAtA[i:])
-		if err59 != nil {
-			return 0, err59
-		}
-		i += n59
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x82
+	return i, nil
+}
+
+func (m *Aggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Aggregate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+	}
+	if m.Mean != 0 {
+		dAtA[i] = 0x11
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
-		i += copy(dAtA[i:], m.OutputBranch)
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
+		i += 8
 	}
-	if len(m.ID) > 0 {
-		dAtA[i] = 0x8a
+	if m.Stddev != 0 {
+		dAtA[i] = 0x19
 		i++
-		dAtA[i] = 0x1
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Stddev))))
+		i += 8
+	}
+	if m.FifthPercentile != 0 {
+		dAtA[i] = 0x21
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FifthPercentile))))
+		i += 8
 	}
-	if m.ScaleDownThreshold != nil {
-		dAtA[i] = 0x92
+	if m.NinetyFifthPercentile != 0 {
+		dAtA[i] = 0x29
 		i++
-		dAtA[i] = 0x1
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NinetyFifthPercentile))))
+		i += 8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProcessStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DownloadTime != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n60, err60 := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
-		if err60 != nil {
-			return 0, err60
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
+		n12, err12 := m.DownloadTime.MarshalTo(dAtA[i:])
+		if err12 != nil {
+			return 0, err12
 		}
-		i += n60
+		i += n12
 	}
-	if m.ResourceRequests != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
+	if m.ProcessTime != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n61, err61 := m.ResourceRequests.MarshalTo(dAtA[i:])
-		if err61 != nil {
-			return 0, err61
+		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
+		n13, err13 := m.ProcessTime.MarshalTo(dAtA[i:])
+		if err13 != nil {
+			return 0, err13
 		}
-		i += n61
+		i += n13
 	}
-	if m.Input != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
+	if m.UploadTime != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n62, err62 := m.Input.MarshalTo(dAtA[i:])
-		if err62 != nil {
-			return 0, err62
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
+		n14, err14 := m.UploadTime.MarshalTo(dAtA[i:])
+		if err14 != nil {
+			return 0, err14
 		}
-		i += n62
+		i += n14
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
+	if m.DownloadBytes != 0 {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
 	}
-	if len(m.CacheSize) > 0 {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x1
+	if m.UploadBytes != 0 {
+		dAtA[i] = 0x28
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
-		i += copy(dAtA[i:], m.CacheSize)
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
 	}
-	if m.EnableStats {
-		dAtA[i] = 0xc0
-		i++
-		dAtA[i] = 0x1
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AggregateProcessStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateProcessStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DownloadTime != nil {
+		dAtA[i] = 0xa
 		i++
-		if m.EnableStats {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
+		n15, err15 := m.DownloadTime.MarshalTo(dAtA[i:])
+		if err15 != nil {
+			return 0, err15
 		}
-		i++
+		i += n15
 	}
-	if len(m.Salt) > 0 {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x1
+	if m.ProcessTime != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i += copy(dAtA[i:], m.Salt)
+		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
+		n16, err16 := m.ProcessTime.MarshalTo(dAtA[i:])
+		if err16 != nil {
+			return 0, err16
+		}
+		i += n16
 	}
-	if m.Batch {
-		dAtA[i] = 0xd8
-		i++
-		dAtA[i] = 0x1
+	if m.UploadTime != nil {
+		dAtA[i] = 0x1a
 		i++
-		if m.Batch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
+		n17, err17 := m.UploadTime.MarshalTo(dAtA[i:])
+		if err17 != nil {
+			return 0, err17
 		}
-		i++
+		i += n17
 	}
-	if len(m.Reason) > 0 {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
-		i += copy(dAtA[i:], m.Reason)
-	}
-	if m.MaxQueueSize != 0 {
-		dAtA[i] = 0xe8
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
-	}
-	if m.Service != nil {
-		dAtA[i] = 0xf2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n63, err63 := m.Service.MarshalTo(dAtA[i:])
-		if err63 != nil {
-			return 0, err63
-		}
-		i += n63
-	}
-	if m.ResourceLimits != nil {
-		dAtA[i] = 0xfa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n64, err64 := m.ResourceLimits.MarshalTo(dAtA[i:])
-		if err64 != nil {
-			return 0, err64
-		}
-		i += n64
-	}
-	if m.ChunkSpec != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n65, err65 := m.ChunkSpec.MarshalTo(dAtA[i:])
-		if err65 != nil {
-			return 0, err65
-		}
-		i += n65
-	}
-	if m.DatumTimeout != nil {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n66, err66 := m.DatumTimeout.MarshalTo(dAtA[i:])
-		if err66 != nil {
-			return 0, err66
-		}
-		i += n66
-	}
-	if m.JobTimeout != nil {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n67, err67 := m.JobTimeout.MarshalTo(dAtA[i:])
-		if err67 != nil {
-			return 0, err67
-		}
-		i += n67
-	}
-	if len(m.GithookURL) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.GithookURL)))
-		i += copy(dAtA[i:], m.GithookURL)
-	}
-	if m.SpecCommit != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
-		n68, err68 := m.SpecCommit.MarshalTo(dAtA[i:])
-		if err68 != nil {
-			return 0, err68
-		}
-		i += n68
-	}
-	if m.Standby {
-		dAtA[i] = 0xa8
-		i++
-		dAtA[i] = 0x2
-		i++
-		if m.Standby {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.Stopped {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x2
-		i++
-		if m.Stopped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.DatumTries != 0 {
-		dAtA[i] = 0xb8
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
-	}
-	if m.SchedulingSpec != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
-		n69, err69 := m.SchedulingSpec.MarshalTo(dAtA[i:])
-		if err69 != nil {
-			return 0, err69
-		}
-		i += n69
-	}
-	if len(m.PodSpec) > 0 {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
-		i += copy(dAtA[i:], m.PodSpec)
-	}
-	if m.HashtreeSpec != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x2
+	if m.DownloadBytes != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.HashtreeSpec.Size()))
-		n70, err70 := m.HashtreeSpec.MarshalTo(dAtA[i:])
-		if err70 != nil {
-			return 0, err70
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes.Size()))
+		n18, err18 := m.DownloadBytes.MarshalTo(dAtA[i:])
+		if err18 != nil {
+			return 0, err18
 		}
-		i += n70
-	}
-	if m.LastJobState != 0 {
-		dAtA[i] = 0xd8
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
-	}
-	if len(m.PodPatch) > 0 {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x2
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
-		i += copy(dAtA[i:], m.PodPatch)
+		i += n18
 	}
-	if m.Spout != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x2
+	if m.UploadBytes != nil {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
-		n71, err71 := m.Spout.MarshalTo(dAtA[i:])
-		if err71 != nil {
-			return 0, err71
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes.Size()))
+		n19, err19 := m.UploadBytes.MarshalTo(dAtA[i:])
+		if err19 != nil {
+			return 0, err19
 		}
-		i += n71
+		i += n19
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8290,7 +8693,7 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
+func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8300,14 +8703,26 @@ func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, msg := range m.PipelineInfo {
-			dAtA[i] = 0xa
+	if len(m.WorkerID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i += copy(dAtA[i:], m.WorkerID)
+	}
+	if len(m.JobID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i += copy(dAtA[i:], m.JobID)
+	}
+	if len(m.Data) > 0 {
+		for _, msg := range m.Data {
+			dAtA[i] = 0x1a
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -8317,13 +8732,58 @@ func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Started != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n20, err20 := m.Started.MarshalTo(dAtA[i:])
+		if err20 != nil {
+			return 0, err20
+		}
+		i += n20
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
+		n21, err21 := m.Stats.MarshalTo(dAtA[i:])
+		if err21 != nil {
+			return 0, err21
+		}
+		i += n21
+	}
+	if m.QueueSize != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.QueueSize))
+	}
+	if m.QueuedChunks != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.QueuedChunks))
+	}
+	if m.RunningChunks != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.RunningChunks))
+	}
+	if m.FinishedChunks != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.FinishedChunks))
+	}
+	if m.ChunksProcessed != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ChunksProcessed))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8333,32 +8793,38 @@ func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n72, err72 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err72 != nil {
-			return 0, err72
-		}
-		i += n72
+	if m.Cpu != 0 {
+		dAtA[i] = 0xd
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Cpu))))
+		i += 4
 	}
-	if m.OutputCommit != nil {
-		dAtA[i] = 0xca
+	if len(m.Memory) > 0 {
+		dAtA[i] = 0x12
 		i++
-		dAtA[i] = 0x1
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
+		i += copy(dAtA[i:], m.Memory)
+	}
+	if len(m.Disk) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n73, err73 := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err73 != nil {
-			return 0, err73
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Disk)))
+		i += copy(dAtA[i:], m.Disk)
+	}
+	if m.Gpu != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Gpu.Size()))
+		n22, err22 := m.Gpu.MarshalTo(dAtA[i:])
+		if err22 != nil {
+			return 0, err22
 		}
-		i += n73
+		i += n22
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8366,7 +8832,7 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *GPUSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8376,40 +8842,21 @@ func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GPUSpec) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Type) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n74, err74 := m.Job.MarshalTo(dAtA[i:])
-		if err74 != nil {
-			return 0, err74
-		}
-		i += n74
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if m.BlockState {
+	if m.Number != 0 {
 		dAtA[i] = 0x10
 		i++
-		if m.BlockState {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.OutputCommit != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n75, err75 := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err75 != nil {
-			return 0, err75
-		}
-		i += n75
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8417,7 +8864,7 @@ func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *EtcdJobInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8427,118 +8874,121 @@ func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EtcdJobInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n76, err76 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err76 != nil {
-			return 0, err76
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n23, err23 := m.Job.MarshalTo(dAtA[i:])
+		if err23 != nil {
+			return 0, err23
 		}
-		i += n76
+		i += n23
 	}
-	if len(m.InputCommit) > 0 {
-		for _, msg := range m.InputCommit {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n24, err24 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err24 != nil {
+			return 0, err24
 		}
+		i += n24
 	}
 	if m.OutputCommit != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n77, err77 := m.OutputCommit.MarshalTo(dAtA[i:])
-		if err77 != nil {
-			return 0, err77
+		n25, err25 := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err25 != nil {
+			return 0, err25
 		}
-		i += n77
+		i += n25
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Restart != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
 	}
-	return i, nil
-}
-
-func (m *FlushJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.DataProcessed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FlushJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commits) > 0 {
-		for _, msg := range m.Commits {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.DataSkipped != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+	}
+	if m.DataTotal != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
+	}
+	if m.DataFailed != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
+		n26, err26 := m.Stats.MarshalTo(dAtA[i:])
+		if err26 != nil {
+			return 0, err26
 		}
+		i += n26
 	}
-	if len(m.ToPipelines) > 0 {
-		for _, msg := range m.ToPipelines {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.StatsCommit != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
+		n27, err27 := m.StatsCommit.MarshalTo(dAtA[i:])
+		if err27 != nil {
+			return 0, err27
 		}
+		i += n27
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.State != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
-	return i, nil
-}
-
-func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Job != nil {
-		dAtA[i] = 0xa
+	if m.Started != nil {
+		dAtA[i] = 0x6a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n78, err78 := m.Job.MarshalTo(dAtA[i:])
-		if err78 != nil {
-			return 0, err78
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n28, err28 := m.Started.MarshalTo(dAtA[i:])
+		if err28 != nil {
+			return 0, err28
 		}
-		i += n78
+		i += n28
+	}
+	if m.Finished != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
+		n29, err29 := m.Finished.MarshalTo(dAtA[i:])
+		if err29 != nil {
+			return 0, err29
+		}
+		i += n29
+	}
+	if m.DataRecovered != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8546,7 +8996,7 @@ func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8556,7 +9006,7 @@ func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -8565,139 +9015,158 @@ func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n79, err79 := m.Job.MarshalTo(dAtA[i:])
-		if err79 != nil {
-			return 0, err79
-		}
-		i += n79
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		n30, err30 := m.Job.MarshalTo(dAtA[i:])
+		if err30 != nil {
+			return 0, err30
+		}
+		i += n30
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Job != nil {
-		dAtA[i] = 0xa
+	if m.Transform != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n80, err80 := m.Job.MarshalTo(dAtA[i:])
-		if err80 != nil {
-			return 0, err80
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n31, err31 := m.Transform.MarshalTo(dAtA[i:])
+		if err31 != nil {
+			return 0, err31
 		}
-		i += n80
+		i += n31
 	}
 	if m.Pipeline != nil {
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n81, err81 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err81 != nil {
-			return 0, err81
+		n32, err32 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err32 != nil {
+			return 0, err32
 		}
-		i += n81
+		i += n32
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.ParentJob != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
+		n33, err33 := m.ParentJob.MarshalTo(dAtA[i:])
+		if err33 != nil {
+			return 0, err33
 		}
+		i += n33
 	}
-	if m.Master {
-		dAtA[i] = 0x28
+	if m.Started != nil {
+		dAtA[i] = 0x3a
 		i++
-		if m.Master {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n34, err34 := m.Started.MarshalTo(dAtA[i:])
+		if err34 != nil {
+			return 0, err34
 		}
+		i += n34
+	}
+	if m.Finished != nil {
+		dAtA[i] = 0x42
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
+		n35, err35 := m.Finished.MarshalTo(dAtA[i:])
+		if err35 != nil {
+			return 0, err35
+		}
+		i += n35
 	}
-	if m.Datum != nil {
-		dAtA[i] = 0x32
+	if m.OutputCommit != nil {
+		dAtA[i] = 0x4a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n82, err82 := m.Datum.MarshalTo(dAtA[i:])
-		if err82 != nil {
-			return 0, err82
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n36, err36 := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err36 != nil {
+			return 0, err36
 		}
-		i += n82
+		i += n36
 	}
-	if m.Follow {
-		dAtA[i] = 0x38
+	if m.State != 0 {
+		dAtA[i] = 0x50
 		i++
-		if m.Follow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	}
+	if m.ParallelismSpec != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
+		n37, err37 := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		if err37 != nil {
+			return 0, err37
+		}
+		i += n37
+	}
+	if m.PipelineVersion != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineVersion))
+	}
+	if m.Service != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
+		n38, err38 := m.Service.MarshalTo(dAtA[i:])
+		if err38 != nil {
+			return 0, err38
 		}
+		i += n38
+	}
+	if m.Egress != nil {
+		dAtA[i] = 0x7a
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
+		n39, err39 := m.Egress.MarshalTo(dAtA[i:])
+		if err39 != nil {
+			return 0, err39
+		}
+		i += n39
 	}
-	if m.Tail != 0 {
-		dAtA[i] = 0x40
+	if len(m.OutputBranch) > 0 {
+		dAtA[i] = 0x8a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Tail))
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
+		i += copy(dAtA[i:], m.OutputBranch)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.OutputRepo != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
+		n40, err40 := m.OutputRepo.MarshalTo(dAtA[i:])
+		if err40 != nil {
+			return 0, err40
+		}
+		i += n40
 	}
-	return i, nil
-}
-
-func (m *LogMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.Restart != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Restart))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PipelineName) > 0 {
-		dAtA[i] = 0xa
+	if m.DataProcessed != 0 {
+		dAtA[i] = 0xb0
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PipelineName)))
-		i += copy(dAtA[i:], m.PipelineName)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
 	}
-	if len(m.JobID) > 0 {
-		dAtA[i] = 0x1a
+	if m.DataTotal != 0 {
+		dAtA[i] = 0xb8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
-		i += copy(dAtA[i:], m.JobID)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataTotal))
 	}
-	if len(m.Data) > 0 {
-		for _, msg := range m.Data {
-			dAtA[i] = 0x22
+	if len(m.WorkerStatus) > 0 {
+		for _, msg := range m.WorkerStatus {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -8707,61 +9176,261 @@ func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.Ts != nil {
-		dAtA[i] = 0x2a
+	if m.ResourceRequests != nil {
+		dAtA[i] = 0xca
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Ts.Size()))
-		n83, err83 := m.Ts.MarshalTo(dAtA[i:])
-		if err83 != nil {
-			return 0, err83
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
+		n41, err41 := m.ResourceRequests.MarshalTo(dAtA[i:])
+		if err41 != nil {
+			return 0, err41
 		}
-		i += n83
+		i += n41
 	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x32
+	if m.Input != nil {
+		dAtA[i] = 0xd2
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if len(m.WorkerID) > 0 {
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
-		i += copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
+		n42, err42 := m.Input.MarshalTo(dAtA[i:])
+		if err42 != nil {
+			return 0, err42
+		}
+		i += n42
 	}
-	if m.User {
-		dAtA[i] = 0x40
+	if m.NewBranch != nil {
+		dAtA[i] = 0xda
 		i++
-		if m.User {
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
+		n43, err43 := m.NewBranch.MarshalTo(dAtA[i:])
+		if err43 != nil {
+			return 0, err43
+		}
+		i += n43
+	}
+	if m.StatsCommit != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
+		n44, err44 := m.StatsCommit.MarshalTo(dAtA[i:])
+		if err44 != nil {
+			return 0, err44
+		}
+		i += n44
+	}
+	if m.DataSkipped != 0 {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
+		n45, err45 := m.Stats.MarshalTo(dAtA[i:])
+		if err45 != nil {
+			return 0, err45
+		}
+		i += n45
+	}
+	if m.EnableStats {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.EnableStats {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if len(m.DatumID) > 0 {
-		dAtA[i] = 0x4a
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0x8a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
-		i += copy(dAtA[i:], m.DatumID)
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
 	}
-	if m.Master {
-		dAtA[i] = 0x50
+	if m.Batch {
+		dAtA[i] = 0x90
 		i++
-		if m.Master {
+		dAtA[i] = 0x2
+		i++
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.ResourceLimits != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
+		n46, err46 := m.ResourceLimits.MarshalTo(dAtA[i:])
+		if err46 != nil {
+			return 0, err46
+		}
+		i += n46
+	}
+	if m.ChunkSpec != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
+		n47, err47 := m.ChunkSpec.MarshalTo(dAtA[i:])
+		if err47 != nil {
+			return 0, err47
+		}
+		i += n47
+	}
+	if m.DatumTimeout != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
+		n48, err48 := m.DatumTimeout.MarshalTo(dAtA[i:])
+		if err48 != nil {
+			return 0, err48
+		}
+		i += n48
+	}
+	if m.JobTimeout != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
+		n49, err49 := m.JobTimeout.MarshalTo(dAtA[i:])
+		if err49 != nil {
+			return 0, err49
+		}
+		i += n49
+	}
+	if m.DataFailed != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
+		n50, err50 := m.SchedulingSpec.MarshalTo(dAtA[i:])
+		if err50 != nil {
+			return 0, err50
+		}
+		i += n50
+	}
+	if len(m.PodSpec) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
+		i += copy(dAtA[i:], m.PodSpec)
+	}
+	if len(m.PodPatch) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i += copy(dAtA[i:], m.PodPatch)
+	}
+	if m.Spout != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
+		n51, err51 := m.Spout.MarshalTo(dAtA[i:])
+		if err51 != nil {
+			return 0, err51
+		}
+		i += n51
+	}
+	if m.DataRecovered != 0 {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
+	}
+	if m.TransformCaching {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.TransformCaching {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
+	if len(m.PendingReason) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PendingReason)))
+		i += copy(dAtA[i:], m.PendingReason)
+	}
+	if m.JobTimeoutWarning != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeoutWarning.Size()))
+		n108, err108 := m.JobTimeoutWarning.MarshalTo(dAtA[i:])
+		if err108 != nil {
+			return 0, err108
+		}
+		i += n108
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *Worker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8771,35 +9440,21 @@ func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n84, err84 := m.Job.MarshalTo(dAtA[i:])
-		if err84 != nil {
-			return 0, err84
-		}
-		i += n84
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if m.State != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8807,7 +9462,7 @@ func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8817,20 +9472,22 @@ func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Datum != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n85, err85 := m.Datum.MarshalTo(dAtA[i:])
-		if err85 != nil {
-			return 0, err85
+	if len(m.JobInfo) > 0 {
+		for _, msg := range m.JobInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n85
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8838,7 +9495,7 @@ func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+func (m *Pipeline) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8848,30 +9505,16 @@ func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pipeline) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n86, err86 := m.Job.MarshalTo(dAtA[i:])
-		if err86 != nil {
-			return 0, err86
-		}
-		i += n86
-	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
-	}
-	if m.Page != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Page))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8879,7 +9522,7 @@ func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListDatumResponse) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8889,73 +9532,58 @@ func (m *ListDatumResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDatumResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.DatumInfos) > 0 {
-		for _, msg := range m.DatumInfos {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n52, err52 := m.Repo.MarshalTo(dAtA[i:])
+		if err52 != nil {
+			return 0, err52
 		}
+		i += n52
 	}
-	if m.TotalPages != 0 {
-		dAtA[i] = 0x10
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
-	if m.Page != 0 {
-		dAtA[i] = 0x18
+	if len(m.Glob) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Page))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *ListDatumStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i += copy(dAtA[i:], m.Glob)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListDatumStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.DatumInfo != nil {
-		dAtA[i] = 0xa
+	if m.Lazy {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.DatumInfo.Size()))
-		n87, err87 := m.DatumInfo.MarshalTo(dAtA[i:])
-		if err87 != nil {
-			return 0, err87
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n87
+		i++
 	}
-	if m.TotalPages != 0 {
-		dAtA[i] = 0x10
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Page != 0 {
-		dAtA[i] = 0x18
+	if m.From != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Page))
+		i = encodeVarintPps(dAtA, i, uint64(m.From.Size()))
+		n53, err53 := m.From.MarshalTo(dAtA[i:])
+		if err53 != nil {
+			return 0, err53
+		}
+		i += n53
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8963,7 +9591,7 @@ func (m *ListDatumStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ChunkSpec) Marshal() (dAtA []byte, err error) {
+func (m *EtcdPipelineInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -8973,64 +9601,62 @@ func (m *ChunkSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChunkSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *EtcdPipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Number != 0 {
+	if m.State != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Number))
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x10
+	if m.SpecCommit != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
+		n54, err54 := m.SpecCommit.MarshalTo(dAtA[i:])
+		if err54 != nil {
+			return 0, err54
+		}
+		i += n54
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeSelector) > 0 {
-		for k, _ := range m.NodeSelector {
-			dAtA[i] = 0xa
+	if len(m.JobCounts) > 0 {
+		for k, _ := range m.JobCounts {
+			dAtA[i] = 0x1a
 			i++
-			v := m.NodeSelector[k]
-			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			v := m.JobCounts[k]
+			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
 			i = encodeVarintPps(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 			i++
-			i = encodeVarintPps(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
+			i = encodeVarintPps(dAtA, i, uint64(k))
+			dAtA[i] = 0x10
 			i++
-			i = encodeVarintPps(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(v))
 		}
 	}
-	if len(m.PriorityClassName) > 0 {
-		dAtA[i] = 0x12
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClassName)))
-		i += copy(dAtA[i:], m.PriorityClassName)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.AuthToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.AuthToken)))
+		i += copy(dAtA[i:], m.AuthToken)
+	}
+	if m.LastJobState != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
+	}
+	if m.PromotedVersion != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PromotedVersion))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9038,7 +9664,7 @@ func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9048,7 +9674,7 @@ func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -9057,96 +9683,145 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n88, err88 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err88 != nil {
-			return 0, err88
+		n55, err55 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err55 != nil {
+			return 0, err55
 		}
-		i += n88
+		i += n55
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n89, err89 := m.Transform.MarshalTo(dAtA[i:])
-		if err89 != nil {
-			return 0, err89
+		n56, err56 := m.Transform.MarshalTo(dAtA[i:])
+		if err56 != nil {
+			return 0, err56
 		}
-		i += n89
+		i += n56
 	}
-	if m.Update {
-		dAtA[i] = 0x28
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x32
 		i++
-		if m.Update {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
+		n57, err57 := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err57 != nil {
+			return 0, err57
 		}
-		i++
+		i += n57
 	}
-	if m.ParallelismSpec != nil {
-		dAtA[i] = 0x3a
+	if m.State != 0 {
+		dAtA[i] = 0x38
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n90, err90 := m.ParallelismSpec.MarshalTo(dAtA[i:])
-		if err90 != nil {
-			return 0, err90
-		}
-		i += n90
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
 	}
-	if m.Egress != nil {
-		dAtA[i] = 0x4a
+	if len(m.RecentError) > 0 {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n91, err91 := m.Egress.MarshalTo(dAtA[i:])
-		if err91 != nil {
-			return 0, err91
-		}
-		i += n91
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RecentError)))
+		i += copy(dAtA[i:], m.RecentError)
 	}
-	if len(m.OutputBranch) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
+	if len(m.JobCounts) > 0 {
+		for k, _ := range m.JobCounts {
+			dAtA[i] = 0x4a
+			i++
+			v := m.JobCounts[k]
+			mapSize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(k))
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(v))
+		}
+	}
+	if m.ParallelismSpec != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
+		n58, err58 := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		if err58 != nil {
+			return 0, err58
+		}
+		i += n58
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+	}
+	if m.Egress != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
+		n59, err59 := m.Egress.MarshalTo(dAtA[i:])
+		if err59 != nil {
+			return 0, err59
+		}
+		i += n59
+	}
+	if len(m.OutputBranch) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
 		i += copy(dAtA[i:], m.OutputBranch)
 	}
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
 	if m.ScaleDownThreshold != nil {
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n92, err92 := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
-		if err92 != nil {
-			return 0, err92
+		n60, err60 := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		if err60 != nil {
+			return 0, err60
 		}
-		i += n92
+		i += n60
 	}
 	if m.ResourceRequests != nil {
-		dAtA[i] = 0x62
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n93, err93 := m.ResourceRequests.MarshalTo(dAtA[i:])
-		if err93 != nil {
-			return 0, err93
+		n61, err61 := m.ResourceRequests.MarshalTo(dAtA[i:])
+		if err61 != nil {
+			return 0, err61
 		}
-		i += n93
+		i += n61
 	}
 	if m.Input != nil {
-		dAtA[i] = 0x6a
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n94, err94 := m.Input.MarshalTo(dAtA[i:])
-		if err94 != nil {
-			return 0, err94
+		n62, err62 := m.Input.MarshalTo(dAtA[i:])
+		if err62 != nil {
+			return 0, err62
 		}
-		i += n94
+		i += n62
 	}
 	if len(m.Description) > 0 {
-		dAtA[i] = 0x72
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
 		i += copy(dAtA[i:], m.Description)
 	}
 	if len(m.CacheSize) > 0 {
-		dAtA[i] = 0x82
+		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x1
 		i++
@@ -9154,7 +9829,7 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.CacheSize)
 	}
 	if m.EnableStats {
-		dAtA[i] = 0x88
+		dAtA[i] = 0xc0
 		i++
 		dAtA[i] = 0x1
 		i++
@@ -9165,20 +9840,16 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	if m.Reprocess {
-		dAtA[i] = 0x90
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0xca
 		i++
 		dAtA[i] = 0x1
 		i++
-		if m.Reprocess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
 	}
 	if m.Batch {
-		dAtA[i] = 0x98
+		dAtA[i] = 0xd8
 		i++
 		dAtA[i] = 0x1
 		i++
@@ -9189,85 +9860,105 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
 	if m.MaxQueueSize != 0 {
-		dAtA[i] = 0xa0
+		dAtA[i] = 0xe8
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
 	}
 	if m.Service != nil {
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xf2
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n95, err95 := m.Service.MarshalTo(dAtA[i:])
-		if err95 != nil {
-			return 0, err95
+		n63, err63 := m.Service.MarshalTo(dAtA[i:])
+		if err63 != nil {
+			return 0, err63
 		}
-		i += n95
+		i += n63
 	}
 	if m.ResourceLimits != nil {
-		dAtA[i] = 0xb2
+		dAtA[i] = 0xfa
 		i++
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n96, err96 := m.ResourceLimits.MarshalTo(dAtA[i:])
-		if err96 != nil {
-			return 0, err96
+		n64, err64 := m.ResourceLimits.MarshalTo(dAtA[i:])
+		if err64 != nil {
+			return 0, err64
 		}
-		i += n96
+		i += n64
 	}
 	if m.ChunkSpec != nil {
-		dAtA[i] = 0xba
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n97, err97 := m.ChunkSpec.MarshalTo(dAtA[i:])
-		if err97 != nil {
-			return 0, err97
+		n65, err65 := m.ChunkSpec.MarshalTo(dAtA[i:])
+		if err65 != nil {
+			return 0, err65
 		}
-		i += n97
+		i += n65
 	}
 	if m.DatumTimeout != nil {
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n98, err98 := m.DatumTimeout.MarshalTo(dAtA[i:])
-		if err98 != nil {
-			return 0, err98
+		n66, err66 := m.DatumTimeout.MarshalTo(dAtA[i:])
+		if err66 != nil {
+			return 0, err66
 		}
-		i += n98
+		i += n66
 	}
 	if m.JobTimeout != nil {
-		dAtA[i] = 0xca
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n99, err99 := m.JobTimeout.MarshalTo(dAtA[i:])
-		if err99 != nil {
-			return 0, err99
+		n67, err67 := m.JobTimeout.MarshalTo(dAtA[i:])
+		if err67 != nil {
+			return 0, err67
 		}
-		i += n99
+		i += n67
 	}
-	if len(m.Salt) > 0 {
-		dAtA[i] = 0xd2
+	if len(m.GithookURL) > 0 {
+		dAtA[i] = 0x9a
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
-		i += copy(dAtA[i:], m.Salt)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.GithookURL)))
+		i += copy(dAtA[i:], m.GithookURL)
+	}
+	if m.SpecCommit != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
+		n68, err68 := m.SpecCommit.MarshalTo(dAtA[i:])
+		if err68 != nil {
+			return 0, err68
+		}
+		i += n68
 	}
 	if m.Standby {
-		dAtA[i] = 0xd8
+		dAtA[i] = 0xa8
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		if m.Standby {
 			dAtA[i] = 1
@@ -9276,64 +9967,280 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Stopped {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.DatumTries != 0 {
-		dAtA[i] = 0xe0
+		dAtA[i] = 0xb8
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
 	}
 	if m.SchedulingSpec != nil {
-		dAtA[i] = 0xea
+		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
-		n100, err100 := m.SchedulingSpec.MarshalTo(dAtA[i:])
-		if err100 != nil {
-			return 0, err100
+		n69, err69 := m.SchedulingSpec.MarshalTo(dAtA[i:])
+		if err69 != nil {
+			return 0, err69
 		}
-		i += n100
+		i += n69
 	}
 	if len(m.PodSpec) > 0 {
-		dAtA[i] = 0xf2
+		dAtA[i] = 0xca
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
 		i += copy(dAtA[i:], m.PodSpec)
 	}
 	if m.HashtreeSpec != nil {
-		dAtA[i] = 0xfa
+		dAtA[i] = 0xd2
 		i++
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.HashtreeSpec.Size()))
-		n101, err101 := m.HashtreeSpec.MarshalTo(dAtA[i:])
-		if err101 != nil {
-			return 0, err101
+		n70, err70 := m.HashtreeSpec.MarshalTo(dAtA[i:])
+		if err70 != nil {
+			return 0, err70
 		}
-		i += n101
+		i += n70
 	}
-	if len(m.PodPatch) > 0 {
-		dAtA[i] = 0x82
+	if m.LastJobState != 0 {
+		dAtA[i] = 0xd8
 		i++
 		dAtA[i] = 0x2
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
-		i += copy(dAtA[i:], m.PodPatch)
+		i = encodeVarintPps(dAtA, i, uint64(m.LastJobState))
 	}
-	if m.Spout != nil {
-		dAtA[i] = 0x8a
+	if len(m.PodPatch) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i += copy(dAtA[i:], m.PodPatch)
+	}
+	if m.Spout != nil {
+		dAtA[i] = 0xea
 		i++
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
-		n102, err102 := m.Spout.MarshalTo(dAtA[i:])
-		if err102 != nil {
-			return 0, err102
+		n71, err71 := m.Spout.MarshalTo(dAtA[i:])
+		if err71 != nil {
+			return 0, err71
 		}
-		i += n102
+		i += n71
+	}
+	if m.Notification != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Notification.Size()))
+		n109, err109 := m.Notification.MarshalTo(dAtA[i:])
+		if err109 != nil {
+			return 0, err109
+		}
+		i += n109
+	}
+	if m.TransformCaching {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.TransformCaching {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxQueuedJobs != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueuedJobs))
+	}
+	if m.CanarySamplePercent != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.CanarySamplePercent))
+	}
+	if len(m.CanarySampleBranch) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CanarySampleBranch)))
+		i += copy(dAtA[i:], m.CanarySampleBranch)
+	}
+	if m.VersionedOutput {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x3
+		i++
+		if m.VersionedOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PromotedVersion != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PromotedVersion))
+	}
+	if m.UploadConcurrency != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadConcurrency))
+	}
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if m.PauseWindow != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PauseWindow.Size()))
+		n110, err110 := m.PauseWindow.MarshalTo(dAtA[i:])
+		if err110 != nil {
+			return 0, err110
+		}
+		i += n110
+	}
+	if m.JobTimeoutWarning != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeoutWarning.Size()))
+		n111, err111 := m.JobTimeoutWarning.MarshalTo(dAtA[i:])
+		if err111 != nil {
+			return 0, err111
+		}
+		i += n111
+	}
+	if len(m.AuthPrincipal) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.AuthPrincipal)))
+		i += copy(dAtA[i:], m.AuthPrincipal)
+	}
+	if m.MLflow != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MLflow.Size()))
+		n112, err112 := m.MLflow.MarshalTo(dAtA[i:])
+		if err112 != nil {
+			return 0, err112
+		}
+		i += n112
+	}
+	if m.StatsService != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.StatsService.Size()))
+		n113, err113 := m.StatsService.MarshalTo(dAtA[i:])
+		if err113 != nil {
+			return 0, err113
+		}
+		i += n113
+	}
+	if len(m.StatsServiceURL) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.StatsServiceURL)))
+		i += copy(dAtA[i:], m.StatsServiceURL)
+	}
+	if m.LogRetention != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LogRetention.Size()))
+		n114, err114 := m.LogRetention.MarshalTo(dAtA[i:])
+		if err114 != nil {
+			return 0, err114
+		}
+		i += n114
+	}
+	if len(m.ExtraOutputs) > 0 {
+		for _, s := range m.ExtraOutputs {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.SharedCache != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SharedCache.Size()))
+		n115, err115 := m.SharedCache.MarshalTo(dAtA[i:])
+		if err115 != nil {
+			return 0, err115
+		}
+		i += n115
+	}
+	if m.DatumTimeoutSpec != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeoutSpec.Size()))
+		n116, err116 := m.DatumTimeoutSpec.MarshalTo(dAtA[i:])
+		if err116 != nil {
+			return 0, err116
+		}
+		i += n116
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9341,7 +10248,7 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9351,20 +10258,22 @@ func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n103, err103 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err103 != nil {
-			return 0, err103
+	if len(m.PipelineInfo) > 0 {
+		for _, msg := range m.PipelineInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n103
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9372,7 +10281,7 @@ func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9382,18 +10291,40 @@ func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n72, err72 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err72 != nil {
+			return 0, err72
+		}
+		i += n72
+	}
+	if m.OutputCommit != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n73, err73 := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err73 != nil {
+			return 0, err73
+		}
+		i += n73
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *InspectJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9403,40 +10334,40 @@ func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if m.Job != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n104, err104 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err104 != nil {
-			return 0, err104
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n74, err74 := m.Job.MarshalTo(dAtA[i:])
+		if err74 != nil {
+			return 0, err74
 		}
-		i += n104
+		i += n74
 	}
-	if m.All {
-		dAtA[i] = 0x20
+	if m.BlockState {
+		dAtA[i] = 0x10
 		i++
-		if m.All {
+		if m.BlockState {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if m.Force {
-		dAtA[i] = 0x28
+	if m.OutputCommit != nil {
+		dAtA[i] = 0x1a
 		i++
-		if m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n75, err75 := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err75 != nil {
+			return 0, err75
 		}
-		i++
+		i += n75
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9444,7 +10375,7 @@ func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9454,7 +10385,7 @@ func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -9463,11 +10394,33 @@ func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n105, err105 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err105 != nil {
-			return 0, err105
+		n76, err76 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err76 != nil {
+			return 0, err76
 		}
-		i += n105
+		i += n76
+	}
+	if len(m.InputCommit) > 0 {
+		for _, msg := range m.InputCommit {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OutputCommit != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
+		n77, err77 := m.OutputCommit.MarshalTo(dAtA[i:])
+		if err77 != nil {
+			return 0, err77
+		}
+		i += n77
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9475,7 +10428,7 @@ func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *FlushJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9485,55 +10438,14 @@ func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FlushJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n106, err106 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err106 != nil {
-			return 0, err106
-		}
-		i += n106
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n107, err107 := m.Pipeline.MarshalTo(dAtA[i:])
-		if err107 != nil {
-			return 0, err107
-		}
-		i += n107
-	}
-	if len(m.Exclude) > 0 {
-		for _, msg := range m.Exclude {
-			dAtA[i] = 0x12
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -9543,9 +10455,9 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.Include) > 0 {
-		for _, msg := range m.Include {
-			dAtA[i] = 0x1a
+	if len(m.ToPipelines) > 0 {
+		for _, msg := range m.ToPipelines {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -9561,7 +10473,7 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9571,15 +10483,58 @@ func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.MemoryBytes != 0 {
-		dAtA[i] = 0x8
+	if m.Job != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.MemoryBytes))
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n78, err78 := m.Job.MarshalTo(dAtA[i:])
+		if err78 != nil {
+			return 0, err78
+		}
+		i += n78
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n117, err117 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err117 != nil {
+			return 0, err117
+		}
+		i += n117
+	}
+	if len(m.States) > 0 {
+		dAtA118 := make([]byte, len(m.States)*10)
+		var j119 int
+		for _, num1 := range m.States {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA118[j119] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j119++
+			}
+			dAtA118[j119] = uint8(num)
+			j119++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(j119))
+		i += copy(dAtA[i:], dAtA118[:j119])
+	}
+	if m.OlderThan != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OlderThan.Size()))
+		n120, err120 := m.OlderThan.MarshalTo(dAtA[i:])
+		if err120 != nil {
+			return 0, err120
+		}
+		i += n120
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9587,7 +10542,7 @@ func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+func (m *StopJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9597,18 +10552,56 @@ func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n79, err79 := m.Job.MarshalTo(dAtA[i:])
+		if err79 != nil {
+			return 0, err79
+		}
+		i += n79
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n121, err121 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err121 != nil {
+			return 0, err121
+		}
+		i += n121
+	}
+	if len(m.States) > 0 {
+		dAtA122 := make([]byte, len(m.States)*10)
+		var j123 int
+		for _, num1 := range m.States {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA122[j123] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j123++
+			}
+			dAtA122[j123] = uint8(num)
+			j123++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(j123))
+		i += copy(dAtA[i:], dAtA122[:j123])
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetLogsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9618,18 +10611,94 @@ func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n80, err80 := m.Job.MarshalTo(dAtA[i:])
+		if err80 != nil {
+			return 0, err80
+		}
+		i += n80
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n81, err81 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err81 != nil {
+			return 0, err81
+		}
+		i += n81
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Master {
+		dAtA[i] = 0x28
+		i++
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Datum != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
+		n82, err82 := m.Datum.MarshalTo(dAtA[i:])
+		if err82 != nil {
+			return 0, err82
+		}
+		i += n82
+	}
+	if m.Follow {
+		dAtA[i] = 0x38
+		i++
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Tail != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Tail))
+	}
+	if len(m.WorkerID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i += copy(dAtA[i:], m.WorkerID)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+func (m *LogMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -9639,1231 +10708,1661 @@ func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.PipelineName) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PipelineName)))
+		i += copy(dAtA[i:], m.PipelineName)
 	}
-	return i, nil
-}
-
-func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.JobID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i += copy(dAtA[i:], m.JobID)
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Secret) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Data) > 0 {
+		for _, msg := range m.Data {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Ts != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Ts.Size()))
+		n83, err83 := m.Ts.MarshalTo(dAtA[i:])
+		if err83 != nil {
+			return 0, err83
+		}
+		i += n83
 	}
-	l = len(m.MountPath)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
-	l = len(m.EnvVar)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.WorkerID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i += copy(dAtA[i:], m.WorkerID)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.User {
+		dAtA[i] = 0x40
+		i++
+		if m.User {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DatumID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i += copy(dAtA[i:], m.DatumID)
+	}
+	if m.Master {
+		dAtA[i] = 0x50
+		i++
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Transform) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestartDatumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Secrets) > 0 {
-		for _, e := range m.Secrets {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if len(m.Stdin) > 0 {
-		for _, s := range m.Stdin {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if len(m.AcceptReturnCode) > 0 {
-		l = 0
-		for _, e := range m.AcceptReturnCode {
-			l += sovPps(uint64(e))
-		}
-		n += 1 + sovPps(uint64(l)) + l
-	}
-	if m.Debug {
-		n += 2
-	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, s := range m.ImagePullSecrets {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.WorkingDir)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Dockerfile)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.ErrCmd) > 0 {
-		for _, s := range m.ErrCmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n84, err84 := m.Job.MarshalTo(dAtA[i:])
+		if err84 != nil {
+			return 0, err84
 		}
+		i += n84
 	}
-	if len(m.ErrStdin) > 0 {
-		for _, s := range m.ErrStdin {
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			dAtA[i] = 0x12
+			i++
 			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Egress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectDatumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Datum != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
+		n85, err85 := m.Datum.MarshalTo(dAtA[i:])
+		if err85 != nil {
+			return 0, err85
+		}
+		i += n85
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Job) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListDatumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n86, err86 := m.Job.MarshalTo(dAtA[i:])
+		if err86 != nil {
+			return 0, err86
+		}
+		i += n86
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Service) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListDatumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDatumResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.InternalPort != 0 {
-		n += 1 + sovPps(uint64(m.InternalPort))
+	if len(m.DatumInfos) > 0 {
+		for _, msg := range m.DatumInfos {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.ExternalPort != 0 {
-		n += 1 + sovPps(uint64(m.ExternalPort))
+	if m.TotalPages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
 	}
-	l = len(m.IP)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Spout) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Overwrite {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListDatumStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PFSInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListDatumStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.DatumInfo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumInfo.Size()))
+		n87, err87 := m.DatumInfo.MarshalTo(dAtA[i:])
+		if err87 != nil {
+			return 0, err87
+		}
+		i += n87
 	}
-	if m.Lazy {
-		n += 2
+	if m.TotalPages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TotalPages))
 	}
-	if m.EmptyFiles {
-		n += 2
+	if m.Page != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CronInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChunkSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Start != nil {
-		l = m.Start.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Number != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Number))
 	}
-	if m.Overwrite {
-		n += 2
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GitInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k, _ := range m.NodeSelector {
+			dAtA[i] = 0xa
+			i++
+			v := m.NodeSelector[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.PriorityClassName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PriorityClassName)))
+		i += copy(dAtA[i:], m.PriorityClassName)
 	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Arch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Arch)))
+		i += copy(dAtA[i:], m.Arch)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Cross) > 0 {
-		for _, e := range m.Cross {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n88, err88 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err88 != nil {
+			return 0, err88
 		}
+		i += n88
 	}
-	if len(m.Union) > 0 {
-		for _, e := range m.Union {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Transform != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n89, err89 := m.Transform.MarshalTo(dAtA[i:])
+		if err89 != nil {
+			return 0, err89
 		}
+		i += n89
 	}
-	if m.Cron != nil {
-		l = m.Cron.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Update {
+		dAtA[i] = 0x28
+		i++
+		if m.Update {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.ParallelismSpec != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
+		n90, err90 := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		if err90 != nil {
+			return 0, err90
+		}
+		i += n90
 	}
-	if m.Pfs != nil {
-		l = m.Pfs.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Egress != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
+		n91, err91 := m.Egress.MarshalTo(dAtA[i:])
+		if err91 != nil {
+			return 0, err91
+		}
+		i += n91
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.OutputBranch) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.OutputBranch)))
+		i += copy(dAtA[i:], m.OutputBranch)
 	}
-	return n
-}
-
-func (m *JobInput) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.ScaleDownThreshold != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
+		n92, err92 := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		if err92 != nil {
+			return 0, err92
+		}
+		i += n92
 	}
-	if m.Lazy {
-		n += 2
+	if m.ResourceRequests != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
+		n93, err93 := m.ResourceRequests.MarshalTo(dAtA[i:])
+		if err93 != nil {
+			return 0, err93
+		}
+		i += n93
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Input != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
+		n94, err94 := m.Input.MarshalTo(dAtA[i:])
+		if err94 != nil {
+			return 0, err94
+		}
+		i += n94
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	return n
-}
-
-func (m *ParallelismSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CacheSize) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
+		i += copy(dAtA[i:], m.CacheSize)
 	}
-	var l int
-	_ = l
-	if m.Constant != 0 {
-		n += 1 + sovPps(uint64(m.Constant))
+	if m.EnableStats {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.EnableStats {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Coefficient != 0 {
-		n += 9
+	if m.Reprocess {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Batch {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *HashtreeSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxQueueSize != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueueSize))
 	}
-	var l int
-	_ = l
-	if m.Constant != 0 {
-		n += 1 + sovPps(uint64(m.Constant))
+	if m.Service != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
+		n95, err95 := m.Service.MarshalTo(dAtA[i:])
+		if err95 != nil {
+			return 0, err95
+		}
+		i += n95
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ResourceLimits != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
+		n96, err96 := m.ResourceLimits.MarshalTo(dAtA[i:])
+		if err96 != nil {
+			return 0, err96
+		}
+		i += n96
 	}
-	return n
-}
-
-func (m *InputFile) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChunkSpec != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
+		n97, err97 := m.ChunkSpec.MarshalTo(dAtA[i:])
+		if err97 != nil {
+			return 0, err97
+		}
+		i += n97
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.DatumTimeout != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
+		n98, err98 := m.DatumTimeout.MarshalTo(dAtA[i:])
+		if err98 != nil {
+			return 0, err98
+		}
+		i += n98
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.JobTimeout != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
+		n99, err99 := m.JobTimeout.MarshalTo(dAtA[i:])
+		if err99 != nil {
+			return 0, err99
+		}
+		i += n99
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
 	}
-	return n
-}
-
-func (m *Datum) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Standby {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Standby {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
 	}
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.SchedulingSpec != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SchedulingSpec.Size()))
+		n100, err100 := m.SchedulingSpec.MarshalTo(dAtA[i:])
+		if err100 != nil {
+			return 0, err100
+		}
+		i += n100
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PodSpec) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodSpec)))
+		i += copy(dAtA[i:], m.PodSpec)
 	}
-	return n
-}
-
-func (m *DatumInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.HashtreeSpec != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.HashtreeSpec.Size()))
+		n101, err101 := m.HashtreeSpec.MarshalTo(dAtA[i:])
+		if err101 != nil {
+			return 0, err101
+		}
+		i += n101
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	if len(m.PodPatch) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PodPatch)))
+		i += copy(dAtA[i:], m.PodPatch)
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Spout != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Spout.Size()))
+		n102, err102 := m.Spout.MarshalTo(dAtA[i:])
+		if err102 != nil {
+			return 0, err102
+		}
+		i += n102
 	}
-	if m.PfsState != nil {
-		l = m.PfsState.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Notification != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Notification.Size()))
+		n124, err124 := m.Notification.MarshalTo(dAtA[i:])
+		if err124 != nil {
+			return 0, err124
+		}
+		i += n124
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.TransformCaching {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.TransformCaching {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MaxQueuedJobs != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxQueuedJobs))
 	}
-	return n
-}
-
-func (m *Aggregate) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Canary {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.Canary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovPps(uint64(m.Count))
+	if len(m.CanarySampleBranch) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CanarySampleBranch)))
+		i += copy(dAtA[i:], m.CanarySampleBranch)
 	}
-	if m.Mean != 0 {
-		n += 9
+	if m.CanarySamplePercent != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.CanarySamplePercent))
 	}
-	if m.Stddev != 0 {
-		n += 9
+	if m.VersionedOutput {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.VersionedOutput {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.FifthPercentile != 0 {
-		n += 9
+	if m.UploadConcurrency != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadConcurrency))
 	}
-	if m.NinetyFifthPercentile != 0 {
-		n += 9
+	if len(m.Group) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PauseWindow != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PauseWindow.Size()))
+		n125, err125 := m.PauseWindow.MarshalTo(dAtA[i:])
+		if err125 != nil {
+			return 0, err125
+		}
+		i += n125
 	}
-	return n
-}
-
-func (m *ProcessStats) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.JobTimeoutWarning != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeoutWarning.Size()))
+		n126, err126 := m.JobTimeoutWarning.MarshalTo(dAtA[i:])
+		if err126 != nil {
+			return 0, err126
+		}
+		i += n126
 	}
-	var l int
-	_ = l
-	if m.DownloadTime != nil {
-		l = m.DownloadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.MLflow != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MLflow.Size()))
+		n127, err127 := m.MLflow.MarshalTo(dAtA[i:])
+		if err127 != nil {
+			return 0, err127
+		}
+		i += n127
 	}
-	if m.ProcessTime != nil {
-		l = m.ProcessTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.StatsService != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.StatsService.Size()))
+		n128, err128 := m.StatsService.MarshalTo(dAtA[i:])
+		if err128 != nil {
+			return 0, err128
+		}
+		i += n128
 	}
-	if m.UploadTime != nil {
-		l = m.UploadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.LogRetention != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LogRetention.Size()))
+		n129, err129 := m.LogRetention.MarshalTo(dAtA[i:])
+		if err129 != nil {
+			return 0, err129
+		}
+		i += n129
 	}
-	if m.DownloadBytes != 0 {
-		n += 1 + sovPps(uint64(m.DownloadBytes))
+	if len(m.ExtraOutputs) > 0 {
+		for _, s := range m.ExtraOutputs {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.UploadBytes != 0 {
-		n += 1 + sovPps(uint64(m.UploadBytes))
+	if m.SharedCache != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SharedCache.Size()))
+		n130, err130 := m.SharedCache.MarshalTo(dAtA[i:])
+		if err130 != nil {
+			return 0, err130
+		}
+		i += n130
+	}
+	if m.DatumTimeoutSpec != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeoutSpec.Size()))
+		n131, err131 := m.DatumTimeoutSpec.MarshalTo(dAtA[i:])
+		if err131 != nil {
+			return 0, err131
+		}
+		i += n131
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AggregateProcessStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.DownloadTime != nil {
-		l = m.DownloadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ProcessTime != nil {
-		l = m.ProcessTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.UploadTime != nil {
-		l = m.UploadTime.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.DownloadBytes != nil {
-		l = m.DownloadBytes.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.UploadBytes != nil {
-		l = m.UploadBytes.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n103, err103 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err103 != nil {
+			return 0, err103
+		}
+		i += n103
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *WorkerStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.JobID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n104, err104 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err104 != nil {
+			return 0, err104
 		}
+		i += n104
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.All {
+		dAtA[i] = 0x20
+		i++
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.QueueSize != 0 {
-		n += 1 + sovPps(uint64(m.QueueSize))
+	if m.Force {
+		dAtA[i] = 0x28
+		i++
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResourceSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Cpu != 0 {
-		n += 5
-	}
-	l = len(m.Memory)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Disk)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n105, err105 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err105 != nil {
+			return 0, err105
+		}
+		i += n105
 	}
-	if m.Gpu != nil {
-		l = m.Gpu.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Group) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GPUSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n106, err106 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err106 != nil {
+			return 0, err106
+		}
+		i += n106
 	}
-	if m.Number != 0 {
-		n += 1 + sovPps(uint64(m.Number))
+	if len(m.Group) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *EtcdJobInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Restart != 0 {
-		n += 1 + sovPps(uint64(m.Restart))
-	}
-	if m.DataProcessed != 0 {
-		n += 1 + sovPps(uint64(m.DataProcessed))
-	}
-	if m.DataSkipped != 0 {
-		n += 1 + sovPps(uint64(m.DataSkipped))
-	}
-	if m.DataTotal != 0 {
-		n += 1 + sovPps(uint64(m.DataTotal))
-	}
-	if m.DataFailed != 0 {
-		n += 1 + sovPps(uint64(m.DataFailed))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.StatsCommit != nil {
-		l = m.StatsCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n107, err107 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err107 != nil {
+			return 0, err107
+		}
+		i += n107
 	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Exclude) > 0 {
+		for _, msg := range m.Exclude {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.DataRecovered != 0 {
-		n += 1 + sovPps(uint64(m.DataRecovered))
+	if len(m.Include) > 0 {
+		for _, msg := range m.Include {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *JobInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ParentJob != nil {
-		l = m.ParentJob.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.PipelineVersion != 0 {
-		n += 1 + sovPps(uint64(m.PipelineVersion))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.OutputRepo != nil {
-		l = m.OutputRepo.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Restart != 0 {
-		n += 2 + sovPps(uint64(m.Restart))
-	}
-	if m.DataProcessed != 0 {
-		n += 2 + sovPps(uint64(m.DataProcessed))
-	}
-	if m.DataTotal != 0 {
-		n += 2 + sovPps(uint64(m.DataTotal))
-	}
-	if len(m.WorkerStatus) > 0 {
-		for _, e := range m.WorkerStatus {
-			l = e.Size()
-			n += 2 + l + sovPps(uint64(l))
-		}
-	}
-	if m.ResourceRequests != nil {
-		l = m.ResourceRequests.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.NewBranch != nil {
-		l = m.NewBranch.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.StatsCommit != nil {
-		l = m.StatsCommit.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DataSkipped != 0 {
-		n += 2 + sovPps(uint64(m.DataSkipped))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.EnableStats {
-		n += 3
-	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Batch {
-		n += 3
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ResourceLimits != nil {
-		l = m.ResourceLimits.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ChunkSpec != nil {
-		l = m.ChunkSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DatumTimeout != nil {
-		l = m.DatumTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.JobTimeout != nil {
-		l = m.JobTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DataFailed != 0 {
-		n += 2 + sovPps(uint64(m.DataFailed))
-	}
-	if m.DatumTries != 0 {
-		n += 2 + sovPps(uint64(m.DatumTries))
-	}
-	if m.SchedulingSpec != nil {
-		l = m.SchedulingSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.PodSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.PodPatch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Spout != nil {
-		l = m.Spout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DataRecovered != 0 {
-		n += 2 + sovPps(uint64(m.DataRecovered))
+	if m.MemoryBytes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.MemoryBytes))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Worker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *JobInfos) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActivateAuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.JobInfo) > 0 {
-		for _, e := range m.JobInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Pipeline) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActivateAuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateAuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PipelineInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetKubeEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetKubeEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n132, err132 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err132 != nil {
+			return 0, err132
+		}
+		i += n132
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *KubeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Lazy {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *KubeEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LastSeen != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LastSeen.Size()))
+		n133, err133 := m.LastSeen.MarshalTo(dAtA[i:])
+		if err133 != nil {
+			return 0, err133
+		}
+		i += n133
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.Object) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Object)))
+		i += copy(dAtA[i:], m.Object)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *EtcdPipelineInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DatumTimeoutSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumTimeoutSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	if m.SpecCommit != nil {
-		l = m.SpecCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.JobCounts) > 0 {
-		for k, v := range m.JobCounts {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	if m.Base != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Base.Size()))
+		n134, err134 := m.Base.MarshalTo(dAtA[i:])
+		if err134 != nil {
+			return 0, err134
 		}
+		i += n134
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.AuthToken)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.LastJobState != 0 {
-		n += 1 + sovPps(uint64(m.LastJobState))
+	if m.PerGB != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PerGB.Size()))
+		n135, err135 := m.PerGB.MarshalTo(dAtA[i:])
+		if err135 != nil {
+			return 0, err135
+		}
+		i += n135
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PipelineInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SharedCacheSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SharedCacheSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.MountPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.MountPath)))
+		i += copy(dAtA[i:], m.MountPath)
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	if len(m.Size_) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Size_)))
+		i += copy(dAtA[i:], m.Size_)
 	}
-	l = len(m.RecentError)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.StorageClass) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.StorageClass)))
+		i += copy(dAtA[i:], m.StorageClass)
 	}
-	if len(m.JobCounts) > 0 {
-		for k, v := range m.JobCounts {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	if m.ReadOnly {
+		dAtA[i] = 0x28
+		i++
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovPps(uint64(m.Version))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *PauseWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PauseWindow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Start) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Start)))
+		i += copy(dAtA[i:], m.Start)
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if m.Duration != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Duration.Size()))
+		n136, err136 := m.Duration.MarshalTo(dAtA[i:])
+		if err136 != nil {
+			return 0, err136
+		}
+		i += n136
 	}
-	if m.ScaleDownThreshold != nil {
-		l = m.ScaleDownThreshold.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ResourceRequests != nil {
-		l = m.ResourceRequests.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *MLflowSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MLflowSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrackingURI) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.TrackingURI)))
+		i += copy(dAtA[i:], m.TrackingURI)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Experiment) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Experiment)))
+		i += copy(dAtA[i:], m.Experiment)
 	}
-	l = len(m.CacheSize)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.EnableStats {
-		n += 3
+	return i, nil
+}
+
+func (m *StatsServiceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *StatsServiceSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
 	}
-	if m.Batch {
-		n += 3
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.MaxQueueSize != 0 {
-		n += 2 + sovPps(uint64(m.MaxQueueSize))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ResourceLimits != nil {
-		l = m.ResourceLimits.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ChunkSpec != nil {
-		l = m.ChunkSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DatumTimeout != nil {
-		l = m.DatumTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.JobTimeout != nil {
-		l = m.JobTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.GithookURL)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.SpecCommit != nil {
-		l = m.SpecCommit.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Standby {
-		n += 3
-	}
-	if m.Stopped {
-		n += 3
-	}
-	if m.DatumTries != 0 {
-		n += 2 + sovPps(uint64(m.DatumTries))
+	if m.TensorBoard {
+		dAtA[i] = 0x10
+		i++
+		if m.TensorBoard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.SchedulingSpec != nil {
-		l = m.SchedulingSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.ExternalPort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ExternalPort))
 	}
-	l = len(m.PodSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HashtreeSpec != nil {
-		l = m.HashtreeSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *NotificationSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.LastJobState != 0 {
-		n += 2 + sovPps(uint64(m.LastJobState))
+	return dAtA[:n], nil
+}
+
+func (m *NotificationSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
 	}
-	l = len(m.PodPatch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.On) > 0 {
+		for _, s := range m.On {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.Spout != nil {
-		l = m.Spout.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Template) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Template)))
+		i += copy(dAtA[i:], m.Template)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PipelineInfos) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PromotePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PromotePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, e := range m.PipelineInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n137, err137 := m.Pipeline.MarshalTo(dAtA[i:])
+		if err137 != nil {
+			return 0, err137
 		}
+		i += n137
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidateDAGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateDAGRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *InspectJobRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidateDAGResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateDAGResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.BlockState {
-		n += 2
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListJobRequest) Size() (n int) {
+func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Secret) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.InputCommit) > 0 {
-		for _, e := range m.InputCommit {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.MountPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
+	l = len(m.EnvVar)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10872,38 +12371,120 @@ func (m *ListJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *FlushJobRequest) Size() (n int) {
+func (m *Transform) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	if len(m.ToPipelines) > 0 {
-		for _, e := range m.ToPipelines {
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if len(m.Stdin) > 0 {
+		for _, s := range m.Stdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.AcceptReturnCode) > 0 {
+		l = 0
+		for _, e := range m.AcceptReturnCode {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.Debug {
+		n += 2
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkingDir)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Dockerfile)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.ErrCmd) > 0 {
+		for _, s := range m.ErrCmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ErrStdin) > 0 {
+		for _, s := range m.ErrStdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.InitCmd) > 0 {
+		for _, s := range m.InitCmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.InitStdin) > 0 {
+		for _, s := range m.InitStdin {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.TeardownCmd) > 0 {
+		for _, s := range m.TeardownCmd {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.TeardownStdin) > 0 {
+		for _, s := range m.TeardownStdin {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DeleteJobRequest) Size() (n int) {
+func (m *Egress) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.URL)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10912,14 +12493,14 @@ func (m *DeleteJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *StopJobRequest) Size() (n int) {
+func (m *Job) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10928,85 +12509,73 @@ func (m *StopJobRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetLogsRequest) Size() (n int) {
+func (m *Service) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.InternalPort != 0 {
+		n += 1 + sovPps(uint64(m.InternalPort))
 	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.ExternalPort != 0 {
+		n += 1 + sovPps(uint64(m.ExternalPort))
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.IP)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Master {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Datum != nil {
-		l = m.Datum.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return n
+}
+
+func (m *Spout) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Follow {
+	var l int
+	_ = l
+	if m.Overwrite {
 		n += 2
 	}
-	if m.Tail != 0 {
-		n += 1 + sovPps(uint64(m.Tail))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *LogMessage) Size() (n int) {
+func (m *PFSInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PipelineName)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.JobID)
+	l = len(m.Repo)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if m.Ts != nil {
-		l = m.Ts.Size()
+	l = len(m.Branch)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.Message)
+	l = len(m.Commit)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.WorkerID)
+	l = len(m.Glob)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.User {
+	if m.Lazy {
 		n += 2
 	}
-	l = len(m.DatumID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Master {
+	if m.EmptyFiles {
 		n += 2
 	}
 	if m.XXX_unrecognized != nil {
@@ -11015,59 +12584,62 @@ func (m *LogMessage) Size() (n int) {
 	return n
 }
 
-func (m *RestartDatumRequest) Size() (n int) {
+func (m *CronInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	return n
-}
-
-func (m *InspectDatumRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
+	if m.Start != nil {
+		l = m.Start.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Overwrite {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ListDatumRequest) Size() (n int) {
+func (m *GitInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovPps(uint64(m.PageSize))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Page != 0 {
-		n += 1 + sovPps(uint64(m.Page))
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11075,23 +12647,35 @@ func (m *ListDatumRequest) Size() (n int) {
 	return n
 }
 
-func (m *ListDatumResponse) Size() (n int) {
+func (m *Input) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.DatumInfos) > 0 {
-		for _, e := range m.DatumInfos {
+	if len(m.Cross) > 0 {
+		for _, e := range m.Cross {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	if m.TotalPages != 0 {
-		n += 1 + sovPps(uint64(m.TotalPages))
+	if len(m.Union) > 0 {
+		for _, e := range m.Union {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if m.Page != 0 {
-		n += 1 + sovPps(uint64(m.Page))
+	if m.Cron != nil {
+		l = m.Cron.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pfs != nil {
+		l = m.Pfs.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11099,21 +12683,26 @@ func (m *ListDatumResponse) Size() (n int) {
 	return n
 }
 
-func (m *ListDatumStreamResponse) Size() (n int) {
+func (m *JobInput) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DatumInfo != nil {
-		l = m.DatumInfo.Size()
+	if m.Commit != nil {
+		l = m.Commit.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.TotalPages != 0 {
-		n += 1 + sovPps(uint64(m.TotalPages))
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Page != 0 {
-		n += 1 + sovPps(uint64(m.Page))
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11121,17 +12710,17 @@ func (m *ListDatumStreamResponse) Size() (n int) {
 	return n
 }
 
-func (m *ChunkSpec) Size() (n int) {
+func (m *ParallelismSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Number != 0 {
-		n += 1 + sovPps(uint64(m.Number))
+	if m.Constant != 0 {
+		n += 1 + sovPps(uint64(m.Constant))
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPps(uint64(m.SizeBytes))
+	if m.Coefficient != 0 {
+		n += 9
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11139,23 +12728,14 @@ func (m *ChunkSpec) Size() (n int) {
 	return n
 }
 
-func (m *SchedulingSpec) Size() (n int) {
+func (m *HashtreeSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.PriorityClassName)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Constant != 0 {
+		n += 1 + sovPps(uint64(m.Constant))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11163,181 +12743,75 @@ func (m *SchedulingSpec) Size() (n int) {
 	return n
 }
 
-func (m *CreatePipelineRequest) Size() (n int) {
+func (m *InputFile) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Update {
-		n += 2
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.OutputBranch)
+	l = len(m.Path)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ScaleDownThreshold != nil {
-		l = m.ScaleDownThreshold.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.ResourceRequests != nil {
-		l = m.ResourceRequests.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Description)
+	l = len(m.Hash)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.CacheSize)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.EnableStats {
-		n += 3
-	}
-	if m.Reprocess {
-		n += 3
-	}
-	if m.Batch {
-		n += 3
-	}
-	if m.MaxQueueSize != 0 {
-		n += 2 + sovPps(uint64(m.MaxQueueSize))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ResourceLimits != nil {
-		l = m.ResourceLimits.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.ChunkSpec != nil {
-		l = m.ChunkSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.DatumTimeout != nil {
-		l = m.DatumTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.JobTimeout != nil {
-		l = m.JobTimeout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Standby {
-		n += 3
-	}
-	if m.DatumTries != 0 {
-		n += 2 + sovPps(uint64(m.DatumTries))
-	}
-	if m.SchedulingSpec != nil {
-		l = m.SchedulingSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.PodSpec)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.HashtreeSpec != nil {
-		l = m.HashtreeSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	l = len(m.PodPatch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Spout != nil {
-		l = m.Spout.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *InspectPipelineRequest) Size() (n int) {
+func (m *Datum) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.ID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ListPipelineRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DeletePipelineRequest) Size() (n int) {
+func (m *DatumInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Datum != nil {
+		l = m.Datum.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.All {
-		n += 2
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
 	}
-	if m.Force {
-		n += 2
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PfsState != nil {
+		l = m.PfsState.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	return n
-}
-
-func (m *StartPipelineRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Reason)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -11346,15 +12820,26 @@ func (m *StartPipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *StopPipelineRequest) Size() (n int) {
+func (m *Aggregate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
+	}
+	if m.Mean != 0 {
+		n += 9
+	}
+	if m.Stddev != 0 {
+		n += 9
+	}
+	if m.FifthPercentile != 0 {
+		n += 9
+	}
+	if m.NinetyFifthPercentile != 0 {
+		n += 9
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11362,27 +12847,29 @@ func (m *StopPipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *RerunPipelineRequest) Size() (n int) {
+func (m *ProcessStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.DownloadTime != nil {
+		l = m.DownloadTime.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Exclude) > 0 {
-		for _, e := range m.Exclude {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.ProcessTime != nil {
+		l = m.ProcessTime.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Include) > 0 {
-		for _, e := range m.Include {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.UploadTime != nil {
+		l = m.UploadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DownloadBytes != 0 {
+		n += 1 + sovPps(uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		n += 1 + sovPps(uint64(m.UploadBytes))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11390,14 +12877,31 @@ func (m *RerunPipelineRequest) Size() (n int) {
 	return n
 }
 
-func (m *GarbageCollectRequest) Size() (n int) {
+func (m *AggregateProcessStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MemoryBytes != 0 {
-		n += 1 + sovPps(uint64(m.MemoryBytes))
+	if m.DownloadTime != nil {
+		l = m.DownloadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ProcessTime != nil {
+		l = m.ProcessTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.UploadTime != nil {
+		l = m.UploadTime.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DownloadBytes != nil {
+		l = m.DownloadBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.UploadBytes != nil {
+		l = m.UploadBytes.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11405,56 +12909,4830 @@ func (m *GarbageCollectRequest) Size() (n int) {
 	return n
 }
 
-func (m *GarbageCollectResponse) Size() (n int) {
+func (m *WorkerStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.QueueSize != 0 {
+		n += 1 + sovPps(uint64(m.QueueSize))
+	}
+	if m.QueuedChunks != 0 {
+		n += 1 + sovPps(uint64(m.QueuedChunks))
+	}
+	if m.RunningChunks != 0 {
+		n += 1 + sovPps(uint64(m.RunningChunks))
+	}
+	if m.FinishedChunks != 0 {
+		n += 1 + sovPps(uint64(m.FinishedChunks))
+	}
+	if m.ChunksProcessed != 0 {
+		n += 1 + sovPps(uint64(m.ChunksProcessed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cpu != 0 {
+		n += 5
+	}
+	l = len(m.Memory)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Disk)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Gpu != nil {
+		l = m.Gpu.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GPUSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovPps(uint64(m.Number))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EtcdJobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Restart != 0 {
+		n += 1 + sovPps(uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		n += 1 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataSkipped != 0 {
+		n += 1 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.DataTotal != 0 {
+		n += 1 + sovPps(uint64(m.DataTotal))
+	}
+	if m.DataFailed != 0 {
+		n += 1 + sovPps(uint64(m.DataFailed))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.StatsCommit != nil {
+		l = m.StatsCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DataRecovered != 0 {
+		n += 1 + sovPps(uint64(m.DataRecovered))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentJob != nil {
+		l = m.ParentJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineVersion != 0 {
+		n += 1 + sovPps(uint64(m.PipelineVersion))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.OutputRepo != nil {
+		l = m.OutputRepo.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Restart != 0 {
+		n += 2 + sovPps(uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		n += 2 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataTotal != 0 {
+		n += 2 + sovPps(uint64(m.DataTotal))
+	}
+	if len(m.WorkerStatus) > 0 {
+		for _, e := range m.WorkerStatus {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.ResourceRequests != nil {
+		l = m.ResourceRequests.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.NewBranch != nil {
+		l = m.NewBranch.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.StatsCommit != nil {
+		l = m.StatsCommit.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DataSkipped != 0 {
+		n += 2 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Batch {
+		n += 3
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ChunkSpec != nil {
+		l = m.ChunkSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DataFailed != 0 {
+		n += 2 + sovPps(uint64(m.DataFailed))
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DataRecovered != 0 {
+		n += 2 + sovPps(uint64(m.DataRecovered))
+	}
+	if m.TransformCaching {
+		n += 3
+	}
+	l = len(m.PendingReason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeoutWarning != nil {
+		l = m.JobTimeoutWarning.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Worker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobInfo) > 0 {
+		for _, e := range m.JobInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Pipeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EtcdPipelineInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.SpecCommit != nil {
+		l = m.SpecCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.JobCounts) > 0 {
+		for k, v := range m.JobCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.AuthToken)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.LastJobState != 0 {
+		n += 1 + sovPps(uint64(m.LastJobState))
+	}
+	if m.PromotedVersion != 0 {
+		n += 1 + sovPps(uint64(m.PromotedVersion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.RecentError)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.JobCounts) > 0 {
+		for k, v := range m.JobCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownThreshold != nil {
+		l = m.ScaleDownThreshold.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ResourceRequests != nil {
+		l = m.ResourceRequests.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.CacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Batch {
+		n += 3
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MaxQueueSize != 0 {
+		n += 2 + sovPps(uint64(m.MaxQueueSize))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ChunkSpec != nil {
+		l = m.ChunkSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.GithookURL)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SpecCommit != nil {
+		l = m.SpecCommit.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Standby {
+		n += 3
+	}
+	if m.Stopped {
+		n += 3
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.HashtreeSpec != nil {
+		l = m.HashtreeSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.LastJobState != 0 {
+		n += 2 + sovPps(uint64(m.LastJobState))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Notification != nil {
+		l = m.Notification.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.TransformCaching {
+		n += 3
+	}
+	if m.MaxQueuedJobs != 0 {
+		n += 2 + sovPps(uint64(m.MaxQueuedJobs))
+	}
+	if m.CanarySamplePercent != 0 {
+		n += 2 + sovPps(uint64(m.CanarySamplePercent))
+	}
+	l = len(m.CanarySampleBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.VersionedOutput {
+		n += 3
+	}
+	if m.PromotedVersion != 0 {
+		n += 2 + sovPps(uint64(m.PromotedVersion))
+	}
+	if m.UploadConcurrency != 0 {
+		n += 2 + sovPps(uint64(m.UploadConcurrency))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.PauseWindow != nil {
+		l = m.PauseWindow.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeoutWarning != nil {
+		l = m.JobTimeoutWarning.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.AuthPrincipal)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MLflow != nil {
+		l = m.MLflow.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.StatsService != nil {
+		l = m.StatsService.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.StatsServiceURL)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.LogRetention != nil {
+		l = m.LogRetention.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if len(m.ExtraOutputs) > 0 {
+		for _, s := range m.ExtraOutputs {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.SharedCache != nil {
+		l = m.SharedCache.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeoutSpec != nil {
+		l = m.DatumTimeoutSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PipelineInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PipelineInfo) > 0 {
+		for _, e := range m.PipelineInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.BlockState {
+		n += 2
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.InputCommit) > 0 {
+		for _, e := range m.InputCommit {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ToPipelines) > 0 {
+		for _, e := range m.ToPipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.OlderThan != nil {
+		l = m.OlderThan.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopJobRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Master {
+		n += 2
+	}
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Follow {
+		n += 2
+	}
+	if m.Tail != 0 {
+		n += 1 + sovPps(uint64(m.Tail))
+	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PipelineName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Ts != nil {
+		l = m.Ts.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.User {
+		n += 2
+	}
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Master {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestartDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDatumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovPps(uint64(m.PageSize))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDatumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DatumInfos) > 0 {
+		for _, e := range m.DatumInfos {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovPps(uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDatumStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DatumInfo != nil {
+		l = m.DatumInfo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovPps(uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		n += 1 + sovPps(uint64(m.Page))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChunkSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovPps(uint64(m.Number))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulingSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PriorityClassName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Arch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownThreshold != nil {
+		l = m.ScaleDownThreshold.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceRequests != nil {
+		l = m.ResourceRequests.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.CacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.EnableStats {
+		n += 3
+	}
+	if m.Reprocess {
+		n += 3
+	}
+	if m.Batch {
+		n += 3
+	}
+	if m.MaxQueueSize != 0 {
+		n += 2 + sovPps(uint64(m.MaxQueueSize))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ChunkSpec != nil {
+		l = m.ChunkSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeout != nil {
+		l = m.DatumTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeout != nil {
+		l = m.JobTimeout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Standby {
+		n += 3
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.SchedulingSpec != nil {
+		l = m.SchedulingSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodSpec)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.HashtreeSpec != nil {
+		l = m.HashtreeSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PodPatch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Spout != nil {
+		l = m.Spout.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Notification != nil {
+		l = m.Notification.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.TransformCaching {
+		n += 3
+	}
+	if m.MaxQueuedJobs != 0 {
+		n += 2 + sovPps(uint64(m.MaxQueuedJobs))
+	}
+	if m.Canary {
+		n += 3
+	}
+	l = len(m.CanarySampleBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.CanarySamplePercent != 0 {
+		n += 2 + sovPps(uint64(m.CanarySamplePercent))
+	}
+	if m.VersionedOutput {
+		n += 3
+	}
+	if m.UploadConcurrency != 0 {
+		n += 2 + sovPps(uint64(m.UploadConcurrency))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.PauseWindow != nil {
+		l = m.PauseWindow.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.JobTimeoutWarning != nil {
+		l = m.JobTimeoutWarning.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.MLflow != nil {
+		l = m.MLflow.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.StatsService != nil {
+		l = m.StatsService.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.LogRetention != nil {
+		l = m.LogRetention.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if len(m.ExtraOutputs) > 0 {
+		for _, s := range m.ExtraOutputs {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.SharedCache != nil {
+		l = m.SharedCache.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumTimeoutSpec != nil {
+		l = m.DatumTimeoutSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InspectPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StartPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StopPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RerunPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Exclude) > 0 {
+		for _, e := range m.Exclude {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Include) > 0 {
+		for _, e := range m.Include {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemoryBytes != 0 {
+		n += 1 + sovPps(uint64(m.MemoryBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GarbageCollectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateAuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetKubeEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KubeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastSeen != nil {
+		l = m.LastSeen.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatumTimeoutSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Base != nil {
+		l = m.Base.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PerGB != nil {
+		l = m.PerGB.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SharedCacheSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.MountPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Size_)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.StorageClass)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MLflowSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrackingURI)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Experiment)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatsServiceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.TensorBoard {
+		n += 2
+	}
+	if m.ExternalPort != 0 {
+		n += 1 + sovPps(uint64(m.ExternalPort))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NotificationSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.On) > 0 {
+		for _, s := range m.On {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.Template)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PromotePipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateDAGRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateDAGResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPps(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPps(x uint64) (n int) {
+	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Secret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AcceptReturnCode) == 0 {
+					m.AcceptReturnCode = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkingDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dockerfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dockerfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCmd = append(m.InitCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitStdin = append(m.InitStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeardownCmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeardownCmd = append(m.TeardownCmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeardownStdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeardownStdin = append(m.TeardownStdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Egress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PFSInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmptyFiles = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitInput{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
+			}
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Coefficient = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashtreeSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashtreeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
+			}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *ActivateAuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *ActivateAuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovPps(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozPps(x uint64) (n int) {
-	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Secret) Unmarshal(dAtA []byte) error {
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11477,17 +17755,17 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11497,29 +17775,33 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11529,29 +17811,33 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPath = string(dAtA[iNdEx:postIndex])
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvVar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11561,29 +17847,52 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvVar = string(dAtA[iNdEx:postIndex])
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
-			var stringLen uint64
+			m.UploadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11593,24 +17902,11 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UploadBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -11636,7 +17932,7 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transform) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11659,17 +17955,17 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11679,29 +17975,33 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11711,27 +18011,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11758,109 +18062,144 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Env[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
+			}
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11870,29 +18209,27 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secrets = append(m.Secrets, &Secret{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11920,89 +18257,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPps
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPps
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AcceptReturnCode) == 0 {
-					m.AcceptReturnCode = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12012,17 +18273,31 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Debug = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12032,29 +18307,33 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12064,29 +18343,33 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkingDir", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
 			}
-			var stringLen uint64
+			m.QueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12096,29 +18379,16 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QueueSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkingDir = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dockerfile", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedChunks", wireType)
 			}
-			var stringLen uint64
+			m.QueuedChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12128,29 +18398,35 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.QueuedChunks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningChunks", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RunningChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunningChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Dockerfile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCmd", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedChunks", wireType)
 			}
-			var stringLen uint64
+			m.FinishedChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12160,29 +18436,16 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FinishedChunks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrCmd = append(m.ErrCmd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrStdin", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunksProcessed", wireType)
 			}
-			var stringLen uint64
+			m.ChunksProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12192,24 +18455,11 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChunksProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrStdin = append(m.ErrStdin, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -12235,7 +18485,7 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Egress) Unmarshal(dAtA []byte) error {
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12258,15 +18508,26 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12294,67 +18555,45 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Job) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Disk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12364,23 +18603,27 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
+			}
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12407,7 +18650,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12430,53 +18673,15 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
-			}
-			m.InternalPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InternalPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
-			}
-			m.ExternalPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12504,67 +18709,13 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Spout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			var v int
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12574,12 +18725,11 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -12605,7 +18755,7 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PFSInput) Unmarshal(dAtA []byte) error {
+func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12628,17 +18778,17 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: EtcdJobInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EtcdJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12648,29 +18798,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12680,29 +18834,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12712,27 +18870,217 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			}
+			m.Restart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			}
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			}
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			}
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			}
+			m.DataFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFailed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
+			}
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12760,13 +19108,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12776,29 +19124,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12808,17 +19160,33 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			var v int
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12828,12 +19196,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EmptyFiles = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -12859,7 +19226,7 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronInput) Unmarshal(dAtA []byte) error {
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12882,17 +19249,17 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12902,29 +19269,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12934,29 +19305,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12966,29 +19341,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -12998,27 +19377,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13045,18 +19428,18 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &types.Timestamp{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13066,71 +19449,88 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GitInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13140,29 +19540,52 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+			}
+			m.PipelineVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13172,29 +19595,33 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13204,27 +19631,31 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13252,65 +19683,11 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Input) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13337,16 +19714,18 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
+			}
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13356,31 +19735,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13390,31 +19754,33 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13441,16 +19807,14 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitInput{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13477,70 +19841,52 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13567,18 +19913,18 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13588,29 +19934,33 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
+			}
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			var v int
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13620,17 +19970,16 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 4:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13640,83 +19989,33 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 32:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			m.Constant = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13726,81 +20025,49 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.EnableStats = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Coefficient = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HashtreeSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashtreeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			m.Constant = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13810,68 +20077,15 @@ func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputFile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+			m.Batch = bool(v != 0)
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13899,13 +20113,13 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13915,85 +20129,33 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14003,27 +20165,31 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
+			}
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14050,72 +20216,54 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			var msglen int
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14125,33 +20273,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 41:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			m.State = 0
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14161,14 +20292,14 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14195,18 +20326,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14216,33 +20347,29 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
-			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14252,85 +20379,29 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14340,114 +20411,52 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransformCaching", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14457,33 +20466,17 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.TransformCaching = bool(v != 0)
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14493,31 +20486,27 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeoutWarning", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14544,51 +20533,13 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
+			if m.JobTimeoutWarning == nil {
+				m.JobTimeoutWarning = &types.Duration{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeoutWarning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
-			}
-			m.DownloadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DownloadBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
-			}
-			m.UploadBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UploadBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14614,7 +20565,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *Worker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14637,17 +20588,17 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14657,33 +20608,29 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14693,31 +20640,68 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= WorkerState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14744,54 +20728,70 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
-			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JobInfo = append(m.JobInfo, &JobInfo{})
+			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14801,27 +20801,23 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
-			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14848,7 +20844,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14871,17 +20867,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14891,27 +20887,31 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14939,13 +20939,13 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14955,31 +20955,49 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14989,31 +21007,27 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15040,32 +21054,13 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.From == nil {
+				m.From = &pfs.Commit{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
-			}
-			m.QueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueueSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15091,7 +21086,7 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15114,26 +21109,169 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EtcdPipelineInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EtcdPipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= PipelineState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
+			}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.JobCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15161,11 +21299,11 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15193,103 +21331,13 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
-			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
 			}
-			var stringLen uint64
+			m.LastJobState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15299,29 +21347,16 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastJobState |= JobState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotedVersion", wireType)
 			}
-			m.Number = 0
+			m.PromotedVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15331,7 +21366,7 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				m.PromotedVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15361,7 +21396,7 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15384,49 +21419,13 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EtcdJobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EtcdJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
@@ -15462,9 +21461,9 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15491,56 +21490,18 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.DataSkipped = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15550,16 +21511,33 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.DataTotal = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15569,16 +21547,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				m.State |= PipelineState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
 			}
-			m.DataFailed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15588,14 +21566,27 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15622,16 +21613,79 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.JobCounts[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15658,37 +21712,18 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15698,27 +21733,14 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15745,18 +21767,18 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15766,33 +21788,29 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
-			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.DataRecovered = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15802,68 +21820,27 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15890,16 +21867,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15926,16 +21903,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15962,18 +21939,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15983,33 +21960,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
-			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16019,33 +21992,49 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+			m.EnableStats = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16055,33 +22044,49 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+			m.Batch = bool(v != 0)
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16091,33 +22096,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
 			}
-			m.State = 0
+			m.MaxQueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16127,14 +22128,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
+				m.MaxQueueSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16161,18 +22162,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
-			m.PipelineVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16182,14 +22183,31 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16216,16 +22234,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16252,16 +22270,52 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithookURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16289,11 +22343,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.GithookURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16320,18 +22374,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 37:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
 			}
-			m.Restart = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16341,16 +22395,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
+			m.Standby = bool(v != 0)
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
 			}
-			m.DataProcessed = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16360,16 +22415,17 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
+			m.Stopped = bool(v != 0)
+		case 39:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			m.DataTotal = 0
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16379,14 +22435,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16413,16 +22469,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
+			}
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16432,31 +22490,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
-			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashtreeSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16483,16 +22537,67 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.HashtreeSpec == nil {
+				m.HashtreeSpec = &HashtreeSpec{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HashtreeSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+			}
+			m.LastJobState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastJobState |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16519,16 +22624,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 46:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16555,18 +22660,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			if m.Notification == nil {
+				m.Notification = &NotificationSpec{}
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 47:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransformCaching", wireType)
 			}
-			m.DataSkipped = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16576,16 +22681,55 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+			m.TransformCaching = bool(v != 0)
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueuedJobs", wireType)
+			}
+			m.MaxQueuedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueuedJobs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySamplePercent", wireType)
+			}
+			m.CanarySamplePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanarySamplePercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySampleBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16595,31 +22739,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanarySampleBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 32:
+		case 51:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionedOutput", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16636,12 +22776,82 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 33:
+			m.VersionedOutput = bool(v != 0)
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotedVersion", wireType)
+			}
+			m.PromotedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromotedVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadConcurrency", wireType)
+			}
+			m.UploadConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadConcurrency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16651,29 +22861,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			if m.PauseWindow == nil {
+				m.PauseWindow = &PauseWindow{}
+			}
+			if err := m.PauseWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeoutWarning", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16683,15 +22897,31 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Batch = bool(v != 0)
-		case 35:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeoutWarning == nil {
+				m.JobTimeoutWarning = &types.Duration{}
+			}
+			if err := m.JobTimeoutWarning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthPrincipal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16719,11 +22949,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.AuthPrincipal = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MLflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16750,16 +22980,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.MLflow == nil {
+				m.MLflow = &MLflowSpec{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MLflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsService", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16786,18 +23016,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
+			if m.StatsService == nil {
+				m.StatsService = &StatsServiceSpec{}
 			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatsService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsServiceURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16807,31 +23037,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StatsServiceURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 39:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogRetention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16858,18 +23084,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.LogRetention == nil {
+				m.LogRetention = &types.Duration{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOutputs", wireType)
 			}
-			m.DataFailed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16879,16 +23105,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 41:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DatumTries = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraOutputs = append(m.ExtraOutputs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedCache", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16898,14 +23137,31 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 42:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SharedCache == nil {
+				m.SharedCache = &SharedCacheSpec{}
+			}
+			if err := m.SharedCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeoutSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16932,18 +23188,72 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.DatumTimeoutSpec == nil {
+				m.DatumTimeoutSpec = &DatumTimeoutSpec{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeoutSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16953,29 +23263,85 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 44:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16985,27 +23351,31 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 45:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17032,32 +23402,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 46:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
-			}
-			m.DataRecovered = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17083,7 +23434,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker) Unmarshal(dAtA []byte) error {
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17106,17 +23457,17 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17126,29 +23477,33 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17158,11 +23513,48 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= WorkerState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockState = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17188,7 +23580,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfos) Unmarshal(dAtA []byte) error {
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17211,15 +23603,15 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17246,8 +23638,80 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfo = append(m.JobInfo, &JobInfo{})
-			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17276,7 +23740,7 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
+func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17299,17 +23763,17 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17319,23 +23783,59 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, &pfs.Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPipelines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ToPipelines = append(m.ToPipelines, &Pipeline{})
+			if err := m.ToPipelines[len(m.ToPipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17362,7 +23862,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInput) Unmarshal(dAtA []byte) error {
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17385,15 +23885,15 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17420,18 +23920,18 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &pfs.Repo{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17441,29 +23941,109 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OlderThan", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17473,29 +24053,87 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.OlderThan == nil {
+				m.OlderThan = &types.Duration{}
+			}
+			if err := m.OlderThan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17505,17 +24143,33 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17525,60 +24179,104 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.From == nil {
-				m.From = &pfs.Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17604,7 +24302,7 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17627,34 +24325,15 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EtcdPipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EtcdPipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17681,16 +24360,16 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17717,79 +24396,16 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			var mapkey int32
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.JobCounts[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17817,13 +24433,33 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17833,29 +24469,33 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			m.LastJobState = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17865,11 +24505,63 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			m.Tail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tail |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17895,7 +24587,7 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17918,89 +24610,17 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18010,50 +24630,27 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &types.Timestamp{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= PipelineState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18081,11 +24678,11 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18112,79 +24709,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
-			}
-			var mapkey int32
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.JobCounts[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18211,37 +24743,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Ts == nil {
+				m.Ts = &types.Timestamp{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18251,31 +24764,27 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18303,11 +24812,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.User = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18335,13 +24864,13 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18351,31 +24880,69 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Master = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &types.Duration{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 19:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18402,18 +24969,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18423,33 +24990,83 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 21:
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18459,29 +25076,87 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 23:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18491,29 +25166,33 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 24:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var v int
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18523,17 +25202,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PageSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18543,49 +25221,70 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Batch = bool(v != 0)
-		case 28:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18595,29 +25294,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
+			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 29:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
 			}
-			m.MaxQueueSize = 0
+			m.TotalPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18627,16 +25328,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQueueSize |= int64(b&0x7F) << shift
+				m.TotalPages |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18646,31 +25347,68 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 31:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDatumStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDatumStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18697,18 +25435,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.DatumInfo == nil {
+				m.DatumInfo = &DatumInfo{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
 			}
-			var msglen int
+			m.TotalPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18718,33 +25456,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalPages |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
-			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 33:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var msglen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18754,33 +25475,70 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Page |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 34:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18790,33 +25548,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 35:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithookURL", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18826,27 +25567,68 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GithookURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 36:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18873,38 +25655,109 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
-			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.Standby = bool(v != 0)
-		case 38:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18914,36 +25767,29 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
-		case 39:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DatumTries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 40:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18953,33 +25799,83 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
-			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Arch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 41:
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18989,27 +25885,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 42:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashtreeSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19036,18 +25936,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HashtreeSpec == nil {
-				m.HashtreeSpec = &HashtreeSpec{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.HashtreeSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 43:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastJobState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.LastJobState = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19057,16 +25957,17 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastJobState |= JobState(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 44:
+			m.Update = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19076,27 +25977,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 45:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19123,70 +26028,48 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19213,68 +26096,16 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &types.Duration{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19301,16 +26132,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19337,72 +26168,50 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19412,31 +26221,27 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19453,12 +26258,12 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.BlockState = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			m.EnableStats = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19468,85 +26273,54 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Reprocess = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Batch = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxQueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueueSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19573,16 +26347,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19609,14 +26383,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19643,70 +26419,16 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlushJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19733,14 +26455,16 @@ func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &pfs.Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
+			}
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToPipelines", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19767,70 +26491,18 @@ func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToPipelines = append(m.ToPipelines, &Pipeline{})
-			if err := m.ToPipelines[len(m.ToPipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19840,85 +26512,66 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Standby = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19945,72 +26598,18 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20020,31 +26619,27 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashtreeSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20071,16 +26666,16 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.HashtreeSpec == nil {
+				m.HashtreeSpec = &HashtreeSpec{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HashtreeSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20108,13 +26703,13 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20124,15 +26719,31 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20159,16 +26770,16 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.Notification == nil {
+				m.Notification = &NotificationSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Notification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 35:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransformCaching", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20185,12 +26796,12 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Follow = bool(v != 0)
-		case 8:
+			m.TransformCaching = bool(v != 0)
+		case 36:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueuedJobs", wireType)
 			}
-			m.Tail = 0
+			m.MaxQueuedJobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20200,68 +26811,34 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tail |= int64(b&0x7F) << shift
+				m.MaxQueuedJobs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Canary = bool(v != 0)
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySampleBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20289,13 +26866,13 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			m.CanarySampleBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySamplePercent", wireType)
 			}
-			var stringLen uint64
+			m.CanarySamplePercent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20305,29 +26882,16 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CanarySamplePercent |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionedOutput", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20337,31 +26901,17 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			m.VersionedOutput = bool(v != 0)
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadConcurrency", wireType)
 			}
-			var msglen int
+			m.UploadConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20371,31 +26921,14 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UploadConcurrency |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Ts == nil {
-				m.Ts = &types.Timestamp{}
-			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20423,13 +26956,13 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20439,29 +26972,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.PauseWindow == nil {
+				m.PauseWindow = &PauseWindow{}
+			}
+			if err := m.PauseWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeoutWarning", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20471,17 +27008,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.User = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeoutWarning == nil {
+				m.JobTimeoutWarning = &types.Duration{}
+			}
+			if err := m.JobTimeoutWarning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MLflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20491,29 +27044,33 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatumID = string(dAtA[iNdEx:postIndex])
+			if m.MLflow == nil {
+				m.MLflow = &MLflowSpec{}
+			}
+			if err := m.MLflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsService", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20523,69 +27080,31 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StatsService == nil {
+				m.StatsService = &StatsServiceSpec{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StatsService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogRetention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20612,16 +27131,16 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.LogRetention == nil {
+				m.LogRetention = &types.Duration{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOutputs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20649,65 +27168,47 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.ExtraOutputs = append(m.ExtraOutputs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedCache", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SharedCache == nil {
+				m.SharedCache = &SharedCacheSpec{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SharedCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeoutSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20734,10 +27235,10 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
+			if m.DatumTimeoutSpec == nil {
+				m.DatumTimeoutSpec = &DatumTimeoutSpec{}
 			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeoutSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20766,7 +27267,7 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20789,15 +27290,15 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20824,51 +27325,13 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -20894,7 +27357,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20917,84 +27380,12 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
-			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
-			}
-			m.TotalPages = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPages |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21020,7 +27411,7 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21043,15 +27434,15 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21078,110 +27469,18 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumInfo == nil {
-				m.DatumInfo = &DatumInfo{}
-			}
-			if err := m.DatumInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
-			}
-			m.TotalPages = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPages |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			m.Number = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21191,16 +27490,17 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.All = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.SizeBytes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21210,11 +27510,12 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21240,7 +27541,7 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
+func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21263,15 +27564,15 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21298,107 +27599,16 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21426,7 +27636,7 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21453,7 +27663,7 @@ func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21476,10 +27686,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21520,9 +27730,9 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21532,87 +27742,81 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Update = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21639,18 +27843,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21660,27 +27864,29 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.Exclude = append(m.Exclude, &pfs.Commit{})
+			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21707,18 +27913,70 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &types.Duration{}
-			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Include = append(m.Include, &pfs.Commit{})
+			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBytes", wireType)
+			}
+			m.MemoryBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -21728,31 +27986,230 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MemoryBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateAuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateAuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateAuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateAuthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateAuthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateAuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetKubeEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetKubeEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetKubeEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21779,195 +28236,71 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheSize = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableStats = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reprocess = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Batch = bool(v != 0)
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueSize", wireType)
-			}
-			m.MaxQueueSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxQueueSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *KubeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 22:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21994,18 +28327,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.LastSeen == nil {
+				m.LastSeen = &types.Timestamp{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastSeen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22015,33 +28348,29 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
-			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22051,33 +28380,29 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
-			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22087,31 +28412,27 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Object = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22139,13 +28460,13 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Standby", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22155,17 +28476,71 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Standby = bool(v != 0)
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DatumTries = 0
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DatumTimeoutSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumTimeoutSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumTimeoutSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22175,14 +28550,31 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 29:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Base == nil {
+				m.Base = &types.Duration{}
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerGB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22209,16 +28601,103 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
+			if m.PerGB == nil {
+				m.PerGB = &types.Duration{}
 			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PerGB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SharedCacheSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharedCacheSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharedCacheSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22246,13 +28725,13 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.MountPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 31:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashtreeSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22262,31 +28741,27 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HashtreeSpec == nil {
-				m.HashtreeSpec = &HashtreeSpec{}
-			}
-			if err := m.HashtreeSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Size_ = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 32:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22314,13 +28789,13 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.StorageClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 33:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22330,28 +28805,12 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
-			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22377,7 +28836,8 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PauseWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22400,17 +28860,17 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22420,82 +28880,60 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Duration == nil {
+				m.Duration = &types.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22521,7 +28959,8 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+
+func (m *MLflowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22544,17 +28983,17 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MLflowSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MLflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingURI", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22564,127 +29003,29 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TrackingURI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22694,27 +29035,23 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Experiment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22741,7 +29078,8 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+
+func (m *StatsServiceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22764,17 +29102,17 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatsServiceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatsServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22784,28 +29122,63 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TensorBoard", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TensorBoard = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22831,7 +29204,8 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
+
+func (m *NotificationSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22854,17 +29228,17 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22874,33 +29248,29 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field On", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22910,31 +29280,29 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, &pfs.Commit{})
-			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.On = append(m.On, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22944,25 +29312,23 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = append(m.Include, &pfs.Commit{})
-			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22989,7 +29355,8 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PromotePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23012,17 +29379,17 @@ func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GarbageCollectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GarbageCollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			m.MemoryBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23032,11 +29399,28 @@ func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemoryBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23062,7 +29446,8 @@ func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
+
+func (m *ValidateDAGRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23085,10 +29470,10 @@ func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GarbageCollectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateDAGRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GarbageCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateDAGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -23116,7 +29501,8 @@ func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateAuthRequest) Unmarshal(dAtA []byte) error {
+
+func (m *ValidateDAGResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23139,66 +29525,76 @@ func (m *ActivateAuthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateAuthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateDAGResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateAuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateDAGResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActivateAuthResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateAuthResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateAuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23224,6 +29620,7 @@ func (m *ActivateAuthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipPps(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
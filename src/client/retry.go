@@ -0,0 +1,136 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+)
+
+// retryableMethods is the set of RPCs that are safe to retry automatically:
+// they're read-only, so re-sending them can't duplicate work.
+var retryableMethods = map[string]bool{
+	"/pfs.API/ListRepo":            true,
+	"/pfs.API/InspectRepo":         true,
+	"/pfs.API/ListCommit":          true,
+	"/pfs.API/InspectCommit":       true,
+	"/pfs.API/ListBranch":          true,
+	"/pfs.API/InspectBranch":       true,
+	"/pfs.API/ListFile":            true,
+	"/pfs.API/InspectFile":         true,
+	"/pfs.API/GlobFile":            true,
+	"/pps.API/ListJob":             true,
+	"/pps.API/InspectJob":          true,
+	"/pps.API/ListPipeline":        true,
+	"/pps.API/InspectPipeline":     true,
+	"/pps.API/ListDatum":           true,
+	"/pps.API/InspectDatum":        true,
+	"/versionpb.API/GetVersion":    true,
+	"/versionpb.API/GetAPIVersion": true,
+}
+
+// Circuit breaker defaults; see WithRetries.
+const (
+	breakerFailureThreshold = 5
+	breakerCooldown         = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned (wrapped in a gRPC Unavailable status) when the
+// client's circuit breaker has tripped: pachd has failed several calls in a
+// row and the cooldown hasn't elapsed, so calls fail fast instead of
+// hammering a down server.
+var ErrCircuitOpen = fmt.Errorf("pachd is unavailable (circuit breaker is open; it will be retried shortly)")
+
+// WithRetries instructs the New* functions to automatically retry idempotent
+// (read-only) RPCs up to 'retries' times with jittered exponential backoff
+// when pachd is unavailable, and to fail fast with ErrCircuitOpen once pachd
+// has failed several calls in a row.
+func WithRetries(retries int) Option {
+	return func(settings *clientSettings) error {
+		if retries < 0 {
+			return fmt.Errorf("retries must be non-negative, is %d", retries)
+		}
+		settings.maxRetries = retries
+		return nil
+	}
+}
+
+// breaker is a simple consecutive-failure circuit breaker shared by all RPCs
+// on one client connection.
+type breaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// check returns an error if the circuit is open.
+func (b *breaker) check() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures >= breakerFailureThreshold && time.Now().Before(b.openUntil) {
+		return ErrCircuitOpen
+	}
+	return nil
+}
+
+// observe records the outcome of a call. Only connectivity errors count
+// against the breaker; application errors (e.g. NotFound) reset it, since
+// they prove the server is reachable.
+func (b *breaker) observe(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err != nil && status.Code(err) == codes.Unavailable {
+		b.failures++
+		if b.failures >= breakerFailureThreshold {
+			b.openUntil = time.Now().Add(breakerCooldown)
+		}
+		return
+	}
+	b.failures = 0
+}
+
+// isRetryable reports whether 'err' indicates a transient condition worth
+// retrying (as opposed to an application error that will recur).
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// newRetryInterceptor returns a unary client interceptor implementing the
+// retry and circuit-breaking policy for a single client connection.
+func newRetryInterceptor(maxRetries int) grpc.UnaryClientInterceptor {
+	b := &breaker{}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := b.check(); err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		// NewExponentialBackOff randomizes each interval, so concurrent
+		// callers don't retry in lockstep.
+		bo := backoff.NewExponentialBackOff()
+		for attempt := 0; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			b.observe(err)
+			if err == nil || !retryableMethods[method] || !isRetryable(err) || attempt >= maxRetries {
+				return err
+			}
+			next := bo.NextBackOff()
+			if next == backoff.Stop {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(next):
+			}
+		}
+	}
+}
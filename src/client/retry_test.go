@@ -0,0 +1,121 @@
+package client
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestBreaker(t *testing.T) {
+	b := &breaker{}
+	require.NoError(t, b.check())
+	// Connectivity errors below the threshold don't open the circuit
+	for i := 0; i < breakerFailureThreshold-1; i++ {
+		b.observe(status.Error(codes.Unavailable, "connection refused"))
+		require.NoError(t, b.check())
+	}
+	// ...but the threshold-th consecutive failure does
+	b.observe(status.Error(codes.Unavailable, "connection refused"))
+	require.YesError(t, b.check())
+	require.Equal(t, ErrCircuitOpen, b.check())
+	// A success closes it again
+	b.observe(nil)
+	require.NoError(t, b.check())
+}
+
+func TestBreakerIgnoresApplicationErrors(t *testing.T) {
+	b := &breaker{}
+	for i := 0; i < breakerFailureThreshold-1; i++ {
+		b.observe(status.Error(codes.Unavailable, "connection refused"))
+	}
+	// An application error proves the server is reachable, so it resets the
+	// failure count rather than tripping the breaker
+	b.observe(status.Error(codes.NotFound, "repo not found"))
+	require.NoError(t, b.check())
+	for i := 0; i < breakerFailureThreshold-1; i++ {
+		b.observe(status.Error(codes.Unavailable, "connection refused"))
+		require.NoError(t, b.check())
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	require.False(t, isRetryable(nil))
+	require.True(t, isRetryable(status.Error(codes.Unavailable, "x")))
+	require.True(t, isRetryable(status.Error(codes.ResourceExhausted, "x")))
+	require.True(t, isRetryable(status.Error(codes.Aborted, "x")))
+	require.False(t, isRetryable(status.Error(codes.NotFound, "x")))
+	require.False(t, isRetryable(status.Error(codes.InvalidArgument, "x")))
+}
+
+// invokeCounter returns a grpc.UnaryInvoker that fails with 'err' for the
+// first 'failures' calls and then succeeds, along with a count of calls made.
+func invokeCounter(failures int, err error) (grpc.UnaryInvoker, *int) {
+	calls := new(int)
+	return func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		*calls++
+		if *calls <= failures {
+			return err
+		}
+		return nil
+	}, calls
+}
+
+func TestRetryInterceptorRetries(t *testing.T) {
+	interceptor := newRetryInterceptor(2)
+	invoker, calls := invokeCounter(1, status.Error(codes.Unavailable, "connection refused"))
+	err := interceptor(context.Background(), "/pfs.API/ListRepo", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, 2, *calls)
+}
+
+func TestRetryInterceptorOnlyRetriesIdempotentRPCs(t *testing.T) {
+	interceptor := newRetryInterceptor(2)
+	invoker, calls := invokeCounter(1, status.Error(codes.Unavailable, "connection refused"))
+	err := interceptor(context.Background(), "/pfs.API/PutFile", nil, nil, nil, invoker)
+	require.YesError(t, err)
+	require.Equal(t, 1, *calls)
+}
+
+func TestRetryInterceptorOnlyRetriesTransientErrors(t *testing.T) {
+	interceptor := newRetryInterceptor(2)
+	invoker, calls := invokeCounter(1, status.Error(codes.NotFound, "repo not found"))
+	err := interceptor(context.Background(), "/pfs.API/ListRepo", nil, nil, nil, invoker)
+	require.YesError(t, err)
+	require.Equal(t, 1, *calls)
+}
+
+func TestRetryInterceptorFailsFastWhenCircuitOpens(t *testing.T) {
+	interceptor := newRetryInterceptor(0)
+	invoker, calls := invokeCounter(breakerFailureThreshold+1, status.Error(codes.Unavailable, "connection refused"))
+	for i := 0; i < breakerFailureThreshold; i++ {
+		err := interceptor(context.Background(), "/pfs.API/ListRepo", nil, nil, nil, invoker)
+		require.YesError(t, err)
+	}
+	require.Equal(t, breakerFailureThreshold, *calls)
+	// The breaker is now open, so further calls fail without reaching the
+	// invoker
+	err := interceptor(context.Background(), "/pfs.API/ListRepo", nil, nil, nil, invoker)
+	require.YesError(t, err)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.True(t, strings.Contains(err.Error(), "circuit breaker"), "unexpected error: %v", err)
+	require.Equal(t, breakerFailureThreshold, *calls)
+}
+
+func TestRetryInterceptorRespectsContext(t *testing.T) {
+	interceptor := newRetryInterceptor(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // already canceled, so the interceptor shouldn't sleep between attempts
+	invoker, calls := invokeCounter(100, status.Error(codes.Unavailable, "connection refused"))
+	start := time.Now()
+	err := interceptor(ctx, "/pfs.API/ListRepo", nil, nil, nil, invoker)
+	require.YesError(t, err)
+	require.Equal(t, 1, *calls)
+	require.True(t, time.Since(start) < 5*time.Second, "interceptor kept retrying on a canceled context")
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// DefaultRPCTimeout is the RPC timeout applied by NewOnUserMachine when no
+// WithRPCTimeout option is given. pachctl sets it from its global --timeout
+// flag; the zero value means RPCs have no deadline.
+var DefaultRPCTimeout time.Duration
+
+// WithRPCTimeout instructs the New* functions to set a deadline of 't' on
+// every RPC the client sends (as opposed to WithDialTimeout, which only
+// bounds establishing the connection). Unary RPCs must complete within 't';
+// streaming RPCs treat 't' as an inactivity timeout and fail if no message is
+// sent or received for that long, so that long-running streams (e.g. 'pachctl
+// logs -f') aren't cut off while they're making progress.
+func WithRPCTimeout(t time.Duration) Option {
+	return func(settings *clientSettings) error {
+		settings.rpcTimeout = t
+		return nil
+	}
+}
+
+// newTimeoutInterceptor returns a unary client interceptor that sets a
+// deadline of 'timeout' on every RPC.
+func newTimeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// newStreamTimeoutInterceptor returns a stream client interceptor that
+// cancels a stream if no message is sent or received on it for 'timeout'
+// (i.e. 'timeout' is an inactivity timeout, not a bound on the stream's total
+// duration).
+func newStreamTimeoutInterceptor(timeout time.Duration) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		timer := time.AfterFunc(timeout, cancel)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			timer.Stop()
+			cancel()
+			return nil, err
+		}
+		return &timeoutClientStream{
+			ClientStream: stream,
+			timeout:      timeout,
+			timer:        timer,
+			cancel:       cancel,
+		}, nil
+	}
+}
+
+// timeoutClientStream wraps a grpc.ClientStream, resetting its inactivity
+// timer whenever a message crosses the stream in either direction.
+type timeoutClientStream struct {
+	grpc.ClientStream
+	timeout time.Duration
+	timer   *time.Timer
+	cancel  context.CancelFunc
+}
+
+func (s *timeoutClientStream) SendMsg(m interface{}) error {
+	s.timer.Reset(s.timeout)
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *timeoutClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		// The stream is over (RecvMsg also returns io.EOF on clean shutdown),
+		// so stop the timer and release the stream's context.
+		s.timer.Stop()
+		s.cancel()
+		return err
+	}
+	s.timer.Reset(s.timeout)
+	return nil
+}
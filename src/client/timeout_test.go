@@ -0,0 +1,101 @@
+package client
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestTimeoutInterceptorSetsDeadline(t *testing.T) {
+	interceptor := newTimeoutInterceptor(time.Minute)
+	var deadline time.Time
+	var hasDeadline bool
+	err := interceptor(context.Background(), "/pfs.API/ListRepo", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			deadline, hasDeadline = ctx.Deadline()
+			return nil
+		})
+	require.NoError(t, err)
+	require.True(t, hasDeadline)
+	require.True(t, time.Until(deadline) <= time.Minute)
+}
+
+// fakeClientStream implements grpc.ClientStream for testing
+// timeoutClientStream; its SendMsg and RecvMsg return the configured errors.
+type fakeClientStream struct {
+	sendErr error
+	recvErr error
+}
+
+func (s *fakeClientStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *fakeClientStream) Trailer() metadata.MD         { return nil }
+func (s *fakeClientStream) CloseSend() error             { return nil }
+func (s *fakeClientStream) Context() context.Context     { return context.Background() }
+func (s *fakeClientStream) SendMsg(m interface{}) error  { return s.sendErr }
+func (s *fakeClientStream) RecvMsg(m interface{}) error  { return s.recvErr }
+
+// newTestTimeoutStream builds a timeoutClientStream around 'fake' whose
+// inactivity timeout is 'timeout', returning the context that the timer
+// cancels.
+func newTestTimeoutStream(fake *fakeClientStream, timeout time.Duration) (*timeoutClientStream, context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	timer := time.AfterFunc(timeout, cancel)
+	return &timeoutClientStream{
+		ClientStream: fake,
+		timeout:      timeout,
+		timer:        timer,
+		cancel:       cancel,
+	}, ctx
+}
+
+func TestStreamTimeoutFiresWhenIdle(t *testing.T) {
+	_, ctx := newTestTimeoutStream(&fakeClientStream{}, 50*time.Millisecond)
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("inactivity timer never fired on an idle stream")
+	}
+}
+
+func TestStreamTimeoutResetsOnActivity(t *testing.T) {
+	stream, ctx := newTestTimeoutStream(&fakeClientStream{}, 250*time.Millisecond)
+	// Keep the stream active for several multiples of the timeout; the timer
+	// should keep getting pushed back
+	for i := 0; i < 10; i++ {
+		time.Sleep(100 * time.Millisecond)
+		if i%2 == 0 {
+			require.NoError(t, stream.SendMsg(nil))
+		} else {
+			require.NoError(t, stream.RecvMsg(nil))
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("inactivity timer fired on an active stream")
+		default:
+		}
+	}
+	// Once the stream goes idle, the timer fires
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("inactivity timer never fired after the stream went idle")
+	}
+}
+
+func TestStreamTimeoutStopsOnRecvError(t *testing.T) {
+	stream, ctx := newTestTimeoutStream(&fakeClientStream{recvErr: io.EOF}, time.Hour)
+	require.Equal(t, io.EOF, stream.RecvMsg(nil))
+	// RecvMsg returning an error ends the stream, which should release the
+	// stream's context immediately rather than holding it for the timeout
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream context wasn't released when the stream ended")
+	}
+}
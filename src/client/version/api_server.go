@@ -23,6 +23,13 @@ func (a *apiServer) GetVersion(ctx context.Context, request *types.Empty) (respo
 	return a.version, nil
 }
 
+func (a *apiServer) GetAPIVersion(ctx context.Context, request *types.Empty) (response *pb.APIVersion, err error) {
+	return &pb.APIVersion{
+		Version:      a.version,
+		Capabilities: Capabilities,
+	}, nil
+}
+
 // APIServerOptions are options when creating a new APIServer.
 type APIServerOptions struct {
 	DisableLogging bool
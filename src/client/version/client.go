@@ -32,6 +32,35 @@ var (
 	}
 )
 
+// Named API capabilities advertised by this build via GetAPIVersion. Clients
+// check these with APIClient.ServerSupports before sending requests that
+// depend on them.
+const (
+	// CapabilitySpouts indicates support for spout pipelines.
+	CapabilitySpouts = "spouts"
+	// CapabilityNotifications indicates support for webhook notification
+	// specs on pipelines.
+	CapabilityNotifications = "notifications"
+	// CapabilityEvents indicates support for the event API
+	// (SubscribeEvents).
+	CapabilityEvents = "events"
+	// CapabilityS3Gateway indicates support for the S3 gateway.
+	CapabilityS3Gateway = "s3-gateway"
+	// CapabilityCanary indicates support for canary pipeline updates and
+	// PromotePipeline.
+	CapabilityCanary = "canary"
+)
+
+// Capabilities is the set of API capabilities supported by this build; it's
+// what the server returns from GetAPIVersion.
+var Capabilities = []string{
+	CapabilitySpouts,
+	CapabilityNotifications,
+	CapabilityEvents,
+	CapabilityS3Gateway,
+	CapabilityCanary,
+}
+
 // PrettyPrintVersion returns a version string optionally tagged with metadata.
 // For example: "1.2.3", or "1.2.3rc1" if version.Additional is "rc1".
 func PrettyPrintVersion(version *pb.Version) string {
@@ -95,8 +95,67 @@ func (m *Version) GetAdditional() string {
 	return ""
 }
 
+// APIVersion describes the server's version along with the named API features
+// it supports, so clients can fail with actionable errors instead of cryptic
+// unmarshalling failures when client and server versions drift.
+type APIVersion struct {
+	Version              *Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Capabilities         []string `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIVersion) Reset()         { *m = APIVersion{} }
+func (m *APIVersion) String() string { return proto.CompactTextString(m) }
+func (*APIVersion) ProtoMessage()    {}
+func (*APIVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66657ffe705dda95, []int{1}
+}
+func (m *APIVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APIVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIVersion.Merge(m, src)
+}
+func (m *APIVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIVersion proto.InternalMessageInfo
+
+func (m *APIVersion) GetVersion() *Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *APIVersion) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Version)(nil), "versionpb.Version")
+	proto.RegisterType((*APIVersion)(nil), "versionpb.APIVersion")
 }
 
 func init() {
@@ -104,22 +163,31 @@ func init() {
 }
 
 var fileDescriptor_66657ffe705dda95 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0xc9, 0x4c,
-	0xcd, 0x2b, 0xd1, 0x2f, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0x83, 0xd1, 0x05, 0x49, 0x30, 0x96,
-	0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x27, 0x5c, 0x42, 0x4a, 0x3a, 0x3d, 0x3f, 0x3f, 0x3d,
-	0x27, 0x55, 0x1f, 0x2c, 0x91, 0x54, 0x9a, 0xa6, 0x9f, 0x9a, 0x5b, 0x50, 0x52, 0x09, 0x51, 0xa7,
-	0x94, 0xcd, 0xc5, 0x1e, 0x06, 0x51, 0x29, 0x24, 0xc2, 0xc5, 0x9a, 0x9b, 0x98, 0x95, 0x5f, 0x24,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1b, 0x04, 0xe1, 0x80, 0x45, 0x33, 0xf3, 0xf2, 0x8b, 0x24, 0x98,
-	0xa0, 0xa2, 0x20, 0x0e, 0x44, 0x34, 0xb9, 0x28, 0x5f, 0x82, 0x19, 0x26, 0x9a, 0x5c, 0x94, 0x2f,
-	0x24, 0xc7, 0xc5, 0x95, 0x98, 0x92, 0x92, 0x59, 0x92, 0x99, 0x9f, 0x97, 0x98, 0x23, 0xc1, 0xa2,
-	0xc0, 0xa8, 0xc1, 0x19, 0x84, 0x24, 0x62, 0xe4, 0xc8, 0xc5, 0xec, 0x18, 0xe0, 0x29, 0x64, 0xc5,
-	0xc5, 0xe5, 0x9e, 0x5a, 0x02, 0xb3, 0x56, 0x4c, 0x0f, 0xe2, 0x3e, 0x3d, 0x98, 0xfb, 0xf4, 0x5c,
-	0x41, 0xee, 0x93, 0x12, 0xd2, 0x83, 0x7b, 0x41, 0x0f, 0xaa, 0x56, 0x89, 0xc1, 0xc9, 0xf7, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf1, 0x58, 0x8e, 0x21,
-	0xca, 0x3a, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x20, 0x31, 0x39,
-	0xa3, 0x32, 0x25, 0xb5, 0x08, 0x99, 0x55, 0x5c, 0x94, 0xac, 0x8f, 0x2b, 0xd0, 0x92, 0xd8, 0xc0,
-	0x96, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x9d, 0xba, 0x62, 0x57, 0x01, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x52,
+	0x4b, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x2f, 0x4b, 0x2d, 0x2a, 0xce,
+	0xcc, 0xcf, 0x83, 0xd1, 0x05, 0x49, 0x30, 0x96, 0x5e, 0x41, 0x51, 0x7e,
+	0x49, 0xbe, 0x10, 0x27, 0x5c, 0x42, 0x4a, 0x3a, 0x3d, 0x3f, 0x3f, 0x3d,
+	0x27, 0x55, 0x1f, 0x2c, 0x91, 0x54, 0x9a, 0xa6, 0x9f, 0x9a, 0x5b, 0x50,
+	0x52, 0x09, 0x51, 0xa7, 0x94, 0xcd, 0xc5, 0x1e, 0x06, 0x51, 0x29, 0x24,
+	0xc2, 0xc5, 0x9a, 0x9b, 0x98, 0x95, 0x5f, 0x24, 0xc1, 0xa8, 0xc0, 0xa8,
+	0xc1, 0x1b, 0x04, 0xe1, 0x80, 0x45, 0x33, 0xf3, 0x80, 0xa2, 0x4c, 0x50,
+	0x51, 0x10, 0x07, 0x22, 0x9a, 0x5c, 0x94, 0x2f, 0xc1, 0x0c, 0x13, 0x05,
+	0x72, 0x84, 0xe4, 0xb8, 0xb8, 0x12, 0x53, 0x52, 0x32, 0x4b, 0x80, 0xa6,
+	0x25, 0xe6, 0x48, 0xb0, 0x00, 0xa5, 0x38, 0x83, 0x90, 0x44, 0x94, 0xe2,
+	0xb8, 0xb8, 0x1c, 0x03, 0x3c, 0x61, 0xf6, 0xe9, 0x70, 0xb1, 0x43, 0x1d,
+	0x09, 0xb6, 0x91, 0xdb, 0x48, 0x48, 0x0f, 0xee, 0x68, 0x3d, 0xa8, 0xa2,
+	0x20, 0x98, 0x12, 0x21, 0x25, 0x2e, 0x9e, 0xe4, 0xc4, 0x82, 0xc4, 0xa4,
+	0xcc, 0x1c, 0xa0, 0x69, 0xa9, 0xc5, 0x40, 0xe7, 0x30, 0x03, 0x4d, 0x47,
+	0x11, 0x33, 0x6a, 0x66, 0xe4, 0x62, 0x06, 0x5a, 0x20, 0x64, 0xc5, 0xc5,
+	0xe5, 0x9e, 0x5a, 0x02, 0xb3, 0x47, 0x4c, 0x0f, 0x12, 0x00, 0x7a, 0xb0,
+	0x00, 0xd0, 0x73, 0x05, 0x05, 0x80, 0x14, 0x16, 0xeb, 0x94, 0x18, 0x84,
+	0x1c, 0xb8, 0x78, 0x81, 0x7a, 0x91, 0x9c, 0x89, 0x4b, 0xbb, 0x28, 0x92,
+	0x76, 0x84, 0x72, 0x25, 0x06, 0x27, 0xdf, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
+	0x00, 0xf1, 0x03, 0x20, 0x9e, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3a, 0x3d,
+	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x20, 0x31,
+	0x39, 0xa3, 0x32, 0x25, 0xb5, 0x08, 0x99, 0x55, 0x5c, 0x94, 0xac, 0x8f,
+	0x2b, 0x5e, 0x93, 0xd8, 0xc0, 0xf6, 0x1a, 0x03, 0x00, 0xa7, 0xeb, 0x8b,
+	0xbf, 0xfa, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -135,6 +203,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type APIClient interface {
 	GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Version, error)
+	GetAPIVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*APIVersion, error)
 }
 
 type aPIClient struct {
@@ -154,9 +223,19 @@ func (c *aPIClient) GetVersion(ctx context.Context, in *types.Empty, opts ...grp
 	return out, nil
 }
 
+func (c *aPIClient) GetAPIVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*APIVersion, error) {
+	out := new(APIVersion)
+	err := c.cc.Invoke(ctx, "/versionpb.API/GetAPIVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 type APIServer interface {
 	GetVersion(context.Context, *types.Empty) (*Version, error)
+	GetAPIVersion(context.Context, *types.Empty) (*APIVersion, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -181,6 +260,24 @@ func _API_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetAPIVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetAPIVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/versionpb.API/GetAPIVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetAPIVersion(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "versionpb.API",
 	HandlerType: (*APIServer)(nil),
@@ -189,6 +286,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _API_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetAPIVersion",
+			Handler:    _API_GetAPIVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "client/version/versionpb/version.proto",
@@ -236,6 +337,52 @@ func (m *Version) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *APIVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIVersion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(m.Version.Size()))
+		n1, err1 := m.Version.MarshalTo(dAtA[i:])
+		if err1 != nil {
+			return 0, err1
+		}
+		i += n1
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintVersion(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -270,6 +417,28 @@ func (m *Version) Size() (n int) {
 	return n
 }
 
+func (m *APIVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovVersion(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovVersion(x uint64) (n int) {
 	for {
 		n++
@@ -426,6 +595,129 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *APIVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVersion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVersion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipVersion(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,10 +3,17 @@ package cmds
 import (
 	"fmt"
 	"os"
+	"text/tabwriter"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 
+	units "github.com/docker/go-units"
 	"github.com/golang/snappy"
 	"github.com/spf13/cobra"
 )
@@ -93,10 +100,274 @@ $ {{alias}} -u s3://bucket/backup`,
 				return err
 			}
 			fmt.Println(ci.ID)
+			printLicenseState(ci)
 			return nil
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(inspectCluster, "inspect cluster"))
 
+	rotateKey := &cobra.Command{
+		Short: "Re-encrypt sensitive metadata under the cluster's current KMS key.",
+		Long: `Re-encrypt sensitive metadata under the cluster's current KMS key.
+
+Run this after rotating the cluster's KMS key (e.g. after prepending a new
+master key to LOCAL_KMS_KEYS, or rotating the AWS/GCP KMS key) so that every
+stored value is wrapped by the new key and the old one can be retired.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			rotated, err := c.RotateKey()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Re-encrypted %d value(s).\n", rotated)
+			return nil
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(rotateKey, "admin rotate-key"))
+
+	var concurrency int64
+	var throttle string
+	var dryRun bool
+	rebalanceObjects := &cobra.Command{
+		Short: "Re-chunk and re-upload objects under the current storage settings.",
+		Long: `Re-chunk and re-upload objects under the current storage settings.
+
+Run this after migrating a cluster from an old version or changing its
+chunking settings, so stored objects regain the dedup and read-performance
+benefits of the current storage layout. The old blocks become garbage and are
+reclaimed by the next garbage collection.`,
+		Example: `
+# See how much data a rebalance would rewrite:
+$ {{alias}} --dry-run
+
+# Rebalance a live cluster, capped at 50MB/s:
+$ {{alias}} --throttle 50M`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var throttleBytes int64
+			if throttle != "" {
+				throttleBytes, err = units.RAMInBytes(throttle)
+				if err != nil {
+					return fmt.Errorf("could not parse --throttle: %v", err)
+				}
+			}
+			var last *admin.RebalanceObjectsResponse
+			if err := c.RebalanceObjects(&admin.RebalanceObjectsRequest{
+				Concurrency:         concurrency,
+				ThrottleBytesPerSec: throttleBytes,
+				DryRun:              dryRun,
+			}, func(resp *admin.RebalanceObjectsResponse) error {
+				last = resp
+				fmt.Printf("\r%d/%d objects (%s)", resp.ObjectsRewritten,
+					resp.ObjectsTotal, units.BytesSize(float64(resp.BytesRewritten)))
+				return nil
+			}); err != nil {
+				fmt.Println()
+				return err
+			}
+			fmt.Println()
+			if last != nil && dryRun {
+				fmt.Printf("Dry run: a rebalance would rewrite %d object(s) (%s).\n",
+					last.ObjectsRewritten, units.BytesSize(float64(last.BytesRewritten)))
+			}
+			return nil
+		}),
+	}
+	rebalanceObjects.Flags().Int64Var(&concurrency, "concurrency", 0, "Number of objects to rewrite in parallel (default 10).")
+	rebalanceObjects.Flags().StringVar(&throttle, "throttle", "", "Cap the rewrite throughput at this many bytes per second, e.g. \"50M\" (default: unlimited).")
+	rebalanceObjects.Flags().BoolVar(&dryRun, "dry-run", false, "Only report what a rebalance would rewrite.")
+	commands = append(commands, cmdutil.CreateAlias(rebalanceObjects, "admin rebalance-objects"))
+
+	var fromURL string
+	var toURL string
+	var noVerify bool
+	migrateStorage := &cobra.Command{
+		Short: "Copy the cluster's stored objects to another object store.",
+		Long: `Copy the cluster's stored objects to another object store.
+
+Every object is copied and read back from the destination to verify its
+content, and objects that already exist in the destination are skipped, so an
+interrupted migration can safely be re-run. Once the copy finishes, redeploy
+pachd against the new backend with the STORAGE_FALLBACK_URL environment
+variable pointing at the old store; during that dual-read cutover window
+reads of objects written after the copy fall back to the old store, and you
+can re-run this command to pick them up before unsetting the variable.`,
+		Example: `
+# Move a cluster's data from S3 to GCS:
+$ {{alias}} --to gs://new-bucket`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if err := c.MigrateStorage(&admin.MigrateStorageRequest{
+				FromURL:     fromURL,
+				ToURL:       toURL,
+				Concurrency: concurrency,
+				NoVerify:    noVerify,
+			}, func(resp *admin.MigrateStorageResponse) error {
+				fmt.Printf("\r%d object(s) copied, %d skipped (%s)", resp.ObjectsCopied,
+					resp.ObjectsSkipped, units.BytesSize(float64(resp.BytesCopied)))
+				return nil
+			}); err != nil {
+				fmt.Println()
+				return err
+			}
+			fmt.Println()
+			oldStore := fromURL
+			if oldStore == "" {
+				oldStore = "<the old store's URL>"
+			}
+			fmt.Printf("Migration complete. Redeploy pachd against the new backend with "+
+				"STORAGE_FALLBACK_URL=%s set for the cutover window, then unset it once "+
+				"you've verified the new store.\n", oldStore)
+			return nil
+		}),
+	}
+	migrateStorage.Flags().StringVar(&fromURL, "from", "", "Object store URL to copy from (default: the cluster's current storage backend).")
+	migrateStorage.Flags().StringVar(&toURL, "to", "", "Object store URL to copy to, e.g. gs://new-bucket (required).")
+	migrateStorage.Flags().Int64Var(&concurrency, "concurrency", 0, "Number of objects to copy in parallel (default 10).")
+	migrateStorage.Flags().BoolVar(&noVerify, "no-verify", false, "Don't read objects back from the destination to verify their content.")
+	commands = append(commands, cmdutil.CreateAlias(migrateStorage, "admin migrate-storage"))
+
+	var principal string
+	var repo string
+	setBandwidthLimit := &cobra.Command{
+		Use:   "{{alias}} <bytes-per-sec>",
+		Short: "Cap the PutFile/GetFile throughput of a principal or a repo.",
+		Long: `Cap the PutFile/GetFile throughput of a principal or a repo.
+
+Limits are enforced server-side with token buckets, so a bulk backfill can't
+starve interactive users or pipeline traffic. A limit of 0 removes the cap.`,
+		Example: `
+# Cap the robot user doing a backfill at 10MB/s:
+$ {{alias}} --principal robot:backfill 10M
+
+# Cap transfers on the "logs" repo at 50MB/s:
+$ {{alias}} --repo logs 50M
+
+# Remove the cap on the "logs" repo:
+$ {{alias}} --repo logs 0`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			var bytesPerSec int64
+			if args[0] != "0" {
+				bytesPerSec, err = units.RAMInBytes(args[0])
+				if err != nil {
+					return fmt.Errorf("could not parse %q: %v", args[0], err)
+				}
+			}
+			return c.SetBandwidthLimit(&pfs.BandwidthLimit{
+				Principal:   principal,
+				Repo:        repo,
+				BytesPerSec: bytesPerSec,
+			})
+		}),
+	}
+	setBandwidthLimit.Flags().StringVar(&principal, "principal", "", "The principal (e.g. a user or robot name) to limit.")
+	setBandwidthLimit.Flags().StringVar(&repo, "repo", "", "The repo to limit.")
+	commands = append(commands, cmdutil.CreateAlias(setBandwidthLimit, "admin set-bandwidth-limit"))
+
+	listBandwidthLimit := &cobra.Command{
+		Short: "List the configured bandwidth limits.",
+		Long:  "List the configured bandwidth limits.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			limits, err := c.ListBandwidthLimit()
+			if err != nil {
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 8, 1, 3, ' ', 0)
+			fmt.Fprintln(writer, "PRINCIPAL\tREPO\tLIMIT")
+			for _, limit := range limits {
+				fmt.Fprintf(writer, "%s\t%s\t%s/s\n", limit.Principal, limit.Repo,
+					units.BytesSize(float64(limit.BytesPerSec)))
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(listBandwidthLimit, "admin list-bandwidth-limit"))
+
+	var namespace string
+	readOnly := &cobra.Command{
+		Use:   "{{alias}} (on|off)",
+		Short: "Turn pachd's read-only mode on or off.",
+		Long: `Turn pachd's read-only mode on or off.
+
+In read-only mode all mutating RPCs fail with a clear error while reads keep
+working; use it during migrations, incident response, and on clusters serving
+as DR replicas. This sets READ_ONLY on the pachd deployment, so the pachd pods
+restart to pick up the change.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			var value string
+			switch args[0] {
+			case "on":
+				value = "true"
+			case "off":
+				value = "false"
+			default:
+				return fmt.Errorf("argument must be \"on\" or \"off\", got %q", args[0])
+			}
+			io := cmdutil.IO{
+				Stdout: os.Stdout,
+				Stderr: os.Stderr,
+			}
+			if err := cmdutil.RunIO(io, "kubectl", "set", "env", "deployment", "pachd", "--namespace", namespace, fmt.Sprintf("READ_ONLY=%s", value)); err != nil {
+				return err
+			}
+			fmt.Printf("Read-only mode will be %s once the pachd pods have restarted.\n", args[0])
+			return nil
+		}),
+	}
+	readOnly.Flags().StringVar(&namespace, "namespace", "default", "Kubernetes namespace Pachyderm is deployed in.")
+	commands = append(commands, cmdutil.CreateAlias(readOnly, "admin read-only"))
+
+	commands = append(commands, etcdCmds()...)
+
 	return commands
 }
+
+// printLicenseState prints the cluster's enterprise license state (if any),
+// warning if the license is about to expire or already has.
+func printLicenseState(ci *admin.ClusterInfo) {
+	if ci.LicenseState == enterprise.State_NONE {
+		return
+	}
+	expiration, err := types.TimestampFromProto(ci.LicenseExpires)
+	if err != nil {
+		return
+	}
+	switch ci.LicenseState {
+	case enterprise.State_ACTIVE:
+		if until := time.Until(expiration); until < 30*24*time.Hour {
+			fmt.Printf("WARNING: enterprise license expires in %v (%v)\n",
+				until.Truncate(time.Hour), expiration)
+		} else {
+			fmt.Printf("Enterprise license expires %v\n", expiration)
+		}
+	case enterprise.State_GRACE_PERIOD:
+		fmt.Printf("WARNING: enterprise license expired %v; enterprise features "+
+			"will be disabled when the grace period ends %v\n", expiration,
+			expiration.Add(enterprise.GracePeriod))
+	case enterprise.State_EXPIRED:
+		fmt.Printf("WARNING: enterprise license expired %v and its grace period "+
+			"has run out; enterprise features are disabled\n", expiration)
+	}
+}
@@ -0,0 +1,318 @@
+package cmds
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/api/option"
+)
+
+// etcdSnapshotPath is where snapshots are staged inside the etcd pod.
+const etcdSnapshotPath = "/tmp/pachyderm-etcd.snapshot"
+
+// etcdDataDir is etcd's data dir (and its persistent volume's mount path) in
+// our deployment manifests.
+const etcdDataDir = "/var/data/etcd"
+
+// kubectlOutput runs kubectl and returns its stdout.
+func kubectlOutput(args ...string) (string, error) {
+	var buf bytes.Buffer
+	if err := cmdutil.RunIO(cmdutil.IO{
+		Stdout: &buf,
+		Stderr: os.Stderr,
+	}, append([]string{"kubectl"}, args...)...); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// etcdPod returns the name of an etcd pod in 'namespace'.
+func etcdPod(namespace string) (string, error) {
+	pod, err := kubectlOutput("get", "pod", "-l", "app=etcd", "--namespace", namespace, "-o", "jsonpath={.items[0].metadata.name}")
+	if err != nil || pod == "" {
+		return "", fmt.Errorf("could not find an etcd pod in namespace %q: %v", namespace, err)
+	}
+	return pod, nil
+}
+
+// etcdExec runs a command in the etcd pod via kubectl.
+func etcdExec(namespace, pod string, ioObj cmdutil.IO, cmd ...string) error {
+	args := []string{"kubectl", "exec", pod, "--namespace", namespace}
+	if ioObj.Stdin != nil {
+		args = append(args, "-i")
+	}
+	args = append(args, "--")
+	args = append(args, cmd...)
+	return cmdutil.RunIO(ioObj, args...)
+}
+
+// quiescePachd scales the pachd deployment down so no metadata writes land
+// while etcd is being snapshotted or restored. It returns a function that
+// scales pachd back to its original size.
+func quiescePachd(namespace string) (func() error, error) {
+	replicas, err := kubectlOutput("get", "deployment", "pachd", "--namespace", namespace, "-o", "jsonpath={.spec.replicas}")
+	if err != nil {
+		return nil, fmt.Errorf("could not find the pachd deployment in namespace %q: %v", namespace, err)
+	}
+	fmt.Printf("Quiescing pachd (scaling the deployment from %s to 0 replicas)...\n", replicas)
+	io := cmdutil.IO{Stdout: os.Stdout, Stderr: os.Stderr}
+	if err := cmdutil.RunIO(io, "kubectl", "scale", "deployment", "pachd", "--namespace", namespace, fmt.Sprintf("--replicas=%s", "0")); err != nil {
+		return nil, err
+	}
+	return func() error {
+		fmt.Printf("Scaling pachd back to %s replicas...\n", replicas)
+		return cmdutil.RunIO(io, "kubectl", "scale", "deployment", "pachd", "--namespace", namespace, fmt.Sprintf("--replicas=%s", replicas))
+	}, nil
+}
+
+// storageSecret reads the cluster's storage secret, whose credentials are
+// used to reach the object store from the user's machine.
+func storageSecret(namespace string) (map[string][]byte, error) {
+	var buf bytes.Buffer
+	if err := cmdutil.RunIO(cmdutil.IO{
+		Stdout: &buf,
+		Stderr: os.Stderr,
+	}, "kubectl", "get", "secret", client.StorageSecretName, "--namespace", namespace, "-o", "json"); err != nil {
+		return nil, fmt.Errorf("could not read the storage secret %q: %v", client.StorageSecretName, err)
+	}
+	var secret struct {
+		Data map[string][]byte `json:"data"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &secret); err != nil {
+		return nil, err
+	}
+	return secret.Data, nil
+}
+
+// objClientFromSecret constructs an object-store client for 'url' using the
+// credentials in the cluster's storage secret, so that snapshots can be moved
+// to and from the object store without creds on the user's machine.
+func objClientFromSecret(url *obj.ObjectStoreURL, namespace string) (obj.Client, error) {
+	data, err := storageSecret(namespace)
+	if err != nil {
+		return nil, err
+	}
+	get := func(key string) string { return strings.TrimSpace(string(data[key])) }
+	switch url.Store {
+	case "s3":
+		if get("amazon-region") != "" {
+			return obj.NewAmazonClient(get("amazon-region"), url.Bucket, &obj.AmazonCreds{
+				ID:     get("amazon-id"),
+				Secret: get("amazon-secret"),
+				Token:  get("amazon-token"),
+			}, get("amazon-distribution"))
+		}
+		if get("minio-endpoint") != "" {
+			return obj.NewMinioClient(get("minio-endpoint"), url.Bucket, get("minio-id"), get("minio-secret"), get("minio-secure") == "1", get("minio-signature") == "1")
+		}
+		return nil, fmt.Errorf("the storage secret has neither amazon nor minio credentials")
+	case "gcs", "gs":
+		cred := get("google-cred")
+		if cred == "" {
+			return nil, fmt.Errorf("the storage secret has no google credentials")
+		}
+		return obj.NewGoogleClient(url.Bucket, []option.ClientOption{option.WithCredentialsJSON([]byte(cred))})
+	case "as", "wasb":
+		return obj.NewMicrosoftClient(url.Bucket, get("microsoft-id"), get("microsoft-secret"))
+	}
+	return nil, fmt.Errorf("unrecognized object store: %s", url.Store)
+}
+
+// etcdCmds returns commands for snapshotting and restoring the etcd metadata
+// store.
+func etcdCmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	var namespace string
+	snapshotEtcd := &cobra.Command{
+		Use:   "{{alias}} <destination>",
+		Short: "Snapshot the etcd metadata store to an object store or local file.",
+		Long: `Snapshot the etcd metadata store to an object store or local file.
+
+Pachd is scaled down while the snapshot is taken so no metadata writes are in
+flight, the snapshot's integrity is verified with "etcdctl snapshot status"
+before it is copied out of the cluster, and pachd is scaled back up afterwards.
+Note that the snapshot covers cluster metadata only (repos, commits, pipelines,
+jobs), not the file contents in the object store.`,
+		Example: `
+# Snapshot to s3, using the credentials in the cluster's storage secret:
+$ {{alias}} s3://bucket/pachyderm.snapshot
+
+# Snapshot to a local file:
+$ {{alias}} ./pachyderm.snapshot`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			pod, err := etcdPod(namespace)
+			if err != nil {
+				return err
+			}
+			resume, err := quiescePachd(namespace)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := resume(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			io := cmdutil.IO{Stdout: os.Stdout, Stderr: os.Stderr}
+			fmt.Println("Taking etcd snapshot...")
+			if err := etcdExec(namespace, pod, io, "env", "ETCDCTL_API=3", "etcdctl", "--endpoints=http://127.0.0.1:2379", "snapshot", "save", etcdSnapshotPath); err != nil {
+				return err
+			}
+			defer func() {
+				if err := etcdExec(namespace, pod, io, "rm", "-f", etcdSnapshotPath); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			fmt.Println("Verifying snapshot integrity...")
+			if err := etcdExec(namespace, pod, io, "env", "ETCDCTL_API=3", "etcdctl", "snapshot", "status", etcdSnapshotPath); err != nil {
+				return fmt.Errorf("snapshot failed its integrity check: %v", err)
+			}
+			if strings.Contains(args[0], "://") {
+				url, err := obj.ParseURL(args[0])
+				if err != nil {
+					return err
+				}
+				objClient, err := objClientFromSecret(url, namespace)
+				if err != nil {
+					return err
+				}
+				w, err := objClient.Writer(context.Background(), url.Object)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := w.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				if err := etcdExec(namespace, pod, cmdutil.IO{Stdout: w, Stderr: os.Stderr}, "cat", etcdSnapshotPath); err != nil {
+					return err
+				}
+			} else {
+				if err := cmdutil.RunIO(io, "kubectl", "cp", fmt.Sprintf("%s/%s:%s", namespace, pod, etcdSnapshotPath), args[0]); err != nil {
+					return err
+				}
+			}
+			fmt.Printf("Snapshot written to %s. Restore it with \"pachctl admin restore-etcd %s\".\n", args[0], args[0])
+			return nil
+		}),
+	}
+	snapshotEtcd.Flags().StringVar(&namespace, "namespace", "default", "Kubernetes namespace Pachyderm is deployed in.")
+	commands = append(commands, cmdutil.CreateAlias(snapshotEtcd, "admin snapshot-etcd"))
+
+	var restoreNamespace string
+	restoreEtcd := &cobra.Command{
+		Use:   "{{alias}} <source>",
+		Short: "Restore the etcd metadata store from a snapshot.",
+		Long: `Restore the etcd metadata store from a snapshot taken with
+"pachctl admin snapshot-etcd".
+
+Pachd is scaled down, the snapshot's integrity is verified with "etcdctl
+snapshot status", the restored data replaces etcd's data directory (the old
+data is kept next to it as "member-old"), the etcd pod is restarted, and pachd
+is scaled back up.`,
+		Example: `
+# Restore from s3, using the credentials in the cluster's storage secret:
+$ {{alias}} s3://bucket/pachyderm.snapshot
+
+# Restore from a local file:
+$ {{alias}} ./pachyderm.snapshot`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			fmt.Println(`Restoring will replace all cluster metadata (repos, commits, pipelines, and
+jobs) with the snapshot's contents. The current metadata is kept in etcd's
+volume as "member-old" until the next restore.`)
+			fmt.Println("Are you sure you want to do this? (y/n):")
+			r := bufio.NewReader(os.Stdin)
+			bytes, err := r.ReadBytes('\n')
+			if err != nil {
+				return err
+			}
+			if bytes[0] != 'y' && bytes[0] != 'Y' {
+				return nil
+			}
+			pod, err := etcdPod(restoreNamespace)
+			if err != nil {
+				return err
+			}
+			resume, err := quiescePachd(restoreNamespace)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := resume(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			io := cmdutil.IO{Stdout: os.Stdout, Stderr: os.Stderr}
+			fmt.Println("Copying snapshot into the etcd pod...")
+			if strings.Contains(args[0], "://") {
+				url, err := obj.ParseURL(args[0])
+				if err != nil {
+					return err
+				}
+				objClient, err := objClientFromSecret(url, restoreNamespace)
+				if err != nil {
+					return err
+				}
+				reader, err := objClient.Reader(context.Background(), url.Object, 0, 0)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := reader.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				if err := etcdExec(restoreNamespace, pod, cmdutil.IO{Stdin: reader, Stdout: os.Stdout, Stderr: os.Stderr}, "sh", "-c", fmt.Sprintf("cat > %s", etcdSnapshotPath)); err != nil {
+					return err
+				}
+			} else {
+				if err := cmdutil.RunIO(io, "kubectl", "cp", args[0], fmt.Sprintf("%s/%s:%s", restoreNamespace, pod, etcdSnapshotPath)); err != nil {
+					return err
+				}
+			}
+			fmt.Println("Verifying snapshot integrity...")
+			if err := etcdExec(restoreNamespace, pod, io, "env", "ETCDCTL_API=3", "etcdctl", "snapshot", "status", etcdSnapshotPath); err != nil {
+				return fmt.Errorf("snapshot failed its integrity check: %v", err)
+			}
+			fmt.Println("Restoring snapshot into etcd's data directory...")
+			restoreScript := fmt.Sprintf(
+				"ETCDCTL_API=3 etcdctl snapshot restore %s --data-dir=%s/restored && "+
+					"rm -rf %s/member-old && "+
+					"mv %s/member %s/member-old && "+
+					"mv %s/restored/member %s/member && "+
+					"rm -rf %s/restored %s",
+				etcdSnapshotPath, etcdDataDir,
+				etcdDataDir,
+				etcdDataDir, etcdDataDir,
+				etcdDataDir, etcdDataDir,
+				etcdDataDir, etcdSnapshotPath)
+			if err := etcdExec(restoreNamespace, pod, io, "sh", "-c", restoreScript); err != nil {
+				return err
+			}
+			// etcd only reads its data dir at startup, so restart the pod to
+			// pick up the restored data.
+			fmt.Println("Restarting etcd...")
+			if err := cmdutil.RunIO(io, "kubectl", "delete", "pod", pod, "--namespace", restoreNamespace); err != nil {
+				return err
+			}
+			fmt.Println("Metadata restored.")
+			return nil
+		}),
+	}
+	restoreEtcd.Flags().StringVar(&restoreNamespace, "namespace", "default", "Kubernetes namespace Pachyderm is deployed in.")
+	commands = append(commands, cmdutil.CreateAlias(restoreEtcd, "admin restore-etcd"))
+
+	return commands
+}
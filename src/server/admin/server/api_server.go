@@ -2,25 +2,38 @@ package server
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"path"
 	"regexp"
 	"sync"
 	"time"
 
+	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/golang/snappy"
 	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kms"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 )
 
 var objHashRE = regexp.MustCompile("[0-9a-f]{128}")
@@ -29,13 +42,412 @@ type apiServer struct {
 	log.Logger
 	address        string
 	storageRoot    string // for downloading/converting hashtrees
+	etcdClient     *etcd.Client
+	pfsEtcdPrefix  string
+	ppsEtcdPrefix  string
 	pachClient     *client.APIClient
 	pachClientOnce sync.Once
 	clusterInfo    *admin.ClusterInfo
 }
 
 func (a *apiServer) InspectCluster(ctx context.Context, request *types.Empty) (*admin.ClusterInfo, error) {
-	return a.clusterInfo, nil
+	result := &admin.ClusterInfo{
+		ID: a.clusterInfo.ID,
+	}
+	// Include the enterprise license state, so that an expiring license is
+	// visible to 'pachctl inspect cluster' (failure to get it shouldn't break
+	// InspectCluster, though)
+	pachClient := a.getPachClient().WithCtx(ctx)
+	if resp, err := pachClient.Enterprise.GetState(pachClient.Ctx(),
+		&enterprise.GetStateRequest{}); err == nil {
+		result.LicenseState = resp.State
+		if resp.Info != nil {
+			result.LicenseExpires = resp.Info.Expires
+		}
+	}
+	return result, nil
+}
+
+// RotateKey re-encrypts the sensitive metadata stored in etcd (currently
+// pipeline auth tokens) under the cluster's current KMS key. It's what makes
+// key rotation complete: after pointing the KMS backend at a new key (or
+// prepending a new local master key), running this re-wraps every stored
+// value so the old key can be retired.
+func (a *apiServer) RotateKey(ctx context.Context, request *admin.RotateKeyRequest) (response *admin.RotateKeyResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pipelines := ppsdb.Pipelines(a.etcdClient, a.ppsEtcdPrefix)
+	var pipelineNames []string
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := pipelines.ReadOnly(ctx).List(pipelinePtr, col.DefaultOptions, func(name string) error {
+		pipelineNames = append(pipelineNames, name)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	var rotated int64
+	for _, name := range pipelineNames {
+		var hadToken bool
+		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			hadToken = false
+			var pipelinePtr pps.EtcdPipelineInfo
+			return pipelines.ReadWrite(stm).Update(name, &pipelinePtr, func() error {
+				if pipelinePtr.AuthToken == "" {
+					return nil
+				}
+				token, err := kms.DecryptSensitive(ctx, pipelinePtr.AuthToken)
+				if err != nil {
+					return fmt.Errorf("error decrypting auth token for pipeline %q: %v", name, err)
+				}
+				pipelinePtr.AuthToken, err = kms.EncryptSensitive(ctx, token)
+				if err != nil {
+					return fmt.Errorf("error re-encrypting auth token for pipeline %q: %v", name, err)
+				}
+				hadToken = true
+				return nil
+			})
+		}); err != nil {
+			return nil, err
+		}
+		if hadToken {
+			rotated++
+		}
+	}
+	return &admin.RotateKeyResponse{Rotated: rotated}, nil
+}
+
+// Diagnose reports cluster health details that clients can't observe from
+// outside pachd: the server's clock (so 'pachctl doctor' can detect clock
+// skew) and whether the object store is reachable, checked by round-tripping
+// a small probe object.
+func (a *apiServer) Diagnose(ctx context.Context, request *admin.DiagnoseRequest) (response *admin.DiagnoseResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	response = &admin.DiagnoseResponse{
+		ServerTime: types.TimestampNow(),
+	}
+	if err := a.checkStorage(ctx); err != nil {
+		response.StorageError = err.Error()
+	} else {
+		response.StorageOK = true
+	}
+	return response, nil
+}
+
+// checkStorage writes, reads back and deletes a probe object to verify that
+// pachd can reach the cluster's object store.
+func (a *apiServer) checkStorage(ctx context.Context) (retErr error) {
+	objClient, err := obj.NewClientFromEnv(a.storageRoot)
+	if err != nil {
+		return fmt.Errorf("could not create object store client: %v", err)
+	}
+	name := path.Join(a.storageRoot, "diagnose", uuid.NewWithoutDashes())
+	defer func() {
+		if err := objClient.Delete(ctx, name); err != nil && !objClient.IsNotExist(err) && retErr == nil {
+			retErr = fmt.Errorf("could not delete probe object: %v", err)
+		}
+	}()
+	w, err := objClient.Writer(ctx, name)
+	if err != nil {
+		return fmt.Errorf("could not write probe object: %v", err)
+	}
+	if _, err := w.Write([]byte("pachyderm storage probe")); err != nil {
+		w.Close()
+		return fmt.Errorf("could not write probe object: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("could not write probe object: %v", err)
+	}
+	r, err := objClient.Reader(ctx, name, 0, 0)
+	if err != nil {
+		return fmt.Errorf("could not read probe object back: %v", err)
+	}
+	if _, err := ioutil.ReadAll(r); err != nil {
+		r.Close()
+		return fmt.Errorf("could not read probe object back: %v", err)
+	}
+	if err := r.Close(); err != nil {
+		return fmt.Errorf("could not read probe object back: %v", err)
+	}
+	return nil
+}
+
+const defaultRebalanceConcurrency = 10
+
+// rebalanceThrottle paces object rewrites so that the total bytes written
+// stays under 'rate' bytes per second (no-op if rate <= 0).
+type rebalanceThrottle struct {
+	mu    sync.Mutex
+	rate  int64
+	start time.Time
+	bytes int64
+}
+
+func (t *rebalanceThrottle) wait(n int64) {
+	if t.rate <= 0 {
+		return
+	}
+	t.mu.Lock()
+	t.bytes += n
+	// the elapsed time that 't.bytes' total bytes allows at 't.rate'
+	minElapsed := time.Duration(float64(t.bytes) / float64(t.rate) * float64(time.Second))
+	sleep := minElapsed - time.Since(t.start)
+	t.mu.Unlock()
+	if sleep > 0 {
+		time.Sleep(sleep)
+	}
+}
+
+// RebalanceObjects re-chunks and re-uploads every object under the cluster's
+// current chunking settings and storage layout. Because objects are content
+// addressed, rewriting an object doesn't change its hash (so nothing that
+// references it needs to be updated); it just moves the content into
+// freshly-written blocks, leaving the old ones as garbage for GC.
+func (a *apiServer) RebalanceObjects(request *admin.RebalanceObjectsRequest, rebalanceServer admin.API_RebalanceObjectsServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.getPachClient().WithCtx(rebalanceServer.Context())
+	concurrency := request.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultRebalanceConcurrency
+	}
+	// List the objects up front so that progress can report a total
+	var objects []*pfs.Object
+	if err := pachClient.ListObject(func(object *pfs.Object) error {
+		objects = append(objects, object)
+		return nil
+	}); err != nil {
+		return err
+	}
+	throttle := &rebalanceThrottle{rate: request.ThrottleBytesPerSec, start: time.Now()}
+	var mu sync.Mutex // guards progress and Send (streams don't allow concurrent Send)
+	progress := &admin.RebalanceObjectsResponse{ObjectsTotal: int64(len(objects))}
+	lastSent := time.Now()
+	if err := rebalanceServer.Send(progress); err != nil {
+		return err
+	}
+	var eg errgroup.Group
+	limiter := limit.New(int(concurrency))
+	for _, object := range objects {
+		object := object
+		limiter.Acquire()
+		eg.Go(func() (retErr error) {
+			defer limiter.Release()
+			objectInfo, err := pachClient.InspectObject(object.Hash)
+			if err != nil {
+				return err
+			}
+			size := int64(objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower)
+			if !request.DryRun {
+				throttle.wait(size)
+				r, w := io.Pipe()
+				go func() {
+					w.CloseWithError(pachClient.GetObject(object.Hash, w))
+				}()
+				if _, _, err := pachClient.PutObject(r); err != nil {
+					r.CloseWithError(err)
+					return fmt.Errorf("error rewriting object %s: %v", object.Hash, err)
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			progress.ObjectsRewritten++
+			progress.BytesRewritten += size
+			if time.Since(lastSent) > time.Second {
+				lastSent = time.Now()
+				return rebalanceServer.Send(progress)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return rebalanceServer.Send(progress)
+}
+
+// SetBandwidthLimit configures (or, with a BytesPerSec of 0, removes) a
+// per-principal or per-repo cap on PutFile/GetFile throughput. The limits are
+// stored in etcd and enforced by pfs with token buckets.
+func (a *apiServer) SetBandwidthLimit(ctx context.Context, request *admin.SetBandwidthLimitRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	limit := request.Limit
+	if limit == nil || (limit.Principal == "") == (limit.Repo == "") {
+		return nil, fmt.Errorf("must specify either a principal or a repo (but not both)")
+	}
+	if limit.BytesPerSec < 0 {
+		return nil, fmt.Errorf("bytes_per_sec must be >= 0")
+	}
+	limits := pfsdb.BandwidthLimits(a.etcdClient, a.pfsEtcdPrefix)
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		key := pfsdb.BandwidthLimitKey(limit)
+		if limit.BytesPerSec == 0 {
+			err := limits.ReadWrite(stm).Delete(key)
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return limits.ReadWrite(stm).Put(key, limit)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListBandwidthLimit returns the configured bandwidth limits.
+func (a *apiServer) ListBandwidthLimit(ctx context.Context, request *admin.ListBandwidthLimitRequest) (response *admin.ListBandwidthLimitResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	response = &admin.ListBandwidthLimitResponse{}
+	limits := pfsdb.BandwidthLimits(a.etcdClient, a.pfsEtcdPrefix)
+	limit := &pfs.BandwidthLimit{}
+	if err := limits.ReadOnly(ctx).List(limit, col.DefaultOptions, func(string) error {
+		response.Limit = append(response.Limit, proto.Clone(limit).(*pfs.BandwidthLimit))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// MigrateStorage copies every object under pachd's storage root from one
+// object store to another, so a cluster can be moved between cloud providers.
+// Each copy is verified by reading it back from the destination and comparing
+// content hashes (unless NoVerify is set), and objects that already exist in
+// the destination are skipped, so an interrupted migration can be resumed.
+func (a *apiServer) MigrateStorage(request *admin.MigrateStorageRequest, migrateServer admin.API_MigrateStorageServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := migrateServer.Context()
+	if request.ToURL == "" {
+		return fmt.Errorf("must specify an object store to migrate to")
+	}
+	var src obj.Client
+	var err error
+	prefix := a.storageRoot
+	if request.FromURL == "" {
+		src, err = obj.NewClientFromEnv(a.storageRoot)
+		if err != nil {
+			return fmt.Errorf("could not create client for the current storage backend: %v", err)
+		}
+	} else {
+		url, err := obj.ParseURL(request.FromURL)
+		if err != nil {
+			return fmt.Errorf("error parsing url %v: %v", request.FromURL, err)
+		}
+		src, err = obj.NewClientFromURLAndSecret(url, false)
+		if err != nil {
+			return err
+		}
+		prefix = url.Object
+	}
+	toURL, err := obj.ParseURL(request.ToURL)
+	if err != nil {
+		return fmt.Errorf("error parsing url %v: %v", request.ToURL, err)
+	}
+	dst, err := obj.NewClientFromURLAndSecret(toURL, false)
+	if err != nil {
+		return err
+	}
+	concurrency := request.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultRebalanceConcurrency
+	}
+	var mu sync.Mutex // guards progress and Send (streams don't allow concurrent Send)
+	progress := &admin.MigrateStorageResponse{}
+	lastSent := time.Now()
+	var eg errgroup.Group
+	limiter := limit.New(int(concurrency))
+	if err := src.Walk(ctx, prefix, func(name string) error {
+		limiter.Acquire()
+		eg.Go(func() (retErr error) {
+			defer limiter.Release()
+			if dst.Exists(ctx, name) {
+				mu.Lock()
+				defer mu.Unlock()
+				progress.ObjectsSkipped++
+				return nil
+			}
+			n, srcHash, err := a.copyObject(ctx, src, dst, name)
+			if err != nil {
+				return err
+			}
+			if !request.NoVerify {
+				dstHash, err := a.hashObject(ctx, dst, name)
+				if err != nil {
+					return fmt.Errorf("error reading %q back from the destination: %v", name, err)
+				}
+				if !bytes.Equal(srcHash, dstHash) {
+					return fmt.Errorf("content of %q differs between source and destination", name)
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			progress.ObjectsCopied++
+			progress.BytesCopied += n
+			if time.Since(lastSent) > time.Second {
+				lastSent = time.Now()
+				return migrateServer.Send(progress)
+			}
+			return nil
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return migrateServer.Send(progress)
+}
+
+// copyObject copies object 'name' from 'src' to 'dst', returning the number
+// of bytes copied and the sha256 of the content as it was read.
+func (a *apiServer) copyObject(ctx context.Context, src obj.Client, dst obj.Client, name string) (_ int64, _ []byte, retErr error) {
+	r, err := src.Reader(ctx, name, 0, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	w, err := dst.Writer(ctx, name)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(w, hash), r)
+	if err != nil {
+		return 0, nil, err
+	}
+	return n, hash.Sum(nil), nil
+}
+
+// hashObject returns the sha256 of object 'name' in 'objClient'.
+func (a *apiServer) hashObject(ctx context.Context, objClient obj.Client, name string) (_ []byte, retErr error) {
+	r, err := objClient.Reader(ctx, name, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
 }
 
 type opVersion int8
@@ -1,6 +1,8 @@
 package server
 
 import (
+	etcd "github.com/coreos/etcd/clientv3"
+
 	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 )
@@ -11,11 +13,14 @@ type APIServer interface {
 }
 
 // NewAPIServer returns a new admin.APIServer
-func NewAPIServer(address string, storageRoot string, clusterInfo *admin.ClusterInfo) APIServer {
+func NewAPIServer(address string, storageRoot string, etcdClient *etcd.Client, pfsEtcdPrefix string, ppsEtcdPrefix string, clusterInfo *admin.ClusterInfo) APIServer {
 	return &apiServer{
-		Logger:      log.NewLogger("admin.API"),
-		address:     address,
-		storageRoot: storageRoot,
-		clusterInfo: clusterInfo,
+		Logger:        log.NewLogger("admin.API"),
+		address:       address,
+		storageRoot:   storageRoot,
+		etcdClient:    etcdClient,
+		pfsEtcdPrefix: pfsEtcdPrefix,
+		ppsEtcdPrefix: ppsEtcdPrefix,
+		clusterInfo:   clusterInfo,
 	}
 }
@@ -3,7 +3,9 @@ package cmds
 import (
 	"bufio"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -19,6 +21,10 @@ import (
 
 var githubAuthLink = `https://github.com/login/oauth/authorize?client_id=d3481e92b4f09ea74ff8&redirect_uri=https%3A%2F%2Fpachyderm.io%2Flogin-hook%2Fdisplay-token.html`
 
+// k8sTokenPath is where Kubernetes mounts a pod's (projected) service account
+// token.
+const k8sTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
 func githubLogin() (string, error) {
 	fmt.Println("(1) Please paste this link into a browser:\n\n" +
 		githubAuthLink + "\n\n" +
@@ -139,6 +145,7 @@ func DeactivateCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 	var useOTP bool
+	var useK8SServiceAccount bool
 	login := &cobra.Command{
 		Short: "Log in to Pachyderm",
 		Long: "Login to Pachyderm. Any resources that have been restricted to " +
@@ -154,7 +161,16 @@ func LoginCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 			// Issue authentication request to Pachyderm and get response
 			var resp *auth.AuthenticateResponse
 			var authErr error
-			if useOTP {
+			if useK8SServiceAccount {
+				// Authenticate with this pod's projected service account token
+				token, err := ioutil.ReadFile(k8sTokenPath)
+				if err != nil {
+					return fmt.Errorf("error reading Kubernetes service account token (are you running inside a pod?): %v", err)
+				}
+				resp, authErr = c.Authenticate(
+					c.Ctx(),
+					&auth.AuthenticateRequest{K8SToken: strings.TrimSpace(string(token))})
+			} else if useOTP {
 				// Exhange short-lived Pachyderm auth code for long-lived Pachyderm token
 				fmt.Println("Please enter your Pachyderm One-Time Password:")
 				code, err := bufio.NewReader(os.Stdin).ReadString('\n')
@@ -193,6 +209,9 @@ func LoginCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 	login.PersistentFlags().BoolVarP(&useOTP, "one-time-password", "o", false,
 		"If set, authenticate with a Dash-provided One-Time Password, rather than "+
 			"via GitHub")
+	login.PersistentFlags().BoolVar(&useK8SServiceAccount, "k8s-service-account", false,
+		"If set, authenticate with this pod's Kubernetes service account token "+
+			"(only works inside a pod in the same cluster as pachd)")
 	return cmdutil.CreateAlias(login, "auth login")
 }
 
@@ -256,25 +275,44 @@ func WhoamiCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 // determine whether the specified user has access to the specified repo.
 func CheckCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 	check := &cobra.Command{
-		Use:   "{{alias}} (none|reader|writer|owner) <repo>",
+		Use:   "{{alias}} (none|reader|writer|owner) <repo>  or  {{alias}} repo <repo> [<principal>]",
 		Short: "Check whether you have reader/writer/etc-level access to 'repo'",
 		Long: "Check whether you have reader/writer/etc-level access to 'repo'. " +
 			"For example, 'pachctl auth check reader private-data' prints \"true\" " +
 			"if the you have at least \"reader\" access to the repo " +
 			"\"private-data\" (you could be a reader, writer, or owner). Unlike " +
 			"`pachctl auth get`, you do not need to have access to 'repo' to " +
-			"discover your own access level.",
-		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
-			scope, err := auth.ParseScope(args[0])
-			if err != nil {
-				return err
-			}
-			repo := args[1]
+			"discover your own access level. Alternatively, 'pachctl auth check " +
+			"repo <repo> [<principal>]' explains the principal's (by default, " +
+			"your own) effective access to 'repo': the resulting role, which " +
+			"binding (direct ACL entry, group entry, or admin status) grants " +
+			"it, and which operations it allows.",
+		Run: cmdutil.RunBoundedArgs(2, 3, func(args []string) error {
 			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return fmt.Errorf("could not connect: %v", err)
 			}
 			defer c.Close()
+			if args[0] == "repo" {
+				req := &auth.ExplainScopeRequest{Repo: args[1]}
+				if len(args) == 3 {
+					req.Username = args[2]
+				}
+				resp, err := c.ExplainScope(c.Ctx(), req)
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				printScopeExplanation(resp)
+				return nil
+			}
+			if len(args) != 2 {
+				return fmt.Errorf("expected two arguments: a scope and a repo")
+			}
+			scope, err := auth.ParseScope(args[0])
+			if err != nil {
+				return err
+			}
+			repo := args[1]
 			resp, err := c.Authorize(c.Ctx(), &auth.AuthorizeRequest{
 				Repo:  repo,
 				Scope: scope,
@@ -289,6 +327,51 @@ func CheckCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 	return cmdutil.CreateAlias(check, "auth check")
 }
 
+// printScopeExplanation renders an ExplainScopeResponse: the principal's
+// effective role, each binding that contributes to it, and the operations the
+// role allows.
+func printScopeExplanation(resp *auth.ExplainScopeResponse) {
+	fmt.Printf("Effective role: %s\n", strings.ToLower(resp.Scope.String()))
+	if resp.Admin {
+		fmt.Println("Granted by: cluster admin (admins are effective owners of every repo)")
+	} else if resp.Scope == resp.Direct && resp.Scope != auth.Scope_NONE {
+		fmt.Printf("Granted by: direct ACL entry (%s)\n", strings.ToLower(resp.Direct.String()))
+	} else {
+		for _, group := range sortedGroups(resp.Groups) {
+			if resp.Groups[group] == resp.Scope {
+				fmt.Printf("Granted by: group %q's ACL entry (%s)\n", group, strings.ToLower(resp.Scope.String()))
+				break
+			}
+		}
+	}
+	if resp.Direct != auth.Scope_NONE {
+		fmt.Printf("Direct ACL entry: %s\n", strings.ToLower(resp.Direct.String()))
+	}
+	for _, group := range sortedGroups(resp.Groups) {
+		fmt.Printf("Group %q ACL entry: %s\n", group, strings.ToLower(resp.Groups[group].String()))
+	}
+	switch resp.Scope {
+	case auth.Scope_NONE:
+		fmt.Println("Allowed operations: none (cannot read or write the repo's data)")
+	case auth.Scope_READER:
+		fmt.Println("Allowed operations: read the repo's data (get/list/glob file, subscribe commit)")
+	case auth.Scope_WRITER:
+		fmt.Println("Allowed operations: read and write the repo's data (put/delete file, start/finish commit)")
+	case auth.Scope_OWNER:
+		fmt.Println("Allowed operations: read and write the repo's data, and modify its ACL or delete it")
+	}
+}
+
+// sortedGroups returns the keys of 'groups' in a stable order.
+func sortedGroups(groups map[string]auth.Scope) []string {
+	var result []string
+	for group := range groups {
+		result = append(result, group)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // GetCmd returns a cobra command that gets either the ACL for a Pachyderm
 // repo or another user's scope of access to that repo
 func GetCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
 	"golang.org/x/sync/errgroup"
+	authv1 "k8s.io/api/authentication/v1"
 
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
 	enterpriseclient "github.com/pachyderm/pachyderm/src/client/enterprise"
@@ -229,6 +230,9 @@ func NewAuthServer(env *serviceenv.ServiceEnv, etcdPrefix string, public bool) (
 	}
 	// Watch for new auth config options
 	go s.watchConfig()
+	// Periodically sync group memberships from the configured directory (if
+	// any--see group_sync.go)
+	go s.groupSyncLoop()
 	return s, nil
 }
 
@@ -649,6 +653,32 @@ func GitHubTokenToUsername(ctx context.Context, oauthToken string) (string, erro
 	return authclient.GitHubPrefix + verifiedUsername, nil
 }
 
+// k8sTokenToUsername validates a Kubernetes service account token with the
+// cluster's TokenReview API and maps it to the Pachyderm principal
+// "k8s:<namespace>:<service account>". This lets pods in the same cluster
+// authenticate with their (projected, short-lived) service account tokens
+// instead of long-lived Pachyderm tokens.
+func (a *apiServer) k8sTokenToUsername(token string) (string, error) {
+	review, err := a.env.GetKubeClient().AuthenticationV1().TokenReviews().Create(&authv1.TokenReview{
+		Spec: authv1.TokenReviewSpec{
+			Token: token,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error reviewing Kubernetes token: %v", err)
+	}
+	if !review.Status.Authenticated {
+		return "", fmt.Errorf("Kubernetes rejected the service account token: %s", review.Status.Error)
+	}
+	// Service account usernames have the form
+	// "system:serviceaccount:<namespace>:<name>"
+	const saPrefix = "system:serviceaccount:"
+	if !strings.HasPrefix(review.Status.User.Username, saPrefix) {
+		return "", fmt.Errorf("Kubernetes token belongs to %q, not to a service account", review.Status.User.Username)
+	}
+	return authclient.KubernetesPrefix + strings.TrimPrefix(review.Status.User.Username, saPrefix), nil
+}
+
 func (a *apiServer) GetAdmins(ctx context.Context, req *authclient.GetAdminsRequest) (resp *authclient.GetAdminsResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
@@ -800,7 +830,7 @@ func (a *apiServer) expiredClusterAdminCheck(ctx context.Context, username strin
 	if err != nil {
 		return err
 	}
-	if state != enterpriseclient.State_ACTIVE && !isAdmin {
+	if !state.Entitled() && !isAdmin {
 		return errors.New("Pachyderm Enterprise is not active in this " +
 			"cluster (until Pachyderm Enterprise is re-activated or Pachyderm " +
 			"auth is deactivated, only cluster admins can perform any operations)")
@@ -853,6 +883,33 @@ func (a *apiServer) Authenticate(ctx context.Context, req *authclient.Authentica
 			return nil, fmt.Errorf("error storing auth token for user \"%s\": %v", username, err)
 		}
 
+	case req.K8SToken != "":
+		// Validate the Kubernetes service account token and map it to a
+		// Pachyderm principal
+		username, err := a.k8sTokenToUsername(req.K8SToken)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the cluster's enterprise token is expired, only admins may log in
+		if err := a.expiredClusterAdminCheck(ctx, username); err != nil {
+			return nil, err
+		}
+
+		// Generate a new Pachyderm token and write it
+		pachToken = uuid.NewWithoutDashes()
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			tokens := a.tokens.ReadWrite(stm)
+			return tokens.PutTTL(hashToken(pachToken),
+				&authclient.TokenInfo{
+					Subject: username,
+					Source:  authclient.TokenInfo_AUTHENTICATE,
+				},
+				defaultTokenTTLSecs)
+		}); err != nil {
+			return nil, fmt.Errorf("error storing auth token for user \"%s\": %v", username, err)
+		}
+
 	case req.OneTimePassword != "":
 		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 			// read short-lived authentication code (and delete it if found)
@@ -1078,7 +1135,7 @@ func (a *apiServer) AuthorizeInTransaction(
 	if err != nil {
 		return nil, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 	}
-	if state != enterpriseclient.State_ACTIVE &&
+	if !state.Entitled() &&
 		!strings.HasPrefix(callerInfo.Subject, authclient.PipelinePrefix) {
 		return nil, errors.New("Pachyderm Enterprise is not active in this " +
 			"cluster (until Pachyderm Enterprise is re-activated or Pachyderm " +
@@ -1243,7 +1300,7 @@ func (a *apiServer) SetScopeInTransaction(
 		if err != nil {
 			return false, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 		}
-		if state != enterpriseclient.State_ACTIVE {
+		if !state.Entitled() {
 			return false, fmt.Errorf("Pachyderm Enterprise is not active in this " +
 				"cluster (only a cluster admin can set a scope)")
 		}
@@ -1358,7 +1415,7 @@ func (a *apiServer) GetScopeInTransaction(
 	if err != nil {
 		return nil, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 	}
-	if state != enterpriseclient.State_ACTIVE && !callerIsAdmin {
+	if !state.Entitled() && !callerIsAdmin {
 		return nil, errors.New("Pachyderm Enterprise is not active in this " +
 			"cluster (until Pachyderm Enterprise is re-activated or Pachyderm " +
 			"auth is deactivated, only cluster admins can perform any operations)")
@@ -1435,6 +1492,89 @@ func (a *apiServer) GetScope(ctx context.Context, req *authclient.GetScopeReques
 	return response, nil
 }
 
+func (a *apiServer) ExplainScope(ctx context.Context, req *authclient.ExplainScopeRequest) (resp *authclient.ExplainScopeResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, authclient.ErrNotActivated
+	}
+
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	callerIsAdmin, err := a.isAdmin(ctx, callerInfo.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller is explaining another user's access, the caller must have
+	// READER access to the repo (same requirement as GetScope)
+	targetSubject := callerInfo.Subject
+	mustHaveReadAccess := false
+	if req.Username != "" {
+		targetSubject, err = a.canonicalizeSubject(ctx, req.Username)
+		if err != nil {
+			return nil, err
+		}
+		mustHaveReadAccess = true
+	}
+
+	// Read the repo's ACL from etcd
+	var acl authclient.ACL
+	if err := col.NewDryrunSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		if err := a.acls.ReadWrite(stm).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if mustHaveReadAccess && !callerIsAdmin {
+		callerScope, err := a.getScope(ctx, callerInfo.Subject, &acl)
+		if err != nil {
+			return nil, err
+		}
+		if callerScope < authclient.Scope_READER {
+			return nil, &authclient.ErrNotAuthorized{
+				Subject:  callerInfo.Subject,
+				Repo:     req.Repo,
+				Required: authclient.Scope_READER,
+			}
+		}
+	}
+
+	// Break the target's access down by binding. Unlike GetScope, the
+	// response's effective scope does account for admin status, since the
+	// point of ExplainScope is to explain why an operation is or isn't
+	// allowed today.
+	response := &authclient.ExplainScopeResponse{
+		Direct: acl.Entries[targetSubject],
+		Groups: make(map[string]authclient.Scope),
+	}
+	response.Admin, err = a.isAdmin(ctx, targetSubject)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := a.getGroups(ctx, targetSubject)
+	if err != nil {
+		return nil, err
+	}
+	response.Scope = response.Direct
+	for _, g := range groups {
+		if groupScope, ok := acl.Entries[g]; ok {
+			response.Groups[g] = groupScope
+			if response.Scope < groupScope {
+				response.Scope = groupScope
+			}
+		}
+	}
+	if response.Admin {
+		response.Scope = authclient.Scope_OWNER
+	}
+	return response, nil
+}
+
 func (a *apiServer) GetACLInTransaction(
 	ctx context.Context,
 	stm col.STM,
@@ -1561,7 +1701,7 @@ func (a *apiServer) SetACLInTransaction(
 		if err != nil {
 			return false, fmt.Errorf("error confirming Pachyderm Enterprise token: %v", err)
 		}
-		if state != enterpriseclient.State_ACTIVE {
+		if !state.Entitled() {
 			return false, fmt.Errorf("Pachyderm Enterprise is not active in this " +
 				"cluster (only a cluster admin can modify an ACL)")
 		}
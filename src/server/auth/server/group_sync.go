@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	logrus "github.com/sirupsen/logrus"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+)
+
+const (
+	// defaultGroupSyncInterval is how often group memberships are pulled from
+	// the external directory if the config doesn't specify an interval
+	defaultGroupSyncInterval = time.Hour
+
+	// groupSyncPollInterval is how often the sync loop re-checks whether a
+	// sync is due (syncs themselves happen every sync_interval)
+	groupSyncPollInterval = 30 * time.Second
+)
+
+// groupSyncConfig is the canonical (i.e. parsed and validated) form of
+// auth.AuthConfig_GroupSyncOptions.
+type groupSyncConfig struct {
+	LDAPURL             *url.URL
+	LDAPBindDN          string
+	LDAPBindPassword    string
+	LDAPGroupBaseDN     string
+	LDAPGroupFilter     string
+	LDAPMemberAttribute string
+
+	SCIMURL   *url.URL
+	SCIMToken string
+
+	Interval    time.Duration
+	GroupPrefix string
+}
+
+func (c *groupSyncConfig) toProto() *authclient.AuthConfig_GroupSyncOptions {
+	result := &authclient.AuthConfig_GroupSyncOptions{
+		LDAPBindDN:          c.LDAPBindDN,
+		LDAPBindPassword:    c.LDAPBindPassword,
+		LDAPGroupBaseDN:     c.LDAPGroupBaseDN,
+		LDAPGroupFilter:     c.LDAPGroupFilter,
+		LDAPMemberAttribute: c.LDAPMemberAttribute,
+		SCIMToken:           c.SCIMToken,
+		GroupPrefix:         c.GroupPrefix,
+	}
+	if c.LDAPURL != nil {
+		result.LDAPURL = c.LDAPURL.String()
+	}
+	if c.SCIMURL != nil {
+		result.SCIMURL = c.SCIMURL.String()
+	}
+	if c.Interval > 0 {
+		result.SyncInterval = c.Interval.String()
+	}
+	return result
+}
+
+// validateGroupSync is a helper of validateConfig that parses and validates
+// an AuthConfig's group_sync_options.
+func validateGroupSync(opts *authclient.AuthConfig_GroupSyncOptions) (*groupSyncConfig, error) {
+	c := &groupSyncConfig{
+		LDAPBindDN:          opts.LDAPBindDN,
+		LDAPBindPassword:    opts.LDAPBindPassword,
+		LDAPGroupBaseDN:     opts.LDAPGroupBaseDN,
+		LDAPGroupFilter:     opts.LDAPGroupFilter,
+		LDAPMemberAttribute: opts.LDAPMemberAttribute,
+		SCIMToken:           opts.SCIMToken,
+		GroupPrefix:         opts.GroupPrefix,
+	}
+	var err error
+	if (opts.LDAPURL == "") == (opts.SCIMURL == "") {
+		return nil, fmt.Errorf("group_sync_options must set exactly one of ldap_url and scim_url")
+	}
+	if opts.LDAPURL != "" {
+		if c.LDAPURL, err = url.Parse(opts.LDAPURL); err != nil {
+			return nil, fmt.Errorf("could not parse LDAP URL (%q): %v", opts.LDAPURL, err)
+		}
+		if c.LDAPURL.Scheme != "ldap" && c.LDAPURL.Scheme != "ldaps" {
+			return nil, fmt.Errorf("LDAP URL %q must use the \"ldap\" or \"ldaps\" scheme", opts.LDAPURL)
+		}
+		if c.LDAPGroupBaseDN == "" {
+			return nil, fmt.Errorf("group_sync_options must set ldap_group_base_dn when syncing from LDAP")
+		}
+		if c.LDAPGroupFilter == "" {
+			c.LDAPGroupFilter = "(objectClass=groupOfNames)"
+		}
+		if _, _, err := parseEqualityFilter(c.LDAPGroupFilter); err != nil {
+			return nil, err
+		}
+		if c.LDAPMemberAttribute == "" {
+			c.LDAPMemberAttribute = "member"
+		}
+		if c.GroupPrefix == "" {
+			c.GroupPrefix = "group/ldap:"
+		}
+	}
+	if opts.SCIMURL != "" {
+		if c.SCIMURL, err = url.Parse(opts.SCIMURL); err != nil {
+			return nil, fmt.Errorf("could not parse SCIM URL (%q): %v", opts.SCIMURL, err)
+		}
+		if c.SCIMURL.Scheme == "" {
+			return nil, fmt.Errorf("SCIM URL %q is invalid (no scheme)", opts.SCIMURL)
+		}
+		if c.GroupPrefix == "" {
+			c.GroupPrefix = "group/scim:"
+		}
+	}
+	if opts.SyncInterval != "" {
+		if c.Interval, err = time.ParseDuration(opts.SyncInterval); err != nil {
+			return nil, fmt.Errorf("could not parse group sync interval: %v", err)
+		}
+		if c.Interval <= 0 {
+			return nil, fmt.Errorf("group sync interval must be positive")
+		}
+	}
+	return c, nil
+}
+
+// groupSyncLoop runs in its own goroutine for the life of the auth server and
+// periodically pulls group memberships from the directory configured in the
+// cluster's group_sync_options (doing nothing if no directory is configured).
+func (a *apiServer) groupSyncLoop() {
+	var lastSync time.Time
+	for {
+		time.Sleep(groupSyncPollInterval)
+		if a.activationState() != full {
+			continue
+		}
+		cfg := a.getGroupSyncConfig()
+		if cfg == nil {
+			continue
+		}
+		interval := cfg.Interval
+		if interval == 0 {
+			interval = defaultGroupSyncInterval
+		}
+		if time.Since(lastSync) < interval {
+			continue
+		}
+		if err := a.syncGroups(context.Background(), cfg); err != nil {
+			logrus.Errorf("error syncing group memberships: %v", err)
+			continue // leave lastSync, so the sync is retried on the next poll
+		}
+		lastSync = time.Now()
+	}
+}
+
+// getGroupSyncConfig returns the current group sync configuration, or nil if
+// there is none.
+func (a *apiServer) getGroupSyncConfig() *groupSyncConfig {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+	if a.configCache == nil {
+		return nil
+	}
+	return a.configCache.GroupSync
+}
+
+// syncGroups pulls group memberships from the configured directory and
+// reconciles Pachyderm's group principals with them: each directory group
+// becomes the principal <group_prefix><group name>, holding exactly the
+// members the directory reports. Member names are used as Pachyderm subjects
+// verbatim, so the directory must be configured to expose principal names
+// (e.g. "github:alice"). Previously synced groups that have disappeared from
+// the directory are emptied.
+func (a *apiServer) syncGroups(ctx context.Context, cfg *groupSyncConfig) error {
+	var memberships map[string][]string
+	var err error
+	if cfg.SCIMURL != nil {
+		memberships, err = fetchSCIMGroups(cfg)
+	} else {
+		memberships, err = fetchLDAPGroups(cfg)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Collect previously synced groups (identified by the prefix), so groups
+	// that were removed from the directory can be emptied
+	stale := make(map[string]bool)
+	var membersProto authclient.Users
+	if err := a.groups.ReadOnly(ctx).ListPrefix(cfg.GroupPrefix, &membersProto, col.DefaultOptions, func(name string) error {
+		// ListPrefix hands back keys with the prefix stripped
+		if _, ok := memberships[name]; !ok {
+			stale[cfg.GroupPrefix+name] = true
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for group, members := range memberships {
+		if err := a.setGroupMembers(ctx, cfg.GroupPrefix+group, members); err != nil {
+			return err
+		}
+	}
+	for group := range stale {
+		if err := a.setGroupMembers(ctx, group, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setGroupMembers reconciles a single group principal's member list with
+// 'usernames', keeping the per-user group mapping consistent (the group-side
+// analogue of setGroupsForUserInternal).
+func (a *apiServer) setGroupMembers(ctx context.Context, group string, usernames []string) error {
+	_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		members := a.members.ReadWrite(stm)
+		groups := a.groups.ReadWrite(stm)
+		desired := addToSet(nil, usernames...)
+		var membersProto authclient.Users
+		return groups.Upsert(group, &membersProto, func() error {
+			var groupsProto authclient.Groups
+			for username := range membersProto.Usernames {
+				if desired[username] {
+					continue
+				}
+				if err := members.Upsert(username, &groupsProto, func() error {
+					groupsProto.Groups = removeFromSet(groupsProto.Groups, group)
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			for username := range desired {
+				if membersProto.Usernames[username] {
+					continue
+				}
+				if err := members.Upsert(username, &groupsProto, func() error {
+					groupsProto.Groups = addToSet(groupsProto.Groups, group)
+					return nil
+				}); err != nil {
+					return err
+				}
+			}
+			membersProto.Usernames = desired
+			return nil
+		})
+	})
+	return err
+}
+
+// fetchSCIMGroups queries the SCIM endpoint's /Groups resource and returns a
+// map from group name to member names.
+func fetchSCIMGroups(cfg *groupSyncConfig) (map[string][]string, error) {
+	groupsURL := *cfg.SCIMURL
+	groupsURL.Path = path.Join(groupsURL.Path, "Groups")
+	req, err := http.NewRequest("GET", groupsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/scim+json")
+	if cfg.SCIMToken != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.SCIMToken)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not query SCIM endpoint: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("SCIM endpoint returned %s", resp.Status)
+	}
+	var body struct {
+		Resources []struct {
+			DisplayName string `json:"displayName"`
+			Members     []struct {
+				Value   string `json:"value"`
+				Display string `json:"display"`
+			} `json:"members"`
+		} `json:"Resources"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("could not parse SCIM group list: %v", err)
+	}
+	result := make(map[string][]string)
+	for _, group := range body.Resources {
+		if group.DisplayName == "" {
+			continue
+		}
+		var members []string
+		for _, member := range group.Members {
+			// per RFC 7643 "value" is the member's ID; "display" is its
+			// human-readable name, which is what matches Pachyderm subjects
+			if member.Display != "" {
+				members = append(members, member.Display)
+			} else if member.Value != "" {
+				members = append(members, member.Value)
+			}
+		}
+		result[group.DisplayName] = members
+	}
+	return result, nil
+}
+
+// parseEqualityFilter parses a simple LDAP equality filter of the form
+// "(attribute=value)" (the only filter syntax the group sync supports).
+func parseEqualityFilter(filter string) (attribute, value string, err error) {
+	inner := strings.TrimSuffix(strings.TrimPrefix(filter, "("), ")")
+	parts := strings.SplitN(inner, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" ||
+		strings.ContainsAny(inner, "()&|!*") {
+		return "", "", fmt.Errorf("unsupported LDAP group filter %q (only simple "+
+			"equality filters like \"(objectClass=groupOfNames)\" are supported)", filter)
+	}
+	return parts[0], parts[1], nil
+}
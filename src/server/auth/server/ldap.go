@@ -0,0 +1,313 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// This file implements just enough of LDAPv3 (RFC 4511) for the group sync in
+// group_sync.go: a simple bind followed by a subtree search for group entries.
+// It deliberately supports only the encodings those two operations produce
+// (definite-length BER, simple equality filters) rather than pulling a full
+// LDAP library into the vendor tree.
+
+// LDAP protocol ops and universal BER tags used below
+const (
+	berTagSequence   = 0x30
+	berTagSet        = 0x31
+	berTagInteger    = 0x02
+	berTagEnumerated = 0x0a
+	berTagBoolean    = 0x01
+	berTagString     = 0x04 // OCTET STRING
+
+	ldapBindRequest       = 0x60 // [APPLICATION 0]
+	ldapBindResponse      = 0x61 // [APPLICATION 1]
+	ldapUnbindRequest     = 0x42 // [APPLICATION 2]
+	ldapSearchRequest     = 0x63 // [APPLICATION 3]
+	ldapSearchResultEntry = 0x64 // [APPLICATION 4]
+	ldapSearchResultDone  = 0x65 // [APPLICATION 5]
+
+	ldapSimpleAuth     = 0x80 // [0] in a bind request
+	ldapFilterEquality = 0xa3 // [3] in a search request
+)
+
+// fetchLDAPGroups connects to the LDAP server in 'cfg', binds, and searches
+// the group base DN with the group filter, returning a map from group name
+// (the entry's cn, falling back to its DN) to the values of the member
+// attribute.
+func fetchLDAPGroups(cfg *groupSyncConfig) (map[string][]string, error) {
+	address := cfg.LDAPURL.Host
+	var conn net.Conn
+	var err error
+	if cfg.LDAPURL.Scheme == "ldaps" {
+		if cfg.LDAPURL.Port() == "" {
+			address += ":636"
+		}
+		conn, err = tls.Dial("tcp", address, nil)
+	} else {
+		if cfg.LDAPURL.Port() == "" {
+			address += ":389"
+		}
+		conn, err = net.DialTimeout("tcp", address, 10*time.Second)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to LDAP server: %v", err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Minute))
+	r := bufio.NewReader(conn)
+
+	// Bind (anonymously if no bind DN is configured)
+	bind := berSeq(
+		berInt(1), // message ID
+		ber(ldapBindRequest, bytes.Join([][]byte{
+			berInt(3), // LDAP protocol version
+			ber(berTagString, []byte(cfg.LDAPBindDN)),
+			ber(ldapSimpleAuth, []byte(cfg.LDAPBindPassword)),
+		}, nil)),
+	)
+	if _, err := conn.Write(bind); err != nil {
+		return nil, err
+	}
+	op, content, err := readLDAPMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading LDAP bind response: %v", err)
+	}
+	if op != ldapBindResponse {
+		return nil, fmt.Errorf("unexpected LDAP response to bind (tag 0x%x)", op)
+	}
+	if err := checkLDAPResult("bind", content); err != nil {
+		return nil, err
+	}
+
+	// Search the group subtree
+	attribute, value, err := parseEqualityFilter(cfg.LDAPGroupFilter)
+	if err != nil {
+		return nil, err
+	}
+	search := berSeq(
+		berInt(2), // message ID
+		ber(ldapSearchRequest, bytes.Join([][]byte{
+			ber(berTagString, []byte(cfg.LDAPGroupBaseDN)),
+			ber(berTagEnumerated, []byte{2}), // scope: wholeSubtree
+			ber(berTagEnumerated, []byte{0}), // derefAliases: never
+			berInt(0),                        // no size limit
+			berInt(0),                        // no time limit
+			ber(berTagBoolean, []byte{0}),    // typesOnly: false
+			ber(ldapFilterEquality, bytes.Join([][]byte{
+				ber(berTagString, []byte(attribute)),
+				ber(berTagString, []byte(value)),
+			}, nil)),
+			berSeq( // attributes to return
+				ber(berTagString, []byte("cn")),
+				ber(berTagString, []byte(cfg.LDAPMemberAttribute)),
+			),
+		}, nil)),
+	)
+	if _, err := conn.Write(search); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string)
+	for {
+		op, content, err := readLDAPMessage(r)
+		if err != nil {
+			return nil, fmt.Errorf("error reading LDAP search response: %v", err)
+		}
+		if op == ldapSearchResultDone {
+			if err := checkLDAPResult("search", content); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if op != ldapSearchResultEntry {
+			continue // referrals etc.
+		}
+		name, members, err := parseLDAPGroupEntry(content, cfg.LDAPMemberAttribute)
+		if err != nil {
+			return nil, err
+		}
+		if name != "" {
+			result[name] = members
+		}
+	}
+
+	// Politely unbind before closing the connection (no response expected)
+	conn.Write(berSeq(berInt(3), ber(ldapUnbindRequest, nil)))
+	return result, nil
+}
+
+// parseLDAPGroupEntry extracts a group name and member list from a
+// SearchResultEntry's content: the entry's DN followed by a sequence of
+// (attribute, value set) pairs.
+func parseLDAPGroupEntry(content []byte, memberAttribute string) (string, []string, error) {
+	tag, dn, rest, err := berElement(content)
+	if err != nil || tag != berTagString {
+		return "", nil, fmt.Errorf("malformed LDAP search entry")
+	}
+	name := string(dn) // fall back to the DN if the entry has no cn
+	var members []string
+	tag, attrs, _, err := berElement(rest)
+	if err != nil || tag != berTagSequence {
+		return "", nil, fmt.Errorf("malformed LDAP search entry attributes")
+	}
+	for len(attrs) > 0 {
+		var attr []byte
+		tag, attr, attrs, err = berElement(attrs)
+		if err != nil || tag != berTagSequence {
+			return "", nil, fmt.Errorf("malformed LDAP attribute")
+		}
+		var attrType, valueSet []byte
+		if tag, attrType, attr, err = berElement(attr); err != nil || tag != berTagString {
+			return "", nil, fmt.Errorf("malformed LDAP attribute type")
+		}
+		if tag, valueSet, _, err = berElement(attr); err != nil || tag != berTagSet {
+			return "", nil, fmt.Errorf("malformed LDAP attribute values")
+		}
+		var values []string
+		for len(valueSet) > 0 {
+			var value []byte
+			if tag, value, valueSet, err = berElement(valueSet); err != nil || tag != berTagString {
+				return "", nil, fmt.Errorf("malformed LDAP attribute value")
+			}
+			values = append(values, string(value))
+		}
+		switch string(attrType) {
+		case "cn":
+			if len(values) > 0 {
+				name = values[0]
+			}
+		case memberAttribute:
+			members = values
+		}
+	}
+	return name, members, nil
+}
+
+// checkLDAPResult inspects an LDAPResult (the content of a bind or
+// search-done response) and returns an error unless the result code is 0
+// (success).
+func checkLDAPResult(op string, content []byte) error {
+	tag, code, rest, err := berElement(content)
+	if err != nil || tag != berTagEnumerated || len(code) == 0 {
+		return fmt.Errorf("malformed LDAP %s result", op)
+	}
+	if resultCode := int(code[len(code)-1]); resultCode != 0 {
+		message := ""
+		// skip matchedDN, then read diagnosticMessage
+		if _, _, rest, err = berElement(rest); err == nil {
+			if _, diagnostic, _, err := berElement(rest); err == nil {
+				message = string(diagnostic)
+			}
+		}
+		return fmt.Errorf("LDAP %s failed with result code %d %q", op, resultCode, message)
+	}
+	return nil
+}
+
+// readLDAPMessage reads one LDAPMessage from 'r' and returns the protocol
+// op's tag and content (the message ID is checked for presence and dropped).
+func readLDAPMessage(r *bufio.Reader) (byte, []byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	length := int(header[1])
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 {
+			return 0, nil, fmt.Errorf("unsupported BER length encoding")
+		}
+		lengthBytes := make([]byte, n)
+		if _, err := io.ReadFull(r, lengthBytes); err != nil {
+			return 0, nil, err
+		}
+		length = 0
+		for _, b := range lengthBytes {
+			length = length<<8 | int(b)
+		}
+	}
+	if header[0] != berTagSequence {
+		return 0, nil, fmt.Errorf("malformed LDAP message (tag 0x%x)", header[0])
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return 0, nil, err
+	}
+	tag, _, rest, err := berElement(content) // message ID
+	if err != nil || tag != berTagInteger {
+		return 0, nil, fmt.Errorf("malformed LDAP message ID")
+	}
+	op, opContent, _, err := berElement(rest)
+	if err != nil {
+		return 0, nil, fmt.Errorf("malformed LDAP protocol op")
+	}
+	return op, opContent, nil
+}
+
+// ber encodes one tag-length-value element with definite-length encoding.
+func ber(tag byte, content []byte) []byte {
+	length := len(content)
+	var header []byte
+	switch {
+	case length < 0x80:
+		header = []byte{tag, byte(length)}
+	case length < 0x100:
+		header = []byte{tag, 0x81, byte(length)}
+	default:
+		header = []byte{tag, 0x82, byte(length >> 8), byte(length)}
+	}
+	return append(header, content...)
+}
+
+// berSeq encodes a SEQUENCE of already-encoded elements.
+func berSeq(elements ...[]byte) []byte {
+	return ber(berTagSequence, bytes.Join(elements, nil))
+}
+
+// berInt encodes a non-negative INTEGER.
+func berInt(i int) []byte {
+	var content []byte
+	for {
+		content = append([]byte{byte(i)}, content...)
+		i >>= 8
+		if i == 0 {
+			break
+		}
+	}
+	if content[0]&0x80 != 0 {
+		content = append([]byte{0}, content...)
+	}
+	return ber(berTagInteger, content)
+}
+
+// berElement splits 'buf' into the first element's tag and content and the
+// remaining bytes.
+func berElement(buf []byte) (tag byte, content, rest []byte, err error) {
+	if len(buf) < 2 {
+		return 0, nil, nil, io.ErrUnexpectedEOF
+	}
+	tag = buf[0]
+	length := int(buf[1])
+	offset := 2
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 || len(buf) < 2+n {
+			return 0, nil, nil, fmt.Errorf("unsupported BER length encoding")
+		}
+		length = 0
+		for i := 0; i < n; i++ {
+			length = length<<8 | int(buf[2+i])
+		}
+		offset += n
+	}
+	if len(buf) < offset+length {
+		return 0, nil, nil, io.ErrUnexpectedEOF
+	}
+	return tag, buf[offset : offset+length], buf[offset+length:], nil
+}
@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestBerRoundTrip(t *testing.T) {
+	// Cover all three definite-length encodings: short form (<0x80), and
+	// long form with one and two length bytes
+	for _, size := range []int{0, 1, 0x7f, 0x80, 0xff, 0x100, 0x1234, 0xffff} {
+		content := bytes.Repeat([]byte{0xab}, size)
+		encoded := ber(berTagString, content)
+		tag, decoded, rest, err := berElement(encoded)
+		require.NoError(t, err, "size %d", size)
+		require.Equal(t, byte(berTagString), tag, "size %d", size)
+		require.Equal(t, size, len(decoded), "size %d", size)
+		require.True(t, bytes.Equal(content, decoded), "size %d", size)
+		require.Equal(t, 0, len(rest), "size %d", size)
+	}
+}
+
+func TestBerElementRest(t *testing.T) {
+	buf := append(ber(berTagString, []byte("first")), ber(berTagString, []byte("second"))...)
+	tag, content, rest, err := berElement(buf)
+	require.NoError(t, err)
+	require.Equal(t, byte(berTagString), tag)
+	require.Equal(t, "first", string(content))
+	tag, content, rest, err = berElement(rest)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(content))
+	require.Equal(t, 0, len(rest))
+}
+
+func TestBerInt(t *testing.T) {
+	for _, test := range []struct {
+		i       int
+		content []byte
+	}{
+		{0, []byte{0}},
+		{1, []byte{1}},
+		{127, []byte{127}},
+		// values with the top bit set need a leading zero so they stay
+		// non-negative
+		{128, []byte{0, 128}},
+		{255, []byte{0, 255}},
+		{256, []byte{1, 0}},
+		{0xabcdef, []byte{0, 0xab, 0xcd, 0xef}},
+	} {
+		tag, content, _, err := berElement(berInt(test.i))
+		require.NoError(t, err, "value %d", test.i)
+		require.Equal(t, byte(berTagInteger), tag, "value %d", test.i)
+		require.True(t, bytes.Equal(test.content, content), "value %d: got %v", test.i, content)
+	}
+}
+
+func TestBerElementErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		buf  []byte
+	}{
+		{"empty", nil},
+		{"tag only", []byte{berTagString}},
+		{"content shorter than declared", []byte{berTagString, 5, 'a', 'b'}},
+		{"indefinite length", []byte{berTagString, 0x80, 'a', 0, 0}},
+		{"length of length too large", []byte{berTagString, 0x85, 1, 1, 1, 1, 1}},
+		{"truncated long-form length", []byte{berTagString, 0x82, 1}},
+		{"long-form content missing", []byte{berTagString, 0x81, 10, 'a'}},
+	} {
+		_, _, _, err := berElement(test.buf)
+		require.YesError(t, err, test.name)
+	}
+}
+
+// searchEntry builds a SearchResultEntry's content: a DN followed by a
+// sequence of (attribute, value set) pairs.
+func searchEntry(dn string, attrs ...[]byte) []byte {
+	return append(ber(berTagString, []byte(dn)), berSeq(attrs...)...)
+}
+
+func attribute(name string, values ...string) []byte {
+	var encoded [][]byte
+	for _, v := range values {
+		encoded = append(encoded, ber(berTagString, []byte(v)))
+	}
+	return berSeq(
+		ber(berTagString, []byte(name)),
+		ber(berTagSet, bytes.Join(encoded, nil)),
+	)
+}
+
+func TestParseLDAPGroupEntry(t *testing.T) {
+	name, members, err := parseLDAPGroupEntry(searchEntry(
+		"cn=devs,ou=groups,dc=example,dc=com",
+		attribute("cn", "devs"),
+		attribute("member", "alice", "bob"),
+	), "member")
+	require.NoError(t, err)
+	require.Equal(t, "devs", name)
+	require.Equal(t, []string{"alice", "bob"}, members)
+}
+
+func TestParseLDAPGroupEntryFallsBackToDN(t *testing.T) {
+	// with no cn attribute the group is named by its DN
+	name, members, err := parseLDAPGroupEntry(searchEntry(
+		"cn=devs,ou=groups,dc=example,dc=com",
+		attribute("member", "alice"),
+	), "member")
+	require.NoError(t, err)
+	require.Equal(t, "cn=devs,ou=groups,dc=example,dc=com", name)
+	require.Equal(t, []string{"alice"}, members)
+}
+
+func TestParseLDAPGroupEntryEmptyGroup(t *testing.T) {
+	// an empty value set is legal and yields no members
+	name, members, err := parseLDAPGroupEntry(searchEntry(
+		"cn=empty,ou=groups,dc=example,dc=com",
+		attribute("cn", "empty"),
+		attribute("member"),
+	), "member")
+	require.NoError(t, err)
+	require.Equal(t, "empty", name)
+	require.Equal(t, 0, len(members))
+}
+
+func TestParseLDAPGroupEntryIgnoresOtherAttributes(t *testing.T) {
+	name, members, err := parseLDAPGroupEntry(searchEntry(
+		"cn=devs,ou=groups,dc=example,dc=com",
+		attribute("cn", "devs"),
+		attribute("description", "the developers"),
+		attribute("uniqueMember", "alice"),
+	), "member")
+	require.NoError(t, err)
+	require.Equal(t, "devs", name)
+	require.Equal(t, 0, len(members))
+	// ...unless that other attribute is the configured member attribute
+	_, members, err = parseLDAPGroupEntry(searchEntry(
+		"cn=devs,ou=groups,dc=example,dc=com",
+		attribute("uniqueMember", "alice"),
+	), "uniqueMember")
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice"}, members)
+}
+
+func TestParseLDAPGroupEntryMalformed(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		content []byte
+	}{
+		{"empty", nil},
+		{"DN with wrong tag", append(berInt(1), berSeq()...)},
+		{"missing attribute list", ber(berTagString, []byte("cn=x"))},
+		{"attribute list with wrong tag", append(ber(berTagString, []byte("cn=x")), ber(berTagSet, nil)...)},
+		{"attribute not a sequence", append(ber(berTagString, []byte("cn=x")), berSeq(berInt(1))...)},
+		{"attribute type with wrong tag", append(ber(berTagString, []byte("cn=x")), berSeq(berSeq(berInt(1)))...)},
+		{"attribute values not a set", append(ber(berTagString, []byte("cn=x")),
+			berSeq(berSeq(ber(berTagString, []byte("cn")), berSeq()))...)},
+		{"value with wrong tag", append(ber(berTagString, []byte("cn=x")),
+			berSeq(berSeq(ber(berTagString, []byte("cn")), ber(berTagSet, berInt(1))))...)},
+	} {
+		_, _, err := parseLDAPGroupEntry(test.content, "member")
+		require.YesError(t, err, test.name)
+	}
+}
+
+// ldapResult builds an LDAPResult's content: a result code, matched DN, and
+// diagnostic message.
+func ldapResult(code int, matchedDN, diagnostic string) []byte {
+	return bytes.Join([][]byte{
+		ber(berTagEnumerated, []byte{byte(code)}),
+		ber(berTagString, []byte(matchedDN)),
+		ber(berTagString, []byte(diagnostic)),
+	}, nil)
+}
+
+func TestCheckLDAPResult(t *testing.T) {
+	require.NoError(t, checkLDAPResult("bind", ldapResult(0, "", "")))
+	err := checkLDAPResult("bind", ldapResult(49, "", "invalid credentials"))
+	require.YesError(t, err)
+	require.Matches(t, "result code 49", err.Error())
+	require.Matches(t, "invalid credentials", err.Error())
+	// malformed results (no enumerated result code) are an error, not a pass
+	require.YesError(t, checkLDAPResult("bind", nil))
+	require.YesError(t, checkLDAPResult("bind", ber(berTagString, []byte("x"))))
+}
+
+func TestReadLDAPMessage(t *testing.T) {
+	payload := ldapResult(0, "", "")
+	msg := berSeq(berInt(1), ber(ldapBindResponse, payload))
+	op, content, err := readLDAPMessage(bufio.NewReader(bytes.NewReader(msg)))
+	require.NoError(t, err)
+	require.Equal(t, byte(ldapBindResponse), op)
+	require.True(t, bytes.Equal(payload, content))
+}
+
+func TestReadLDAPMessageLong(t *testing.T) {
+	// a message whose outer length needs long-form encoding
+	payload := bytes.Repeat([]byte{0xab}, 0x500)
+	msg := berSeq(berInt(2), ber(ldapSearchResultEntry, payload))
+	op, content, err := readLDAPMessage(bufio.NewReader(bytes.NewReader(msg)))
+	require.NoError(t, err)
+	require.Equal(t, byte(ldapSearchResultEntry), op)
+	require.True(t, bytes.Equal(payload, content))
+}
+
+func TestReadLDAPMessageErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		msg  []byte
+	}{
+		{"empty", nil},
+		{"truncated header", []byte{berTagSequence}},
+		{"not a sequence", ber(berTagString, []byte("x"))},
+		{"truncated content", berSeq(berInt(1), ber(ldapBindResponse, nil))[:4]},
+		{"indefinite length", []byte{berTagSequence, 0x80, 0, 0}},
+		{"missing message ID", berSeq()},
+		{"message ID with wrong tag", berSeq(ber(berTagString, []byte("1")), ber(ldapBindResponse, nil))},
+		{"missing protocol op", berSeq(berInt(1))},
+	} {
+		_, _, err := readLDAPMessage(bufio.NewReader(bytes.NewReader(test.msg)))
+		require.YesError(t, err, test.name)
+	}
+}
+
+func TestParseEqualityFilter(t *testing.T) {
+	attr, value, err := parseEqualityFilter("(objectClass=groupOfNames)")
+	require.NoError(t, err)
+	require.Equal(t, "objectClass", attr)
+	require.Equal(t, "groupOfNames", value)
+	// the parentheses are optional
+	attr, value, err = parseEqualityFilter("cn=devs")
+	require.NoError(t, err)
+	require.Equal(t, "cn", attr)
+	require.Equal(t, "devs", value)
+	// values may contain '='
+	_, value, err = parseEqualityFilter("(member=cn=alice,dc=example)")
+	require.NoError(t, err)
+	require.Equal(t, "cn=alice,dc=example", value)
+	// anything beyond a simple equality match is rejected
+	for _, filter := range []string{
+		"",
+		"()",
+		"(cn=)",
+		"(=devs)",
+		"noequals",
+		"(&(objectClass=group)(cn=devs))",
+		"(|(cn=a)(cn=b))",
+		"(!(cn=devs))",
+		"(cn=dev*)",
+	} {
+		_, _, err := parseEqualityFilter(filter)
+		require.YesError(t, err, "filter %q", filter)
+	}
+}
+
+// TestBerFuzz feeds pseudo-random input to the parsing functions; they must
+// return errors (or garbage) rather than panicking or reading out of bounds.
+func TestBerFuzz(t *testing.T) {
+	rng := rand.New(rand.NewSource(45))
+	for i := 0; i < 100000; i++ {
+		buf := make([]byte, rng.Intn(64))
+		rng.Read(buf)
+		berElement(buf)
+		parseLDAPGroupEntry(buf, "member")
+		checkLDAPResult("bind", buf)
+		readLDAPMessage(bufio.NewReader(bytes.NewReader(buf)))
+	}
+}
+
+// TestBerFuzzMutated does the same with mutations of a valid message, which
+// exercises the parsers' deeper branches more often than pure noise.
+func TestBerFuzzMutated(t *testing.T) {
+	valid := berSeq(berInt(1), ber(ldapSearchResultEntry, searchEntry(
+		"cn=devs,ou=groups,dc=example,dc=com",
+		attribute("cn", "devs"),
+		attribute("member", "alice", "bob"),
+	)))
+	rng := rand.New(rand.NewSource(45))
+	for i := 0; i < 100000; i++ {
+		buf := make([]byte, len(valid))
+		copy(buf, valid)
+		for n := rng.Intn(4) + 1; n > 0; n-- {
+			buf[rng.Intn(len(buf))] = byte(rng.Intn(256))
+		}
+		// also try truncations
+		buf = buf[:rng.Intn(len(buf)+1)]
+		if op, content, err := readLDAPMessage(bufio.NewReader(bytes.NewReader(buf))); err == nil && op == ldapSearchResultEntry {
+			parseLDAPGroupEntry(content, "member")
+		}
+		berElement(buf)
+	}
+}
+
+// TestReadLDAPMessageShortReads makes sure the reader handles messages that
+// arrive one byte at a time (as they can off a real socket).
+func TestReadLDAPMessageShortReads(t *testing.T) {
+	payload := ldapResult(0, "", "")
+	msg := berSeq(berInt(1), ber(ldapBindResponse, payload))
+	op, content, err := readLDAPMessage(bufio.NewReader(iotest(msg)))
+	require.NoError(t, err)
+	require.Equal(t, byte(ldapBindResponse), op)
+	require.True(t, bytes.Equal(payload, content))
+}
+
+// iotest returns a reader that yields 'buf' one byte per Read call.
+func iotest(buf []byte) io.Reader {
+	return &oneByteReader{buf: buf}
+}
+
+type oneByteReader struct {
+	buf []byte
+}
+
+func (r *oneByteReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		return 0, io.EOF
+	}
+	p[0] = r.buf[0]
+	r.buf = r.buf[1:]
+	return 1, nil
+}
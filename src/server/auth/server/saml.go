@@ -47,6 +47,10 @@ type canonicalConfig struct {
 		DashURL         *url.URL
 		SessionDuration time.Duration
 	}
+
+	// GroupSync, if set, configures periodic group-membership syncing from an
+	// external directory (see group_sync.go)
+	GroupSync *groupSyncConfig
 }
 
 func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
@@ -58,40 +62,44 @@ func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
 	}
 
 	// Non-empty config case
-	metadataBytes, err := xml.MarshalIndent(c.IDP.Metadata, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("could not marshal ID provider metadata: %v", err)
-	}
-	samlIDP := &auth.IDProvider{
-		Name:        c.IDP.Name,
-		Description: c.IDP.Description,
-		SAML: &auth.IDProvider_SAMLOptions{
-			MetadataXML:    metadataBytes,
-			GroupAttribute: c.IDP.GroupAttribute,
-		},
-	}
-	if c.IDP.MetadataURL != nil {
-		samlIDP.SAML.MetadataURL = c.IDP.MetadataURL.String()
-	}
+	result := &auth.AuthConfig{}
+	if c.IDP.Name != "" {
+		metadataBytes, err := xml.MarshalIndent(c.IDP.Metadata, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal ID provider metadata: %v", err)
+		}
+		samlIDP := &auth.IDProvider{
+			Name:        c.IDP.Name,
+			Description: c.IDP.Description,
+			SAML: &auth.IDProvider_SAMLOptions{
+				MetadataXML:    metadataBytes,
+				GroupAttribute: c.IDP.GroupAttribute,
+			},
+		}
+		if c.IDP.MetadataURL != nil {
+			samlIDP.SAML.MetadataURL = c.IDP.MetadataURL.String()
+		}
 
-	result := &auth.AuthConfig{
-		IDProviders: []*auth.IDProvider{samlIDP},
-		SAMLServiceOptions: &auth.AuthConfig_SAMLServiceOptions{
+		result.IDProviders = []*auth.IDProvider{samlIDP}
+		result.SAMLServiceOptions = &auth.AuthConfig_SAMLServiceOptions{
 			ACSURL:      c.SAMLSvc.ACSURL.String(),
 			MetadataURL: c.SAMLSvc.MetadataURL.String(),
-		},
-	}
-	if c.SAMLSvc.DashURL != nil {
-		result.SAMLServiceOptions.DashURL = c.SAMLSvc.DashURL.String()
+		}
+		if c.SAMLSvc.DashURL != nil {
+			result.SAMLServiceOptions.DashURL = c.SAMLSvc.DashURL.String()
+		}
+		if c.SAMLSvc.SessionDuration > 0 {
+			result.SAMLServiceOptions.SessionDuration = c.SAMLSvc.SessionDuration.String()
+		}
 	}
-	if c.SAMLSvc.SessionDuration > 0 {
-		result.SAMLServiceOptions.SessionDuration = c.SAMLSvc.SessionDuration.String()
+	if c.GroupSync != nil {
+		result.GroupSyncOptions = c.GroupSync.toProto()
 	}
 	return result, nil
 }
 
 func (c *canonicalConfig) IsEmpty() bool {
-	return c == nil || c.IDP.Name == ""
+	return c == nil || (c.IDP.Name == "" && c.GroupSync == nil)
 }
 
 // fetchRawIDPMetadata is a helper of validateConfig, below. It takes the URL
@@ -331,6 +339,13 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 		}
 	}
 
+	// Validate group_sync_options
+	if config.GroupSyncOptions != nil {
+		if c.GroupSync, err = validateGroupSync(config.GroupSyncOptions); err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
@@ -57,6 +57,11 @@ func (a *InactiveAPIServer) GetScope(context.Context, *auth.GetScopeRequest) (*a
 	return nil, auth.ErrNotActivated
 }
 
+// ExplainScope implements the ExplainScope RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ExplainScope(context.Context, *auth.ExplainScopeRequest) (*auth.ExplainScopeResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // GetACL implements the GetACL RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetACL(context.Context, *auth.GetACLRequest) (*auth.GetACLResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -0,0 +1,263 @@
+package cmds
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/server/pkg/workload"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// Cmds returns a slice containing benchmark commands.
+func Cmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
+	var commands []*cobra.Command
+
+	benchmarkDocs := &cobra.Command{
+		Short: "Run standardized load tests against the cluster.",
+		Long: `Run standardized load tests against the cluster.
+
+Benchmarks create repos (and pipelines) named benchmark-<id>, report
+throughput and latency percentiles, and clean up after themselves, so runs
+can be compared across cluster sizings and Pachyderm releases.`,
+	}
+	cmdutil.SetDocsUsage(benchmarkDocs)
+	commands = append(commands, cmdutil.CreateAlias(benchmarkDocs, "benchmark"))
+
+	var smallFiles int
+	var smallFileSize string
+	var largeFileSize string
+	var concurrency int
+	var keep bool
+	benchmarkPFS := &cobra.Command{
+		Short: "Benchmark PFS: small-file puts, a large streaming put, and list/glob at scale.",
+		Long: `Benchmark PFS: small-file puts, a large streaming put, and list/glob at scale.
+
+The benchmark writes to a scratch repo named benchmark-<id>, which is deleted
+when the run finishes unless --keep is set.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			smallBytes, err := units.RAMInBytes(smallFileSize)
+			if err != nil {
+				return fmt.Errorf("could not parse --small-file-size: %v", err)
+			}
+			largeBytes, err := units.RAMInBytes(largeFileSize)
+			if err != nil {
+				return fmt.Errorf("could not parse --large-file-size: %v", err)
+			}
+			repo := fmt.Sprintf("benchmark-%s", uuid.NewWithoutDashes()[:8])
+			if err := c.CreateRepo(repo); err != nil {
+				return err
+			}
+			if !keep {
+				defer func() {
+					if err := c.DeleteRepo(repo, true); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+			}
+			fmt.Printf("Benchmarking PFS in repo %s\n\n", repo)
+			writer := tabwriter.NewWriter(os.Stdout, 8, 1, 3, ' ', 0)
+			fmt.Fprintln(writer, "PHASE\tTHROUGHPUT\tP50\tP90\tP99")
+
+			// Phase 1: small-file puts
+			commit, err := c.StartCommit(repo, "master")
+			if err != nil {
+				return err
+			}
+			latencies := make([]time.Duration, smallFiles)
+			limiter := limit.New(concurrency)
+			start := time.Now()
+			var eg errgroup.Group
+			for i := 0; i < smallFiles; i++ {
+				i := i
+				limiter.Acquire()
+				eg.Go(func() error {
+					defer limiter.Release()
+					putStart := time.Now()
+					r := workload.NewReader(rand.New(rand.NewSource(int64(i))), smallBytes)
+					if _, err := c.PutFile(repo, commit.ID, fmt.Sprintf("/small/file-%d", i), r); err != nil {
+						return err
+					}
+					latencies[i] = time.Since(putStart)
+					return nil
+				})
+			}
+			if err := eg.Wait(); err != nil {
+				return err
+			}
+			if err := c.FinishCommit(repo, commit.ID); err != nil {
+				return err
+			}
+			printPhase(writer, "small-file puts", int64(smallFiles)*smallBytes, time.Since(start), latencies)
+
+			// Phase 2: one large streaming put
+			commit, err = c.StartCommit(repo, "master")
+			if err != nil {
+				return err
+			}
+			start = time.Now()
+			if _, err := c.PutFile(repo, commit.ID, "/large/file", workload.NewReader(rand.New(rand.NewSource(0)), largeBytes)); err != nil {
+				return err
+			}
+			putLatency := time.Since(start)
+			if err := c.FinishCommit(repo, commit.ID); err != nil {
+				return err
+			}
+			printPhase(writer, "large streaming put", largeBytes, putLatency, []time.Duration{putLatency})
+
+			// Phase 3: list and glob at scale
+			latencies = latencies[:0]
+			start = time.Now()
+			for i := 0; i < 10; i++ {
+				opStart := time.Now()
+				if _, err := c.ListFile(repo, "master", "/small"); err != nil {
+					return err
+				}
+				latencies = append(latencies, time.Since(opStart))
+				opStart = time.Now()
+				if _, err := c.GlobFile(repo, "master", "/small/file-*"); err != nil {
+					return err
+				}
+				latencies = append(latencies, time.Since(opStart))
+			}
+			printPhase(writer, "list/glob", 0, time.Since(start), latencies)
+			return writer.Flush()
+		}),
+	}
+	benchmarkPFS.Flags().IntVar(&smallFiles, "small-files", 1000, "Number of small files to put.")
+	benchmarkPFS.Flags().StringVar(&smallFileSize, "small-file-size", "1K", "Size of each small file.")
+	benchmarkPFS.Flags().StringVar(&largeFileSize, "large-file-size", "100M", "Size of the large streaming put.")
+	benchmarkPFS.Flags().IntVar(&concurrency, "concurrency", 10, "Number of concurrent puts.")
+	benchmarkPFS.Flags().BoolVar(&keep, "keep", false, "Don't delete the benchmark repo when the run finishes.")
+	commands = append(commands, cmdutil.CreateAlias(benchmarkPFS, "benchmark pfs"))
+
+	var numFiles int
+	var fileSize string
+	var commits int
+	benchmarkPPS := &cobra.Command{
+		Short: "Benchmark PPS: end-to-end latency of a synthetic copy pipeline.",
+		Long: `Benchmark PPS: end-to-end latency of a synthetic copy pipeline.
+
+The benchmark creates an input repo and a pipeline that copies its input to
+its output, commits data repeatedly and measures the time until each
+commit's output is available. The repo and pipeline are named
+benchmark-<id> and deleted when the run finishes unless --keep is set.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			fileBytes, err := units.RAMInBytes(fileSize)
+			if err != nil {
+				return fmt.Errorf("could not parse --file-size: %v", err)
+			}
+			name := fmt.Sprintf("benchmark-%s", uuid.NewWithoutDashes()[:8])
+			if err := c.CreateRepo(name); err != nil {
+				return err
+			}
+			if !keep {
+				defer func() {
+					if err := c.DeleteRepo(name, true); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+			}
+			if err := c.CreatePipeline(
+				name,
+				"", // default user image
+				[]string{"bash"},
+				[]string{fmt.Sprintf("cp -r /pfs/%s/* /pfs/out/", name)},
+				nil,
+				client.NewPFSInput(name, "/*"),
+				"",
+				false,
+			); err != nil {
+				return err
+			}
+			if !keep {
+				defer func() {
+					if err := c.DeletePipeline(name, true); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+			}
+			fmt.Printf("Benchmarking PPS with pipeline %s\n\n", name)
+			writer := tabwriter.NewWriter(os.Stdout, 8, 1, 3, ' ', 0)
+			fmt.Fprintln(writer, "PHASE\tTHROUGHPUT\tP50\tP90\tP99")
+			var latencies []time.Duration
+			start := time.Now()
+			for i := 0; i < commits; i++ {
+				commit, err := c.StartCommit(name, "master")
+				if err != nil {
+					return err
+				}
+				for j := 0; j < numFiles; j++ {
+					r := workload.NewReader(rand.New(rand.NewSource(int64(i*numFiles+j))), fileBytes)
+					if _, err := c.PutFile(name, commit.ID, fmt.Sprintf("/file-%d-%d", i, j), r); err != nil {
+						return err
+					}
+				}
+				commitStart := time.Now()
+				if err := c.FinishCommit(name, commit.ID); err != nil {
+					return err
+				}
+				if _, err := c.FlushCommitAll([]*pfs.Commit{commit}, nil); err != nil {
+					return err
+				}
+				latencies = append(latencies, time.Since(commitStart))
+			}
+			printPhase(writer, "commit to output", int64(commits*numFiles)*fileBytes, time.Since(start), latencies)
+			return writer.Flush()
+		}),
+	}
+	benchmarkPPS.Flags().IntVar(&numFiles, "files", 10, "Number of files to put per commit.")
+	benchmarkPPS.Flags().StringVar(&fileSize, "file-size", "1M", "Size of each file.")
+	benchmarkPPS.Flags().IntVar(&commits, "commits", 5, "Number of commits to process.")
+	benchmarkPPS.Flags().BoolVar(&keep, "keep", false, "Don't delete the benchmark repo and pipeline when the run finishes.")
+	commands = append(commands, cmdutil.CreateAlias(benchmarkPPS, "benchmark pps"))
+
+	return commands
+}
+
+// printPhase writes one benchmark phase's throughput ("-" when the phase
+// doesn't move a meaningful amount of data) and latency percentiles.
+func printPhase(w *tabwriter.Writer, phase string, bytes int64, elapsed time.Duration, latencies []time.Duration) {
+	throughput := "-"
+	if bytes > 0 && elapsed > 0 {
+		throughput = fmt.Sprintf("%s/s", units.BytesSize(float64(bytes)/elapsed.Seconds()))
+	}
+	fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%v\n", phase, throughput,
+		percentile(latencies, 50), percentile(latencies, 90), percentile(latencies, 99))
+}
+
+// percentile returns the p'th percentile of 'latencies'.
+func percentile(latencies []time.Duration, p int) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := (len(sorted)*p + 99) / 100
+	if i > 0 {
+		i--
+	}
+	return sorted[i]
+}
@@ -1,12 +1,16 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/src/server/cmd/pachctl/cmd"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 
+	"github.com/russross/blackfriday"
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 )
@@ -28,12 +32,101 @@ func recursiveBlockQuoteExamples(parent *cobra.Command) {
 	}
 }
 
+// commandAnchor converts a command's path ("pachctl list repo") into the
+// anchor used for it in the single-page HTML reference.
+func commandAnchor(commandPath string) string {
+	return strings.Replace(commandPath, " ", "_", -1)
+}
+
+// genHTMLReference renders the whole command tree as a single HTML page. Each
+// command becomes a section, and the "SEE ALSO" links that cobra generates
+// between related commands are rewritten into anchors within the page, so the
+// cross-links work offline.
+func genHTMLReference(rootCmd *cobra.Command, path string) (retErr error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if _, err := fmt.Fprintf(f, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>pachctl reference</title>
+</head>
+<body>
+`); err != nil {
+		return err
+	}
+	// Markdown links point at files like "pachctl_list_repo.md"; turn them
+	// into in-page anchors
+	linkHandler := func(link string) string {
+		return "#" + strings.TrimSuffix(link, ".md")
+	}
+	var genSection func(c *cobra.Command) error
+	genSection = func(c *cobra.Command) error {
+		if !c.IsAvailableCommand() || c.IsHelpCommand() {
+			return nil
+		}
+		var markdown bytes.Buffer
+		if err := doc.GenMarkdownCustom(c, &markdown, linkHandler); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(f, "<section id=%q>\n", commandAnchor(c.CommandPath())); err != nil {
+			return err
+		}
+		if _, err := f.Write(blackfriday.MarkdownCommon(markdown.Bytes())); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(f, "</section>\n"); err != nil {
+			return err
+		}
+		// Sort children for a stable page
+		children := c.Commands()
+		sort.Slice(children, func(i, j int) bool {
+			return children[i].Name() < children[j].Name()
+		})
+		for _, child := range children {
+			if err := genSection(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := genSection(rootCmd); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "</body>\n</html>\n")
+	return err
+}
+
 func do(appEnvObj interface{}) error {
 	// Set 'os.Args[0]' so that examples use the expected command name
 	os.Args[0] = "pachctl"
 
 	rootCmd := cmd.PachctlCmd()
 
+	// Man pages are generated before the examples are block-quoted--roff
+	// output would show the markdown fences literally
+	if err := os.MkdirAll("./doc/man/", 0755); err != nil {
+		return err
+	}
+	if err := doc.GenManTree(rootCmd, &doc.GenManHeader{
+		Title:   "PACHCTL",
+		Section: "1",
+		Source:  "Pachyderm",
+		Manual:  "Pachyderm Manual",
+	}, "./doc/man/"); err != nil {
+		return err
+	}
+
 	recursiveBlockQuoteExamples(rootCmd)
-	return doc.GenMarkdownTree(rootCmd, "./doc/pachctl/")
+	if err := doc.GenMarkdownTree(rootCmd, "./doc/pachctl/"); err != nil {
+		return err
+	}
+	return genHTMLReference(rootCmd, "./doc/pachctl/pachctl.html")
 }
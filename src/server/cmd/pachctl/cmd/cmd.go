@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"runtime"
 	"sort"
 	"strings"
 	"text/template"
@@ -25,6 +26,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 	admincmds "github.com/pachyderm/pachyderm/src/server/admin/cmds"
 	authcmds "github.com/pachyderm/pachyderm/src/server/auth/cmds"
+	benchmarkcmds "github.com/pachyderm/pachyderm/src/server/benchmark/cmds"
 	debugcmds "github.com/pachyderm/pachyderm/src/server/debug/cmds"
 	enterprisecmds "github.com/pachyderm/pachyderm/src/server/enterprise/cmds"
 	pfscmds "github.com/pachyderm/pachyderm/src/server/pfs/cmds"
@@ -33,6 +35,7 @@ import (
 	logutil "github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	ppscmds "github.com/pachyderm/pachyderm/src/server/pps/cmds"
+	replicationcmds "github.com/pachyderm/pachyderm/src/server/replication/cmds"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 
 	log "github.com/sirupsen/logrus"
@@ -306,6 +309,7 @@ func PachctlCmd() *cobra.Command {
 	var verbose bool
 	var noMetrics bool
 	var noPortForwarding bool
+	var rpcTimeout time.Duration
 
 	raw := false
 	rawFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -325,6 +329,9 @@ Environment variables:
     Jaeger trace to all outgoing RPCs
 `,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// Propagate --timeout to every pach client the command creates
+			client.DefaultRPCTimeout = rpcTimeout
+
 			log.SetFormatter(new(prefixed.TextFormatter))
 
 			if !verbose {
@@ -351,6 +358,7 @@ Environment variables:
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Output verbose logs")
 	rootCmd.PersistentFlags().BoolVarP(&noMetrics, "no-metrics", "", false, "Don't report user metrics for this command")
 	rootCmd.PersistentFlags().BoolVarP(&noPortForwarding, "no-port-forwarding", "", false, "Disable implicit port forwarding")
+	rootCmd.PersistentFlags().DurationVar(&rpcTimeout, "timeout", 0, "Bound every RPC the command sends with this deadline (e.g. 30s; streaming RPCs treat it as an inactivity timeout). This is distinct from the connection timeout; 0 means no deadline.")
 
 	var subcommands []*cobra.Command
 
@@ -388,7 +396,7 @@ Environment variables:
 				}
 			} else {
 				printVersionHeader(writer)
-				printVersion(writer, "pachctl", version.Version)
+				printClientVersion(writer)
 				if err := writer.Flush(); err != nil {
 					return err
 				}
@@ -413,7 +421,7 @@ Environment variables:
 			defer pachClient.Close()
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
-			version, err := pachClient.GetVersion(ctx, &types.Empty{})
+			serverVersion, err := pachClient.GetVersion(ctx, &types.Empty{})
 
 			if err != nil {
 				buf := bytes.NewBufferString("")
@@ -425,15 +433,16 @@ Environment variables:
 
 			// print server version
 			if raw {
-				if err := marshaller.Marshal(os.Stdout, version); err != nil {
+				if err := marshaller.Marshal(os.Stdout, serverVersion); err != nil {
 					return err
 				}
 			} else {
-				printVersion(writer, "pachd", version)
+				printVersion(writer, "pachd", serverVersion)
 				if err := writer.Flush(); err != nil {
 					return err
 				}
 			}
+			printVersionSkewWarning(version.Version, serverVersion)
 			return nil
 		}),
 	}
@@ -503,6 +512,8 @@ This resets the cluster to its initial state.`,
 	var pfsPort uint16
 	var s3gatewayPort uint16
 	var namespace string
+	var servicePipeline string
+	var servicePort uint16
 	portForward := &cobra.Command{
 		Short: "Forward a port on the local machine to pachd. This command blocks.",
 		Long:  "Forward a port on the local machine to pachd. This command blocks.",
@@ -518,6 +529,35 @@ This resets the cluster to its initial state.`,
 
 			defer fw.Close()
 
+			if servicePipeline != "" {
+				c, err := client.NewOnUserMachine(!noMetrics, !noPortForwarding, "user")
+				if err != nil {
+					return err
+				}
+				defer c.Close()
+				pipelineInfo, err := c.InspectPipeline(servicePipeline)
+				if err != nil {
+					return err
+				}
+				if pipelineInfo.Service == nil {
+					return fmt.Errorf("pipeline %s is not a service pipeline", servicePipeline)
+				}
+				remotePort := uint16(pipelineInfo.Service.InternalPort)
+				localPort := servicePort
+				if localPort == 0 {
+					localPort = remotePort
+				}
+				fmt.Printf("Forwarding localhost:%v to port %v of pipeline %q...\n", localPort, remotePort, servicePipeline)
+				if err := fw.RunForServicePipeline(servicePipeline, localPort, remotePort); err != nil {
+					return err
+				}
+				fmt.Println("CTRL-C to exit")
+				ch := make(chan os.Signal, 1)
+				signal.Notify(ch, os.Interrupt)
+				<-ch
+				return nil
+			}
+
 			failCount := 0
 
 			fmt.Println("Forwarding the pachd (Pachyderm daemon) port...")
@@ -574,6 +614,8 @@ This resets the cluster to its initial state.`,
 	portForward.Flags().Uint16VarP(&pfsPort, "pfs-port", "f", 30652, "The local port to bind PFS over HTTP to.")
 	portForward.Flags().Uint16VarP(&s3gatewayPort, "s3gateway-port", "s", 30600, "The local port to bind the s3gateway to.")
 	portForward.Flags().StringVar(&namespace, "namespace", "default", "Kubernetes namespace Pachyderm is deployed in.")
+	portForward.Flags().StringVar(&servicePipeline, "service", "", "Forward to the given service pipeline's internal port instead of the standard Pachyderm ports. The tunnel reconnects if the pipeline's pod restarts.")
+	portForward.Flags().Uint16Var(&servicePort, "service-port", 0, "The local port to bind the service pipeline to (defaults to its internal port).")
 	subcommands = append(subcommands, cmdutil.CreateAlias(portForward, "port-forward"))
 
 	var install bool
@@ -624,6 +666,10 @@ This resets the cluster to its initial state.`,
 	completion.Flags().StringVar(&path, "path", "/etc/bash_completion.d/pachctl", "Path to install the completion to. This will default to `/etc/bash_completion.d/` if unspecified.")
 	subcommands = append(subcommands, cmdutil.CreateAlias(completion, "completion"))
 
+	subcommands = append(subcommands, cmdutil.CreateAlias(docsCmds(), "docs"))
+	subcommands = append(subcommands, cmdutil.CreateAlias(doctorCmd(&noMetrics, &noPortForwarding), "doctor"))
+	subcommands = append(subcommands, statsCmds()...)
+
 	// Logical commands for grouping commands by verb (no run functions)
 	deleteDocs := &cobra.Command{
 		Short: "Delete an existing Pachyderm resource.",
@@ -727,13 +773,17 @@ This resets the cluster to its initial state.`,
 	}
 	subcommands = append(subcommands, cmdutil.CreateAlias(editDocs, "edit"))
 
+	subcommands = append(subcommands, topCmd(&noMetrics, &noPortForwarding))
+
 	subcommands = append(subcommands, pfscmds.Cmds(&noMetrics, &noPortForwarding)...)
 	subcommands = append(subcommands, ppscmds.Cmds(&noMetrics, &noPortForwarding)...)
 	subcommands = append(subcommands, deploycmds.Cmds(&noMetrics, &noPortForwarding)...)
 	subcommands = append(subcommands, authcmds.Cmds(&noMetrics, &noPortForwarding)...)
 	subcommands = append(subcommands, enterprisecmds.Cmds(&noMetrics, &noPortForwarding)...)
 	subcommands = append(subcommands, admincmds.Cmds(&noMetrics, &noPortForwarding)...)
+	subcommands = append(subcommands, benchmarkcmds.Cmds(&noMetrics, &noPortForwarding)...)
 	subcommands = append(subcommands, debugcmds.Cmds(&noMetrics, &noPortForwarding)...)
+	subcommands = append(subcommands, replicationcmds.Cmds(&noMetrics, &noPortForwarding)...)
 
 	cmdutil.MergeCommands(rootCmd, subcommands)
 
@@ -743,6 +793,29 @@ This resets the cluster to its initial state.`,
 	return rootCmd
 }
 
+// printVersionSkewWarning warns on stderr when pachctl and the connected
+// pachd differ in major or minor version, and points at the release artifact
+// that matches the server so users know exactly what to install.
+func printVersionSkewWarning(clientVersion *versionpb.Version, serverVersion *versionpb.Version) {
+	if clientVersion.Major == serverVersion.Major && clientVersion.Minor == serverVersion.Minor {
+		return
+	}
+	serverRelease := version.PrettyPrintVersionNoAdditional(serverVersion)
+	fmt.Fprintf(os.Stderr, "WARNING: pachctl is %s but pachd is %s; major/minor version skew can cause commands to fail in confusing ways.\n",
+		version.PrettyPrintVersion(clientVersion), version.PrettyPrintVersion(serverVersion))
+	fmt.Fprintf(os.Stderr, "To install the matching pachctl, download:\n  %s\n", releaseArtifactURL(serverRelease))
+}
+
+// releaseArtifactURL returns the download URL for the pachctl release
+// artifact matching 'release' on the current platform. Windows binaries ship
+// as zip archives, all other platforms ship tarballs.
+func releaseArtifactURL(release string) string {
+	if runtime.GOOS == "windows" {
+		return fmt.Sprintf("https://github.com/pachyderm/pachyderm/releases/download/v%s/pachctl_%s_windows_amd64.zip", release, release)
+	}
+	return fmt.Sprintf("https://github.com/pachyderm/pachyderm/releases/download/v%s/pachctl_%s_%s_amd64.tar.gz", release, release, runtime.GOOS)
+}
+
 func printVersionHeader(w io.Writer) {
 	fmt.Fprintf(w, "COMPONENT\tVERSION\t\n")
 }
@@ -751,6 +824,13 @@ func printVersion(w io.Writer, component string, v *versionpb.Version) {
 	fmt.Fprintf(w, "%s\t%s\t\n", component, version.PrettyPrintVersion(v))
 }
 
+// printClientVersion prints pachctl's version along with the platform it was
+// built for, so that e.g. a Windows binary running under WSL is easy to tell
+// apart from a Linux one.
+func printClientVersion(w io.Writer) {
+	fmt.Fprintf(w, "pachctl\t%s (%s/%s)\t\n", version.PrettyPrintVersion(version.Version), runtime.GOOS, runtime.GOARCH)
+}
+
 func applyRootUsageFunc(rootCmd *cobra.Command) {
 	// Partition subcommands by category
 	var docs []*cobra.Command
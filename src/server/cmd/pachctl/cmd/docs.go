@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/spf13/cobra"
+)
+
+// helpTopic is an offline help topic embedded in the pachctl binary, so docs
+// for core concepts are available without a network connection.
+type helpTopic struct {
+	short string
+	body  string
+}
+
+var helpTopics = map[string]helpTopic{
+	"glob": {
+		short: "How glob patterns split an input repo into datums.",
+		body: `Glob patterns
+
+A pipeline input's glob pattern determines how the input repo's files are
+split into datums:
+
+  /        the whole repo is a single datum
+  /*       every top-level file or directory is its own datum
+  /*/*     every second-level file or directory is its own datum
+  /**      every file (at any depth) is its own datum
+  /foo/*   every file or directory under /foo is its own datum
+
+A datum is the unit of work: each datum is processed independently (possibly
+on different workers) and results are only recomputed for datums whose input
+files changed. Character classes like /201[89]-* also work; the pattern
+matches against the whole path from the repo root.`,
+	},
+	"datum": {
+		short: "What datums are and how they're processed and cached.",
+		body: `Datums
+
+A datum is the unit of work in a pipeline: the input's glob pattern (and, for
+cross/union inputs, the combination of the members' datums) determines how
+input commits split into datums. Each datum:
+
+- is processed independently, so datums can run in parallel across workers
+- is retried independently (datum_tries) and can fail without failing other
+  datums' output
+- has its results cached by content hash, so unchanged datums are skipped in
+  later jobs ("N + M / T" in 'pachctl list job' is processed + skipped datums)
+
+Use 'pachctl list datum <job>' to see a job's datums, '--failed --reason' to
+triage failures, and 'pachctl inspect datum' (with stats enabled) for
+per-datum timing and logs.`,
+	},
+	"branch": {
+		short: "How branches and commits organize a repo's history.",
+		body: `Branches
+
+A branch is a named pointer to a commit, like in git. Each repo has any
+number of branches ("master" by convention); a commit is started on a branch
+and the branch moves to it when it's finished. Pipelines subscribe to
+branches: a pipeline runs a job for each new commit on its input branches and
+writes its output as commits on its output branch.
+
+Branches can also have provenance relationships: a branch's head is
+recomputed when the heads of the branches it's downstream of change. See
+'pachctl create branch --help' for creating branches at specific commits.`,
+	},
+	"backup": {
+		short: "Backing up and restoring cluster state.",
+		body: `Backup and restore
+
+'pachctl extract' streams the cluster's state (repos, commits, branches,
+pipelines and, unless --no-objects is set, object storage content) to stdout
+or to an object storage URL:
+
+  pachctl extract > backup
+  pachctl extract -u s3://bucket/backup
+
+'pachctl restore' reverses it:
+
+  pachctl restore < backup
+  pachctl restore -u s3://bucket/backup
+
+Restore into an empty cluster; a partial restore can leave the cluster in an
+invalid state. 'pachctl extract pipeline' extracts a single pipeline's spec.`,
+	},
+}
+
+func docsCmds() *cobra.Command {
+	docs := &cobra.Command{
+		Use:   "{{alias}} [<topic>]",
+		Short: "Show offline help topics.",
+		Long: `Show offline help topics.
+
+With no arguments, list the available topics. These docs are embedded in the
+pachctl binary, so they work without a network connection.`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			if len(args) == 0 {
+				var names []string
+				for name := range helpTopics {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				fmt.Println("Available topics:")
+				for _, name := range names {
+					fmt.Printf("  %-10s %s\n", name, helpTopics[name].short)
+				}
+				fmt.Println("\nRun 'pachctl docs <topic>' to read one.")
+				return nil
+			}
+			topic, ok := helpTopics[args[0]]
+			if !ok {
+				var names []string
+				for name := range helpTopics {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				return fmt.Errorf("no topic %q (available topics: %s)", args[0], strings.Join(names, ", "))
+			}
+			fmt.Println(topic.body)
+			return nil
+		}),
+	}
+	return docs
+}
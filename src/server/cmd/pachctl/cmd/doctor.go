@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/version"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+)
+
+// maxClockSkew is how far apart this machine's clock and pachd's can be
+// before 'pachctl doctor' flags it--beyond this, TLS validation and auth
+// token expiry start misbehaving.
+const maxClockSkew = 2 * time.Minute
+
+// doctorCtx collects the state of a 'pachctl doctor' run: each check prints
+// its own status line and appends remediation steps, which are printed at the
+// end in the order the checks ran (most fundamental problems first).
+type doctorCtx struct {
+	remediations []string
+}
+
+func (d *doctorCtx) ok(format string, args ...interface{}) {
+	fmt.Printf("OK    %s\n", fmt.Sprintf(format, args...))
+}
+
+func (d *doctorCtx) warn(format string, args ...interface{}) {
+	fmt.Printf("WARN  %s\n", fmt.Sprintf(format, args...))
+}
+
+func (d *doctorCtx) fail(format string, args ...interface{}) {
+	fmt.Printf("FAIL  %s\n", fmt.Sprintf(format, args...))
+}
+
+func (d *doctorCtx) remediate(format string, args ...interface{}) {
+	d.remediations = append(d.remediations, fmt.Sprintf(format, args...))
+}
+
+func (d *doctorCtx) finish() {
+	if len(d.remediations) == 0 {
+		fmt.Println("\nNo problems found.")
+		return
+	}
+	fmt.Println("\nRemediation steps, most fundamental first:")
+	for i, remediation := range d.remediations {
+		fmt.Printf("%d. %s\n", i+1, remediation)
+	}
+}
+
+func doctorCmd(noMetrics *bool, noPortForwarding *bool) *cobra.Command {
+	return &cobra.Command{
+		Short: "Diagnose common problems with this machine's connection to Pachyderm.",
+		Long: `Diagnose common problems with this machine's connection to Pachyderm.
+
+Checks connectivity to pachd, pachctl/pachd version skew, the kubectl
+context, auth token validity, clock skew, and (via pachd) object store
+reachability, and prints remediation steps for anything that's wrong.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			d := &doctorCtx{}
+
+			// kubectl context--port forwarding depends on it pointing at the
+			// right cluster
+			if address := os.Getenv("ADDRESS"); address != "" {
+				d.ok("ADDRESS is set to %q (kubectl context is not used to connect)", address)
+			} else if out, err := exec.Command("kubectl", "config", "current-context").Output(); err != nil {
+				d.warn("could not get kubectl context: %v", err)
+				d.remediate("pachctl port-forwards through kubectl; make sure kubectl is installed and configured (`kubectl config current-context`), or set ADDRESS to connect to pachd directly")
+			} else {
+				d.ok("kubectl context is %q (pachctl will port-forward to the pachd in this cluster)", strings.TrimSpace(string(out)))
+			}
+
+			// pachd connectivity
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user", client.WithDialTimeout(10*time.Second))
+			if err != nil {
+				d.fail("could not connect to pachd: %v", err)
+				d.remediate("make sure pachd is running (`kubectl get pods -l app=pachd`) and that either port forwarding works or ADDRESS points at pachd's IP and port")
+				d.finish()
+				return nil
+			}
+			defer c.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			serverVersion, err := c.GetVersion(ctx, &types.Empty{})
+			if err != nil {
+				d.fail("could not reach pachd at %s: %v", c.GetAddress(), err)
+				d.remediate("make sure pachd is running (`kubectl get pods -l app=pachd`) and that either port forwarding works or ADDRESS points at pachd's IP and port")
+				d.finish()
+				return nil
+			}
+			d.ok("connected to pachd at %s", c.GetAddress())
+
+			// version skew
+			if version.Version.Major != serverVersion.Major || version.Version.Minor != serverVersion.Minor {
+				d.warn("pachctl is %s but pachd is %s--version skew can cause confusing failures",
+					version.PrettyPrintVersion(version.Version), version.PrettyPrintVersion(serverVersion))
+				serverRelease := version.PrettyPrintVersionNoAdditional(serverVersion)
+				d.remediate("install the pachctl that matches pachd: %s", releaseArtifactURL(serverRelease))
+			} else {
+				d.ok("pachctl and pachd are both %s", version.PrettyPrintVersion(serverVersion))
+			}
+
+			// auth token
+			if _, err := c.AuthAPIClient.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{}); err != nil {
+				if auth.IsErrNotActivated(err) {
+					d.ok("auth is not activated")
+				} else if auth.IsErrNotSignedIn(err) || auth.IsErrBadToken(err) {
+					d.fail("auth is activated but this machine's token is missing or invalid: %v", err)
+					d.remediate("sign in again with `pachctl auth login`")
+				} else {
+					d.warn("could not check auth token: %v", err)
+				}
+			} else {
+				d.ok("auth token is valid")
+			}
+
+			// server-side checks: clock skew and object store reachability
+			resp, err := c.Diagnose()
+			if err != nil {
+				d.warn("could not run server-side diagnostics (is pachd older than pachctl?): %v", err)
+				d.finish()
+				return nil
+			}
+			serverTime, err := types.TimestampFromProto(resp.ServerTime)
+			if err != nil {
+				d.warn("could not parse pachd's clock: %v", err)
+			} else if skew := time.Since(serverTime); skew > maxClockSkew || skew < -maxClockSkew {
+				d.warn("this machine's clock and pachd's differ by about %v", skew.Truncate(time.Second))
+				d.remediate("fix this machine's clock (large skew breaks TLS validation and auth token expiry); check NTP on both this machine and the cluster nodes")
+			} else {
+				d.ok("clock skew is about %v", skew.Truncate(time.Second))
+			}
+			if resp.StorageOK {
+				d.ok("pachd can reach the object store")
+			} else {
+				d.fail("pachd cannot reach the object store: %s", resp.StorageError)
+				d.remediate("check the object store credentials and bucket configured at deploy time (`kubectl logs -l app=pachd` will have details)")
+			}
+
+			d.finish()
+			return nil
+		}),
+	}
+}
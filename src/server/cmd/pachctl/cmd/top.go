@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/juju/ansiterm"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/event"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+	"github.com/spf13/cobra"
+)
+
+// topState is the cluster activity that 'pachctl top' maintains from the
+// event stream: which jobs are running, which commits are open, and the most
+// recent backing-store latencies. It's seeded with one initial listing and
+// then kept current by SubscribeEvents, so refreshes don't re-list the whole
+// cluster.
+type topState struct {
+	mu          sync.Mutex
+	streamErr   error                       // terminal error from the event stream
+	runningJobs map[string]bool             // IDs of jobs currently running
+	openCommits map[string]*types.Timestamp // "repo@commit" -> when it was opened
+
+	// etcdLatency and objectLatency come from the most recent cluster stats
+	// event; nil until the first one arrives.
+	etcdLatency   *types.Duration
+	objectLatency *types.Duration
+}
+
+// topSample is one refresh's worth of cluster activity, used to compute
+// throughput deltas between refreshes.
+type topSample struct {
+	taken         time.Time
+	jobs          []*ppsclient.JobInfo
+	openCommits   []topCommit
+	dataProcessed map[string]int64 // job ID -> DataProcessed at 'taken'
+	etcdLatency   *types.Duration
+	objectLatency *types.Duration
+}
+
+// topCommit is an open commit as 'pachctl top' tracks it: events don't carry
+// a full CommitInfo, just the commit and when it was opened.
+type topCommit struct {
+	key     string // "repo@commit"
+	started *types.Timestamp
+}
+
+// maxCommitsPerRepo caps how many commits per repo 'pachctl top' scans when
+// seeding its view of open commits, so startup stays cheap on large clusters.
+const maxCommitsPerRepo = 100
+
+func topCmd(noMetrics *bool, noPortForwarding *bool) *cobra.Command {
+	var refresh time.Duration
+	top := &cobra.Command{
+		Short: "Show a refreshing view of cluster activity.",
+		Long: `Show a refreshing view of cluster activity.
+
+Displays running jobs (with datum progress and throughput), active workers,
+open commits, and etcd/object-store latency, updating every refresh interval
+until interrupted. After one initial listing the view is driven by the
+cluster's event stream rather than polling.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			state, err := seedTopState(c)
+			if err != nil {
+				return err
+			}
+			go followTopEvents(c, state)
+
+			interrupt := make(chan os.Signal, 1)
+			signal.Notify(interrupt, os.Interrupt)
+
+			var prev *topSample
+			for {
+				sample, err := takeTopSample(c, state)
+				if err != nil {
+					return err
+				}
+				// Clear the screen and move the cursor home before redrawing.
+				fmt.Print("\033[2J\033[H")
+				renderTopSample(sample, prev)
+				prev = sample
+				select {
+				case <-interrupt:
+					return nil
+				case <-time.After(refresh):
+				}
+			}
+		}),
+	}
+	top.Flags().DurationVar(&refresh, "refresh", 2*time.Second, "How often to refresh the view.")
+	return cmdutil.CreateAlias(top, "top")
+}
+
+// seedTopState lists running jobs and open commits once, so that activity
+// predating the event subscription still shows up.
+func seedTopState(c *client.APIClient) (*topState, error) {
+	state := &topState{
+		runningJobs: make(map[string]bool),
+		openCommits: make(map[string]*types.Timestamp),
+	}
+	if err := c.ListJobF("", nil, nil, func(jobInfo *ppsclient.JobInfo) error {
+		if jobRunning(jobInfo.State) {
+			state.runningJobs[jobInfo.Job.ID] = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	repoInfos, err := c.ListRepo()
+	if err != nil {
+		return nil, err
+	}
+	for _, repoInfo := range repoInfos {
+		if err := c.ListCommitF(repoInfo.Repo.Name, "", "", maxCommitsPerRepo, func(commitInfo *pfs.CommitInfo) error {
+			if commitInfo.Finished == nil {
+				state.openCommits[commitKey(commitInfo.Commit)] = commitInfo.Started
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// followTopEvents subscribes to the cluster's event stream and applies each
+// event to 'state' until the stream breaks (at which point the next refresh
+// reports the error).
+func followTopEvents(c *client.APIClient, state *topState) {
+	err := c.SubscribeEventsF(nil, nil, nil, func(ev *event.Event) error {
+		state.mu.Lock()
+		defer state.mu.Unlock()
+		switch ev.Type {
+		case event.EventType_EVENT_COMMIT_STARTED:
+			if ev.CommitEvent != nil && ev.CommitEvent.Commit != nil {
+				state.openCommits[commitKey(ev.CommitEvent.Commit)] = ev.Timestamp
+			}
+		case event.EventType_EVENT_COMMIT_FINISHED:
+			if ev.CommitEvent != nil && ev.CommitEvent.Commit != nil {
+				delete(state.openCommits, commitKey(ev.CommitEvent.Commit))
+			}
+		case event.EventType_EVENT_JOB_STATE:
+			if ev.JobEvent != nil && ev.JobEvent.Job != nil {
+				if jobRunning(ev.JobEvent.State) {
+					state.runningJobs[ev.JobEvent.Job.ID] = true
+				} else {
+					delete(state.runningJobs, ev.JobEvent.Job.ID)
+				}
+			}
+		case event.EventType_EVENT_CLUSTER_STATS:
+			if ev.ClusterStatsEvent != nil {
+				state.etcdLatency = ev.ClusterStatsEvent.EtcdLatency
+				state.objectLatency = ev.ClusterStatsEvent.ObjectLatency
+			}
+		}
+		return nil
+	})
+	state.mu.Lock()
+	state.streamErr = err
+	state.mu.Unlock()
+}
+
+// takeTopSample snapshots 'state' and inspects each running job for its
+// current progress and worker status.
+func takeTopSample(c *client.APIClient, state *topState) (*topSample, error) {
+	state.mu.Lock()
+	if state.streamErr != nil {
+		defer state.mu.Unlock()
+		return nil, state.streamErr
+	}
+	jobIDs := make([]string, 0, len(state.runningJobs))
+	for jobID := range state.runningJobs {
+		jobIDs = append(jobIDs, jobID)
+	}
+	sample := &topSample{
+		taken:         time.Now(),
+		openCommits:   make([]topCommit, 0, len(state.openCommits)),
+		dataProcessed: make(map[string]int64),
+		etcdLatency:   state.etcdLatency,
+		objectLatency: state.objectLatency,
+	}
+	for key, started := range state.openCommits {
+		sample.openCommits = append(sample.openCommits, topCommit{key: key, started: started})
+	}
+	state.mu.Unlock()
+
+	for _, jobID := range jobIDs {
+		jobInfo, err := c.InspectJob(jobID, false)
+		if err != nil {
+			// The job may have been deleted since its last event; the next
+			// refresh won't see it either way.
+			continue
+		}
+		if !jobRunning(jobInfo.State) {
+			continue
+		}
+		sample.jobs = append(sample.jobs, jobInfo)
+		sample.dataProcessed[jobInfo.Job.ID] = jobInfo.DataProcessed
+	}
+	sort.Slice(sample.jobs, func(i, j int) bool {
+		if sample.jobs[i].Pipeline.Name != sample.jobs[j].Pipeline.Name {
+			return sample.jobs[i].Pipeline.Name < sample.jobs[j].Pipeline.Name
+		}
+		return sample.jobs[i].Job.ID < sample.jobs[j].Job.ID
+	})
+	sort.Slice(sample.openCommits, func(i, j int) bool {
+		return sample.openCommits[i].key < sample.openCommits[j].key
+	})
+	return sample, nil
+}
+
+func renderTopSample(sample *topSample, prev *topSample) {
+	var workers int
+	for _, jobInfo := range sample.jobs {
+		workers += len(jobInfo.WorkerStatus)
+	}
+	fmt.Printf("%s  jobs: %d  workers: %d  open commits: %d  etcd: %s  object store: %s\n\n",
+		sample.taken.Format("15:04:05"), len(sample.jobs), workers, len(sample.openCommits),
+		topLatency(sample.etcdLatency), topLatency(sample.objectLatency))
+
+	writer := ansiterm.NewTabWriter(os.Stdout, 20, 1, 3, ' ', 0)
+	fmt.Fprintln(writer, "JOB\tPIPELINE\tSTATE\tPROGRESS\tDATUMS/S\t")
+	for _, jobInfo := range sample.jobs {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%d + %d / %d\t%s\t\n",
+			jobInfo.Job.ID,
+			jobInfo.Pipeline.Name,
+			strings.TrimPrefix(jobInfo.State.String(), "JOB_"),
+			jobInfo.DataProcessed,
+			jobInfo.DataSkipped,
+			jobInfo.DataTotal,
+			topThroughput(jobInfo, sample, prev),
+		)
+	}
+	writer.Flush()
+
+	if len(sample.openCommits) > 0 {
+		fmt.Println()
+		writer := ansiterm.NewTabWriter(os.Stdout, 20, 1, 3, ' ', 0)
+		fmt.Fprintln(writer, "OPEN COMMIT\tSTARTED\t")
+		for _, commit := range sample.openCommits {
+			fmt.Fprintf(writer, "%s\t%s\t\n",
+				commit.key,
+				pretty.Ago(commit.started),
+			)
+		}
+		writer.Flush()
+	}
+}
+
+// topThroughput returns the rate at which 'jobInfo' processed datums between
+// the previous refresh and this one, or "-" if there's no previous sample to
+// compare against.
+func topThroughput(jobInfo *ppsclient.JobInfo, sample *topSample, prev *topSample) string {
+	if prev == nil {
+		return "-"
+	}
+	prevProcessed, ok := prev.dataProcessed[jobInfo.Job.ID]
+	if !ok {
+		return "-"
+	}
+	elapsed := sample.taken.Sub(prev.taken).Seconds()
+	if elapsed <= 0 {
+		return "-"
+	}
+	rate := float64(jobInfo.DataProcessed-prevProcessed) / elapsed
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", rate), "0"), ".")
+}
+
+// topLatency formats a probe latency from a cluster stats event, or "-" if no
+// stats event has arrived yet (or pachd can't measure it).
+func topLatency(latency *types.Duration) string {
+	if latency == nil {
+		return "-"
+	}
+	d, err := types.DurationFromProto(latency)
+	if err != nil {
+		return "-"
+	}
+	return d.Round(time.Millisecond).String()
+}
+
+func jobRunning(state ppsclient.JobState) bool {
+	return state == ppsclient.JobState_JOB_RUNNING || state == ppsclient.JobState_JOB_MERGING
+}
+
+func commitKey(commit *pfs.Commit) string {
+	return commit.Repo.Name + "@" + commit.ID
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/config"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/juju/ansiterm"
+	"github.com/spf13/cobra"
+)
+
+// UsageStatsEnvVar enables local usage analytics when set to "true". Nothing
+// is recorded (and nothing leaves the machine even when it is set) unless the
+// user opts in.
+const UsageStatsEnvVar = "PACHCTL_USAGE_STATS"
+
+var usageStatsPath = filepath.Join(config.HomeDir(), ".pachyderm", "usage-stats.json")
+
+// usageRecord is one line of the usage stats file: a single pachctl
+// invocation.
+type usageRecord struct {
+	Command    string    `json:"command"`
+	Time       time.Time `json:"time"`
+	DurationMS int64     `json:"duration_ms"`
+	Succeeded  bool      `json:"succeeded"`
+}
+
+// RecordUsage appends a record of a pachctl invocation to the local usage
+// stats file if the user has opted in with PACHCTL_USAGE_STATS=true. It's
+// best-effort: recording must never break the command that ran, so errors are
+// swallowed.
+func RecordUsage(cmd *cobra.Command, start time.Time, cmdErr error) {
+	if os.Getenv(UsageStatsEnvVar) != "true" || cmd == nil {
+		return
+	}
+	record, err := json.Marshal(usageRecord{
+		Command:    cmd.CommandPath(),
+		Time:       start,
+		DurationMS: int64(time.Since(start) / time.Millisecond),
+		Succeeded:  cmdErr == nil,
+	})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(usageStatsPath), 0755); err != nil {
+		return
+	}
+	f, err := os.OpenFile(usageStatsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	f.Write(append(record, '\n'))
+}
+
+// usageSummary aggregates the records for one command.
+type usageSummary struct {
+	command  string
+	runs     int
+	failures int
+	total    time.Duration
+	lastRun  time.Time
+}
+
+func statsCmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	usage := &cobra.Command{
+		Short: "Summarize local pachctl usage stats.",
+		Long: `Summarize local pachctl usage stats.
+
+Usage stats are only recorded if you've opted in by setting
+PACHCTL_USAGE_STATS=true; they're stored in ~/.pachyderm/usage-stats.json and
+never leave this machine.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			f, err := os.Open(usageStatsPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return fmt.Errorf("no usage stats recorded; set %s=true to start recording", UsageStatsEnvVar)
+				}
+				return err
+			}
+			defer f.Close()
+			summaries := make(map[string]*usageSummary)
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var record usageRecord
+				if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+					continue // skip corrupt lines rather than failing the summary
+				}
+				summary, ok := summaries[record.Command]
+				if !ok {
+					summary = &usageSummary{command: record.Command}
+					summaries[record.Command] = summary
+				}
+				summary.runs++
+				if !record.Succeeded {
+					summary.failures++
+				}
+				summary.total += time.Duration(record.DurationMS) * time.Millisecond
+				if record.Time.After(summary.lastRun) {
+					summary.lastRun = record.Time
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			var sorted []*usageSummary
+			for _, summary := range summaries {
+				sorted = append(sorted, summary)
+			}
+			sort.Slice(sorted, func(i, j int) bool {
+				return sorted[i].runs > sorted[j].runs
+			})
+			writer := ansiterm.NewTabWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			fmt.Fprint(writer, "COMMAND\tRUNS\tFAILURES\tAVG TIME\tLAST RUN\t\n")
+			for _, summary := range sorted {
+				fmt.Fprintf(writer, "%s\t%d\t%d\t%v\t%s\t\n",
+					summary.command,
+					summary.runs,
+					summary.failures,
+					(summary.total / time.Duration(summary.runs)).Truncate(time.Millisecond),
+					summary.lastRun.Local().Format("2006-01-02 15:04:05"),
+				)
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(usage, "stats usage"))
+
+	return commands
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/server/cmd/pachctl/cmd"
@@ -17,7 +18,11 @@ func main() {
 	tracing.InstallJaegerTracerFromEnv()
 	err := func() error {
 		defer tracing.CloseAndReportTraces()
-		return cmd.PachctlCmd().Execute()
+		start := time.Now()
+		executed, err := cmd.PachctlCmd().ExecuteC()
+		// Opt-in local usage analytics (see 'pachctl stats usage')
+		cmd.RecordUsage(executed, start, err)
+		return err
 	}()
 	if err != nil {
 		if errString := strings.TrimSpace(err.Error()); errString != "" {
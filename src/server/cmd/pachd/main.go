@@ -19,6 +19,7 @@ import (
 	debugclient "github.com/pachyderm/pachyderm/src/client/debug"
 	deployclient "github.com/pachyderm/pachyderm/src/client/deploy"
 	eprsclient "github.com/pachyderm/pachyderm/src/client/enterprise"
+	eventclient "github.com/pachyderm/pachyderm/src/client/event"
 	healthclient "github.com/pachyderm/pachyderm/src/client/health"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/discovery"
@@ -33,6 +34,7 @@ import (
 	debugserver "github.com/pachyderm/pachyderm/src/server/debug/server"
 	deployserver "github.com/pachyderm/pachyderm/src/server/deploy"
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
+	eventserver "github.com/pachyderm/pachyderm/src/server/event/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
 	pach_http "github.com/pachyderm/pachyderm/src/server/http"
 	"github.com/pachyderm/pachyderm/src/server/pfs/s3"
@@ -44,6 +46,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	logutil "github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/maintenance"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
@@ -74,7 +77,7 @@ func init() {
 }
 
 func main() {
-	log.SetFormatter(logutil.FormatterFunc(logutil.Pretty))
+	log.SetFormatter(logutil.FormatterFunc(logutil.JSON))
 
 	switch {
 	case readiness:
@@ -107,18 +110,21 @@ func doSidecarMode(config interface{}) (retErr error) {
 	}()
 	switch env.LogLevel {
 	case "debug":
-		log.SetLevel(log.DebugLevel)
+		logutil.SetLevel(log.DebugLevel, 0)
 	case "info":
-		log.SetLevel(log.InfoLevel)
+		logutil.SetLevel(log.InfoLevel, 0)
 	case "error":
-		log.SetLevel(log.ErrorLevel)
+		logutil.SetLevel(log.ErrorLevel, 0)
 	default:
 		log.Errorf("Unrecognized log level %s, falling back to default of \"info\"", env.LogLevel)
-		log.SetLevel(log.InfoLevel)
+		logutil.SetLevel(log.InfoLevel, 0)
 	}
 	if env.EtcdPrefix == "" {
 		env.EtcdPrefix = col.DefaultPrefix
 	}
+	if env.GRPCMaxMsgSize > 0 {
+		grpcutil.SetMaxMsgSize(env.GRPCMaxMsgSize)
+	}
 	clusterID, err := getClusterID(env.GetEtcdClient())
 	if err != nil {
 		return fmt.Errorf("getClusterID: %v", err)
@@ -223,18 +229,38 @@ func doFullMode(config interface{}) (retErr error) {
 	}()
 	switch env.LogLevel {
 	case "debug":
-		log.SetLevel(log.DebugLevel)
+		logutil.SetLevel(log.DebugLevel, 0)
 	case "info":
-		log.SetLevel(log.InfoLevel)
+		logutil.SetLevel(log.InfoLevel, 0)
 	case "error":
-		log.SetLevel(log.ErrorLevel)
+		logutil.SetLevel(log.ErrorLevel, 0)
 	default:
 		log.Errorf("Unrecognized log level %s, falling back to default of \"info\"", env.LogLevel)
-		log.SetLevel(log.InfoLevel)
+		logutil.SetLevel(log.InfoLevel, 0)
 	}
 	if env.EtcdPrefix == "" {
 		env.EtcdPrefix = col.DefaultPrefix
 	}
+	if env.GRPCMaxMsgSize > 0 {
+		grpcutil.SetMaxMsgSize(env.GRPCMaxMsgSize)
+	}
+	if env.ReadOnly {
+		grpcutil.SetReadOnlyMode(true)
+		log.Warnf("pachd is in read-only mode (READ_ONLY=true): all mutating RPCs will be rejected")
+	}
+	grpcutil.SetAdmissionLimits(env.ExpensiveRPCConcurrency, env.ExpensiveRPCConcurrencyPerPrincipal, env.ExpensiveRPCRatePerPrincipal)
+	// Resolve auth tokens to principals for the per-RPC log lines; failures
+	// just leave the principal off the line
+	grpcutil.SetPrincipalResolver(func(ctx context.Context, token string) (string, error) {
+		pachClient := env.GetPachClient(ctx)
+		pachClient.SetAuthToken(token)
+		resp, err := pachClient.AuthAPIClient.WhoAmI(pachClient.Ctx(), &authclient.WhoAmIRequest{})
+		if err != nil {
+			return "", err
+		}
+		return resp.Username, nil
+	})
+
 	clusterID, err := getClusterID(env.GetEtcdClient())
 	if err != nil {
 		return fmt.Errorf("getClusterID: %v", err)
@@ -282,6 +308,9 @@ func doFullMode(config interface{}) (retErr error) {
 	kubeNamespace := getNamespace()
 	publicHealthServer := health.NewHealthServer()
 	peerHealthServer := health.NewHealthServer()
+	if err := maintenance.Start(env); err != nil {
+		return fmt.Errorf("maintenance.Start: %v", err)
+	}
 
 	// TODO(msteffen): We should not use an errorgroup here. Errorgroup waits
 	// until *all* goroutines have run and then returns, but we want pachd to halt
@@ -355,6 +384,8 @@ func doFullMode(config interface{}) (retErr error) {
 						env.IAMRole,
 						env.ImagePullSecret,
 						env.NoExposeDockerSocket,
+						env.WorkerDirectObjectAccess,
+						env.PrePullImages,
 						reporter,
 						env.WorkerUsesRoot,
 						env.PPSWorkerPort,
@@ -396,7 +427,8 @@ func doFullMode(config interface{}) (retErr error) {
 					eprsclient.RegisterAPIServer(s, enterpriseAPIServer)
 
 					deployclient.RegisterAPIServer(s, deployserver.NewDeployServer(env.GetKubeClient(), kubeNamespace))
-					adminclient.RegisterAPIServer(s, adminserver.NewAPIServer(address, env.StorageRoot, &adminclient.ClusterInfo{ID: clusterID}))
+					adminclient.RegisterAPIServer(s, adminserver.NewAPIServer(address, env.StorageRoot, env.GetEtcdClient(), path.Join(env.EtcdPrefix, env.PFSEtcdPrefix), path.Join(env.EtcdPrefix, env.PPSEtcdPrefix), &adminclient.ClusterInfo{ID: clusterID}))
+					publicHealthServer.SetDegradedCheck(env.GetPressureMonitor().Degraded)
 					healthclient.RegisterHealthServer(s, publicHealthServer)
 					versionpb.RegisterAPIServer(s, version.NewAPIServer(version.Version, version.APIServerOptions{}))
 					debugclient.RegisterDebugServer(s, debugserver.NewDebugServer(
@@ -405,6 +437,12 @@ func doFullMode(config interface{}) (retErr error) {
 						path.Join(env.EtcdPrefix, env.PPSEtcdPrefix),
 						env.PPSWorkerPort,
 					))
+					eventclient.RegisterAPIServer(s, eventserver.NewAPIServer(
+						env.GetEtcdClient(),
+						path.Join(env.EtcdPrefix, env.PFSEtcdPrefix),
+						path.Join(env.EtcdPrefix, env.PPSEtcdPrefix),
+						env.StorageRoot,
+					))
 					return nil
 				},
 			},
@@ -473,6 +511,8 @@ func doFullMode(config interface{}) (retErr error) {
 						env.IAMRole,
 						env.ImagePullSecret,
 						env.NoExposeDockerSocket,
+						env.WorkerDirectObjectAccess,
+						env.PrePullImages,
 						reporter,
 						env.WorkerUsesRoot,
 						env.PPSWorkerPort,
@@ -502,9 +542,10 @@ func doFullMode(config interface{}) (retErr error) {
 					eprsclient.RegisterAPIServer(s, enterpriseAPIServer)
 
 					deployclient.RegisterAPIServer(s, deployserver.NewDeployServer(env.GetKubeClient(), kubeNamespace))
+					peerHealthServer.SetDegradedCheck(env.GetPressureMonitor().Degraded)
 					healthclient.RegisterHealthServer(s, peerHealthServer)
 					versionpb.RegisterAPIServer(s, version.NewAPIServer(version.Version, version.APIServerOptions{}))
-					adminclient.RegisterAPIServer(s, adminserver.NewAPIServer(address, env.StorageRoot, &adminclient.ClusterInfo{ID: clusterID}))
+					adminclient.RegisterAPIServer(s, adminserver.NewAPIServer(address, env.StorageRoot, env.GetEtcdClient(), path.Join(env.EtcdPrefix, env.PFSEtcdPrefix), path.Join(env.EtcdPrefix, env.PPSEtcdPrefix), &adminclient.ClusterInfo{ID: clusterID}))
 					return nil
 				},
 			},
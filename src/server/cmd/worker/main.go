@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
@@ -23,8 +24,10 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 	debugserver "github.com/pachyderm/pachyderm/src/server/debug/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kms"
 	logutil "github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/secrets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	"github.com/pachyderm/pachyderm/src/server/worker"
 	"google.golang.org/grpc"
@@ -35,6 +38,14 @@ import (
 func main() {
 	log.SetFormatter(logutil.FormatterFunc(logutil.Pretty))
 
+	// In an image pre-pull pod there's nothing to do--the point of the pod is
+	// just to keep the pipeline image on the node--so block forever rather
+	// than starting a worker.
+	if os.Getenv(client.PPSPrePullEnv) == "true" {
+		log.Infof("pre-pull pod for image; idling")
+		select {}
+	}
+
 	// Copy the contents of /pach-bin/certs into /etc/ssl/certs. Don't return an
 	// error (which would cause 'Walk()' to exit early) but do record if any certs
 	// are known to be missing so we can inform the user
@@ -115,7 +126,12 @@ func getPipelineInfo(pachClient *client.APIClient, env *serviceenv.ServiceEnv) (
 	if err := pipelinePtr.Unmarshal(resp.Kvs[0].Value); err != nil {
 		return nil, err
 	}
-	pachClient.SetAuthToken(pipelinePtr.AuthToken)
+	// The token may be envelope-encrypted in etcd
+	authToken, err := kms.DecryptSensitive(ctx, pipelinePtr.AuthToken)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting pipeline auth token: %v", err)
+	}
+	pachClient.SetAuthToken(authToken)
 	// Notice we use the SpecCommitID from our env, not from etcd. This is
 	// because the value in etcd might get updated while the worker pod is
 	// being created and we don't want to run the transform of one version of
@@ -141,6 +157,30 @@ func do(config interface{}) error {
 		return fmt.Errorf("error getting pipelineInfo: %v", err)
 	}
 
+	// Resolve any secret references (e.g. "vault:path#key") in the
+	// transform's env vars with this worker's identity, before anything reads
+	// them. The pod's env contains the raw references, so we overwrite those
+	// too (userCodeEnv builds the user code's env from os.Environ()).
+	var provider secrets.Provider
+	for name, value := range pipelineInfo.Transform.Env {
+		if !secrets.IsRef(value) {
+			continue
+		}
+		if provider == nil {
+			if provider, err = secrets.NewProvider(); err != nil {
+				return fmt.Errorf("error creating secrets provider: %v", err)
+			}
+		}
+		resolved, err := provider.Resolve(value)
+		if err != nil {
+			return fmt.Errorf("error resolving secret for env var %s: %v", name, err)
+		}
+		pipelineInfo.Transform.Env[name] = resolved
+		if err := os.Setenv(name, resolved); err != nil {
+			return err
+		}
+	}
+
 	// Construct worker API server.
 	workerRcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
 	apiServer, err := worker.NewAPIServer(pachClient, env.GetEtcdClient(), env.PPSEtcdPrefix, pipelineInfo, env.PodName, env.Namespace, env.StorageRoot)
@@ -167,6 +207,28 @@ func do(config interface{}) error {
 		)
 	})
 
+	// Serve the worker API on a Unix socket too, so user code running in the
+	// same pod can reach it (e.g. to stream output through PutOutputFile)
+	// without knowing the worker's port.
+	eg.Go(func() error {
+		if err := os.MkdirAll(client.PPSInputPrefix, 0777); err != nil {
+			return err
+		}
+		if err := os.RemoveAll(client.PPSWorkerSockPath); err != nil {
+			return err
+		}
+		listener, err := net.Listen("unix", client.PPSWorkerSockPath)
+		if err != nil {
+			return err
+		}
+		s := grpc.NewServer(
+			grpc.MaxRecvMsgSize(grpcutil.MaxMsgSize),
+			grpc.MaxSendMsgSize(grpcutil.MaxMsgSize),
+		)
+		worker.RegisterWorkerServer(s, apiServer)
+		return s.Serve(listener)
+	})
+
 	// Wait until server is ready, then put our IP address into etcd, so pachd can
 	// discover us
 	<-ready
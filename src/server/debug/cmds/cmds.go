@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"text/tabwriter"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -18,6 +19,7 @@ import (
 func Cmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 	var commands []*cobra.Command
 
+	var upload string
 	dump := &cobra.Command{
 		Short: "Return a dump of running goroutines.",
 		Long:  "Return a dump of running goroutines.",
@@ -27,9 +29,13 @@ func Cmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 				return err
 			}
 			defer client.Close()
+			if upload != "" {
+				return client.DumpURL(upload)
+			}
 			return client.Dump(os.Stdout)
 		}),
 	}
+	dump.Flags().StringVarP(&upload, "upload", "u", "", "An object storage url (i.e. s3://...) to upload the dump to from the server, rather than streaming it back.")
 	commands = append(commands, cmdutil.CreateAlias(dump, "debug dump"))
 
 	var duration time.Duration
@@ -120,6 +126,58 @@ func Cmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 	pprof.Flags().DurationVarP(&duration, "duration", "d", time.Minute, "Duration to run a CPU profile for.")
 	commands = append(commands, cmdutil.CreateAlias(pprof, "debug pprof"))
 
+	var revertAfter time.Duration
+	logLevel := &cobra.Command{
+		Use:   "{{alias}} <level>",
+		Short: "Set pachd's log level.",
+		Long: `Set pachd's log level.
+
+Levels are logrus levels: debug, info, warn or error. With --duration the
+level reverts to info once the duration elapses, so verbosity can be raised
+temporarily without restarting pachd.`,
+		Example: `
+# Log at debug level for the next ten minutes:
+$ {{alias}} debug --duration 10m`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.SetLogLevel(args[0], revertAfter)
+		}),
+	}
+	logLevel.Flags().DurationVarP(&revertAfter, "duration", "d", 0, "How long to stay at this level before reverting to info (0 means until the next change or restart).")
+	commands = append(commands, cmdutil.CreateAlias(logLevel, "debug log-level"))
+
+	etcdUsage := &cobra.Command{
+		Short: "Show the size of pachd's etcd database, broken down by collection.",
+		Long: `Show the size of pachd's etcd database, broken down by collection.
+
+Collection sizes are logical (keys plus values); the database also holds old
+revisions and free pages until it's compacted and defragmented, so it can be
+larger than the collections' total.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			resp, err := client.EtcdUsage()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Database size: %s\n\n", units.BytesSize(float64(resp.DbSizeBytes)))
+			writer := tabwriter.NewWriter(os.Stdout, 8, 1, 3, ' ', 0)
+			fmt.Fprintln(writer, "COLLECTION\tKEYS\tSIZE")
+			for _, collection := range resp.Collections {
+				fmt.Fprintf(writer, "%s\t%d\t%s\n", collection.Collection, collection.Keys, units.BytesSize(float64(collection.Bytes)))
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(etcdUsage, "debug etcd-usage"))
+
 	debug := &cobra.Command{
 		Short: "Debug commands for analyzing a running cluster.",
 		Long:  "Debug commands for analyzing a running cluster.",
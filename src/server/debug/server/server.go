@@ -4,18 +4,29 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"runtime/pprof"
+	"sort"
+	"strings"
 	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/debug"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	logutil "github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/worker"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 )
 
 const (
 	defaultDuration = time.Minute
+
+	// etcdUsagePageSize is how many keys EtcdUsage reads from etcd at a time
+	// while it pages through the keyspace.
+	etcdUsagePageSize = 1000
 )
 
 // NewDebugServer creates a new server that serves the debug api over GRPC
@@ -35,12 +46,41 @@ type debugServer struct {
 	workerGrpcPort uint16
 }
 
-func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpServer) error {
+func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpServer) (retErr error) {
+	if request.URL != "" {
+		url, err := obj.ParseURL(request.URL)
+		if err != nil {
+			return fmt.Errorf("error parsing url %v: %v", request.URL, err)
+		}
+		if url.Object == "" {
+			return fmt.Errorf("URL must be <svc>://<bucket>/<object> (no object in %s)", request.URL)
+		}
+		objClient, err := obj.NewClientFromURLAndSecret(url, false)
+		if err != nil {
+			return err
+		}
+		objW, err := objClient.Writer(server.Context(), url.Object)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := objW.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		// don't pass the URL down to the workers--their dumps come back over
+		// grpc and end up in the same object
+		request.URL = ""
+		return s.dump(server.Context(), request, objW)
+	}
+	return s.dump(server.Context(), request, grpcutil.NewStreamingBytesWriter(server))
+}
+
+func (s *debugServer) dump(ctx context.Context, request *debug.DumpRequest, w io.Writer) error {
 	profile := pprof.Lookup("goroutine")
 	if profile == nil {
 		return fmt.Errorf("unable to find goroutine profile")
 	}
-	w := grpcutil.NewStreamingBytesWriter(server)
 	if s.name != "" {
 		if _, err := fmt.Fprintf(w, "== %s ==\n\n", s.name); err != nil {
 			return err
@@ -51,7 +91,7 @@ func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpSe
 	}
 	if !request.Recursed {
 		request.Recursed = true
-		cs, err := worker.Clients(server.Context(), "", s.etcdClient, s.etcdPrefix, s.workerGrpcPort)
+		cs, err := worker.Clients(ctx, "", s.etcdClient, s.etcdPrefix, s.workerGrpcPort)
 		if err != nil {
 			return err
 		}
@@ -60,7 +100,7 @@ func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpSe
 				return err
 			}
 			dumpC, err := c.Dump(
-				server.Context(),
+				ctx,
 				request,
 			)
 			if err != nil {
@@ -118,3 +158,75 @@ func (s *debugServer) Binary(request *debug.BinaryRequest, server debug.Debug_Bi
 	_, err = io.CopyBuffer(w, f, buf)
 	return err
 }
+
+func (s *debugServer) SetLogLevel(ctx context.Context, request *debug.SetLogLevelRequest) (*debug.SetLogLevelResponse, error) {
+	level, err := logrus.ParseLevel(request.Level)
+	if err != nil {
+		return nil, err
+	}
+	var revertAfter time.Duration
+	if request.Duration != nil {
+		revertAfter, err = types.DurationFromProto(request.Duration)
+		if err != nil {
+			return nil, err
+		}
+	}
+	logutil.SetLevel(level, revertAfter)
+	return &debug.SetLogLevelResponse{}, nil
+}
+
+// EtcdUsage reports the size of the etcd database and a logical breakdown of
+// the keyspace by collection, so operators can see what's filling etcd
+// before it hits its storage quota.
+func (s *debugServer) EtcdUsage(ctx context.Context, request *debug.EtcdUsageRequest) (*debug.EtcdUsageResponse, error) {
+	response := &debug.EtcdUsageResponse{}
+	for _, endpoint := range s.etcdClient.Endpoints() {
+		status, err := s.etcdClient.Status(ctx, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if status.DbSize > response.DbSizeBytes {
+			response.DbSizeBytes = status.DbSize
+		}
+	}
+	// Page through the entire keyspace, grouping keys by collection (the
+	// first two path segments, e.g. "pachyderm_pps/jobs").
+	usage := make(map[string]*debug.CollectionUsage)
+	key := "\x00"
+	for {
+		resp, err := s.etcdClient.Get(ctx, key,
+			etcd.WithFromKey(),
+			etcd.WithSort(etcd.SortByKey, etcd.SortAscend),
+			etcd.WithLimit(etcdUsagePageSize))
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Kvs) == 0 {
+			break
+		}
+		for _, kv := range resp.Kvs {
+			collection := string(kv.Key)
+			if parts := strings.SplitN(collection, "/", 3); len(parts) >= 2 {
+				collection = path.Join(parts[0], parts[1])
+			}
+			u, ok := usage[collection]
+			if !ok {
+				u = &debug.CollectionUsage{Collection: collection}
+				usage[collection] = u
+			}
+			u.Keys++
+			u.Bytes += int64(len(kv.Key) + len(kv.Value))
+		}
+		if !resp.More {
+			break
+		}
+		key = string(append(resp.Kvs[len(resp.Kvs)-1].Key, 0))
+	}
+	for _, u := range usage {
+		response.Collections = append(response.Collections, u)
+	}
+	sort.Slice(response.Collections, func(i, j int) bool {
+		return response.Collections[i].Bytes > response.Collections[j].Bytes
+	})
+	return response, nil
+}
@@ -33,6 +33,12 @@ func parseISO8601(s string) (time.Time, error) {
 // publicly-accessible to accessible only by the owner, who can subsequently add
 // users
 func ActivateCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
+	return cmdutil.CreateAlias(newActivateCmd(noMetrics, noPortForwarding), "enterprise activate")
+}
+
+// newActivateCmd builds the activation command that's aliased as both
+// 'enterprise activate' and 'license activate'.
+func newActivateCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 	var expires string
 	activate := &cobra.Command{
 		Use: "{{alias}} <activation-code>",
@@ -78,7 +84,7 @@ func ActivateCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
 		"the signed expiration time encoded in 'activation-code', and therefore "+
 		"is only useful for testing.")
 
-	return cmdutil.CreateAlias(activate, "enterprise activate")
+	return activate
 }
 
 // GetStateCmd returns a cobra.Command to activate the enterprise features of
@@ -131,5 +137,101 @@ func Cmds(noMetrics, noPortForwarding *bool) []*cobra.Command {
 	commands = append(commands, ActivateCmd(noMetrics, noPortForwarding))
 	commands = append(commands, GetStateCmd(noMetrics, noPortForwarding))
 
+	license := &cobra.Command{
+		Short: "License commands manage the cluster's enterprise license",
+		Long:  "License commands manage the cluster's enterprise license",
+	}
+	commands = append(commands, cmdutil.CreateAlias(license, "license"))
+
+	commands = append(commands, cmdutil.CreateAlias(newActivateCmd(noMetrics, noPortForwarding), "license activate"))
+
+	commands = append(commands, cmdutil.CreateAlias(newStatusCmd(noMetrics, noPortForwarding), "license status"))
+	commands = append(commands, cmdutil.CreateAlias(newHeartbeatCmd(noMetrics, noPortForwarding), "license heartbeat"))
+
 	return commands
 }
+
+// newStatusCmd returns a command that shows the state of the cluster's
+// enterprise license.
+func newStatusCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
+	return &cobra.Command{
+		Short: "Show the state of the cluster's enterprise license",
+		Long: "Show the state of the cluster's enterprise license: whether one " +
+			"is present, when it expires, and whether the cluster is in its " +
+			"post-expiry grace period (during which enterprise features keep " +
+			"working).",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %s", err.Error())
+			}
+			defer c.Close()
+			resp, err := c.Enterprise.Heartbeat(c.Ctx(), &enterprise.HeartbeatRequest{})
+			if err != nil {
+				return err
+			}
+			printHeartbeat(resp)
+			return nil
+		}),
+	}
+}
+
+// newHeartbeatCmd returns a command like 'license status' that exits nonzero
+// unless the license is active.
+func newHeartbeatCmd(noMetrics, noPortForwarding *bool) *cobra.Command {
+	return &cobra.Command{
+		Short: "Check the cluster's enterprise license, failing if it needs attention",
+		Long: "Check the cluster's enterprise license. This behaves like " +
+			"'pachctl license status' but exits nonzero unless the license is " +
+			"active, making it suitable for running from cron or a monitoring " +
+			"system so that license expiry is noticed before enterprise features " +
+			"shut off.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %s", err.Error())
+			}
+			defer c.Close()
+			resp, err := c.Enterprise.Heartbeat(c.Ctx(), &enterprise.HeartbeatRequest{})
+			if err != nil {
+				return err
+			}
+			printHeartbeat(resp)
+			if resp.State != enterprise.State_ACTIVE {
+				return fmt.Errorf("enterprise license needs attention (state: %s)",
+					resp.State.String())
+			}
+			return nil
+		}),
+	}
+}
+
+// printHeartbeat pretty-prints a license heartbeat: the license state and
+// expiration, and how much of the grace period remains if it has expired.
+func printHeartbeat(resp *enterprise.HeartbeatResponse) {
+	if resp.State == enterprise.State_NONE {
+		fmt.Println("No Pachyderm Enterprise license was found")
+		return
+	}
+	expiration, err := types.TimestampFromProto(resp.Info.Expires)
+	if err != nil {
+		fmt.Printf("License state: %s\n", resp.State.String())
+		return
+	}
+	switch resp.State {
+	case enterprise.State_ACTIVE:
+		fmt.Printf("License state: ACTIVE\nExpiration: %s\n", expiration.String())
+	case enterprise.State_GRACE_PERIOD:
+		graceRemaining, err := types.DurationFromProto(resp.GraceRemaining)
+		if err != nil {
+			graceRemaining = 0
+		}
+		fmt.Printf("License state: GRACE_PERIOD\nExpired: %s\n"+
+			"Enterprise features will be disabled in %v unless the license is renewed\n",
+			expiration.String(), graceRemaining.Truncate(time.Hour))
+	case enterprise.State_EXPIRED:
+		fmt.Printf("License state: EXPIRED\nExpired: %s\n"+
+			"The grace period has run out and enterprise features are disabled\n",
+			expiration.String())
+	}
+}
@@ -92,9 +92,48 @@ func NewEnterpriseServer(env *serviceenv.ServiceEnv, etcdPrefix string) (ec.APIS
 	}
 	s.enterpriseExpiration.Store(s.defaultEnterpriseRecord)
 	go s.watchEnterpriseToken(etcdPrefix)
+	go s.warnOnExpiry()
 	return s, nil
 }
 
+const (
+	// expiryWarningWindow is how far ahead of the enterprise token's
+	// expiration warnOnExpiry starts emitting warnings
+	expiryWarningWindow = 30 * 24 * time.Hour
+
+	// expiryCheckInterval is how often warnOnExpiry re-checks the token
+	expiryCheckInterval = 24 * time.Hour
+)
+
+// warnOnExpiry periodically logs a warning when the cluster's enterprise
+// token is about to expire, is in its grace period, or has expired, so that
+// license expiry shows up in pachd's logs well before enterprise features
+// shut off.
+func (a *apiServer) warnOnExpiry() {
+	for {
+		state, record, err := a.currentState()
+		if err == nil && state != ec.State_NONE {
+			expiration, err := types.TimestampFromProto(record.Expires)
+			if err == nil {
+				switch state {
+				case ec.State_EXPIRED:
+					logrus.Warnf("enterprise token expired %v and its grace period has "+
+						"run out; enterprise features are disabled", expiration)
+				case ec.State_GRACE_PERIOD:
+					logrus.Warnf("enterprise token expired %v; enterprise features will "+
+						"be disabled when the grace period ends %v", expiration,
+						expiration.Add(ec.GracePeriod))
+				case ec.State_ACTIVE:
+					if until := time.Until(expiration); until < expiryWarningWindow {
+						logrus.Warnf("enterprise token expires in %v (%v)", until, expiration)
+					}
+				}
+			}
+		}
+		time.Sleep(expiryCheckInterval)
+	}
+}
+
 func (a *apiServer) watchEnterpriseToken(etcdPrefix string) {
 	backoff.RetryNotify(func() error {
 		// Watch for incoming enterprise tokens
@@ -265,32 +304,75 @@ func (a *apiServer) Activate(ctx context.Context, req *ec.ActivateRequest) (resp
 	}, nil
 }
 
-// GetState returns the current state of the cluster's Pachyderm Enterprise key (ACTIVE, EXPIRED, or NONE)
-func (a *apiServer) GetState(ctx context.Context, req *ec.GetStateRequest) (resp *ec.GetStateResponse, retErr error) {
-	a.LogReq(req)
-	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
-
+// currentState computes the cluster's current enterprise state from the
+// cached enterprise record. Expired tokens spend ec.GracePeriod in the
+// GRACE_PERIOD state (during which enterprise features keep working) before
+// becoming EXPIRED.
+func (a *apiServer) currentState() (ec.State, *ec.EnterpriseRecord, error) {
 	record, ok := a.enterpriseExpiration.Load().(*ec.EnterpriseRecord)
 	if !ok {
-		return nil, fmt.Errorf("could not retrieve enterprise expiration time")
+		return ec.State_NONE, nil, fmt.Errorf("could not retrieve enterprise expiration time")
 	}
 	expiration, err := types.TimestampFromProto(record.Expires)
 	if err != nil {
-		return nil, fmt.Errorf("could not parse expiration timestamp: %s", err.Error())
+		return ec.State_NONE, nil, fmt.Errorf("could not parse expiration timestamp: %s", err.Error())
 	}
 	if expiration.IsZero() {
+		return ec.State_NONE, record, nil
+	}
+	switch now := time.Now(); {
+	case now.After(expiration.Add(ec.GracePeriod)):
+		return ec.State_EXPIRED, record, nil
+	case now.After(expiration):
+		return ec.State_GRACE_PERIOD, record, nil
+	default:
+		return ec.State_ACTIVE, record, nil
+	}
+}
+
+// GetState returns the current state of the cluster's Pachyderm Enterprise key (ACTIVE, GRACE_PERIOD, EXPIRED, or NONE)
+func (a *apiServer) GetState(ctx context.Context, req *ec.GetStateRequest) (resp *ec.GetStateResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.pachLogger.Log(req, resp, retErr, time.Since(start)) }(time.Now())
+
+	state, record, err := a.currentState()
+	if err != nil {
+		return nil, err
+	}
+	if state == ec.State_NONE {
 		return &ec.GetStateResponse{State: ec.State_NONE}, nil
 	}
-	resp = &ec.GetStateResponse{
+	return &ec.GetStateResponse{
+		State: state,
 		Info: &ec.TokenInfo{
 			Expires: record.Expires,
 		},
 		ActivationCode: record.ActivationCode,
+	}, nil
+}
+
+// Heartbeat is a lightweight version of GetState for monitoring: it returns
+// the license state and expiration but not the activation code.
+func (a *apiServer) Heartbeat(ctx context.Context, req *ec.HeartbeatRequest) (resp *ec.HeartbeatResponse, retErr error) {
+	state, record, err := a.currentState()
+	if err != nil {
+		return nil, err
 	}
-	if time.Now().After(expiration) {
-		resp.State = ec.State_EXPIRED
-	} else {
-		resp.State = ec.State_ACTIVE
+	if state == ec.State_NONE {
+		return &ec.HeartbeatResponse{State: ec.State_NONE}, nil
+	}
+	resp = &ec.HeartbeatResponse{
+		State: state,
+		Info: &ec.TokenInfo{
+			Expires: record.Expires,
+		},
+	}
+	if state == ec.State_GRACE_PERIOD {
+		expiration, err := types.TimestampFromProto(record.Expires)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse expiration timestamp: %s", err.Error())
+		}
+		resp.GraceRemaining = types.DurationProto(time.Until(expiration.Add(ec.GracePeriod)))
 	}
 	return resp, nil
 }
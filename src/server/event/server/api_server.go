@@ -0,0 +1,363 @@
+package server
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/event"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+	log "github.com/sirupsen/logrus"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// statsInterval is how often cluster stats events are emitted to
+	// subscribers that ask for them.
+	statsInterval = 10 * time.Second
+	// statsProbeKey is the key (or object name) the latency probes read; it
+	// doesn't need to exist.
+	statsProbeKey = "stats-probe"
+)
+
+// NewAPIServer creates a new server that serves the event api over GRPC. It
+// synthesizes events by watching the PFS and PPS etcd collections, so it
+// requires no cooperation from the other API servers.
+func NewAPIServer(etcdClient *etcd.Client, pfsEtcdPrefix string, ppsEtcdPrefix string, storageRoot string) event.APIServer {
+	a := &apiServer{
+		etcdClient:    etcdClient,
+		pfsEtcdPrefix: pfsEtcdPrefix,
+		ppsEtcdPrefix: ppsEtcdPrefix,
+	}
+	// The object client is only used to measure object-store latency for
+	// cluster stats events; without one those events just omit the field.
+	objClient, err := obj.NewClientFromEnv(storageRoot)
+	if err != nil {
+		log.Warnf("event server running without an object client (cluster stats events won't include object-store latency): %v", err)
+	} else {
+		a.objClient = objClient
+	}
+	return a
+}
+
+type apiServer struct {
+	etcdClient    *etcd.Client
+	objClient     obj.Client // nil if pachd has no object storage configured
+	pfsEtcdPrefix string
+	ppsEtcdPrefix string
+}
+
+func (a *apiServer) SubscribeEvents(request *event.SubscribeEventsRequest, server event.API_SubscribeEventsServer) error {
+	ctx := server.Context()
+	events := make(chan *event.Event)
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return a.watchCommits(ctx, request, events)
+	})
+	eg.Go(func() error {
+		return a.watchBranches(ctx, request, events)
+	})
+	eg.Go(func() error {
+		return a.watchJobs(ctx, request, events)
+	})
+	eg.Go(func() error {
+		return a.watchPipelines(ctx, request, events)
+	})
+	if wantsType(request, event.EventType_EVENT_CLUSTER_STATS) {
+		eg.Go(func() error {
+			return a.probeStats(ctx, events)
+		})
+	}
+	eg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ev := <-events:
+				if !wantEvent(request, ev) {
+					continue
+				}
+				if err := server.Send(ev); err != nil {
+					return err
+				}
+			}
+		}
+	})
+	return eg.Wait()
+}
+
+func (a *apiServer) watchCommits(ctx context.Context, request *event.SubscribeEventsRequest, events chan<- *event.Event) error {
+	// An empty repo makes the collection span commits in every repo; keys are
+	// then "<repo>/<commitID>".
+	watcher, err := pfsdb.Commits(a.etcdClient, a.pfsEtcdPrefix, "").ReadOnly(ctx).Watch()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		var ev *watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok = <-watcher.Watch():
+		}
+		if !ok {
+			return nil
+		}
+		if ev.Type != watch.EventPut {
+			continue
+		}
+		var key string
+		commitInfo := &pfs.CommitInfo{}
+		if err := ev.Unmarshal(&key, commitInfo); err != nil {
+			return err
+		}
+		eventType := event.EventType_EVENT_COMMIT_STARTED
+		if commitInfo.Finished != nil {
+			eventType = event.EventType_EVENT_COMMIT_FINISHED
+		}
+		events <- &event.Event{
+			Type:      eventType,
+			Timestamp: types.TimestampNow(),
+			CommitEvent: &event.CommitEvent{
+				Commit:   commitInfo.Commit,
+				Finished: commitInfo.Finished,
+			},
+		}
+	}
+}
+
+func (a *apiServer) watchBranches(ctx context.Context, request *event.SubscribeEventsRequest, events chan<- *event.Event) error {
+	watcher, err := pfsdb.Branches(a.etcdClient, a.pfsEtcdPrefix, "").ReadOnly(ctx).Watch()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		var ev *watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok = <-watcher.Watch():
+		}
+		if !ok {
+			return nil
+		}
+		branchEvent := &event.BranchEvent{}
+		var key string
+		if ev.Type == watch.EventDelete {
+			branchInfo := &pfs.BranchInfo{}
+			if err := ev.UnmarshalPrev(&key, branchInfo); err != nil {
+				return err
+			}
+			branchEvent.Branch = branchInfo.Branch
+		} else {
+			branchInfo := &pfs.BranchInfo{}
+			if err := ev.Unmarshal(&key, branchInfo); err != nil {
+				return err
+			}
+			branchEvent.Branch = branchInfo.Branch
+			branchEvent.Head = branchInfo.Head
+		}
+		events <- &event.Event{
+			Type:        event.EventType_EVENT_BRANCH_MOVED,
+			Timestamp:   types.TimestampNow(),
+			BranchEvent: branchEvent,
+		}
+	}
+}
+
+func (a *apiServer) watchJobs(ctx context.Context, request *event.SubscribeEventsRequest, events chan<- *event.Event) error {
+	watcher, err := ppsdb.Jobs(a.etcdClient, a.ppsEtcdPrefix).ReadOnly(ctx).Watch()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	// lastState tracks the last state we emitted per job so that etcd writes
+	// that don't change the state (e.g. datum progress updates) are dropped.
+	lastState := make(map[string]pps.JobState)
+	for {
+		var ev *watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok = <-watcher.Watch():
+		}
+		if !ok {
+			return nil
+		}
+		if ev.Type != watch.EventPut {
+			continue
+		}
+		var key string
+		jobInfo := &pps.EtcdJobInfo{}
+		if err := ev.Unmarshal(&key, jobInfo); err != nil {
+			return err
+		}
+		if state, ok := lastState[key]; ok && state == jobInfo.State {
+			continue
+		}
+		lastState[key] = jobInfo.State
+		events <- &event.Event{
+			Type:      event.EventType_EVENT_JOB_STATE,
+			Timestamp: types.TimestampNow(),
+			JobEvent: &event.JobEvent{
+				Job:      jobInfo.Job,
+				Pipeline: jobInfo.Pipeline,
+				State:    jobInfo.State,
+				Reason:   jobInfo.Reason,
+			},
+		}
+	}
+}
+
+func (a *apiServer) watchPipelines(ctx context.Context, request *event.SubscribeEventsRequest, events chan<- *event.Event) error {
+	watcher, err := ppsdb.Pipelines(a.etcdClient, a.ppsEtcdPrefix).ReadOnly(ctx).Watch()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		var ev *watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok = <-watcher.Watch():
+		}
+		if !ok {
+			return nil
+		}
+		if ev.Type != watch.EventPut {
+			continue
+		}
+		var key string
+		pipelineInfo := &pps.EtcdPipelineInfo{}
+		if err := ev.Unmarshal(&key, pipelineInfo); err != nil {
+			return err
+		}
+		eventType := event.EventType_EVENT_PIPELINE_UPDATED
+		if ev.PrevKey == nil {
+			eventType = event.EventType_EVENT_PIPELINE_CREATED
+		}
+		events <- &event.Event{
+			Type:      eventType,
+			Timestamp: types.TimestampNow(),
+			PipelineEvent: &event.PipelineEvent{
+				Pipeline: &pps.Pipeline{Name: key},
+				State:    pipelineInfo.State,
+			},
+		}
+	}
+}
+
+// probeStats periodically measures the round-trip time of a small read
+// against etcd (and, if configured, the object store) and emits the result as
+// a cluster stats event. The first probe runs immediately so that new
+// subscribers (e.g. 'pachctl top') aren't blind for a full interval.
+func (a *apiServer) probeStats(ctx context.Context, events chan<- *event.Event) error {
+	ticker := time.NewTicker(statsInterval)
+	defer ticker.Stop()
+	for {
+		stats := &event.ClusterStatsEvent{}
+		start := time.Now()
+		if _, err := a.etcdClient.Get(ctx, statsProbeKey); err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		stats.EtcdLatency = types.DurationProto(time.Since(start))
+		if a.objClient != nil {
+			start = time.Now()
+			a.objClient.Exists(ctx, statsProbeKey)
+			stats.ObjectLatency = types.DurationProto(time.Since(start))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case events <- &event.Event{
+			Type:              event.EventType_EVENT_CLUSTER_STATS,
+			Timestamp:         types.TimestampNow(),
+			ClusterStatsEvent: stats,
+		}:
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// wantsType returns whether the request's type filter matches 't'. An empty
+// filter matches all types.
+func wantsType(request *event.SubscribeEventsRequest, t event.EventType) bool {
+	if len(request.Types) == 0 {
+		return true
+	}
+	for _, want := range request.Types {
+		if want == t {
+			return true
+		}
+	}
+	return false
+}
+
+// wantEvent applies the request's filters to 'ev'.
+func wantEvent(request *event.SubscribeEventsRequest, ev *event.Event) bool {
+	if !wantsType(request, ev.Type) {
+		return false
+	}
+	if len(request.Repos) > 0 {
+		var repo string
+		switch {
+		case ev.CommitEvent != nil && ev.CommitEvent.Commit != nil:
+			repo = ev.CommitEvent.Commit.Repo.Name
+		case ev.BranchEvent != nil && ev.BranchEvent.Branch != nil:
+			repo = ev.BranchEvent.Branch.Repo.Name
+		}
+		if repo != "" {
+			var found bool
+			for _, r := range request.Repos {
+				if r == repo {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	if len(request.Pipelines) > 0 {
+		var pipeline string
+		switch {
+		case ev.JobEvent != nil && ev.JobEvent.Pipeline != nil:
+			pipeline = ev.JobEvent.Pipeline.Name
+		case ev.PipelineEvent != nil && ev.PipelineEvent.Pipeline != nil:
+			pipeline = ev.PipelineEvent.Pipeline.Name
+		}
+		if pipeline != "" {
+			var found bool
+			for _, p := range request.Pipelines {
+				if p == pipeline {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
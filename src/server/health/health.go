@@ -8,10 +8,11 @@ import (
 	"golang.org/x/net/context"
 )
 
-// Server adds the Ready method to health.HealthServer.
+// Server adds the Ready and SetDegradedCheck methods to health.HealthServer.
 type Server interface {
 	health.HealthServer
 	Ready()
+	SetDegradedCheck(check func() (bool, string))
 }
 
 // NewHealthServer returns a new health server
@@ -21,9 +22,15 @@ func NewHealthServer() Server {
 
 type healthServer struct {
 	ready bool
+	// check reports whether the server is degraded (shedding load because a
+	// backing service is under pressure), or is nil if no check is configured
+	check func() (bool, string)
 }
 
-// Health implements the Health method for healthServer.
+// Health implements the Health method for healthServer. Note that a degraded
+// server is still healthy: it's serving reads and shedding load on purpose,
+// so failing the k8s readiness check (and losing the pod) would only make
+// things worse.
 func (h *healthServer) Health(context.Context, *types.Empty) (*types.Empty, error) {
 	if !h.ready {
 		return nil, fmt.Errorf("server not ready")
@@ -31,8 +38,27 @@ func (h *healthServer) Health(context.Context, *types.Empty) (*types.Empty, erro
 	return &types.Empty{}, nil
 }
 
+// State implements the State method for healthServer, reporting whether the
+// server is degraded and why.
+func (h *healthServer) State(context.Context, *types.Empty) (*health.HealthState, error) {
+	if h.check == nil {
+		return &health.HealthState{}, nil
+	}
+	degraded, reason := h.check()
+	return &health.HealthState{
+		Degraded: degraded,
+		Reason:   reason,
+	}, nil
+}
+
 // Ready tells pachd to start responding positively to Health requests. This
 // will cause the node to pass its k8s readiness check.
 func (h *healthServer) Ready() {
 	h.ready = true
 }
+
+// SetDegradedCheck sets the function that State calls to determine whether
+// the server is degraded.
+func (h *healthServer) SetDegradedCheck(check func() (bool, string)) {
+	h.check = check
+}
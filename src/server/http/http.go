@@ -11,8 +11,10 @@ import (
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	"github.com/julienschmidt/httprouter"
 	"golang.org/x/net/context"
@@ -27,10 +29,12 @@ func versionPath(p string) string {
 }
 
 var (
-	getFilePath = versionPath("pfs/repos/:repoName/commits/:commitID/files/*filePath")
-	servicePath = versionPath("pps/services/:serviceName/*path")
-	loginPath   = versionPath("auth/login")
-	logoutPath  = versionPath("auth/logout")
+	getFilePath    = versionPath("pfs/repos/:repoName/commits/:commitID/files/*filePath")
+	listFilePath   = versionPath("pfs/repos/:repoName/commits/:commitID/list/*filePath")
+	commitInfoPath = versionPath("pfs/repos/:repoName/commits/:commitID")
+	servicePath    = versionPath("pps/services/:serviceName/*path")
+	loginPath      = versionPath("auth/login")
+	logoutPath     = versionPath("auth/logout")
 )
 
 type router = *httprouter.Router
@@ -53,6 +57,8 @@ func NewHTTPServer(address string) (http.Handler, error) {
 	}
 
 	router.GET(getFilePath, s.getFileHandler)
+	router.GET(listFilePath, s.listFileHandler)
+	router.GET(commitInfoPath, s.commitInfoHandler)
 	router.GET(servicePath, s.serviceHandler)
 
 	router.POST(loginPath, s.authLoginHandler)
@@ -95,6 +101,39 @@ func (s *server) getFileHandler(w http.ResponseWriter, r *http.Request, ps httpr
 	return
 }
 
+// listFileHandler returns the files under a path as JSON, so non-Go callers
+// (e.g. Python notebooks) can explore a commit without a Pachyderm client.
+// Ranged GETs of the sibling "files" route serve partial file reads.
+func (s *server) listFileHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	c := s.getPachClient()
+	fileInfos, err := c.ListFile(ps.ByName("repoName"), ps.ByName("commitID"), ps.ByName("filePath"))
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	marshaller := &jsonpb.Marshaler{}
+	if err := marshaller.Marshal(w, &pfs.FileInfos{FileInfo: fileInfos}); err != nil {
+		httpError(w, err)
+	}
+}
+
+// commitInfoHandler returns a commit's info as JSON. Callers pin a branch by
+// requesting it here and reading the resolved commit ID out of the response.
+func (s *server) commitInfoHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	c := s.getPachClient()
+	commitInfo, err := c.InspectCommit(ps.ByName("repoName"), ps.ByName("commitID"))
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	marshaller := &jsonpb.Marshaler{}
+	if err := marshaller.Marshal(w, commitInfo); err != nil {
+		httpError(w, err)
+	}
+}
+
 func (s *server) serviceHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	c := s.getPachClient()
 	serviceName := ps.ByName("serviceName")
@@ -3327,7 +3327,7 @@ func testGetLogs(t *testing.T, enableStats bool) {
 
 	c := getPachClient(t)
 	require.NoError(t, c.DeleteAll())
-	iter := c.GetLogs("", "", nil, "", false, false, 0)
+	iter := c.GetLogs("", "", nil, "", "", false, false, 0)
 	for iter.Next() {
 	}
 	require.NoError(t, iter.Err())
@@ -3365,7 +3365,7 @@ func testGetLogs(t *testing.T, enableStats bool) {
 	require.NoError(t, err)
 
 	// Get logs from pipeline, using pipeline
-	iter = c.GetLogs(pipelineName, "", nil, "", false, false, 0)
+	iter = c.GetLogs(pipelineName, "", nil, "", "", false, false, 0)
 	var numLogs int
 	var loglines []string
 	for iter.Next() {
@@ -3381,7 +3381,7 @@ func testGetLogs(t *testing.T, enableStats bool) {
 	require.NoError(t, iter.Err())
 
 	// Get logs from pipeline, using pipeline (tailing the last two log lines)
-	iter = c.GetLogs(pipelineName, "", nil, "", false, false, 2)
+	iter = c.GetLogs(pipelineName, "", nil, "", "", false, false, 2)
 	numLogs = 0
 	loglines = []string{}
 	for iter.Next() {
@@ -3394,7 +3394,7 @@ func testGetLogs(t *testing.T, enableStats bool) {
 
 	// Get logs from pipeline, using a pipeline that doesn't exist. There should
 	// be an error
-	iter = c.GetLogs("__DOES_NOT_EXIST__", "", nil, "", false, false, 0)
+	iter = c.GetLogs("__DOES_NOT_EXIST__", "", nil, "", "", false, false, 0)
 	require.False(t, iter.Next())
 	require.YesError(t, iter.Err())
 	require.Matches(t, "could not get", iter.Err().Error())
@@ -3407,7 +3407,7 @@ func testGetLogs(t *testing.T, enableStats bool) {
 	// (2) Get logs using extracted job ID
 	// wait for logs to be collected
 	time.Sleep(10 * time.Second)
-	iter = c.GetLogs("", jobInfos[0].Job.ID, nil, "", false, false, 0)
+	iter = c.GetLogs("", jobInfos[0].Job.ID, nil, "", "", false, false, 0)
 	numLogs = 0
 	for iter.Next() {
 		numLogs++
@@ -3418,20 +3418,20 @@ func testGetLogs(t *testing.T, enableStats bool) {
 	require.True(t, numLogs > 0)
 
 	// Get logs for datums but don't specify pipeline or job. These should error
-	iter = c.GetLogs("", "", []string{"/foo"}, "", false, false, 0)
+	iter = c.GetLogs("", "", []string{"/foo"}, "", "", false, false, 0)
 	require.False(t, iter.Next())
 	require.YesError(t, iter.Err())
 
 	resp, err := c.ListDatum(jobInfos[0].Job.ID, 0, 0)
 	require.NoError(t, err)
 	require.True(t, len(resp.DatumInfos) > 0)
-	iter = c.GetLogs("", "", nil, resp.DatumInfos[0].Datum.ID, false, false, 0)
+	iter = c.GetLogs("", "", nil, resp.DatumInfos[0].Datum.ID, "", false, false, 0)
 	require.False(t, iter.Next())
 	require.YesError(t, iter.Err())
 
 	// Get logs from pipeline, using a job that doesn't exist. There should
 	// be an error
-	iter = c.GetLogs("", "__DOES_NOT_EXIST__", nil, "", false, false, 0)
+	iter = c.GetLogs("", "__DOES_NOT_EXIST__", nil, "", "", false, false, 0)
 	require.False(t, iter.Next())
 	require.YesError(t, iter.Err())
 	require.Matches(t, "could not get", iter.Err().Error())
@@ -3444,15 +3444,15 @@ func testGetLogs(t *testing.T, enableStats bool) {
 	// TODO(msteffen) This code shouldn't be wrapped in a backoff, but for some
 	// reason GetLogs is not yet 100% consistent. This reduces flakes in testing.
 	require.NoError(t, backoff.Retry(func() error {
-		pathLog := c.GetLogs("", jobInfos[0].Job.ID, []string{"/file"}, "", false, false, 0)
+		pathLog := c.GetLogs("", jobInfos[0].Job.ID, []string{"/file"}, "", "", false, false, 0)
 
 		hexHash := "19fdf57bdf9eb5a9602bfa9c0e6dd7ed3835f8fd431d915003ea82747707be66"
 		require.Equal(t, hexHash, hex.EncodeToString(fileInfo.Hash)) // sanity-check test
-		hexLog := c.GetLogs("", jobInfos[0].Job.ID, []string{hexHash}, "", false, false, 0)
+		hexLog := c.GetLogs("", jobInfos[0].Job.ID, []string{hexHash}, "", "", false, false, 0)
 
 		base64Hash := "Gf31e9+etalgK/qcDm3X7Tg1+P1DHZFQA+qCdHcHvmY="
 		require.Equal(t, base64Hash, base64.StdEncoding.EncodeToString(fileInfo.Hash))
-		base64Log := c.GetLogs("", jobInfos[0].Job.ID, []string{base64Hash}, "", false, false, 0)
+		base64Log := c.GetLogs("", jobInfos[0].Job.ID, []string{base64Hash}, "", "", false, false, 0)
 
 		numLogs = 0
 		for {
@@ -3486,13 +3486,13 @@ func testGetLogs(t *testing.T, enableStats bool) {
 
 	// Filter logs based on input (using file that doesn't exist). There should
 	// be no logs
-	iter = c.GetLogs("", jobInfos[0].Job.ID, []string{"__DOES_NOT_EXIST__"}, "", false, false, 0)
+	iter = c.GetLogs("", jobInfos[0].Job.ID, []string{"__DOES_NOT_EXIST__"}, "", "", false, false, 0)
 	require.False(t, iter.Next())
 	require.NoError(t, iter.Err())
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
-	iter = c.WithCtx(ctx).GetLogs(pipelineName, "", nil, "", false, false, 0)
+	iter = c.WithCtx(ctx).GetLogs(pipelineName, "", nil, "", "", false, false, 0)
 	numLogs = 0
 	for iter.Next() {
 		numLogs++
@@ -6898,7 +6898,7 @@ func TestPipelineWithJobTimeout(t *testing.T) {
 	// Block on the job being complete before we call ListDatum
 	jobInfo, err := c.InspectJob(jobs[0].Job.ID, true)
 	require.NoError(t, err)
-	require.Equal(t, pps.JobState_JOB_KILLED.String(), jobInfo.State.String())
+	require.Equal(t, pps.JobState_JOB_TIMED_OUT.String(), jobInfo.State.String())
 	started, err := types.TimestampFromProto(jobInfo.Started)
 	require.NoError(t, err)
 	finished, err := types.TimestampFromProto(jobInfo.Finished)
@@ -8012,7 +8012,7 @@ func TestDatumTries(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(jobInfos))
 
-	iter := c.GetLogs("", jobInfos[0].Job.ID, nil, "", false, false, 0)
+	iter := c.GetLogs("", jobInfos[0].Job.ID, nil, "", "", false, false, 0)
 	var observedTries int64
 	for iter.Next() {
 		if strings.Contains(iter.Message().Message, "errored running user code after") {
@@ -1,31 +1,33 @@
 package cmds
 
 import (
+	"archive/tar"
 	"bufio"
-	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	gosync "sync"
-	"syscall"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
-	"github.com/hanwen/go-fuse/fuse/nodefs"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
-	"github.com/pachyderm/pachyderm/src/server/pfs/fuse"
 	"github.com/pachyderm/pachyderm/src/server/pfs/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	pkgpretty "github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 	"github.com/spf13/cobra"
@@ -62,6 +64,74 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateAlias(repoDocs, "repo"))
 
 	var description string
+	var extractSchemas bool
+	var retention string
+	var classification string
+	var getFileFilters []string
+	var maxSize string
+	var maxFiles uint64
+	var maxCommits uint64
+	// repoQuota converts the quota flags into a RepoQuota, or nil if no quota
+	// flag was set (so 'update repo' without quota flags leaves the quota
+	// alone)
+	repoQuota := func() (*pfsclient.RepoQuota, error) {
+		if maxSize == "" && maxFiles == 0 && maxCommits == 0 {
+			return nil, nil
+		}
+		quota := &pfsclient.RepoQuota{
+			MaxFileCount: maxFiles,
+			MaxCommits:   maxCommits,
+		}
+		if maxSize != "" {
+			sizeBytes, err := units.RAMInBytes(maxSize)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse --max-size: %v", err)
+			}
+			quota.MaxSizeBytes = uint64(sizeBytes)
+		}
+		return quota, nil
+	}
+	// repoRetention converts the --retention flag into a Duration, or nil if
+	// the flag wasn't set (so 'update repo' without it leaves the retention
+	// period alone)
+	repoRetention := func() (*types.Duration, error) {
+		if retention == "" {
+			return nil, nil
+		}
+		d, err := time.ParseDuration(retention)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse --retention: %v", err)
+		}
+		return types.DurationProto(d), nil
+	}
+	// repoGetFileFilters parses the --get-file-filter flags, or returns nil if
+	// none were given (so 'update repo' without the flag leaves the filters
+	// alone)
+	repoGetFileFilters := func() ([]*pfsclient.GetFileFilter, error) {
+		var filters []*pfsclient.GetFileFilter
+		for _, filterJSON := range getFileFilters {
+			filter := &pfsclient.GetFileFilter{}
+			if err := jsonpb.UnmarshalString(filterJSON, filter); err != nil {
+				return nil, fmt.Errorf("could not parse --get-file-filter: %v", err)
+			}
+			filters = append(filters, filter)
+		}
+		return filters, nil
+	}
+	getFileFilterFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringArrayVar(&getFileFilters, "get-file-filter", nil, "A server-side content filter as JSON, e.g. '{\"filter\":\"csv-mask\",\"path_glob\":\"/**.csv\",\"options\":{\"columns\":\"ssn\"}}'; 'get file' returns sanitized content to callers below the filter's raw_scope. Can be given multiple times; on 'update repo' the given filters replace the repo's current ones.")
+	}
+	classificationFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&classification, "classification", "", "Data classification of the repo: \"public\", \"internal\", \"confidential\" or \"pii\". Egress and S3 gateway reads of \"confidential\" and \"pii\" repos are blocked unless the destination is allowlisted.")
+	}
+	retentionFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&retention, "retention", "", "Put the repo in WORM mode for compliance: finished commits (and the repo itself) can't be deleted, even by admins, until they're older than this period, e.g. \"17520h\" for 2 years. Can be raised later but never lowered or removed.")
+	}
+	quotaFlags := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&maxSize, "max-size", "", "Maximum total size of the repo's data, e.g. \"100G\" (default: unlimited).")
+		cmd.Flags().Uint64Var(&maxFiles, "max-files", 0, "Maximum number of files in the repo (default: unlimited).")
+		cmd.Flags().Uint64Var(&maxCommits, "max-commits", 0, "Maximum number of commits in the repo (default: unlimited).")
+	}
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
@@ -72,17 +142,39 @@ or type (e.g. csv, binary, images, etc).`,
 				return err
 			}
 			defer c.Close()
+			quota, err := repoQuota()
+			if err != nil {
+				return err
+			}
+			retention, err := repoRetention()
+			if err != nil {
+				return err
+			}
+			filters, err := repoGetFileFilters()
+			if err != nil {
+				return err
+			}
 			_, err = c.PfsAPIClient.CreateRepo(
 				c.Ctx(),
 				&pfsclient.CreateRepoRequest{
-					Repo:        client.NewRepo(args[0]),
-					Description: description,
+					Repo:           client.NewRepo(args[0]),
+					Description:    description,
+					Quota:          quota,
+					ExtractSchemas: extractSchemas,
+					Retention:      retention,
+					Classification: classification,
+					GetFileFilters: filters,
 				},
 			)
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().BoolVar(&extractSchemas, "extract-schemas", false, "Extract the schemas of columnar files (csv, tsv, parquet) when commits finish; view them with 'inspect file --schema'.")
+	quotaFlags(createRepo)
+	retentionFlag(createRepo)
+	classificationFlag(createRepo)
+	getFileFilterFlag(createRepo)
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
 	updateRepo := &cobra.Command{
@@ -95,18 +187,40 @@ or type (e.g. csv, binary, images, etc).`,
 				return err
 			}
 			defer c.Close()
+			quota, err := repoQuota()
+			if err != nil {
+				return err
+			}
+			retention, err := repoRetention()
+			if err != nil {
+				return err
+			}
+			filters, err := repoGetFileFilters()
+			if err != nil {
+				return err
+			}
 			_, err = c.PfsAPIClient.CreateRepo(
 				c.Ctx(),
 				&pfsclient.CreateRepoRequest{
-					Repo:        client.NewRepo(args[0]),
-					Description: description,
-					Update:      true,
+					Repo:           client.NewRepo(args[0]),
+					Description:    description,
+					Update:         true,
+					Quota:          quota,
+					ExtractSchemas: extractSchemas,
+					Retention:      retention,
+					Classification: classification,
+					GetFileFilters: filters,
 				},
 			)
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().BoolVar(&extractSchemas, "extract-schemas", false, "Extract the schemas of columnar files (csv, tsv, parquet) when commits finish; view them with 'inspect file --schema'.")
+	quotaFlags(updateRepo)
+	retentionFlag(updateRepo)
+	classificationFlag(updateRepo)
+	getFileFilterFlag(updateRepo)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
 	inspectRepo := &cobra.Command{
@@ -276,6 +390,7 @@ $ {{alias}} test -p XXX`,
 	startCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
 	commands = append(commands, cmdutil.CreateAlias(startCommit, "start commit"))
 
+	var resume bool
 	finishCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>",
 		Short: "Finish a started commit.",
@@ -290,6 +405,9 @@ $ {{alias}} test -p XXX`,
 				return err
 			}
 			defer cli.Close()
+			if resume {
+				return cli.ResumeFinishCommit(commit.Repo.Name, commit.ID)
+			}
 			if description != "" {
 				_, err := cli.PfsAPIClient.FinishCommit(cli.Ctx(),
 					&pfsclient.FinishCommitRequest{
@@ -303,6 +421,7 @@ $ {{alias}} test -p XXX`,
 	}
 	finishCommit.Flags().StringVarP(&description, "message", "m", "", "A description of this commit's contents (overwrites any existing commit description)")
 	finishCommit.Flags().StringVar(&description, "description", "", "A description of this commit's contents (synonym for --message)")
+	finishCommit.Flags().BoolVar(&resume, "resume", false, "Resume a finish that was interrupted (e.g. by a pachd crash) or failed, leaving the commit stuck in the finishing or failed state.")
 	commands = append(commands, cmdutil.CreateAlias(finishCommit, "finish commit"))
 
 	inspectCommit := &cobra.Command{
@@ -464,6 +583,139 @@ $ {{alias}} foo@XXX -r bar -r baz`,
 	flushCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(flushCommit, "flush commit"))
 
+	commitSetDocs := &cobra.Command{
+		Short: "Docs for commit sets.",
+		Long: `A commit set is the set of commits produced across repos by one
+triggering commit: the triggering commit itself plus every commit derived
+from it downstream in the DAG.
+
+Commit sets let you reason about one pipeline run across the DAG instead of
+per-repo commits: 'list commitset' shows recent runs, 'inspect commitset'
+shows every commit in a run, and 'wait commitset' blocks until a run has
+finished everywhere. A commit set's ID is the ID of its triggering commit.`,
+	}
+	cmdutil.SetDocsUsage(commitSetDocs)
+	commands = append(commands, cmdutil.CreateAlias(commitSetDocs, "commitset"))
+
+	inspectCommitSet := &cobra.Command{
+		Use:   "{{alias}} <commit-set>",
+		Short: "Return all commits in a commit set.",
+		Long:  "Return all commits in a commit set.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			commitSetInfo, err := c.InspectCommitSet(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, commitSetInfo)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
+			for _, commitInfo := range commitSetInfo.Commits {
+				pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps)
+			}
+			return writer.Flush()
+		}),
+	}
+	inspectCommitSet.Flags().AddFlagSet(rawFlags)
+	inspectCommitSet.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(inspectCommitSet, "inspect commitset"))
+
+	listCommitSet := &cobra.Command{
+		Short: "Return all commit sets, newest first.",
+		Long:  "Return all commit sets, newest first.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			if raw {
+				return c.ListCommitSetF(func(csi *pfsclient.CommitSetInfo) error {
+					return marshaller.Marshal(os.Stdout, csi)
+				})
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitSetHeader)
+			if err := c.ListCommitSetF(func(csi *pfsclient.CommitSetInfo) error {
+				pretty.PrintCommitSetInfo(writer, csi, fullTimestamps)
+				return nil
+			}); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}),
+	}
+	listCommitSet.Flags().AddFlagSet(rawFlags)
+	listCommitSet.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(listCommitSet, "list commitset"))
+
+	waitCommitSet := &cobra.Command{
+		Use:   "{{alias}} <commit-set>",
+		Short: "Wait for all commits in a commit set to finish and return them.",
+		Long:  "Wait for all commits in a commit set to finish and return them.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			commitSetInfo, err := c.InspectCommitSet(args[0])
+			if err != nil {
+				return err
+			}
+			commitIter, err := c.FlushCommit([]*pfsclient.Commit{commitSetInfo.Commit}, nil)
+			if err != nil {
+				return err
+			}
+			return printCommitIter(commitIter)
+		}),
+	}
+	waitCommitSet.Flags().AddFlagSet(rawFlags)
+	waitCommitSet.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(waitCommitSet, "wait commitset"))
+
+	var dryRun bool
+	deleteCommitSet := &cobra.Command{
+		Use:   "{{alias}} <commit-set>",
+		Short: "Delete a commit set: the triggering commit and all commits derived from it.",
+		Long: `Delete a commit set: the triggering commit and all commits derived from it.
+
+The deletion is atomic, so derived data is never left behind after the
+triggering commit is gone. The deleted commits are printed; with --dry-run
+they're printed without anything being deleted.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+
+			commitSetInfo, err := c.DeleteCommitSet(args[0], dryRun)
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, commitSetInfo)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
+			for _, commitInfo := range commitSetInfo.Commits {
+				pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps)
+			}
+			return writer.Flush()
+		}),
+	}
+	deleteCommitSet.Flags().BoolVar(&dryRun, "dry-run", false, "Print the commits that would be deleted without deleting anything.")
+	deleteCommitSet.Flags().AddFlagSet(rawFlags)
+	deleteCommitSet.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(deleteCommitSet, "delete commitset"))
+
 	var newCommits bool
 	subscribeCommit := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch>",
@@ -625,6 +877,76 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 	deleteBranch.Flags().BoolVarP(&force, "force", "f", false, "remove the branch regardless of errors; use with care")
 	commands = append(commands, cmdutil.CreateAlias(deleteBranch, "delete branch"))
 
+	createTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit> <tag>",
+		Short: "Create an immutable tag pointing to a commit.",
+		Long:  "Create an immutable tag pointing to a commit. The tag can be used anywhere a commit ID is accepted; to reuse a tag name it must be deleted first.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.CreateCommitTag(commit.Repo.Name, commit.ID, args[1])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(createTag, "create tag"))
+
+	listTag := &cobra.Command{
+		Use:   "{{alias}} <repo>",
+		Short: "Return all tags on a repo.",
+		Long:  "Return all tags on a repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			tags, err := client.ListCommitTag(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, tag := range tags {
+					if err := marshaller.Marshal(os.Stdout, tag); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.TagHeader)
+			for _, tag := range tags {
+				pretty.PrintTag(writer, tag)
+			}
+			return writer.Flush()
+		}),
+	}
+	listTag.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(listTag, "list tag"))
+
+	deleteTag := &cobra.Command{
+		Use:   "{{alias}} <repo>@<tag>",
+		Short: "Delete a tag",
+		Long:  "Delete a tag, while leaving the commit it points to intact",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			tag, err := cmdutil.ParseBranch(args[0])
+			if err != nil {
+				return err
+			}
+			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.DeleteCommitTag(tag.Repo.Name, tag.Name)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteTag, "delete tag"))
+
 	fileDocs := &cobra.Command{
 		Short: "Docs for files.",
 		Long: `Files are the lowest level data objects in Pachyderm.
@@ -638,6 +960,7 @@ from commits with 'get file'.`,
 
 	var filePaths []string
 	var recursive bool
+	var tarStream bool
 	var inputFile string
 	var parallelism int
 	var split string
@@ -672,6 +995,9 @@ $ {{alias}} -r repo branch -f dir
 # Put the contents of a directory as repo/branch/file, i.e. put files at the top level:
 $ {{alias}} -r repo branch / -f dir
 
+# Stream the contents of a directory as a tarball, preserving its paths:
+$ tar cf - dir | {{alias}} repo branch --tar
+
 # Put the data from a URL as repo/branch/path:
 $ {{alias}} repo branch path -f http://host/path
 
@@ -711,6 +1037,18 @@ $ {{alias}} repo branch -i http://host/path`,
 			if putFileCommit {
 				fmt.Fprintf(os.Stderr, "flag --commit / -c is deprecated; as of 1.7.2, you will get the same behavior without it\n")
 			}
+			if tarStream {
+				if inputFile != "" {
+					return fmt.Errorf("--tar cannot be used with -i")
+				}
+				if len(filePaths) != 1 || filePaths[0] != "-" {
+					return fmt.Errorf("--tar reads the stream from stdin, it cannot be used with -f")
+				}
+				if split != "" {
+					return fmt.Errorf("--tar cannot be used with --split")
+				}
+				return putFileTarStream(pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, overwrite, os.Stdin)
+			}
 
 			limiter := limit.New(int(parallelism))
 			var sources []string
@@ -787,6 +1125,7 @@ $ {{alias}} repo branch -i http://host/path`,
 	putFile.Flags().StringSliceVarP(&filePaths, "file", "f", []string{"-"}, "The file to be put, it can be a local file or a URL.")
 	putFile.Flags().StringVarP(&inputFile, "input-file", "i", "", "Read filepaths or URLs from a file.  If - is used, paths are read from the standard input.")
 	putFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively put the files in a directory.")
+	putFile.Flags().BoolVar(&tarStream, "tar", false, "Read a tar stream from stdin and put each file in it. This streams an entire directory over one connection, which is much faster than per-file uploads when there are many small files.")
 	putFile.Flags().IntVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel.")
 	putFile.Flags().StringVar(&split, "split", "", "Split the input file into smaller files, subject to the constraints of --target-file-datums and --target-file-bytes. Permissible values are `line`, `json`, `sql` and `csv`.")
 	putFile.Flags().UintVar(&targetFileDatums, "target-file-datums", 0, "The upper bound of the number of datums that each file contains, the last file will contain fewer if the datums don't divide evenly; needs to be used with --split.")
@@ -857,19 +1196,11 @@ $ {{alias}} foo@master^2:XXX`,
 				puller := sync.NewPuller()
 				return puller.Pull(client, outputPath, file.Commit.Repo.Name, file.Commit.ID, file.Path, false, false, parallelism, nil, "")
 			}
-			var w io.Writer
-			// If an output path is given, print the output to stdout
+			// If no output path is given, print the output to stdout
 			if outputPath == "" {
-				w = os.Stdout
-			} else {
-				f, err := os.Create(outputPath)
-				if err != nil {
-					return err
-				}
-				defer f.Close()
-				w = f
+				return client.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, 0, 0, os.Stdout)
 			}
-			return client.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, 0, 0, w)
+			return getFileToPath(client, file, outputPath)
 		}),
 	}
 	getFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively download a directory.")
@@ -877,6 +1208,7 @@ $ {{alias}} foo@master^2:XXX`,
 	getFile.Flags().IntVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be downloaded in parallel")
 	commands = append(commands, cmdutil.CreateAlias(getFile, "get file"))
 
+	var showSchema bool
 	inspectFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>:<path/in/pfs>",
 		Short: "Return info about a file.",
@@ -898,16 +1230,37 @@ $ {{alias}} foo@master^2:XXX`,
 			if fileInfo == nil {
 				return fmt.Errorf("file %s not found", file.Path)
 			}
+			if showSchema {
+				if fileInfo.Schema == nil {
+					return fmt.Errorf("no schema recorded for %s (columnar schema extraction is enabled per repo with --extract-schemas)", args[0])
+				}
+				if raw {
+					return marshaller.Marshal(os.Stdout, fileInfo.Schema)
+				}
+				fmt.Printf("Format: %s\n", fileInfo.Schema.Format)
+				if fileInfo.Schema.RowCount >= 0 {
+					fmt.Printf("Rows: %d\n", fileInfo.Schema.RowCount)
+				}
+				writer := tabwriter.NewWriter(os.Stdout, pretty.SchemaHeader)
+				for _, column := range fileInfo.Schema.Columns {
+					pretty.PrintSchemaColumn(writer, column)
+				}
+				return writer.Flush()
+			}
 			if raw {
 				return marshaller.Marshal(os.Stdout, fileInfo)
 			}
 			return pretty.PrintDetailedFileInfo(fileInfo)
 		}),
 	}
+	inspectFile.Flags().BoolVar(&showSchema, "schema", false, "Print the file's extracted columnar schema (see 'create repo --extract-schemas') instead of its metadata.")
 	inspectFile.Flags().AddFlagSet(rawFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
 	var history int64
+	var pathsOnly bool
+	var print0 bool
+	var relative bool
 	listFile := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/in/pfs>]",
 		Short: "Return the files in a directory.",
@@ -931,7 +1284,11 @@ $ {{alias}} foo@master^2
 $ {{alias}} foo@master --history n
 
 # list all versions of top-level files on branch "master" in repo "foo"
-$ {{alias}} foo@master --history -1`,
+$ {{alias}} foo@master --history -1
+
+# list every file under directory "dir" (recursively) on branch "master" in
+# repo "foo"
+$ {{alias}} foo@master:dir -R`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			file, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -942,13 +1299,30 @@ $ {{alias}} foo@master --history -1`,
 				return err
 			}
 			defer client.Close()
+			if recursive && history != 0 {
+				return fmt.Errorf("cannot use --history with --recursive")
+			}
+			// With --recursive, walk the whole subtree server-side (one
+			// WalkFile call) instead of listing one directory
+			listFileF := func(f func(fi *pfsclient.FileInfo) error) error {
+				if recursive {
+					return client.WalkF(file.Commit.Repo.Name, file.Commit.ID, file.Path, f)
+				}
+				return client.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, f)
+			}
 			if raw {
-				return client.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
+				return listFileF(func(fi *pfsclient.FileInfo) error {
 					return marshaller.Marshal(os.Stdout, fi)
 				})
 			}
+			if pathsOnly || print0 {
+				return listFileF(func(fi *pfsclient.FileInfo) error {
+					pretty.PrintFilePath(os.Stdout, fi, print0, relative)
+					return nil
+				})
+			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
-			if err := client.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
+			if err := listFileF(func(fi *pfsclient.FileInfo) error {
 				pretty.PrintFileInfo(writer, fi, fullTimestamps)
 				return nil
 			}); err != nil {
@@ -960,6 +1334,10 @@ $ {{alias}} foo@master --history -1`,
 	listFile.Flags().AddFlagSet(rawFlags)
 	listFile.Flags().AddFlagSet(fullTimestampsFlags)
 	listFile.Flags().Int64Var(&history, "history", 0, "Return revision history for files.")
+	listFile.Flags().BoolVar(&pathsOnly, "paths-only", false, "Print only file paths, one per line, for piping into other tools.")
+	listFile.Flags().BoolVar(&print0, "print0", false, "Print file paths separated by NUL bytes, for xargs -0 (implies --paths-only).")
+	listFile.Flags().BoolVar(&relative, "relative", false, "With --paths-only or --print0, print paths relative to the repo root (no leading slash).")
+	listFile.Flags().BoolVarP(&recursive, "recursive", "R", false, "List files under the given path recursively (a single server-side traversal, rather than one call per directory).")
 	commands = append(commands, cmdutil.CreateAlias(listFile, "list file"))
 
 	globFile := &cobra.Command{
@@ -996,6 +1374,12 @@ $ {{alias}} "foo@master:data/*"`,
 				}
 				return nil
 			}
+			if pathsOnly || print0 {
+				for _, fileInfo := range fileInfos {
+					pretty.PrintFilePath(os.Stdout, fileInfo, print0, relative)
+				}
+				return nil
+			}
 			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
 			for _, fileInfo := range fileInfos {
 				pretty.PrintFileInfo(writer, fileInfo, fullTimestamps)
@@ -1005,9 +1389,109 @@ $ {{alias}} "foo@master:data/*"`,
 	}
 	globFile.Flags().AddFlagSet(rawFlags)
 	globFile.Flags().AddFlagSet(fullTimestampsFlags)
+	globFile.Flags().BoolVar(&pathsOnly, "paths-only", false, "Print only file paths, one per line, for piping into other tools.")
+	globFile.Flags().BoolVar(&print0, "print0", false, "Print file paths separated by NUL bytes, for xargs -0 (implies --paths-only).")
+	globFile.Flags().BoolVar(&relative, "relative", false, "With --paths-only or --print0, print paths relative to the repo root (no leading slash).")
 	commands = append(commands, cmdutil.CreateAlias(globFile, "glob file"))
 
+	var findName string
+	var findSize string
+	var newerThan string
+	findFile := &cobra.Command{
+		Use:   "{{alias}} <repo>@<branch-or-commit>[:<path/in/pfs>]",
+		Short: "Search a commit for files by name, size and date.",
+		Long: `Search a commit for files by name, size and date.
+
+The search runs server-side over the commit's file metadata, so predicates
+that globbing can't express (size and modification date) don't require
+downloading or listing anything client-side. Only regular files are returned.`,
+		Example: `
+# find CSV files on branch "master" in repo "foo"
+$ {{alias}} foo@master --name "*.csv"
+
+# find files bigger than 100MB under directory "logs"
+$ {{alias}} foo@master:logs --size +100M
+
+# find files added or modified in the last week
+$ {{alias}} foo@master --newer-than 7d`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			file, err := cmdutil.ParseFile(args[0])
+			if err != nil {
+				return err
+			}
+			request := &pfsclient.FindFileRequest{
+				Commit: file.Commit,
+				Path:   file.Path,
+				Name:   findName,
+			}
+			if findSize != "" {
+				sizeBytes, err := units.RAMInBytes(findSize[1:])
+				if err != nil {
+					return fmt.Errorf("could not parse size %q: %v", findSize, err)
+				}
+				switch findSize[0] {
+				case '+':
+					request.MinSizeBytes = uint64(sizeBytes)
+				case '-':
+					request.MaxSizeBytes = uint64(sizeBytes)
+				default:
+					return fmt.Errorf("--size must start with + (bigger than) or - (smaller than), e.g. +100M")
+				}
+			}
+			if newerThan != "" {
+				age, err := parseDuration(newerThan)
+				if err != nil {
+					return fmt.Errorf("could not parse age %q: %v", newerThan, err)
+				}
+				if request.ModifiedSince, err = types.TimestampProto(time.Now().Add(-age)); err != nil {
+					return err
+				}
+			}
+			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			fs, err := client.PfsAPIClient.FindFile(client.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
+			for {
+				fileInfo, err := fs.Recv()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				if raw {
+					if err := marshaller.Marshal(os.Stdout, fileInfo); err != nil {
+						return err
+					}
+				} else if pathsOnly || print0 {
+					pretty.PrintFilePath(os.Stdout, fileInfo, print0, relative)
+				} else {
+					pretty.PrintFileInfo(writer, fileInfo, fullTimestamps)
+				}
+			}
+			if raw || pathsOnly || print0 {
+				return nil
+			}
+			return writer.Flush()
+		}),
+	}
+	findFile.Flags().AddFlagSet(rawFlags)
+	findFile.Flags().AddFlagSet(fullTimestampsFlags)
+	findFile.Flags().StringVar(&findName, "name", "", "Only return files whose base name matches this glob pattern (e.g. \"*.csv\").")
+	findFile.Flags().StringVar(&findSize, "size", "", "Only return files bigger (+) or smaller (-) than this size, e.g. +100M or -1G.")
+	findFile.Flags().StringVar(&newerThan, "newer-than", "", "Only return files added or modified by a commit within this age, e.g. 7d or 12h.")
+	findFile.Flags().BoolVar(&pathsOnly, "paths-only", false, "Print only file paths, one per line, for piping into other tools.")
+	findFile.Flags().BoolVar(&print0, "print0", false, "Print file paths separated by NUL bytes, for xargs -0 (implies --paths-only).")
+	findFile.Flags().BoolVar(&relative, "relative", false, "With --paths-only or --print0, print paths relative to the repo root (no leading slash).")
+	commands = append(commands, cmdutil.CreateAlias(findFile, "find"))
+
 	var shallow bool
+	var tabular bool
 	diffFile := &cobra.Command{
 		Use:   "{{alias}} <new-repo>@<new-branch-or-commit>:<new-path> [<old-repo>@<old-branch-or-commit>:<old-path>]",
 		Short: "Return a diff of two file trees.",
@@ -1019,7 +1503,11 @@ $ {{alias}} foo@master:path
 
 # Return the diff between the master branches of repos foo and bar at paths
 # path1 and path2, respectively.
-$ {{alias}} foo@master:path1 bar@master:path2`,
+$ {{alias}} foo@master:path1 bar@master:path2
+
+# Summarize the row- and column-level changes of the csv file "data.csv"
+# between the head of "master" and its parent.
+$ {{alias}} foo@master:data.csv --tabular`,
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
 			newFile, err := cmdutil.ParseFile(args[0])
 			if err != nil {
@@ -1039,6 +1527,35 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			}
 			defer client.Close()
 
+			if tabular {
+				diff, err := client.DiffTabularFile(
+					newFile.Commit.Repo.Name, newFile.Commit.ID, newFile.Path,
+					oldFile.Commit.Repo.Name, oldFile.Commit.ID, oldFile.Path,
+				)
+				if err != nil {
+					return err
+				}
+				if raw {
+					return marshaller.Marshal(os.Stdout, diff)
+				}
+				fmt.Printf("Format: %s\n", diff.Format)
+				if diff.Sampled {
+					fmt.Println("(row counts are estimates from a bounded sample of each file)")
+				}
+				fmt.Printf("Rows Added: %d\n", diff.RowsAdded)
+				fmt.Printf("Rows Removed: %d\n", diff.RowsRemoved)
+				if len(diff.ColumnsAdded) > 0 {
+					fmt.Printf("Columns Added: %s\n", strings.Join(diff.ColumnsAdded, ", "))
+				}
+				if len(diff.ColumnsRemoved) > 0 {
+					fmt.Printf("Columns Removed: %s\n", strings.Join(diff.ColumnsRemoved, ", "))
+				}
+				if len(diff.ColumnsChanged) > 0 {
+					fmt.Printf("Columns Changed: %s\n", strings.Join(diff.ColumnsChanged, ", "))
+				}
+				return nil
+			}
+
 			newFiles, oldFiles, err := client.DiffFile(
 				newFile.Commit.Repo.Name, newFile.Commit.ID, newFile.Path,
 				oldFile.Commit.Repo.Name, oldFile.Commit.ID, oldFile.Path,
@@ -1072,6 +1589,8 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 		}),
 	}
 	diffFile.Flags().BoolVarP(&shallow, "shallow", "s", false, "Specifies whether or not to diff subdirectories")
+	diffFile.Flags().BoolVar(&tabular, "tabular", false, "For csv, tsv and parquet files, summarize row- and column-level changes instead of listing changed files.")
+	diffFile.Flags().AddFlagSet(rawFlags)
 	diffFile.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(diffFile, "diff file"))
 
@@ -1166,103 +1685,125 @@ Tags are a low-level resource and should not be accessed directly by most users.
 	}
 	commands = append(commands, cmdutil.CreateAlias(fsck, "fsck"))
 
-	var debug bool
-	var commits cmdutil.RepeatedStringArg
-	mount := &cobra.Command{
-		Use:   "{{alias}} <path/to/mount/point>",
-		Short: "Mount pfs locally. This command blocks.",
-		Long:  "Mount pfs locally. This command blocks.",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
-			client, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "fuse")
+	miscDocs := &cobra.Command{
+		Short: "Miscellaneous debugging utilities.",
+		Long:  "Miscellaneous debugging utilities.",
+	}
+	cmdutil.SetDocsUsage(miscDocs)
+	commands = append(commands, cmdutil.CreateAlias(miscDocs, "misc"))
+
+	var sampleSize int64
+	testGlob := &cobra.Command{
+		Use:   `{{alias}} <repo>@<branch-or-commit> <pattern>`,
+		Short: "Test a glob pattern against a commit.",
+		Long:  "Test a glob pattern against a commit. Returns the number of matching files, a sample of the matches, and size statistics, so you can debug a pipeline's glob expression without creating the pipeline. Glob patterns are documented [here](https://golang.org/pkg/path/filepath/#Match).",
+		Example: `
+# Test how many datums the glob "/*" would produce against the master branch of
+# the repo "foo".  Note how the double quotation marks around the pattern are
+# necessary because otherwise your shell might interpret the "*".
+$ {{alias}} foo@master "/*"`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			commit, err := cmdutil.ParseCommit(args[0])
 			if err != nil {
 				return err
 			}
-			defer client.Close()
-			mountPoint := args[0]
-			commits, err := parseCommits(commits)
+			c, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return err
 			}
-			opts := &fuse.Options{
-				Fuse: &nodefs.Options{
-					Debug: debug,
-				},
-				Commits: commits,
+			defer c.Close()
+			resp, err := c.GlobPreview(commit.Repo.Name, commit.ID, args[1], sampleSize)
+			if err != nil {
+				return err
 			}
-			return fuse.Mount(client, mountPoint, opts)
-		}),
-	}
-	mount.Flags().BoolVarP(&debug, "debug", "d", false, "Turn on debug messages.")
-	mount.Flags().VarP(&commits, "commits", "c", "Commits to mount for repos, arguments should be of the form \"repo@commit\"")
-	mount.MarkFlagCustom("commits", "__pachctl_get_repo_branch")
-	commands = append(commands, cmdutil.CreateAlias(mount, "mount"))
-
-	unmount := &cobra.Command{
-		Use:   "{{alias}} <path/to/mount/point>",
-		Short: "Unmount pfs.",
-		Long:  "Unmount pfs.",
-		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
-			if len(args) == 1 {
-				return syscall.Unmount(args[0], 0)
+			if raw {
+				return marshaller.Marshal(os.Stdout, resp)
 			}
-
-			if all {
-				stdin := strings.NewReader(`
-	mount | grep pfs:// | cut -f 3 -d " "
-	`)
-				var stdout bytes.Buffer
-				if err := cmdutil.RunIO(cmdutil.IO{
-					Stdin:  stdin,
-					Stdout: &stdout,
-					Stderr: os.Stderr,
-				}, "sh"); err != nil {
-					return err
-				}
-				scanner := bufio.NewScanner(&stdout)
-				var mounts []string
-				for scanner.Scan() {
-					mounts = append(mounts, scanner.Text())
-				}
-				if len(mounts) == 0 {
-					fmt.Println("No mounts found.")
-					return nil
-				}
-				fmt.Printf("Unmount the following filesystems? yN\n")
-				for _, mount := range mounts {
-					fmt.Printf("%s\n", mount)
-				}
-				r := bufio.NewReader(os.Stdin)
-				bytes, err := r.ReadBytes('\n')
-				if err != nil {
-					return err
-				}
-				if bytes[0] == 'y' || bytes[0] == 'Y' {
-					for _, mount := range mounts {
-						if err := syscall.Unmount(mount, 0); err != nil {
-							return err
-						}
-					}
-				}
+			fmt.Printf("Matches: %d\n", resp.NumMatches)
+			if resp.NumMatches == 0 {
+				return nil
 			}
-			return nil
+			fmt.Printf("Size: %s min / %s avg / %s max / %s total\n",
+				pkgpretty.Size(resp.MinSizeBytes), pkgpretty.Size(resp.AvgSizeBytes),
+				pkgpretty.Size(resp.MaxSizeBytes), pkgpretty.Size(resp.TotalSizeBytes))
+			fmt.Println("Sample matches:")
+			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
+			for _, fileInfo := range resp.Sample {
+				pretty.PrintFileInfo(writer, fileInfo, fullTimestamps)
+			}
+			return writer.Flush()
 		}),
 	}
-	unmount.Flags().BoolVarP(&all, "all", "a", false, "unmount all pfs mounts")
-	commands = append(commands, cmdutil.CreateAlias(unmount, "unmount"))
+	testGlob.Flags().Int64Var(&sampleSize, "sample", 10, "Number of sample matches to return.")
+	testGlob.Flags().AddFlagSet(rawFlags)
+	testGlob.Flags().AddFlagSet(fullTimestampsFlags)
+	commands = append(commands, cmdutil.CreateAlias(testGlob, "misc test-glob"))
+
+	commands = append(commands, mountCmds(noMetrics, noPortForwarding)...)
 
 	return commands
 }
 
-func parseCommits(args []string) (map[string]string, error) {
-	result := make(map[string]string)
-	for _, arg := range args {
-		split := strings.Split(arg, "@")
-		if len(split) != 2 {
-			return nil, fmt.Errorf("malformed input %s, must be of the form repo@commit", args)
+// getFileToPath downloads a file to outputPath atomically: the data is written
+// to a temporary file in the destination directory and only renamed into place
+// once the download has completed and its size has been verified, so an
+// interrupted download never leaves a truncated file at outputPath. Parent
+// directories of outputPath are created if they don't exist, and the
+// downloaded file's modification time is set to the time the file was
+// committed.
+func getFileToPath(c *client.APIClient, file *pfsclient.File, outputPath string) (retErr error) {
+	fileInfo, err := c.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0700); err != nil {
+		return err
+	}
+	// The temporary file must be in the same directory as outputPath so that
+	// the rename below doesn't cross filesystems. ioutil.TempFile picks a
+	// unique name, so concurrent downloads to the same outputPath don't
+	// clobber each other's partial data.
+	f, err := ioutil.TempFile(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(f.Name())
 		}
-		result[split[0]] = split[1]
+	}()
+	if err := c.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, 0, 0, f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
 	}
-	return result, nil
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if uint64(stat.Size()) != fileInfo.SizeBytes {
+		return fmt.Errorf("downloaded %d bytes for file %s, expected %d (the file may have changed mid-download)", stat.Size(), file.Path, fileInfo.SizeBytes)
+	}
+	// ioutil.TempFile creates the file 0600; give the finished download the
+	// mode os.Create would have produced.
+	if err := os.Chmod(f.Name(), downloadFileMode); err != nil {
+		return err
+	}
+	if fileInfo.Committed != nil {
+		if committed, err := types.TimestampFromProto(fileInfo.Committed); err == nil {
+			if err := os.Chtimes(f.Name(), committed, committed); err != nil {
+				return err
+			}
+		}
+	}
+	return os.Rename(f.Name(), outputPath)
 }
 
 func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
@@ -1271,8 +1812,9 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
 	split string, targetFileDatums, targetFileBytes, headerRecords uint, // split
 	filesPut *gosync.Map) (retErr error) {
 	// Resolve the path, then trim any prefixed '../' to avoid sending bad paths
-	// to the server
-	path = filepath.Clean(path)
+	// to the server. ToSlash undoes Clean's conversion to the OS separator on
+	// Windows, since this is a pfs path.
+	path = filepath.ToSlash(filepath.Clean(path))
 	for strings.HasPrefix(path, "../") {
 		path = strings.TrimPrefix(path, "../")
 	}
@@ -1334,7 +1876,7 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
 			if info.IsDir() {
 				return nil
 			}
-			childDest := filepath.Join(path, strings.TrimPrefix(filePath, source))
+			childDest := filepath.ToSlash(filepath.Join(path, strings.TrimPrefix(filePath, source)))
 			eg.Go(func() error {
 				// don't do a second recursive 'put file', just put the one file at
 				// filePath into childDest, and then this walk loop will go on to the
@@ -1351,6 +1893,17 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
 	}
 	limiter.Acquire()
 	defer limiter.Release()
+	stat, err := os.Lstat(source)
+	if err != nil {
+		return err
+	}
+	if stat.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(source)
+		if err != nil {
+			return err
+		}
+		return pfc.PutFileSymlink(repo, commit, path, target)
+	}
 	f, err := os.Open(source)
 	if err != nil {
 		return err
@@ -1360,9 +1913,52 @@ func putFileHelper(c *client.APIClient, pfc client.PutFileClient,
 			retErr = err
 		}
 	}()
+	if split == "" && !overwrite {
+		// Record the file's mode bits so downloads can restore them.
+		_, err := pfc.PutFileWithMetadata(repo, commit, path, uint32(stat.Mode().Perm()), f)
+		return err
+	}
 	return putFile(f)
 }
 
+// putFileTarStream reads a tar stream from r and puts each regular file in it
+// under prefix, so an entire directory can be streamed over one connection
+// instead of one RPC per file.
+func putFileTarStream(pfc client.PutFileClient, repo, commit, prefix string, overwrite bool, r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dest := joinPaths(prefix, hdr.Name)
+		switch hdr.Typeflag {
+		case tar.TypeSymlink:
+			if err := pfc.PutFileSymlink(repo, commit, dest, hdr.Linkname); err != nil {
+				return err
+			}
+			continue
+		case tar.TypeReg:
+		default:
+			continue
+		}
+		if overwrite {
+			if _, err := pfc.PutFileOverwrite(repo, commit, dest, tr, 0); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := pfc.PutFileWithMetadata(repo, commit, dest, uint32(hdr.Mode&0777), tr); err != nil {
+			return err
+		}
+	}
+}
+
+// joinPaths joins a pfs path prefix with a local file path or URL. The result
+// is a pfs path, which is always slash-separated, even on Windows.
 func joinPaths(prefix, filePath string) string {
 	if url, err := url.Parse(filePath); err == nil && url.Scheme != "" {
 		if url.Scheme == "pfs" {
@@ -1372,9 +1968,22 @@ func joinPaths(prefix, filePath string) string {
 			if len(splitPath) < 3 {
 				return prefix
 			}
-			return filepath.Join(append([]string{prefix}, splitPath[2:]...)...)
+			return filepath.ToSlash(filepath.Join(append([]string{prefix}, splitPath[2:]...)...))
+		}
+		return filepath.ToSlash(filepath.Join(prefix, strings.TrimPrefix(url.Path, "/")))
+	}
+	return filepath.ToSlash(filepath.Join(prefix, filePath))
+}
+
+// parseDuration parses a duration such as "24h" or "7d" (time.ParseDuration
+// syntax, plus a "d" suffix meaning days).
+func parseDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse duration %q: %v", s, err)
 		}
-		return filepath.Join(prefix, strings.TrimPrefix(url.Path, "/"))
+		return time.Duration(days * float64(24*time.Hour)), nil
 	}
-	return filepath.Join(prefix, filePath)
+	return time.ParseDuration(s)
 }
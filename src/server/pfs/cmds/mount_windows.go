@@ -0,0 +1,38 @@
+// +build windows
+
+package cmds
+
+import (
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// mountCmds returns stubs for the mount and unmount commands, FUSE isn't
+// available on Windows so all they do is print an error.
+func mountCmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
+	var commands []*cobra.Command
+
+	mount := &cobra.Command{
+		Use:   "{{alias}} <path/to/mount/point>",
+		Short: "Mount pfs locally. This command blocks.",
+		Long:  "Mount pfs locally. This command blocks.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			return fmt.Errorf("mounting pfs is not supported on Windows")
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(mount, "mount"))
+
+	unmount := &cobra.Command{
+		Use:   "{{alias}} <path/to/mount/point>",
+		Short: "Unmount pfs.",
+		Long:  "Unmount pfs.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			return fmt.Errorf("mounting pfs is not supported on Windows")
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(unmount, "unmount"))
+
+	return commands
+}
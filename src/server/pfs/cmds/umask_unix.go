@@ -0,0 +1,18 @@
+// +build !windows
+
+package cmds
+
+import (
+	"os"
+	"syscall"
+)
+
+// downloadFileMode is the mode 'get file --output' gives finished downloads:
+// what os.Create would have produced, i.e. 0666 with the process umask
+// applied. Reading the umask requires briefly clearing it, which is
+// process-wide, so it's captured once at startup rather than per download.
+var downloadFileMode = func() os.FileMode {
+	umask := syscall.Umask(0)
+	syscall.Umask(umask)
+	return os.FileMode(0666 &^ umask)
+}()
@@ -0,0 +1,9 @@
+// +build windows
+
+package cmds
+
+import "os"
+
+// downloadFileMode is the mode 'get file --output' gives finished downloads.
+// Windows has no umask; 0666 is what os.Create produces there.
+var downloadFileMode os.FileMode = 0666
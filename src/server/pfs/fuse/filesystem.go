@@ -63,6 +63,24 @@ func (fs *filesystem) GetAttr(name string, context *fuse.Context) (*fuse.Attr, f
 	return fs.getAttr(name)
 }
 
+func (fs *filesystem) Readlink(name string, context *fuse.Context) (string, fuse.Status) {
+	_, f, err := fs.parsePath(name)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	if f == nil {
+		return "", fuse.EINVAL
+	}
+	fi, err := fs.c.InspectFile(f.Commit.Repo.Name, f.Commit.ID, f.Path)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	if fi.SymlinkTarget == "" {
+		return "", fuse.EINVAL
+	}
+	return fi.SymlinkTarget, fuse.OK
+}
+
 func (fs *filesystem) OpenDir(name string, context *fuse.Context) ([]fuse.DirEntry, fuse.Status) {
 	var result []fuse.DirEntry
 	r, f, err := fs.parsePath(name)
@@ -203,6 +221,13 @@ func repoDirEntry(ri *pfs.RepoInfo) fuse.DirEntry {
 func fileMode(fi *pfs.FileInfo) uint32 {
 	switch fi.FileType {
 	case pfs.FileType_FILE:
+		if fi.SymlinkTarget != "" {
+			return fuse.S_IFLNK | 0777
+		}
+		if fi.Mode != 0 {
+			// Mask out write bits, the mount is read-only.
+			return fuse.S_IFREG | (fi.Mode & 0555)
+		}
 		return modeFile
 	case pfs.FileType_DIR:
 		return modeDir
@@ -0,0 +1,203 @@
+package fuse
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/hanwen/go-fuse/fuse"
+	"github.com/hanwen/go-fuse/fuse/nodefs"
+	"github.com/hanwen/go-fuse/fuse/pathfs"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// MountSubtree mounts the subtree of a single commit rooted at file.Path at
+// mountPoint; paths outside the subtree aren't visible. Nothing is downloaded
+// upfront: directory listings and reads are translated into PFS calls as they
+// happen, so a reader that only touches a small slice of a large file only
+// downloads that slice.
+//
+// Unlike Mount, MountSubtree doesn't block; it returns once the mount is
+// ready and the returned server's Unmount method tears it down.
+func MountSubtree(c *client.APIClient, mountPoint string, file *pfs.File) (*fuse.Server, error) {
+	nfs := pathfs.NewPathNodeFs(newSubtreeFilesystem(c, file), nil)
+	server, _, err := nodefs.MountRoot(mountPoint, nfs.Root(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("nodefs.MountRoot: %v", err)
+	}
+	go server.Serve()
+	if err := server.WaitMount(); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+type subtreeFilesystem struct {
+	pathfs.FileSystem
+	c      *client.APIClient
+	file   *pfs.File
+	target []string // the components of file.Path
+}
+
+func newSubtreeFilesystem(c *client.APIClient, file *pfs.File) pathfs.FileSystem {
+	return &subtreeFilesystem{
+		FileSystem: pathfs.NewDefaultFileSystem(),
+		c:          c,
+		file:       file,
+		target:     splitPath(file.Path),
+	}
+}
+
+// resolve maps a name relative to the mount point onto the commit. If name is
+// a strict ancestor of the subtree root it returns the next component on the
+// way there, otherwise it returns the corresponding path within the commit.
+// ok is false if name falls outside the subtree.
+func (fs *subtreeFilesystem) resolve(name string) (pfsPath string, next string, ok bool) {
+	components := splitPath(name)
+	if len(components) < len(fs.target) {
+		for i, c := range components {
+			if c != fs.target[i] {
+				return "", "", false
+			}
+		}
+		return "", fs.target[len(components)], true
+	}
+	for i, c := range fs.target {
+		if c != components[i] {
+			return "", "", false
+		}
+	}
+	return "/" + path.Join(components...), "", true
+}
+
+func (fs *subtreeFilesystem) GetAttr(name string, context *fuse.Context) (*fuse.Attr, fuse.Status) {
+	pfsPath, _, ok := fs.resolve(name)
+	if !ok {
+		return nil, fuse.ENOENT
+	}
+	if pfsPath == "" {
+		// an ancestor of the subtree root, synthesized as a directory
+		return &fuse.Attr{
+			Mode: modeDir,
+		}, fuse.OK
+	}
+	fi, err := fs.c.InspectFile(fs.file.Commit.Repo.Name, fs.file.Commit.ID, pfsPath)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &fuse.Attr{
+		Mode: fileMode(fi),
+		Size: fi.SizeBytes,
+	}, fuse.OK
+}
+
+func (fs *subtreeFilesystem) OpenDir(name string, context *fuse.Context) ([]fuse.DirEntry, fuse.Status) {
+	pfsPath, next, ok := fs.resolve(name)
+	if !ok {
+		return nil, fuse.ENOENT
+	}
+	if pfsPath == "" {
+		// an ancestor of the subtree root only contains the next component
+		// on the way there
+		mode := uint32(modeDir)
+		if len(splitPath(name))+1 == len(fs.target) {
+			fi, err := fs.c.InspectFile(fs.file.Commit.Repo.Name, fs.file.Commit.ID, fs.file.Path)
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			mode = fileMode(fi)
+		}
+		return []fuse.DirEntry{{Name: next, Mode: mode}}, fuse.OK
+	}
+	var result []fuse.DirEntry
+	if err := fs.c.ListFileF(fs.file.Commit.Repo.Name, fs.file.Commit.ID, pfsPath, 0, func(fi *pfs.FileInfo) error {
+		result = append(result, fileDirEntry(fi))
+		return nil
+	}); err != nil {
+		return nil, toStatus(err)
+	}
+	return result, fuse.OK
+}
+
+func (fs *subtreeFilesystem) Readlink(name string, context *fuse.Context) (string, fuse.Status) {
+	pfsPath, _, ok := fs.resolve(name)
+	if !ok {
+		return "", fuse.ENOENT
+	}
+	if pfsPath == "" {
+		return "", fuse.EINVAL
+	}
+	fi, err := fs.c.InspectFile(fs.file.Commit.Repo.Name, fs.file.Commit.ID, pfsPath)
+	if err != nil {
+		return "", toStatus(err)
+	}
+	if fi.SymlinkTarget == "" {
+		return "", fuse.EINVAL
+	}
+	return fi.SymlinkTarget, fuse.OK
+}
+
+func (fs *subtreeFilesystem) Open(name string, flags uint32, context *fuse.Context) (nodefs.File, fuse.Status) {
+	f := int(flags)
+	writeFlags := os.O_WRONLY | os.O_RDWR
+	if f&writeFlags != 0 {
+		return nil, fuse.EROFS
+	}
+	pfsPath, _, ok := fs.resolve(name)
+	if !ok {
+		return nil, fuse.ENOENT
+	}
+	if pfsPath == "" {
+		return nil, fuse.EINVAL
+	}
+	attr, status := fs.GetAttr(name, context)
+	if status != fuse.OK {
+		return nil, status
+	}
+	return &sparseFile{
+		File: nodefs.NewDefaultFile(),
+		fs:   fs,
+		path: pfsPath,
+		attr: attr,
+	}, fuse.OK
+}
+
+// sparseFile reads a PFS file one requested range at a time rather than
+// downloading it when it's opened.
+type sparseFile struct {
+	nodefs.File
+	fs   *subtreeFilesystem
+	path string
+	attr *fuse.Attr
+}
+
+func (f *sparseFile) Read(dest []byte, offset int64) (fuse.ReadResult, fuse.Status) {
+	if offset >= int64(f.attr.Size) {
+		return fuse.ReadResultData(nil), fuse.OK
+	}
+	size := int64(len(dest))
+	if offset+size > int64(f.attr.Size) {
+		size = int64(f.attr.Size) - offset
+	}
+	var buf bytes.Buffer
+	if err := f.fs.c.GetFile(f.fs.file.Commit.Repo.Name, f.fs.file.Commit.ID, f.path, offset, size, &buf); err != nil {
+		return nil, toStatus(err)
+	}
+	return fuse.ReadResultData(buf.Bytes()), fuse.OK
+}
+
+func (f *sparseFile) GetAttr(out *fuse.Attr) fuse.Status {
+	*out = *f.attr
+	return fuse.OK
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
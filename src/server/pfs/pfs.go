@@ -3,6 +3,7 @@ package pfs
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
@@ -56,6 +57,25 @@ type ErrParentCommitNotFound struct {
 	Commit *pfs.Commit
 }
 
+// ErrQuotaExceeded represents an error where finishing a commit (or starting
+// a new one) would put the repo over one of its quota limits.
+type ErrQuotaExceeded struct {
+	Repo  *pfs.Repo
+	Limit string // which limit would be exceeded, e.g. "size"
+	Used  uint64
+	Quota uint64
+}
+
+// ErrComplianceRetention represents an error where a finished commit can't be
+// deleted because its repo is in WORM (write-once-read-many) mode and the
+// commit is still within the repo's compliance retention period (see
+// CreateRepoRequest.retention). There is deliberately no override for this
+// error, even for cluster admins.
+type ErrComplianceRetention struct {
+	Commit    *pfs.Commit
+	Retention time.Duration
+}
+
 func (e ErrFileNotFound) Error() string {
 	return fmt.Sprintf("file %v not found in repo %v at commit %v", e.File.Path, e.File.Commit.Repo.Name, e.File.Commit.ID)
 }
@@ -93,6 +113,14 @@ func (e ErrParentCommitNotFound) Error() string {
 	return fmt.Sprintf("parent commit %v not found in repo %v", e.Commit.ID, e.Commit.Repo.Name)
 }
 
+func (e ErrComplianceRetention) Error() string {
+	return fmt.Sprintf("commit %v/%v is within its repo's compliance retention period of %v and cannot be deleted", e.Commit.Repo.Name, e.Commit.ID, e.Retention)
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota exceeded: repo %v would use %v of its %v quota of %v (raise the quota with 'update repo', or delete data)", e.Repo.Name, e.Used, e.Limit, e.Quota)
+}
+
 // ByteRangeSize returns byteRange.Upper - byteRange.Lower.
 func ByteRangeSize(byteRange *pfs.ByteRange) uint64 {
 	return byteRange.Upper - byteRange.Lower
@@ -105,8 +133,19 @@ var (
 	repoNotFoundRe   = regexp.MustCompile(`repos/ ?[a-zA-Z0-9.\-_]{1,255} not found`)
 	branchNotFoundRe = regexp.MustCompile(`branches/[a-zA-Z0-9.\-_]{1,255}/ [^ ]+ not found`)
 	fileNotFoundRe   = regexp.MustCompile(`file .+ not found`)
+
+	complianceRetentionRe = regexp.MustCompile(`commit [^ ]+/[^ ]+ is within its repo's compliance retention period`)
 )
 
+// IsComplianceRetentionErr returns true if 'err' has an error message that
+// matches ErrComplianceRetention
+func IsComplianceRetentionErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return complianceRetentionRe.MatchString(grpcutil.ScrubGRPC(err).Error())
+}
+
 // IsCommitNotFoundErr returns true if 'err' has an error message that matches
 // ErrCommitNotFound
 func IsCommitNotFoundErr(err error) bool {
@@ -5,8 +5,10 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/docker/go-units"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
@@ -16,12 +18,18 @@ const (
 	RepoHeader = "NAME\tCREATED\tSIZE (MASTER)\t\n"
 	// RepoAuthHeader is the header for repos with auth information attached.
 	RepoAuthHeader = "NAME\tCREATED\tSIZE (MASTER)\tACCESS LEVEL\t\n"
+	// CommitSetHeader is the header for commit sets.
+	CommitSetHeader = "ID\tCOMMITS\tPROGRESS\tSTARTED\t\n"
 	// CommitHeader is the header for commits.
-	CommitHeader = "REPO\tBRANCH\tCOMMIT\tPARENT\tSTARTED\tDURATION\tSIZE\t\n"
+	CommitHeader = "REPO\tBRANCH\tCOMMIT\tPARENT\tSTARTED\tDURATION\tSIZE\tSTATE\t\n"
 	// BranchHeader is the header for branches.
 	BranchHeader = "BRANCH\tHEAD\t\n"
+	// TagHeader is the header for commit tags.
+	TagHeader = "TAG\tCOMMIT\tCREATED\t\n"
 	// FileHeader is the header for files.
 	FileHeader = "COMMIT\tNAME\tTYPE\tCOMMITTED\tSIZE\t\n"
+	// SchemaHeader is the header for the columns of an extracted file schema.
+	SchemaHeader = "COLUMN\tTYPE\t\n"
 )
 
 // PrintRepoHeader prints a repo header.
@@ -69,7 +77,11 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}
-Size of HEAD on master: {{prettySize .SizeBytes}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .SizeBytes}}{{if .Quota}}
+Quota: {{repoQuota .RepoInfo}}{{end}}{{if .Retention}}
+Retention (WORM): {{prettyDuration .Retention}}{{end}}{{if .Classification}}
+Classification: {{.Classification}}{{end}}{{if .GetFileFilters}}
+GetFile filters:{{range .GetFileFilters}} {{.Filter}} ({{.PathGlob}}){{end}}{{end}}{{if .AuthInfo}}
 Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 `)
 	if err != nil {
@@ -97,6 +109,35 @@ func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	}
 }
 
+// PrintTag pretty-prints a commit tag.
+func PrintTag(w io.Writer, tagInfo *pfs.CommitTagInfo) {
+	fmt.Fprintf(w, "%s\t", tagInfo.Name)
+	fmt.Fprintf(w, "%s\t", tagInfo.Commit.ID)
+	fmt.Fprintf(w, "%s\t\n", pretty.Ago(tagInfo.Created))
+}
+
+// PrintCommitSetInfo pretty-prints a commit set.
+func PrintCommitSetInfo(w io.Writer, commitSetInfo *pfs.CommitSetInfo, fullTimestamps bool) {
+	var finished int
+	for _, commitInfo := range commitSetInfo.Commits {
+		if commitInfo.Finished != nil {
+			finished++
+		}
+	}
+	fmt.Fprintf(w, "%s\t", commitSetInfo.ID)
+	fmt.Fprintf(w, "%d\t", len(commitSetInfo.Commits))
+	fmt.Fprintf(w, "%d / %d finished\t", finished, len(commitSetInfo.Commits))
+	var started *types.Timestamp
+	if len(commitSetInfo.Commits) > 0 {
+		started = commitSetInfo.Commits[0].Started
+	}
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t\n", started.String())
+	} else {
+		fmt.Fprintf(w, "%s\t\n", pretty.Ago(started))
+	}
+}
+
 // PrintCommitInfoHeader prints a commit info header.
 func PrintCommitInfoHeader(w io.Writer) {
 	fmt.Fprint(w, CommitHeader)
@@ -123,12 +164,47 @@ func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, fullTimestamps boo
 	}
 	if commitInfo.Finished != nil {
 		fmt.Fprintf(w, fmt.Sprintf("%s\t", pretty.TimeDifference(commitInfo.Started, commitInfo.Finished)))
-		fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(commitInfo.SizeBytes)))
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(commitInfo.SizeBytes)))
 	} else {
 		fmt.Fprintf(w, "-\t")
 		// Open commits don't have meaningful size information
-		fmt.Fprintf(w, "-\t\n")
+		fmt.Fprintf(w, "-\t")
+	}
+	fmt.Fprintf(w, "%s\t\n", commitState(commitInfo))
+}
+
+// commitState renders the lifecycle state of 'commitInfo'. Commits written
+// before phases existed have no phase but a non-nil 'finished'; they're
+// rendered as finished.
+func commitState(commitInfo *pfs.CommitInfo) string {
+	if commitInfo.Phase == pfs.CommitPhase_PHASE_OPEN && commitInfo.Finished != nil {
+		return "finished"
+	}
+	switch commitInfo.Phase {
+	case pfs.CommitPhase_PHASE_OPEN:
+		return "open"
+	case pfs.CommitPhase_PHASE_FINISHING:
+		return "finishing"
+	case pfs.CommitPhase_PHASE_FINISHED:
+		return "finished"
+	case pfs.CommitPhase_PHASE_FAILED:
+		return "failed"
 	}
+	return "-"
+}
+
+// indexStatus renders where 'commitInfo' is in the content-indexing pipeline
+// (only shown when the cluster has an indexer configured).
+func indexStatus(commitInfo *pfs.CommitInfo) string {
+	switch commitInfo.IndexStatus {
+	case pfs.IndexStatus_INDEX_PENDING:
+		return "pending"
+	case pfs.IndexStatus_INDEX_DONE:
+		return "done"
+	case pfs.IndexStatus_INDEX_FAILED:
+		return "failed"
+	}
+	return "-"
 }
 
 // PrintableCommitInfo is a wrapper around CommitInfo containing any formatting options
@@ -156,6 +232,10 @@ Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}}{{end}}{{if .Finished}}{{if .FullTimestamps}}
 Finished: {{.Finished}}{{else}}
 Finished: {{prettyAgo .Finished}}{{end}}{{end}}
+State: {{commitState .CommitInfo}}{{if .PhaseReason}}
+Reason: {{.PhaseReason}}{{end}}{{if .IndexStatus}}
+Index Status: {{indexStatus .CommitInfo}}{{if .IndexReason}}
+Index Reason: {{.IndexReason}}{{end}}{{end}}
 Size: {{prettySize .SizeBytes}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Commit.Repo.Name}}@{{.Commit.ID}} ({{.Branch.Name}}) {{end}} {{end}}
 `)
@@ -169,6 +249,23 @@ Provenance: {{range .Provenance}} {{.Commit.Repo.Name}}@{{.Commit.ID}} ({{.Branc
 	return nil
 }
 
+// PrintFilePath prints just the path of 'fileInfo', for output that's piped
+// into tools like xargs or parallel; 'print0' terminates the path with a NUL
+// byte instead of a newline (so paths with spaces or newlines survive xargs
+// -0), and 'relative' drops the leading slash so the path is relative to the
+// repo root.
+func PrintFilePath(w io.Writer, fileInfo *pfs.FileInfo, print0 bool, relative bool) {
+	path := fileInfo.File.Path
+	if relative {
+		path = strings.TrimPrefix(path, "/")
+	}
+	terminator := "\n"
+	if print0 {
+		terminator = "\x00"
+	}
+	fmt.Fprintf(w, "%s%s", path, terminator)
+}
+
 // PrintFileInfoHeader prints a file info header.
 func PrintFileInfoHeader(w io.Writer) {
 	fmt.Fprint(w, FileHeader)
@@ -209,6 +306,11 @@ Children: {{range .Children}} {{.}} {{end}}
 	return template.Execute(os.Stdout, fileInfo)
 }
 
+// PrintSchemaColumn pretty-prints one column of an extracted file schema.
+func PrintSchemaColumn(w io.Writer, column *pfs.SchemaColumn) {
+	fmt.Fprintf(w, "%s\t%s\t\n", column.Name, column.Type)
+}
+
 type uint64Slice []uint64
 
 func (s uint64Slice) Len() int           { return len(s) }
@@ -222,10 +324,30 @@ func fileType(fileType pfs.FileType) string {
 	return "dir"
 }
 
+// repoQuota renders a repo's quota limits alongside its current usage, e.g.
+// "500MB of 1GB max size, 10 max files, 2 of 5 max commits".
+func repoQuota(ri *pfs.RepoInfo) string {
+	var parts []string
+	if ri.Quota.MaxSizeBytes > 0 {
+		parts = append(parts, fmt.Sprintf("%s of %s max size", pretty.Size(ri.SizeBytes), pretty.Size(ri.Quota.MaxSizeBytes)))
+	}
+	if ri.Quota.MaxFileCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d max files", ri.Quota.MaxFileCount))
+	}
+	if ri.Quota.MaxCommits > 0 {
+		parts = append(parts, fmt.Sprintf("%d max commits", ri.Quota.MaxCommits))
+	}
+	return strings.Join(parts, ", ")
+}
+
 var funcMap = template.FuncMap{
-	"prettyAgo":  pretty.Ago,
-	"prettySize": pretty.Size,
-	"fileType":   fileType,
+	"prettyAgo":      pretty.Ago,
+	"prettySize":     pretty.Size,
+	"prettyDuration": pretty.Duration,
+	"fileType":       fileType,
+	"repoQuota":      repoQuota,
+	"commitState":    commitState,
+	"indexStatus":    indexStatus,
 }
 
 // CompactPrintBranch renders 'b' as a compact string, e.g.
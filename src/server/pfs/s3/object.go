@@ -11,6 +11,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/audit"
 )
 
 type objectHandler struct {
@@ -21,8 +22,30 @@ func newObjectHandler(pc *client.APIClient) *objectHandler {
 	return &objectHandler{pc: pc}
 }
 
+// checkClassification returns true (and writes an AccessDenied response) if
+// 'repo' is classified "confidential" or "pii". The S3 gateway reads with
+// pachd's own credentials rather than the caller's, so classified repos are
+// not served over it at all.
+func (h *objectHandler) checkClassification(w http.ResponseWriter, r *http.Request, repo string) bool {
+	repoInfo, err := h.pc.InspectRepo(repo)
+	if err != nil {
+		return false // missing repos are handled by the calling handler
+	}
+	if !client.RestrictedClassification(repoInfo.Classification) {
+		return false
+	}
+	audit.Violation(r.RemoteAddr, "s3-gateway read", repo,
+		fmt.Sprintf("repo is classified %q and cannot be read over the S3 gateway", repoInfo.Classification))
+	writeError(w, r, http.StatusForbidden, "AccessDenied",
+		fmt.Sprintf("repo %s is classified and cannot be read over the S3 gateway", repo))
+	return true
+}
+
 func (h *objectHandler) get(w http.ResponseWriter, r *http.Request) {
 	repo, branch, file := objectArgs(w, r)
+	if h.checkClassification(w, r, repo) {
+		return
+	}
 	branchInfo, err := h.pc.InspectBranch(repo, branch)
 	if err != nil {
 		maybeNotFoundError(w, r, err)
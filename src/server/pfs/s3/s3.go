@@ -149,7 +149,7 @@ func Server(pc *client.APIClient, port uint16) *http.Server {
 					return
 				}
 
-				isEnterprise = resp.State == enterpriseclient.State_ACTIVE
+				isEnterprise = resp.State.Entitled()
 			}
 			if !isEnterprise {
 				enterpriseDisabledError(w, r)
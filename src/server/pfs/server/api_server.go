@@ -9,6 +9,8 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/bandwidth"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
@@ -54,7 +56,7 @@ func (a *apiServer) CreateRepo(ctx context.Context, request *pfs.CreateRepoReque
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	if err := a.driver.createRepo(a.env.GetPachClient(ctx), request.Repo, request.Description, request.Update); err != nil {
+	if err := a.driver.createRepo(a.env.GetPachClient(ctx), request.Repo, request.Description, request.Update, request.Quota, request.ExtractSchemas, request.Retention, request.Classification, request.GetFileFilters); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -138,6 +140,15 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) ResumeFinish(ctx context.Context, request *pfs.ResumeFinishRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.driver.resumeFinish(a.env.GetPachClient(ctx), request.Commit); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) InspectCommit(ctx context.Context, request *pfs.InspectCommitRequest) (response *pfs.CommitInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -197,6 +208,25 @@ func (a *apiServer) ListBranch(ctx context.Context, request *pfs.ListBranchReque
 	return &pfs.BranchInfos{BranchInfo: branches}, nil
 }
 
+func (a *apiServer) ListBranchStream(req *pfs.ListBranchRequest, respServer pfs.API_ListBranchStreamServer) (retErr error) {
+	func() { a.Log(req, nil, nil, 0) }()
+	sent := 0
+	defer func(start time.Time) {
+		a.Log(req, fmt.Sprintf("stream containing %d branches", sent), retErr, time.Since(start))
+	}(time.Now())
+	branches, err := a.driver.listBranch(a.env.GetPachClient(respServer.Context()), req.Repo)
+	if err != nil {
+		return err
+	}
+	for _, branchInfo := range branches {
+		sent++
+		if err := respServer.Send(branchInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -207,6 +237,37 @@ func (a *apiServer) DeleteBranch(ctx context.Context, request *pfs.DeleteBranchR
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) CreateCommitTag(ctx context.Context, request *pfs.CreateCommitTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.createCommitTag(a.env.GetPachClient(ctx), request.Commit, request.Name); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) ListCommitTag(ctx context.Context, request *pfs.ListCommitTagRequest) (response *pfs.CommitTagInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	tags, err := a.driver.listCommitTag(a.env.GetPachClient(ctx), request.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.CommitTagInfos{TagInfo: tags}, nil
+}
+
+func (a *apiServer) DeleteCommitTag(ctx context.Context, request *pfs.DeleteCommitTagRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.deleteCommitTag(a.env.GetPachClient(ctx), request.Repo, request.Name); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -224,6 +285,27 @@ func (a *apiServer) FlushCommit(request *pfs.FlushCommitRequest, stream pfs.API_
 	return a.driver.flushCommit(a.env.GetPachClient(stream.Context()), request.Commits, request.ToRepos, stream.Send)
 }
 
+func (a *apiServer) InspectCommitSet(ctx context.Context, request *pfs.InspectCommitSetRequest) (response *pfs.CommitSetInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.inspectCommitSet(a.env.GetPachClient(ctx), request.ID)
+}
+
+func (a *apiServer) ListCommitSet(request *pfs.ListCommitSetRequest, stream pfs.API_ListCommitSetServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.listCommitSet(a.env.GetPachClient(stream.Context()), stream.Send)
+}
+
+func (a *apiServer) DeleteCommitSet(ctx context.Context, request *pfs.DeleteCommitSetRequest) (response *pfs.CommitSetInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.deleteCommitSet(a.env.GetPachClient(ctx), request.ID, request.DryRun)
+}
+
 func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream pfs.API_SubscribeCommitServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
@@ -231,6 +313,13 @@ func (a *apiServer) SubscribeCommit(request *pfs.SubscribeCommitRequest, stream
 	return a.driver.subscribeCommit(a.env.GetPachClient(stream.Context()), request.Repo, request.Branch, request.From, request.State, stream.Send)
 }
 
+func (a *apiServer) SubscribeFiles(request *pfs.SubscribeFilesRequest, stream pfs.API_SubscribeFilesServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.subscribeFiles(a.env.GetPachClient(stream.Context()), request.Repo, request.Branch, request.Pattern, request.From, stream.Send)
+}
+
 func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error) {
 	s := newPutFileServer(putFileServer)
 	r, err := s.Peek()
@@ -264,10 +353,12 @@ func (a *apiServer) GetFile(request *pfs.GetFileRequest, apiGetFileServer pfs.AP
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 
-	file, err := a.driver.getFile(a.env.GetPachClient(apiGetFileServer.Context()), request.File, request.OffsetBytes, request.SizeBytes)
+	pachClient := a.env.GetPachClient(apiGetFileServer.Context())
+	file, err := a.driver.getFile(pachClient, request.File, request.OffsetBytes, request.SizeBytes)
 	if err != nil {
 		return err
 	}
+	file = bandwidth.NewReader(file, a.driver.bandwidthLimiters(pachClient, request.File.Commit.Repo.Name)...)
 	return grpcutil.WriteToStreamingBytesServer(file, apiGetFileServer)
 }
 
@@ -278,6 +369,45 @@ func (a *apiServer) InspectFile(ctx context.Context, request *pfs.InspectFileReq
 	return a.driver.inspectFile(a.env.GetPachClient(ctx), request.File)
 }
 
+func (a *apiServer) BatchInspectFile(request *pfs.BatchInspectFileRequest, respServer pfs.API_BatchInspectFileServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	var sent int
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
+	}(time.Now())
+	if (len(request.Paths) == 0) == (request.Pattern == "") {
+		return fmt.Errorf("must specify either paths or a pattern (but not both)")
+	}
+	pachClient := a.env.GetPachClient(respServer.Context())
+	if request.Pattern != "" {
+		return a.driver.globFile(pachClient, request.Commit, request.Pattern, func(fi *pfs.FileInfo) error {
+			sent++
+			return respServer.Send(&pfs.BatchInspectFileResponse{
+				Path:     fi.File.Path,
+				FileInfo: fi,
+			})
+		})
+	}
+	for _, path := range request.Paths {
+		resp := &pfs.BatchInspectFileResponse{Path: path}
+		fi, err := a.driver.inspectFile(pachClient, client.NewFile(request.Commit.Repo.Name, request.Commit.ID, path))
+		switch {
+		case err == nil:
+			resp.FileInfo = fi
+		case pfsserver.IsFileNotFoundErr(err):
+			// report per-path, so one missing file doesn't abort the batch
+			resp.Error = err.Error()
+		default:
+			return err
+		}
+		sent++
+		if err := respServer.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *apiServer) ListFile(ctx context.Context, request *pfs.ListFileRequest) (response *pfs.FileInfos, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
@@ -325,6 +455,18 @@ func (a *apiServer) WalkFile(request *pfs.WalkFileRequest, server pfs.API_WalkFi
 	})
 }
 
+func (a *apiServer) FindFile(request *pfs.FindFileRequest, respServer pfs.API_FindFileServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	var sent int
+	defer func(start time.Time) {
+		a.Log(request, fmt.Sprintf("response stream with %d objects", sent), retErr, time.Since(start))
+	}(time.Now())
+	return a.driver.findFile(a.env.GetPachClient(respServer.Context()), request, func(fi *pfs.FileInfo) error {
+		sent++
+		return respServer.Send(fi)
+	})
+}
+
 func (a *apiServer) GlobFile(ctx context.Context, request *pfs.GlobFileRequest) (response *pfs.FileInfos, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
@@ -360,6 +502,37 @@ func (a *apiServer) GlobFileStream(request *pfs.GlobFileRequest, respServer pfs.
 	})
 }
 
+func (a *apiServer) GlobPreview(ctx context.Context, request *pfs.GlobPreviewRequest) (response *pfs.GlobPreviewResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	sampleSize := request.SampleSize
+	if sampleSize == 0 {
+		sampleSize = 10
+	}
+	response = &pfs.GlobPreviewResponse{}
+	if err := a.driver.globFile(a.env.GetPachClient(ctx), request.Commit, request.Pattern, func(fi *pfs.FileInfo) error {
+		response.NumMatches++
+		response.TotalSizeBytes += fi.SizeBytes
+		if response.NumMatches == 1 || fi.SizeBytes < response.MinSizeBytes {
+			response.MinSizeBytes = fi.SizeBytes
+		}
+		if fi.SizeBytes > response.MaxSizeBytes {
+			response.MaxSizeBytes = fi.SizeBytes
+		}
+		if int64(len(response.Sample)) < sampleSize {
+			response.Sample = append(response.Sample, fi)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if response.NumMatches > 0 {
+		response.AvgSizeBytes = response.TotalSizeBytes / uint64(response.NumMatches)
+	}
+	return response, nil
+}
+
 func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest) (response *pfs.DiffFileResponse, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
@@ -383,6 +556,12 @@ func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest)
 	}, nil
 }
 
+func (a *apiServer) DiffTabularFile(ctx context.Context, request *pfs.DiffTabularFileRequest) (response *pfs.TabularDiff, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return a.driver.diffTabularFile(a.env.GetPachClient(ctx), request.NewFile, request.OldFile)
+}
+
 func (a *apiServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
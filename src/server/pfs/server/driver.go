@@ -33,12 +33,15 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	"github.com/pachyderm/pachyderm/src/server/pkg/bandwidth"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/filefilter"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pressure"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/sql"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
@@ -48,6 +51,8 @@ import (
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -101,11 +106,17 @@ type driver struct {
 	prefix     string
 
 	// collections
-	repos          col.Collection
-	putFileRecords col.Collection
-	commits        collectionFactory
-	branches       collectionFactory
-	openCommits    col.Collection
+	repos col.Collection
+	// bandwidthLimits holds the per-principal and per-repo transfer limits
+	// ('pachctl admin set-bandwidth-limit'); bandwidth holds the token
+	// buckets that enforce them
+	bandwidthLimits col.Collection
+	bandwidth       *bandwidth.Registry
+	putFileRecords  col.Collection
+	commits         collectionFactory
+	branches        collectionFactory
+	tags            collectionFactory
+	openCommits     col.Collection
 
 	// a cache for hashtrees
 	treeCache *hashtree.Cache
@@ -115,6 +126,14 @@ type driver struct {
 
 	// memory limiter (useful for limiting operations that could use a lot of memory)
 	memoryLimiter *semaphore.Weighted
+
+	// indexer pushes finished commits to an external search index, or is nil
+	// if none is configured (see indexer.go)
+	indexer *indexer
+
+	// pressure reports whether etcd is under latency/quota pressure, in
+	// which case startCommit sheds load by rejecting new commits
+	pressure *pressure.Monitor
 }
 
 // newDriver is used to create a new Driver instance
@@ -126,21 +145,28 @@ func newDriver(env *serviceenv.ServiceEnv, etcdPrefix string, treeCache *hashtre
 	// Initialize driver
 	etcdClient := env.GetEtcdClient()
 	d := &driver{
-		etcdClient:     etcdClient,
-		prefix:         etcdPrefix,
-		repos:          pfsdb.Repos(etcdClient, etcdPrefix),
-		putFileRecords: pfsdb.PutFileRecords(etcdClient, etcdPrefix),
+		etcdClient:      etcdClient,
+		prefix:          etcdPrefix,
+		repos:           pfsdb.Repos(etcdClient, etcdPrefix),
+		bandwidthLimits: pfsdb.BandwidthLimits(etcdClient, etcdPrefix),
+		bandwidth:       bandwidth.NewRegistry(),
+		putFileRecords:  pfsdb.PutFileRecords(etcdClient, etcdPrefix),
 		commits: func(repo string) col.Collection {
 			return pfsdb.Commits(etcdClient, etcdPrefix, repo)
 		},
 		branches: func(repo string) col.Collection {
 			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
 		},
+		tags: func(repo string) col.Collection {
+			return pfsdb.Tags(etcdClient, etcdPrefix, repo)
+		},
 		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
 		treeCache:   treeCache,
 		storageRoot: storageRoot,
 		// Allow up to a third of the requested memory to be used for memory intensive operations
 		memoryLimiter: semaphore.NewWeighted(memoryRequest / 3),
+		indexer:       newIndexer(),
+		pressure:      env.GetPressureMonitor(),
 	}
 	// Create spec repo (default repo)
 	repo := client.NewRepo(ppsconsts.SpecRepo)
@@ -195,7 +221,7 @@ func absent(key string) etcd.Cmp {
 	return etcd.Compare(etcd.CreateRevision(key), "=", 0)
 }
 
-func (d *driver) createRepo(pachClient *client.APIClient, repo *pfs.Repo, description string, update bool) error {
+func (d *driver) createRepo(pachClient *client.APIClient, repo *pfs.Repo, description string, update bool, quota *pfs.RepoQuota, extractSchemas bool, retention *types.Duration, classification string, getFileFilters []*pfs.GetFileFilter) error {
 	ctx := pachClient.Ctx()
 	// Check that the user is logged in (user doesn't need any access level to
 	// create a repo, but they must be authenticated if auth is active)
@@ -208,6 +234,26 @@ func (d *driver) createRepo(pachClient *client.APIClient, repo *pfs.Repo, descri
 	if err := validateRepoName(repo.Name); err != nil {
 		return err
 	}
+	if !client.ValidClassification(classification) {
+		return fmt.Errorf("invalid classification \"%s\"; must be \"%s\", \"%s\", \"%s\" or \"%s\"",
+			classification, client.ClassificationPublic, client.ClassificationInternal,
+			client.ClassificationConfidential, client.ClassificationPII)
+	}
+	for _, filter := range getFileFilters {
+		f, err := filefilter.Get(filter.Filter)
+		if err != nil {
+			return err
+		}
+		if filter.PathGlob == "" {
+			return fmt.Errorf("file filter %q needs a path_glob selecting the files it applies to", filter.Filter)
+		}
+		if _, err := globlib.Compile(filter.PathGlob, '/'); err != nil {
+			return fmt.Errorf("could not compile path_glob of file filter %q: %v", filter.Filter, err)
+		}
+		if err := f.Validate(filter.Options); err != nil {
+			return err
+		}
+	}
 
 	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
@@ -247,9 +293,51 @@ func (d *driver) createRepo(pachClient *client.APIClient, repo *pfs.Repo, descri
 		}
 
 		repoInfo := &pfs.RepoInfo{
-			Repo:        repo,
-			Created:     created,
-			Description: description,
+			Repo:           repo,
+			Created:        created,
+			Description:    description,
+			Quota:          quota,
+			ExtractSchemas: extractSchemas,
+			Retention:      retention,
+			Classification: classification,
+			GetFileFilters: getFileFilters,
+		}
+		if quota == nil {
+			// an update that doesn't mention the quota keeps the old one
+			repoInfo.Quota = existingRepoInfo.Quota
+		}
+		if classification == "" {
+			// an update that doesn't mention the classification keeps the old
+			// one
+			repoInfo.Classification = existingRepoInfo.Classification
+		}
+		if getFileFilters == nil {
+			// an update that doesn't mention file filters keeps the old ones
+			repoInfo.GetFileFilters = existingRepoInfo.GetFileFilters
+		}
+		if retention == nil {
+			// an update that doesn't mention retention keeps the old period
+			repoInfo.Retention = existingRepoInfo.Retention
+		} else {
+			newRetention, err := types.DurationFromProto(retention)
+			if err != nil {
+				return err
+			}
+			if newRetention <= 0 {
+				return fmt.Errorf("retention must be positive")
+			}
+			if existingRepoInfo.Retention != nil {
+				// once a repo is in WORM mode, the retention period can be
+				// raised but never lowered or removed (that would defeat the
+				// compliance guarantee)
+				oldRetention, err := types.DurationFromProto(existingRepoInfo.Retention)
+				if err != nil {
+					return err
+				}
+				if newRetention < oldRetention {
+					return fmt.Errorf("retention can be raised but not lowered (current period: %v)", oldRetention)
+				}
+			}
 		}
 		// Only Put the new repoInfo if something has changed.  This
 		// optimization is impactful because pps will frequently update the
@@ -767,6 +855,31 @@ func (d *driver) deleteRepo(pachClient *client.APIClient, repo *pfs.Repo, force
 	// if repo.Name == ppsconsts.SpecRepo {
 	// 	return fmt.Errorf("cannot delete the special PPS repo %s", ppsconsts.SpecRepo)
 	// }
+	// If the repo is in WORM mode, refuse to delete it--even with force--while
+	// any of its finished commits is within the retention period
+	wormRepoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).Get(repo.Name, wormRepoInfo); err == nil && wormRepoInfo.Retention != nil {
+		retention, err := types.DurationFromProto(wormRepoInfo.Retention)
+		if err != nil {
+			return err
+		}
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits(repo.Name).ReadOnly(ctx).List(commitInfo, col.DefaultOptions, func(string) error {
+			if commitInfo.Finished == nil {
+				return nil
+			}
+			finished, err := types.TimestampFromProto(commitInfo.Finished)
+			if err != nil {
+				return err
+			}
+			if time.Since(finished) < retention {
+				return pfsserver.ErrComplianceRetention{Commit: commitInfo.Commit, Retention: retention}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
 	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(repo.Name).ReadWrite(stm)
@@ -820,6 +933,7 @@ func (d *driver) deleteRepo(pachClient *client.APIClient, repo *pfs.Repo, force
 		// exist in etcd but branches do.
 		branches := d.branches(repo.Name).ReadWrite(stm)
 		branches.DeleteAll()
+		d.tags(repo.Name).ReadWrite(stm).DeleteAll()
 		if err := repos.Delete(repo.Name); err != nil && !col.IsErrNotFound(err) {
 			return fmt.Errorf("repos.Delete: %v", err)
 		}
@@ -838,6 +952,13 @@ func (d *driver) deleteRepo(pachClient *client.APIClient, repo *pfs.Repo, force
 }
 
 func (d *driver) startCommit(pachClient *client.APIClient, parent *pfs.Commit, branch string, provenance []*pfs.CommitProvenance, description string) (*pfs.Commit, error) {
+	// Shed load while etcd is under pressure: refusing new commits up front
+	// with a retryable error beats accepting them and having every write in
+	// the commit time out. In-flight work (PutFile to already-open commits,
+	// pipeline output) is allowed to finish.
+	if degraded, reason := d.pressure.Degraded(); degraded {
+		return nil, grpc.Errorf(codes.Unavailable, "not starting new commits while etcd is under pressure (%s); retry later", reason)
+	}
 	return d.makeCommit(pachClient, "", parent, branch, provenance, nil, nil, nil, description)
 }
 
@@ -868,6 +989,29 @@ func (d *driver) makeCommit(pachClient *client.APIClient, ID string, parent *pfs
 		return nil, err
 	}
 
+	// Check the repo's commit-count quota (if any) before creating a new
+	// commit. This is checked outside the txn below--a concurrent StartCommit
+	// may briefly put the repo slightly over quota, but the limit is advisory
+	// and the check stays off etcd's hot path.
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadOnly(pachClient.Ctx()).Get(parent.Repo.Name, repoInfo); err != nil {
+		return nil, err
+	}
+	if repoInfo.Quota != nil && repoInfo.Quota.MaxCommits > 0 {
+		commitCount, err := d.commits(parent.Repo.Name).ReadOnly(pachClient.Ctx()).Count()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(commitCount) >= repoInfo.Quota.MaxCommits {
+			return nil, pfsserver.ErrQuotaExceeded{
+				Repo:  parent.Repo,
+				Limit: "commit-count",
+				Used:  uint64(commitCount) + 1,
+				Quota: repoInfo.Quota.MaxCommits,
+			}
+		}
+	}
+
 	// New commit and commitInfo
 	newCommit := &pfs.Commit{
 		Repo: parent.Repo,
@@ -1090,6 +1234,21 @@ func (d *driver) finishCommit(pachClient *client.APIClient, commit *pfs.Commit,
 		commitInfo.Description = description
 	}
 
+	// Record that the finish is underway before the (possibly long) merge, so
+	// that a pachd crash partway through is observable (the commit will be
+	// PHASE_FINISHING) and resumable with ResumeFinish, rather than leaving
+	// the commit looking open forever
+	if err := d.setCommitPhase(ctx, commit, pfs.CommitPhase_PHASE_FINISHING, ""); err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			// best effort--the commit can still be finished again, PHASE_FAILED
+			// just makes the interrupted finish (and its cause) observable
+			d.setCommitPhase(ctx, commit, pfs.CommitPhase_PHASE_FAILED, retErr.Error())
+		}
+	}()
+
 	scratchPrefix := d.scratchCommitPrefix(commit)
 	defer func() {
 		if retErr != nil {
@@ -1128,6 +1287,11 @@ func (d *driver) finishCommit(pachClient *client.APIClient, commit *pfs.Commit,
 			if err != nil {
 				return err
 			}
+			// Record columnar schemas in the tree before it's sealed, if the
+			// repo has schema extraction enabled (see schema.go)
+			if err := d.extractSchemas(pachClient, commit.Repo, finishedTree); err != nil {
+				return err
+			}
 			// Put the tree to object storage.
 			treeRef, err := hashtree.PutHashTree(pachClient, finishedTree)
 			if err != nil {
@@ -1144,10 +1308,71 @@ func (d *driver) finishCommit(pachClient *client.APIClient, commit *pfs.Commit,
 		}
 
 		commitInfo.SizeBytes = uint64(finishedTree.FSSize())
+
+		// Enforce the repo's size and file-count quotas (if any) against the
+		// finished filesystem, so that writes from PutFile are rejected here
+		// rather than silently filling the object store
+		if err := d.checkRepoQuota(pachClient, commit.Repo, finishedTree); err != nil {
+			return err
+		}
 	}
 
 	commitInfo.Finished = now()
-	return d.writeFinishedCommit(ctx, commit, commitInfo)
+	if d.indexer != nil && finishedTree != nil {
+		commitInfo.IndexStatus = pfs.IndexStatus_INDEX_PENDING
+	}
+	if err := d.writeFinishedCommit(ctx, commit, commitInfo); err != nil {
+		return err
+	}
+	if commitInfo.IndexStatus == pfs.IndexStatus_INDEX_PENDING {
+		// Index the finished commit in the background, detached from the
+		// FinishCommit RPC's context so that a disconnecting caller doesn't
+		// interrupt it (see indexer.go)
+		go d.indexCommitInBackground(pachClient.WithCtx(context.Background()), commitInfo, finishedTree)
+	}
+	return nil
+}
+
+// checkRepoQuota returns an ErrQuotaExceeded if 'tree' (the filesystem that
+// finishing a commit would leave 'repo' with) exceeds the repo's size or
+// file-count quota. It's a no-op if the repo has no quota.
+func (d *driver) checkRepoQuota(pachClient *client.APIClient, repo *pfs.Repo, tree hashtree.HashTree) error {
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadOnly(pachClient.Ctx()).Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	quota := repoInfo.Quota
+	if quota == nil {
+		return nil
+	}
+	if quota.MaxSizeBytes > 0 && uint64(tree.FSSize()) > quota.MaxSizeBytes {
+		return pfsserver.ErrQuotaExceeded{
+			Repo:  repo,
+			Limit: "size",
+			Used:  uint64(tree.FSSize()),
+			Quota: quota.MaxSizeBytes,
+		}
+	}
+	if quota.MaxFileCount > 0 {
+		var fileCount uint64
+		if err := tree.Walk("/", func(path string, node *hashtree.NodeProto) error {
+			if node.FileNode != nil {
+				fileCount++
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if fileCount > quota.MaxFileCount {
+			return pfsserver.ErrQuotaExceeded{
+				Repo:  repo,
+				Limit: "file-count",
+				Used:  fileCount,
+				Quota: quota.MaxFileCount,
+			}
+		}
+	}
+	return nil
 }
 
 func (d *driver) finishOutputCommit(pachClient *client.APIClient, commit *pfs.Commit, trees []*pfs.Object, datums *pfs.Object, size uint64) (retErr error) {
@@ -1169,11 +1394,46 @@ func (d *driver) finishOutputCommit(pachClient *client.APIClient, commit *pfs.Co
 	return d.writeFinishedCommit(ctx, commit, commitInfo)
 }
 
+// setCommitPhase records where 'commit' is in the finish lifecycle (see
+// CommitPhase in pfs.proto); 'reason' is the error that interrupted the
+// finish when 'phase' is PHASE_FAILED.
+func (d *driver) setCommitPhase(ctx context.Context, commit *pfs.Commit, phase pfs.CommitPhase, reason string) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		commitInfo := &pfs.CommitInfo{}
+		return d.commits(commit.Repo.Name).ReadWrite(stm).Update(commit.ID, commitInfo, func() error {
+			commitInfo.Phase = phase
+			commitInfo.PhaseReason = reason
+			return nil
+		})
+	})
+	return err
+}
+
+// resumeFinish re-runs the finish of a commit whose finish was interrupted
+// (e.g. pachd crashed while merging a huge commit) or failed. The commit's
+// scratch space is only deleted after a successful finish, so re-running it
+// is safe.
+func (d *driver) resumeFinish(pachClient *client.APIClient, commit *pfs.Commit) error {
+	commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished != nil {
+		return pfsserver.ErrCommitFinished{commit}
+	}
+	if commitInfo.Phase != pfs.CommitPhase_PHASE_FINISHING && commitInfo.Phase != pfs.CommitPhase_PHASE_FAILED {
+		return fmt.Errorf("commit %s is open and has no finish to resume; use FinishCommit", commit.FullID())
+	}
+	return d.finishCommit(pachClient, commit, nil, false, "")
+}
+
 // writeFinishedCommit writes these changes to etcd:
 // 1) it closes the input commit (i.e., it writes any changes made to it and
 //    removes it from the open commits)
 // 2) if the commit is the new HEAD of master, it updates the repo size
 func (d *driver) writeFinishedCommit(ctx context.Context, commit *pfs.Commit, commitInfo *pfs.CommitInfo) error {
+	commitInfo.Phase = pfs.CommitPhase_PHASE_FINISHED
+	commitInfo.PhaseReason = ""
 	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		if err := commits.Put(commit.ID, commitInfo); err != nil {
@@ -1509,13 +1769,25 @@ func (d *driver) resolveCommit(stm col.STM, userCommit *pfs.Commit) (*pfs.Commit
 		branchInfo := &pfs.BranchInfo{}
 		// See if we are given a branch
 		if err := branches.Get(commit.ID, branchInfo); err != nil {
-			return nil, err
-		}
-		if branchInfo.Head == nil {
-			return nil, pfsserver.ErrNoHead{branchInfo.Branch}
+			if !col.IsErrNotFound(err) {
+				return nil, err
+			}
+			// Not a branch--see if we are given a tag
+			tagInfo := &pfs.CommitTagInfo{}
+			if tagErr := d.tags(commit.Repo.Name).ReadWrite(stm).Get(commit.ID, tagInfo); tagErr != nil {
+				if col.IsErrNotFound(tagErr) {
+					return nil, err // report the branch lookup's error, as before
+				}
+				return nil, tagErr
+			}
+			commit.ID = tagInfo.Commit.ID
+		} else {
+			if branchInfo.Head == nil {
+				return nil, pfsserver.ErrNoHead{branchInfo.Branch}
+			}
+			commitBranch = branchInfo.Branch
+			commit.ID = branchInfo.Head.ID
 		}
-		commitBranch = branchInfo.Branch
-		commit.ID = branchInfo.Head.ID
 	}
 
 	// Traverse commits' parents until you've reached the right ancestor
@@ -1708,6 +1980,33 @@ func (d *driver) subscribeCommit(pachClient *client.APIClient, repo *pfs.Repo, b
 	}
 }
 
+// subscribeFiles streams the FileInfo of every file matching 'pattern' that
+// changed in each newly finished commit on 'branch'. It's subscribeCommit
+// composed with a diff of each commit against its parent, so consumers can
+// react to changes under specific paths without diffing every commit
+// client-side.
+func (d *driver) subscribeFiles(pachClient *client.APIClient, repo *pfs.Repo, branch string, pattern string, from *pfs.Commit, f func(*pfs.FileInfo) error) error {
+	g, err := globlib.Compile(pattern, '/')
+	if err != nil {
+		return fmt.Errorf("error parsing glob pattern: %v", err)
+	}
+	return d.subscribeCommit(pachClient, repo, branch, from, pfs.CommitState_FINISHED, func(commitInfo *pfs.CommitInfo) error {
+		newFileInfos, _, err := d.diffFile(pachClient, client.NewFile(repo.Name, commitInfo.Commit.ID, ""), nil, false)
+		if err != nil {
+			return err
+		}
+		for _, fileInfo := range newFileInfos {
+			if !g.Match(fileInfo.File.Path) {
+				continue
+			}
+			if err := f(fileInfo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (d *driver) flushCommit(pachClient *client.APIClient, fromCommits []*pfs.Commit, toRepos []*pfs.Repo, f func(*pfs.CommitInfo) error) error {
 	if len(fromCommits) == 0 {
 		return fmt.Errorf("fromCommits cannot be empty")
@@ -1777,6 +2076,154 @@ func (d *driver) flushCommit(pachClient *client.APIClient, fromCommits []*pfs.Co
 	return nil
 }
 
+// commitSetFromRoot builds the CommitSetInfo for the commit set triggered by
+// 'root': the root commit itself plus its subvenance. Subvenant commits that
+// have since been deleted are skipped.
+func (d *driver) commitSetFromRoot(pachClient *client.APIClient, root *pfs.CommitInfo) (*pfs.CommitSetInfo, error) {
+	result := &pfs.CommitSetInfo{
+		ID:      root.Commit.ID,
+		Commit:  root.Commit,
+		Commits: []*pfs.CommitInfo{root},
+	}
+	for _, subvCommit := range root.Subvenance {
+		subvCommitInfo, err := d.inspectCommit(pachClient, subvCommit.Upper, pfs.CommitState_STARTED)
+		if err != nil {
+			if _, ok := err.(pfsserver.ErrCommitNotFound); ok {
+				continue // just skip this
+			}
+			return nil, err
+		}
+		result.Commits = append(result.Commits, subvCommitInfo)
+	}
+	return result, nil
+}
+
+func (d *driver) inspectCommitSet(pachClient *client.APIClient, id string) (*pfs.CommitSetInfo, error) {
+	ctx := pachClient.Ctx()
+	// The request only carries the commit set's ID, so find the repo that
+	// holds the triggering commit.
+	var root *pfs.CommitInfo
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions, func(repoName string) error {
+		if repoName == ppsconsts.SpecRepo || root != nil {
+			return nil
+		}
+		commitInfo := &pfs.CommitInfo{}
+		if err := d.commits(repoName).ReadOnly(ctx).Get(id, commitInfo); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		root = commitInfo
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, fmt.Errorf("commit set %s not found", id)
+	}
+	if err := d.checkIsAuthorized(pachClient, root.Commit.Repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	return d.commitSetFromRoot(pachClient, root)
+}
+
+func (d *driver) listCommitSet(pachClient *client.APIClient, f func(*pfs.CommitSetInfo) error) error {
+	ctx := pachClient.Ctx()
+	// Every commit without provenance (i.e. not created by a pipeline) roots
+	// a commit set. Repos the caller can't read are skipped, as in ListRepo.
+	var roots []*pfs.CommitInfo
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(ctx).List(repoInfo, col.DefaultOptions, func(repoName string) error {
+		if repoName == ppsconsts.SpecRepo {
+			return nil
+		}
+		if err := d.checkIsAuthorized(pachClient, repoInfo.Repo, auth.Scope_READER); err != nil {
+			if auth.IsErrNotAuthorized(err) {
+				return nil
+			}
+			return err
+		}
+		commitInfo := &pfs.CommitInfo{}
+		return d.commits(repoName).ReadOnly(ctx).List(commitInfo, col.DefaultOptions, func(commitID string) error {
+			if len(commitInfo.Provenance) == 0 {
+				roots = append(roots, proto.Clone(commitInfo).(*pfs.CommitInfo))
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		ti, _ := types.TimestampFromProto(roots[i].Started)
+		tj, _ := types.TimestampFromProto(roots[j].Started)
+		return ti.After(tj) // newest first
+	})
+	for _, root := range roots {
+		commitSetInfo, err := d.commitSetFromRoot(pachClient, root)
+		if err != nil {
+			return err
+		}
+		if err := f(commitSetInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteCommitSet deletes a triggering commit and all of its downstream
+// derived commits. The deletion itself is the single atomic transaction that
+// deleteCommit already runs over a commit's subvenance; this just resolves
+// the set first so the caller gets back (or, with dryRun, can preview) the
+// full impact.
+func (d *driver) deleteCommitSet(pachClient *client.APIClient, id string, dryRun bool) (*pfs.CommitSetInfo, error) {
+	commitSetInfo, err := d.inspectCommitSet(pachClient, id)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return commitSetInfo, nil
+	}
+	if err := d.deleteCommit(pachClient, commitSetInfo.Commit); err != nil {
+		return nil, err
+	}
+	return commitSetInfo, nil
+}
+
+// checkRetentionSTM returns an ErrComplianceRetention if 'commitInfo' is a
+// finished commit that's still inside its repo's compliance retention period
+// (see CreateRepoRequest.retention). The check is applied to every deleted
+// commit, including commits squashed as part of deleting their ancestor, and
+// there's deliberately no force/admin override.
+func (d *driver) checkRetentionSTM(stm col.STM, commitInfo *pfs.CommitInfo) error {
+	if commitInfo.Finished == nil {
+		return nil
+	}
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadWrite(stm).Get(commitInfo.Commit.Repo.Name, repoInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if repoInfo.Retention == nil {
+		return nil
+	}
+	retention, err := types.DurationFromProto(repoInfo.Retention)
+	if err != nil {
+		return err
+	}
+	finished, err := types.TimestampFromProto(commitInfo.Finished)
+	if err != nil {
+		return err
+	}
+	if time.Since(finished) < retention {
+		return pfsserver.ErrComplianceRetention{Commit: commitInfo.Commit, Retention: retention}
+	}
+	return nil
+}
+
 func (d *driver) deleteCommit(pachClient *client.APIClient, userCommit *pfs.Commit) error {
 	ctx := pachClient.Ctx()
 	if err := d.checkIsAuthorized(pachClient, userCommit.Repo, auth.Scope_WRITER); err != nil {
@@ -1817,6 +2264,9 @@ func (d *driver) deleteCommit(pachClient *client.APIClient, userCommit *pfs.Comm
 				if err := commits.Get(commit.ID, commitInfo); err != nil {
 					return err
 				}
+				if err := d.checkRetentionSTM(stm, commitInfo); err != nil {
+					return err
+				}
 				// If a commit has already been deleted, we don't want to overwrite the existing information, since commitInfo will be nil
 				if _, ok := deleted[commit.ID]; !ok {
 					deleted[commit.ID] = commitInfo
@@ -2269,6 +2719,62 @@ func (d *driver) deleteBranchSTM(stm col.STM, branch *pfs.Branch, force bool) er
 	return nil
 }
 
+func (d *driver) createCommitTag(pachClient *client.APIClient, commit *pfs.Commit, name string) error {
+	if err := d.checkIsAuthorized(pachClient, commit.Repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("tag name cannot be empty")
+	}
+	_, err := col.NewSTM(pachClient.Ctx(), d.etcdClient, func(stm col.STM) error {
+		// Resolve 'commit' (it may itself be named by a branch or a tag), so
+		// the tag always points at a concrete commit ID
+		commitInfo, err := d.resolveCommit(stm, commit)
+		if err != nil {
+			return err
+		}
+		tags := d.tags(commit.Repo.Name).ReadWrite(stm)
+		tagInfo := &pfs.CommitTagInfo{}
+		if err := tags.Get(name, tagInfo); err == nil {
+			return fmt.Errorf("tag %s@%s already exists and tags are immutable; delete it to reuse the name", commit.Repo.Name, name)
+		} else if !col.IsErrNotFound(err) {
+			return err
+		}
+		return tags.Create(name, &pfs.CommitTagInfo{
+			Name:    name,
+			Commit:  commitInfo.Commit,
+			Created: now(),
+		})
+	})
+	return err
+}
+
+func (d *driver) listCommitTag(pachClient *client.APIClient, repo *pfs.Repo) ([]*pfs.CommitTagInfo, error) {
+	if err := d.checkIsAuthorized(pachClient, repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	var result []*pfs.CommitTagInfo
+	tagInfo := &pfs.CommitTagInfo{}
+	tags := d.tags(repo.Name).ReadOnly(pachClient.Ctx())
+	if err := tags.List(tagInfo, col.DefaultOptions, func(string) error {
+		result = append(result, proto.Clone(tagInfo).(*pfs.CommitTagInfo))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *driver) deleteCommitTag(pachClient *client.APIClient, repo *pfs.Repo, name string) error {
+	if err := d.checkIsAuthorized(pachClient, repo, auth.Scope_WRITER); err != nil {
+		return err
+	}
+	_, err := col.NewSTM(pachClient.Ctx(), d.etcdClient, func(stm col.STM) error {
+		return d.tags(repo.Name).ReadWrite(stm).Delete(name)
+	})
+	return err
+}
+
 // scratchCommitPrefix returns an etcd prefix that's used to temporarily
 // store the state of a file in an open commit.  Once the commit is finished,
 // the scratch space is removed.
@@ -2300,8 +2806,9 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 	var putFileRecords []*pfs.PutFileRecords
 	var mu sync.Mutex
 	oneOff, repo, branch, err := d.forEachPutFile(pachClient, s, func(req *pfs.PutFileRequest, r io.Reader) error {
+		r = bandwidth.NewReader(r, d.bandwidthLimiters(pachClient, req.File.Commit.Repo.Name)...)
 		records, err := d.putFile(pachClient, req.File, req.Delimiter, req.TargetFileDatums,
-			req.TargetFileBytes, req.HeaderRecords, req.OverwriteIndex, r)
+			req.TargetFileBytes, req.HeaderRecords, req.OverwriteIndex, req.Mode, req.SymlinkTarget, r)
 		if err != nil {
 			return err
 		}
@@ -2332,6 +2839,7 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 
 func (d *driver) putFile(pachClient *client.APIClient, file *pfs.File, delimiter pfs.Delimiter,
 	targetFileDatums, targetFileBytes, headerRecords int64, overwriteIndex *pfs.OverwriteIndex,
+	mode uint32, symlinkTarget string,
 	reader io.Reader) (*pfs.PutFileRecords, error) {
 	if err := d.checkIsAuthorized(pachClient, file.Commit.Repo, auth.Scope_WRITER); err != nil {
 		return nil, err
@@ -2341,7 +2849,13 @@ func (d *driver) putFile(pachClient *client.APIClient, file *pfs.File, delimiter
 	if hasPutFileOptions && delimiter == pfs.Delimiter_NONE {
 		return nil, fmt.Errorf("cannot set split options--targetFileBytes, targetFileDatums, or headerRecords--with delimiter == NONE, split disabled")
 	}
-	records := &pfs.PutFileRecords{}
+	if (mode != 0 || symlinkTarget != "") && delimiter != pfs.Delimiter_NONE {
+		return nil, fmt.Errorf("cannot set mode or symlinkTarget with delimiter != NONE; split files don't carry POSIX metadata")
+	}
+	records := &pfs.PutFileRecords{
+		Mode:          mode,
+		SymlinkTarget: symlinkTarget,
+	}
 	if overwriteIndex != nil && overwriteIndex.Index == 0 {
 		records.Tombstone = true
 	}
@@ -2921,6 +3435,61 @@ func provenantOnInput(provenance []*pfs.CommitProvenance) bool {
 	return provenanceCount > 0
 }
 
+// bandwidthLimiters returns the token buckets that apply to a transfer on
+// 'repo' by the current caller (empty when no limits are configured).
+func (d *driver) bandwidthLimiters(pachClient *client.APIClient, repo string) []*bandwidth.Limiter {
+	ctx := pachClient.Ctx()
+	var limiters []*bandwidth.Limiter
+	limit := &pfs.BandwidthLimit{}
+	if me, err := pachClient.WhoAmI(ctx, &auth.WhoAmIRequest{}); err == nil {
+		key := pfsdb.BandwidthLimitKey(&pfs.BandwidthLimit{Principal: me.Username})
+		if err := d.bandwidthLimits.ReadOnly(ctx).Get(key, limit); err == nil {
+			limiters = append(limiters, d.bandwidth.Limiter(key, limit.BytesPerSec))
+		}
+	}
+	key := pfsdb.BandwidthLimitKey(&pfs.BandwidthLimit{Repo: repo})
+	if err := d.bandwidthLimits.ReadOnly(ctx).Get(key, limit); err == nil {
+		limiters = append(limiters, d.bandwidth.Limiter(key, limit.BytesPerSec))
+	}
+	return limiters
+}
+
+// maybeFilterFile applies the repo's get_file_filters (if any) to 'r', the
+// content of 'file' that getFile is about to return. Callers whose access
+// level on the repo is at least a filter's raw_scope (OWNER when unset)
+// bypass that filter; when auth isn't activated there are no restricted
+// callers and everyone reads raw content.
+func (d *driver) maybeFilterFile(pachClient *client.APIClient, file *pfs.File, r io.Reader) (io.Reader, error) {
+	repoInfo := &pfs.RepoInfo{}
+	if err := d.repos.ReadOnly(pachClient.Ctx()).Get(file.Commit.Repo.Name, repoInfo); err != nil {
+		return nil, err
+	}
+	for _, filter := range repoInfo.GetFileFilters {
+		g, err := globlib.Compile(filter.PathGlob, '/')
+		if err != nil {
+			return nil, fmt.Errorf("could not compile path_glob of file filter %q: %v", filter.Filter, err)
+		}
+		if !g.Match(file.Path) {
+			continue
+		}
+		rawScope := filter.RawScope
+		if rawScope == auth.Scope_NONE {
+			rawScope = auth.Scope_OWNER
+		}
+		if err := d.checkIsAuthorized(pachClient, file.Commit.Repo, rawScope); err == nil {
+			continue // the caller may read the raw content
+		} else if !auth.IsErrNotAuthorized(err) {
+			return nil, err
+		}
+		f, err := filefilter.Get(filter.Filter)
+		if err != nil {
+			return nil, err
+		}
+		r = f.Apply(r, filter.Options)
+	}
+	return r, nil
+}
+
 func (d *driver) getFile(pachClient *client.APIClient, file *pfs.File, offset int64, size int64) (r io.Reader, retErr error) {
 	ctx := pachClient.Ctx()
 	if err := d.checkIsAuthorized(pachClient, file.Commit.Repo, auth.Scope_READER); err != nil {
@@ -3009,7 +3578,7 @@ func (d *driver) getFile(pachClient *client.APIClient, file *pfs.File, offset in
 		if err != nil {
 			return nil, err
 		}
-		return grpcutil.NewStreamingBytesReader(getObjectsClient, nil), nil
+		return d.maybeFilterFile(pachClient, file, grpcutil.NewStreamingBytesReader(getObjectsClient, nil))
 	}
 	// Handle commits to output repos
 	if commitInfo.Finished == nil {
@@ -3064,7 +3633,7 @@ func (d *driver) getFile(pachClient *client.APIClient, file *pfs.File, offset in
 	if err != nil {
 		return nil, err
 	}
-	return grpcutil.NewStreamingBytesReader(getBlocksClient, nil), nil
+	return d.maybeFilterFile(pachClient, file, grpcutil.NewStreamingBytesReader(getBlocksClient, nil))
 }
 
 // If full is false, exclude potentially large fields such as `Objects`
@@ -3081,6 +3650,9 @@ func nodeToFileInfo(ci *pfs.CommitInfo, path string, node *hashtree.NodeProto, f
 	}
 	if node.FileNode != nil {
 		fileInfo.FileType = pfs.FileType_FILE
+		fileInfo.Mode = node.FileNode.Mode
+		fileInfo.SymlinkTarget = node.FileNode.SymlinkTarget
+		fileInfo.Schema = node.FileNode.Schema
 		if full {
 			fileInfo.Objects = node.FileNode.Objects
 			fileInfo.BlockRefs = node.FileNode.BlockRefs
@@ -3347,6 +3919,83 @@ func (d *driver) walkFile(pachClient *client.APIClient, file *pfs.File, f func(*
 	})
 }
 
+// findFile streams the regular files under 'request.Path' in 'request.Commit'
+// that satisfy all of the request's predicates: a glob pattern over the base
+// name, size bounds, and a modification-time cutoff. The search runs entirely
+// over the hashtree metadata written when commits finish; the time predicate
+// is answered by diffing the commits that finished after the cutoff, so its
+// cost is proportional to the amount of recent history rather than the size
+// of the repo.
+func (d *driver) findFile(pachClient *client.APIClient, request *pfs.FindFileRequest, f func(*pfs.FileInfo) error) error {
+	if request.Name != "" {
+		// validate the name pattern up front, so a bad pattern fails the call
+		// instead of silently matching nothing
+		if _, err := path.Match(request.Name, ""); err != nil {
+			return fmt.Errorf("invalid name pattern %q: %v", request.Name, err)
+		}
+	}
+	// If there's a time cutoff, collect the paths modified by every commit
+	// that finished after it ('modified' == nil means no cutoff)
+	var modified map[string]bool
+	if request.ModifiedSince != nil {
+		since, err := types.TimestampFromProto(request.ModifiedSince)
+		if err != nil {
+			return err
+		}
+		modified = make(map[string]bool)
+		ci, err := d.inspectCommit(pachClient, request.Commit, pfs.CommitState_STARTED)
+		if err != nil {
+			return err
+		}
+		for {
+			if ci.Finished != nil {
+				finished, err := types.TimestampFromProto(ci.Finished)
+				if err != nil {
+					return err
+				}
+				if finished.Before(since) {
+					break
+				}
+			}
+			news, _, err := d.diffFile(pachClient,
+				client.NewFile(ci.Commit.Repo.Name, ci.Commit.ID, ""), nil, false)
+			if err != nil {
+				return err
+			}
+			for _, fi := range news {
+				modified[fi.File.Path] = true
+			}
+			if ci.ParentCommit == nil {
+				break
+			}
+			if ci, err = d.inspectCommit(pachClient, ci.ParentCommit, pfs.CommitState_STARTED); err != nil {
+				return err
+			}
+		}
+	}
+	file := client.NewFile(request.Commit.Repo.Name, request.Commit.ID, request.Path)
+	return d.walkFile(pachClient, file, func(fi *pfs.FileInfo) error {
+		if fi.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		if request.Name != "" {
+			if ok, _ := path.Match(request.Name, path.Base(fi.File.Path)); !ok {
+				return nil
+			}
+		}
+		if request.MinSizeBytes > 0 && fi.SizeBytes < request.MinSizeBytes {
+			return nil
+		}
+		if request.MaxSizeBytes > 0 && fi.SizeBytes > request.MaxSizeBytes {
+			return nil
+		}
+		if modified != nil && !modified[fi.File.Path] {
+			return nil
+		}
+		return f(fi)
+	})
+}
+
 func (d *driver) globFile(pachClient *client.APIClient, commit *pfs.Commit, pattern string, f func(*pfs.FileInfo) error) (retErr error) {
 	if err := d.checkIsAuthorized(pachClient, commit.Repo, auth.Scope_READER); err != nil {
 		return err
@@ -3564,7 +4213,7 @@ func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtr
 		}
 	}
 	if !records.Split {
-		if len(records.Records) == 0 {
+		if len(records.Records) == 0 && records.SymlinkTarget == "" {
 			return nil
 		}
 		for _, record := range records.Records {
@@ -3589,6 +4238,18 @@ func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtr
 				}
 			}
 		}
+		if records.SymlinkTarget != "" && len(records.Records) == 0 {
+			// A symlink has no content of its own; create an empty file for
+			// the metadata to hang off of.
+			if err := tree.PutFile(key, nil, 0); err != nil {
+				return err
+			}
+		}
+		if records.Mode != 0 || records.SymlinkTarget != "" {
+			if err := tree.SetFileMetadata(key, records.Mode, records.SymlinkTarget); err != nil {
+				return err
+			}
+		}
 	} else {
 		nodes, err := tree.ListAll(key)
 		if err != nil && hashtree.Code(err) != hashtree.PathNotFound {
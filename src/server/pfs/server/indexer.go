@@ -0,0 +1,172 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+	"unicode/utf8"
+
+	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// IndexerURLEnvVar is the pachd environment variable holding the URL of
+	// an HTTP content indexer (e.g. an Elasticsearch ingest endpoint). When
+	// set, the metadata of every file in a commit is POSTed there when the
+	// commit finishes, and the result is recorded in the commit's
+	// 'index_status'.
+	IndexerURLEnvVar = "PACH_INDEXER_URL"
+	// IndexerContentLimitEnvVar optionally extends indexing to file contents:
+	// files no larger than this size (e.g. "1M") that contain valid UTF-8
+	// have their full text included in the indexed document.
+	IndexerContentLimitEnvVar = "PACH_INDEXER_CONTENT_LIMIT"
+
+	// indexBatchSize is the number of documents sent per request to the
+	// indexer, bounding request sizes for commits with many files.
+	indexBatchSize = 1000
+)
+
+// indexDocument is the JSON document posted to the indexer for each file.
+type indexDocument struct {
+	Repo      string `json:"repo"`
+	Commit    string `json:"commit"`
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size_bytes"`
+	Hash      string `json:"hash"`
+	Finished  string `json:"finished,omitempty"`
+	Content   string `json:"content,omitempty"`
+}
+
+// indexer pushes the contents of finished commits to an external search
+// index. A nil indexer indexes nothing, so callers don't need to check
+// whether indexing is configured.
+type indexer struct {
+	url          string
+	contentLimit int64 // include file contents up to this size (0 = never)
+	httpClient   *http.Client
+}
+
+// newIndexer returns the indexer configured by the environment, or nil if
+// PACH_INDEXER_URL is unset.
+func newIndexer() *indexer {
+	url := os.Getenv(IndexerURLEnvVar)
+	if url == "" {
+		return nil
+	}
+	var contentLimit int64
+	if limit := os.Getenv(IndexerContentLimitEnvVar); limit != "" {
+		var err error
+		contentLimit, err = units.RAMInBytes(limit)
+		if err != nil {
+			logrus.Errorf("could not parse %s %q (not indexing file contents): %v",
+				IndexerContentLimitEnvVar, limit, err)
+			contentLimit = 0
+		}
+	}
+	return &indexer{
+		url:          url,
+		contentLimit: contentLimit,
+		httpClient:   &http.Client{Timeout: time.Minute},
+	}
+}
+
+// indexCommit posts an indexDocument for every file in 'tree' to the
+// indexer's URL, in batches of indexBatchSize.
+func (ix *indexer) indexCommit(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, tree hashtree.HashTree) error {
+	commit := commitInfo.Commit
+	var finished string
+	if commitInfo.Finished != nil {
+		if t, err := types.TimestampFromProto(commitInfo.Finished); err == nil {
+			finished = t.Format(time.RFC3339)
+		}
+	}
+	var batch []*indexDocument
+	if err := tree.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil {
+			return nil
+		}
+		doc := &indexDocument{
+			Repo:      commit.Repo.Name,
+			Commit:    commit.ID,
+			Path:      path,
+			SizeBytes: node.SubtreeSize,
+			Hash:      fmt.Sprintf("%x", node.Hash),
+			Finished:  finished,
+		}
+		if ix.contentLimit > 0 && node.SubtreeSize <= ix.contentLimit {
+			var content bytes.Buffer
+			if err := pachClient.GetFile(commit.Repo.Name, commit.ID, path, 0, 0, &content); err == nil &&
+				utf8.Valid(content.Bytes()) {
+				doc.Content = content.String()
+			}
+		}
+		batch = append(batch, doc)
+		if len(batch) >= indexBatchSize {
+			if err := ix.postBatch(batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(batch) > 0 {
+		return ix.postBatch(batch)
+	}
+	return nil
+}
+
+func (ix *indexer) postBatch(batch []*indexDocument) error {
+	payload, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+	resp, err := ix.httpClient.Post(ix.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("indexer returned %s", resp.Status)
+	}
+	return nil
+}
+
+// indexCommitInBackground runs the indexer over a freshly finished commit and
+// records the outcome in the commit's index_status. It's called in its own
+// goroutine by finishCommit--indexing failures are recorded and logged but
+// never fail the finish itself.
+func (d *driver) indexCommitInBackground(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, tree hashtree.HashTree) {
+	commit := commitInfo.Commit
+	status, reason := pfs.IndexStatus_INDEX_DONE, ""
+	if err := d.indexer.indexCommit(pachClient, commitInfo, tree); err != nil {
+		logrus.Errorf("could not index commit %s: %v", commit.FullID(), err)
+		status, reason = pfs.IndexStatus_INDEX_FAILED, err.Error()
+	}
+	if err := d.setCommitIndexStatus(pachClient.Ctx(), commit, status, reason); err != nil {
+		logrus.Errorf("could not record index status of commit %s: %v", commit.FullID(), err)
+	}
+}
+
+func (d *driver) setCommitIndexStatus(ctx context.Context, commit *pfs.Commit, status pfs.IndexStatus, reason string) error {
+	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		commitInfo := &pfs.CommitInfo{}
+		return d.commits(commit.Repo.Name).ReadWrite(stm).Update(commit.ID, commitInfo, func() error {
+			commitInfo.IndexStatus = status
+			commitInfo.IndexReason = reason
+			return nil
+		})
+	})
+	return err
+}
@@ -251,7 +251,15 @@ func (s *objBlockAPIServer) PutObjectSplit(server pfsclient.ObjectAPI_PutObjectS
 
 func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader, split bool) (_ *pfsclient.Object, retErr error) {
 	hash := pfsclient.NewHash()
-	r := io.TeeReader(dataReader, hash)
+	var hashes io.Writer = hash
+	// in the dual-hash transition mode, also hash the content under the
+	// legacy algorithm so it can be deduped against objects written before
+	// the algorithm changed
+	legacyHash := pfsclient.NewLegacyHash()
+	if legacyHash != nil {
+		hashes = io.MultiWriter(hash, legacyHash)
+	}
+	r := io.TeeReader(dataReader, hashes)
 	block := &pfsclient.Block{Hash: uuid.NewWithoutDashes()}
 	var size int64
 	if err := func() (retErr error) {
@@ -296,6 +304,22 @@ func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader,
 	if err != nil {
 		return nil, err
 	}
+	if !resp.Exists && legacyHash != nil {
+		// the content isn't stored under the current algorithm's hash; if
+		// it's stored under the legacy one, reuse that object instead of
+		// storing the content twice
+		legacyObject := &pfsclient.Object{Hash: pfsclient.EncodeHash(legacyHash.Sum(nil))}
+		legacyResp, err := s.CheckObject(ctx, &pfsclient.CheckObjectRequest{Object: legacyObject})
+		if err != nil {
+			return nil, err
+		}
+		if legacyResp.Exists {
+			if err := s.objClient.Delete(ctx, s.blockPath(block)); err != nil {
+				return nil, err
+			}
+			return legacyObject, nil
+		}
+	}
 	if resp.Exists {
 		// the object already exists so we delete the block we put
 		if err := s.objClient.Delete(ctx, s.blockPath(block)); err != nil {
@@ -0,0 +1,515 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"io"
+	pathlib "path"
+	"strconv"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/sirupsen/logrus"
+)
+
+// Schema extraction: when a commit finishes on a repo with 'extract_schemas'
+// enabled, the schemas of its columnar files (CSV, TSV and Parquet) are
+// parsed out of the file contents and recorded in the commit's hashtree, so
+// that data catalogs can read column names and types from InspectFile instead
+// of downloading the files to infer them.
+
+const (
+	// schemaSampleSize bounds how much of a CSV/TSV file is read to infer its
+	// schema; files larger than this get an unknown (-1) row count.
+	schemaSampleSize = 1 << 20
+	// schemaTypeRows is the number of data rows sampled to infer column types.
+	schemaTypeRows = 100
+	// parquetFooterSize bounds how much of a Parquet file's tail is read to
+	// find its footer metadata.
+	parquetFooterSize = 1 << 20
+)
+
+// extractSchemas records the schema of every columnar file in 'tree' that
+// doesn't already have one (files unchanged since the parent commit keep the
+// schema extracted when they were written). It's called by finishCommit
+// before the tree is sealed, and is a no-op unless the repo has schema
+// extraction enabled. Malformed files are skipped--a file that doesn't parse
+// must not prevent its commit from finishing.
+func (d *driver) extractSchemas(pachClient *client.APIClient, repo *pfs.Repo, tree hashtree.HashTree) error {
+	repoInfo := new(pfs.RepoInfo)
+	if err := d.repos.ReadOnly(pachClient.Ctx()).Get(repo.Name, repoInfo); err != nil {
+		return err
+	}
+	if !repoInfo.ExtractSchemas {
+		return nil
+	}
+	type candidate struct {
+		path string
+		node *hashtree.NodeProto
+	}
+	var candidates []candidate
+	if err := tree.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil || node.FileNode.Schema != nil ||
+			node.FileNode.SymlinkTarget != "" || len(node.FileNode.Objects) == 0 {
+			return nil
+		}
+		switch pathlib.Ext(path) {
+		case ".csv", ".tsv", ".parquet":
+			candidates = append(candidates, candidate{path, node})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	var updated bool
+	for _, c := range candidates {
+		schema, err := extractSchema(pachClient, c.path, c.node)
+		if err != nil {
+			logrus.Debugf("could not extract schema of %q: %v", c.path, err)
+			continue
+		}
+		if err := tree.SetFileSchema(c.path, schema); err != nil {
+			return err
+		}
+		updated = true
+	}
+	if updated {
+		// re-hash the tree so the modified nodes' hashes are up to date
+		return tree.Hash()
+	}
+	return nil
+}
+
+func extractSchema(pachClient *client.APIClient, filePath string, node *hashtree.NodeProto) (*pfs.FileSchema, error) {
+	var hashes []string
+	for _, object := range node.FileNode.Objects {
+		hashes = append(hashes, object.Hash)
+	}
+	size := uint64(node.SubtreeSize)
+	switch pathlib.Ext(filePath) {
+	case ".csv":
+		return extractSeparatedValuesSchema(pachClient, hashes, size, "csv", ',')
+	case ".tsv":
+		return extractSeparatedValuesSchema(pachClient, hashes, size, "tsv", '\t')
+	case ".parquet":
+		return extractParquetSchema(pachClient, hashes, size)
+	}
+	return nil, fmt.Errorf("unrecognized columnar format %q", pathlib.Ext(filePath))
+}
+
+// extractSeparatedValuesSchema infers a CSV/TSV file's schema from up to
+// schemaSampleSize bytes of its head: the first record names the columns, and
+// up to schemaTypeRows subsequent records are sampled to infer column types.
+// The row count is only exact when the whole file fit in the sample.
+func extractSeparatedValuesSchema(pachClient *client.APIClient, hashes []string, size uint64, format string, comma rune) (*pfs.FileSchema, error) {
+	whole := size <= schemaSampleSize
+	data, err := pachClient.ReadObjects(hashes, 0, schemaSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	schema := &pfs.FileSchema{
+		Format:   format,
+		RowCount: -1,
+	}
+	columnTypes := make([]string, len(header))
+	for _, name := range header {
+		schema.Columns = append(schema.Columns, &pfs.SchemaColumn{
+			Name: strings.TrimSpace(name),
+		})
+	}
+	var rows int64
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !whole {
+				break // the sample likely ends mid-record
+			}
+			return nil, err
+		}
+		rows++
+		if rows <= schemaTypeRows {
+			for i, field := range record {
+				if i < len(columnTypes) {
+					columnTypes[i] = mergeColumnType(columnTypes[i], field)
+				}
+			}
+		}
+	}
+	if whole {
+		schema.RowCount = rows
+	}
+	for i, columnType := range columnTypes {
+		if columnType == "" {
+			columnType = "string"
+		}
+		schema.Columns[i].Type = columnType
+	}
+	return schema, nil
+}
+
+// mergeColumnType widens 'current' so that it also admits 'field': "int"
+// widens to "float" widens to "string"; empty fields don't affect the type.
+func mergeColumnType(current, field string) string {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return current
+	}
+	var fieldType string
+	if _, err := strconv.ParseInt(field, 10, 64); err == nil {
+		fieldType = "int"
+	} else if _, err := strconv.ParseFloat(field, 64); err == nil {
+		fieldType = "float"
+	} else if _, err := strconv.ParseBool(field); err == nil {
+		fieldType = "bool"
+	} else {
+		fieldType = "string"
+	}
+	switch {
+	case current == "" || current == fieldType:
+		return fieldType
+	case (current == "int" && fieldType == "float") || (current == "float" && fieldType == "int"):
+		return "float"
+	}
+	return "string"
+}
+
+// extractParquetSchema reads a Parquet file's footer, which ends with
+// [metadata][4-byte metadata length][magic "PAR1"], and decodes the column
+// names, types and row count out of the metadata.
+func extractParquetSchema(pachClient *client.APIClient, hashes []string, size uint64) (*pfs.FileSchema, error) {
+	if size < 12 {
+		return nil, fmt.Errorf("file too small to be parquet")
+	}
+	footerSize := uint64(parquetFooterSize)
+	if footerSize > size {
+		footerSize = size
+	}
+	tail, err := pachClient.ReadObjects(hashes, size-footerSize, footerSize)
+	if err != nil {
+		return nil, err
+	}
+	return parquetSchemaFromTail(tail)
+}
+
+// parquetSchemaFromTail decodes a parquet schema out of the last bytes of a
+// file (which must include the whole footer).
+func parquetSchemaFromTail(tail []byte) (*pfs.FileSchema, error) {
+	if !bytes.HasSuffix(tail, []byte("PAR1")) {
+		return nil, fmt.Errorf("missing parquet magic")
+	}
+	if len(tail) < 12 {
+		return nil, fmt.Errorf("file too small to be parquet")
+	}
+	metadataLen := uint64(binary.LittleEndian.Uint32(tail[len(tail)-8 : len(tail)-4]))
+	if metadataLen+8 > uint64(len(tail)) {
+		return nil, fmt.Errorf("parquet footer metadata (%d bytes) exceeds the %d-byte sample", metadataLen, parquetFooterSize)
+	}
+	return parseParquetFooter(tail[uint64(len(tail))-8-metadataLen : len(tail)-8])
+}
+
+// The parquet footer metadata is a FileMetaData struct encoded with thrift's
+// compact protocol. Only the two fields the schema needs--the SchemaElement
+// list (field 2) and the row count (field 3)--are decoded; everything else is
+// skipped, so new parquet-format fields don't break extraction.
+const (
+	thriftStop      = 0x0
+	thriftBoolTrue  = 0x1
+	thriftBoolFalse = 0x2
+	thriftByte      = 0x3
+	thriftI16       = 0x4
+	thriftI32       = 0x5
+	thriftI64       = 0x6
+	thriftDouble    = 0x7
+	thriftBinary    = 0x8
+	thriftList      = 0x9
+	thriftSet       = 0xa
+	thriftMap       = 0xb
+	thriftStruct    = 0xc
+)
+
+func parseParquetFooter(data []byte) (*pfs.FileSchema, error) {
+	reader := &thriftReader{data: data}
+	schema := &pfs.FileSchema{
+		Format:   "parquet",
+		RowCount: -1,
+	}
+	var lastID int16
+	for {
+		fieldType, id, err := reader.fieldHeader(lastID)
+		if err != nil {
+			return nil, err
+		}
+		if fieldType == thriftStop {
+			break
+		}
+		lastID = id
+		switch {
+		case id == 2 && fieldType == thriftList:
+			elemType, n, err := reader.listHeader()
+			if err != nil {
+				return nil, err
+			}
+			if elemType != thriftStruct {
+				return nil, fmt.Errorf("malformed parquet schema list")
+			}
+			for i := 0; i < n; i++ {
+				name, columnType, numChildren, err := reader.readSchemaElement()
+				if err != nil {
+					return nil, err
+				}
+				// the first element is the schema root, and nested groups
+				// have children of their own; only the leaves are columns
+				if i > 0 && numChildren == 0 {
+					schema.Columns = append(schema.Columns, &pfs.SchemaColumn{
+						Name: name,
+						Type: columnType,
+					})
+				}
+			}
+		case id == 3 && (fieldType == thriftI64 || fieldType == thriftI32):
+			rows, err := reader.zigzag()
+			if err != nil {
+				return nil, err
+			}
+			schema.RowCount = rows
+		default:
+			if err := reader.skip(fieldType); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return schema, nil
+}
+
+// readSchemaElement decodes one SchemaElement struct: field 1 is the physical
+// type, field 4 the name, field 5 the number of children (non-zero only for
+// the root and nested groups) and field 6 the converted (logical) type.
+func (t *thriftReader) readSchemaElement() (string, string, int64, error) {
+	var name string
+	var physical, converted, numChildren int64 = -1, -1, 0
+	var lastID int16
+	for {
+		fieldType, id, err := t.fieldHeader(lastID)
+		if err != nil {
+			return "", "", 0, err
+		}
+		if fieldType == thriftStop {
+			break
+		}
+		lastID = id
+		switch {
+		case id == 1 && fieldType == thriftI32:
+			physical, err = t.zigzag()
+		case id == 4 && fieldType == thriftBinary:
+			var raw []byte
+			raw, err = t.bytes()
+			name = string(raw)
+		case id == 5 && fieldType == thriftI32:
+			numChildren, err = t.zigzag()
+		case id == 6 && fieldType == thriftI32:
+			converted, err = t.zigzag()
+		default:
+			err = t.skip(fieldType)
+		}
+		if err != nil {
+			return "", "", 0, err
+		}
+	}
+	return name, parquetColumnType(physical, converted), numChildren, nil
+}
+
+// parquetColumnType maps parquet physical and converted types onto the same
+// type names that CSV inference uses.
+func parquetColumnType(physical, converted int64) string {
+	if converted == 0 { // UTF8
+		return "string"
+	}
+	switch physical {
+	case 0: // BOOLEAN
+		return "bool"
+	case 1, 2, 3: // INT32, INT64, INT96
+		return "int"
+	case 4, 5: // FLOAT, DOUBLE
+		return "float"
+	}
+	return "string" // BYTE_ARRAY, FIXED_LEN_BYTE_ARRAY
+}
+
+// thriftReader decodes just enough of thrift's compact protocol to read a
+// parquet footer.
+type thriftReader struct {
+	data []byte
+	pos  int
+}
+
+func (t *thriftReader) byte() (byte, error) {
+	if t.pos >= len(t.data) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	b := t.data[t.pos]
+	t.pos++
+	return b, nil
+}
+
+func (t *thriftReader) varint() (uint64, error) {
+	var result uint64
+	var shift uint
+	for {
+		b, err := t.byte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+		shift += 7
+		if shift > 63 {
+			return 0, fmt.Errorf("malformed varint")
+		}
+	}
+}
+
+func (t *thriftReader) zigzag() (int64, error) {
+	raw, err := t.varint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(raw>>1) ^ -int64(raw&1), nil
+}
+
+func (t *thriftReader) bytes() ([]byte, error) {
+	length, err := t.varint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(t.pos)+length > uint64(len(t.data)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	raw := t.data[t.pos : uint64(t.pos)+length]
+	t.pos += int(length)
+	return raw, nil
+}
+
+// fieldHeader reads the next struct field's type and id; a thriftStop type
+// marks the end of the struct. Field ids are usually encoded as a delta from
+// the previous field's id, packed into the type byte.
+func (t *thriftReader) fieldHeader(lastID int16) (byte, int16, error) {
+	b, err := t.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	if b == thriftStop {
+		return thriftStop, 0, nil
+	}
+	fieldType := b & 0x0f
+	if delta := int16(b >> 4); delta != 0 {
+		return fieldType, lastID + delta, nil
+	}
+	id, err := t.zigzag()
+	return fieldType, int16(id), err
+}
+
+// listHeader reads a list (or set) header: the element type and size are
+// packed into one byte unless the size is 15 or more.
+func (t *thriftReader) listHeader() (byte, int, error) {
+	b, err := t.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	elemType := b & 0x0f
+	size := int(b >> 4)
+	if size == 15 {
+		bigSize, err := t.varint()
+		if err != nil {
+			return 0, 0, err
+		}
+		size = int(bigSize)
+	}
+	return elemType, size, nil
+}
+
+// skip advances past one value of the given type.
+func (t *thriftReader) skip(fieldType byte) error {
+	switch fieldType {
+	case thriftBoolTrue, thriftBoolFalse:
+		return nil
+	case thriftByte:
+		_, err := t.byte()
+		return err
+	case thriftI16, thriftI32, thriftI64:
+		_, err := t.varint()
+		return err
+	case thriftDouble:
+		if t.pos+8 > len(t.data) {
+			return io.ErrUnexpectedEOF
+		}
+		t.pos += 8
+		return nil
+	case thriftBinary:
+		_, err := t.bytes()
+		return err
+	case thriftList, thriftSet:
+		elemType, size, err := t.listHeader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < size; i++ {
+			if err := t.skip(elemType); err != nil {
+				return err
+			}
+		}
+		return nil
+	case thriftMap:
+		size, err := t.varint()
+		if err != nil {
+			return err
+		}
+		if size == 0 {
+			return nil
+		}
+		kv, err := t.byte()
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < size; i++ {
+			if err := t.skip(kv >> 4); err != nil {
+				return err
+			}
+			if err := t.skip(kv & 0x0f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case thriftStruct:
+		var lastID int16
+		for {
+			fieldType, id, err := t.fieldHeader(lastID)
+			if err != nil {
+				return err
+			}
+			if fieldType == thriftStop {
+				return nil
+			}
+			lastID = id
+			if err := t.skip(fieldType); err != nil {
+				return err
+			}
+		}
+	}
+	return fmt.Errorf("unknown thrift type %d", fieldType)
+}
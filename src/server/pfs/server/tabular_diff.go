@@ -0,0 +1,242 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	pathlib "path"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// Tabular diffs: 'pachctl diff file --tabular' summarizes how a CSV, TSV or
+// Parquet file changed between two commits--rows added and removed, columns
+// added, removed and changed--instead of the binary "file changed" that a
+// regular diff gives. The summary is computed here rather than on the client
+// so that huge files can be sampled next to the data.
+
+// diffTabularFile computes a TabularDiff between 'newFile' and 'oldFile'
+// (which defaults to the same path in the parent of 'newFile's commit, like
+// diffFile). CSV and TSV files are compared row by row over up to
+// schemaSampleSize bytes of each version; parquet files are compared through
+// their footer metadata, so their row delta is the net change.
+func (d *driver) diffTabularFile(pachClient *client.APIClient, newFile *pfs.File, oldFile *pfs.File) (*pfs.TabularDiff, error) {
+	if oldFile == nil {
+		commitInfo, err := d.inspectCommit(pachClient, newFile.Commit, pfs.CommitState_STARTED)
+		if err != nil {
+			return nil, err
+		}
+		if commitInfo.ParentCommit == nil {
+			return nil, fmt.Errorf("commit %s@%s has no parent to diff against", newFile.Commit.Repo.Name, newFile.Commit.ID)
+		}
+		oldFile = client.NewFile(commitInfo.ParentCommit.Repo.Name, commitInfo.ParentCommit.ID, newFile.Path)
+	}
+	ext := pathlib.Ext(newFile.Path)
+	if pathlib.Ext(oldFile.Path) != ext {
+		return nil, fmt.Errorf("cannot compute a tabular diff across formats (%q vs %q)", ext, pathlib.Ext(oldFile.Path))
+	}
+	newInfo, err := d.inspectFile(pachClient, newFile)
+	if err != nil {
+		return nil, err
+	}
+	oldInfo, err := d.inspectFile(pachClient, oldFile)
+	if err != nil {
+		return nil, err
+	}
+	switch ext {
+	case ".csv":
+		return diffSeparatedValuesFile(pachClient, newFile, oldFile, newInfo, oldInfo, "csv", ',')
+	case ".tsv":
+		return diffSeparatedValuesFile(pachClient, newFile, oldFile, newInfo, oldInfo, "tsv", '\t')
+	case ".parquet":
+		return diffParquetFile(pachClient, newFile, oldFile, newInfo, oldInfo)
+	}
+	return nil, fmt.Errorf("%q is not a tabular file (.csv, .tsv or .parquet)", newFile.Path)
+}
+
+// readFileSample reads up to 'size' bytes of 'file' starting at 'offset'.
+func readFileSample(pachClient *client.APIClient, file *pfs.File, offset int64, size int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pachClient.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, offset, size, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func diffSeparatedValuesFile(pachClient *client.APIClient, newFile, oldFile *pfs.File, newInfo, oldInfo *pfs.FileInfo, format string, comma rune) (*pfs.TabularDiff, error) {
+	diff := &pfs.TabularDiff{
+		Format:  format,
+		Sampled: newInfo.SizeBytes > schemaSampleSize || oldInfo.SizeBytes > schemaSampleSize,
+	}
+	newData, err := readFileSample(pachClient, newFile, 0, schemaSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	oldData, err := readFileSample(pachClient, oldFile, 0, schemaSampleSize)
+	if err != nil {
+		return nil, err
+	}
+	newHeader, newRecords, err := parseSeparatedValues(newData, comma, newInfo.SizeBytes <= schemaSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", newFile.Path, err)
+	}
+	oldHeader, oldRecords, err := parseSeparatedValues(oldData, comma, oldInfo.SizeBytes <= schemaSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", oldFile.Path, err)
+	}
+
+	newColumns := make(map[string]int)
+	for i, name := range newHeader {
+		newColumns[name] = i
+	}
+	oldColumns := make(map[string]int)
+	for i, name := range oldHeader {
+		oldColumns[name] = i
+	}
+	for _, name := range newHeader {
+		if _, ok := oldColumns[name]; !ok {
+			diff.ColumnsAdded = append(diff.ColumnsAdded, name)
+		}
+	}
+	for _, name := range oldHeader {
+		if _, ok := newColumns[name]; !ok {
+			diff.ColumnsRemoved = append(diff.ColumnsRemoved, name)
+		}
+	}
+
+	// Row-level diff: rows are compared as multisets, so reordering a file
+	// doesn't count as a change but duplicated rows do
+	rowCounts := make(map[string]int64)
+	for _, record := range newRecords {
+		rowCounts[strings.Join(record, "\x00")]++
+	}
+	for _, record := range oldRecords {
+		rowCounts[strings.Join(record, "\x00")]--
+	}
+	for _, count := range rowCounts {
+		if count > 0 {
+			diff.RowsAdded += count
+		} else {
+			diff.RowsRemoved -= count
+		}
+	}
+
+	// A column present in both versions "changed" if the multiset of its
+	// values differs
+	for _, name := range newHeader {
+		oldIdx, ok := oldColumns[name]
+		if !ok {
+			continue
+		}
+		newIdx := newColumns[name]
+		valueCounts := make(map[string]int64)
+		for _, record := range newRecords {
+			if newIdx < len(record) {
+				valueCounts[record[newIdx]]++
+			}
+		}
+		for _, record := range oldRecords {
+			if oldIdx < len(record) {
+				valueCounts[record[oldIdx]]--
+			}
+		}
+		for _, count := range valueCounts {
+			if count != 0 {
+				diff.ColumnsChanged = append(diff.ColumnsChanged, name)
+				break
+			}
+		}
+	}
+	return diff, nil
+}
+
+// parseSeparatedValues splits 'data' into a header record and data records.
+// If 'whole' is false, 'data' is a truncated sample and a parse error at the
+// end (likely a record cut off mid-line) just ends the records early.
+func parseSeparatedValues(data []byte, comma rune, whole bool) ([]string, [][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !whole {
+				break
+			}
+			return nil, nil, err
+		}
+		records = append(records, record)
+	}
+	return header, records, nil
+}
+
+// diffParquetFile compares two parquet files through their footer metadata:
+// the row delta comes from the footers' row counts (so it's a net change, not
+// per-row), and a column "changed" if its type changed.
+func diffParquetFile(pachClient *client.APIClient, newFile, oldFile *pfs.File, newInfo, oldInfo *pfs.FileInfo) (*pfs.TabularDiff, error) {
+	newSchema, err := parquetFileSchema(pachClient, newFile, newInfo)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", newFile.Path, err)
+	}
+	oldSchema, err := parquetFileSchema(pachClient, oldFile, oldInfo)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %v", oldFile.Path, err)
+	}
+	diff := &pfs.TabularDiff{Format: "parquet"}
+	if newSchema.RowCount >= 0 && oldSchema.RowCount >= 0 {
+		if delta := newSchema.RowCount - oldSchema.RowCount; delta > 0 {
+			diff.RowsAdded = delta
+		} else {
+			diff.RowsRemoved = -delta
+		}
+	}
+	oldTypes := make(map[string]string)
+	for _, column := range oldSchema.Columns {
+		oldTypes[column.Name] = column.Type
+	}
+	newTypes := make(map[string]string)
+	for _, column := range newSchema.Columns {
+		newTypes[column.Name] = column.Type
+	}
+	for _, column := range newSchema.Columns {
+		oldType, ok := oldTypes[column.Name]
+		if !ok {
+			diff.ColumnsAdded = append(diff.ColumnsAdded, column.Name)
+		} else if oldType != column.Type {
+			diff.ColumnsChanged = append(diff.ColumnsChanged, column.Name)
+		}
+	}
+	for _, column := range oldSchema.Columns {
+		if _, ok := newTypes[column.Name]; !ok {
+			diff.ColumnsRemoved = append(diff.ColumnsRemoved, column.Name)
+		}
+	}
+	return diff, nil
+}
+
+// parquetFileSchema reads 'file's parquet footer and decodes its schema.
+func parquetFileSchema(pachClient *client.APIClient, file *pfs.File, fileInfo *pfs.FileInfo) (*pfs.FileSchema, error) {
+	if fileInfo.SizeBytes < 12 {
+		return nil, fmt.Errorf("file too small to be parquet")
+	}
+	footerSize := uint64(parquetFooterSize)
+	if footerSize > fileInfo.SizeBytes {
+		footerSize = fileInfo.SizeBytes
+	}
+	tail, err := readFileSample(pachClient, file, int64(fileInfo.SizeBytes-footerSize), int64(footerSize))
+	if err != nil {
+		return nil, err
+	}
+	return parquetSchemaFromTail(tail)
+}
@@ -0,0 +1,20 @@
+// Package audit records security-relevant events (currently blocked reads
+// and egresses of classified repos) as structured log lines in pachd's
+// output, so operators can route them to their log collector or SIEM of
+// choice.
+package audit
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// Violation records a policy violation: 'principal' attempted 'action' (e.g.
+// "egress", "s3-gateway read") on 'repo' and was blocked for 'reason'.
+func Violation(principal string, action string, repo string, reason string) {
+	log.WithFields(log.Fields{
+		"audit":     "violation",
+		"principal": principal,
+		"action":    action,
+		"repo":      repo,
+	}).Errorf("policy violation: %s", reason)
+}
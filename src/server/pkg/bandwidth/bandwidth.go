@@ -0,0 +1,114 @@
+// Package bandwidth implements the token buckets behind Pachyderm's
+// per-principal and per-repo transfer shaping (see 'pachctl admin
+// set-bandwidth-limit'): PutFile and GetFile streams subject to a limit draw
+// tokens from a shared bucket and are delayed once it empties, so a bulk
+// backfill can't starve interactive users or pipeline traffic.
+package bandwidth
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket: callers Wait for the right to transfer n bytes
+// and are delayed once the bucket empties. The bucket holds up to one
+// second's worth of tokens, so short bursts pass unthrottled.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   int64 // bytes per second
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter that allows 'rate' bytes per second.
+func NewLimiter(rate int64) *Limiter {
+	return &Limiter{
+		rate:   rate,
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+// SetRate changes the limiter's rate; in-flight Waits finish under the old
+// rate, subsequent ones use the new one.
+func (l *Limiter) SetRate(rate int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = rate
+}
+
+// Wait blocks until the caller may transfer another n bytes.
+func (l *Limiter) Wait(n int) {
+	l.mu.Lock()
+	if l.rate <= 0 {
+		l.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.rate)
+	if max := float64(l.rate); l.tokens > max {
+		l.tokens = max
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	var sleep time.Duration
+	if l.tokens < 0 {
+		sleep = time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+	}
+	l.mu.Unlock()
+	if sleep > 0 {
+		time.Sleep(sleep)
+	}
+}
+
+// Registry hands out shared Limiters by key, so that every stream throttled
+// by the same limit draws from the same bucket.
+type Registry struct {
+	mu       sync.Mutex
+	limiters map[string]*Limiter
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{limiters: make(map[string]*Limiter)}
+}
+
+// Limiter returns the shared limiter stored under 'key', creating it if
+// needed and updating its rate to 'rate'.
+func (r *Registry) Limiter(key string, rate int64) *Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limiters[key]
+	if !ok {
+		l = NewLimiter(rate)
+		r.limiters[key] = l
+		return l
+	}
+	l.SetRate(rate)
+	return l
+}
+
+// NewReader wraps 'r' so that every read waits on 'limiters'; with no
+// limiters it returns 'r' unchanged.
+func NewReader(r io.Reader, limiters ...*Limiter) io.Reader {
+	if len(limiters) == 0 {
+		return r
+	}
+	return &reader{r: r, limiters: limiters}
+}
+
+type reader struct {
+	r        io.Reader
+	limiters []*Limiter
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		for _, l := range r.limiters {
+			l.Wait(n)
+		}
+	}
+	return n, err
+}
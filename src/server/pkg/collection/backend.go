@@ -0,0 +1,47 @@
+package collection
+
+import (
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// Backend abstracts the store behind Pachyderm's metadata collections, so
+// that deployments can keep small clusters on etcd (the default) and move
+// metadata-heavy clusters to Postgres (see NewPostgresBackend), which has no
+// practical keyspace ceiling. A Backend hands out Collections and runs
+// transactions against whichever store it wraps; collections from one
+// backend must only be used with transactions from the same backend.
+type Backend interface {
+	// NewCollection creates a collection; the arguments are the same as
+	// NewCollection's, minus the client.
+	NewCollection(prefix string, indexes []*Index, template proto.Message, keyCheck func(string) error, valCheck func(proto.Message) error) Collection
+	// NewSTM runs 'apply' in a transaction against the backend, retrying it
+	// as necessary until it applies cleanly.
+	NewSTM(ctx context.Context, apply func(STM) error) error
+	// NewDryrunSTM is like NewSTM except that the final commit is skipped.
+	NewDryrunSTM(ctx context.Context, apply func(STM) error) error
+}
+
+// NewEtcdBackend returns a Backend that stores collections in etcd; it's
+// equivalent to calling NewCollection and NewSTM with 'etcdClient' directly.
+func NewEtcdBackend(etcdClient *etcd.Client) Backend {
+	return &etcdBackend{etcdClient: etcdClient}
+}
+
+type etcdBackend struct {
+	etcdClient *etcd.Client
+}
+
+func (b *etcdBackend) NewCollection(prefix string, indexes []*Index, template proto.Message, keyCheck func(string) error, valCheck func(proto.Message) error) Collection {
+	return NewCollection(b.etcdClient, prefix, indexes, template, keyCheck, valCheck)
+}
+
+func (b *etcdBackend) NewSTM(ctx context.Context, apply func(STM) error) error {
+	_, err := NewSTM(ctx, b.etcdClient, apply)
+	return err
+}
+
+func (b *etcdBackend) NewDryrunSTM(ctx context.Context, apply func(STM) error) error {
+	return NewDryrunSTM(ctx, b.etcdClient, apply)
+}
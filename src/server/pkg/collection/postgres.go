@@ -0,0 +1,658 @@
+package collection
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+
+	v3 "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	// pgTable is the table that holds every collection's key/value pairs.
+	// Collections are distinguished by key prefix, exactly as they are in
+	// etcd, so the indexing scheme described in types.go carries over
+	// unchanged.
+	pgTable = "collections"
+	// pgRevSeq is the sequence that stands in for etcd's revision counter;
+	// every write takes a new value from it so that List can sort by
+	// CreateRevision/ModRevision the way the etcd backend does.
+	pgRevSeq = "collections_rev"
+	// pgWatchInterval is how often postgres watchers poll for changes.
+	// Postgres has no equivalent of etcd's watch API, so watchers scan for
+	// rows whose mod_rev has advanced past the last revision they saw.
+	pgWatchInterval = time.Second
+)
+
+var pgSchema = []string{
+	fmt.Sprintf(`CREATE SEQUENCE IF NOT EXISTS %s`, pgRevSeq),
+	fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		key text PRIMARY KEY,
+		value bytea NOT NULL,
+		create_rev bigint NOT NULL,
+		mod_rev bigint NOT NULL,
+		expires_at timestamptz
+	)`, pgTable),
+	// text_pattern_ops makes the LIKE 'prefix%' scans that back List and
+	// DeleteAll use the index regardless of the database's collation.
+	fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_prefix_idx ON %s (key text_pattern_ops)`, pgTable, pgTable),
+}
+
+// NewPostgresBackend returns a Backend that stores collections in postgres,
+// in a single key/value table that mirrors the etcd keyspace. It creates the
+// schema if it doesn't already exist. 'db' must be connected to a database
+// that the caller may create tables in; the postgres driver must already be
+// registered (pachd does this with a blank import of lib/pq).
+func NewPostgresBackend(db *sql.DB) (Backend, error) {
+	for _, stmt := range pgSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("could not initialize postgres metadata schema: %v", err)
+		}
+	}
+	return &pgBackend{db: db}, nil
+}
+
+type pgBackend struct {
+	db *sql.DB
+}
+
+func (b *pgBackend) NewCollection(prefix string, indexes []*Index, template proto.Message, keyCheck func(string) error, valCheck func(proto.Message) error) Collection {
+	if len(prefix) > 0 && prefix[len(prefix)-1] != '/' {
+		prefix = prefix + "/"
+	}
+	return &pgCollection{
+		collection: &collection{
+			prefix:   prefix,
+			indexes:  indexes,
+			limit:    defaultLimit,
+			template: template,
+			keyCheck: keyCheck,
+			valCheck: valCheck,
+		},
+		backend: b,
+	}
+}
+
+func (b *pgBackend) NewSTM(ctx context.Context, apply func(STM) error) error {
+	return b.newSTM(ctx, apply, false)
+}
+
+func (b *pgBackend) NewDryrunSTM(ctx context.Context, apply func(STM) error) error {
+	return b.newSTM(ctx, apply, true)
+}
+
+// newSTM runs 'apply' in a serializable postgres transaction, retrying it
+// until it commits without a serialization failure. This gives the same
+// semantics as the etcd STM: reads see a consistent snapshot, and the commit
+// only goes through if nothing read has since changed.
+func (b *pgBackend) newSTM(ctx context.Context, apply func(STM) error, dryrun bool) (retErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(stmError)
+			if !ok {
+				panic(r)
+			}
+			retErr = e.err
+		}
+	}()
+	for {
+		tx, err := b.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+		if err != nil {
+			return err
+		}
+		s := &pgSTM{ctx: ctx, tx: tx, safePuts: make(map[string]uintptr)}
+		if err := apply(s); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if dryrun {
+			return tx.Rollback()
+		}
+		err = tx.Commit()
+		if err == nil {
+			return nil
+		}
+		if !pgIsRetriable(err) {
+			return err
+		}
+	}
+}
+
+// pgIsRetriable reports whether 'err' is a serialization failure or deadlock
+// (postgres SQLSTATE 40001/40P01), i.e. the transaction lost a race and
+// should simply be retried.
+func pgIsRetriable(err error) bool {
+	return strings.Contains(err.Error(), "40001") || strings.Contains(err.Error(), "40P01")
+}
+
+// pgSTM implements STM over a serializable postgres transaction. Unlike the
+// etcd STM it applies writes to the transaction as they happen rather than
+// buffering them, because postgres's serializable isolation already provides
+// the read-set validation that the etcd STM implements by hand.
+type pgSTM struct {
+	ctx context.Context
+	tx  *sql.Tx
+	// safePuts mirrors the etcd STM's safePutPtr tracking (see
+	// SetSafePutCheck in transaction.go).
+	safePuts map[string]uintptr
+}
+
+func (s *pgSTM) Context() context.Context {
+	return s.ctx
+}
+
+func (s *pgSTM) Get(key string) (string, error) {
+	var val []byte
+	err := s.tx.QueryRowContext(s.ctx, fmt.Sprintf(
+		`SELECT value FROM %s WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`,
+		pgTable), key).Scan(&val)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound{Key: key}
+	} else if err != nil {
+		panic(stmError{err})
+	}
+	return string(val), nil
+}
+
+func (s *pgSTM) Put(key, val string, ttl int64, ptr uintptr) error {
+	var expiresAt interface{}
+	if ttl > 0 {
+		expiresAt = time.Now().Add(time.Duration(ttl) * time.Second)
+	}
+	if _, err := s.tx.ExecContext(s.ctx, fmt.Sprintf(
+		`INSERT INTO %s (key, value, create_rev, mod_rev, expires_at)
+		VALUES ($1, $2, nextval('%s'), currval('%s'), $3)
+		ON CONFLICT (key) DO UPDATE
+		SET value = excluded.value, mod_rev = excluded.mod_rev, expires_at = excluded.expires_at`,
+		pgTable, pgRevSeq, pgRevSeq), key, []byte(val), expiresAt); err != nil {
+		return fmt.Errorf("error writing %q: %v", key, err)
+	}
+	if ptr != 0 {
+		s.safePuts[key] = ptr
+	}
+	return nil
+}
+
+func (s *pgSTM) Del(key string) {
+	if _, err := s.tx.ExecContext(s.ctx, fmt.Sprintf(
+		`DELETE FROM %s WHERE key = $1`, pgTable), key); err != nil {
+		panic(stmError{err})
+	}
+}
+
+func (s *pgSTM) DelAll(prefix string) {
+	if _, err := s.tx.ExecContext(s.ctx, fmt.Sprintf(
+		`DELETE FROM %s WHERE key LIKE $1`, pgTable), pgLikePrefix(prefix)); err != nil {
+		panic(stmError{err})
+	}
+}
+
+func (s *pgSTM) Rev(key string) int64 {
+	var rev int64
+	err := s.tx.QueryRowContext(s.ctx, fmt.Sprintf(
+		`SELECT mod_rev FROM %s WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`,
+		pgTable), key).Scan(&rev)
+	if err == sql.ErrNoRows {
+		return 0
+	} else if err != nil {
+		panic(stmError{err})
+	}
+	return rev
+}
+
+func (s *pgSTM) TTL(key string) (int64, error) {
+	var expiresAt *time.Time
+	err := s.tx.QueryRowContext(s.ctx, fmt.Sprintf(
+		`SELECT expires_at FROM %s WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`,
+		pgTable), key).Scan(&expiresAt)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound{Key: key}
+	} else if err != nil {
+		panic(stmError{err})
+	}
+	if expiresAt == nil {
+		return 0, nil
+	}
+	ttl := int64(time.Until(*expiresAt) / time.Second)
+	if ttl < 1 {
+		ttl = 1 // key hasn't expired yet, so don't report a zero ("no") TTL
+	}
+	return ttl, nil
+}
+
+func (s *pgSTM) SetSafePutCheck(key string, ptr uintptr) {
+	if _, ok := s.safePuts[key]; ok {
+		s.safePuts[key] = ptr
+	}
+}
+
+func (s *pgSTM) IsSafePut(key string, ptr uintptr) bool {
+	if existing, ok := s.safePuts[key]; ok && existing != 0 && ptr != existing {
+		return false
+	}
+	return true
+}
+
+// commit, reset and fetch exist only to satisfy STM; they're etcd-specific
+// hooks used by runSTM, which the postgres backend doesn't go through (see
+// newSTM above).
+func (s *pgSTM) commit() *v3.TxnResponse          { return nil }
+func (s *pgSTM) reset()                           {}
+func (s *pgSTM) fetch(key string) *v3.GetResponse { return nil }
+
+// pgLikePrefix turns a key prefix into a LIKE pattern, escaping LIKE's
+// metacharacters so that keys containing '%' or '_' match literally.
+func pgLikePrefix(prefix string) string {
+	prefix = strings.Replace(prefix, `\`, `\\`, -1)
+	prefix = strings.Replace(prefix, `%`, `\%`, -1)
+	prefix = strings.Replace(prefix, `_`, `\_`, -1)
+	return prefix + "%"
+}
+
+// pgCollection implements Collection over a pgBackend. The read-write side
+// reuses readWriteCollection unchanged--it only talks to the store through
+// the STM interface--so all of the secondary-index bookkeeping in
+// collection.go behaves identically on both backends.
+type pgCollection struct {
+	*collection
+	backend *pgBackend
+}
+
+func (c *pgCollection) ReadWrite(stm STM) ReadWriteCollection {
+	return &readWriteCollection{
+		collection: c.collection,
+		stm:        stm,
+	}
+}
+
+func (c *pgCollection) ReadWriteInt(stm STM) ReadWriteIntCollection {
+	return &readWriteIntCollection{
+		collection: c.collection,
+		stm:        stm,
+	}
+}
+
+func (c *pgCollection) ReadOnly(ctx context.Context) ReadonlyCollection {
+	return &pgReadonlyCollection{
+		pgCollection: c,
+		ctx:          ctx,
+	}
+}
+
+// Claim is the same algorithm as the etcd collection's Claim, with the etcd
+// transactions replaced by the backend's.
+func (c *pgCollection) Claim(ctx context.Context, key string, val proto.Message, f func(context.Context) error) error {
+	var claimed bool
+	if err := c.backend.NewSTM(ctx, func(stm STM) error {
+		readWriteC := c.ReadWrite(stm)
+		if err := readWriteC.Get(key, val); err != nil {
+			if !IsErrNotFound(err) {
+				return err
+			}
+			claimed = true
+			return readWriteC.PutTTL(key, val, ttl)
+		}
+		claimed = false
+		return nil
+	}); err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrNotClaimed
+	}
+	claimCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		for {
+			select {
+			case <-time.After((time.Second * time.Duration(ttl)) / 2):
+				if err := c.backend.NewSTM(claimCtx, func(stm STM) error {
+					readWriteC := c.ReadWrite(stm)
+					if err := readWriteC.Get(key, val); err != nil {
+						return err
+					}
+					return readWriteC.PutTTL(key, val, ttl)
+				}); err != nil {
+					cancel()
+					return
+				}
+			case <-claimCtx.Done():
+				return
+			}
+		}
+	}()
+	return f(claimCtx)
+}
+
+type pgReadonlyCollection struct {
+	*pgCollection
+	ctx context.Context
+}
+
+func (c *pgReadonlyCollection) Get(key string, val proto.Message) error {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return err
+	}
+	var value []byte
+	err := c.backend.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		`SELECT value FROM %s WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`,
+		pgTable), c.Path(key)).Scan(&value)
+	if err == sql.ErrNoRows {
+		return ErrNotFound{c.prefix, key}
+	} else if err != nil {
+		return err
+	}
+	return proto.Unmarshal(value, val)
+}
+
+// list scans every live key under 'prefix' in the order given by 'opts'.
+// Both of the etcd sort targets map onto mod_rev here: postgres has a single
+// revision sequence, and create_rev never changes after the first write, so
+// sorting by create_rev matches etcd's SortByCreateRevision exactly and
+// mod_rev matches SortByModRevision.
+func (c *pgReadonlyCollection) list(prefix string, opts *Options, f func(key string, val []byte) error) error {
+	order := "create_rev"
+	if opts.Target == v3.SortByModRevision {
+		order = "mod_rev"
+	}
+	direction := "DESC"
+	if opts.Order == v3.SortAscend {
+		direction = "ASC"
+	}
+	rows, err := c.backend.db.QueryContext(c.ctx, fmt.Sprintf(
+		`SELECT key, value FROM %s WHERE key LIKE $1 AND (expires_at IS NULL OR expires_at > now()) ORDER BY %s %s`,
+		pgTable, order, direction), pgLikePrefix(prefix))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			return err
+		}
+		if err := f(key, value); err != nil {
+			if err == errutil.ErrBreak {
+				return nil
+			}
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (c *pgReadonlyCollection) GetByIndex(index *Index, indexVal interface{}, val proto.Message, opts *Options, f func(key string) error) error {
+	return c.list(c.indexDir(index, indexVal)+"/", opts, func(indexKey string, _ []byte) error {
+		key := path.Base(indexKey)
+		if err := c.Get(key, val); err != nil {
+			if IsErrNotFound(err) {
+				// The object was deleted but an old index entry still
+				// exists (see the etcd GetByIndex for details).
+				return nil
+			}
+			return err
+		}
+		return f(key)
+	})
+}
+
+func (c *pgReadonlyCollection) GetBlock(key string, val proto.Message) error {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return err
+	}
+	watcher, err := c.WatchOne(key)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		e := <-watcher.Watch()
+		if e.Err != nil {
+			return e.Err
+		}
+		return e.Unmarshal(&key, val)
+	}
+}
+
+func (c *pgReadonlyCollection) TTL(key string) (int64, error) {
+	var expiresAt *time.Time
+	err := c.backend.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		`SELECT expires_at FROM %s WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`,
+		pgTable), c.Path(key)).Scan(&expiresAt)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound{c.prefix, key}
+	} else if err != nil {
+		return 0, err
+	}
+	if expiresAt == nil {
+		return 0, nil
+	}
+	ttl := int64(time.Until(*expiresAt) / time.Second)
+	if ttl < 1 {
+		ttl = 1
+	}
+	return ttl, nil
+}
+
+func (c *pgReadonlyCollection) List(val proto.Message, opts *Options, f func(string) error) error {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return err
+	}
+	return c.list(c.prefix, opts, func(key string, value []byte) error {
+		if err := proto.Unmarshal(value, val); err != nil {
+			return err
+		}
+		return f(strings.TrimPrefix(key, c.prefix))
+	})
+}
+
+func (c *pgReadonlyCollection) ListPrefix(prefix string, val proto.Message, opts *Options, f func(string) error) error {
+	queryPrefix := c.prefix
+	if prefix != "" {
+		queryPrefix = path.Join(c.prefix, prefix)
+	}
+	return c.list(queryPrefix, opts, func(key string, value []byte) error {
+		if err := proto.Unmarshal(value, val); err != nil {
+			return err
+		}
+		return f(strings.TrimPrefix(key, queryPrefix))
+	})
+}
+
+func (c *pgReadonlyCollection) Count() (int64, error) {
+	var count int64
+	err := c.backend.db.QueryRowContext(c.ctx, fmt.Sprintf(
+		`SELECT count(*) FROM %s WHERE key LIKE $1 AND (expires_at IS NULL OR expires_at > now())`,
+		pgTable), pgLikePrefix(c.prefix)).Scan(&count)
+	return count, err
+}
+
+func (c *pgReadonlyCollection) Watch(opts ...watch.OpOption) (watch.Watcher, error) {
+	return c.watchPrefix(c.prefix, c.prefix)
+}
+
+func (c *pgReadonlyCollection) WatchByIndex(index *Index, val interface{}) (watch.Watcher, error) {
+	eventCh := make(chan *watch.Event)
+	done := make(chan struct{})
+	watcher, err := c.watchPrefix(c.indexDir(index, val)+"/", "")
+	if err != nil {
+		return nil, err
+	}
+	// As with the etcd WatchByIndex, translate events on index entries into
+	// events on the indexed objects themselves.
+	go func() (retErr error) {
+		defer func() {
+			if retErr != nil {
+				eventCh <- &watch.Event{
+					Type: watch.EventError,
+					Err:  retErr,
+				}
+				watcher.Close()
+			}
+			close(eventCh)
+		}()
+		for {
+			var ev *watch.Event
+			var ok bool
+			select {
+			case ev, ok = <-watcher.Watch():
+			case <-done:
+				watcher.Close()
+				return nil
+			}
+			if !ok {
+				watcher.Close()
+				return nil
+			}
+			var directEv *watch.Event
+			switch ev.Type {
+			case watch.EventError:
+				return ev.Err
+			case watch.EventPut:
+				var value []byte
+				err := c.backend.db.QueryRowContext(c.ctx, fmt.Sprintf(
+					`SELECT value FROM %s WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`,
+					pgTable), c.Path(path.Base(string(ev.Key)))).Scan(&value)
+				if err == sql.ErrNoRows {
+					// the item was deleted shortly after we received this event
+					continue
+				} else if err != nil {
+					return err
+				}
+				directEv = &watch.Event{
+					Key:      []byte(path.Base(string(ev.Key))),
+					Value:    value,
+					Type:     ev.Type,
+					Template: c.template,
+				}
+			case watch.EventDelete:
+				directEv = &watch.Event{
+					Key:      []byte(path.Base(string(ev.Key))),
+					Type:     ev.Type,
+					Template: c.template,
+				}
+			}
+			eventCh <- directEv
+		}
+	}()
+	return watch.MakeWatcher(eventCh, done), nil
+}
+
+func (c *pgReadonlyCollection) WatchOne(key string, opts ...watch.OpOption) (watch.Watcher, error) {
+	return c.watchPrefix(c.Path(key), c.prefix)
+}
+
+func (c *pgReadonlyCollection) WatchOneF(key string, f func(e *watch.Event) error, opts ...watch.OpOption) error {
+	watcher, err := c.WatchOne(key)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		select {
+		case e := <-watcher.Watch():
+			if err := f(e); err != nil {
+				if err == errutil.ErrBreak {
+					return nil
+				}
+				return err
+			}
+		case <-c.ctx.Done():
+			return c.ctx.Err()
+		}
+	}
+}
+
+// watchPrefix emits the current contents under 'prefix' followed by any
+// future changes, polling postgres for rows whose mod_rev has advanced and
+// diffing the key set to detect deletes. Keys in the emitted events have
+// 'trimPrefix' removed, matching the etcd watcher's behavior.
+func (c *pgReadonlyCollection) watchPrefix(prefix, trimPrefix string) (watch.Watcher, error) {
+	eventCh := make(chan *watch.Event)
+	done := make(chan struct{})
+	go func() (retErr error) {
+		defer func() {
+			if retErr != nil {
+				select {
+				case eventCh <- &watch.Event{Type: watch.EventError, Err: retErr}:
+				case <-done:
+				}
+			}
+			close(eventCh)
+		}()
+		var lastRev int64
+		known := make(map[string]bool)
+		for {
+			rows, err := c.backend.db.QueryContext(c.ctx, fmt.Sprintf(
+				`SELECT key, value, mod_rev FROM %s WHERE key LIKE $1 AND (expires_at IS NULL OR expires_at > now()) ORDER BY mod_rev ASC`,
+				pgTable), pgLikePrefix(prefix))
+			if err != nil {
+				return err
+			}
+			live := make(map[string]bool)
+			var events []*watch.Event
+			maxRev := lastRev
+			for rows.Next() {
+				var key string
+				var value []byte
+				var modRev int64
+				if err := rows.Scan(&key, &value, &modRev); err != nil {
+					rows.Close()
+					return err
+				}
+				live[key] = true
+				if modRev > lastRev {
+					events = append(events, &watch.Event{
+						Key:      []byte(strings.TrimPrefix(key, trimPrefix)),
+						Value:    value,
+						Type:     watch.EventPut,
+						Template: c.template,
+					})
+				}
+				if modRev > maxRev {
+					maxRev = modRev
+				}
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return err
+			}
+			for key := range known {
+				if !live[key] {
+					events = append(events, &watch.Event{
+						Key:      []byte(strings.TrimPrefix(key, trimPrefix)),
+						Type:     watch.EventDelete,
+						Template: c.template,
+					})
+				}
+			}
+			lastRev = maxRev
+			known = live
+			for _, ev := range events {
+				select {
+				case eventCh <- ev:
+				case <-done:
+					return nil
+				case <-c.ctx.Done():
+					return c.ctx.Err()
+				}
+			}
+			select {
+			case <-time.After(pgWatchInterval):
+			case <-done:
+				return nil
+			case <-c.ctx.Done():
+				return c.ctx.Err()
+			}
+		}
+	}()
+	return watch.MakeWatcher(eventCh, done), nil
+}
@@ -0,0 +1,60 @@
+package collection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestPgLikePrefix(t *testing.T) {
+	for _, test := range []struct {
+		prefix, pattern string
+	}{
+		{"", "%"},
+		{"jobs/", "jobs/%"},
+		// LIKE metacharacters in keys must match literally
+		{"100%/", `100\%/%`},
+		{"a_b/", `a\_b/%`},
+		{`a\b/`, `a\\b/%`},
+		{`%_\`, `\%\_\\%`},
+	} {
+		require.Equal(t, test.pattern, pgLikePrefix(test.prefix), "prefix %q", test.prefix)
+	}
+}
+
+func TestPgIsRetriable(t *testing.T) {
+	// serialization failures and deadlocks should be retried
+	require.True(t, pgIsRetriable(fmt.Errorf("pq: could not serialize access due to concurrent update (SQLSTATE 40001)")))
+	require.True(t, pgIsRetriable(fmt.Errorf("pq: deadlock detected (SQLSTATE 40P01)")))
+	// anything else shouldn't
+	require.False(t, pgIsRetriable(fmt.Errorf(`pq: relation "collections" does not exist`)))
+	require.False(t, pgIsRetriable(fmt.Errorf("driver: bad connection")))
+}
+
+func TestPgCollectionPrefix(t *testing.T) {
+	b := &pgBackend{}
+	// NewCollection should normalize the prefix to end in a slash, the way
+	// the etcd backend's path handling does
+	c := b.NewCollection("pipelines", nil, &pps.PipelineInfo{}, nil, nil)
+	require.Equal(t, "pipelines/", c.(*pgCollection).prefix)
+	c = b.NewCollection("pipelines/", nil, &pps.PipelineInfo{}, nil, nil)
+	require.Equal(t, "pipelines/", c.(*pgCollection).prefix)
+}
+
+func TestPgSTMSafePuts(t *testing.T) {
+	s := &pgSTM{safePuts: make(map[string]uintptr)}
+	// an unwritten key is always a safe put
+	require.True(t, s.IsSafePut("k", 1))
+	// a key written with a pointer is only safely re-put with the same pointer
+	s.safePuts["k"] = 1
+	require.True(t, s.IsSafePut("k", 1))
+	require.False(t, s.IsSafePut("k", 2))
+	// SetSafePutCheck only updates keys that have been written
+	s.SetSafePutCheck("other", 3)
+	require.True(t, s.IsSafePut("other", 4))
+	s.SetSafePutCheck("k", 2)
+	require.True(t, s.IsSafePut("k", 2))
+	require.False(t, s.IsSafePut("k", 1))
+}
@@ -69,6 +69,12 @@ var (
 		Verbs:         []string{"get", "list", "watch", "create", "update", "delete"},
 		Resources:     []string{"secrets"},
 		ResourceNames: []string{client.StorageSecretName},
+	}, {
+		// pachd validates Kubernetes service account tokens presented to
+		// "pachctl auth login --k8s-service-account" via the TokenReview API
+		APIGroups: []string{"authentication.k8s.io"},
+		Verbs:     []string{"create"},
+		Resources: []string{"tokenreviews"},
 	}}
 
 	// The name of the local volume (mounted kubernetes secret) where pachd
@@ -110,7 +110,10 @@ func getEncoder(outputFormat string) BytesEncoder {
 	}
 }
 
-func kubectlCreate(dryRun bool, manifest BytesEncoder, opts *assets.AssetOpts, metrics bool) error {
+func kubectlCreate(dryRun bool, kustomizeDir string, manifest BytesEncoder, opts *assets.AssetOpts, metrics bool) error {
+	if kustomizeDir != "" {
+		return writeKustomizeAssets(kustomizeDir, manifest)
+	}
 	if dryRun {
 		_, err := os.Stdout.Write(manifest.Buffer().Bytes())
 		return err
@@ -150,8 +153,10 @@ func deployCmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 	var commands []*cobra.Command
 	var opts *assets.AssetOpts
 
+	var attachExisting bool
 	var dryRun bool
 	var outputFormat string
+	var kustomizeDir string
 
 	var dev bool
 	var hostPath string
@@ -189,7 +194,7 @@ func deployCmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 			if err := assets.WriteLocalAssets(manifest, opts, hostPath); err != nil {
 				return err
 			}
-			return kubectlCreate(dryRun, manifest, opts, metrics)
+			return kubectlCreate(dryRun, kustomizeDir, manifest, opts, metrics)
 		}),
 	}
 	deployLocal.Flags().StringVar(&hostPath, "host-path", "/var/pachyderm", "Location on the host machine where PFS metadata will be stored.")
@@ -233,7 +238,7 @@ func deployCmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 			if err = assets.WriteGoogleAssets(manifest, opts, bucket, cred, volumeSize); err != nil {
 				return err
 			}
-			return kubectlCreate(dryRun, manifest, opts, metrics)
+			return kubectlCreate(dryRun, kustomizeDir, manifest, opts, metrics)
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(deployGoogle, "deploy google"))
@@ -265,7 +270,7 @@ If <object store backend> is \"s3\", then the arguments are:
 			if err != nil {
 				return err
 			}
-			return kubectlCreate(dryRun, manifest, opts, metrics)
+			return kubectlCreate(dryRun, kustomizeDir, manifest, opts, metrics)
 		}),
 	}
 	deployCustom.Flags().BoolVarP(&secure, "secure", "s", false, "Enable secure access to a Minio server.")
@@ -372,7 +377,7 @@ If <object store backend> is \"s3\", then the arguments are:
 			if err = assets.WriteAmazonAssets(manifest, opts, region, bucket, volumeSize, amazonCreds, cloudfrontDistribution); err != nil {
 				return err
 			}
-			return kubectlCreate(dryRun, manifest, opts, metrics)
+			return kubectlCreate(dryRun, kustomizeDir, manifest, opts, metrics)
 		}),
 	}
 	deployAmazon.Flags().StringVar(&cloudfrontDistribution, "cloudfront-distribution", "",
@@ -422,7 +427,7 @@ If <object store backend> is \"s3\", then the arguments are:
 			if err = assets.WriteMicrosoftAssets(manifest, opts, container, accountName, accountKey, volumeSize); err != nil {
 				return err
 			}
-			return kubectlCreate(dryRun, manifest, opts, metrics)
+			return kubectlCreate(dryRun, kustomizeDir, manifest, opts, metrics)
 		}),
 	}
 	commands = append(commands, cmdutil.CreateAlias(deployMicrosoft, "deploy microsoft"))
@@ -564,6 +569,18 @@ If <object store backend> is \"s3\", then the arguments are:
 		Short: "Deploy a Pachyderm cluster.",
 		Long:  "Deploy a Pachyderm cluster.",
 		PersistentPreRun: cmdutil.Run(func([]string) error {
+			if kustomizeDir != "" {
+				// kustomize bases are yaml
+				outputFormat = "yaml"
+			}
+			if attachExisting && !dryRun {
+				// Fail before anything is applied if the data resources from a
+				// previous "undeploy --keep-data" are gone. Etcd's StatefulSet
+				// re-binds the surviving volume claims by name on its own.
+				if err := verifyDataResources(namespace); err != nil {
+					return fmt.Errorf("cannot attach to existing data: %v", err)
+				}
+			}
 			dashImage = getDefaultOrLatestDashImage(dashImage, dryRun)
 			opts = &assets.AssetOpts{
 				FeatureFlags: assets.FeatureFlags{
@@ -613,6 +630,8 @@ If <object store backend> is \"s3\", then the arguments are:
 	deploy.PersistentFlags().StringVar(&etcdVolume, "static-etcd-volume", "", "Deploy etcd as a ReplicationController with one pod.  The pod uses the given persistent volume.")
 	deploy.PersistentFlags().StringVar(&etcdStorageClassName, "etcd-storage-class", "", "If set, the name of an existing StorageClass to use for etcd storage. Ignored if --static-etcd-volume is set.")
 	deploy.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Don't actually deploy pachyderm to Kubernetes, instead just print the manifest.")
+	deploy.PersistentFlags().BoolVar(&attachExisting, "attach-existing", false, "Re-attach the deployment to the data resources left behind by \"pachctl undeploy --keep-data\": verify that the storage secret and etcd volumes are still present before deploying, and fail without applying anything if they aren't.")
+	deploy.PersistentFlags().StringVar(&kustomizeDir, "kustomize", "","Don't actually deploy pachyderm to Kubernetes, instead write a kustomize-compatible manifest set (a base plus overlays for storage class, resource sizing, TLS, and node selectors) to the given directory.")
 	deploy.PersistentFlags().StringVarP(&outputFormat, "output", "o", "json", "Output formmat. One of: json|yaml")
 	deploy.PersistentFlags().StringVar(&logLevel, "log-level", "info", "The level of log messages to print options are, from least to most verbose: \"error\", \"info\", \"debug\".")
 	deploy.PersistentFlags().BoolVar(&dashOnly, "dashboard-only", false, "Only deploy the Pachyderm UI (experimental), without the rest of pachyderm. This is for launching the UI adjacent to an existing Pachyderm cluster. After deployment, run \"pachctl port-forward\" to connect")
@@ -656,6 +675,7 @@ If <object store backend> is \"s3\", then the arguments are:
 			"etc).")
 
 	commands = append(commands, cmdutil.CreateAlias(deploy, "deploy"))
+	commands = append(commands, deployDiffCmd())
 
 	return commands
 }
@@ -667,11 +687,25 @@ func Cmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
 	commands = append(commands, deployCmds(noMetrics, noPortForwarding)...)
 
 	var all bool
+	var keepData bool
 	var namespace string
 	undeploy := &cobra.Command{
 		Short: "Tear down a deployed Pachyderm cluster.",
 		Long:  "Tear down a deployed Pachyderm cluster.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if all && keepData {
+				return errors.New("cannot pass both --all and --keep-data")
+			}
+			if keepData {
+				fmt.Printf(`
+By using the --keep-data flag, you are going to delete the Pachyderm cluster's
+Kubernetes resources but keep the resources that hold its data: the storage
+secret (which names the object-store bucket), and the persistent volumes where
+etcd stores cluster metadata. After the teardown, the remaining resources will
+be verified and reported; the cluster can be re-created against them with
+"pachctl deploy ... --attach-existing".
+`)
+			}
 			if all {
 				fmt.Printf(`
 By using the --all flag, you are going to delete everything, including the
@@ -699,11 +733,15 @@ underlying volume will not be removed.
 					"replicationcontroller",
 					"deployment",
 					"serviceaccount",
-					"secret",
 					"statefulset",
 					"clusterrole",
 					"clusterrolebinding",
 				}
+				if !keepData {
+					// the storage secret names the bucket and holds the
+					// object-store credentials, so --keep-data keeps it
+					assets = append(assets, "secret")
+				}
 				if all {
 					assets = append(assets, []string{
 						"storageclass",
@@ -716,6 +754,9 @@ underlying volume will not be removed.
 						return err
 					}
 				}
+				if keepData {
+					return verifyDataResources(namespace)
+				}
 			}
 			return nil
 		}),
@@ -728,6 +769,13 @@ removed, making metadata such repos, commits, pipelines, and jobs
 unrecoverable. If your persistent volume was manually provisioned (i.e. if
 you used the "--static-etcd-volume" flag), the underlying volume will not be
 removed.`)
+	undeploy.Flags().BoolVar(&keepData, "keep-data", false, `
+Delete the cluster's Kubernetes resources but keep the resources holding its
+data: the storage secret (which names the object-store bucket) and the
+persistent volumes where etcd stores cluster metadata. After the teardown the
+remaining data resources are verified and reported. Re-create the cluster
+against them with "pachctl deploy ... --attach-existing". Cannot be combined
+with --all.`)
 	undeploy.Flags().StringVar(&namespace, "namespace", "default", "Kubernetes namespace to undeploy Pachyderm from.")
 	commands = append(commands, cmdutil.CreateAlias(undeploy, "undeploy"))
 
@@ -757,7 +805,7 @@ removed.`)
 				DashImage: getDefaultOrLatestDashImage("", updateDashDryRun),
 			}
 			assets.WriteDashboardAssets(manifest, opts)
-			return kubectlCreate(updateDashDryRun, manifest, opts, false)
+			return kubectlCreate(updateDashDryRun, "", manifest, opts, false)
 		}),
 	}
 	updateDash.Flags().BoolVar(&updateDashDryRun, "dry-run", false, "Don't actually deploy Pachyderm Dash to Kubernetes, instead just print the manifest.")
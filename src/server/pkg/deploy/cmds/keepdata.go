@@ -0,0 +1,70 @@
+package cmds
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+)
+
+// bucketSecretKeys are the keys under which each storage backend records its
+// bucket (or container) in the storage secret.
+var bucketSecretKeys = []string{
+	"amazon-bucket",
+	"google-bucket",
+	"microsoft-container",
+	"minio-bucket",
+}
+
+// verifyDataResources checks that the resources holding a cluster's data --
+// the storage secret (which names the object-store bucket) and the etcd
+// volume claims -- are present in the cluster, and reports what it finds.
+// It's called by "undeploy --keep-data" after tearing the cluster down and by
+// "deploy --attach-existing" before bringing it back up.
+func verifyDataResources(namespace string) error {
+	// The storage secret names the bucket and holds the credentials that a
+	// re-attached cluster will need.
+	var buf bytes.Buffer
+	if err := cmdutil.RunIO(cmdutil.IO{
+		Stdout: &buf,
+		Stderr: os.Stderr,
+	}, "kubectl", "get", "secret", client.StorageSecretName, "--namespace", namespace, "-o", "json"); err != nil {
+		return fmt.Errorf("could not find the storage secret %q (the object-store credentials and bucket name are stored there): %v", client.StorageSecretName, err)
+	}
+	var secret struct {
+		Data map[string][]byte `json:"data"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &secret); err != nil {
+		return err
+	}
+	bucket := ""
+	for _, key := range bucketSecretKeys {
+		if len(secret.Data[key]) > 0 {
+			bucket = string(secret.Data[key])
+			break
+		}
+	}
+	if bucket != "" {
+		fmt.Printf("Object store bucket %q is still referenced by the storage secret %q; Pachyderm has not deleted any objects from it.\n", bucket, client.StorageSecretName)
+	} else {
+		fmt.Printf("Storage secret %q is intact (no bucket is named in it, so this cluster stores data on a local volume).\n", client.StorageSecretName)
+	}
+
+	// The etcd volume holds all cluster metadata; list the claims and volumes
+	// so the user can see that they're still bound.
+	fmt.Println("Persistent volumes holding cluster metadata:")
+	io := cmdutil.IO{
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	}
+	if err := cmdutil.RunIO(io, "kubectl", "get", "pvc", "-l", "suite=pachyderm", "--namespace", namespace); err != nil {
+		return fmt.Errorf("could not list etcd's persistent volume claims: %v", err)
+	}
+	if err := cmdutil.RunIO(io, "kubectl", "get", "pv", "-l", "suite=pachyderm"); err != nil {
+		return fmt.Errorf("could not list etcd's persistent volumes: %v", err)
+	}
+	return nil
+}
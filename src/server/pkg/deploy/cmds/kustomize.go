@@ -0,0 +1,181 @@
+package cmds
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/spf13/cobra"
+)
+
+// kustomization is the base kustomization.yaml; it pulls in the generated
+// manifest so overlays can patch it.
+var baseKustomization = `resources:
+- manifest.yaml
+`
+
+// overlayKustomization is the kustomization.yaml written into each overlay.
+var overlayKustomization = `bases:
+- ../../base
+patchesStrategicMerge:
+- %s
+`
+
+// overlays are the overlay points we scaffold. Each patch file is a working
+// example that users edit in place; kustomize merges it over the base
+// manifest.
+var overlays = []struct {
+	name  string
+	patch string
+	body  string
+}{
+	{
+		name:  "storage-class",
+		patch: "etcd-storage-class.yaml",
+		body: `# Patch the storage class used by etcd's volume claims. Change
+# "standard" to a StorageClass available in your cluster.
+apiVersion: apps/v1beta1
+kind: StatefulSet
+metadata:
+  name: etcd
+spec:
+  volumeClaimTemplates:
+  - metadata:
+      name: etcd-storage
+      annotations:
+        volume.beta.kubernetes.io/storage-class: standard
+`,
+	},
+	{
+		name:  "resources",
+		patch: "pachd-resources.yaml",
+		body: `# Patch pachd's resource requests and limits. Adjust the values to
+# match your workload; pachd's memory request should leave room for its
+# block cache.
+apiVersion: apps/v1beta1
+kind: Deployment
+metadata:
+  name: pachd
+spec:
+  template:
+    spec:
+      containers:
+      - name: pachd
+        resources:
+          requests:
+            cpu: "1"
+            memory: 2G
+`,
+	},
+	{
+		name:  "tls",
+		patch: "pachd-tls.yaml",
+		body: `# Mount a TLS certificate and key into pachd. Create the secret with:
+#   kubectl create secret tls pachd-tls --cert=<cert path> --key=<key path>
+apiVersion: apps/v1beta1
+kind: Deployment
+metadata:
+  name: pachd
+spec:
+  template:
+    spec:
+      containers:
+      - name: pachd
+        env:
+        - name: TLS_CERT_SECRET_NAME
+          value: pachd-tls
+        volumeMounts:
+        - name: pachd-tls-cert
+          mountPath: /pachd-tls-cert
+      volumes:
+      - name: pachd-tls-cert
+        secret:
+          secretName: pachd-tls
+`,
+	},
+	{
+		name:  "node-selector",
+		patch: "node-selector.yaml",
+		body: `# Pin pachd to a set of nodes. Change the label to match the nodes
+# that should run Pachyderm; apply the same patch to the etcd StatefulSet
+# if etcd should be pinned too.
+apiVersion: apps/v1beta1
+kind: Deployment
+metadata:
+  name: pachd
+spec:
+  template:
+    spec:
+      nodeSelector:
+        pachyderm-node: "true"
+`,
+	},
+}
+
+// writeKustomizeAssets writes the manifest in 'manifest' into 'dir' as a
+// kustomize-compatible layout: a base holding the generated manifest, plus
+// one overlay per common customization point.
+func writeKustomizeAssets(dir string, manifest BytesEncoder) error {
+	baseDir := filepath.Join(dir, "base")
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(baseDir, "manifest.yaml"), manifest.Buffer().Bytes(), 0644); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(baseDir, "kustomization.yaml"), []byte(baseKustomization), 0644); err != nil {
+		return err
+	}
+	for _, overlay := range overlays {
+		overlayDir := filepath.Join(dir, "overlays", overlay.name)
+		if err := os.MkdirAll(overlayDir, 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(overlayDir, "kustomization.yaml"), []byte(fmt.Sprintf(overlayKustomization, overlay.patch)), 0644); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(overlayDir, overlay.patch), []byte(overlay.body), 0644); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Wrote kustomize assets to %s.\nApply the base with \"kubectl apply -k %s\", or apply an overlay (e.g. \"kubectl apply -k %s\") after editing its patch.\n", dir, baseDir, filepath.Join(dir, "overlays", "tls"))
+	return nil
+}
+
+// deployDiffCmd returns a command that diffs a generated manifest against
+// what's currently running in the cluster.
+func deployDiffCmd() *cobra.Command {
+	diff := &cobra.Command{
+		Use:   "{{alias}} <manifest>",
+		Short: "Diff a Pachyderm manifest against the running cluster.",
+		Long: `Diff a Pachyderm manifest against the running cluster.
+
+Reads a manifest from the given file ("-" reads from stdin, so the output of
+"pachctl deploy ... --dry-run" can be piped in directly) and compares it
+against the resources currently in the cluster using "kubectl diff". Note that
+kubectl exits non-zero when the manifest and the cluster differ.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			var manifest *os.File
+			if args[0] == "-" {
+				manifest = os.Stdin
+			} else {
+				var err error
+				manifest, err = os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer manifest.Close()
+			}
+			io := cmdutil.IO{
+				Stdin:  manifest,
+				Stdout: os.Stdout,
+				Stderr: os.Stderr,
+			}
+			return cmdutil.RunIO(io, "kubectl", "diff", "-f", "-")
+		}),
+	}
+	return cmdutil.CreateAlias(diff, "deploy diff")
+}
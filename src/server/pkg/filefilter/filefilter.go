@@ -0,0 +1,117 @@
+// Package filefilter implements the server-side content filters that back
+// GetFileFilter (see src/client/pfs/pfs.proto): per-repo plugins that rewrite
+// file data returned by GetFile, so restricted callers work with a sanitized
+// view of sensitive repos. Filters register themselves by name; repos
+// reference them by that name in their get_file_filters configuration.
+package filefilter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Filter is a content filter plugin. Implementations must be safe for
+// concurrent use; one instance serves all repos that reference the filter.
+type Filter interface {
+	// Apply wraps 'r', returning a reader of the sanitized content. 'options'
+	// comes from the repo's GetFileFilter configuration and is validated by
+	// Validate before the filter is ever applied.
+	Apply(r io.Reader, options map[string]string) io.Reader
+
+	// Validate checks 'options' when a repo is created or updated, so
+	// misconfigured filters are rejected up front rather than at read time.
+	Validate(options map[string]string) error
+}
+
+var filters = map[string]Filter{
+	"csv-mask": &csvMask{},
+}
+
+// Register makes a filter available under 'name'; it's the extension point
+// for filters compiled in outside this package. Registering a name twice
+// panics, like a duplicate flag registration would.
+func Register(name string, f Filter) {
+	if _, ok := filters[name]; ok {
+		panic(fmt.Sprintf("file filter %q is already registered", name))
+	}
+	filters[name] = f
+}
+
+// Get returns the filter registered under 'name', or an error naming the
+// registered filters if there is none.
+func Get(name string) (Filter, error) {
+	f, ok := filters[name]
+	if !ok {
+		names := make([]string, 0, len(filters))
+		for name := range filters {
+			names = append(names, name)
+		}
+		return nil, fmt.Errorf("no file filter named %q is registered (registered filters: %s)",
+			name, strings.Join(names, ", "))
+	}
+	return f, nil
+}
+
+// csvMask replaces the values of the columns named in options["columns"]
+// (comma-separated header names) with options["replacement"] (default
+// "[REDACTED]"). The first row is treated as the header and passed through
+// unchanged.
+type csvMask struct{}
+
+func (c *csvMask) Validate(options map[string]string) error {
+	if options["columns"] == "" {
+		return fmt.Errorf("csv-mask requires a \"columns\" option listing the columns to mask")
+	}
+	return nil
+}
+
+func (c *csvMask) Apply(r io.Reader, options map[string]string) io.Reader {
+	replacement := options["replacement"]
+	if replacement == "" {
+		replacement = "[REDACTED]"
+	}
+	masked := make(map[string]bool)
+	for _, column := range strings.Split(options["columns"], ",") {
+		masked[strings.TrimSpace(column)] = true
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1 // tolerate ragged rows; mask what we can
+		cw := csv.NewWriter(pw)
+		var maskedCols map[int]bool
+		for {
+			record, err := cr.Read()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if maskedCols == nil {
+				// header row: record which field indexes to mask
+				maskedCols = make(map[int]bool)
+				for i, header := range record {
+					if masked[strings.TrimSpace(header)] {
+						maskedCols[i] = true
+					}
+				}
+			} else {
+				for i := range record {
+					if maskedCols[i] {
+						record[i] = replacement
+					}
+				}
+			}
+			if err := cw.Write(record); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		cw.Flush()
+		pw.CloseWithError(cw.Error())
+	}()
+	return pr
+}
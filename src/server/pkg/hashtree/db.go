@@ -711,6 +711,40 @@ func (h *dbHashTree) PutFileOverwrite(path string, objects []*pfs.Object, overwr
 	return h.putFile(path, objects, overwriteIndex, sizeDelta, false)
 }
 
+// SetFileMetadata records POSIX metadata (mode bits and, for symlinks, the
+// link target) on the regular file at path, which must already exist. The
+// metadata doesn't contribute to the node's hash.
+func (h *dbHashTree) SetFileMetadata(path string, mode uint32, symlinkTarget string) error {
+	path = clean(path)
+	return h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil {
+			return err
+		}
+		if node.FileNode == nil {
+			return errorf(PathConflict, "cannot set file metadata on non-file %q", path)
+		}
+		node.FileNode.Mode = mode
+		node.FileNode.SymlinkTarget = symlinkTarget
+		return put(tx, path, node)
+	})
+}
+
+func (h *dbHashTree) SetFileSchema(path string, schema *pfs.FileSchema) error {
+	path = clean(path)
+	return h.Batch(func(tx *bolt.Tx) error {
+		node, err := get(tx, path)
+		if err != nil {
+			return err
+		}
+		if node.FileNode == nil {
+			return errorf(PathConflict, "cannot set file schema on non-file %q", path)
+		}
+		node.FileNode.Schema = schema
+		return put(tx, path, node)
+	})
+}
+
 // PutDirHeaderFooter implements the hashtree.PutDirHeaderFooter interface
 // method
 func (h *dbHashTree) PutDirHeaderFooter(path string, header, footer *pfs.Object, headerSize, footerSize int64) error {
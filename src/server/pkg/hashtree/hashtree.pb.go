@@ -39,10 +39,19 @@ type FileNodeProto struct {
 	// block_refs/objects. Without this signal, all calls to pfs.GetFile() would
 	// need to check the parent directory's metadata before beginning to return
 	// the file's contents, which would be slow.)
-	HasHeaderFooter      bool     `protobuf:"varint,6,opt,name=has_header_footer,json=hasHeaderFooter,proto3" json:"has_header_footer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HasHeaderFooter bool `protobuf:"varint,6,opt,name=has_header_footer,json=hasHeaderFooter,proto3" json:"has_header_footer,omitempty"`
+	// mode contains the POSIX mode bits (permissions) the file was uploaded
+	// with, or 0 if none were recorded.
+	Mode uint32 `protobuf:"varint,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	// symlink_target, if set, marks this file as a symbolic link pointing at
+	// the given target. A symlink's content is empty.
+	SymlinkTarget string `protobuf:"bytes,8,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
+	// schema is the columnar schema extracted from this file when its commit
+	// finished, if the repo has schema extraction enabled.
+	Schema               *pfs.FileSchema `protobuf:"bytes,9,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *FileNodeProto) Reset()         { *m = FileNodeProto{} }
@@ -99,6 +108,27 @@ func (m *FileNodeProto) GetHasHeaderFooter() bool {
 	return false
 }
 
+func (m *FileNodeProto) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileNodeProto) GetSymlinkTarget() string {
+	if m != nil {
+		return m.SymlinkTarget
+	}
+	return ""
+}
+
+func (m *FileNodeProto) GetSchema() *pfs.FileSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 // Shared refers to data common to all direct children of a directory (i.e.
 // headers and footers)
 type Shared struct {
@@ -507,47 +537,64 @@ func init() {
 	proto.RegisterType((*Index)(nil), "hashtree.Index")
 }
 
-func init() { proto.RegisterFile("server/pkg/hashtree/hashtree.proto", fileDescriptor_4bd44075bd9a7a70) }
+func init() {
+	proto.RegisterFile("server/pkg/hashtree/hashtree.proto", fileDescriptor_4bd44075bd9a7a70)
+}
 
 var fileDescriptor_4bd44075bd9a7a70 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x65, 0x6d, 0x27, 0x71, 0x26, 0xa9, 0x08, 0x0b, 0x02, 0xab, 0x42, 0x69, 0x30, 0x02, 0x05,
-	0x04, 0x89, 0x54, 0x10, 0x20, 0x8e, 0x15, 0x54, 0x25, 0x07, 0x40, 0x5b, 0x4e, 0x5c, 0x22, 0x7f,
-	0x8c, 0x6b, 0x13, 0xd7, 0x1b, 0xed, 0x3a, 0x15, 0xe9, 0xef, 0xe0, 0xc0, 0x99, 0x03, 0xff, 0x04,
-	0x89, 0x23, 0x3f, 0x01, 0x95, 0x3f, 0x82, 0xf6, 0xa3, 0x75, 0x0b, 0x3d, 0x44, 0x9a, 0xf7, 0xe6,
-	0xcd, 0xf3, 0xbc, 0xcd, 0x2e, 0x84, 0x12, 0xc5, 0x11, 0x8a, 0xe9, 0x72, 0x71, 0x30, 0xcd, 0x23,
-	0x99, 0xd7, 0x02, 0xf1, 0xac, 0x98, 0x2c, 0x05, 0xaf, 0x39, 0xf5, 0x4f, 0xf1, 0xe6, 0x8d, 0xa4,
-	0x2c, 0xb0, 0xaa, 0xa7, 0xcb, 0x4c, 0xaa, 0x9f, 0xe9, 0x87, 0xdf, 0x08, 0x6c, 0xec, 0x16, 0x25,
-	0xbe, 0xe5, 0x29, 0xbe, 0xd7, 0x13, 0xf7, 0xa0, 0xc3, 0xe3, 0x4f, 0x98, 0xd4, 0x32, 0xf0, 0x46,
-	0xee, 0xb8, 0xb7, 0xdd, 0x9b, 0x28, 0xf9, 0x3b, 0xcd, 0xb1, 0xd3, 0x1e, 0x7d, 0x04, 0x10, 0x97,
-	0x3c, 0x59, 0xcc, 0x05, 0x66, 0x32, 0x68, 0x69, 0xe5, 0x86, 0x56, 0xee, 0x28, 0x9a, 0x61, 0xc6,
-	0xba, 0xb1, 0xad, 0x24, 0x7d, 0x08, 0xd7, 0xf2, 0x48, 0xce, 0x73, 0x8c, 0x52, 0x14, 0xf3, 0x8c,
-	0xf3, 0x1a, 0x45, 0xd0, 0x1e, 0x91, 0xb1, 0xcf, 0xae, 0xe6, 0x91, 0xdc, 0xd3, 0xfc, 0xae, 0xa6,
-	0x67, 0x9e, 0x4f, 0x06, 0xce, 0xcc, 0xf3, 0x9d, 0x81, 0x3b, 0xf3, 0x7c, 0x77, 0xe0, 0x85, 0x5f,
-	0x08, 0xb4, 0xf7, 0xf3, 0x48, 0x60, 0x4a, 0xef, 0x42, 0xdb, 0x98, 0x04, 0x64, 0x44, 0xfe, 0x5d,
-	0xce, 0xb6, 0x94, 0xc8, 0x7e, 0xc2, 0xb9, 0x44, 0x64, 0x5a, 0x74, 0x0b, 0x7a, 0x76, 0x1d, 0x59,
-	0x1c, 0x63, 0xe0, 0x8e, 0xc8, 0xd8, 0x65, 0x60, 0xa8, 0xfd, 0xe2, 0x18, 0x95, 0xc0, 0x48, 0x8d,
-	0xc0, 0x33, 0x02, 0x43, 0x29, 0x41, 0x98, 0x01, 0x7d, 0x55, 0x08, 0x4c, 0x6a, 0x2e, 0xd6, 0xcd,
-	0xf9, 0x6d, 0x82, 0x9f, 0xe4, 0x45, 0x99, 0x0a, 0xac, 0x02, 0x77, 0xe4, 0x8e, 0xbb, 0xec, 0x0c,
-	0xd3, 0x31, 0xb4, 0xa5, 0xce, 0xa1, 0xdd, 0x7a, 0xdb, 0x83, 0xc9, 0xd9, 0xdf, 0x65, 0xf2, 0x31,
-	0xdb, 0x3f, 0x7f, 0x08, 0xe1, 0x0f, 0x02, 0xdd, 0xc6, 0x9f, 0x82, 0x57, 0x45, 0x87, 0xa8, 0xf3,
-	0x77, 0x99, 0xae, 0x15, 0xa7, 0x8c, 0x74, 0xdc, 0x3e, 0xd3, 0x35, 0xbd, 0x03, 0x7d, 0xb9, 0x8a,
-	0x95, 0xf7, 0xf9, 0x80, 0x3d, 0xcb, 0xe9, 0x84, 0x4f, 0xa1, 0x9b, 0x15, 0x25, 0xce, 0x2b, 0x9e,
-	0xa2, 0xdd, 0xe8, 0x56, 0xb3, 0xd1, 0x85, 0x6b, 0xc1, 0xfc, 0xcc, 0x42, 0xfa, 0x1c, 0xfc, 0xb4,
-	0x10, 0x66, 0xa8, 0xa5, 0x87, 0x6e, 0x37, 0x43, 0xff, 0x1f, 0x08, 0xeb, 0xa4, 0x85, 0x50, 0x28,
-	0xfc, 0x4e, 0x60, 0x63, 0x2f, 0x92, 0xf9, 0x07, 0x81, 0x36, 0x4b, 0x00, 0x9d, 0x23, 0x14, 0xb2,
-	0xe0, 0x95, 0x8e, 0xd3, 0x62, 0xa7, 0x90, 0x4e, 0xc1, 0xc9, 0x64, 0xe0, 0xe8, 0x6b, 0xb5, 0xd5,
-	0xd8, 0x5f, 0x18, 0x9f, 0xec, 0xca, 0xd7, 0x55, 0x2d, 0xd6, 0xcc, 0xc9, 0xe4, 0xe6, 0x0c, 0x3a,
-	0x16, 0xd2, 0x01, 0xb8, 0x0b, 0x5c, 0xdb, 0x03, 0x52, 0x25, 0x7d, 0x00, 0xad, 0xa3, 0xa8, 0x5c,
-	0xa1, 0xbd, 0x0f, 0xd7, 0x1b, 0xc3, 0x66, 0x4d, 0xa3, 0x78, 0xe9, 0xbc, 0x20, 0xe1, 0x7d, 0xe8,
-	0xef, 0xac, 0x92, 0x05, 0xd6, 0xe6, 0x5e, 0xd2, 0x9b, 0xd0, 0x8e, 0x35, 0xb6, 0x9e, 0x16, 0x85,
-	0x8f, 0xa1, 0xf5, 0xa6, 0x4a, 0xf1, 0x33, 0xed, 0x03, 0x59, 0xe8, 0x5e, 0x9f, 0x91, 0x85, 0x92,
-	0xf3, 0x2c, 0x93, 0x58, 0xeb, 0xcf, 0x79, 0xcc, 0xa2, 0x9d, 0xbd, 0x9f, 0x27, 0x43, 0xf2, 0xeb,
-	0x64, 0x48, 0x7e, 0x9f, 0x0c, 0xc9, 0xd7, 0x3f, 0xc3, 0x2b, 0x1f, 0x9f, 0x1d, 0x14, 0x75, 0xbe,
-	0x8a, 0x27, 0x09, 0x3f, 0x9c, 0x2e, 0xa3, 0x24, 0x5f, 0xa7, 0x28, 0xce, 0x57, 0x52, 0x24, 0xd3,
-	0x4b, 0x1e, 0x79, 0xdc, 0xd6, 0x8f, 0xf7, 0xc9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x3f,
-	0xaa, 0x91, 0x02, 0x04, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6d, 0x54,
+	0xcd, 0x6e, 0xd3, 0x40, 0x10, 0xc6, 0x76, 0x7e, 0x9c, 0x49, 0x42, 0xc3,
+	0x82, 0xc0, 0x8a, 0x50, 0x5b, 0x5c, 0x01, 0x05, 0x41, 0x22, 0x15, 0x04,
+	0x88, 0x63, 0x04, 0x55, 0xe8, 0x01, 0xd0, 0xa6, 0x27, 0x2e, 0x91, 0xed,
+	0xac, 0x63, 0xe3, 0xc4, 0x8e, 0x76, 0x9d, 0x8a, 0xf0, 0x00, 0x3c, 0x01,
+	0x07, 0x9e, 0x80, 0x37, 0x41, 0xe2, 0xc8, 0x23, 0x20, 0x78, 0x11, 0x66,
+	0x7f, 0x5a, 0xb7, 0xa2, 0x87, 0xb5, 0x66, 0xbe, 0xf9, 0x66, 0x3c, 0xdf,
+	0x78, 0xbc, 0xe0, 0x0b, 0xc6, 0x4f, 0x18, 0x1f, 0xae, 0xb2, 0xf9, 0x30,
+	0x09, 0x44, 0x52, 0x72, 0xc6, 0xce, 0x8c, 0xc1, 0x8a, 0x17, 0x65, 0x41,
+	0xdc, 0x53, 0xbf, 0x7f, 0x23, 0x5a, 0xa4, 0x2c, 0x2f, 0x87, 0xab, 0x58,
+	0xc8, 0xa3, 0xe3, 0xfe, 0x17, 0x1b, 0xba, 0x87, 0xe9, 0x82, 0xbd, 0x2d,
+	0x66, 0xec, 0xbd, 0xca, 0xb8, 0x0b, 0xcd, 0x22, 0xfc, 0xc8, 0xa2, 0x52,
+	0x78, 0xb5, 0x5d, 0x67, 0xbf, 0x7d, 0xd0, 0x1e, 0x48, 0xfa, 0x3b, 0x85,
+	0xd1, 0xd3, 0x18, 0x79, 0x04, 0x10, 0x2e, 0x8a, 0x28, 0x9b, 0x72, 0x16,
+	0x0b, 0xaf, 0xae, 0x98, 0x5d, 0xc5, 0x1c, 0x49, 0x98, 0xb2, 0x98, 0xb6,
+	0x42, 0x63, 0x09, 0xf2, 0x10, 0xae, 0x61, 0x23, 0xd3, 0x84, 0x05, 0x33,
+	0xc6, 0xa7, 0x71, 0x51, 0x94, 0x8c, 0x7b, 0x8d, 0x5d, 0x6b, 0xdf, 0xa5,
+	0x5b, 0x18, 0x18, 0x2b, 0xfc, 0x50, 0xc1, 0x47, 0x35, 0xd7, 0xea, 0xd9,
+	0xf8, 0xb4, 0x7b, 0x0e, 0x3e, 0x9d, 0x5e, 0x8d, 0x10, 0xa8, 0x2d, 0xb1,
+	0x3f, 0xaf, 0x89, 0x09, 0x5d, 0xaa, 0x6c, 0x6c, 0xf3, 0xaa, 0xd8, 0x2c,
+	0x17, 0x69, 0x9e, 0x4d, 0xcb, 0x80, 0xcf, 0x59, 0xe9, 0xb9, 0x18, 0x6d,
+	0xd1, 0xae, 0x41, 0x8f, 0x15, 0x48, 0xee, 0x43, 0x43, 0x44, 0x09, 0x5b,
+	0x06, 0x5e, 0x0b, 0xc3, 0xed, 0x83, 0x2d, 0xd5, 0xa2, 0x54, 0x3c, 0x51,
+	0x30, 0x35, 0x61, 0xff, 0xab, 0x05, 0x8d, 0x49, 0x12, 0x70, 0x36, 0x23,
+	0x7b, 0xd0, 0xd0, 0x8d, 0x7a, 0x96, 0xca, 0xb9, 0x30, 0x00, 0x13, 0x92,
+	0x24, 0x23, 0xc3, 0xbe, 0x84, 0xa4, 0x43, 0x64, 0x07, 0xda, 0x46, 0xb2,
+	0x48, 0x3f, 0x33, 0xcf, 0x41, 0xa6, 0x43, 0x41, 0x43, 0x13, 0x44, 0x24,
+	0x41, 0x53, 0x35, 0xa1, 0xa6, 0x09, 0x1a, 0x92, 0x04, 0x3f, 0x06, 0xf2,
+	0x2a, 0xe5, 0x58, 0xb3, 0xe0, 0x9b, 0xea, 0x1b, 0xf5, 0xc1, 0x8d, 0x92,
+	0x74, 0x31, 0xe3, 0x2c, 0xc7, 0xa2, 0x0e, 0xca, 0x3e, 0xf3, 0xc9, 0x3e,
+	0x2a, 0x56, 0x3a, 0x54, 0xb5, 0xf6, 0x41, 0x6f, 0x70, 0xb6, 0x12, 0x5a,
+	0x1f, 0x35, 0xf1, 0xf3, 0x83, 0xf6, 0x7f, 0x58, 0xd0, 0xaa, 0xea, 0xe3,
+	0xc0, 0xf3, 0x60, 0xc9, 0x94, 0xfe, 0x16, 0x55, 0xb6, 0xc4, 0x64, 0x21,
+	0x25, 0xb7, 0x43, 0x95, 0x4d, 0xee, 0x40, 0x47, 0xac, 0x43, 0x59, 0xfb,
+	0xbc, 0xc0, 0xb6, 0xc1, 0x94, 0xc2, 0xa7, 0xd0, 0x8a, 0x71, 0xda, 0xd3,
+	0x5c, 0x7e, 0x40, 0xdd, 0xd1, 0xad, 0xaa, 0xa3, 0x0b, 0xab, 0x47, 0xdd,
+	0xd8, 0xb8, 0xe4, 0x39, 0xb8, 0xb3, 0x94, 0xeb, 0xa4, 0xba, 0x4a, 0xba,
+	0x5d, 0x25, 0xfd, 0x3f, 0x10, 0xda, 0x44, 0xb6, 0xf4, 0xfc, 0xef, 0x16,
+	0x74, 0xc7, 0x48, 0x3c, 0x46, 0xa2, 0xd6, 0xe2, 0x41, 0x13, 0x7f, 0x12,
+	0x91, 0x16, 0xb9, 0x92, 0x53, 0xa7, 0xa7, 0x2e, 0x19, 0x82, 0x8d, 0xab,
+	0x6b, 0xab, 0xd5, 0xdd, 0xa9, 0xca, 0x5f, 0x48, 0x1f, 0x1c, 0x8a, 0xd7,
+	0x79, 0xc9, 0x37, 0x14, 0xa9, 0xfd, 0x23, 0x68, 0x1a, 0x97, 0xf4, 0xc0,
+	0xc9, 0xd8, 0xc6, 0x0c, 0x48, 0x9a, 0xe4, 0x01, 0xd4, 0x4f, 0x82, 0xc5,
+	0x9a, 0x99, 0x7d, 0xb8, 0x5e, 0x15, 0xac, 0xda, 0xd4, 0x8c, 0x97, 0xf6,
+	0x0b, 0xcb, 0xbf, 0x07, 0x9d, 0xd1, 0x3a, 0xca, 0x58, 0xa9, 0x77, 0x9f,
+	0xdc, 0x84, 0x46, 0xa8, 0x7c, 0x53, 0xd3, 0x78, 0xfe, 0x63, 0xa8, 0xbf,
+	0xc9, 0x67, 0xec, 0x13, 0xe9, 0x80, 0x95, 0xa9, 0x58, 0x87, 0x5a, 0x99,
+	0xa4, 0x17, 0x71, 0x2c, 0x90, 0x2e, 0x5f, 0x57, 0xa3, 0xc6, 0x1b, 0x8d,
+	0x7f, 0xfe, 0xd9, 0xb6, 0x7e, 0xe1, 0xf9, 0x8d, 0xe7, 0xdb, 0xdf, 0xed,
+	0x2b, 0x1f, 0x9e, 0xcd, 0xd3, 0x32, 0x59, 0x87, 0x83, 0xa8, 0x58, 0x0e,
+	0x57, 0x41, 0x94, 0x6c, 0xf0, 0x6d, 0xe7, 0x2d, 0xc1, 0xa3, 0xe1, 0x25,
+	0x17, 0x49, 0xd8, 0x50, 0x17, 0xc4, 0x93, 0x7f, 0xa5, 0x30, 0x45, 0x95,
+	0x66, 0x04, 0x00, 0x00,
 }
 
 func (m *FileNodeProto) Marshal() (dAtA []byte, err error) {
@@ -599,6 +646,27 @@ func (m *FileNodeProto) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Mode))
+	}
+	if len(m.SymlinkTarget) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(len(m.SymlinkTarget)))
+		i += copy(dAtA[i:], m.SymlinkTarget)
+	}
+	if m.Schema != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintHashtree(dAtA, i, uint64(m.Schema.Size()))
+		n7, err7 := m.Schema.MarshalTo(dAtA[i:])
+		if err7 != nil {
+			return 0, err7
+		}
+		i += n7
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -903,6 +971,17 @@ func (m *FileNodeProto) Size() (n int) {
 	if m.HasHeaderFooter {
 		n += 2
 	}
+	if m.Mode != 0 {
+		n += 1 + sovHashtree(uint64(m.Mode))
+	}
+	l = len(m.SymlinkTarget)
+	if l > 0 {
+		n += 1 + l + sovHashtree(uint64(l))
+	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovHashtree(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1181,6 +1260,93 @@ func (m *FileNodeProto) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasHeaderFooter = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymlinkTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymlinkTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHashtree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHashtree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &pfs.FileSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHashtree(dAtA[iNdEx:])
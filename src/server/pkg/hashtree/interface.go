@@ -146,6 +146,15 @@ type HashTree interface {
 	// the size of the objects removed.
 	PutFileOverwrite(path string, objects []*pfs.Object, overwriteIndex *pfs.OverwriteIndex, sizeDelta int64) error
 
+	// SetFileMetadata records POSIX metadata (mode bits and, for symlinks,
+	// the link target) on the regular file at 'path', which must already
+	// exist.
+	SetFileMetadata(path string, mode uint32, symlinkTarget string) error
+
+	// SetFileSchema records an extracted columnar schema on the regular file
+	// at 'path', which must already exist.
+	SetFileSchema(path string, schema *pfs.FileSchema) error
+
 	// PutDir creates a directory (or does nothing if one exists).
 	PutDir(path string) error
 
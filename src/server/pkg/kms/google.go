@@ -0,0 +1,95 @@
+package kms
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"golang.org/x/net/context"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	// GoogleKeyEnvVar is the full resource name of the Cloud KMS crypto key
+	// that wraps data keys, i.e.
+	// "projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k>".
+	GoogleKeyEnvVar = "GOOGLE_KMS_KEY"
+
+	cloudKMSEndpoint = "https://cloudkms.googleapis.com/v1"
+	cloudKMSScope    = "https://www.googleapis.com/auth/cloudkms"
+)
+
+type googleKMS struct {
+	client *http.Client
+	key    string
+}
+
+func newGoogleKMS() (KMS, error) {
+	key := os.Getenv(GoogleKeyEnvVar)
+	if key == "" {
+		return nil, fmt.Errorf("the GOOGLE KMS backend requires a crypto key resource name in %s", GoogleKeyEnvVar)
+	}
+	// Application default credentials, same as the object storage client
+	client, err := google.DefaultClient(context.Background(), cloudKMSScope)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Google application default credentials: %v", err)
+	}
+	return &googleKMS{client: client, key: key}, nil
+}
+
+func (g *googleKMS) WrapKey(ctx context.Context, key []byte) ([]byte, error) {
+	resp, err := g.call(ctx, "encrypt", map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp["ciphertext"])
+}
+
+func (g *googleKMS) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	// Like AWS KMS, Cloud KMS records the key version in the ciphertext, so
+	// rotating the crypto key's primary version is transparent here
+	resp, err := g.call(ctx, "decrypt", map[string]string{
+		"ciphertext": base64.StdEncoding.EncodeToString(wrapped),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp["plaintext"])
+}
+
+func (g *googleKMS) call(ctx context.Context, method string, body map[string]string) (map[string]string, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s/%s:%s", cloudKMSEndpoint, g.key, method)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	httpResp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Cloud KMS %s returned %s: %s", method, httpResp.Status, respBody)
+	}
+	resp := make(map[string]string)
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
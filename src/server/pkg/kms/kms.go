@@ -0,0 +1,155 @@
+// Package kms provides envelope encryption for sensitive metadata (pipeline
+// auth tokens and similar credentials) stored in etcd. Each value is
+// encrypted with a fresh AES-256-GCM data key, and the data key is wrapped by
+// a pluggable KMS backend (AWS KMS, Google Cloud KMS, or a local master key).
+package kms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// BackendEnvVar selects the KMS backend: "LOCAL", "AMAZON" or "GOOGLE".
+	// If it's unset, sensitive values are stored unencrypted.
+	BackendEnvVar = "KMS_BACKEND"
+
+	// encryptedPrefix marks a stored value as envelope-encrypted. Values
+	// without it pass through DecryptSensitive unchanged, so encryption can
+	// be enabled on a cluster with existing plaintext values.
+	encryptedPrefix = "kms-enc:v1:"
+)
+
+// KMS wraps and unwraps data keys with a master key it controls.
+type KMS interface {
+	// WrapKey encrypts a data key under the backend's master key.
+	WrapKey(ctx context.Context, key []byte) ([]byte, error)
+	// UnwrapKey decrypts a data key wrapped by WrapKey, possibly with an
+	// earlier version of the master key (which is how key rotation works:
+	// re-encrypting a value unwraps with the old key and wraps with the
+	// current one).
+	UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// NewFromEnv returns the KMS backend configured in the environment, or nil if
+// none is configured.
+func NewFromEnv() (KMS, error) {
+	backend := os.Getenv(BackendEnvVar)
+	switch strings.ToUpper(backend) {
+	case "":
+		return nil, nil
+	case "LOCAL":
+		return newLocalKMS()
+	case "AMAZON":
+		return newAmazonKMS()
+	case "GOOGLE":
+		return newGoogleKMS()
+	default:
+		return nil, fmt.Errorf("unrecognized KMS backend %q (expected LOCAL, AMAZON or GOOGLE)", backend)
+	}
+}
+
+var (
+	defaultKMS     KMS
+	defaultKMSErr  error
+	defaultKMSOnce sync.Once
+)
+
+func fromEnv() (KMS, error) {
+	defaultKMSOnce.Do(func() {
+		defaultKMS, defaultKMSErr = NewFromEnv()
+	})
+	return defaultKMS, defaultKMSErr
+}
+
+// EncryptSensitive envelope-encrypts s with the KMS backend configured in the
+// environment. If no backend is configured, s is returned unchanged.
+func EncryptSensitive(ctx context.Context, s string) (string, error) {
+	k, err := fromEnv()
+	if err != nil {
+		return "", err
+	}
+	if k == nil || s == "" {
+		return s, nil
+	}
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	wrapped, err := k.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("error wrapping data key: %v", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	ciphertext := aead.Seal(nonce, nonce, []byte(s), nil)
+	return encryptedPrefix +
+		base64.StdEncoding.EncodeToString(wrapped) + ":" +
+		base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// DecryptSensitive reverses EncryptSensitive. Values that aren't encrypted
+// (e.g. ones stored before encryption was enabled) pass through unchanged, so
+// it's safe to call on any stored value.
+func DecryptSensitive(ctx context.Context, s string) (string, error) {
+	if !strings.HasPrefix(s, encryptedPrefix) {
+		return s, nil
+	}
+	k, err := fromEnv()
+	if err != nil {
+		return "", err
+	}
+	if k == nil {
+		return "", fmt.Errorf("found an encrypted value but no KMS backend is configured (set %s)", BackendEnvVar)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(s, encryptedPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", fmt.Errorf("malformed encrypted value")
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %v", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %v", err)
+	}
+	dataKey, err := k.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return "", fmt.Errorf("error unwrapping data key: %v", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return "", fmt.Errorf("malformed encrypted value")
+	}
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting value: %v", err)
+	}
+	return string(plaintext), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
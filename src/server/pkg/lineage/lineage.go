@@ -0,0 +1,165 @@
+// Package lineage exports Pachyderm provenance (pipelines, jobs and their
+// input/output repos) as OpenLineage run events, so that Pachyderm lineage
+// appears in org-wide data catalogs like Marquez.
+package lineage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// EndpointEnvVar is the pachd/worker environment variable holding the URL of
+// an OpenLineage-compatible event endpoint (e.g. Marquez's
+// "http://marquez:5000/api/v1/lineage"). When set, a run event is posted
+// there every time a job reaches a terminal state.
+const EndpointEnvVar = "OPENLINEAGE_URL"
+
+// NamespaceEnvVar optionally overrides the OpenLineage namespace that
+// Pachyderm jobs and datasets are reported under (default "pachyderm").
+const NamespaceEnvVar = "OPENLINEAGE_NAMESPACE"
+
+const defaultNamespace = "pachyderm"
+
+// producer identifies the source of the events, per the OpenLineage spec.
+const producer = "https://github.com/pachyderm/pachyderm"
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// Event is an OpenLineage run event.
+type Event struct {
+	EventType string    `json:"eventType"` // "START", "COMPLETE", "ABORT" or "FAIL"
+	EventTime string    `json:"eventTime"`
+	Run       Run       `json:"run"`
+	Job       Job       `json:"job"`
+	Inputs    []Dataset `json:"inputs,omitempty"`
+	Outputs   []Dataset `json:"outputs,omitempty"`
+	Producer  string    `json:"producer"`
+}
+
+// Run identifies one run of a job; Pachyderm uses the job ID.
+type Run struct {
+	RunID string `json:"runId"`
+}
+
+// Job identifies a job; Pachyderm uses the pipeline name.
+type Job struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// Dataset identifies a dataset; Pachyderm uses the repo name.
+type Dataset struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// Exporter posts OpenLineage events to a single endpoint. A nil Exporter
+// ignores all events, so callers don't need to check whether lineage export
+// is configured.
+type Exporter struct {
+	url       string
+	namespace string
+}
+
+// New returns an Exporter posting to 'url', or nil if 'url' is empty.
+func New(url, namespace string) *Exporter {
+	if url == "" {
+		return nil
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return &Exporter{
+		url:       url,
+		namespace: namespace,
+	}
+}
+
+// FromEnv returns the Exporter configured by the environment, or nil if
+// OPENLINEAGE_URL is unset.
+func FromEnv() *Exporter {
+	return New(os.Getenv(EndpointEnvVar), os.Getenv(NamespaceEnvVar))
+}
+
+// JobEvent builds the OpenLineage run event describing 'jobInfo' reaching
+// 'state': the pipeline is the OpenLineage job, the Pachyderm job ID is the
+// run ID, and the job's input and output repos are the datasets. Nil is
+// returned for non-terminal states.
+func (e *Exporter) JobEvent(jobInfo *pps.JobInfo, state pps.JobState) *Event {
+	var eventType string
+	switch state {
+	case pps.JobState_JOB_SUCCESS:
+		eventType = "COMPLETE"
+	case pps.JobState_JOB_FAILURE, pps.JobState_JOB_TIMED_OUT:
+		eventType = "FAIL"
+	case pps.JobState_JOB_KILLED:
+		eventType = "ABORT"
+	default:
+		return nil
+	}
+	event := &Event{
+		EventType: eventType,
+		EventTime: time.Now().UTC().Format(time.RFC3339),
+		Run:       Run{RunID: jobInfo.Job.ID},
+		Job: Job{
+			Namespace: e.namespace,
+			Name:      jobInfo.Pipeline.Name,
+		},
+	}
+	seen := make(map[string]bool)
+	pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+		var repo string
+		switch {
+		case input.Pfs != nil:
+			repo = input.Pfs.Repo
+		case input.Cron != nil:
+			repo = input.Cron.Repo
+		case input.Git != nil:
+			repo = input.Git.Name
+		}
+		if repo == "" || seen[repo] {
+			return
+		}
+		seen[repo] = true
+		event.Inputs = append(event.Inputs, Dataset{
+			Namespace: e.namespace,
+			Name:      repo,
+		})
+	})
+	if jobInfo.OutputCommit != nil {
+		event.Outputs = append(event.Outputs, Dataset{
+			Namespace: e.namespace,
+			Name:      jobInfo.OutputCommit.Repo.Name,
+		})
+	}
+	return event
+}
+
+// Emit posts 'event' to the Exporter's endpoint. Errors are returned so
+// callers can log them; lineage export failures should never fail the
+// operation being reported on.
+func (e *Exporter) Emit(event *Event) error {
+	if e == nil || event == nil {
+		return nil
+	}
+	event.Producer = producer
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Post(e.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("lineage endpoint returned %s", resp.Status)
+	}
+	return nil
+}
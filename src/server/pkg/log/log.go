@@ -48,9 +48,42 @@ func NewLocalLogger(service string) Logger {
 	return newLogger(service, false)
 }
 
-func newLogger(service string, exportStats bool) Logger {
+// baseLogger is shared by every logger this package hands out, so that
+// SetLevel can change the verbosity of the whole process at once.
+var baseLogger = func() *logrus.Logger {
 	l := logrus.New()
-	l.Formatter = FormatterFunc(Pretty)
+	l.Formatter = FormatterFunc(JSON)
+	return l
+}()
+
+var (
+	levelMu    sync.Mutex
+	levelTimer *time.Timer
+)
+
+// SetLevel sets the level of every logger created by this package, as well as
+// the global logrus logger. If 'revertAfter' is nonzero the level reverts to
+// info once it elapses, so verbosity can be raised temporarily (e.g. with
+// 'pachctl debug log-level debug --duration 10m') without restarting the
+// process or leaving it spewing debug logs forever.
+func SetLevel(level logrus.Level, revertAfter time.Duration) {
+	levelMu.Lock()
+	defer levelMu.Unlock()
+	if levelTimer != nil {
+		levelTimer.Stop()
+		levelTimer = nil
+	}
+	baseLogger.Level = level
+	logrus.SetLevel(level)
+	if revertAfter != 0 && level != logrus.InfoLevel {
+		levelTimer = time.AfterFunc(revertAfter, func() {
+			SetLevel(logrus.InfoLevel, 0)
+		})
+	}
+}
+
+func newLogger(service string, exportStats bool) Logger {
+	l := baseLogger
 	newLogger := &logger{
 		l.WithFields(logrus.Fields{"service": service}),
 		make(map[string]*prometheus.HistogramVec),
@@ -247,6 +280,32 @@ func (f FormatterFunc) Format(entry *logrus.Entry) ([]byte, error) {
 	return f(entry)
 }
 
+// JSON formats a logrus entry as a single JSON object per line, e.g.
+// ```
+// {"time":"2019-02-11T16:02:02Z","level":"info","service":"pfs.API","method":"InspectRepo","request":{"repo":{"name":"images"}},"duration":0.01}
+// ```
+// so logs can be parsed by aggregators without fragile regexes.
+func JSON(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, len(entry.Data)+3)
+	for k, v := range entry.Data {
+		if duration, ok := v.(time.Duration); ok {
+			data[k] = duration.Seconds()
+		} else {
+			data[k] = v
+		}
+	}
+	data["time"] = entry.Time.Format(logrus.DefaultTimestampFormat)
+	data["level"] = entry.Level.String()
+	if entry.Message != "" {
+		data["message"] = entry.Message
+	}
+	serialized, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to marshal fields to JSON, %v", err)
+	}
+	return append(serialized, '\n'), nil
+}
+
 // Pretty formats a logrus entry like so:
 // ```
 // 2019-02-11T16:02:02Z INFO pfs.API.InspectRepo {"request":{"repo":{"name":"images"}}} []
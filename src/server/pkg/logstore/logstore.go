@@ -0,0 +1,134 @@
+// Package logstore persists job logs in the object store, so that they
+// outlive the worker pods that emitted them. Each datum's log lines are
+// written as one gzip-compressed object tagged with the job, datum, and
+// worker they came from; the tag layout makes it cheap to fetch all of a
+// job's logs, or just one datum's, long after the pods are gone.
+package logstore
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+// tagPrefix namespaces persisted-log tags away from datum output tags in the
+// object store's tag index.
+const tagPrefix = "job.logs"
+
+// Tag returns the object tag under which one worker's log lines for one
+// datum are stored. Master logs (which have no datum) use datumID "master".
+func Tag(jobID, datumID, workerID string) string {
+	if datumID == "" {
+		datumID = "master"
+	}
+	return path.Join(tagPrefix, jobID, datumID, workerID)
+}
+
+// JobPrefix returns the tag prefix shared by all of a job's persisted logs.
+func JobPrefix(jobID string) string {
+	return path.Join(tagPrefix, jobID) + "/"
+}
+
+// DatumPrefix returns the tag prefix shared by all of a job+datum's
+// persisted logs (one tag per worker that processed the datum).
+func DatumPrefix(jobID, datumID string) string {
+	return path.Join(tagPrefix, jobID, datumID) + "/"
+}
+
+// PutLogs compresses 'logs' (newline-delimited jsonpb LogMessages, as
+// written by the worker's logger) and stores them under Tag(jobID, datumID,
+// workerID). Empty logs are skipped rather than stored.
+func PutLogs(pachClient *client.APIClient, jobID, datumID, workerID string, logs []byte) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	if _, err := gzw.Write(logs); err != nil {
+		return err
+	}
+	if err := gzw.Close(); err != nil {
+		return err
+	}
+	if _, _, err := pachClient.PutObject(&buf, Tag(jobID, datumID, workerID)); err != nil {
+		return fmt.Errorf("error persisting logs for job %q datum %q: %v", jobID, datumID, err)
+	}
+	return nil
+}
+
+// ForEachLine fetches every persisted log object under 'prefix' (see
+// JobPrefix/DatumPrefix), decompresses it, and calls 'f' with each log line.
+// Lines within one object arrive in the order they were logged; objects
+// arrive in tag order, so callers that need a global order must sort.
+func ForEachLine(pachClient *client.APIClient, prefix string, f func(*pps.LogMessage) error) error {
+	return forEachTag(pachClient, prefix, func(tag *pfs.Tag) error {
+		var buf bytes.Buffer
+		if err := pachClient.GetTag(tag.Name, &buf); err != nil {
+			return err
+		}
+		gzr, err := gzip.NewReader(&buf)
+		if err != nil {
+			return err
+		}
+		defer gzr.Close()
+		scanner := bufio.NewScanner(gzr)
+		for scanner.Scan() {
+			msg := new(pps.LogMessage)
+			if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+				continue
+			}
+			msg.Message = strings.TrimSuffix(msg.Message, "\n")
+			if err := f(msg); err != nil {
+				return err
+			}
+		}
+		return scanner.Err()
+	})
+}
+
+// DeleteJobLogs removes all of a job's persisted logs, e.g. because the
+// pipeline's log retention has expired. The underlying objects become
+// garbage once their tags are gone.
+func DeleteJobLogs(pachClient *client.APIClient, jobID string) error {
+	var tags []*pfs.Tag
+	if err := forEachTag(pachClient, JobPrefix(jobID), func(tag *pfs.Tag) error {
+		tags = append(tags, tag)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	_, err := pachClient.ObjectAPIClient.DeleteTags(pachClient.Ctx(), &pfs.DeleteTagsRequest{Tags: tags})
+	return grpcutil.ScrubGRPC(err)
+}
+
+func forEachTag(pachClient *client.APIClient, prefix string, f func(*pfs.Tag) error) error {
+	listTagClient, err := pachClient.ObjectAPIClient.ListTags(pachClient.Ctx(), &pfs.ListTagsRequest{Prefix: prefix})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	for {
+		resp, err := listTagClient.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+		if err := f(resp.Tag); err != nil {
+			return err
+		}
+	}
+}
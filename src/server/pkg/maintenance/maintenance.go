@@ -0,0 +1,180 @@
+// Package maintenance keeps etcd healthy as a cluster ages: it compacts old
+// revisions on a schedule so the keyspace stops growing without bound, and
+// defragments members during a configured maintenance window once the
+// database exceeds a size threshold, returning the space freed by compaction
+// to the OS before etcd hits its storage quota.
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	units "github.com/docker/go-units"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// defragCheckInterval is how often the controller checks whether a
+	// member needs defragmenting (the check itself is a cheap Status call).
+	defragCheckInterval = 10 * time.Minute
+	// requestTimeout bounds each maintenance request except Defragment,
+	// which legitimately takes as long as it takes.
+	requestTimeout = time.Minute
+)
+
+// Start parses the etcd maintenance configuration in 'env' and starts the
+// maintenance controller in the background for the life of the process. See
+// the EtcdCompactionInterval, EtcdDefragThreshold and EtcdMaintenanceWindow
+// fields in serviceenv.PachdSpecificConfiguration for what's configurable.
+func Start(env *serviceenv.ServiceEnv) error {
+	c := &controller{env: env}
+	if env.EtcdCompactionInterval != "" && env.EtcdCompactionInterval != "0" {
+		interval, err := time.ParseDuration(env.EtcdCompactionInterval)
+		if err != nil {
+			return fmt.Errorf("could not parse ETCD_COMPACTION_INTERVAL: %v", err)
+		}
+		c.compactionInterval = interval
+	}
+	if env.EtcdDefragThreshold != "" && env.EtcdDefragThreshold != "0" {
+		threshold, err := units.RAMInBytes(env.EtcdDefragThreshold)
+		if err != nil {
+			return fmt.Errorf("could not parse ETCD_DEFRAG_THRESHOLD: %v", err)
+		}
+		c.defragThreshold = threshold
+	}
+	if env.EtcdMaintenanceWindow != "" {
+		window, err := parseWindow(env.EtcdMaintenanceWindow)
+		if err != nil {
+			return fmt.Errorf("could not parse ETCD_MAINTENANCE_WINDOW: %v", err)
+		}
+		c.window = window
+	}
+	go c.run()
+	return nil
+}
+
+type controller struct {
+	env *serviceenv.ServiceEnv
+
+	// compactionInterval is how often old revisions are compacted, and
+	// therefore also how much revision history is kept; 0 disables
+	// compaction
+	compactionInterval time.Duration
+	// defragThreshold is the database size above which members are
+	// defragmented; 0 disables defragmentation
+	defragThreshold int64
+	// window is the daily UTC window in which defragmentation may run, or
+	// nil, which also disables defragmentation (defragmenting blocks the
+	// member, so it should never happen implicitly)
+	window *window
+}
+
+func (c *controller) run() {
+	etcdClient := c.env.GetEtcdClient()
+	if c.compactionInterval > 0 {
+		go c.compactLoop(etcdClient)
+	}
+	if c.defragThreshold > 0 && c.window != nil {
+		go c.defragLoop(etcdClient)
+	}
+}
+
+// compactLoop compacts etcd to the revision recorded one interval ago, so
+// that one interval of history is always retained and watchers lagging by
+// less than the interval are unaffected.
+func (c *controller) compactLoop(etcdClient *etcd.Client) {
+	ticker := time.NewTicker(c.compactionInterval)
+	defer ticker.Stop()
+	var lastRev int64
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		resp, err := etcdClient.Get(ctx, "compaction-probe")
+		if err != nil {
+			cancel()
+			log.Errorf("etcd maintenance: could not get current revision: %v", err)
+			continue
+		}
+		if lastRev > 0 {
+			if _, err := etcdClient.Compact(ctx, lastRev); err != nil {
+				// an already-compacted revision (e.g. etcd's own
+				// auto-compaction got there first) isn't a problem
+				if !strings.Contains(err.Error(), "has been compacted") {
+					log.Errorf("etcd maintenance: could not compact to revision %d: %v", lastRev, err)
+				}
+			} else {
+				log.Infof("etcd maintenance: compacted revisions older than %d", lastRev)
+			}
+		}
+		lastRev = resp.Header.Revision
+		cancel()
+	}
+}
+
+// defragLoop defragments members whose database exceeds the threshold, one
+// at a time (defragmenting blocks the member while it runs) and only inside
+// the maintenance window.
+func (c *controller) defragLoop(etcdClient *etcd.Client) {
+	ticker := time.NewTicker(defragCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if !c.window.contains(time.Now().UTC()) {
+			continue
+		}
+		for _, endpoint := range etcdClient.Endpoints() {
+			ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+			status, err := etcdClient.Status(ctx, endpoint)
+			cancel()
+			if err != nil {
+				log.Errorf("etcd maintenance: could not get status of %s: %v", endpoint, err)
+				continue
+			}
+			if status.DbSize <= c.defragThreshold {
+				continue
+			}
+			log.Infof("etcd maintenance: defragmenting %s (db size %s over threshold %s)",
+				endpoint, units.BytesSize(float64(status.DbSize)), units.BytesSize(float64(c.defragThreshold)))
+			start := time.Now()
+			if _, err := etcdClient.Defragment(context.Background(), endpoint); err != nil {
+				log.Errorf("etcd maintenance: could not defragment %s: %v", endpoint, err)
+				continue
+			}
+			log.Infof("etcd maintenance: defragmented %s in %v", endpoint, time.Since(start))
+		}
+	}
+}
+
+// window is a daily window in UTC, expressed in minutes since midnight; it
+// may span midnight (e.g. 23:00-01:00).
+type window struct {
+	start, end int
+}
+
+// parseWindow parses a window of the form "HH:MM-HH:MM" (UTC).
+func parseWindow(s string) (*window, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected HH:MM-HH:MM, got %q", s)
+	}
+	w := &window{}
+	for i, minutes := range []*int{&w.start, &w.end} {
+		t, err := time.Parse("15:04", parts[i])
+		if err != nil {
+			return nil, fmt.Errorf("expected HH:MM-HH:MM, got %q", s)
+		}
+		*minutes = t.Hour()*60 + t.Minute()
+	}
+	return w, nil
+}
+
+func (w *window) contains(t time.Time) bool {
+	minutes := t.Hour()*60 + t.Minute()
+	if w.start <= w.end {
+		return minutes >= w.start && minutes < w.end
+	}
+	// the window spans midnight
+	return minutes >= w.start || minutes < w.end
+}
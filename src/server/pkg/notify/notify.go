@@ -0,0 +1,132 @@
+// Package notify posts cluster activity notifications (job failures, pipeline
+// crash loops, commit finishes) to Slack-compatible webhooks or generic HTTP
+// endpoints.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// Event names accepted in a pipeline's notification spec and implied by the
+// cluster-level configuration.
+const (
+	EventJobFailure        = "job_failure"
+	EventJobTimeoutWarning = "job_timeout_warning"
+	EventPipelineCrashLoop = "pipeline_crash_loop"
+	EventCommitFinish      = "commit_finish"
+)
+
+// NotificationWebhookEnvVar is the pachd/worker environment variable holding
+// the cluster-level webhook URL. Pipelines may override it in their specs.
+const NotificationWebhookEnvVar = "NOTIFICATION_WEBHOOK"
+
+const defaultTemplate = `Pachyderm: {{.Event}} pipeline={{.Pipeline}}{{if .JobID}} job={{.JobID}}{{end}}{{if .Reason}} reason={{.Reason}}{{end}}`
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// Message is the data available to notification templates.
+type Message struct {
+	Event    string
+	Pipeline string
+	JobID    string
+	State    string
+	Reason   string
+}
+
+// Notifier posts messages for a subscribed set of events to a single webhook.
+// A nil Notifier ignores all messages, so callers don't need to check whether
+// notifications are configured.
+type Notifier struct {
+	url      string
+	on       []string
+	template string
+}
+
+// New returns a Notifier posting to 'url', or nil if 'url' is empty. An empty
+// 'on' subscribes to job failures only.
+func New(url string, on []string, template string) *Notifier {
+	if url == "" {
+		return nil
+	}
+	if len(on) == 0 {
+		on = []string{EventJobFailure}
+	}
+	if template == "" {
+		template = defaultTemplate
+	}
+	return &Notifier{
+		url:      url,
+		on:       on,
+		template: template,
+	}
+}
+
+// ForPipeline returns the Notifier for 'pipelineInfo', honoring the pipeline's
+// notification spec if set and falling back to the cluster-level webhook.
+func ForPipeline(pipelineInfo *pps.PipelineInfo) *Notifier {
+	if pipelineInfo != nil && pipelineInfo.Notification != nil {
+		return New(
+			pipelineInfo.Notification.URL,
+			pipelineInfo.Notification.On,
+			pipelineInfo.Notification.Template,
+		)
+	}
+	return New(os.Getenv(NotificationWebhookEnvVar), nil, "")
+}
+
+// Send renders and posts 'msg' if the Notifier is subscribed to its event.
+// Errors are returned so callers can log them; notification failures should
+// never fail the operation being reported on.
+func (n *Notifier) Send(msg *Message) error {
+	if n == nil {
+		return nil
+	}
+	var subscribed bool
+	for _, event := range n.on {
+		if event == msg.Event {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		return nil
+	}
+	tmpl, err := template.New("notification").Parse(n.template)
+	if err != nil {
+		return fmt.Errorf("could not parse notification template: %v", err)
+	}
+	var text bytes.Buffer
+	if err := tmpl.Execute(&text, msg); err != nil {
+		return fmt.Errorf("could not render notification template: %v", err)
+	}
+	// The payload is Slack's incoming-webhook format, which generic receivers
+	// can also parse; the raw fields are included alongside "text".
+	payload, err := json.Marshal(map[string]string{
+		"text":     text.String(),
+		"event":    msg.Event,
+		"pipeline": msg.Pipeline,
+		"job_id":   msg.JobID,
+		"state":    msg.State,
+		"reason":   msg.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Post(n.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
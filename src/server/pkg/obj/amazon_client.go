@@ -196,9 +196,18 @@ func newAmazonClient(region, bucket string, creds *AmazonCreds, cloudfrontDistri
 		r = true
 	}
 	awsClient := &amazonClient{
-		bucket:   bucket,
-		s3:       s3.New(session),
-		uploader: s3manager.NewUploader(session),
+		bucket: bucket,
+		s3:     s3.New(session),
+		uploader: s3manager.NewUploader(session, func(u *s3manager.Uploader) {
+			// Apply the upload tuning knobs from the environment (zero
+			// leaves the s3manager defaults in place)
+			if concurrency := UploadConcurrency(); concurrency > 0 {
+				u.Concurrency = concurrency
+			}
+			if partSize := UploadPartSize(); partSize > 0 {
+				u.PartSize = partSize
+			}
+		}),
 		reversed: r,
 	}
 
@@ -413,7 +422,9 @@ func newWriter(ctx context.Context, client *amazonClient, name string) *amazonWr
 		pipe:    writer,
 	}
 	go func() {
-		_, err := client.uploader.Upload(&s3manager.UploadInput{
+		// UploadWithContext (rather than Upload) so that cancelling the
+		// context aborts the transfer promptly, in-flight parts included
+		_, err := client.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
 			ACL:             &uploadACL,
 			Body:            reader,
 			Bucket:          aws.String(client.bucket),
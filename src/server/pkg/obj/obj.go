@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,8 +28,46 @@ import (
 const (
 	StorageBackendEnvVar = "STORAGE_BACKEND"
 	PachRootEnvVar       = "PACH_ROOT"
+	// StorageFallbackURLEnvVar, if set, names a second object store (as an
+	// object store URL, e.g. "s3://old-bucket") that reads fall back to when
+	// an object isn't in the primary store yet. It's the dual-read cutover
+	// window of a storage migration (see 'pachctl admin migrate-storage'):
+	// point pachd's primary backend at the new store, set this to the old
+	// one, and unset it once the migration has been verified.
+	StorageFallbackURLEnvVar = "STORAGE_FALLBACK_URL"
 )
 
+// Environment variables for tuning uploads, on backends that support
+// multipart uploads (currently Amazon)
+const (
+	// StorageUploadConcurrencyEnvVar is how many parts of a single object may
+	// be uploaded in parallel. Zero or unset leaves the client's default.
+	StorageUploadConcurrencyEnvVar = "STORAGE_UPLOAD_CONCURRENCY"
+	// StorageUploadPartSizeEnvVar is the multipart part size in bytes. Zero
+	// or unset leaves the client's default.
+	StorageUploadPartSizeEnvVar = "STORAGE_UPLOAD_PART_SIZE"
+)
+
+// UploadConcurrency returns the value of StorageUploadConcurrencyEnvVar, or
+// 0 if it's unset (or unparseable), which means the client's default.
+func UploadConcurrency() int {
+	result, err := strconv.Atoi(os.Getenv(StorageUploadConcurrencyEnvVar))
+	if err != nil || result < 0 {
+		return 0
+	}
+	return result
+}
+
+// UploadPartSize returns the value of StorageUploadPartSizeEnvVar, or 0 if
+// it's unset (or unparseable), which means the client's default.
+func UploadPartSize() int64 {
+	result, err := strconv.ParseInt(os.Getenv(StorageUploadPartSizeEnvVar), 10, 64)
+	if err != nil || result < 0 {
+		return 0
+	}
+	return result
+}
+
 // Valid object storage backends
 const (
 	Minio     = "MINIO"
@@ -511,11 +550,80 @@ func NewClientFromEnv(storageRoot string) (c Client, err error) {
 	switch {
 	case err != nil:
 		return nil, err
-	case c != nil:
-		return TracingObjClient(storageBackend, c), nil
-	default:
+	case c == nil:
 		return nil, fmt.Errorf("unrecognized storage backend: %s", storageBackend)
 	}
+	c = TracingObjClient(storageBackend, c)
+	if fallbackURL := os.Getenv(StorageFallbackURLEnvVar); fallbackURL != "" {
+		url, err := ParseURL(fallbackURL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %v", StorageFallbackURLEnvVar, err)
+		}
+		fallback, err := NewClientFromURLAndSecret(url, false)
+		if err != nil {
+			return nil, err
+		}
+		c = &fallbackClient{primary: c, fallback: fallback}
+	}
+	return c, nil
+}
+
+// fallbackClient implements the dual-read window of a storage migration:
+// writes and deletes go to 'primary' only, but reads of objects that aren't
+// in 'primary' yet fall back to 'fallback'.
+type fallbackClient struct {
+	primary  Client
+	fallback Client
+}
+
+func (c *fallbackClient) Writer(ctx context.Context, name string) (io.WriteCloser, error) {
+	return c.primary.Writer(ctx, name)
+}
+
+func (c *fallbackClient) Reader(ctx context.Context, name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	r, err := c.primary.Reader(ctx, name, offset, size)
+	if err != nil && c.primary.IsNotExist(err) {
+		return c.fallback.Reader(ctx, name, offset, size)
+	}
+	return r, err
+}
+
+func (c *fallbackClient) Delete(ctx context.Context, name string) error {
+	return c.primary.Delete(ctx, name)
+}
+
+func (c *fallbackClient) Walk(ctx context.Context, prefix string, fn func(name string) error) error {
+	// walk the union of both stores (objects already migrated appear in both,
+	// so skip names the primary walk already visited)
+	seen := make(map[string]bool)
+	if err := c.primary.Walk(ctx, prefix, func(name string) error {
+		seen[name] = true
+		return fn(name)
+	}); err != nil {
+		return err
+	}
+	return c.fallback.Walk(ctx, prefix, func(name string) error {
+		if seen[name] {
+			return nil
+		}
+		return fn(name)
+	})
+}
+
+func (c *fallbackClient) Exists(ctx context.Context, name string) bool {
+	return c.primary.Exists(ctx, name) || c.fallback.Exists(ctx, name)
+}
+
+func (c *fallbackClient) IsRetryable(err error) bool {
+	return c.primary.IsRetryable(err) || c.fallback.IsRetryable(err)
+}
+
+func (c *fallbackClient) IsNotExist(err error) bool {
+	return c.primary.IsNotExist(err) || c.fallback.IsNotExist(err)
+}
+
+func (c *fallbackClient) IsIgnorable(err error) bool {
+	return c.primary.IsIgnorable(err) || c.fallback.IsIgnorable(err)
 }
 
 // NewExponentialBackOffConfig creates an exponential back-off config with
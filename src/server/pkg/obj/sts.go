@@ -0,0 +1,52 @@
+package obj
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// ScopedCredDuration is how long credentials minted by ScopedAmazonCreds are
+// valid. 36 hours is the maximum that STS allows for federation tokens.
+// TODO: rotate credentials for worker pods that outlive this window, rather
+// than relying on pipeline restarts to pick up fresh ones.
+const ScopedCredDuration = 36 * time.Hour
+
+// ScopedAmazonCreds uses STS to mint short-lived credentials that can only
+// access 'bucket', so that worker pods can read and write the object store
+// directly without holding the cluster's long-lived storage secret. The
+// credentials expire after 'duration' (at most ScopedCredDuration, per STS).
+func ScopedAmazonCreds(region, bucket string, creds *AmazonCreds, duration time.Duration) (*AmazonCreds, error) {
+	if creds.ID == "" {
+		return nil, fmt.Errorf("scoped credentials require static AWS credentials (not Vault or the EC2 metadata service)")
+	}
+	awsConfig := &aws.Config{
+		Region:      aws.String(region),
+		Credentials: credentials.NewStaticCredentials(creds.ID, creds.Secret, creds.Token),
+	}
+	policy := fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:PutObject", "s3:DeleteObject", "s3:ListBucket"],
+    "Resource": ["arn:aws:s3:::%s", "arn:aws:s3:::%s/*"]
+  }]
+}`, bucket, bucket)
+	resp, err := sts.New(session.New(awsConfig)).GetFederationToken(&sts.GetFederationTokenInput{
+		Name:            aws.String("pachyderm-worker"),
+		Policy:          aws.String(policy),
+		DurationSeconds: aws.Int64(int64(duration / time.Second)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting federation token: %v", err)
+	}
+	return &AmazonCreds{
+		ID:     *resp.Credentials.AccessKeyId,
+		Secret: *resp.Credentials.SecretAccessKey,
+		Token:  *resp.Credentials.SessionToken,
+	}, nil
+}
@@ -16,6 +16,8 @@ const (
 	putFileRecordsPrefix = "/putFileRecords"
 	commitsPrefix        = "/commits"
 	branchesPrefix       = "/branches"
+	tagsPrefix           = "/tags"
+	bandwidthPrefix      = "/bandwidthLimits"
 	openCommitsPrefix    = "/openCommits"
 )
 
@@ -77,6 +79,45 @@ func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Colle
 	)
 }
 
+// Tags returns a collection of commit tags
+func Tags(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, tagsPrefix, repo),
+		nil,
+		&pfs.CommitTagInfo{},
+		func(key string) error {
+			if uuid.IsUUIDWithoutDashes(key) {
+				return fmt.Errorf("tag name cannot be a UUID V4")
+			}
+			return nil
+		},
+		nil,
+	)
+}
+
+// BandwidthLimits returns the collection of bandwidth limits, keyed by
+// BandwidthLimitKey.
+func BandwidthLimits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, bandwidthPrefix),
+		nil,
+		&pfs.BandwidthLimit{},
+		nil,
+		nil,
+	)
+}
+
+// BandwidthLimitKey returns the collection key of a bandwidth limit, which
+// constrains either a principal or a repo.
+func BandwidthLimitKey(limit *pfs.BandwidthLimit) string {
+	if limit.Principal != "" {
+		return "principal:" + limit.Principal
+	}
+	return "repo:" + limit.Repo
+}
+
 // OpenCommits returns a collection of open commits
 func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
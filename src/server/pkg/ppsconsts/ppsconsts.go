@@ -15,6 +15,14 @@ const (
 	// SpecFile is the file in every SpecRepo commit containing the PipelineInfo
 	SpecFile = "spec"
 
+	// TemplateRepo contains pipeline templates (one file per template, on its
+	// master branch), instantiated by 'pachctl create pipeline
+	// --from-template'.
+	TemplateRepo = "__templates__"
+
+	// TemplateRepoDesc is the description applied to the template repo.
+	TemplateRepoDesc = "PPS pipeline templates repo."
+
 	// PPSTokenKey is a key (in etcd) that maps to PPS's auth token.
 	// This is the token that PPS uses to authorize spec writes.
 	PPSTokenKey = "master_token"
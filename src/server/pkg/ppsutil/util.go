@@ -185,6 +185,7 @@ func GetPipelineInfo(pachClient *client.APIClient, ptr *pps.EtcdPipelineInfo, fu
 	result.JobCounts = ptr.JobCounts
 	result.LastJobState = ptr.LastJobState
 	result.SpecCommit = ptr.SpecCommit
+	result.PromotedVersion = ptr.PromotedVersion
 	return result, nil
 }
 
@@ -233,9 +234,15 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommitInfo *pfs.CommitInfo)
 	return jobInput
 }
 
+// ExtraOutputRepo returns the name of the repo backing one of a pipeline's
+// extra outputs (see CreatePipelineRequest.ExtraOutputs).
+func ExtraOutputRepo(pipeline, output string) string {
+	return pipeline + "-" + output
+}
+
 // PipelineReqFromInfo converts a PipelineInfo into a CreatePipelineRequest.
 func PipelineReqFromInfo(pipelineInfo *ppsclient.PipelineInfo) *ppsclient.CreatePipelineRequest {
-	return &ppsclient.CreatePipelineRequest{
+	result := &ppsclient.CreatePipelineRequest{
 		Pipeline:           pipelineInfo.Pipeline,
 		Transform:          pipelineInfo.Transform,
 		ParallelismSpec:    pipelineInfo.ParallelismSpec,
@@ -256,7 +263,26 @@ func PipelineReqFromInfo(pipelineInfo *ppsclient.PipelineInfo) *ppsclient.Create
 		DatumTimeout:       pipelineInfo.DatumTimeout,
 		JobTimeout:         pipelineInfo.JobTimeout,
 		Salt:               pipelineInfo.Salt,
+		Notification:       pipelineInfo.Notification,
+		Spout:              pipelineInfo.Spout,
+		Standby:            pipelineInfo.Standby,
+		DatumTries:         pipelineInfo.DatumTries,
+		SchedulingSpec:     pipelineInfo.SchedulingSpec,
+		PodSpec:            pipelineInfo.PodSpec,
+		PodPatch:           pipelineInfo.PodPatch,
+		TransformCaching:   pipelineInfo.TransformCaching,
+		MaxQueuedJobs:      pipelineInfo.MaxQueuedJobs,
+		VersionedOutput:    pipelineInfo.VersionedOutput,
+		ExtraOutputs:       pipelineInfo.ExtraOutputs,
+		SharedCache:        pipelineInfo.SharedCache,
+		DatumTimeoutSpec:   pipelineInfo.DatumTimeoutSpec,
+	}
+	if pipelineInfo.VersionedOutput {
+		// The stored spec's output branch is the per-version branch ("v2",
+		// ...); the user-facing spec always uses master
+		result.OutputBranch = "master"
 	}
+	return result
 }
 
 // PipelineManifestReader helps with unmarshalling pipeline configs from JSON. It's used by
@@ -346,7 +372,7 @@ func DescribeSyntaxError(originalErr error, parsedBuffer bytes.Buffer) error {
 // otherwise.
 func IsTerminal(state pps.JobState) bool {
 	switch state {
-	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED:
+	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED, pps.JobState_JOB_TIMED_OUT:
 		return true
 	case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING, pps.JobState_JOB_MERGING:
 		return false
@@ -0,0 +1,129 @@
+// Package pressure detects when etcd is struggling — slow requests or a full
+// keyspace — so that pachd can shed load gracefully instead of letting every
+// request time out at once. While the monitor reports a degraded state, PFS
+// rejects new commits with retryable errors, garbage collection is paused
+// and the health API reports the reason.
+package pressure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/etcdserverpb"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// probeInterval is how often the monitor probes etcd.
+	probeInterval = 15 * time.Second
+	// probeTimeout bounds each probe; a probe that hits it counts as slow.
+	probeTimeout = 5 * time.Second
+	// latencyThreshold is the probe latency above which etcd is considered
+	// under pressure. A healthy etcd answers a single-key read in
+	// milliseconds, so a full second means requests are already queueing.
+	latencyThreshold = time.Second
+	// degradeAfter is the number of consecutive slow probes before the
+	// monitor reports a degraded state; recoverAfter is the number of
+	// consecutive fast probes before it clears. Requiring more than one in
+	// both directions keeps a single hiccup from flapping the state.
+	degradeAfter = 2
+	recoverAfter = 2
+	// probeKey is the key the monitor reads; it doesn't need to exist.
+	probeKey = "pressure-probe"
+)
+
+// Monitor periodically probes etcd and tracks whether it's under pressure.
+type Monitor struct {
+	etcdClient *etcd.Client
+
+	mu       sync.Mutex
+	degraded bool
+	reason   string
+	slow     int // consecutive slow probes
+	fast     int // consecutive fast probes
+}
+
+// NewMonitor returns a Monitor that probes 'etcdClient' in the background
+// for the life of the process.
+func NewMonitor(etcdClient *etcd.Client) *Monitor {
+	m := &Monitor{etcdClient: etcdClient}
+	go m.monitor()
+	return m
+}
+
+// Degraded returns whether etcd is under pressure and, if so, why. Callers
+// shedding load should return retryable errors (e.g. gRPC Unavailable) that
+// include the reason.
+func (m *Monitor) Degraded() (bool, string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.degraded, m.reason
+}
+
+func (m *Monitor) monitor() {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if reason := m.probe(); reason != "" {
+			m.recordSlow(reason)
+		} else {
+			m.recordFast()
+		}
+	}
+}
+
+// probe times a single-key read and checks for active alarms, returning a
+// non-empty reason if etcd is under pressure.
+func (m *Monitor) probe() string {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	start := time.Now()
+	if _, err := m.etcdClient.Get(ctx, probeKey); err != nil {
+		return fmt.Sprintf("etcd probe failed: %v", err)
+	}
+	if latency := time.Since(start); latency > latencyThreshold {
+		return fmt.Sprintf("etcd probe took %v (threshold %v)", latency, latencyThreshold)
+	}
+	// Alarms (in particular NOSPACE, raised when etcd hits its storage
+	// quota) make etcd reject all writes, so they count as pressure even
+	// when reads are still fast.
+	resp, err := m.etcdClient.AlarmList(ctx)
+	if err != nil {
+		return fmt.Sprintf("etcd alarm list failed: %v", err)
+	}
+	for _, alarm := range resp.Alarms {
+		if alarm.Alarm == etcdserverpb.AlarmType_NOSPACE {
+			return "etcd NOSPACE alarm is active (storage quota exhausted)"
+		}
+	}
+	return ""
+}
+
+func (m *Monitor) recordSlow(reason string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.slow++
+	m.fast = 0
+	if !m.degraded && m.slow >= degradeAfter {
+		m.degraded = true
+		log.Errorf("etcd is under pressure, shedding load: %s", reason)
+	}
+	if m.degraded {
+		m.reason = reason
+	}
+}
+
+func (m *Monitor) recordFast() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fast++
+	m.slow = 0
+	if m.degraded && m.fast >= recoverAfter {
+		m.degraded = false
+		m.reason = ""
+		log.Infof("etcd has recovered, resuming normal operation")
+	}
+}
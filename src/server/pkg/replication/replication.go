@@ -0,0 +1,235 @@
+// Package replication implements asynchronous mirroring of repos from a
+// primary cluster to a replica cluster, for disaster recovery. Commits are
+// replayed onto the replica in order; each mirrored commit records the
+// primary commit it came from in its description, which is how the mirror
+// finds where it left off after a restart.
+package replication
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+// sourcePrefix starts the first line of every mirrored commit's description;
+// the rest of the line is "<repo>@<commit>" on the primary.
+const sourcePrefix = "replication-source: "
+
+// sourceOf returns the primary commit ID recorded in a mirrored commit's
+// description, or "" if the commit wasn't created by the mirror.
+func sourceOf(commitInfo *pfs.CommitInfo) string {
+	if commitInfo == nil {
+		return ""
+	}
+	firstLine := strings.SplitN(commitInfo.Description, "\n", 2)[0]
+	if !strings.HasPrefix(firstLine, sourcePrefix) {
+		return ""
+	}
+	source := strings.TrimPrefix(firstLine, sourcePrefix)
+	if idx := strings.Index(source, "@"); idx >= 0 {
+		return source[idx+1:]
+	}
+	return ""
+}
+
+// BranchStatus describes how far behind the primary a replica branch is.
+type BranchStatus struct {
+	Repo         string
+	Branch       string
+	PrimaryHead  string
+	ReplicaHead  string
+	LastMirrored string // primary commit the replica head was mirrored from
+	Lag          int    // number of primary commits not yet mirrored
+}
+
+// pending returns the primary commits on repo@branch that haven't been
+// mirrored yet (i.e. that come after 'lastMirrored'), oldest first.
+func pending(primary *client.APIClient, repo, branch, lastMirrored string) ([]*pfs.CommitInfo, error) {
+	var result []*pfs.CommitInfo
+	commitInfo, err := primary.InspectCommit(repo, branch)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for commitInfo != nil && commitInfo.Commit.ID != lastMirrored {
+		// only finished commits can be read and mirrored
+		if commitInfo.Finished != nil {
+			result = append(result, commitInfo)
+		}
+		if commitInfo.ParentCommit == nil {
+			break
+		}
+		commitInfo, err = primary.InspectCommit(repo, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// reverse into oldest-first order
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
+
+// replicaHead returns the head of repo@branch on the replica (nil if the
+// branch doesn't exist there yet).
+func replicaHead(replica *client.APIClient, repo, branch string) (*pfs.CommitInfo, error) {
+	commitInfo, err := replica.InspectCommit(repo, branch)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return commitInfo, nil
+}
+
+// Status reports, for every branch of every repo in 'repos', how far behind
+// the primary the replica is.
+func Status(primary *client.APIClient, replica *client.APIClient, repos []string) ([]*BranchStatus, error) {
+	var result []*BranchStatus
+	for _, repo := range repos {
+		branchInfos, err := primary.ListBranch(repo)
+		if err != nil {
+			return nil, err
+		}
+		for _, branchInfo := range branchInfos {
+			if branchInfo.Head == nil {
+				continue
+			}
+			status := &BranchStatus{
+				Repo:        repo,
+				Branch:      branchInfo.Branch.Name,
+				PrimaryHead: branchInfo.Head.ID,
+			}
+			head, err := replicaHead(replica, repo, branchInfo.Branch.Name)
+			if err != nil {
+				return nil, err
+			}
+			if head != nil {
+				status.ReplicaHead = head.Commit.ID
+				status.LastMirrored = sourceOf(head)
+			}
+			pendingCommits, err := pending(primary, repo, branchInfo.Branch.Name, status.LastMirrored)
+			if err != nil {
+				return nil, err
+			}
+			status.Lag = len(pendingCommits)
+			result = append(result, status)
+		}
+	}
+	return result, nil
+}
+
+// SyncBranch mirrors the primary commits on repo@branch that the replica
+// doesn't have yet, oldest first, and returns how many it mirrored.
+func SyncBranch(primary *client.APIClient, replica *client.APIClient, repo, branch string) (int, error) {
+	if err := replica.CreateRepo(repo); err != nil && !errutil.IsAlreadyExistError(err) {
+		return 0, err
+	}
+	head, err := replicaHead(replica, repo, branch)
+	if err != nil {
+		return 0, err
+	}
+	pendingCommits, err := pending(primary, repo, branch, sourceOf(head))
+	if err != nil {
+		return 0, err
+	}
+	for _, commitInfo := range pendingCommits {
+		if err := mirrorCommit(primary, replica, repo, branch, commitInfo); err != nil {
+			return 0, err
+		}
+	}
+	return len(pendingCommits), nil
+}
+
+// mirrorCommit replays one primary commit onto the replica branch by applying
+// the diff between the commit and its parent.
+func mirrorCommit(primary *client.APIClient, replica *client.APIClient, repo, branch string, commitInfo *pfs.CommitInfo) (retErr error) {
+	newCommit, err := replica.StartCommit(repo, branch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			// don't leave a half-mirrored open commit on the replica
+			if err := replica.DeleteCommit(repo, newCommit.ID); err != nil {
+				retErr = fmt.Errorf("%v (and could not clean up replica commit %s: %v)", retErr, newCommit.ID, err)
+			}
+		}
+	}()
+	newFiles, oldFiles, err := primary.DiffFile(repo, commitInfo.Commit.ID, "/", "", "", "", false)
+	if err != nil {
+		return err
+	}
+	for _, fileInfo := range oldFiles {
+		if fileInfo.FileType != pfs.FileType_FILE {
+			continue
+		}
+		if err := replica.DeleteFile(repo, newCommit.ID, fileInfo.File.Path); err != nil {
+			return err
+		}
+	}
+	for _, fileInfo := range newFiles {
+		if fileInfo.FileType != pfs.FileType_FILE {
+			continue
+		}
+		reader, err := primary.GetFileReader(repo, commitInfo.Commit.ID, fileInfo.File.Path, 0, 0)
+		if err != nil {
+			return err
+		}
+		if _, err := replica.PutFile(repo, newCommit.ID, fileInfo.File.Path, reader); err != nil {
+			return err
+		}
+	}
+	description := fmt.Sprintf("%s%s@%s", sourcePrefix, repo, commitInfo.Commit.ID)
+	if commitInfo.Description != "" {
+		description += "\n" + commitInfo.Description
+	}
+	if _, err := replica.PfsAPIClient.FinishCommit(replica.Ctx(), &pfs.FinishCommitRequest{
+		Commit:      newCommit,
+		Description: description,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Mirror continuously mirrors 'repos' from the primary to the replica,
+// syncing every branch of every repo once per 'interval' until ctx is
+// cancelled. 'onSync' (optional) is called after each branch sync that
+// mirrored at least one commit.
+func Mirror(ctx context.Context, primary *client.APIClient, replica *client.APIClient, repos []string, interval time.Duration, onSync func(repo, branch string, mirrored int)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, repo := range repos {
+			branchInfos, err := primary.ListBranch(repo)
+			if err != nil {
+				return err
+			}
+			for _, branchInfo := range branchInfos {
+				mirrored, err := SyncBranch(primary, replica, repo, branchInfo.Branch.Name)
+				if err != nil {
+					return err
+				}
+				if mirrored > 0 && onSync != nil {
+					onSync(repo, branchInfo.Branch.Name, mirrored)
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
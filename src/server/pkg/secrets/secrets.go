@@ -0,0 +1,41 @@
+// Package secrets resolves references to externally stored secrets in
+// pipeline env vars, so pipelines can pull credentials from a secret store at
+// pod start instead of requiring static Kubernetes secrets.
+package secrets
+
+import (
+	"strings"
+)
+
+// vaultPrefix marks an env var value as a reference into Vault, e.g.
+// "vault:secret/data/pipelines#db-password".
+const vaultPrefix = "vault:"
+
+// Provider resolves secret references in pipeline env vars.
+type Provider interface {
+	// Resolve returns the secret value that ref points to. ref includes the
+	// provider prefix (e.g. "vault:path#key").
+	Resolve(ref string) (string, error)
+}
+
+// IsRef returns true if value is a secret reference that a Provider can
+// resolve, rather than a literal value.
+func IsRef(value string) bool {
+	return strings.HasPrefix(value, vaultPrefix)
+}
+
+// HasRefs returns true if any value in env is a secret reference.
+func HasRefs(env map[string]string) bool {
+	for _, value := range env {
+		if IsRef(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewProvider returns a Provider built from the environment. Vault is
+// currently the only implementation.
+func NewProvider() (Provider, error) {
+	return newVaultProvider()
+}
@@ -0,0 +1,88 @@
+package secrets
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	// VaultAddressEnvVar is the address of the Vault server, e.g.
+	// "https://vault:8200". (This is the env var the Vault client itself
+	// reads; it's re-declared here so pachd can propagate it to worker pods.)
+	VaultAddressEnvVar = "VAULT_ADDR"
+	// VaultRoleEnvVar is the Vault Kubernetes-auth role that workers log in
+	// with when no token is provided in VAULT_TOKEN.
+	VaultRoleEnvVar = "VAULT_ROLE"
+	// kubeTokenPath is where Kubernetes mounts a pod's service account token,
+	// which identifies the worker to Vault's Kubernetes auth backend.
+	kubeTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+type vaultProvider struct {
+	client *vault.Client
+}
+
+// newVaultProvider creates a Provider backed by Vault. The server address
+// comes from VAULT_ADDR. Authentication uses VAULT_TOKEN if set; otherwise it
+// logs in through Vault's Kubernetes auth backend with the pod's service
+// account token and the role in VAULT_ROLE, so each worker fetches secrets
+// with its own identity.
+func newVaultProvider() (Provider, error) {
+	client, err := vault.NewClient(vault.DefaultConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error creating Vault client: %v", err)
+	}
+	if client.Token() == "" {
+		role := os.Getenv(VaultRoleEnvVar)
+		if role == "" {
+			return nil, fmt.Errorf("no Vault token in VAULT_TOKEN and no role in %s to log in with", VaultRoleEnvVar)
+		}
+		jwt, err := ioutil.ReadFile(kubeTokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading service account token for Vault login: %v", err)
+		}
+		secret, err := client.Logical().Write("auth/kubernetes/login", map[string]interface{}{
+			"role": role,
+			"jwt":  strings.TrimSpace(string(jwt)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error logging in to Vault as role %q: %v", role, err)
+		}
+		if secret == nil || secret.Auth == nil {
+			return nil, fmt.Errorf("vault login as role %q returned no token", role)
+		}
+		client.SetToken(secret.Auth.ClientToken)
+	}
+	return &vaultProvider{client: client}, nil
+}
+
+// Resolve reads a reference of the form "vault:path#key", e.g.
+// "vault:secret/data/pipelines#db-password".
+func (v *vaultProvider) Resolve(ref string) (string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(ref, vaultPrefix), "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("malformed Vault reference %q (expected \"vault:path#key\")", ref)
+	}
+	path, key := parts[0], parts[1]
+	secret, err := v.client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading %q from Vault: %v", path, err)
+	}
+	if secret == nil {
+		return "", fmt.Errorf("no secret at Vault path %q", path)
+	}
+	data := secret.Data
+	// KV version 2 secrets nest the fields under "data"
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		data = nested
+	}
+	value, ok := data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("no key %q in Vault secret at %q", key, path)
+	}
+	return value, nil
+}
@@ -20,6 +20,49 @@ type GlobalConfiguration struct {
 	PPSEtcdPrefix string `env:"PPS_ETCD_PREFIX,default=pachyderm_pps"`
 	Namespace     string `env:"NAMESPACE,default=default"`
 	StorageRoot   string `env:"PACH_ROOT,default=/pach"`
+	// StorageHashAlgorithm selects the content-hash algorithm the
+	// block/object layer names objects with: "sha512" (the default) or
+	// "blake3", which is significantly faster on large uploads. Because
+	// objects are content addressed, changing the algorithm only affects
+	// newly written objects; existing ones keep their old names and remain
+	// readable.
+	StorageHashAlgorithm string `env:"STORAGE_HASH_ALGORITHM,default=sha512"`
+	// StorageLegacyHashAlgorithm enables the dual-hash transition mode: set
+	// it to the previous algorithm while switching, and PutObject will also
+	// compute the legacy hash of everything it writes and dedup against
+	// objects stored under it, so re-uploaded content isn't stored twice.
+	// Unset it once the bulk of the cluster's data has been rewritten (see
+	// 'pachctl admin rebalance-objects').
+	StorageLegacyHashAlgorithm string `env:"STORAGE_LEGACY_HASH_ALGORITHM,default="`
+	// NotificationWebhook is the cluster-level webhook that receives job
+	// failure/crash-loop/commit-finish notifications (see pkg/notify).
+	NotificationWebhook string `env:"NOTIFICATION_WEBHOOK,default="`
+	// ReadOnly makes pachd reject all mutating RPCs while reads keep
+	// working, for migrations, incident response, and DR replicas.
+	ReadOnly bool `env:"READ_ONLY,default=false"`
+	// GRPCMaxMsgSize is the largest gRPC message (in bytes) that pachd will
+	// send or accept. Raise it if large FileInfos or hashtree responses
+	// exceed the default; clients must be configured to match (see
+	// client.WithMaxMsgSize).
+	GRPCMaxMsgSize int `env:"GRPC_MAX_MSG_SIZE,default=20971520"`
+	// WorkerDirectObjectAccess makes worker pods read and write blocks
+	// directly against the object store instead of proxying every byte
+	// through their pachd sidecar. With an Amazon backend the workers get
+	// short-lived credentials scoped to the storage bucket rather than the
+	// cluster's storage secret.
+	WorkerDirectObjectAccess bool `env:"WORKER_DIRECT_OBJECT_ACCESS,default=false"`
+	// ExpensiveRPCConcurrency caps how many expensive RPCs (GlobFile,
+	// ListDatum, flush operations, etc.) pachd runs at once; callers over the
+	// cap wait in line. Zero disables the cap.
+	ExpensiveRPCConcurrency int `env:"EXPENSIVE_RPC_CONCURRENCY,default=32"`
+	// ExpensiveRPCConcurrencyPerPrincipal caps how many expensive RPCs a
+	// single caller may have in flight, so one caller can't occupy every slot.
+	// Callers over their cap get RESOURCE_EXHAUSTED. Zero disables the cap.
+	ExpensiveRPCConcurrencyPerPrincipal int `env:"EXPENSIVE_RPC_CONCURRENCY_PER_PRINCIPAL,default=8"`
+	// ExpensiveRPCRatePerPrincipal rate-limits each caller's expensive RPCs
+	// (in calls per second; bursts of up to twice this are allowed). Callers
+	// over their limit get RESOURCE_EXHAUSTED. Zero disables the limit.
+	ExpensiveRPCRatePerPrincipal float64 `env:"EXPENSIVE_RPC_RATE_PER_PRINCIPAL,default=10"`
 }
 
 // PachdFullConfiguration contains the full pachd configuration.
@@ -30,29 +73,66 @@ type PachdFullConfiguration struct {
 
 // PachdSpecificConfiguration contains the pachd specific configuration.
 type PachdSpecificConfiguration struct {
-	NumShards             uint64 `env:"NUM_SHARDS,default=32"`
-	StorageBackend        string `env:"STORAGE_BACKEND,default="`
-	StorageHostPath       string `env:"STORAGE_HOST_PATH,default="`
-	EtcdPrefix            string `env:"ETCD_PREFIX,default="`
-	PFSEtcdPrefix         string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
-	AuthEtcdPrefix        string `env:"PACHYDERM_AUTH_ETCD_PREFIX,default=pachyderm_auth"`
-	EnterpriseEtcdPrefix  string `env:"PACHYDERM_ENTERPRISE_ETCD_PREFIX,default=pachyderm_enterprise"`
-	KubeAddress           string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
-	Metrics               bool   `env:"METRICS,default=true"`
-	Init                  bool   `env:"INIT,default=false"`
-	BlockCacheBytes       string `env:"BLOCK_CACHE_BYTES,default=1G"`
-	PFSCacheSize          string `env:"PFS_CACHE_SIZE,default=0"`
-	WorkerImage           string `env:"WORKER_IMAGE,default="`
-	WorkerSidecarImage    string `env:"WORKER_SIDECAR_IMAGE,default="`
-	WorkerImagePullPolicy string `env:"WORKER_IMAGE_PULL_POLICY,default="`
-	LogLevel              string `env:"LOG_LEVEL,default=info"`
-	IAMRole               string `env:"IAM_ROLE,default="`
-	ImagePullSecret       string `env:"IMAGE_PULL_SECRET,default="`
-	NoExposeDockerSocket  bool   `env:"NO_EXPOSE_DOCKER_SOCKET,default=false"`
-	ExposeObjectAPI       bool   `env:"EXPOSE_OBJECT_API,default=false"`
-	MemoryRequest         string `env:"PACHD_MEMORY_REQUEST,default=1T"`
-	WorkerUsesRoot        bool   `env:"WORKER_USES_ROOT,default=true"`
-	S3GatewayPort         uint16 `env:"S3GATEWAY_PORT,default=600"`
+	NumShards       uint64 `env:"NUM_SHARDS,default=32"`
+	StorageBackend  string `env:"STORAGE_BACKEND,default="`
+	StorageHostPath string `env:"STORAGE_HOST_PATH,default="`
+	// StorageUploadConcurrency and StorageUploadPartSize tune multipart
+	// uploads to the object store (the number of parts uploaded in parallel
+	// per object, and the part size in bytes). They apply to pachd and are
+	// passed along to workers; 0 leaves the object-store client's defaults.
+	StorageUploadConcurrency int    `env:"STORAGE_UPLOAD_CONCURRENCY,default=0"`
+	StorageUploadPartSize    int64  `env:"STORAGE_UPLOAD_PART_SIZE,default=0"`
+	EtcdPrefix               string `env:"ETCD_PREFIX,default="`
+	// MetadataBackend selects the store behind pachd's metadata collections
+	// (commits, jobs, pipelines, etc.): "etcd" (the default) or "postgres".
+	// Postgres has no practical keyspace ceiling, unlike etcd's ~8GB, so
+	// metadata-heavy clusters should use it. Switching the backend on an
+	// existing cluster requires migrating the metadata first.
+	MetadataBackend string `env:"METADATA_BACKEND,default=etcd"`
+	// MetadataPostgresHost et al. configure the postgres connection used
+	// when MetadataBackend is "postgres".
+	MetadataPostgresHost     string `env:"METADATA_POSTGRES_SERVICE_HOST,default="`
+	MetadataPostgresPort     string `env:"METADATA_POSTGRES_SERVICE_PORT,default=5432"`
+	MetadataPostgresDatabase string `env:"METADATA_POSTGRES_DATABASE,default=pachyderm"`
+	MetadataPostgresUser     string `env:"METADATA_POSTGRES_USER,default=pachyderm"`
+	MetadataPostgresPassword string `env:"METADATA_POSTGRES_PASSWORD,default="`
+	PFSEtcdPrefix            string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
+	AuthEtcdPrefix           string `env:"PACHYDERM_AUTH_ETCD_PREFIX,default=pachyderm_auth"`
+	EnterpriseEtcdPrefix     string `env:"PACHYDERM_ENTERPRISE_ETCD_PREFIX,default=pachyderm_enterprise"`
+	KubeAddress              string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
+	Metrics                  bool   `env:"METRICS,default=true"`
+	Init                     bool   `env:"INIT,default=false"`
+	BlockCacheBytes          string `env:"BLOCK_CACHE_BYTES,default=1G"`
+	PFSCacheSize             string `env:"PFS_CACHE_SIZE,default=0"`
+	WorkerImage              string `env:"WORKER_IMAGE,default="`
+	WorkerSidecarImage       string `env:"WORKER_SIDECAR_IMAGE,default="`
+	WorkerImagePullPolicy    string `env:"WORKER_IMAGE_PULL_POLICY,default="`
+	LogLevel                 string `env:"LOG_LEVEL,default=info"`
+	IAMRole                  string `env:"IAM_ROLE,default="`
+	ImagePullSecret          string `env:"IMAGE_PULL_SECRET,default="`
+	NoExposeDockerSocket     bool   `env:"NO_EXPOSE_DOCKER_SOCKET,default=false"`
+	ExposeObjectAPI          bool   `env:"EXPOSE_OBJECT_API,default=false"`
+	MemoryRequest            string `env:"PACHD_MEMORY_REQUEST,default=1T"`
+	WorkerUsesRoot           bool   `env:"WORKER_USES_ROOT,default=true"`
+	S3GatewayPort            uint16 `env:"S3GATEWAY_PORT,default=600"`
+	// EtcdCompactionInterval is how often pachd compacts old etcd revisions.
+	// Each compaction retains one interval of history, so watchers lagging by
+	// less than the interval are unaffected. "0" disables compaction (e.g.
+	// when etcd's own auto-compaction is configured instead).
+	EtcdCompactionInterval string `env:"ETCD_COMPACTION_INTERVAL,default=1h"`
+	// EtcdDefragThreshold is the etcd database size above which pachd
+	// defragments members during the maintenance window, returning the space
+	// freed by compaction to the OS. "0" disables defragmentation.
+	EtcdDefragThreshold string `env:"ETCD_DEFRAG_THRESHOLD,default=1G"`
+	// EtcdMaintenanceWindow is the daily UTC window (e.g. "01:00-03:00") in
+	// which pachd may defragment etcd members. Defragmenting blocks each
+	// member while it runs, so it never happens outside the window; if the
+	// window is empty, defragmentation is disabled.
+	EtcdMaintenanceWindow string `env:"ETCD_MAINTENANCE_WINDOW,default="`
+	// PrePullImages makes the PPS master maintain a DaemonSet per pipeline
+	// that pulls the pipeline's image on every node when the pipeline is
+	// created or updated, so that scaling out doesn't wait on image pulls.
+	PrePullImages bool `env:"PRE_PULL_IMAGES,default=false"`
 }
 
 // WorkerFullConfiguration contains the full worker configuration.
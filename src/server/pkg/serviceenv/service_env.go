@@ -1,15 +1,21 @@
 package serviceenv
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net"
 	"os"
+	"sync"
 	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	_ "github.com/lib/pq" // registers the "postgres" driver for GetMetadataBackend
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pressure"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	kube "k8s.io/client-go/kubernetes"
@@ -52,6 +58,18 @@ type ServiceEnv struct {
 	kubeClient *kube.Clientset
 	// kubeEg coordinates the initialization of kubeClient (see pachdEg)
 	kubeEg errgroup.Group
+
+	// pressureMonitor watches etcd for latency/quota pressure; it's shared
+	// by all users of this environment so that every service sheds load
+	// based on the same signal (see GetPressureMonitor)
+	pressureMonitor     *pressure.Monitor
+	pressureMonitorOnce sync.Once
+
+	// metadataBackend is the store behind pachd's metadata collections,
+	// selected by the METADATA_BACKEND config (see GetMetadataBackend)
+	metadataBackend     col.Backend
+	metadataBackendErr  error
+	metadataBackendOnce sync.Once
 }
 
 // InitPachOnlyEnv initializes this service environment. This dials a GRPC
@@ -62,6 +80,11 @@ type ServiceEnv struct {
 // until the client is ready.
 func InitPachOnlyEnv(config *Configuration) *ServiceEnv {
 	env := &ServiceEnv{Configuration: config}
+	// Configure the content-hash algorithms before anything can hash (the
+	// client library itself doesn't read the environment)
+	if err := pfs.SetHashAlgorithms(config.StorageHashAlgorithm, config.StorageLegacyHashAlgorithm); err != nil {
+		log.Fatalf("invalid storage hash configuration: %v", err)
+	}
 	env.pachAddress = net.JoinHostPort("127.0.0.1", fmt.Sprintf("%d", env.PeerPort))
 	env.pachEg.Go(env.initPachClient)
 	return env // env is not ready yet
@@ -181,6 +204,43 @@ func (env *ServiceEnv) GetEtcdClient() *etcd.Client {
 	return env.etcdClient
 }
 
+// GetMetadataBackend returns the store behind pachd's metadata collections:
+// the shared etcd client by default, or a postgres connection if the
+// environment sets METADATA_BACKEND=postgres. Like GetEtcdClient, it panics
+// if the backend can't be reached, as there's no sensible way to recover.
+func (env *ServiceEnv) GetMetadataBackend() col.Backend {
+	env.metadataBackendOnce.Do(func() {
+		if env.MetadataBackend != "postgres" {
+			env.metadataBackend = col.NewEtcdBackend(env.GetEtcdClient())
+			return
+		}
+		db, err := sql.Open("postgres", fmt.Sprintf(
+			"host=%s port=%s dbname=%s user=%s password=%s sslmode=disable",
+			env.MetadataPostgresHost, env.MetadataPostgresPort,
+			env.MetadataPostgresDatabase, env.MetadataPostgresUser,
+			env.MetadataPostgresPassword))
+		if err != nil {
+			env.metadataBackendErr = err
+			return
+		}
+		env.metadataBackend, env.metadataBackendErr = col.NewPostgresBackend(db)
+	})
+	if env.metadataBackendErr != nil {
+		panic(env.metadataBackendErr) // If env can't connect, there's no sensible way to recover
+	}
+	return env.metadataBackend
+}
+
+// GetPressureMonitor returns the environment's shared etcd pressure monitor,
+// starting it on first use. Like GetEtcdClient, this blocks until the etcd
+// client is ready.
+func (env *ServiceEnv) GetPressureMonitor() *pressure.Monitor {
+	env.pressureMonitorOnce.Do(func() {
+		env.pressureMonitor = pressure.NewMonitor(env.GetEtcdClient())
+	})
+	return env.pressureMonitor
+}
+
 // GetKubeClient returns the already connected Kubernetes API client without
 // modification.
 func (env *ServiceEnv) GetKubeClient() *kube.Clientset {
@@ -9,7 +9,6 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
-	"syscall"
 
 	pachclient "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -29,6 +28,8 @@ type Puller struct {
 	pipes map[string]bool
 	// cleaned signals if the cleanup goroutine has been started
 	cleaned bool
+	// mounts contains unmount functions for lazily mounted inputs
+	mounts []func() error
 	// wg is used to wait for all goroutines associated with this Puller
 	// to complete.
 	wg sync.WaitGroup
@@ -59,7 +60,7 @@ func (p *Puller) makePipe(path string, f func(io.Writer) error) error {
 	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
 		return err
 	}
-	if err := syscall.Mkfifo(path, 0666); err != nil {
+	if err := makeFifo(path); err != nil {
 		return err
 	}
 	func() {
@@ -173,6 +174,12 @@ func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, f
 		if fileInfo.FileType == pfs.FileType_DIR {
 			return os.MkdirAll(path, 0700)
 		}
+		if fileInfo.SymlinkTarget != "" {
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				return err
+			}
+			return os.Symlink(fileInfo.SymlinkTarget, path)
+		}
 		if pipes {
 			return p.makePipe(path, func(w io.Writer) error {
 				return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, w)
@@ -184,9 +191,17 @@ func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, f
 		eg.Go(func() (retErr error) {
 			limiter.Acquire()
 			defer limiter.Release()
-			return p.makeFile(path, func(w io.Writer) error {
+			if err := p.makeFile(path, func(w io.Writer) error {
 				return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, w)
-			})
+			}); err != nil {
+				return err
+			}
+			if fileInfo.Mode != 0 {
+				// Restore the mode bits (e.g. executable bits) the file was
+				// uploaded with.
+				return os.Chmod(path, os.FileMode(fileInfo.Mode))
+			}
+			return nil
 		})
 		return nil
 	}); err != nil {
@@ -330,7 +345,7 @@ func (p *Puller) CleanUp() (int64, error) {
 		defer p.Unlock()
 		p.cleaned = true
 		for path := range p.pipes {
-			f, err := os.OpenFile(path, syscall.O_NONBLOCK+os.O_RDONLY, os.ModeNamedPipe)
+			f, err := openFifoNonBlocking(path)
 			if err != nil && result == nil {
 				result = err
 			}
@@ -348,6 +363,20 @@ func (p *Puller) CleanUp() (int64, error) {
 			result = err
 		}
 	}
+
+	// Unmount any lazily mounted inputs
+	mounts := func() []func() error {
+		p.Lock()
+		defer p.Unlock()
+		mounts := p.mounts
+		p.mounts = nil
+		return mounts
+	}()
+	for _, unmount := range mounts {
+		if err := unmount(); err != nil && result == nil {
+			result = err
+		}
+	}
 	size := p.size
 	p.size = 0
 	return size, result
@@ -376,6 +405,8 @@ func Push(client *pachclient.APIClient, root string, commit *pfs.Commit, overwri
 			if err != nil {
 				return err
 			}
+			// PFS paths are always slash-separated, even on Windows.
+			relPath = filepath.ToSlash(relPath)
 
 			if overwrite {
 				if err := client.DeleteFile(commit.Repo.Name, commit.ID, relPath); err != nil {
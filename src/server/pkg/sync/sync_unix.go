@@ -0,0 +1,42 @@
+// +build !windows
+
+package sync
+
+import (
+	"os"
+	"syscall"
+
+	pachclient "github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pfs/fuse"
+)
+
+// MountLazy mounts the subtree of repo@commit rooted at file at mountPoint
+// via FUSE. Nothing is downloaded upfront; directory listings and reads are
+// served out of PFS as they happen, so a reader that only touches a small
+// slice of a large file only downloads that slice. The mount is torn down by
+// CleanUp.
+func (p *Puller) MountLazy(client *pachclient.APIClient, mountPoint string, repo, commit, file string) error {
+	if err := os.MkdirAll(mountPoint, 0700); err != nil {
+		return err
+	}
+	server, err := fuse.MountSubtree(client, mountPoint, pachclient.NewFile(repo, commit, file))
+	if err != nil {
+		return err
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.mounts = append(p.mounts, server.Unmount)
+	return nil
+}
+
+// makeFifo creates a named pipe at path.
+func makeFifo(path string) error {
+	return syscall.Mkfifo(path, 0666)
+}
+
+// openFifoNonBlocking opens the named pipe at path for reading without
+// blocking on a writer, which unblocks the goroutines that are waiting to
+// write to it.
+func openFifoNonBlocking(path string) (*os.File, error) {
+	return os.OpenFile(path, syscall.O_NONBLOCK+os.O_RDONLY, os.ModeNamedPipe)
+}
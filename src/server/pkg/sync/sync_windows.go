@@ -0,0 +1,28 @@
+// +build windows
+
+package sync
+
+import (
+	"fmt"
+	"os"
+
+	pachclient "github.com/pachyderm/pachyderm/src/client"
+)
+
+// MountLazy is unreachable on Windows; workers, the only callers, always run
+// in Linux containers. The stub exists so that the package builds there.
+func (p *Puller) MountLazy(client *pachclient.APIClient, mountPoint string, repo, commit, file string) error {
+	return fmt.Errorf("lazy input mounts are not supported on Windows")
+}
+
+// makeFifo returns an error, Windows has no named pipes in the filesystem
+// namespace so lazy files can't be supported there.
+func makeFifo(path string) error {
+	return fmt.Errorf("lazy files are not supported on Windows")
+}
+
+// openFifoNonBlocking is unreachable on Windows because makeFifo never
+// succeeds, so no pipes are ever registered with the Puller.
+func openFifoNonBlocking(path string) (*os.File, error) {
+	return nil, fmt.Errorf("lazy files are not supported on Windows")
+}
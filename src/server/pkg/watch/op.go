@@ -2,16 +2,32 @@ package watch
 
 import etcd "github.com/coreos/etcd/clientv3"
 
-// OpOption is a simple typedef for etcd.OpOption.
-type OpOption etcd.OpOption
+// OpOption modifies how a watch is issued. It carries the underlying
+// etcd.OpOption alongside flags describing it, so that coalesced watches
+// (see shared.go) can apply per-subscriber options client-side instead of
+// passing them to etcd, where they'd affect every subscriber on the shared
+// stream.
+type OpOption struct {
+	op        etcd.OpOption
+	filterPut bool
+	coalesce  bool
+}
 
 // WithPrevKV gets the previous key-value pair before the event happens. If the previous KV is already compacted,
 // nothing will be returned.
 func WithPrevKV() OpOption {
-	return OpOption(etcd.WithPrevKV())
+	return OpOption{op: etcd.WithPrevKV()}
 }
 
 // WithFilterPut discards PUT events from the watcher.
 func WithFilterPut() OpOption {
-	return OpOption(etcd.WithFilterPut())
+	return OpOption{op: etcd.WithFilterPut(), filterPut: true}
+}
+
+// WithCoalesce routes the watch through the process's shared watch stream
+// for the watched keyspace instead of opening a dedicated etcd watch. Use it
+// for watches that exist in large numbers (e.g. one per pipeline or per
+// job), where dedicated streams would stampede etcd after a restart.
+func WithCoalesce() OpOption {
+	return OpOption{coalesce: true}
 }
@@ -0,0 +1,334 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+)
+
+// This file implements coalesced watches (see WithCoalesce). Instead of one
+// etcd watch stream per watcher, all coalesced watchers under the same
+// top-level etcd prefix (e.g. "pachyderm_pps/") share a single stream, and
+// events are fanned out to subscribers client-side. The shared stream tracks
+// the last revision it has seen and resumes from there when etcd drops the
+// stream, so a pachd restart or etcd leader election costs one watch
+// re-establishment per keyspace rather than one per pipeline/job/worker.
+
+// hubs holds one hub per etcd client; watches issued through the same client
+// coalesce with each other.
+var (
+	hubsMu sync.Mutex
+	hubs   = make(map[*etcd.Client]*hub)
+)
+
+type hub struct {
+	client  *etcd.Client
+	mu      sync.Mutex
+	streams map[string]*sharedStream
+}
+
+// newSharedWatcher subscribes to the shared stream for prefix's keyspace,
+// creating the stream if it doesn't exist yet. It has the same semantics as
+// NewWatcher: the watcher first delivers the current contents of the prefix,
+// then any subsequent events.
+func newSharedWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message, opts ...OpOption) (Watcher, error) {
+	hubsMu.Lock()
+	h, ok := hubs[client]
+	if !ok {
+		h = &hub{client: client, streams: make(map[string]*sharedStream)}
+		hubs[client] = h
+	}
+	hubsMu.Unlock()
+	return h.watch(ctx, trimPrefix, prefix, template, opts...)
+}
+
+// streamRoot returns the keyspace that a watch on 'prefix' coalesces under:
+// the first path component of the prefix. All of a service's collections
+// live under one such component (e.g. "pachyderm_pps/pipelines",
+// "pachyderm_pps/jobs"), so this puts every coalesced watch a service issues
+// on a single stream.
+func streamRoot(prefix string) string {
+	if i := strings.Index(prefix, "/"); i >= 0 {
+		return prefix[:i+1]
+	}
+	return prefix
+}
+
+func (h *hub) watch(ctx context.Context, trimPrefix, prefix string, template proto.Message, opts ...OpOption) (Watcher, error) {
+	var filterPut bool
+	for _, opt := range opts {
+		filterPut = filterPut || opt.filterPut
+	}
+	sub := &subscriber{
+		prefix:     prefix,
+		trimPrefix: trimPrefix,
+		template:   template,
+		filterPut:  filterPut,
+		gated:      true,
+		notify:     make(chan struct{}, 1),
+		eventCh:    make(chan *Event),
+		done:       make(chan struct{}),
+	}
+
+	root := streamRoot(prefix)
+	h.mu.Lock()
+	stream, ok := h.streams[root]
+	if !ok {
+		streamCtx, cancel := context.WithCancel(context.Background())
+		stream = &sharedStream{
+			hub:    h,
+			root:   root,
+			ctx:    streamCtx,
+			cancel: cancel,
+			subs:   make(map[*subscriber]struct{}),
+		}
+		h.streams[root] = stream
+		go stream.run()
+	}
+	stream.mu.Lock()
+	stream.subs[sub] = struct{}{}
+	stream.mu.Unlock()
+	h.mu.Unlock()
+
+	// List the current contents of the prefix. The subscriber is gated until
+	// the list completes: events that arrive in the meantime are buffered,
+	// and any that the list already reflects (rev <= the list's revision)
+	// are dropped when the gate opens, so nothing is missed or duplicated.
+	resp, err := h.client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	if err != nil {
+		stream.unsubscribe(sub)
+		return nil, err
+	}
+	var listEvents []*Event
+	for _, etcdKv := range resp.Kvs {
+		listEvents = append(listEvents, &Event{
+			Key:      bytes.TrimPrefix(etcdKv.Key, []byte(trimPrefix)),
+			Value:    etcdKv.Value,
+			Type:     EventPut,
+			Rev:      etcdKv.ModRevision,
+			Template: template,
+		})
+	}
+	sub.open(listEvents, resp.Header.Revision)
+
+	go sub.deliver(ctx, stream)
+	return sub, nil
+}
+
+// sharedStream is a single etcd watch stream serving every coalesced watcher
+// under one keyspace.
+type sharedStream struct {
+	hub    *hub
+	root   string
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+	// lastRev is the highest etcd revision the stream has seen; when the
+	// stream drops it resumes from lastRev+1 rather than re-reading the
+	// world.
+	lastRev int64
+}
+
+func (s *sharedStream) run() {
+	for {
+		opts := []etcd.OpOption{etcd.WithPrefix(), etcd.WithPrevKV()}
+		if s.lastRev > 0 {
+			opts = append(opts, etcd.WithRev(s.lastRev+1))
+		}
+		etcdWatcher := etcd.NewWatcher(s.hub.client)
+		rch := etcdWatcher.Watch(s.ctx, s.root, opts...)
+		for resp := range rch {
+			if err := resp.Err(); err != nil {
+				etcdWatcher.Close()
+				s.fail(err)
+				return
+			}
+			for _, etcdEv := range resp.Events {
+				s.broadcast(etcdEv)
+			}
+			if resp.Header.Revision > s.lastRev {
+				s.lastRev = resp.Header.Revision
+			}
+		}
+		etcdWatcher.Close()
+		if s.ctx.Err() != nil {
+			return
+		}
+		// The stream was dropped (e.g. etcd restarted); resume from the last
+		// revision we saw. Rate-limit and jitter the re-establishment the
+		// same way dedicated watchers do, so that many processes coming back
+		// at once don't knock etcd over again.
+		reestablishLimiter.Acquire()
+		time.Sleep(time.Duration(rand.Intn(1000)) * time.Millisecond)
+		reestablishLimiter.Release()
+	}
+}
+
+func (s *sharedStream) broadcast(etcdEv *etcd.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if !strings.HasPrefix(string(etcdEv.Kv.Key), sub.prefix) {
+			continue
+		}
+		if sub.filterPut && etcdEv.Type == etcd.EventTypePut {
+			continue
+		}
+		ev := &Event{
+			Key:      bytes.TrimPrefix(etcdEv.Kv.Key, []byte(sub.trimPrefix)),
+			Value:    etcdEv.Kv.Value,
+			Rev:      etcdEv.Kv.ModRevision,
+			Template: sub.template,
+		}
+		if etcdEv.PrevKv != nil {
+			ev.PrevKey = bytes.TrimPrefix(etcdEv.PrevKv.Key, []byte(sub.trimPrefix))
+			ev.PrevValue = etcdEv.PrevKv.Value
+		}
+		if etcdEv.Type == etcd.EventTypePut {
+			ev.Type = EventPut
+		} else {
+			ev.Type = EventDelete
+		}
+		sub.enqueue(ev)
+	}
+}
+
+// fail delivers 'err' to every subscriber and tears the stream down; the
+// next coalesced watch on this keyspace will start a fresh stream.
+func (s *sharedStream) fail(err error) {
+	s.hub.mu.Lock()
+	delete(s.hub.streams, s.root)
+	s.hub.mu.Unlock()
+	s.cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		sub.enqueue(&Event{Type: EventError, Err: err})
+	}
+	s.subs = make(map[*subscriber]struct{})
+}
+
+func (s *sharedStream) unsubscribe(sub *subscriber) {
+	s.mu.Lock()
+	delete(s.subs, sub)
+	empty := len(s.subs) == 0
+	s.mu.Unlock()
+	if empty {
+		s.hub.mu.Lock()
+		// Recheck under the hub's lock so a concurrent subscriber doesn't
+		// get attached to a stream we're about to cancel.
+		s.mu.Lock()
+		if len(s.subs) == 0 {
+			delete(s.hub.streams, s.root)
+			s.cancel()
+		}
+		s.mu.Unlock()
+		s.hub.mu.Unlock()
+	}
+}
+
+// subscriber is a single coalesced watcher. It implements Watcher. Events
+// are buffered in an unbounded queue so that one slow consumer can't stall
+// the shared stream for everyone else.
+type subscriber struct {
+	prefix     string
+	trimPrefix string
+	template   proto.Message
+	filterPut  bool
+
+	mu sync.Mutex
+	// gated is true until the initial listing completes; while gated,
+	// incoming events accumulate in pending.
+	gated   bool
+	pending []*Event
+	queue   []*Event
+	// minRev is the revision of the initial listing; stream events at or
+	// below it are already reflected in the listing and are dropped.
+	minRev int64
+	notify chan struct{}
+
+	eventCh chan *Event
+	done    chan struct{}
+}
+
+func (sub *subscriber) Watch() <-chan *Event {
+	return sub.eventCh
+}
+
+func (sub *subscriber) Close() {
+	close(sub.done)
+}
+
+func (sub *subscriber) enqueue(ev *Event) {
+	sub.mu.Lock()
+	if sub.gated {
+		sub.pending = append(sub.pending, ev)
+	} else if ev.Rev > sub.minRev || ev.Type == EventError {
+		sub.queue = append(sub.queue, ev)
+	}
+	sub.mu.Unlock()
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+// open ungates the subscriber: the initial listing is queued first, followed
+// by any buffered events newer than the listing.
+func (sub *subscriber) open(listEvents []*Event, listRev int64) {
+	sub.mu.Lock()
+	sub.minRev = listRev
+	sub.queue = listEvents
+	for _, ev := range sub.pending {
+		if ev.Rev > listRev || ev.Type == EventError {
+			sub.queue = append(sub.queue, ev)
+		}
+	}
+	sub.pending = nil
+	sub.gated = false
+	sub.mu.Unlock()
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (sub *subscriber) deliver(ctx context.Context, stream *sharedStream) {
+	defer func() {
+		stream.unsubscribe(sub)
+		close(sub.eventCh)
+	}()
+	for {
+		sub.mu.Lock()
+		queue := sub.queue
+		sub.queue = nil
+		sub.mu.Unlock()
+		for _, ev := range queue {
+			select {
+			case sub.eventCh <- ev:
+			case <-sub.done:
+				return
+			case <-ctx.Done():
+				return
+			}
+			if ev.Type == EventError {
+				return
+			}
+		}
+		select {
+		case <-sub.notify:
+		case <-sub.done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
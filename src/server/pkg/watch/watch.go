@@ -7,12 +7,23 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/rand"
 	"reflect"
+	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/limit"
 )
 
+// reestablishLimiter bounds how many watches may be re-established
+// concurrently. When etcd drops its watch channels (e.g. a leader election
+// or a restart under memory pressure), every watcher in the process notices
+// at once; re-issuing all of those watches simultaneously can knock over an
+// etcd that's just coming back. Combined with the jitter below, this brings
+// watches back in staggered batches instead.
+var reestablishLimiter = limit.New(20)
+
 // EventType is the type of event
 type EventType int
 
@@ -94,6 +105,11 @@ func (s byModRev) Less(i, j int) bool {
 
 // NewWatcher watches a given etcd prefix for events.
 func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message, opts ...OpOption) (Watcher, error) {
+	for _, opt := range opts {
+		if opt.coalesce {
+			return newSharedWatcher(ctx, client, trimPrefix, prefix, template, opts...)
+		}
+	}
 	eventCh := make(chan *Event)
 	done := make(chan struct{})
 	// First list the collection to get the current items
@@ -112,7 +128,9 @@ func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix str
 	// we won't miss any items.
 	options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
 	for _, opt := range opts {
-		options = append(options, etcd.OpOption(opt))
+		if opt.op != nil {
+			options = append(options, opt.op)
+		}
 	}
 	rch := etcdWatcher.Watch(ctx, prefix, options...)
 
@@ -151,8 +169,11 @@ func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix str
 				if err := etcdWatcher.Close(); err != nil {
 					return err
 				}
+				reestablishLimiter.Acquire()
+				time.Sleep(time.Duration(rand.Intn(1000)) * time.Millisecond)
 				etcdWatcher = etcd.NewWatcher(client)
 				rch = etcdWatcher.Watch(ctx, prefix, etcd.WithPrefix(), etcd.WithRev(nextRevision))
+				reestablishLimiter.Release()
 				continue
 			}
 			if err := resp.Err(); err != nil {
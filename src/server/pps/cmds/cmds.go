@@ -1,6 +1,7 @@
 package cmds
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,22 +11,33 @@ import (
 	"os/user"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
+	"unicode/utf8"
 
 	units "github.com/docker/go-units"
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	pachdclient "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/lineage"
+	pkgpretty "github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pps/pretty"
+	workerpkg "github.com/pachyderm/pachyderm/src/server/worker"
+	"github.com/sergi/go-diff/diffmatchpatch"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -100,6 +112,7 @@ If the job fails, the output commit will not be populated with data.`,
 	var pipelineName string
 	var outputCommitStr string
 	var inputCommitStrs []string
+	var pending bool
 	listJob := &cobra.Command{
 		Short: "Return info about jobs.",
 		Long:  "Return info about jobs.",
@@ -110,6 +123,9 @@ $ {{alias}}
 # Return all jobs in pipeline foo
 $ {{alias}} -p foo
 
+# Return jobs that haven't started running, with the reason they're pending
+$ {{alias}} --pending
+
 # Return all jobs whose input commits include foo@XXX and bar@YYY
 $ {{alias}} -i foo@XXX -i bar@YYY
 
@@ -137,14 +153,27 @@ $ {{alias}} -p foo -i bar@YYY`,
 
 			if raw {
 				return client.ListJobF(pipelineName, commits, outputCommit, func(ji *ppsclient.JobInfo) error {
+					if pending && ji.State != ppsclient.JobState_JOB_STARTING {
+						return nil
+					}
 					if err := marshaller.Marshal(os.Stdout, ji); err != nil {
 						return err
 					}
 					return nil
 				})
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.JobHeader)
+			header := pretty.JobHeader
+			if pending {
+				header = pretty.JobPendingHeader
+			}
+			writer := tabwriter.NewWriter(os.Stdout, header)
 			if err := client.ListJobF(pipelineName, commits, outputCommit, func(ji *ppsclient.JobInfo) error {
+				if pending {
+					if ji.State == ppsclient.JobState_JOB_STARTING {
+						pretty.PrintPendingJobInfo(writer, ji, fullTimestamps)
+					}
+					return nil
+				}
 				pretty.PrintJobInfo(writer, ji, fullTimestamps)
 				return nil
 			}); err != nil {
@@ -159,6 +188,7 @@ $ {{alias}} -p foo -i bar@YYY`,
 	listJob.MarkFlagCustom("output", "__pachctl_get_repo_commit")
 	listJob.Flags().StringSliceVarP(&inputCommitStrs, "input", "i", []string{}, "List jobs with a specific set of input commits. format: <repo>@<branch-or-commit>")
 	listJob.MarkFlagCustom("input", "__pachctl_get_repo_commit")
+	listJob.Flags().BoolVar(&pending, "pending", false, "Show only jobs that haven't started running yet, with the reason they're pending.")
 	listJob.Flags().AddFlagSet(rawFlags)
 	listJob.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(listJob, "list job"))
@@ -213,42 +243,239 @@ $ {{alias}} foo@XXX -p bar -p baz`,
 	flushJob.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(flushJob, "flush job"))
 
+	var deleteJobPipeline string
+	var deleteJobStates []string
+	var olderThan string
 	deleteJob := &cobra.Command{
-		Use:   "{{alias}} <job>",
+		Use:   "{{alias}} [<job>]",
 		Short: "Delete a job.",
-		Long:  "Delete a job.",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+		Long: `Delete a job.
+
+With no job argument, deletes every finished job matching all of the
+--pipeline, --state and --older-than filters (at least one must be given), so
+that mass cleanups don't require scripting around 'list job'.`,
+		Example: `
+# Delete all of edges' failed jobs
+$ {{alias}} --pipeline edges --state failure
+
+# Delete every job that started more than 30 days ago
+$ {{alias}} --older-than 30d`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
-			if err := client.DeleteJob(args[0]); err != nil {
-				cmdutil.ErrorAndExit("error from DeleteJob: %s", err.Error())
+			request := &ppsclient.DeleteJobRequest{}
+			if len(args) > 0 {
+				if deleteJobPipeline != "" || len(deleteJobStates) > 0 || olderThan != "" {
+					return fmt.Errorf("cannot specify both a job and job filters")
+				}
+				request.Job = pachdclient.NewJob(args[0])
+			} else {
+				if deleteJobPipeline == "" && len(deleteJobStates) == 0 && olderThan == "" {
+					return fmt.Errorf("must specify a job or at least one of --pipeline, --state and --older-than")
+				}
+				if deleteJobPipeline != "" {
+					request.Pipeline = pachdclient.NewPipeline(deleteJobPipeline)
+				}
+				if request.States, err = parseJobStates(deleteJobStates); err != nil {
+					return err
+				}
+				if olderThan != "" {
+					duration, err := parseDuration(olderThan)
+					if err != nil {
+						return err
+					}
+					request.OlderThan = types.DurationProto(duration)
+				}
+			}
+			if _, err := client.PpsAPIClient.DeleteJob(client.Ctx(), request); err != nil {
+				cmdutil.ErrorAndExit("error from DeleteJob: %s", grpcutil.ScrubGRPC(err).Error())
 			}
 			return nil
 		}),
 	}
+	deleteJob.Flags().StringVarP(&deleteJobPipeline, "pipeline", "p", "", "Delete jobs created by this pipeline.")
+	deleteJob.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
+	deleteJob.Flags().StringSliceVar(&deleteJobStates, "state", nil, "Delete only jobs in this state (e.g. \"failure\"); can be specified multiple times.")
+	deleteJob.Flags().StringVar(&olderThan, "older-than", "", "Delete only jobs that started more than this long ago (e.g. \"720h\" or \"30d\").")
 	commands = append(commands, cmdutil.CreateAlias(deleteJob, "delete job"))
 
+	var stopJobPipeline string
+	var stopJobStates []string
 	stopJob := &cobra.Command{
-		Use:   "{{alias}} <job>",
+		Use:   "{{alias}} [<job>]",
 		Short: "Stop a job.",
-		Long:  "Stop a job.  The job will be stopped immediately.",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+		Long: `Stop a job.  The job will be stopped immediately.
+
+With no job argument, stops every unfinished job matching all of the
+--pipeline and --state filters (at least one must be given).`,
+		Example: `
+# Stop all of edges' running jobs
+$ {{alias}} --pipeline edges --state running`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
-			if err := client.StopJob(args[0]); err != nil {
-				cmdutil.ErrorAndExit("error from StopJob: %s", err.Error())
+			request := &ppsclient.StopJobRequest{}
+			if len(args) > 0 {
+				if stopJobPipeline != "" || len(stopJobStates) > 0 {
+					return fmt.Errorf("cannot specify both a job and job filters")
+				}
+				request.Job = pachdclient.NewJob(args[0])
+			} else {
+				if stopJobPipeline == "" && len(stopJobStates) == 0 {
+					return fmt.Errorf("must specify a job or at least one of --pipeline and --state")
+				}
+				if stopJobPipeline != "" {
+					request.Pipeline = pachdclient.NewPipeline(stopJobPipeline)
+				}
+				if request.States, err = parseJobStates(stopJobStates); err != nil {
+					return err
+				}
+			}
+			if _, err := client.PpsAPIClient.StopJob(client.Ctx(), request); err != nil {
+				cmdutil.ErrorAndExit("error from StopJob: %s", grpcutil.ScrubGRPC(err).Error())
 			}
 			return nil
 		}),
 	}
+	stopJob.Flags().StringVarP(&stopJobPipeline, "pipeline", "p", "", "Stop jobs created by this pipeline.")
+	stopJob.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
+	stopJob.Flags().StringSliceVar(&stopJobStates, "state", nil, "Stop only jobs in this state (e.g. \"running\"); can be specified multiple times.")
 	commands = append(commands, cmdutil.CreateAlias(stopJob, "stop job"))
 
+	var showContent bool
+	diffJob := &cobra.Command{
+		Use:   "{{alias}} <new-job> <old-job>",
+		Short: "Compare the outputs of two jobs.",
+		Long: `Compare the outputs of two jobs.
+
+Lists files that exist in only one job's output and files whose content
+differs, and compares the jobs' datum counts and process stats. Useful for
+validating that a pipeline refactor produces equivalent output; the jobs
+don't have to be from the same pipeline.`,
+		Example: `
+# Compare a refactored pipeline's job against a job of the original version
+$ {{alias}} 5f93d03b65fa41c49318e8be0881803b 7f30cc3b6ef44456835481e10350fe16
+
+# Also print content diffs for text files that changed
+$ {{alias}} 5f93d03b65fa41c49318e8be0881803b 7f30cc3b6ef44456835481e10350fe16 --content`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			newJob, err := client.InspectJob(args[0], false)
+			if err != nil {
+				return err
+			}
+			oldJob, err := client.InspectJob(args[1], false)
+			if err != nil {
+				return err
+			}
+			if newJob.OutputCommit == nil || oldJob.OutputCommit == nil {
+				return fmt.Errorf("both jobs must have output commits")
+			}
+			newFiles, oldFiles, err := client.DiffFile(
+				newJob.OutputCommit.Repo.Name, newJob.OutputCommit.ID, "/",
+				oldJob.OutputCommit.Repo.Name, oldJob.OutputCommit.ID, "/",
+				false,
+			)
+			if err != nil {
+				return err
+			}
+			// Regular files appearing in both diffs changed; the rest exist in
+			// only one job's output
+			oldByPath := make(map[string]bool)
+			for _, fileInfo := range oldFiles {
+				if fileInfo.FileType == pfs.FileType_FILE {
+					oldByPath[fileInfo.File.Path] = true
+				}
+			}
+			var added, deleted, changed []string
+			for _, fileInfo := range newFiles {
+				if fileInfo.FileType != pfs.FileType_FILE {
+					continue
+				}
+				if oldByPath[fileInfo.File.Path] {
+					changed = append(changed, fileInfo.File.Path)
+					delete(oldByPath, fileInfo.File.Path)
+				} else {
+					added = append(added, fileInfo.File.Path)
+				}
+			}
+			for filePath := range oldByPath {
+				deleted = append(deleted, filePath)
+			}
+			sort.Strings(added)
+			sort.Strings(deleted)
+			sort.Strings(changed)
+			if len(added)+len(deleted)+len(changed) == 0 {
+				fmt.Println("Outputs are identical.")
+			}
+			if len(added) > 0 {
+				fmt.Printf("Only in %s:\n", args[0])
+				for _, filePath := range added {
+					fmt.Printf("  %s\n", filePath)
+				}
+			}
+			if len(deleted) > 0 {
+				fmt.Printf("Only in %s:\n", args[1])
+				for _, filePath := range deleted {
+					fmt.Printf("  %s\n", filePath)
+				}
+			}
+			if len(changed) > 0 {
+				fmt.Println("Changed:")
+				for _, filePath := range changed {
+					fmt.Printf("  %s\n", filePath)
+				}
+			}
+			if showContent {
+				dmp := diffmatchpatch.New()
+				for _, filePath := range changed {
+					var newBuf, oldBuf bytes.Buffer
+					if err := client.GetFile(newJob.OutputCommit.Repo.Name, newJob.OutputCommit.ID, filePath, 0, 0, &newBuf); err != nil {
+						return err
+					}
+					if err := client.GetFile(oldJob.OutputCommit.Repo.Name, oldJob.OutputCommit.ID, filePath, 0, 0, &oldBuf); err != nil {
+						return err
+					}
+					fmt.Printf("\ndiff %s\n", filePath)
+					if !utf8.Valid(newBuf.Bytes()) || !utf8.Valid(oldBuf.Bytes()) ||
+						bytes.IndexByte(newBuf.Bytes(), 0) != -1 || bytes.IndexByte(oldBuf.Bytes(), 0) != -1 {
+						fmt.Println("(binary files differ)")
+						continue
+					}
+					diffs := dmp.DiffMain(oldBuf.String(), newBuf.String(), true)
+					diffs = dmp.DiffCleanupSemantic(diffs)
+					fmt.Println(dmp.DiffPrettyText(diffs))
+				}
+			}
+			fmt.Println("Stats:")
+			writer := tabwriter.NewWriter(os.Stdout, fmt.Sprintf("STAT\t%s\t%s\t\n", args[0], args[1]))
+			fmt.Fprintf(writer, "Processed\t%d\t%d\t\n", newJob.DataProcessed, oldJob.DataProcessed)
+			fmt.Fprintf(writer, "Skipped\t%d\t%d\t\n", newJob.DataSkipped, oldJob.DataSkipped)
+			fmt.Fprintf(writer, "Failed\t%d\t%d\t\n", newJob.DataFailed, oldJob.DataFailed)
+			fmt.Fprintf(writer, "Total\t%d\t%d\t\n", newJob.DataTotal, oldJob.DataTotal)
+			if newJob.Stats != nil && oldJob.Stats != nil {
+				fmt.Fprintf(writer, "Downloaded\t%s\t%s\t\n", pkgpretty.Size(newJob.Stats.DownloadBytes), pkgpretty.Size(oldJob.Stats.DownloadBytes))
+				fmt.Fprintf(writer, "Uploaded\t%s\t%s\t\n", pkgpretty.Size(newJob.Stats.UploadBytes), pkgpretty.Size(oldJob.Stats.UploadBytes))
+				fmt.Fprintf(writer, "Download Time\t%s\t%s\t\n", pkgpretty.Duration(newJob.Stats.DownloadTime), pkgpretty.Duration(oldJob.Stats.DownloadTime))
+				fmt.Fprintf(writer, "Process Time\t%s\t%s\t\n", pkgpretty.Duration(newJob.Stats.ProcessTime), pkgpretty.Duration(oldJob.Stats.ProcessTime))
+				fmt.Fprintf(writer, "Upload Time\t%s\t%s\t\n", pkgpretty.Duration(newJob.Stats.UploadTime), pkgpretty.Duration(oldJob.Stats.UploadTime))
+			}
+			return writer.Flush()
+		}),
+	}
+	diffJob.Flags().BoolVar(&showContent, "content", false, "Print content diffs for text files that changed (binary files are only flagged).")
+	commands = append(commands, cmdutil.CreateAlias(diffJob, "diff job"))
+
 	datumDocs := &cobra.Command{
 		Short: "Docs for datums.",
 		Long: `Datums are the small independent units of processing for Pachyderm jobs.
@@ -291,30 +518,76 @@ each datum.`,
 
 	var pageSize int64
 	var page int64
+	var failedOnly bool
+	var showReason bool
+	var specPath string
+	var sample int
 	listDatum := &cobra.Command{
 		Use:   "{{alias}} <job>",
 		Short: "Return the datums in a job.",
-		Long:  "Return the datums in a job.",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+		Long: `Return the datums in a job.
+
+With -f, no job is needed: the datums that a pipeline spec *would* process
+are enumerated from the current heads of its input branches, without creating
+the pipeline. This is for checking that a glob/cross/union expression produces
+the expected datums; --sample additionally materializes the first N datums'
+file layouts (empty files, laid out as the user code would see them under
+/pfs) in ./pfs-sample.`,
+		Example: `
+# Return the datums in the job aedfa12aedf
+$ {{alias}} aedfa12aedf
+
+# Enumerate the datums that pipeline.json would process
+$ {{alias}} -f pipeline.json
+
+# Also materialize the first 10 datums' file layouts under ./pfs-sample
+$ {{alias}} -f pipeline.json --sample 10`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
+			if specPath != "" {
+				if len(args) != 0 {
+					return fmt.Errorf("cannot provide both a job and a pipeline spec")
+				}
+				return dryRunDatums(client, specPath, sample)
+			}
+			if sample != 0 {
+				return fmt.Errorf("--sample requires a pipeline spec (-f)")
+			}
+			if len(args) != 1 {
+				return fmt.Errorf("must provide a job or a pipeline spec (-f)")
+			}
 			if pageSize < 0 {
 				return fmt.Errorf("pageSize must be zero or positive")
 			}
 			if page < 0 {
 				return fmt.Errorf("page must be zero or positive")
 			}
+			if showReason {
+				// there's no reason to show for datums that didn't fail
+				failedOnly = true
+			}
 			if raw {
 				return client.ListDatumF(args[0], pageSize, page, func(di *ppsclient.DatumInfo) error {
+					if failedOnly && di.State != ppsclient.DatumState_FAILED {
+						return nil
+					}
 					return marshaller.Marshal(os.Stdout, di)
 				})
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.DatumHeader)
+			header := pretty.DatumHeader
+			if showReason {
+				header = pretty.DatumReasonHeader
+			}
+			writer := tabwriter.NewWriter(os.Stdout, header)
 			if err := client.ListDatumF(args[0], pageSize, page, func(di *ppsclient.DatumInfo) error {
-				pretty.PrintDatumInfo(writer, di)
+				if failedOnly && di.State != ppsclient.DatumState_FAILED {
+					return nil
+				}
+				pretty.PrintDatumInfo(writer, di, showReason)
 				return nil
 			}); err != nil {
 				return err
@@ -324,6 +597,10 @@ each datum.`,
 	}
 	listDatum.Flags().Int64Var(&pageSize, "pageSize", 0, "Specify the number of results sent back in a single page")
 	listDatum.Flags().Int64Var(&page, "page", 0, "Specify the page of results to send")
+	listDatum.Flags().BoolVar(&failedOnly, "failed", false, "Show only failed datums")
+	listDatum.Flags().BoolVar(&showReason, "reason", false, "Show why each failed datum failed (implies --failed)")
+	listDatum.Flags().StringVarP(&specPath, "file", "f", "", "Enumerate the datums that this pipeline spec would process instead of a job's datums. It can be a url or local file. - reads from stdin.")
+	listDatum.Flags().IntVar(&sample, "sample", 0, "Materialize the first N datums' file layouts under ./pfs-sample (only with -f).")
 	listDatum.Flags().AddFlagSet(rawFlags)
 	commands = append(commands, cmdutil.CreateAlias(listDatum, "list datum"))
 
@@ -354,6 +631,7 @@ each datum.`,
 	var (
 		jobID       string
 		datumID     string
+		workerID    string
 		commaInputs string // comma-separated list of input files of interest
 		master      bool
 		follow      bool
@@ -394,7 +672,18 @@ $ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
 
 			// Issue RPC
 			marshaler := &jsonpb.Marshaler{}
-			iter := client.GetLogs(pipelineName, jobID, data, datumID, master, follow, tail)
+			iter := client.GetLogs(pipelineName, jobID, data, datumID, workerID, master, follow, tail)
+			// prefixLine tags a log line with the worker and datum it came
+			// from, so that merged logs from many workers stay attributable
+			prefixLine := func(msg *ppsclient.LogMessage) string {
+				if msg.WorkerID == "" {
+					return msg.Message
+				}
+				if msg.DatumID != "" {
+					return fmt.Sprintf("[%s/%s] %s", msg.WorkerID, msg.DatumID, msg.Message)
+				}
+				return fmt.Sprintf("[%s] %s", msg.WorkerID, msg.Message)
+			}
 			for iter.Next() {
 				var messageStr string
 				if raw {
@@ -405,7 +694,7 @@ $ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
 					}
 					fmt.Println(messageStr)
 				} else if iter.Message().User {
-					fmt.Println(iter.Message().Message)
+					fmt.Println(prefixLine(iter.Message()))
 				} else if iter.Message().Master && master {
 					fmt.Println(iter.Message().Message)
 				} else if pipelineName == "" && jobID == "" {
@@ -422,6 +711,7 @@ $ {{alias}} --pipeline=filter --inputs=/apple.txt,123aef`,
 		"this job (accepts job ID)")
 	getLogs.MarkFlagCustom("job", "__pachctl_get_job")
 	getLogs.Flags().StringVar(&datumID, "datum", "", "Filter for log lines for this datum (accepts datum ID)")
+	getLogs.Flags().StringVar(&workerID, "worker-id", "", "Filter for log lines from this worker (accepts a worker pod name, as shown by inspect job)")
 	getLogs.Flags().StringVar(&commaInputs, "inputs", "", "Filter for log lines "+
 		"generated while processing these files (accepts PFS paths or file hashes)")
 	getLogs.Flags().BoolVar(&master, "master", false, "Return log messages from the master process (pipeline must be set).")
@@ -448,14 +738,24 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	var registry string
 	var username string
 	var pipelinePath string
+	var fromTemplate string
+	var templateSets []string
 	createPipeline := &cobra.Command{
 		Short: "Create a new pipeline.",
 		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(!*noMetrics, !*noPortForwarding, false, build, pushImages, registry, username, pipelinePath, false)
+			if fromTemplate != "" {
+				return createPipelineFromTemplate(!*noMetrics, !*noPortForwarding, fromTemplate, templateSets)
+			}
+			if len(templateSets) > 0 {
+				return fmt.Errorf("--set is only valid with --from-template")
+			}
+			return pipelineHelper(!*noMetrics, !*noPortForwarding, false, build, pushImages, registry, username, pipelinePath, false, false, "", 0)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
+	createPipeline.Flags().StringVar(&fromTemplate, "from-template", "", "Instantiate the named pipeline template (see 'pachctl create template') instead of reading a spec from --file.")
+	createPipeline.Flags().StringSliceVar(&templateSets, "set", nil, "Set a field in the template before creating the pipeline, as <dotted.json.path>=<value> (e.g. --set input.pfs.repo=images). Can be specified multiple times. Only valid with --from-template.")
 	createPipeline.Flags().BoolVarP(&build, "build", "b", false, "If true, build and push local docker images into the docker registry.")
 	createPipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the docker registry.")
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
@@ -463,11 +763,14 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	commands = append(commands, cmdutil.CreateAlias(createPipeline, "create pipeline"))
 
 	var reprocess bool
+	var canary bool
+	var canarySampleBranch string
+	var canarySamplePercent int64
 	updatePipeline := &cobra.Command{
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(!*noMetrics, !*noPortForwarding, reprocess, build, pushImages, registry, username, pipelinePath, true)
+			return pipelineHelper(!*noMetrics, !*noPortForwarding, reprocess, build, pushImages, registry, username, pipelinePath, true, canary, canarySampleBranch, canarySamplePercent)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -476,8 +779,38 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	updatePipeline.Flags().BoolVar(&canary, "canary", false, "Run the new spec as a canary alongside the current version instead of replacing it. The canary writes to its own output repo (\"<pipeline>-canary\"); promote it with 'pachctl promote pipeline'.")
+	updatePipeline.Flags().StringVar(&canarySampleBranch, "canary-sample-branch", "", "Point the canary's PFS inputs at this branch instead of the branches the live pipeline reads. Only valid with --canary.")
+	updatePipeline.Flags().Int64Var(&canarySamplePercent, "canary-sample-percent", 0, "Make the canary process only this percentage of datums, selected deterministically. Only valid with --canary.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
+	promotePipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Promote a pipeline's canary or current version.",
+		Long: `Promote a pipeline's canary or current version.
+
+For a pipeline with a canary, the pipeline is updated to the spec its canary
+runs (with any canary sampling removed, so the promoted spec processes
+everything) and the canary pipeline is deleted.
+
+For a pipeline with versioned output, the current spec version is promoted:
+the pipeline's master branch starts following the current version's output
+branch ("v2", "v3", ...).`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if err := client.ServerSupports(version.CapabilityCanary); err != nil {
+				return err
+			}
+			return client.PromotePipeline(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(promotePipeline, "promote pipeline"))
+
+	var kubeEvents bool
 	inspectPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Return info about a pipeline.",
@@ -488,6 +821,20 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
+			if kubeEvents {
+				writer := tabwriter.NewWriter(os.Stdout, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE\t\n")
+				if err := client.GetKubeEvents(args[0], func(event *ppsclient.KubeEvent) error {
+					lastSeen := ""
+					if event.LastSeen != nil {
+						lastSeen = pkgpretty.Ago(event.LastSeen)
+					}
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t\n", lastSeen, event.Type, event.Reason, event.Object, event.Message)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return writer.Flush()
+			}
 			pipelineInfo, err := client.InspectPipeline(args[0])
 			if err != nil {
 				return err
@@ -505,6 +852,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			return pretty.PrintDetailedPipelineInfo(pi)
 		}),
 	}
+	inspectPipeline.Flags().BoolVar(&kubeEvents, "k8s-events", false, "Show recent kubernetes events for the pipeline's worker pods (image pull failures, scheduling failures, OOM kills, ...) instead of the pipeline's info.")
 	inspectPipeline.Flags().AddFlagSet(rawFlags)
 	inspectPipeline.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectPipeline, "inspect pipeline"))
@@ -595,6 +943,180 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	editPipeline.Flags().StringVar(&editor, "editor", "", "Editor to use for modifying the manifest.")
 	commands = append(commands, cmdutil.CreateAlias(editPipeline, "edit pipeline"))
 
+	explainPipeline := &cobra.Command{
+		Short: "Print a plain-English description of a pipeline spec.",
+		Long: `Print a plain-English description of a pipeline spec.
+
+Reads a pipeline spec (the same JSON passed to 'pachctl create pipeline') and
+describes what it will do: what triggers jobs, how datums will be formed from
+the inputs, how many workers will run, what resources each worker gets, and
+where output goes. Useful when reviewing a spec change.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
+			if err != nil {
+				return err
+			}
+			for {
+				request, err := cfgReader.NextCreatePipelineRequest()
+				if err == io.EOF {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				explainPipelineSpec(request)
+			}
+		}),
+	}
+	explainPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
+	commands = append(commands, cmdutil.CreateAlias(explainPipeline, "explain pipeline"))
+
+	var lintJSON bool
+	lintPipeline := &cobra.Command{
+		Short: "Check a pipeline spec against best-practice lint rules.",
+		Long: `Check a pipeline spec against best-practice lint rules.
+
+Reads a pipeline spec (the same JSON passed to 'pachctl create pipeline') and
+warns about patterns that usually indicate a mistake: parallelism wasted on a
+single-datum glob, a "latest" (or missing) image tag, no resource requests,
+and cron inputs whose repos grow without bound. Warnings do not make the
+command fail; pass --json to get machine-readable output for CI gating.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
+			if err != nil {
+				return err
+			}
+			var warnings []lintWarning
+			for {
+				request, err := cfgReader.NextCreatePipelineRequest()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					return err
+				}
+				warnings = append(warnings, lintPipelineSpec(request)...)
+			}
+			if lintJSON {
+				if warnings == nil {
+					warnings = []lintWarning{}
+				}
+				return json.NewEncoder(os.Stdout).Encode(warnings)
+			}
+			for _, warning := range warnings {
+				fmt.Printf("%s: %s: %s\n", warning.Pipeline, warning.Code, warning.Message)
+			}
+			return nil
+		}),
+	}
+	lintPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
+	lintPipeline.Flags().BoolVar(&lintJSON, "json", false, "Print warnings as a JSON array instead of human-readable lines.")
+	commands = append(commands, cmdutil.CreateAlias(lintPipeline, "lint pipeline"))
+
+	templateDocs := &cobra.Command{
+		Short: "Docs for pipeline templates.",
+		Long: `Pipeline templates are reusable pipeline specs stored in the cluster.
+
+A template is an ordinary pipeline spec, except that fields may be left out or
+given placeholder values; 'pachctl create pipeline --from-template <name>'
+fills fields in with --set before creating the pipeline. Templates live in the
+reserved "` + ppsconsts.TemplateRepo + `" repo, so they are versioned like any
+other data and shared by everyone using the cluster.`,
+	}
+	cmdutil.SetDocsUsage(templateDocs)
+	commands = append(commands, cmdutil.CreateAlias(templateDocs, "template"))
+
+	var templatePath string
+	createTemplate := &cobra.Command{
+		Use:   "{{alias}} <template>",
+		Short: "Create or update a pipeline template.",
+		Long:  "Create or update a pipeline template from a pipeline spec. The spec may leave fields out or use placeholder values; they are filled in with --set when the template is instantiated.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			var spec []byte
+			var err error
+			if templatePath == "-" {
+				fmt.Print("Reading from stdin.\n")
+				spec, err = ioutil.ReadAll(os.Stdin)
+			} else {
+				spec, err = ioutil.ReadFile(templatePath)
+			}
+			if err != nil {
+				return err
+			}
+			if !json.Valid(spec) {
+				return fmt.Errorf("template is not valid JSON")
+			}
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if err := client.CreateRepo(ppsconsts.TemplateRepo); err != nil && !strings.Contains(err.Error(), "already exists") {
+				return err
+			}
+			if _, err := client.PutFileOverwrite(ppsconsts.TemplateRepo, "master", args[0], bytes.NewReader(spec), 0); err != nil {
+				return err
+			}
+			return nil
+		}),
+	}
+	createTemplate.Flags().StringVarP(&templatePath, "file", "f", "-", "The JSON file containing the template, it can be a url or local file. - reads from stdin.")
+	commands = append(commands, cmdutil.CreateAlias(createTemplate, "create template"))
+
+	listTemplate := &cobra.Command{
+		Short: "List the pipeline templates stored in the cluster.",
+		Long:  "List the pipeline templates stored in the cluster.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			fileInfos, err := client.ListFile(ppsconsts.TemplateRepo, "master", "")
+			if err != nil {
+				// No templates have been created yet.
+				if strings.Contains(err.Error(), "not found") {
+					return nil
+				}
+				return err
+			}
+			writer := tabwriter.NewWriter(os.Stdout, "TEMPLATE\tSIZE\t\n")
+			for _, fileInfo := range fileInfos {
+				fmt.Fprintf(writer, "%s\t%s\t\n", path.Base(fileInfo.File.Path), units.BytesSize(float64(fileInfo.SizeBytes)))
+			}
+			return writer.Flush()
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(listTemplate, "list template"))
+
+	inspectTemplate := &cobra.Command{
+		Use:   "{{alias}} <template>",
+		Short: "Print a pipeline template's spec.",
+		Long:  "Print a pipeline template's spec.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.GetFile(ppsconsts.TemplateRepo, "master", args[0], 0, 0, os.Stdout)
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(inspectTemplate, "inspect template"))
+
+	deleteTemplate := &cobra.Command{
+		Use:   "{{alias}} <template>",
+		Short: "Delete a pipeline template.",
+		Long:  "Delete a pipeline template. Pipelines already created from it are unaffected.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			return client.DeleteFile(ppsconsts.TemplateRepo, "master", args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteTemplate, "delete template"))
+
 	var spec bool
 	listPipeline := &cobra.Command{
 		Short: "Return info about all pipelines.",
@@ -675,42 +1197,96 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	deletePipeline.Flags().BoolVarP(&force, "force", "f", false, "delete the pipeline regardless of errors; use with care")
 	commands = append(commands, cmdutil.CreateAlias(deletePipeline, "delete pipeline"))
 
+	var startPipelineGroup string
 	startPipeline := &cobra.Command{
-		Use:   "{{alias}} <pipeline>",
+		Use:   "{{alias}} [<pipeline>]",
 		Short: "Restart a stopped pipeline.",
-		Long:  "Restart a stopped pipeline.",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+		Long:  "Restart a stopped pipeline.  With --group, restarts every pipeline whose spec assigns it to that group.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
-				cmdutil.ErrorAndExit("error from StartPipeline: %s", err.Error())
+			request := &ppsclient.StartPipelineRequest{Group: startPipelineGroup}
+			if len(args) > 0 {
+				request.Pipeline = pachdclient.NewPipeline(args[0])
+			}
+			if _, err := client.PpsAPIClient.StartPipeline(client.Ctx(), request); err != nil {
+				cmdutil.ErrorAndExit("error from StartPipeline: %s", grpcutil.ScrubGRPC(err).Error())
 			}
 			return nil
 		}),
 	}
+	startPipeline.Flags().StringVar(&startPipelineGroup, "group", "", "Restart every pipeline in this group instead of a single pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(startPipeline, "start pipeline"))
 
+	var stopPipelineGroup string
 	stopPipeline := &cobra.Command{
-		Use:   "{{alias}} <pipeline>",
+		Use:   "{{alias}} [<pipeline>]",
 		Short: "Stop a running pipeline.",
-		Long:  "Stop a running pipeline.",
-		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+		Long:  "Stop a running pipeline.  With --group, stops every pipeline whose spec assigns it to that group.",
+		Example: `
+# Stop all of the nightly-etl pipelines for a maintenance window
+$ {{alias}} --group nightly-etl`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
-				cmdutil.ErrorAndExit("error from StopPipeline: %s", err.Error())
+			request := &ppsclient.StopPipelineRequest{Group: stopPipelineGroup}
+			if len(args) > 0 {
+				request.Pipeline = pachdclient.NewPipeline(args[0])
+			}
+			if _, err := client.PpsAPIClient.StopPipeline(client.Ctx(), request); err != nil {
+				cmdutil.ErrorAndExit("error from StopPipeline: %s", grpcutil.ScrubGRPC(err).Error())
 			}
 			return nil
 		}),
 	}
+	stopPipeline.Flags().StringVar(&stopPipelineGroup, "group", "", "Stop every pipeline in this group instead of a single pipeline.")
 	commands = append(commands, cmdutil.CreateAlias(stopPipeline, "stop pipeline"))
 
+	validateDAG := &cobra.Command{
+		Short: "Validate the pipeline DAG and report anything broken or suspect.",
+		Long: `Validate the pipeline DAG and report anything broken or suspect.
+
+Errors are problems that break the DAG: input cycles, inputs that reference
+repos that don't exist, and glob patterns that can never match anything.
+Warnings flag pipelines that will never see new data, e.g. because an update
+moved an upstream pipeline's output branch away from the branch they read.
+
+The same checks run server-side when a pipeline is created or updated; this
+command re-runs them over the DAG as a whole.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			response, err := client.ValidateDAG()
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, response)
+			}
+			for _, warning := range response.Warnings {
+				fmt.Printf("WARNING: %s\n", warning)
+			}
+			for _, e := range response.Errors {
+				fmt.Printf("ERROR: %s\n", e)
+			}
+			if len(response.Errors) > 0 {
+				return fmt.Errorf("pipeline DAG has %d broken pipeline(s)", len(response.Errors))
+			}
+			return nil
+		}),
+	}
+	validateDAG.Flags().AddFlagSet(rawFlags)
+	commands = append(commands, cmdutil.CreateAlias(validateDAG, "validate dag"))
+
 	var memory string
 	garbageCollect := &cobra.Command{
 		Short: "Garbage collect unused data.",
@@ -753,10 +1329,74 @@ you can increase the amount of memory used for the bloom filters with the
 	garbageCollect.Flags().StringVarP(&memory, "memory", "m", "0", "The amount of memory to use during garbage collection. Default is 10MB.")
 	commands = append(commands, cmdutil.CreateAlias(garbageCollect, "garbage-collect"))
 
+	lineageDocs := &cobra.Command{
+		Short: "Docs for lineage.",
+		Long: `Pachyderm tracks the provenance of every commit: which pipelines and jobs
+produced it, from which inputs. That lineage can be exported as OpenLineage
+events, so it appears in org-wide data catalogs like Marquez alongside lineage
+from other systems.
+
+New events are exported automatically as jobs finish if pachd's OPENLINEAGE_URL
+environment variable is set; 'pachctl lineage export' backfills events for jobs
+that already ran.`,
+	}
+	cmdutil.SetDocsUsage(lineageDocs)
+	commands = append(commands, cmdutil.CreateAlias(lineageDocs, "lineage"))
+
+	var lineageURL string
+	var lineageNamespace string
+	lineageExport := &cobra.Command{
+		Use:   "{{alias}} [<pipeline>]",
+		Short: "Export the lineage of past jobs as OpenLineage events.",
+		Long: `Export the lineage of past jobs as OpenLineage events.
+
+One run event is posted to the endpoint for every job in a terminal state, for
+all pipelines or just the given one. Use this to backfill a data catalog; new
+jobs are exported automatically if pachd's OPENLINEAGE_URL environment variable
+is set.`,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			if lineageURL == "" {
+				lineageURL = os.Getenv(lineage.EndpointEnvVar)
+			}
+			exporter := lineage.New(lineageURL, lineageNamespace)
+			if exporter == nil {
+				return fmt.Errorf("no OpenLineage endpoint given (pass --url or set %s)", lineage.EndpointEnvVar)
+			}
+			client, err := pachdclient.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			var pipelineName string
+			if len(args) > 0 {
+				pipelineName = args[0]
+			}
+			var exported int
+			if err := client.ListJobF(pipelineName, nil, nil, func(jobInfo *ppsclient.JobInfo) error {
+				event := exporter.JobEvent(jobInfo, jobInfo.State)
+				if event == nil {
+					return nil // job is still running
+				}
+				if err := exporter.Emit(event); err != nil {
+					return fmt.Errorf("could not export job %s: %v", jobInfo.Job.ID, err)
+				}
+				exported++
+				return nil
+			}); err != nil {
+				return err
+			}
+			fmt.Printf("exported %d events\n", exported)
+			return nil
+		}),
+	}
+	lineageExport.Flags().StringVar(&lineageURL, "url", "", "The OpenLineage endpoint to post events to (default: the OPENLINEAGE_URL environment variable).")
+	lineageExport.Flags().StringVar(&lineageNamespace, "namespace", "", "The OpenLineage namespace to report jobs and datasets under (default \"pachyderm\").")
+	commands = append(commands, cmdutil.CreateAlias(lineageExport, "lineage export"))
+
 	return commands
 }
 
-func pipelineHelper(metrics bool, portForwarding bool, reprocess bool, build bool, pushImages bool, registry string, username string, pipelinePath string, update bool) error {
+func pipelineHelper(metrics bool, portForwarding bool, reprocess bool, build bool, pushImages bool, registry string, username string, pipelinePath string, update bool, canary bool, canarySampleBranch string, canarySamplePercent int64) error {
 	cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
 	if err != nil {
 		return err
@@ -776,6 +1416,9 @@ func pipelineHelper(metrics bool, portForwarding bool, reprocess bool, build boo
 		if update {
 			request.Update = true
 			request.Reprocess = reprocess
+			request.Canary = canary
+			request.CanarySampleBranch = canarySampleBranch
+			request.CanarySamplePercent = canarySamplePercent
 		}
 		if build || pushImages {
 			if build && pushImages {
@@ -820,6 +1463,23 @@ func pipelineHelper(metrics bool, portForwarding bool, reprocess bool, build boo
 			}
 			request.Transform.Image = image
 		}
+		// Check that the server supports any optional features the spec uses
+		// before sending it, so version drift produces a readable error.
+		if request.Spout != nil {
+			if err := client.ServerSupports(version.CapabilitySpouts); err != nil {
+				return err
+			}
+		}
+		if request.Notification != nil {
+			if err := client.ServerSupports(version.CapabilityNotifications); err != nil {
+				return err
+			}
+		}
+		if request.Canary {
+			if err := client.ServerSupports(version.CapabilityCanary); err != nil {
+				return err
+			}
+		}
 		if _, err := client.PpsAPIClient.CreatePipeline(
 			client.Ctx(),
 			request,
@@ -830,6 +1490,373 @@ func pipelineHelper(metrics bool, portForwarding bool, reprocess bool, build boo
 	return nil
 }
 
+// parseJobStates converts job state names from the command line (e.g.
+// "running" or "JOB_RUNNING") into pps.JobState values.
+func parseJobStates(states []string) ([]ppsclient.JobState, error) {
+	var result []ppsclient.JobState
+	for _, state := range states {
+		name := strings.ToUpper(state)
+		if !strings.HasPrefix(name, "JOB_") {
+			name = "JOB_" + name
+		}
+		value, ok := ppsclient.JobState_value[name]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized job state: %s", state)
+		}
+		result = append(result, ppsclient.JobState(value))
+	}
+	return result, nil
+}
+
+// parseDuration is like time.ParseDuration, but additionally accepts a "d"
+// (day) suffix since job retention is usually thought of in days.
+func parseDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse duration %q: %v", s, err)
+		}
+		return time.Duration(days * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
+
+// dryRunDatums enumerates the datums that the pipeline spec at 'specPath'
+// would process, from the current heads of its input branches, without
+// creating the pipeline. If sample > 0 the first 'sample' datums' file
+// layouts are materialized (as empty files) under ./pfs-sample.
+func dryRunDatums(client *pachdclient.APIClient, specPath string, sample int) error {
+	cfgReader, err := ppsutil.NewPipelineManifestReader(specPath)
+	if err != nil {
+		return err
+	}
+	request, err := cfgReader.NextCreatePipelineRequest()
+	if err != nil {
+		return err
+	}
+	// Point each PFS input at the head of its branch, the way a job triggered
+	// right now would see it. Inputs without a head commit yield no datums.
+	ppsclient.VisitInput(request.Input, func(input *ppsclient.Input) {
+		if input.Pfs == nil || input.Pfs.Commit != "" {
+			return
+		}
+		if input.Pfs.Name == "" {
+			input.Pfs.Name = input.Pfs.Repo
+		}
+		branch := input.Pfs.Branch
+		if branch == "" {
+			branch = "master"
+		}
+		commitInfo, err := client.InspectCommit(input.Pfs.Repo, branch)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: no head commit for %s@%s, treating input as empty: %v\n", input.Pfs.Repo, branch, err)
+			return
+		}
+		input.Pfs.Commit = commitInfo.Commit.ID
+	})
+	df, err := workerpkg.NewDatumFactory(client, request.Input)
+	if err != nil {
+		return err
+	}
+	writer := tabwriter.NewWriter(os.Stdout, "DATUM\tFILES\t\n")
+	for i := 0; i < df.Len(); i++ {
+		var paths []string
+		for _, input := range df.Datum(i) {
+			paths = append(paths, path.Join("/pfs", input.Name, input.FileInfo.File.Path))
+		}
+		fmt.Fprintf(writer, "%d\t%s\t\n", i, strings.Join(paths, ", "))
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if sample > df.Len() {
+		sample = df.Len()
+	}
+	for i := 0; i < sample; i++ {
+		for _, input := range df.Datum(i) {
+			target := filepath.Join("pfs-sample", fmt.Sprintf("datum-%d", i), input.Name, input.FileInfo.File.Path)
+			if input.FileInfo.FileType == pfs.FileType_DIR {
+				if err := os.MkdirAll(target, 0755); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.Create(target)
+			if err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	if sample > 0 {
+		fmt.Printf("Materialized %d datums under ./pfs-sample\n", sample)
+	}
+	return nil
+}
+
+// explainPipelineSpec prints a plain-English summary of one pipeline spec:
+// what triggers it, how datums are formed from its inputs, the expected
+// parallelism, per-worker resources, and where output goes.
+func explainPipelineSpec(request *ppsclient.CreatePipelineRequest) {
+	fmt.Printf("Pipeline %q:\n", request.Pipeline.Name)
+	if request.Description != "" {
+		fmt.Printf("  %s\n", request.Description)
+	}
+	switch {
+	case request.Spout != nil:
+		fmt.Println("  This is a spout: it runs continuously and produces output on its own, rather than being triggered by input commits.")
+	case request.Service != nil:
+		fmt.Println("  This is a service: it runs continuously over the head of its inputs instead of processing discrete jobs.")
+	case request.Input == nil:
+		fmt.Println("  It has no input, so jobs will never be triggered.")
+	default:
+		fmt.Println("  A job is triggered whenever any of its inputs gets a new commit:")
+		explainInput(request.Input, "    ")
+	}
+	fmt.Printf("  Each job runs %s.\n", explainParallelism(request.ParallelismSpec))
+	if s := explainResources(request.ResourceRequests); s != "" {
+		fmt.Printf("  Each worker requests %s.\n", s)
+	}
+	if s := explainResources(request.ResourceLimits); s != "" {
+		fmt.Printf("  Each worker is limited to %s.\n", s)
+	}
+	outputBranch := request.OutputBranch
+	if outputBranch == "" {
+		outputBranch = "master"
+	}
+	fmt.Printf("  Output is committed to %s@%s.\n", request.Pipeline.Name, outputBranch)
+	if request.Egress != nil && request.Egress.URL != "" {
+		fmt.Printf("  Each output commit is also egressed to %s.\n", request.Egress.URL)
+	}
+	fmt.Println()
+}
+
+// explainInput prints one line (or an indented block, for cross/union) per
+// input, describing what triggers jobs and how the input's datums are formed.
+func explainInput(input *ppsclient.Input, indent string) {
+	switch {
+	case input == nil:
+	case input.Pfs != nil:
+		in := input.Pfs
+		name := in.Name
+		if name == "" {
+			name = in.Repo
+		}
+		branch := in.Branch
+		if branch == "" {
+			branch = "master"
+		}
+		fmt.Printf("%s- %q: new commits on %s@%s; glob %q means %s", indent, name, in.Repo, branch, in.Glob, explainGlob(in.Glob))
+		if in.Lazy {
+			fmt.Printf(" (files are downloaded lazily, as the code reads them)")
+		}
+		if in.EmptyFiles {
+			fmt.Printf(" (files appear empty; only their names are exposed)")
+		}
+		fmt.Println()
+	case input.Cron != nil:
+		word := "a new datum"
+		if input.Cron.Overwrite {
+			word = "an overwritten datum"
+		}
+		fmt.Printf("%s- %q: a tick on cron schedule %q; each tick triggers a job with %s\n", indent, input.Cron.Name, input.Cron.Spec, word)
+	case input.Git != nil:
+		branch := input.Git.Branch
+		if branch == "" {
+			branch = "master"
+		}
+		fmt.Printf("%s- %q: pushes to branch %q of %s (delivered via the git webhook)\n", indent, input.Git.Name, branch, input.Git.URL)
+	case len(input.Cross) > 0:
+		fmt.Printf("%s- the cross product of (every combination of datums, one from each):\n", indent)
+		for _, in := range input.Cross {
+			explainInput(in, indent+"  ")
+		}
+	case len(input.Union) > 0:
+		fmt.Printf("%s- the union of (every datum from any of these, independently):\n", indent)
+		for _, in := range input.Union {
+			explainInput(in, indent+"  ")
+		}
+	}
+}
+
+// explainGlob spells out what a PFS input's glob pattern does to datum
+// formation, covering the common patterns specially.
+func explainGlob(glob string) string {
+	switch glob {
+	case "", "/":
+		return "the whole input forms a single datum"
+	case "/*":
+		return "each top-level file or directory is its own datum"
+	case "/**":
+		return "every file, at any depth, is its own datum"
+	}
+	if strings.Contains(glob, "**") {
+		return "every path matching it, at any depth below the fixed part, is its own datum"
+	}
+	return "each path matching it is its own datum"
+}
+
+func explainParallelism(spec *ppsclient.ParallelismSpec) string {
+	if spec == nil || (spec.Constant == 0 && spec.Coefficient == 0) {
+		return "on one worker per cluster node (the default parallelism)"
+	}
+	if spec.Constant > 0 {
+		if spec.Constant == 1 {
+			return "on a single worker"
+		}
+		return fmt.Sprintf("on %d workers", spec.Constant)
+	}
+	return fmt.Sprintf("on %g workers per cluster node", spec.Coefficient)
+}
+
+func explainResources(spec *ppsclient.ResourceSpec) string {
+	if spec == nil {
+		return ""
+	}
+	var parts []string
+	if spec.Cpu != 0 {
+		parts = append(parts, fmt.Sprintf("%g CPUs", spec.Cpu))
+	}
+	if spec.Memory != "" {
+		parts = append(parts, spec.Memory+" of memory")
+	}
+	if spec.Gpu != nil && spec.Gpu.Number > 0 {
+		parts = append(parts, fmt.Sprintf("%d GPUs (%s)", spec.Gpu.Number, spec.Gpu.Type))
+	}
+	if spec.Disk != "" {
+		parts = append(parts, spec.Disk+" of disk")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// createPipelineFromTemplate instantiates a cluster-stored pipeline template
+// (see 'pachctl create template'): it reads the template from the template
+// repo, applies the --set overrides, and creates the resulting pipeline(s).
+func createPipelineFromTemplate(metrics bool, portForwarding bool, template string, sets []string) error {
+	client, err := pachdclient.NewOnUserMachine(metrics, portForwarding, "user")
+	if err != nil {
+		return fmt.Errorf("error connecting to pachd: %v", err)
+	}
+	defer client.Close()
+	var buf bytes.Buffer
+	if err := client.GetFile(ppsconsts.TemplateRepo, "master", template, 0, 0, &buf); err != nil {
+		return fmt.Errorf("could not read template %q (create it with 'pachctl create template'): %v", template, err)
+	}
+	decoder := json.NewDecoder(&buf)
+	for {
+		var spec interface{}
+		if err := decoder.Decode(&spec); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("malformed template %q: %v", template, err)
+		}
+		for _, set := range sets {
+			if err := applyTemplateSet(spec, set); err != nil {
+				return err
+			}
+		}
+		rendered, err := json.Marshal(spec)
+		if err != nil {
+			return err
+		}
+		request := &ppsclient.CreatePipelineRequest{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(rendered), request); err != nil {
+			return fmt.Errorf("template %q does not render to a valid pipeline spec: %v", template, err)
+		}
+		if _, err := client.PpsAPIClient.CreatePipeline(client.Ctx(), request); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+}
+
+// applyTemplateSet applies one --set override, given as
+// "<dotted.json.path>=<value>", to a decoded template document. Intermediate
+// objects are created as needed. The value is parsed as JSON if possible (so
+// numbers, booleans, and objects work) and used as a string otherwise.
+func applyTemplateSet(spec interface{}, set string) error {
+	eq := strings.Index(set, "=")
+	if eq < 0 {
+		return fmt.Errorf("malformed --set %q: expected <dotted.json.path>=<value>", set)
+	}
+	fields := strings.Split(set[:eq], ".")
+	var value interface{}
+	if err := json.Unmarshal([]byte(set[eq+1:]), &value); err != nil {
+		value = set[eq+1:]
+	}
+	node, ok := spec.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot set %q: template document is not a JSON object", set[:eq])
+	}
+	for _, field := range fields[:len(fields)-1] {
+		child, ok := node[field].(map[string]interface{})
+		if !ok {
+			if node[field] != nil {
+				return fmt.Errorf("cannot set %q: %q is not a JSON object", set[:eq], field)
+			}
+			child = make(map[string]interface{})
+			node[field] = child
+		}
+		node = child
+	}
+	node[fields[len(fields)-1]] = value
+	return nil
+}
+
+// lintWarning is one best-practice violation found by 'pachctl lint
+// pipeline'. Code names the rule and is stable, so CI can gate on specific
+// rules from the --json output.
+type lintWarning struct {
+	Pipeline string `json:"pipeline"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+}
+
+// lintPipelineSpec runs the lint rules over one pipeline spec and returns the
+// warnings. The rules are heuristics: each flags a pattern that is usually,
+// but not always, a mistake.
+func lintPipelineSpec(request *ppsclient.CreatePipelineRequest) []lintWarning {
+	var warnings []lintWarning
+	warn := func(code, format string, args ...interface{}) {
+		warnings = append(warnings, lintWarning{
+			Pipeline: request.Pipeline.Name,
+			Code:     code,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+	hasPFSInput := false
+	multipleDatums := false
+	ppsclient.VisitInput(request.Input, func(input *ppsclient.Input) {
+		if input.Pfs != nil {
+			hasPFSInput = true
+			if input.Pfs.Glob != "" && input.Pfs.Glob != "/" {
+				multipleDatums = true
+			}
+		}
+		if input.Cron != nil && !input.Cron.Overwrite {
+			warn("cron-no-overwrite", "cron input %q adds a new file every tick; set \"overwrite\" unless you need the tick history", input.Cron.Name)
+		}
+	})
+	parallelism := request.ParallelismSpec
+	if hasPFSInput && !multipleDatums && parallelism != nil && (parallelism.Constant > 1 || parallelism.Coefficient > 0) {
+		warn("wasted-parallelism", "every input uses glob \"/\", which forms a single datum, so only one of the requested workers will ever have work")
+	}
+	if request.ResourceRequests == nil {
+		warn("no-resource-requests", "no resource requests set; Kubernetes may schedule workers onto nodes without enough CPU or memory for them")
+	}
+	if request.Transform != nil && request.Transform.Image != "" && !strings.Contains(request.Transform.Image, "@") {
+		_, tag := docker.ParseRepositoryTag(request.Transform.Image)
+		if tag == "" || tag == "latest" {
+			warn("latest-image", "image %q has no pinned tag; jobs may silently pick up a different image after a push", request.Transform.Image)
+		}
+	}
+	return warnings
+}
+
 // ByCreationTime is an implementation of sort.Interface which
 // sorts pps job info by creation time, ascending.
 type ByCreationTime []*ppsclient.JobInfo
@@ -24,10 +24,17 @@ const (
 	PipelineHeader = "NAME\tINPUT\tCREATED\tSTATE / LAST JOB\t\n"
 	// JobHeader is the header for jobs
 	JobHeader = "ID\tPIPELINE\tSTARTED\tDURATION\tRESTART\tPROGRESS\tDL\tUL\tSTATE\t\n"
+	// JobPendingHeader is the header for jobs when printing pending reasons
+	// (list job --pending)
+	JobPendingHeader = "ID\tPIPELINE\tSTARTED\tSTATE\tREASON\t\n"
 	// DatumHeader is the header for datums
 	DatumHeader = "ID\tSTATUS\tTIME\t\n"
+	// DatumReasonHeader is the header for datums when printing failure reasons
+	DatumReasonHeader = "ID\tSTATUS\tTIME\tREASON\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
+	// datumReasonLen is the amount of the datum reason that we print
+	datumReasonLen = 75
 )
 
 // PrintJobHeader prints a job header.
@@ -71,6 +78,24 @@ func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, fullTimestamps bool)
 	}
 }
 
+// PrintPendingJobInfo pretty-prints a pending job along with the reason it
+// hasn't started running.
+func PrintPendingJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", jobInfo.Job.ID)
+	fmt.Fprintf(w, "%s\t", jobInfo.Pipeline.Name)
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", jobInfo.Started.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(jobInfo.Started))
+	}
+	fmt.Fprintf(w, "%s\t", jobState(jobInfo.State))
+	if jobInfo.PendingReason != "" {
+		fmt.Fprintf(w, "%s\t\n", jobInfo.PendingReason)
+	} else {
+		fmt.Fprintf(w, "-\t\n")
+	}
+}
+
 // PrintPipelineHeader prints a pipeline header.
 func PrintPipelineHeader(w io.Writer) {
 	fmt.Fprint(w, PipelineHeader)
@@ -133,7 +158,8 @@ Started: {{.Started}}{{else}}
 Started: {{prettyAgo .Started}} {{end}}{{if .Finished}}
 Duration: {{prettyTimeDifference .Started .Finished}} {{end}}
 State: {{jobState .State}}
-Reason: {{.Reason}}
+Reason: {{.Reason}}{{if .PendingReason}}
+Pending Reason: {{.PendingReason}}{{end}}
 Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
 Skipped: {{.DataSkipped}}
@@ -196,7 +222,8 @@ func NewPrintablePipelineInfo(pi *ppsclient.PipelineInfo) *PrintablePipelineInfo
 func PrintDetailedPipelineInfo(pipelineInfo *PrintablePipelineInfo) error {
 	template, err := template.New("PipelineInfo").Funcs(funcMap).Parse(
 		`Name: {{.Pipeline.Name}}{{if .Description}}
-Description: {{.Description}}{{end}}{{if .FullTimestamps }}
+Description: {{.Description}}{{end}}{{if .Group}}
+Group: {{.Group}}{{end}}{{if .FullTimestamps }}
 Created: {{.CreatedAt}}{{ else }}
 Created: {{prettyAgo .CreatedAt}} {{end}}
 State: {{pipelineState .State}}
@@ -214,11 +241,15 @@ Parallelism Spec: {{.ParallelismSpec}}
     Number: {{ .ResourceLimits.Gpu.Number }} {{end}} {{end}}
 Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
-Input:
+{{if .JobTimeoutWarning}}Job Timeout Warning: {{.JobTimeoutWarning}}
+{{end}}Input:
 {{pipelineInput .PipelineInfo}}
 {{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
-Output Branch: {{.OutputBranch}}
-Transform:
+{{ if .StatsServiceURL }}Stats Service URL: {{.StatsServiceURL}} {{end}}
+{{if .AuthPrincipal}}Auth Principal: {{.AuthPrincipal}}
+{{end}}Output Branch: {{.OutputBranch}}
+{{if .VersionedOutput}}Promoted Version: {{.PromotedVersion}}
+{{end}}Transform:
 {{prettyTransform .Transform}}
 {{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
 {{if .RecentError}} Recent Error: {{.RecentError}} {{end}}
@@ -243,11 +274,17 @@ func PrintDatumInfoHeader(w io.Writer) {
 // PrintDatumInfo pretty-prints file info.
 // If recurse is false and directory size is 0, display "-" instead
 // If fast is true and file size is 0, display "-" instead
-func PrintDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
+// If reason is true, a trimmed single-line failure reason is appended
+func PrintDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo, reason bool) {
 	totalTime := "-"
 	if datumInfo.Stats != nil {
 		totalTime = units.HumanDuration(client.GetDatumTotalTime(datumInfo.Stats))
 	}
+	if reason {
+		flatReason := safeTrim(strings.Replace(datumInfo.Reason, "\n", " ", -1), datumReasonLen)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", datumInfo.Datum.ID, datumState(datumInfo.State), totalTime, flatReason)
+		return
+	}
 	fmt.Fprintf(w, "%s\t%s\t%s\n", datumInfo.Datum.ID, datumState(datumInfo.State), totalTime)
 }
 
@@ -256,6 +293,9 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	fmt.Fprintf(w, "ID\t%s\n", datumInfo.Datum.ID)
 	fmt.Fprintf(w, "Job ID\t%s\n", datumInfo.Datum.Job.ID)
 	fmt.Fprintf(w, "State\t%s\n", datumInfo.State)
+	if datumInfo.Reason != "" {
+		fmt.Fprintf(w, "Reason\t%s\n", datumInfo.Reason)
+	}
 	fmt.Fprintf(w, "Data Downloaded\t%s\n", pretty.Size(datumInfo.Stats.DownloadBytes))
 	fmt.Fprintf(w, "Data Uploaded\t%s\n", pretty.Size(datumInfo.Stats.UploadBytes))
 
@@ -338,6 +378,8 @@ func jobState(jobState ppsclient.JobState) string {
 		return color.New(color.FgGreen).SprintFunc()("success")
 	case ppsclient.JobState_JOB_KILLED:
 		return color.New(color.FgRed).SprintFunc()("killed")
+	case ppsclient.JobState_JOB_TIMED_OUT:
+		return color.New(color.FgRed).SprintFunc()("timed out")
 	}
 	return "-"
 }
@@ -399,6 +441,11 @@ func jobCounts(counts map[int32]int32) string {
 	for i := int32(ppsclient.JobState_JOB_STARTING); i <= int32(ppsclient.JobState_JOB_SUCCESS); i++ {
 		fmt.Fprintf(&buffer, "%s: %d\t", jobState(ppsclient.JobState(i)), counts[i])
 	}
+	// Jobs that blew their runtime budget indicate the budget needs tuning,
+	// so surface them even though the state is rarer than the ones above
+	if timedOut := counts[int32(ppsclient.JobState_JOB_TIMED_OUT)]; timedOut != 0 {
+		fmt.Fprintf(&buffer, "%s: %d\t", jobState(ppsclient.JobState_JOB_TIMED_OUT), timedOut)
+	}
 	return buffer.String()
 }
 
@@ -7,6 +7,7 @@ import (
 	goerr "errors"
 	"fmt"
 	"io"
+	"os"
 	"path"
 	"path/filepath"
 	"regexp"
@@ -16,6 +17,7 @@ import (
 	"sync"
 	"time"
 
+	globlib "github.com/gobwas/glob"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -23,10 +25,13 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	"github.com/pachyderm/pachyderm/src/server/pkg/audit"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kms"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/logstore"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
@@ -47,6 +52,9 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -60,6 +68,9 @@ const (
 	// DefaultDatumTries is the default number of times a datum will be tried
 	// before we give up and consider the job failed.
 	DefaultDatumTries = 3
+	// canarySuffix is appended to a pipeline's name to name the shadow
+	// pipeline that canary updates run (see CreatePipelineRequest.Canary).
+	canarySuffix = "-canary"
 )
 
 var (
@@ -67,6 +78,10 @@ var (
 	suite               = "pachyderm"
 	defaultGCMemory     = 20 * 1024 * 1024 // 20 MB
 	pipelineNameMatcher = regexp.MustCompile("^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$")
+	extraOutputMatcher  = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+	// shared cache names become part of a PVC name, so they must be valid
+	// kubernetes resource names
+	sharedCacheNameMatcher = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
 )
 
 func newErrJobNotFound(job string) error {
@@ -119,6 +134,8 @@ type apiServer struct {
 	iamRole               string
 	imagePullSecret       string
 	noExposeDockerSocket  bool
+	workerDirectObjAccess bool
+	prePullImages         bool
 	reporter              *metrics.Reporter
 	monitorCancels        map[string]func()
 	workerUsesRoot        bool
@@ -202,6 +219,12 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 					return fmt.Errorf("input must specify a branch")
 				case len(input.Pfs.Glob) == 0:
 					return fmt.Errorf("input must specify a glob")
+				case input.Pfs.Lazy && input.Pfs.EmptyFiles:
+					return fmt.Errorf("input cannot specify both lazy and empty_files; " +
+						"empty_files skips the data entirely while lazy exposes it on demand")
+				}
+				if _, err := globlib.Compile(input.Pfs.Glob, '/'); err != nil {
+					return fmt.Errorf("glob \"%s\" can never match anything: %v", input.Pfs.Glob, err)
 				}
 				// Note that input.Pfs.Commit is empty if a) this is a job b) one of
 				// the job pipeline's input branches has no commits yet
@@ -259,6 +282,18 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 }
 
 func validateTransform(transform *pps.Transform) error {
+	if transform == nil {
+		return nil
+	}
+	// The hooks are optional, but "present and empty" is rejected rather than
+	// treated as absent: the worker indexes the command's first element, and
+	// JSON specs can produce an empty non-nil list via "init_cmd": [].
+	if transform.InitCmd != nil && len(transform.InitCmd) == 0 {
+		return fmt.Errorf("init_cmd cannot be empty; either provide a command or omit the field")
+	}
+	if transform.TeardownCmd != nil && len(transform.TeardownCmd) == 0 {
+		return fmt.Errorf("teardown_cmd cannot be empty; either provide a command or omit the field")
+	}
 	return nil
 }
 
@@ -743,15 +778,68 @@ func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.Etc
 		result.ChunkSpec = pipelineInfo.ChunkSpec
 		result.DatumTimeout = pipelineInfo.DatumTimeout
 		result.JobTimeout = pipelineInfo.JobTimeout
+		result.JobTimeoutWarning = pipelineInfo.JobTimeoutWarning
 		result.DatumTries = pipelineInfo.DatumTries
 		result.SchedulingSpec = pipelineInfo.SchedulingSpec
 		result.PodSpec = pipelineInfo.PodSpec
 		result.PodPatch = pipelineInfo.PodPatch
+		result.TransformCaching = pipelineInfo.TransformCaching
 	}
 	result.OutputRepo = &pfs.Repo{Name: jobPtr.Pipeline.Name}
+	if result.State == pps.JobState_JOB_STARTING {
+		result.PendingReason = a.jobPendingReason(pachClient, result, commitInfo, pipelinePtr, pipelineInfo)
+	}
 	return result, nil
 }
 
+// jobPendingReason explains why a job that's in JOB_STARTING hasn't started
+// running: a paused pipeline, an unfinished upstream commit, or worker pods
+// that haven't been scheduled (e.g. for lack of quota). It's best-effort: if
+// no specific cause can be determined the reason is left empty rather than
+// failing the inspect.
+func (a *apiServer) jobPendingReason(pachClient *client.APIClient, jobInfo *pps.JobInfo, commitInfo *pfs.CommitInfo, pipelinePtr *pps.EtcdPipelineInfo, pipelineInfo *pps.PipelineInfo) string {
+	if pipelinePtr.State == pps.PipelineState_PIPELINE_PAUSED {
+		return "pipeline is paused"
+	}
+	for _, prov := range commitInfo.Provenance {
+		if prov.Commit.Repo.Name == ppsconsts.SpecRepo {
+			continue
+		}
+		provCommitInfo, err := pachClient.InspectCommit(prov.Commit.Repo.Name, prov.Commit.ID)
+		if err != nil {
+			continue
+		}
+		if provCommitInfo.Finished == nil {
+			return fmt.Sprintf("waiting on input commit %s@%s to finish", prov.Commit.Repo.Name, prov.Commit.ID)
+		}
+	}
+	if pipelineInfo.Version == 0 {
+		// Without a full JobInfo we don't know which RC runs this job's
+		// workers, so the pod checks below aren't possible.
+		return ""
+	}
+	pods, err := a.rcPods(ppsutil.PipelineRcName(jobInfo.Pipeline.Name, pipelineInfo.Version))
+	if err != nil {
+		logrus.Errorf("failed to get worker pods for pending job %s: %v", jobInfo.Job.ID, err)
+		return ""
+	}
+	if len(pods) == 0 {
+		return "no worker pods have been scheduled"
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase != v1.PodPending {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == v1.PodScheduled && cond.Status == v1.ConditionFalse {
+				return fmt.Sprintf("worker pod %s can't be scheduled: %s", pod.Name, cond.Message)
+			}
+		}
+		return fmt.Sprintf("worker pod %s is pending", pod.Name)
+	}
+	return "workers are starting up"
+}
+
 func (a *apiServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (response *pps.JobInfos, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) {
@@ -840,11 +928,49 @@ func (a *apiServer) DeleteJob(ctx context.Context, request *pps.DeleteJobRequest
 		return nil, err
 	}
 
-	_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		return a.jobs.ReadWrite(stm).Delete(request.Job.ID)
-	})
-	if err != nil {
-		return nil, err
+	jobIDs := []string{}
+	if request.Job != nil {
+		jobIDs = append(jobIDs, request.Job.ID)
+	} else {
+		if request.Pipeline == nil && len(request.States) == 0 && request.OlderThan == nil {
+			return nil, fmt.Errorf("must specify either a job or a job filter (pipeline, state or older-than)")
+		}
+		var olderThan time.Duration
+		if request.OlderThan != nil {
+			var err error
+			olderThan, err = types.DurationFromProto(request.OlderThan)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := a.selectJobs(ctx, request.Pipeline, request.States, func(jobPtr *pps.EtcdJobInfo) error {
+			// Only delete jobs that have finished; running jobs must be
+			// stopped first.
+			if !ppsutil.IsTerminal(jobPtr.State) {
+				return nil
+			}
+			if olderThan != 0 {
+				started, err := types.TimestampFromProto(jobPtr.Started)
+				if err != nil {
+					return err
+				}
+				if time.Since(started) < olderThan {
+					return nil
+				}
+			}
+			jobIDs = append(jobIDs, jobPtr.Job.ID)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	for _, jobID := range jobIDs {
+		_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			return a.jobs.ReadWrite(stm).Delete(jobID)
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &types.Empty{}, nil
 }
@@ -857,23 +983,71 @@ func (a *apiServer) StopJob(ctx context.Context, request *pps.StopJobRequest) (r
 		return nil, err
 	}
 
-	// Lookup jobInfo
-	jobPtr := &pps.EtcdJobInfo{}
-	if err := a.jobs.ReadOnly(ctx).Get(request.Job.ID, jobPtr); err != nil {
-		return nil, err
-	}
-	// Finish the job's output commit without a tree -- worker/master will mark
-	// the job 'killed'
-	if _, err := pachClient.PfsAPIClient.FinishCommit(ctx,
-		&pfs.FinishCommitRequest{
-			Commit: jobPtr.OutputCommit,
-			Empty:  true,
+	var outputCommits []*pfs.Commit
+	if request.Job != nil {
+		// Lookup jobInfo
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := a.jobs.ReadOnly(ctx).Get(request.Job.ID, jobPtr); err != nil {
+			return nil, err
+		}
+		outputCommits = append(outputCommits, jobPtr.OutputCommit)
+	} else {
+		if request.Pipeline == nil && len(request.States) == 0 {
+			return nil, fmt.Errorf("must specify either a job or a job filter (pipeline or state)")
+		}
+		if err := a.selectJobs(ctx, request.Pipeline, request.States, func(jobPtr *pps.EtcdJobInfo) error {
+			// Jobs that have already finished have nothing to stop.
+			if ppsutil.IsTerminal(jobPtr.State) {
+				return nil
+			}
+			outputCommits = append(outputCommits, jobPtr.OutputCommit)
+			return nil
 		}); err != nil {
-		return nil, err
+			return nil, err
+		}
+	}
+	// Finish the jobs' output commits without trees -- worker/master will mark
+	// the jobs 'killed'
+	for _, outputCommit := range outputCommits {
+		if _, err := pachClient.PfsAPIClient.FinishCommit(ctx,
+			&pfs.FinishCommitRequest{
+				Commit: outputCommit,
+				Empty:  true,
+			}); err != nil {
+			return nil, err
+		}
 	}
 	return &types.Empty{}, nil
 }
 
+// selectJobs calls 'f' with the EtcdJobInfo of every job matching the
+// bulk-selection fields shared by DeleteJobRequest and StopJobRequest: if
+// 'pipeline' is non-nil only that pipeline's jobs are visited, and if 'states'
+// is non-empty only jobs currently in one of those states are visited.
+func (a *apiServer) selectJobs(ctx context.Context, pipeline *pps.Pipeline, states []pps.JobState, f func(jobPtr *pps.EtcdJobInfo) error) error {
+	jobs := a.jobs.ReadOnly(ctx)
+	jobPtr := &pps.EtcdJobInfo{}
+	_f := func(string) error {
+		if len(states) > 0 {
+			var match bool
+			for _, state := range states {
+				if jobPtr.State == state {
+					match = true
+					break
+				}
+			}
+			if !match {
+				return nil
+			}
+		}
+		return f(jobPtr)
+	}
+	if pipeline != nil {
+		return jobs.GetByIndex(ppsdb.JobsPipelineIndex, pipeline, jobPtr, col.DefaultOptions, _f)
+	}
+	return jobs.List(jobPtr, col.DefaultOptions, _f)
+}
+
 func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -963,7 +1137,11 @@ func (a *apiServer) listDatum(pachClient *client.APIClient, job *pps.Job, page,
 		var datumInfos []*pps.DatumInfo
 		for i := start; i < end; i++ {
 			datum := df.Datum(i) // flattened slice of *worker.Input to job
-			id := workerpkg.HashDatum(jobInfo.Pipeline.Name, jobInfo.Salt, datum)
+			salt := jobInfo.Salt
+			if jobInfo.TransformCaching {
+				salt = workerpkg.TransformSalt(jobInfo.Transform)
+			}
+			id := workerpkg.HashDatum(jobInfo.Pipeline.Name, salt, datum)
 			datumInfo := &pps.DatumInfo{
 				Datum: &pps.Datum{
 					ID:  id,
@@ -1139,6 +1317,12 @@ func (a *apiServer) getDatum(pachClient *client.APIClient, repo string, commit *
 	_, err = pfsClient.InspectFile(ctx, &pfs.InspectFileRequest{File: stateFile})
 	if err == nil {
 		datumInfo.State = pps.DatumState_FAILED
+		// The failure file contains the reason the datum failed
+		var reason bytes.Buffer
+		if err := pachClient.GetFile(commit.Repo.Name, commit.ID, stateFile.Path, 0, 0, &reason); err != nil {
+			return nil, err
+		}
+		datumInfo.Reason = reason.String()
 	} else if !isNotFoundErr(err) {
 		return nil, err
 	}
@@ -1214,6 +1398,68 @@ func (a *apiServer) InspectDatum(ctx context.Context, request *pps.InspectDatumR
 	return datumInfo, nil
 }
 
+// GetKubeEvents proxies recent kubernetes events for a pipeline's worker
+// pods (and their RC) through pachd, so that users without kubectl access
+// can see why workers aren't starting.
+func (a *apiServer) GetKubeEvents(request *pps.GetKubeEventsRequest, server pps.API_GetKubeEventsServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(server.Context())
+	if request.Pipeline == nil {
+		return fmt.Errorf("must specify a pipeline")
+	}
+	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	if err != nil {
+		return err
+	}
+	// Seeing a pipeline's kube events requires the same access as reading
+	// its logs
+	if err := a.authorizePipelineOp(pachClient, pipelineOpGetLogs, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
+	rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+	// Collect events for the RC itself and for each of its pods: scheduling
+	// and image failures attach to the pods, scale-up problems to the RC
+	objects := []string{rcName}
+	pods, err := a.rcPods(rcName)
+	if err != nil {
+		return fmt.Errorf("could not get pods in rc %q: %v", rcName, err)
+	}
+	for _, pod := range pods {
+		objects = append(objects, pod.ObjectMeta.Name)
+	}
+	var events []v1.Event
+	for _, object := range objects {
+		eventList, err := a.env.GetKubeClient().CoreV1().Events(a.namespace).List(metav1.ListOptions{
+			FieldSelector: "involvedObject.name=" + object,
+		})
+		if err != nil {
+			return err
+		}
+		events = append(events, eventList.Items...)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Time.Before(events[j].LastTimestamp.Time)
+	})
+	for _, event := range events {
+		ts, err := types.TimestampProto(event.LastTimestamp.Time)
+		if err != nil {
+			return err
+		}
+		if err := server.Send(&pps.KubeEvent{
+			LastSeen: ts,
+			Type:     event.Type,
+			Reason:   event.Reason,
+			Object:   event.InvolvedObject.Name,
+			Message:  event.Message,
+			Count:    int64(event.Count),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
@@ -1236,6 +1482,7 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		// RC name
 		var pipelineInfo *pps.PipelineInfo
 		var statsCommit *pfs.Commit
+		var jobFinished bool
 		var err error
 		if request.Pipeline != nil {
 			pipelineInfo, err = a.inspectPipeline(pachClient, request.Pipeline.Name)
@@ -1248,6 +1495,7 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 				return fmt.Errorf("could not get job information for \"%s\": %v", request.Job.ID, err)
 			}
 			statsCommit = jobPtr.StatsCommit
+			jobFinished = ppsutil.IsTerminal(jobPtr.State)
 			pipelineInfo, err = a.inspectPipeline(pachClient, jobPtr.Pipeline.Name)
 		}
 		if err != nil {
@@ -1259,6 +1507,19 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 			return err
 		}
 
+		// If the job has finished, read its logs from the object store, where
+		// the workers persisted them as the datums ran (see pkg/logstore)--
+		// the pods that emitted them may already be gone.
+		if jobFinished && !request.Follow {
+			sent, err := a.getLogsFromStore(pachClient, request, apiGetLogsServer)
+			if err != nil {
+				return err
+			}
+			if sent {
+				return nil
+			}
+		}
+
 		// If the job had stats enabled, we use the logs from the stats
 		// commit since that's likely to yield better results.
 		if statsCommit != nil {
@@ -1281,23 +1542,35 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		return fmt.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
 	}
 
-	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
-	// and channels are read into the output server in a stable order.
-	// (sort the pods to make sure that the order of log lines is stable)
+	// Spawn one goroutine per pod. Each goro writes its pod's logs to its own
+	// channel; the channels are then merged into the output server in
+	// timestamp order (or arrival order when following, as a live stream has
+	// no final order). Sort the pods so that ties break stably.
 	sort.Sort(podSlice(pods))
-	logCh := make(chan *pps.LogMessage)
-	var eg errgroup.Group
-	var mu sync.Mutex
-	eg.Go(func() error {
+	if request.WorkerID != "" {
+		// Worker IDs are pod names, so only that worker's pod can have
+		// matching lines--don't scrape the others.
+		var filtered []v1.Pod
 		for _, pod := range pods {
-			pod := pod
-			if !request.Follow {
-				mu.Lock()
+			if pod.ObjectMeta.Name == request.WorkerID {
+				filtered = append(filtered, pod)
 			}
+		}
+		if len(filtered) == 0 {
+			return fmt.Errorf("no worker %q was found in rc \"%s\"", request.WorkerID, rcName)
+		}
+		pods = filtered
+	}
+	logChs := make([]chan *pps.LogMessage, len(pods))
+	for i := range pods {
+		logChs[i] = make(chan *pps.LogMessage)
+	}
+	var eg errgroup.Group
+	eg.Go(func() error {
+		for i, pod := range pods {
+			i, pod := i, pod
 			eg.Go(func() (retErr error) {
-				if !request.Follow {
-					defer mu.Unlock()
-				}
+				defer close(logChs[i])
 				tailLines := &request.Tail
 				if *tailLines <= 0 {
 					tailLines = nil
@@ -1344,6 +1617,9 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 						if request.Datum != nil && request.Datum.ID != msg.DatumID {
 							continue
 						}
+						if request.WorkerID != "" && request.WorkerID != msg.WorkerID {
+							continue
+						}
 						if request.Master != msg.Master {
 							continue
 						}
@@ -1355,7 +1631,7 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 
 					// Log message passes all filters -- return it
 					select {
-					case logCh <- msg:
+					case logChs[i] <- msg:
 					case <-ctx.Done():
 						return nil
 					}
@@ -1366,24 +1642,147 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		return nil
 	})
 	var egErr error
+	done := make(chan struct{})
 	go func() {
 		egErr = eg.Wait()
-		close(logCh)
+		close(done)
 	}()
 
-	for msg := range logCh {
-		if err := apiGetLogsServer.Send(msg); err != nil {
-			return err
+	if request.Follow {
+		// A live stream has no final order, so forward each worker's lines
+		// as they arrive.
+		merged := make(chan *pps.LogMessage)
+		var wg sync.WaitGroup
+		for _, ch := range logChs {
+			ch := ch
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for msg := range ch {
+					merged <- msg
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(merged)
+		}()
+		for msg := range merged {
+			if err := apiGetLogsServer.Send(msg); err != nil {
+				return err
+			}
 		}
+	} else if err := mergeLogsByTimestamp(logChs, apiGetLogsServer.Send); err != nil {
+		return err
 	}
+	<-done
 	return egErr
 }
 
+// mergeLogsByTimestamp forwards log messages from each of 'chans'--each of
+// which must deliver its own messages in timestamp order--to 'send' in global
+// timestamp order, so that logs scraped from many workers interleave the way
+// they were emitted rather than pod by pod.
+func mergeLogsByTimestamp(chans []chan *pps.LogMessage, send func(*pps.LogMessage) error) error {
+	heads := make([]*pps.LogMessage, len(chans))
+	for {
+		minI := -1
+		for i := range chans {
+			if heads[i] == nil && chans[i] != nil {
+				msg, ok := <-chans[i]
+				if !ok {
+					chans[i] = nil
+					continue
+				}
+				heads[i] = msg
+			}
+			if heads[i] != nil && (minI == -1 || logTsBefore(heads[i], heads[minI])) {
+				minI = i
+			}
+		}
+		if minI == -1 {
+			return nil
+		}
+		msg := heads[minI]
+		heads[minI] = nil
+		if err := send(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// logTsBefore reports whether 'a' was logged before 'b'. Messages without a
+// parseable timestamp (e.g. pachd's own log lines) sort first, which keeps
+// them in arrival order relative to each other.
+func logTsBefore(a, b *pps.LogMessage) bool {
+	at, aErr := types.TimestampFromProto(a.Ts)
+	bt, bErr := types.TimestampFromProto(b.Ts)
+	if aErr != nil || bErr != nil {
+		return bErr == nil
+	}
+	return at.Before(bt)
+}
+
+// getLogsFromStore reads a finished job's logs from the object store, where
+// the workers persisted them (see pkg/logstore). It reports whether any
+// matching lines were found; if none were, the caller falls back to the
+// older sources (the stats commit or the pods themselves).
+func (a *apiServer) getLogsFromStore(pachClient *client.APIClient, request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer) (bool, error) {
+	prefix := logstore.JobPrefix(request.Job.ID)
+	if request.Datum != nil && request.Datum.ID != "" {
+		prefix = logstore.DatumPrefix(request.Job.ID, request.Datum.ID)
+	}
+	var msgs []*pps.LogMessage
+	if err := logstore.ForEachLine(pachClient, prefix, func(msg *pps.LogMessage) error {
+		if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
+			return nil
+		}
+		if request.Job != nil && request.Job.ID != msg.JobID {
+			return nil
+		}
+		if request.Datum != nil && request.Datum.ID != msg.DatumID {
+			return nil
+		}
+		if request.WorkerID != "" && request.WorkerID != msg.WorkerID {
+			return nil
+		}
+		if request.Master != msg.Master {
+			return nil
+		}
+		if !workerpkg.MatchDatum(request.DataFilters, msg.Data) {
+			return nil
+		}
+		msgs = append(msgs, msg)
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	if len(msgs) == 0 {
+		return false, nil
+	}
+	// Each persisted object is internally ordered; sort so logs from
+	// different workers and datums come back in timestamp order.
+	sort.SliceStable(msgs, func(i, j int) bool { return logTsBefore(msgs[i], msgs[j]) })
+	for _, msg := range msgs {
+		if err := apiGetLogsServer.Send(msg); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
 func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.GetLogsRequest, apiGetLogsServer pps.API_GetLogsServer, statsCommit *pfs.Commit) error {
 	pfsClient := pachClient.PfsAPIClient
+	// Logs in the stats commit are indexed by datum ("<datumID>/logs"), so a
+	// datum filter narrows the scan to a single file instead of every
+	// worker's output.
+	pattern := "*/logs"
+	if request.Datum != nil && request.Datum.ID != "" {
+		pattern = path.Join(request.Datum.ID, "logs")
+	}
 	fs, err := pfsClient.GlobFileStream(pachClient.Ctx(), &pfs.GlobFileRequest{
 		Commit:  statsCommit,
-		Pattern: "*/logs", // this is the path where logs reside
+		Pattern: pattern,
 	})
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -1392,6 +1791,7 @@ func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.
 	limiter := limit.New(20)
 	var eg errgroup.Group
 	var mu sync.Mutex
+	var msgs []*pps.LogMessage
 	for {
 		fileInfo, err := fs.Recv()
 		if err == io.EOF {
@@ -1424,6 +1824,9 @@ func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.
 				if request.Datum != nil && request.Datum.ID != msg.DatumID {
 					continue
 				}
+				if request.WorkerID != "" && request.WorkerID != msg.WorkerID {
+					continue
+				}
 				if request.Master != msg.Master {
 					continue
 				}
@@ -1432,16 +1835,24 @@ func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.
 				}
 
 				mu.Lock()
-				if err := apiGetLogsServer.Send(msg); err != nil {
-					mu.Unlock()
-					return err
-				}
+				msgs = append(msgs, msg)
 				mu.Unlock()
 			}
 			return nil
 		})
 	}
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	// Each datum's log file is internally ordered, but the files are read
+	// concurrently; sort so the merged logs come back in timestamp order.
+	sort.SliceStable(msgs, func(i, j int) bool { return logTsBefore(msgs[i], msgs[j]) })
+	for _, msg := range msgs {
+		if err := apiGetLogsServer.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
@@ -1481,6 +1892,26 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 	if pipelineInfo.OutputBranch == "" {
 		return fmt.Errorf("pipeline needs to specify an output branch")
 	}
+	if pipelineInfo.SharedCache != nil {
+		if !sharedCacheNameMatcher.MatchString(pipelineInfo.SharedCache.Name) {
+			return fmt.Errorf("invalid shared cache name %q: it must be a valid kubernetes resource name (lowercase alphanumeric characters and '-')", pipelineInfo.SharedCache.Name)
+		}
+		if pipelineInfo.SharedCache.Size_ != "" {
+			if _, err := resource.ParseQuantity(pipelineInfo.SharedCache.Size_); err != nil {
+				return fmt.Errorf("could not parse shared cache size '%s': %v", pipelineInfo.SharedCache.Size_, err)
+			}
+		}
+	}
+	extraOutputSeen := make(map[string]bool)
+	for _, name := range pipelineInfo.ExtraOutputs {
+		if !extraOutputMatcher.MatchString(name) {
+			return fmt.Errorf("invalid extra output name %q: it must consist of alphanumeric characters, '-' or '_'", name)
+		}
+		if extraOutputSeen[name] {
+			return fmt.Errorf("duplicate extra output %q", name)
+		}
+		extraOutputSeen[name] = true
+	}
 	if _, err := resource.ParseQuantity(pipelineInfo.CacheSize); err != nil {
 		return fmt.Errorf("could not parse cacheSize '%s': %v", pipelineInfo.CacheSize, err)
 	}
@@ -1490,21 +1921,143 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 			return err
 		}
 	}
+	if pipelineInfo.JobTimeoutWarning != nil {
+		warning, err := types.DurationFromProto(pipelineInfo.JobTimeoutWarning)
+		if err != nil {
+			return err
+		}
+		if pipelineInfo.JobTimeout != nil {
+			timeout, err := types.DurationFromProto(pipelineInfo.JobTimeout)
+			if err != nil {
+				return err
+			}
+			if warning >= timeout {
+				return fmt.Errorf("JobTimeoutWarning must be less than JobTimeout")
+			}
+		}
+	}
 	if pipelineInfo.DatumTimeout != nil {
 		_, err := types.DurationFromProto(pipelineInfo.DatumTimeout)
 		if err != nil {
 			return err
 		}
 	}
+	if pipelineInfo.DatumTimeoutSpec != nil {
+		if pipelineInfo.DatumTimeout != nil {
+			return fmt.Errorf("datum_timeout_spec can't be combined with datum_timeout")
+		}
+		if pipelineInfo.DatumTimeoutSpec.Base != nil {
+			if _, err := types.DurationFromProto(pipelineInfo.DatumTimeoutSpec.Base); err != nil {
+				return err
+			}
+		}
+		if pipelineInfo.DatumTimeoutSpec.PerGB == nil {
+			return fmt.Errorf("datum_timeout_spec needs per_gb (otherwise use datum_timeout)")
+		}
+		if _, err := types.DurationFromProto(pipelineInfo.DatumTimeoutSpec.PerGB); err != nil {
+			return err
+		}
+	}
 	if pipelineInfo.PodSpec != "" && !json.Valid([]byte(pipelineInfo.PodSpec)) {
 		return fmt.Errorf("malformed PodSpec")
 	}
 	if pipelineInfo.PodPatch != "" && !json.Valid([]byte(pipelineInfo.PodPatch)) {
 		return fmt.Errorf("malformed PodPatch")
 	}
+	if pipelineInfo.MaxQueuedJobs < 0 {
+		return fmt.Errorf("MaxQueuedJobs must be >= 0")
+	}
+	if pipelineInfo.UploadConcurrency < 0 {
+		return fmt.Errorf("UploadConcurrency must be >= 0")
+	}
+	if pipelineInfo.PauseWindow != nil {
+		if _, err := cron.ParseStandard(pipelineInfo.PauseWindow.Start); err != nil {
+			return fmt.Errorf("pause_window.start is not a valid cron spec: %v", err)
+		}
+		duration, err := types.DurationFromProto(pipelineInfo.PauseWindow.Duration)
+		if err != nil {
+			return err
+		}
+		if duration <= 0 {
+			return fmt.Errorf("pause_window.duration must be positive")
+		}
+	}
+	if pipelineInfo.CanarySamplePercent < 0 || pipelineInfo.CanarySamplePercent > 100 {
+		return fmt.Errorf("CanarySamplePercent must be between 0 and 100")
+	}
+	if pipelineInfo.VersionedOutput && pipelineInfo.OutputBranch != "master" {
+		return fmt.Errorf("VersionedOutput requires the default output branch (master); " +
+			"each version writes to its own branch and master follows the promoted version")
+	}
+	if pipelineInfo.SchedulingSpec != nil {
+		switch pipelineInfo.SchedulingSpec.Arch {
+		case "", "amd64", "arm64":
+		default:
+			return fmt.Errorf("invalid arch \"%s\"; must be \"amd64\" or \"arm64\"", pipelineInfo.SchedulingSpec.Arch)
+		}
+	}
+	if pipelineInfo.MLflow != nil && pipelineInfo.MLflow.TrackingURI == "" {
+		return fmt.Errorf("mlflow.tracking_uri is required when mlflow is set")
+	}
+	if pipelineInfo.StatsService != nil {
+		if pipelineInfo.Service != nil {
+			return fmt.Errorf("stats_service cannot be set on a service pipeline")
+		}
+		if pipelineInfo.StatsService.Path == "" && !pipelineInfo.EnableStats {
+			return fmt.Errorf("stats_service requires enable_stats unless stats_service.path selects an output subdirectory")
+		}
+		if p := pipelineInfo.StatsService.ExternalPort; p != 0 && (p < 30000 || p > 32767) {
+			return fmt.Errorf("stats_service.external_port must be in the NodePort range (30000-32767)")
+		}
+	}
+	if pipelineInfo.Egress != nil {
+		if err := a.validateEgressClassification(pachClient, pipelineInfo); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// validateEgressClassification blocks pipelines that egress data derived from
+// "confidential" or "pii" repos (see CreateRepoRequest.Classification) unless
+// the egress URL starts with one of the prefixes in the
+// CLASSIFICATION_EGRESS_ALLOWLIST environment variable (comma-separated).
+// Blocked attempts are recorded in the audit log.
+func (a *apiServer) validateEgressClassification(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	var restrictedRepo string
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if restrictedRepo != "" || input.Pfs == nil {
+			return
+		}
+		repoInfo, err := pachClient.InspectRepo(input.Pfs.Repo)
+		if err != nil {
+			return // missing repos are caught by validateInput
+		}
+		if client.RestrictedClassification(repoInfo.Classification) {
+			restrictedRepo = input.Pfs.Repo
+		}
+	})
+	if restrictedRepo == "" {
+		return nil
+	}
+	for _, prefix := range strings.Split(os.Getenv(client.EgressAllowlistEnvVar), ",") {
+		if prefix != "" && strings.HasPrefix(pipelineInfo.Egress.URL, prefix) {
+			return nil
+		}
+	}
+	audit.Violation(pipelineInfo.Pipeline.Name, "egress", restrictedRepo,
+		fmt.Sprintf("egress URL %q is not in the classification allowlist", pipelineInfo.Egress.URL))
+	return fmt.Errorf("input repo %s is classified and its data cannot be egressed to %q; "+
+		"ask your admin to add the destination to %s", restrictedRepo, pipelineInfo.Egress.URL,
+		client.EgressAllowlistEnvVar)
+}
+
+// versionedOutputBranch names the output branch that version 'version' of a
+// versioned-output pipeline writes to.
+func versionedOutputBranch(version uint64) string {
+	return fmt.Sprintf("v%d", version)
+}
+
 func branchProvenance(input *pps.Input) []*pfs.Branch {
 	var result []*pfs.Branch
 	pps.VisitInput(input, func(input *pps.Input) {
@@ -1757,34 +2310,76 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if request.Salt == "" {
 		request.Salt = uuid.NewWithoutDashes()
 	}
+	if request.Canary {
+		if !request.Update {
+			return nil, fmt.Errorf("canary only applies to pipeline updates")
+		}
+		if strings.HasSuffix(request.Pipeline.Name, canarySuffix) {
+			return nil, fmt.Errorf("pipeline %s is already a canary", request.Pipeline.Name)
+		}
+		// There must be a live pipeline to canary against
+		if _, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err != nil {
+			return nil, err
+		}
+		// Run the new spec as a shadow pipeline next to the live one. It writes
+		// to its own output repo ("<pipeline>-canary") so its results can be
+		// compared against live output, and PromotePipeline replaces the live
+		// pipeline with its spec.
+		request.Pipeline = client.NewPipeline(request.Pipeline.Name + canarySuffix)
+		if request.CanarySampleBranch != "" {
+			pps.VisitInput(request.Input, func(input *pps.Input) {
+				if input.Pfs != nil {
+					input.Pfs.Branch = request.CanarySampleBranch
+				}
+			})
+		}
+	} else if request.CanarySampleBranch != "" || request.CanarySamplePercent != 0 {
+		return nil, fmt.Errorf("canary sampling options only apply to canary updates")
+	}
 	pipelineInfo := &pps.PipelineInfo{
-		Pipeline:         request.Pipeline,
-		Version:          1,
-		Transform:        request.Transform,
-		ParallelismSpec:  request.ParallelismSpec,
-		HashtreeSpec:     request.HashtreeSpec,
-		Input:            request.Input,
-		OutputBranch:     request.OutputBranch,
-		Egress:           request.Egress,
-		CreatedAt:        now(),
-		ResourceRequests: request.ResourceRequests,
-		ResourceLimits:   request.ResourceLimits,
-		Description:      request.Description,
-		CacheSize:        request.CacheSize,
-		EnableStats:      request.EnableStats,
-		Salt:             request.Salt,
-		Batch:            request.Batch,
-		MaxQueueSize:     request.MaxQueueSize,
-		Service:          request.Service,
-		Spout:            request.Spout,
-		ChunkSpec:        request.ChunkSpec,
-		DatumTimeout:     request.DatumTimeout,
-		JobTimeout:       request.JobTimeout,
-		Standby:          request.Standby,
-		DatumTries:       request.DatumTries,
-		SchedulingSpec:   request.SchedulingSpec,
-		PodSpec:          request.PodSpec,
-		PodPatch:         request.PodPatch,
+		Pipeline:            request.Pipeline,
+		Version:             1,
+		Transform:           request.Transform,
+		ParallelismSpec:     request.ParallelismSpec,
+		HashtreeSpec:        request.HashtreeSpec,
+		Input:               request.Input,
+		OutputBranch:        request.OutputBranch,
+		Egress:              request.Egress,
+		CreatedAt:           now(),
+		ResourceRequests:    request.ResourceRequests,
+		ResourceLimits:      request.ResourceLimits,
+		Description:         request.Description,
+		CacheSize:           request.CacheSize,
+		EnableStats:         request.EnableStats,
+		Salt:                request.Salt,
+		Batch:               request.Batch,
+		MaxQueueSize:        request.MaxQueueSize,
+		Service:             request.Service,
+		Spout:               request.Spout,
+		ChunkSpec:           request.ChunkSpec,
+		DatumTimeout:        request.DatumTimeout,
+		JobTimeout:          request.JobTimeout,
+		JobTimeoutWarning:   request.JobTimeoutWarning,
+		Standby:             request.Standby,
+		DatumTries:          request.DatumTries,
+		SchedulingSpec:      request.SchedulingSpec,
+		PodSpec:             request.PodSpec,
+		PodPatch:            request.PodPatch,
+		Notification:        request.Notification,
+		TransformCaching:    request.TransformCaching,
+		MaxQueuedJobs:       request.MaxQueuedJobs,
+		CanarySamplePercent: request.CanarySamplePercent,
+		CanarySampleBranch:  request.CanarySampleBranch,
+		VersionedOutput:     request.VersionedOutput,
+		UploadConcurrency:   request.UploadConcurrency,
+		Group:               request.Group,
+		PauseWindow:         request.PauseWindow,
+		MLflow:              request.MLflow,
+		StatsService:        request.StatsService,
+		LogRetention:        request.LogRetention,
+		ExtraOutputs:        request.ExtraOutputs,
+		SharedCache:         request.SharedCache,
+		DatumTimeoutSpec:    request.DatumTimeoutSpec,
 	}
 	setPipelineDefaults(pipelineInfo)
 
@@ -1792,6 +2387,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if err := a.validatePipeline(pachClient, pipelineInfo); err != nil {
 		return nil, err
 	}
+	if err := a.validateDAGAcyclic(pachClient, pipelineInfo); err != nil {
+		return nil, err
+	}
 	var visitErr error
 	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
 		if input.Cron != nil {
@@ -1865,6 +2463,11 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				if !request.Reprocess {
 					pipelineInfo.Salt = oldPipelineInfo.Salt
 				}
+				if pipelineInfo.VersionedOutput {
+					// Each version writes to its own branch; master keeps
+					// following the promoted version until PromotePipeline
+					pipelineInfo.OutputBranch = versionedOutputBranch(pipelineInfo.Version)
+				}
 				// Write updated PipelineInfo back to PFS.
 				commit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
 				if err != nil {
@@ -1885,6 +2488,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				return nil, err
 			}
 		}
+		if oldPipelineInfo.OutputBranch != pipelineInfo.OutputBranch {
+			a.warnOrphanedDownstream(pachClient, pipelineName, oldPipelineInfo.OutputBranch, pipelineInfo.OutputBranch)
+		}
 	} else {
 		// Create output repo, pipeline output, and stats
 		if _, err := pfsClient.CreateRepo(ctx, &pfs.CreateRepoRequest{
@@ -1892,6 +2498,11 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}); err != nil && !isAlreadyExistsErr(err) {
 			return nil, err
 		}
+		if pipelineInfo.VersionedOutput {
+			// The first version is promoted automatically (master has nothing
+			// else to follow yet)
+			pipelineInfo.OutputBranch = versionedOutputBranch(pipelineInfo.Version)
+		}
 		commit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
 		if err != nil {
 			return nil, err
@@ -1903,6 +2514,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			SpecCommit: commit,
 			State:      pps.PipelineState_PIPELINE_STARTING,
 		}
+		if pipelineInfo.VersionedOutput {
+			pipelinePtr.PromotedVersion = pipelineInfo.Version
+		}
 
 		// Generate pipeline's auth token & add pipeline to the ACLs of input/output
 		// repos
@@ -1916,8 +2530,8 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 				}
 				return grpcutil.ScrubGRPC(err)
 			}
-			pipelinePtr.AuthToken = tokenResp.Token
-			return nil
+			pipelinePtr.AuthToken, err = kms.EncryptSensitive(ctx, tokenResp.Token)
+			return err
 		}); err != nil {
 			return nil, err
 		}
@@ -1962,6 +2576,25 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			return nil, fmt.Errorf("could not create/update stats branch: %v", err)
 		}
 	}
+	// Create a repo and branch for each extra output. Extra output branches
+	// are provenant on the output branch (like stats), so every output commit
+	// gets a sibling commit in each extra output repo with the same
+	// (transitive) provenance.
+	for _, name := range pipelineInfo.ExtraOutputs {
+		extraRepo := ppsutil.ExtraOutputRepo(pipelineName, name)
+		if _, err := pfsClient.CreateRepo(ctx, &pfs.CreateRepoRequest{
+			Repo:        client.NewRepo(extraRepo),
+			Description: fmt.Sprintf("Extra output %q of pipeline %s.", name, pipelineName),
+		}); err != nil && !isAlreadyExistsErr(err) {
+			return nil, err
+		}
+		if _, err := pfsClient.CreateBranch(ctx, &pfs.CreateBranchRequest{
+			Branch:     client.NewBranch(extraRepo, pipelineInfo.OutputBranch),
+			Provenance: []*pfs.Branch{outputBranch},
+		}); err != nil {
+			return nil, fmt.Errorf("could not create/update extra output branch %q: %v", name, err)
+		}
+	}
 
 	return &types.Empty{}, nil
 }
@@ -2050,6 +2683,11 @@ func (a *apiServer) inspectPipeline(pachClient *client.APIClient, name string) (
 	if err != nil {
 		return nil, err
 	}
+	if pipelinePtr.AuthToken != "" {
+		// surface the principal that the pipeline acts as, so callers can audit
+		// its (least-privilege) ACL entries with e.g. 'pachctl auth get'
+		pipelineInfo.AuthPrincipal = auth.PipelinePrefix + name
+	}
 	if pipelineInfo.Service != nil {
 		rcName := ppsutil.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
 		if err != nil {
@@ -2093,6 +2731,15 @@ func (a *apiServer) inspectPipeline(pachClient *client.APIClient, name string) (
 			pipelineInfo.GithookURL = githook.URLFromDomain(ingress.Hostname)
 		}
 	}
+	if pipelineInfo.StatsService != nil {
+		pipelineInfo.StatsServiceURL = "pending"
+		if statsInfo, err := a.inspectPipeline(pachClient, name+statsSuffix); err == nil {
+			rcName := ppsutil.PipelineRcName(statsInfo.Pipeline.Name, statsInfo.Version)
+			if svc, err := kubeClient.CoreV1().Services(a.namespace).Get(fmt.Sprintf("%s-user", rcName), metav1.GetOptions{}); err == nil && len(svc.Spec.Ports) > 0 {
+				pipelineInfo.StatsServiceURL = fmt.Sprintf("http://%s:%d", svc.Spec.ClusterIP, svc.Spec.Ports[0].Port)
+			}
+		}
+	}
 	return pipelineInfo, nil
 }
 
@@ -2196,12 +2843,28 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 	if err := pachClient.DeleteRepo(request.Pipeline.Name, request.Force); err != nil {
 		return nil, err
 	}
+	// Delete the pipeline's extra output repos along with the output repo
+	for _, name := range pipelineInfo.ExtraOutputs {
+		if err := pachClient.DeleteRepo(ppsutil.ExtraOutputRepo(request.Pipeline.Name, name), request.Force); err != nil && !isNotFoundErr(err) {
+			return nil, err
+		}
+	}
 
 	// Delete pipeline's workers
 	if err := a.deleteWorkersForPipeline(request.Pipeline.Name); err != nil {
 		return nil, fmt.Errorf("error deleting workers: %v", err)
 	}
 
+	// Delete the pipeline's companion stats service pipeline (if any)
+	if pipelineInfo.StatsService != nil {
+		if _, err := a.deletePipeline(pachClient, &pps.DeletePipelineRequest{
+			Pipeline: client.NewPipeline(request.Pipeline.Name + statsSuffix),
+			Force:    request.Force,
+		}); err != nil && !strings.Contains(err.Error(), "not found") {
+			return nil, err
+		}
+	}
+
 	// If necessary, revoke the pipeline's auth token and remove it from its inputs' ACLs
 	if pipelinePtr.AuthToken != "" {
 		// If auth was deactivated after the pipeline was created, don't try to revoke
@@ -2211,9 +2874,13 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 				if err := a.fixPipelineInputRepoACLs(superUserClient, nil, pipelineInfo); err != nil {
 					return grpcutil.ScrubGRPC(err)
 				}
-				_, err := superUserClient.RevokeAuthToken(superUserClient.Ctx(),
+				token, err := kms.DecryptSensitive(ctx, pipelinePtr.AuthToken)
+				if err != nil {
+					return err
+				}
+				_, err = superUserClient.RevokeAuthToken(superUserClient.Ctx(),
 					&auth.RevokeAuthTokenRequest{
-						Token: pipelinePtr.AuthToken,
+						Token: token,
 					})
 				return grpcutil.ScrubGRPC(err)
 			}); err != nil {
@@ -2274,38 +2941,51 @@ func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelin
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(ctx)
 
-	// Get request.Pipeline's info
-	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	names, err := a.pipelinesForRequest(pachClient, request.Pipeline, request.Group)
 	if err != nil {
 		return nil, err
 	}
+	for _, name := range names {
+		if err := a.startPipeline(pachClient, name); err != nil {
+			return nil, err
+		}
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) startPipeline(pachClient *client.APIClient, name string) error {
+	// Get the pipeline's info
+	pipelineInfo, err := a.inspectPipeline(pachClient, name)
+	if err != nil {
+		return err
+	}
 
 	// check if the caller is authorized to update this pipeline
 	if err := a.authorizePipelineOp(pachClient, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Replace missing branch provenance (removed by StopPipeline)
 	provenance := append(branchProvenance(pipelineInfo.Input),
 		client.NewBranch(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name))
 	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
+		name,
 		pipelineInfo.OutputBranch,
 		pipelineInfo.OutputBranch,
 		provenance,
 	); err != nil {
-		return nil, err
+		return err
 	}
 
 	pipelineInfo.Stopped = false
 	commit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit); err != nil {
-		return nil, err
+	if a.updatePipelineSpecCommit(pachClient, name, commit); err != nil {
+		return err
 	}
-	return &types.Empty{}, nil
+	return nil
 }
 
 func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
@@ -2313,38 +2993,82 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(ctx)
 
-	// Get request.Pipeline's info
-	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	names, err := a.pipelinesForRequest(pachClient, request.Pipeline, request.Group)
 	if err != nil {
 		return nil, err
 	}
+	for _, name := range names {
+		if err := a.stopPipeline(pachClient, name); err != nil {
+			return nil, err
+		}
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) stopPipeline(pachClient *client.APIClient, name string) error {
+	// Get the pipeline's info
+	pipelineInfo, err := a.inspectPipeline(pachClient, name)
+	if err != nil {
+		return err
+	}
 
 	// check if the caller is authorized to update this pipeline
 	if err := a.authorizePipelineOp(pachClient, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Remove branch provenance (pass branch twice so that it continues to point
 	// at the same commit, but also pass empty provenance slice)
 	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
+		name,
 		pipelineInfo.OutputBranch,
 		pipelineInfo.OutputBranch,
 		nil,
 	); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Update PipelineInfo with new state
 	pipelineInfo.Stopped = true
 	commit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit); err != nil {
+	if a.updatePipelineSpecCommit(pachClient, name, commit); err != nil {
+		return err
+	}
+	return nil
+}
+
+// pipelinesForRequest resolves the pipeline/group pair shared by
+// StartPipelineRequest and StopPipelineRequest into a list of pipeline names:
+// either the single named pipeline, or every pipeline whose spec assigns it to
+// 'group'. Exactly one of the two must be set.
+func (a *apiServer) pipelinesForRequest(pachClient *client.APIClient, pipeline *pps.Pipeline, group string) ([]string, error) {
+	if (pipeline == nil) == (group == "") {
+		return nil, fmt.Errorf("must specify either a pipeline or a group (but not both)")
+	}
+	if pipeline != nil {
+		return []string{pipeline.Name}, nil
+	}
+	var names []string
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(pachClient.Ctx()).List(pipelinePtr, col.DefaultOptions, func(string) error {
+		pipelineInfo, err := ppsutil.GetPipelineInfo(pachClient, pipelinePtr, true)
+		if err != nil {
+			return err
+		}
+		if pipelineInfo.Group == group {
+			names = append(names, pipelineInfo.Pipeline.Name)
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
-	return &types.Empty{}, nil
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no pipelines in group %q", group)
+	}
+	return names, nil
 }
 
 func (a *apiServer) RerunPipeline(ctx context.Context, request *pps.RerunPipelineRequest) (response *types.Empty, retErr error) {
@@ -2354,6 +3078,229 @@ func (a *apiServer) RerunPipeline(ctx context.Context, request *pps.RerunPipelin
 	return nil, fmt.Errorf("TODO")
 }
 
+func (a *apiServer) PromotePipeline(ctx context.Context, request *pps.PromotePipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "PromotePipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	if err := checkLoggedIn(pachClient); err != nil {
+		return nil, err
+	}
+	pipelineName := request.Pipeline.Name
+	liveInfo, err := a.inspectPipeline(pachClient, pipelineName)
+	if err != nil {
+		return nil, err
+	}
+	if liveInfo.VersionedOutput {
+		// Promote the current spec version: master now follows its output
+		// branch
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			pipelinePtr := &pps.EtcdPipelineInfo{}
+			return a.pipelines.ReadWrite(stm).Update(pipelineName, pipelinePtr, func() error {
+				pipelinePtr.PromotedVersion = liveInfo.Version
+				return nil
+			})
+		}); err != nil {
+			return nil, err
+		}
+		// Point master at the promoted branch's head right away; the PPS
+		// master moves it as further commits finish
+		branch := versionedOutputBranch(liveInfo.Version)
+		if ci, err := pachClient.InspectCommit(pipelineName, branch); err == nil {
+			if err := pachClient.CreateBranch(pipelineName, "master", ci.Commit.ID, nil); err != nil {
+				return nil, err
+			}
+		}
+		return &types.Empty{}, nil
+	}
+
+	canaryInfo, err := a.inspectPipeline(pachClient, pipelineName+canarySuffix)
+	if err != nil {
+		return nil, fmt.Errorf("pipeline %s has no canary: %v", pipelineName, err)
+	}
+
+	// Re-point the canary's spec at the live pipeline and apply it as a
+	// normal update
+	promoteReq := ppsutil.PipelineReqFromInfo(canaryInfo)
+	promoteReq.Pipeline = client.NewPipeline(pipelineName)
+	promoteReq.Update = true
+	if canaryInfo.CanarySampleBranch != "" {
+		// The canary's PFS inputs were pointed at a sample branch; point them
+		// back at the branches the live pipeline reads
+		liveBranches := make(map[string]string)
+		pps.VisitInput(liveInfo.Input, func(input *pps.Input) {
+			if input.Pfs != nil {
+				liveBranches[input.Pfs.Name] = input.Pfs.Branch
+			}
+		})
+		pps.VisitInput(promoteReq.Input, func(input *pps.Input) {
+			if input.Pfs != nil && input.Pfs.Branch == canaryInfo.CanarySampleBranch {
+				if branch, ok := liveBranches[input.Pfs.Name]; ok {
+					input.Pfs.Branch = branch
+				}
+			}
+		})
+	}
+	if _, err := a.CreatePipeline(ctx, promoteReq); err != nil {
+		return nil, err
+	}
+
+	// Tear down the canary now that the live pipeline runs its spec
+	if _, err := a.DeletePipeline(ctx, &pps.DeletePipelineRequest{Pipeline: canaryInfo.Pipeline}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// inputCycle walks upstream from the pipeline 'name' through the inputs of
+// the pipelines in 'pipelines', and returns the path of the first input cycle
+// it finds (or nil, if there is none).
+func inputCycle(pipelines map[string]*pps.PipelineInfo, name string, path []string) []string {
+	for i, ancestor := range path {
+		if ancestor == name {
+			return append(path[i:], name)
+		}
+	}
+	pipelineInfo, ok := pipelines[name]
+	if !ok {
+		return nil // 'name' is an input data repo, not a pipeline--no edges up
+	}
+	path = append(path, name)
+	var result []string
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if result == nil && input.Pfs != nil {
+			result = inputCycle(pipelines, input.Pfs.Repo, path)
+		}
+	})
+	return result
+}
+
+// validateDAGAcyclic checks that creating (or updating) 'pipelineInfo'
+// doesn't introduce an input cycle into the pipeline DAG. Any new cycle must
+// pass through the new pipeline, so it suffices to walk upstream from it.
+func (a *apiServer) validateDAGAcyclic(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	pipelines := make(map[string]*pps.PipelineInfo)
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(pachClient.Ctx()).List(pipelinePtr, col.DefaultOptions, func(name string) error {
+		if name == pipelineInfo.Pipeline.Name {
+			return nil // superseded by the version being created, added below
+		}
+		other, err := ppsutil.GetPipelineInfo(pachClient, pipelinePtr, true)
+		if err != nil {
+			return err
+		}
+		pipelines[other.Pipeline.Name] = other
+		return nil
+	}); err != nil {
+		return err
+	}
+	pipelines[pipelineInfo.Pipeline.Name] = pipelineInfo
+	if cycle := inputCycle(pipelines, pipelineInfo.Pipeline.Name, nil); cycle != nil {
+		return fmt.Errorf("pipeline would create an input cycle: %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// warnOrphanedDownstream logs a warning for every pipeline that reads
+// 'pipelineName@oldBranch' after an update moved the pipeline's output branch
+// from 'oldBranch' to 'newBranch'. It's best-effort: the update has already
+// committed, and 'pachctl validate dag' reports the same pipelines.
+func (a *apiServer) warnOrphanedDownstream(pachClient *client.APIClient, pipelineName, oldBranch, newBranch string) {
+	otherPtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(pachClient.Ctx()).List(otherPtr, col.DefaultOptions, func(otherName string) error {
+		if otherName == pipelineName {
+			return nil
+		}
+		other, err := ppsutil.GetPipelineInfo(pachClient, otherPtr, true)
+		if err != nil {
+			return err
+		}
+		pps.VisitInput(other.Input, func(input *pps.Input) {
+			if input.Pfs != nil && input.Pfs.Repo == pipelineName && input.Pfs.Branch == oldBranch {
+				logrus.Warnf("update moved the output branch of pipeline %s from %s to %s, orphaning downstream pipeline %s (which reads %s@%s and will never see new data)",
+					pipelineName, oldBranch, newBranch, otherName, pipelineName, oldBranch)
+			}
+		})
+		return nil
+	}); err != nil {
+		logrus.Errorf("could not check for orphaned downstream pipelines: %v", err)
+	}
+}
+
+func (a *apiServer) ValidateDAG(ctx context.Context, request *pps.ValidateDAGRequest) (response *pps.ValidateDAGResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.env.GetPachClient(ctx)
+	if err := checkLoggedIn(pachClient); err != nil {
+		return nil, err
+	}
+
+	// Assemble every pipeline's full spec
+	var pipelineInfos []*pps.PipelineInfo
+	pipelines := make(map[string]*pps.PipelineInfo)
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(pachClient.Ctx()).List(pipelinePtr, col.DefaultOptions, func(string) error {
+		pipelineInfo, err := ppsutil.GetPipelineInfo(pachClient, pipelinePtr, true)
+		if err != nil {
+			return err
+		}
+		pipelineInfos = append(pipelineInfos, pipelineInfo)
+		pipelines[pipelineInfo.Pipeline.Name] = pipelineInfo
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	response = &pps.ValidateDAGResponse{}
+	// Check each pipeline's inputs. Globs that don't compile can never match
+	// anything--validateInput rejects them now, but pipelines may predate that
+	// check.
+	for _, pipelineInfo := range pipelineInfos {
+		name := pipelineInfo.Pipeline.Name
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if input.Pfs == nil {
+				return
+			}
+			if _, err := globlib.Compile(input.Pfs.Glob, '/'); err != nil {
+				response.Errors = append(response.Errors,
+					fmt.Sprintf("pipeline %s: glob \"%s\" can never match anything: %v", name, input.Pfs.Glob, err))
+			}
+			if _, err := pachClient.InspectRepo(input.Pfs.Repo); err != nil {
+				response.Errors = append(response.Errors,
+					fmt.Sprintf("pipeline %s: input repo %s: %v", name, input.Pfs.Repo, err))
+				return
+			}
+			if upstream, ok := pipelines[input.Pfs.Repo]; ok && upstream.OutputBranch != input.Pfs.Branch {
+				response.Warnings = append(response.Warnings,
+					fmt.Sprintf("pipeline %s reads %s@%s, but pipeline %s writes to branch %s; %s will never see new data",
+						name, input.Pfs.Repo, input.Pfs.Branch, input.Pfs.Repo, upstream.OutputBranch, name))
+			}
+		})
+	}
+	// Check for input cycles (any pipeline on a cycle finds it, so one report
+	// per cycle suffices)
+	reported := make(map[string]bool)
+	for _, pipelineInfo := range pipelineInfos {
+		cycle := inputCycle(pipelines, pipelineInfo.Pipeline.Name, nil)
+		if cycle == nil {
+			continue
+		}
+		duplicate := false
+		for _, name := range cycle {
+			if reported[name] {
+				duplicate = true
+			}
+			reported[name] = true
+		}
+		if !duplicate {
+			response.Errors = append(response.Errors,
+				fmt.Sprintf("input cycle: %s", strings.Join(cycle, " -> ")))
+		}
+	}
+	return response, nil
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -2479,8 +3426,12 @@ func CollectActiveObjectsAndTags(ctx context.Context, pachClient *client.APIClie
 
 	eg = errgroup.Group{}
 	for _, pipelineInfo := range pipelineInfos {
+		salt := pipelineInfo.Salt
+		if pipelineInfo.TransformCaching {
+			salt = workerpkg.TransformSalt(pipelineInfo.Transform)
+		}
 		tags, err := pachClient.ObjectAPIClient.ListTags(pachClient.Ctx(), &pfs.ListTagsRequest{
-			Prefix:        client.DatumTagPrefix(pipelineInfo.Salt),
+			Prefix:        client.DatumTagPrefix(salt),
 			IncludeObject: true,
 		})
 		if err != nil {
@@ -2517,6 +3468,11 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 	if err := checkLoggedIn(pachClient); err != nil {
 		return nil, err
 	}
+	// GC is etcd-heavy (it scans and rewrites object metadata), so it's
+	// paused while etcd is under pressure rather than making things worse.
+	if degraded, reason := a.env.GetPressureMonitor().Degraded(); degraded {
+		return nil, grpc.Errorf(codes.Unavailable, "garbage collection is paused while etcd is under pressure (%s); retry later", reason)
+	}
 	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
 	if err != nil {
 		return nil, err
@@ -2663,10 +3619,14 @@ func (a *apiServer) ActivateAuth(ctx context.Context, req *pps.ActivateAuthReque
 				if err != nil {
 					return fmt.Errorf("could not generate pipeline auth token: %v", grpcutil.ScrubGRPC(err))
 				}
+				encryptedToken, err := kms.EncryptSensitive(ctx, tokenResp.Token)
+				if err != nil {
+					return err
+				}
 				_, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 					var pipelinePtr pps.EtcdPipelineInfo
 					if err := a.pipelines.ReadWrite(stm).Update(pipelineName, &pipelinePtr, func() error {
-						pipelinePtr.AuthToken = tokenResp.Token
+						pipelinePtr.AuthToken = encryptedToken
 						return nil
 					}); err != nil {
 						return fmt.Errorf("could not update \"%s\" with new auth token: %v",
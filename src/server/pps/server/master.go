@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"os"
 	"path"
 	"strings"
 	"time"
@@ -25,12 +26,19 @@ import (
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/logstore"
+	"github.com/pachyderm/pachyderm/src/server/pkg/notify"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 )
 
 const (
 	masterLockPath = "_master_lock"
+
+	// logRetentionInterval is how often the master scans for persisted job
+	// logs that have outlived their pipeline's log retention.
+	logRetentionInterval = time.Hour
 )
 
 var (
@@ -60,12 +68,16 @@ func (a *apiServer) master() {
 
 		log.Infof("Launching PPS master process")
 
-		pipelineWatcher, err := a.pipelines.ReadOnly(ctx).Watch(watch.WithPrevKV())
+		pipelineWatcher, err := a.pipelines.ReadOnly(ctx).Watch(watch.WithPrevKV(), watch.WithCoalesce())
 		if err != nil {
 			return fmt.Errorf("error creating watch: %+v", err)
 		}
 		defer pipelineWatcher.Close()
 
+		// Periodically delete persisted job logs (see pkg/logstore) for
+		// pipelines that set a log retention.
+		go a.expireJobLogs(ctx, pachClient)
+
 		// watchChan will be nil if the Watch call below errors, this means
 		// that we won't receive events from k8s and won't be able to detect
 		// errors in pods. We could just return that error and retry but that
@@ -183,6 +195,11 @@ func (a *apiServer) master() {
 							// get a chance at creating the workers.
 							return err
 						}
+						if pipelineInfo.StatsService != nil {
+							if err := a.checkOrDeployStatsService(pachClient, pipelineInfo); err != nil {
+								return err
+							}
+						}
 					}
 					if pipelineInfo.State == pps.PipelineState_PIPELINE_RUNNING {
 						if err := a.scaleUpWorkersForPipeline(pipelineInfo); err != nil {
@@ -247,7 +264,20 @@ func (a *apiServer) master() {
 }
 
 func (a *apiServer) setPipelineFailure(ctx context.Context, pipelineName string, reason string) error {
-	return ppsutil.FailPipeline(ctx, a.env.GetEtcdClient(), a.pipelines, pipelineName, reason)
+	if err := ppsutil.FailPipeline(ctx, a.env.GetEtcdClient(), a.pipelines, pipelineName, reason); err != nil {
+		return err
+	}
+	// Crash loops are reported to the cluster-level webhook (if any); the
+	// pipeline's own spec isn't consulted because it may be the thing that's
+	// broken.
+	if err := notify.New(os.Getenv(notify.NotificationWebhookEnvVar), []string{notify.EventPipelineCrashLoop}, "").Send(&notify.Message{
+		Event:    notify.EventPipelineCrashLoop,
+		Pipeline: pipelineName,
+		Reason:   reason,
+	}); err != nil {
+		log.Errorf("error sending crash-loop notification for pipeline %s: %v", pipelineName, err)
+	}
+	return nil
 }
 
 func (a *apiServer) checkOrDeployGithookService() error {
@@ -337,7 +367,8 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 			pipelineInfo.SpecCommit.ID,
 			pipelineInfo.SchedulingSpec,
 			pipelineInfo.PodSpec,
-			pipelineInfo.PodPatch)
+			pipelineInfo.PodPatch,
+			pipelineInfo.SharedCache)
 		// Set the pipeline name env
 		options.workerEnv = append(options.workerEnv, v1.EnvVar{
 			Name:  client.PPSPipelineNameEnv,
@@ -401,6 +432,20 @@ func (a *apiServer) deleteWorkersForPipeline(pipelineName string) error {
 			}
 		}
 	}
+	// Pre-pull DaemonSets are deleted regardless of whether pre-pulling is
+	// currently enabled, so disabling it cleans up DaemonSets from when it
+	// was on.
+	dss, err := kubeClient.AppsV1().DaemonSets(a.namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	for _, ds := range dss.Items {
+		if err := kubeClient.AppsV1().DaemonSets(a.namespace).Delete(ds.Name, opts); err != nil {
+			if !isNotFoundErr(err) {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -475,6 +520,24 @@ func (a *apiServer) monitorPipeline(pachClient *client.APIClient, pipelineInfo *
 			})
 		}
 	})
+	if pipelineInfo.VersionedOutput {
+		// Keep the pipeline's master branch following this version's output
+		// branch for as long as this version is the promoted one
+		eg.Go(func() error {
+			return backoff.RetryNotify(func() error {
+				return pachClient.SubscribeCommitF(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch, "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+					pipelinePtr := &pps.EtcdPipelineInfo{}
+					if err := a.pipelines.ReadOnly(pachClient.Ctx()).Get(pipelineInfo.Pipeline.Name, pipelinePtr); err != nil {
+						return err
+					}
+					if pipelinePtr.PromotedVersion != pipelineInfo.Version {
+						return nil
+					}
+					return pachClient.CreateBranch(pipelineInfo.Pipeline.Name, "master", ci.Commit.ID, nil)
+				})
+			}, backoff.NewInfiniteBackOff(), notifyCtx(pachClient.Ctx(), "versioned output for "+pipelineInfo.Pipeline.Name))
+		})
+	}
 	if !pipelineInfo.Standby {
 		// Standby is false so simply put it in RUNNING and leave it there.  This is
 		// only done with eg.Go so that we can handle all the errors in the
@@ -632,6 +695,55 @@ func (a *apiServer) makeCronCommits(pachClient *client.APIClient, in *pps.Input)
 	}
 }
 
+// expireJobLogs periodically deletes persisted job logs (see pkg/logstore)
+// that have outlived their pipeline's log retention. It runs in the PPS
+// master and exits when the master loses its lock (i.e. ctx is cancelled).
+func (a *apiServer) expireJobLogs(ctx context.Context, pachClient *client.APIClient) {
+	for {
+		select {
+		case <-time.After(logRetentionInterval):
+		case <-ctx.Done():
+			return
+		}
+		if err := a.expireJobLogsOnce(ctx, pachClient); err != nil {
+			log.Errorf("error expiring persisted job logs: %v", err)
+		}
+	}
+}
+
+func (a *apiServer) expireJobLogsOnce(ctx context.Context, pachClient *client.APIClient) error {
+	pipelineInfos, err := pachClient.ListPipeline()
+	if err != nil {
+		return err
+	}
+	for _, pipelineInfo := range pipelineInfos {
+		if pipelineInfo.LogRetention == nil {
+			continue
+		}
+		retention, err := types.DurationFromProto(pipelineInfo.LogRetention)
+		if err != nil {
+			return err
+		}
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, pipelineInfo.Pipeline, jobPtr, col.DefaultOptions, func(jobID string) error {
+			if !ppsutil.IsTerminal(jobPtr.State) || jobPtr.Finished == nil {
+				return nil
+			}
+			finished, err := types.TimestampFromProto(jobPtr.Finished)
+			if err != nil {
+				return err
+			}
+			if time.Since(finished) < retention {
+				return nil
+			}
+			return logstore.DeleteJobLogs(pachClient, jobID)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isNilBranchErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "has no head")
 }
@@ -22,6 +22,8 @@ func NewAPIServer(
 	iamRole string,
 	imagePullSecret string,
 	noExposeDockerSocket bool,
+	workerDirectObjectAccess bool,
+	prePullImages bool,
 	reporter *metrics.Reporter,
 	workerUsesRoot bool,
 	workerGrpcPort uint16,
@@ -44,6 +46,8 @@ func NewAPIServer(
 		iamRole:               iamRole,
 		imagePullSecret:       imagePullSecret,
 		noExposeDockerSocket:  noExposeDockerSocket,
+		workerDirectObjAccess: workerDirectObjectAccess,
+		prePullImages:         prePullImages,
 		reporter:              reporter,
 		workerUsesRoot:        workerUsesRoot,
 		pipelines:             ppsdb.Pipelines(env.GetEtcdClient(), etcdPrefix),
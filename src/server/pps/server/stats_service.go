@@ -0,0 +1,77 @@
+package server
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+const (
+	// statsSuffix is appended to a pipeline's name to name the companion
+	// service pipeline that serves its stats branch (or an output
+	// subdirectory) over HTTP (see CreatePipelineRequest.stats_service).
+	statsSuffix = "-stats"
+
+	// statsServicePort is the port that the companion's server listens on
+	// inside its container.
+	statsServicePort = 6006
+	// statsServiceDefaultExternalPort is the NodePort that the companion's
+	// k8s service is exposed on when the spec doesn't pick one.
+	statsServiceDefaultExternalPort = 30606
+
+	// tensorBoardImage is the image that the companion runs when
+	// stats_service.tensor_board is set.
+	tensorBoardImage = "tensorflow/tensorflow:1.13.1"
+	// fileServerImage is the image that the companion runs otherwise; it
+	// serves the mounted directory with python's built-in HTTP server.
+	fileServerImage = "python:3.7"
+)
+
+// checkOrDeployStatsService creates or updates the companion service pipeline
+// ("<pipeline>-stats") that serves pipelineInfo's stats branch (or the output
+// subdirectory named by stats_service.path) over HTTP. The companion is an
+// ordinary service pipeline, so its data is mounted under /pfs by the normal
+// worker machinery and the usual "<rc>-user" k8s service exposes its port;
+// inspectPipeline surfaces the resulting URL.
+func (a *apiServer) checkOrDeployStatsService(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+	spec := pipelineInfo.StatsService
+	repo := pipelineInfo.Pipeline.Name
+	branch := "stats"
+	if spec.Path != "" {
+		branch = pipelineInfo.OutputBranch
+	}
+	logdir := path.Join("/pfs", repo, spec.Path)
+	transform := &pps.Transform{
+		Image: fileServerImage,
+		Cmd:   []string{"python3", "-m", "http.server", fmt.Sprint(statsServicePort), "--directory", logdir},
+	}
+	if spec.TensorBoard {
+		transform = &pps.Transform{
+			Image: tensorBoardImage,
+			Cmd:   []string{"tensorboard", "--logdir", logdir, "--host", "0.0.0.0", "--port", fmt.Sprint(statsServicePort)},
+		}
+	}
+	externalPort := spec.ExternalPort
+	if externalPort == 0 {
+		externalPort = statsServiceDefaultExternalPort
+	}
+	request := &pps.CreatePipelineRequest{
+		Pipeline:        client.NewPipeline(repo + statsSuffix),
+		Transform:       transform,
+		Input:           client.NewPFSInputOpts(repo, repo, branch, "/", false),
+		ParallelismSpec: &pps.ParallelismSpec{Constant: 1},
+		Service: &pps.Service{
+			InternalPort: statsServicePort,
+			ExternalPort: externalPort,
+		},
+		Description: fmt.Sprintf("Stats service for the %s pipeline.", repo),
+	}
+	// Update only works on pipelines that already exist
+	if _, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err == nil {
+		request.Update = true
+	}
+	_, err := pachClient.PpsAPIClient.CreatePipeline(pachClient.Ctx(), request)
+	return err
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"os"
 	"strconv"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -11,9 +12,13 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/secrets"
 	"github.com/pachyderm/pachyderm/src/server/worker"
+	logrus "github.com/sirupsen/logrus"
 
+	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,6 +49,23 @@ type workerOptions struct {
 	// s3)
 	imagePullSecrets []v1.LocalObjectReference
 	service          *pps.Service
+	sharedCache      *pps.SharedCacheSpec // Shared cache volume mounted in the user container
+}
+
+// schedulingNodeSelector converts a SchedulingSpec into a pod node selector.
+// An arch constraint becomes a selector on the node's architecture label; the
+// worker and sidecar images are manifest lists, so each node's runtime pulls
+// the matching architecture on its own.
+func schedulingNodeSelector(schedulingSpec *pps.SchedulingSpec) map[string]string {
+	if schedulingSpec.Arch == "" {
+		return schedulingSpec.NodeSelector
+	}
+	nodeSelector := make(map[string]string)
+	for k, v := range schedulingSpec.NodeSelector {
+		nodeSelector[k] = v
+	}
+	nodeSelector["kubernetes.io/arch"] = schedulingSpec.Arch
+	return nodeSelector
 }
 
 func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
@@ -82,7 +104,27 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 	sidecarEnv = append(sidecarEnv, assets.GetSecretEnvVars(a.storageBackend)...)
 	workerEnv := options.workerEnv
 	workerEnv = append(options.workerEnv, v1.EnvVar{Name: "PACH_ROOT", Value: a.storageRoot})
-	workerEnv = append(workerEnv, assets.GetSecretEnvVars(a.storageBackend)...)
+	workerEnv = append(workerEnv, a.workerSecretEnvVars()...)
+	if a.workerDirectObjAccess {
+		workerEnv = append(workerEnv, v1.EnvVar{
+			Name:  worker.DirectObjectAccessEnvVar,
+			Value: "true",
+		})
+	}
+	// Pass the upload tuning knobs along to the workers
+	for _, name := range []string{obj.StorageUploadConcurrencyEnvVar, obj.StorageUploadPartSizeEnvVar} {
+		if value := os.Getenv(name); value != "" {
+			workerEnv = append(workerEnv, v1.EnvVar{Name: name, Value: value})
+		}
+	}
+	// Pass the content-hash selection along to the workers, so that they name
+	// objects the same way pachd does
+	if a.env.StorageHashAlgorithm != "" {
+		workerEnv = append(workerEnv, v1.EnvVar{Name: "STORAGE_HASH_ALGORITHM", Value: a.env.StorageHashAlgorithm})
+	}
+	if a.env.StorageLegacyHashAlgorithm != "" {
+		workerEnv = append(workerEnv, v1.EnvVar{Name: "STORAGE_LEGACY_HASH_ALGORITHM", Value: a.env.StorageLegacyHashAlgorithm})
+	}
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
 	storageVolumeName := "pach-disk"
@@ -153,7 +195,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 	if err != nil {
 		return v1.PodSpec{}, err
 	}
-	if resp.State != enterprise.State_ACTIVE {
+	if !resp.State.Entitled() {
 		workerImage = assets.AddRegistry("", workerImage)
 	}
 	podSpec := v1.PodSpec{
@@ -203,7 +245,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 		SecurityContext:               securityContext,
 	}
 	if options.schedulingSpec != nil {
-		podSpec.NodeSelector = options.schedulingSpec.NodeSelector
+		podSpec.NodeSelector = schedulingNodeSelector(options.schedulingSpec)
 		podSpec.PriorityClassName = options.schedulingSpec.PriorityClassName
 	}
 	resourceRequirements := v1.ResourceRequirements{
@@ -252,10 +294,46 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 	return podSpec, nil
 }
 
+// workerSecretEnvVars returns the env vars that give the worker container
+// access to the object store. With direct object access enabled and an
+// Amazon backend, pachd mints short-lived credentials scoped to the storage
+// bucket and injects their values directly, so worker pods never hold the
+// cluster's long-lived storage secret; in every other case the vars
+// reference the storage secret, as the sidecar's do.
+func (a *apiServer) workerSecretEnvVars() []v1.EnvVar {
+	if a.workerDirectObjAccess && a.storageBackend == obj.Amazon {
+		creds, err := obj.ScopedAmazonCreds(
+			os.Getenv(obj.AmazonRegionEnvVar),
+			os.Getenv(obj.AmazonBucketEnvVar),
+			&obj.AmazonCreds{
+				ID:     os.Getenv(obj.AmazonIDEnvVar),
+				Secret: os.Getenv(obj.AmazonSecretEnvVar),
+				Token:  os.Getenv(obj.AmazonTokenEnvVar),
+			},
+			obj.ScopedCredDuration,
+		)
+		if err != nil {
+			logrus.Errorf("could not mint scoped worker credentials (falling back to the storage secret): %v", err)
+		} else {
+			return []v1.EnvVar{
+				{Name: obj.StorageBackendEnvVar, Value: a.storageBackend},
+				{Name: obj.AmazonRegionEnvVar, Value: os.Getenv(obj.AmazonRegionEnvVar)},
+				{Name: obj.AmazonBucketEnvVar, Value: os.Getenv(obj.AmazonBucketEnvVar)},
+				{Name: obj.AmazonIDEnvVar, Value: creds.ID},
+				{Name: obj.AmazonSecretEnvVar, Value: creds.Secret},
+				{Name: obj.AmazonTokenEnvVar, Value: creds.Token},
+				{Name: obj.AmazonDistributionEnvVar, Value: os.Getenv(obj.AmazonDistributionEnvVar)},
+			}
+		}
+	}
+	return assets.GetSecretEnvVars(a.storageBackend)
+}
+
 func (a *apiServer) getWorkerOptions(pipelineName string, pipelineVersion uint64,
 	parallelism int32, resourceRequests *v1.ResourceList, resourceLimits *v1.ResourceList,
 	transform *pps.Transform, cacheSize string, service *pps.Service,
-	specCommitID string, schedulingSpec *pps.SchedulingSpec, podSpec string, podPatch string) *workerOptions {
+	specCommitID string, schedulingSpec *pps.SchedulingSpec, podSpec string, podPatch string,
+	sharedCache *pps.SharedCacheSpec) *workerOptions {
 	rcName := ppsutil.PipelineRcName(pipelineName, pipelineVersion)
 	labels := labels(rcName)
 	labels["version"] = version.PrettyVersion()
@@ -275,6 +353,19 @@ func (a *apiServer) getWorkerOptions(pipelineName string, pipelineVersion uint64
 			},
 		)
 	}
+	// If any env var is a secret reference, pass along pachd's Vault address
+	// and role so the worker can resolve the references at pod start with its
+	// own identity
+	if secrets.HasRefs(transform.Env) {
+		for _, envVar := range []string{secrets.VaultAddressEnvVar, secrets.VaultRoleEnvVar} {
+			if value := os.Getenv(envVar); value != "" {
+				workerEnv = append(workerEnv, v1.EnvVar{
+					Name:  envVar,
+					Value: value,
+				})
+			}
+		}
+	}
 	// We use Kubernetes' "Downward API" so the workers know their IP
 	// addresses, which they will then post on etcd so the job managers
 	// can discover the workers.
@@ -377,6 +468,26 @@ func (a *apiServer) getWorkerOptions(pipelineName string, pipelineVersion uint64
 		Name:      client.PPSWorkerVolume,
 		MountPath: client.PPSInputPrefix,
 	})
+	if sharedCache != nil {
+		mountPath := sharedCache.MountPath
+		if mountPath == "" {
+			mountPath = "/cache/" + sharedCache.Name
+		}
+		volumes = append(volumes, v1.Volume{
+			Name: "shared-cache",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: sharedCachePVCName(sharedCache.Name),
+					ReadOnly:  sharedCache.ReadOnly,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, v1.VolumeMount{
+			Name:      "shared-cache",
+			MountPath: mountPath,
+			ReadOnly:  sharedCache.ReadOnly,
+		})
+	}
 	var imagePullSecrets []v1.LocalObjectReference
 	for _, secret := range transform.ImagePullSecrets {
 		imagePullSecrets = append(imagePullSecrets, v1.LocalObjectReference{Name: secret})
@@ -407,10 +518,64 @@ func (a *apiServer) getWorkerOptions(pipelineName string, pipelineVersion uint64
 		schedulingSpec:   schedulingSpec,
 		podSpec:          podSpec,
 		podPatch:         podPatch,
+		sharedCache:      sharedCache,
+	}
+}
+
+// sharedCachePVCName returns the name of the PersistentVolumeClaim backing a
+// shared cache (see SharedCacheSpec). Pipelines that name the same cache
+// share the claim.
+func sharedCachePVCName(cache string) string {
+	return "pachyderm-cache-" + cache
+}
+
+// ensureSharedCachePVC creates the PersistentVolumeClaim backing
+// options.sharedCache if it doesn't exist yet. The claim asks for
+// ReadWriteMany so the cache can be mounted by workers on every node;
+// whether that's honored depends on the storage class.
+func (a *apiServer) ensureSharedCachePVC(options *workerOptions) error {
+	if options.sharedCache == nil || options.sharedCache.ReadOnly {
+		return nil
+	}
+	size := options.sharedCache.Size_
+	if size == "" {
+		size = "1Gi"
+	}
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return err
+	}
+	pvc := &v1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   sharedCachePVCName(options.sharedCache.Name),
+			Labels: map[string]string{"suite": suite},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: quantity,
+				},
+			},
+		},
+	}
+	if options.sharedCache.StorageClass != "" {
+		pvc.Spec.StorageClassName = &options.sharedCache.StorageClass
 	}
+	if _, err := a.env.GetKubeClient().CoreV1().PersistentVolumeClaims(a.namespace).Create(pvc); err != nil && !isAlreadyExistsErr(err) {
+		return err
+	}
+	return nil
 }
 
 func (a *apiServer) createWorkerRc(options *workerOptions) error {
+	if err := a.ensureSharedCachePVC(options); err != nil {
+		return err
+	}
 	podSpec, err := a.workerPodSpec(options)
 	if err != nil {
 		return err
@@ -507,5 +672,114 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 			}
 		}
 	}
+
+	if a.prePullImages {
+		if err := a.createPrePullDaemonSet(options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createPrePullDaemonSet creates a DaemonSet that runs one pod per node with
+// the pipeline's image, so that every node pulls the image when the pipeline
+// is created or updated rather than the first time a worker is scheduled
+// there. The pods run the worker binary in an idle mode (see PPSPrePullEnv)
+// and request no resources, so they don't keep real workloads from
+// scheduling.
+func (a *apiServer) createPrePullDaemonSet(options *workerOptions) error {
+	dsName := options.rcName + "-pull"
+	labels := labels(dsName)
+	labels["component"] = "pre-pull"
+	labels["pipelineName"] = options.labels["pipelineName"]
+	labels["version"] = version.PrettyVersion()
+	pullPolicy := a.workerImagePullPolicy
+	if pullPolicy == "" {
+		pullPolicy = "IfNotPresent"
+	}
+	workerImage := a.workerImage
+	resp, err := a.env.GetPachClient(context.Background()).Enterprise.GetState(context.Background(), &enterprise.GetStateRequest{})
+	if err != nil {
+		return err
+	}
+	if !resp.State.Entitled() {
+		workerImage = assets.AddRegistry("", workerImage)
+	}
+	zeroVal := int64(0)
+	cpuZeroQuantity := resource.MustParse("0")
+	memZeroQuantity := resource.MustParse("0M")
+	pachBinMount := []v1.VolumeMount{{
+		Name:      "pach-bin",
+		MountPath: "/pach-bin",
+	}}
+	podSpec := v1.PodSpec{
+		InitContainers: []v1.Container{
+			{
+				Name:            "init",
+				Image:           workerImage,
+				Command:         []string{"/pach/worker.sh"},
+				ImagePullPolicy: v1.PullPolicy(pullPolicy),
+				VolumeMounts:    pachBinMount,
+			},
+		},
+		Containers: []v1.Container{
+			{
+				Name:            "pull",
+				Image:           options.userImage,
+				Command:         []string{"/pach-bin/worker"},
+				ImagePullPolicy: v1.PullPolicy(pullPolicy),
+				Env: []v1.EnvVar{{
+					Name:  client.PPSPrePullEnv,
+					Value: "true",
+				}},
+				Resources: v1.ResourceRequirements{
+					Requests: map[v1.ResourceName]resource.Quantity{
+						v1.ResourceCPU:    cpuZeroQuantity,
+						v1.ResourceMemory: memZeroQuantity,
+					},
+				},
+				VolumeMounts: pachBinMount,
+			},
+		},
+		RestartPolicy: "Always",
+		Volumes: []v1.Volume{{
+			Name: "pach-bin",
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		}},
+		ImagePullSecrets:              options.imagePullSecrets,
+		TerminationGracePeriodSeconds: &zeroVal,
+	}
+	if options.schedulingSpec != nil {
+		podSpec.NodeSelector = schedulingNodeSelector(options.schedulingSpec)
+	}
+	ds := &apps.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        dsName,
+			Labels:      labels,
+			Annotations: options.annotations,
+		},
+		Spec: apps.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        dsName,
+					Labels:      labels,
+					Annotations: options.annotations,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+	if _, err := a.env.GetKubeClient().AppsV1().DaemonSets(a.namespace).Create(ds); err != nil {
+		if !isAlreadyExistsErr(err) {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,163 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/replication"
+
+	"github.com/juju/ansiterm"
+	"github.com/spf13/cobra"
+)
+
+// Cmds returns a slice containing replication commands.
+func Cmds(noMetrics *bool, noPortForwarding *bool) []*cobra.Command {
+	var commands []*cobra.Command
+
+	// newClients connects to the primary (the cluster pachctl is configured
+	// for) and the replica (the --target address).
+	newClients := func(target string) (*client.APIClient, *client.APIClient, error) {
+		if target == "" {
+			return nil, nil, fmt.Errorf("must specify the replica cluster with --target")
+		}
+		primary, err := client.NewOnUserMachine(!*noMetrics, !*noPortForwarding, "user")
+		if err != nil {
+			return nil, nil, err
+		}
+		replica, err := client.NewFromAddress(target)
+		if err != nil {
+			primary.Close()
+			return nil, nil, err
+		}
+		return primary, replica, nil
+	}
+
+	var target string
+	var interval time.Duration
+	run := &cobra.Command{
+		Use:   "{{alias}} <repo>...",
+		Short: "Continuously mirror repos to a replica cluster.",
+		Long: `Continuously mirror repos to a replica cluster.
+
+Finished commits on every branch of the given repos are replayed onto the
+replica in order, along with their file contents. The mirror records, in each
+replica commit's description, which primary commit it came from, so it picks
+up where it left off if restarted. Runs until interrupted.`,
+		Example: `
+# Mirror the repos "images" and "edges" to a cluster in another region:
+$ {{alias}} images edges --target 10.0.2.15:30650`,
+		Run: cmdutil.Run(func(args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("must specify at least one repo to mirror")
+			}
+			primary, replica, err := newClients(target)
+			if err != nil {
+				return err
+			}
+			defer primary.Close()
+			defer replica.Close()
+			fmt.Printf("Mirroring %d repos to %s; press Ctrl-C to stop.\n", len(args), target)
+			return replication.Mirror(context.Background(), primary, replica, args, interval, func(repo, branch string, mirrored int) {
+				fmt.Printf("%s: mirrored %d commits to %s@%s\n", time.Now().Format(time.Stamp), mirrored, repo, branch)
+			})
+		}),
+	}
+	run.Flags().StringVar(&target, "target", "", "Address (host:port) of the replica cluster's pachd.")
+	run.Flags().DurationVar(&interval, "interval", 10*time.Second, "How often to check the primary for new commits.")
+	commands = append(commands, cmdutil.CreateAlias(run, "replication run"))
+
+	var statusTarget string
+	status := &cobra.Command{
+		Use:   "{{alias}} <repo>...",
+		Short: "Show how far behind the primary the replica cluster is.",
+		Long:  "Show how far behind the primary the replica cluster is, per branch of each mirrored repo.",
+		Run: cmdutil.Run(func(args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("must specify at least one repo")
+			}
+			primary, replica, err := newClients(statusTarget)
+			if err != nil {
+				return err
+			}
+			defer primary.Close()
+			defer replica.Close()
+			statuses, err := replication.Status(primary, replica, args)
+			if err != nil {
+				return err
+			}
+			writer := ansiterm.NewTabWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			fmt.Fprintln(writer, "REPO\tBRANCH\tPRIMARY HEAD\tREPLICA HEAD\tLAG\t")
+			for _, status := range statuses {
+				lag := "up to date"
+				if status.Lag > 0 {
+					lag = fmt.Sprintf("%d commits behind", status.Lag)
+				}
+				replicaHead := status.ReplicaHead
+				if replicaHead == "" {
+					replicaHead = "-"
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t\n", status.Repo, status.Branch, status.PrimaryHead, replicaHead, lag)
+			}
+			return writer.Flush()
+		}),
+	}
+	status.Flags().StringVar(&statusTarget, "target", "", "Address (host:port) of the replica cluster's pachd.")
+	commands = append(commands, cmdutil.CreateAlias(status, "replication status"))
+
+	var promoteTarget string
+	var force bool
+	promote := &cobra.Command{
+		Use:   "{{alias}} <repo>...",
+		Short: "Verify that a replica cluster is ready to take over from the primary.",
+		Long: `Verify that a replica cluster is ready to take over from the primary.
+
+Checks that every branch of the given repos is fully mirrored and fails if any
+are behind (unless --force is passed, e.g. when the primary is unreachable and
+losing the unmirrored commits is acceptable). After promotion, stop any
+"replication run" processes and point writers at the replica.`,
+		Run: cmdutil.Run(func(args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("must specify at least one repo")
+			}
+			primary, replica, err := newClients(promoteTarget)
+			if err != nil {
+				return err
+			}
+			defer primary.Close()
+			defer replica.Close()
+			statuses, err := replication.Status(primary, replica, args)
+			if err != nil {
+				if !force {
+					return fmt.Errorf("could not check replica lag (pass --force to promote anyway, losing any unmirrored commits): %v", err)
+				}
+			} else {
+				behind := 0
+				for _, status := range statuses {
+					if status.Lag > 0 {
+						fmt.Printf("%s@%s is %d commits behind the primary\n", status.Repo, status.Branch, status.Lag)
+						behind++
+					}
+				}
+				if behind > 0 && !force {
+					return fmt.Errorf("%d branches are behind the primary; let \"replication run\" catch up, or pass --force to promote anyway and lose the unmirrored commits", behind)
+				}
+			}
+			fmt.Printf(`The replica at %s is promoted. To complete the failover:
+  - stop any "pachctl replication run" processes mirroring to it
+  - point clients and pipelines at the replica
+  - once the old primary is healthy again, mirror in the other direction to
+    catch it up before failing back
+`, promoteTarget)
+			return nil
+		}),
+	}
+	promote.Flags().StringVar(&promoteTarget, "target", "", "Address (host:port) of the replica cluster's pachd.")
+	promote.Flags().BoolVar(&force, "force", false, "Promote even if branches are behind or the primary is unreachable.")
+	commands = append(commands, cmdutil.CreateAlias(promote, "replication promote"))
+
+	return commands
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"io/ioutil"
 	"log"
@@ -48,6 +49,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/exec"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/logstore"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
@@ -61,6 +63,10 @@ const (
 	concurrency = 100
 	logBuffer   = 25
 
+	// How long cancelled user code gets to exit after SIGTERM before it's
+	// killed with SIGKILL
+	userCodeKillGracePeriod = 10 * time.Second
+
 	planPrefix        = "/plan"
 	chunkPrefix       = "/chunk"
 	mergePrefix       = "/merge"
@@ -112,6 +118,9 @@ type APIServer struct {
 	stats *pps.ProcessStats
 	// queueSize is the number of items enqueued
 	queueSize int64
+	// chunksProcessed is the number of chunks this worker has taken off the
+	// job's datum queue and processed
+	chunksProcessed int64
 
 	// The total number of workers for this pipeline
 	numWorkers int
@@ -141,6 +150,18 @@ type APIServer struct {
 	// hashtreeStorage is the where we store on disk hashtrees
 	hashtreeStorage string
 
+	// Direct object-store access (see obj_direct.go). directObj is nil unless
+	// direct access is enabled and the client could be created.
+	directObjOnce     sync.Once
+	directObj         obj.Client
+	directStorageRoot string
+
+	// directOutput collects files that user code streams through the
+	// PutOutputFile RPC for the datum that's currently running (see
+	// output_direct.go); it's nil when no datum is running
+	directOutputMu sync.Mutex
+	directOutput   *directOutput
+
 	// numShards is the number of filesystem shards for the output of this pipeline
 	numShards int64
 	// claimedShard communicates the context for the shard that was claimed
@@ -172,6 +193,49 @@ type taggedLogger struct {
 	objSize      int64
 	msgCh        chan string
 	eg           errgroup.Group
+	// persist, if set, accumulates this logger's lines so they can be stored
+	// in the object store when the datum finishes (see pkg/logstore); it's
+	// shared by all clones of the logger
+	persist *logPersister
+}
+
+// logPersister buffers a datum's log lines and writes them to the object
+// store (compressed, tagged by job/datum/worker) when the datum finishes, so
+// 'pachctl logs' keeps working after the worker pods are gone.
+type logPersister struct {
+	pachClient *client.APIClient
+	jobID      string
+	datumID    string
+	workerID   string
+
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	closed bool
+}
+
+// maxPersistedLogBytes caps how much of a single datum's logs are buffered
+// for persistence (uncompressed), so that a pathologically chatty datum (or
+// a long-running service) can't run the worker out of memory; anything past
+// the cap is only available from the live pod.
+const maxPersistedLogBytes = 64 * 1024 * 1024
+
+func (p *logPersister) write(msg string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed || p.buf.Len()+len(msg) > maxPersistedLogBytes {
+		return
+	}
+	p.buf.WriteString(msg)
+}
+
+func (p *logPersister) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	return logstore.PutLogs(p.pachClient, p.jobID, p.datumID, p.workerID, p.buf.Bytes())
 }
 
 // DatumID computes the id for a datum, this value is used in ListDatum and
@@ -210,6 +274,14 @@ func (a *APIServer) getTaggedLogger(pachClient *client.APIClient, jobID string,
 	// InputFileID is a single string id for the data from this input, it's used in logs and in
 	// the statsTree
 	result.template.DatumID = a.DatumID(data)
+	if jobID != "" {
+		result.persist = &logPersister{
+			pachClient: pachClient,
+			jobID:      jobID,
+			datumID:    result.template.DatumID,
+			workerID:   a.workerName,
+		}
+	}
 	if enableStats {
 		putObjClient, err := pachClient.ObjectAPIClient.PutObject(pachClient.Ctx())
 		if err != nil {
@@ -251,6 +323,9 @@ func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
 		return
 	}
 	fmt.Println(msg)
+	if logger.persist != nil {
+		logger.persist.write(msg + "\n")
+	}
 	if logger.putObjClient != nil {
 		logger.msgCh <- msg + "\n"
 	}
@@ -294,6 +369,16 @@ func (logger *taggedLogger) Close() (*pfs.Object, int64, error) {
 	return nil, 0, nil
 }
 
+// closePersist stores any buffered log lines in the object store; it's a
+// no-op for loggers that don't persist their logs (no job ID), or if the
+// logs were already stored.
+func (logger *taggedLogger) closePersist() error {
+	if logger.persist == nil {
+		return nil
+	}
+	return logger.persist.close()
+}
+
 func (logger *taggedLogger) clone() *taggedLogger {
 	return &taggedLogger{
 		template:     logger.template, // Copy struct
@@ -301,6 +386,7 @@ func (logger *taggedLogger) clone() *taggedLogger {
 		marshaler:    &jsonpb.Marshaler{},
 		putObjClient: logger.putObjClient,
 		msgCh:        logger.msgCh,
+		persist:      logger.persist,
 	}
 }
 
@@ -350,7 +436,7 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 	if err != nil {
 		logger.Logf("failed to get enterprise state with error: %v\n", err)
 	} else {
-		server.exportStats = resp.State == enterprise.State_ACTIVE
+		server.exportStats = resp.State.Entitled()
 	}
 	numWorkers, err := ppsutil.GetExpectedNumWorkers(kubeClient, pipelineInfo.ParallelismSpec)
 	if err != nil {
@@ -522,7 +608,7 @@ func (a *APIServer) downloadData(pachClient *client.APIClient, logger *taggedLog
 		if err := os.MkdirAll(filepath.Dir(outPath), 0700); err != nil {
 			return "", fmt.Errorf("mkdirall :%v", err)
 		}
-		if err := syscall.Mkfifo(outPath, 0666); err != nil {
+		if err := makeFifo(outPath); err != nil {
 			return "", fmt.Errorf("mkfifo :%v", err)
 		}
 	} else {
@@ -530,6 +616,12 @@ func (a *APIServer) downloadData(pachClient *client.APIClient, logger *taggedLog
 			return "", err
 		}
 	}
+	// Create a directory for each extra output (currently /pfs/out-<name>)
+	for _, name := range a.pipelineInfo.ExtraOutputs {
+		if err := os.MkdirAll(filepath.Join(dir, "out-"+name), 0777); err != nil {
+			return "", err
+		}
+	}
 	for _, input := range inputs {
 		if input.GitURL != "" {
 			if err := a.downloadGitData(pachClient, dir, input); err != nil {
@@ -538,6 +630,18 @@ func (a *APIServer) downloadData(pachClient *client.APIClient, logger *taggedLog
 			continue
 		}
 		file := input.FileInfo.File
+		if input.Lazy && statsTree == nil {
+			// Mount a FUSE layer at the input's root instead of downloading
+			// it: listings and reads are served out of PFS as the user code
+			// asks for them, so a datum that only reads a small slice of a
+			// large input (e.g. an indexed BAM file) only downloads that
+			// slice. Stats pipelines fall through to the pipe-based pull
+			// below because stats need the input's full file list.
+			if err := puller.MountLazy(pachClient, filepath.Join(dir, input.Name), file.Commit.Repo.Name, file.Commit.ID, file.Path); err != nil {
+				return "", err
+			}
+			continue
+		}
 		root := filepath.Join(dir, input.Name, file.Path)
 		var statsRoot string
 		if statsTree != nil {
@@ -560,6 +664,11 @@ func (a *APIServer) linkData(inputs []*Input, dir string) error {
 			return err
 		}
 	}
+	for _, name := range a.pipelineInfo.ExtraOutputs {
+		if err := os.Symlink(filepath.Join(dir, "out-"+name), filepath.Join(client.PPSInputPrefix, "out-"+name)); err != nil {
+			return err
+		}
+	}
 	return os.Symlink(filepath.Join(dir, "out"), filepath.Join(client.PPSInputPrefix, "out"))
 }
 
@@ -569,6 +678,11 @@ func (a *APIServer) unlinkData(inputs []*Input) error {
 			return err
 		}
 	}
+	for _, name := range a.pipelineInfo.ExtraOutputs {
+		if err := os.RemoveAll(filepath.Join(client.PPSInputPrefix, "out-"+name)); err != nil {
+			return err
+		}
+	}
 	return os.RemoveAll(filepath.Join(client.PPSInputPrefix, "out"))
 }
 
@@ -608,6 +722,77 @@ func (a *APIServer) reportDeferredUserCodeStats(err error, start time.Time, stat
 	}
 }
 
+// maxStderrTailLines is the number of trailing lines of the user code's
+// stderr that are included in a failed datum's reason.
+const maxStderrTailLines = 10
+
+// tailBuffer is an io.Writer that retains the last maxLines lines written to
+// it, so a failed datum's reason can include the tail of the user code's
+// stderr without buffering all of it.
+type tailBuffer struct {
+	maxLines int
+	lines    []string
+	partial  string
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.partial += string(p)
+	for {
+		i := strings.IndexByte(t.partial, '\n')
+		if i < 0 {
+			break
+		}
+		t.lines = append(t.lines, t.partial[:i])
+		t.partial = t.partial[i+1:]
+		if len(t.lines) > t.maxLines {
+			t.lines = t.lines[1:]
+		}
+	}
+	return len(p), nil
+}
+
+// String returns the retained stderr tail formatted for inclusion in an error
+// message, or "" if nothing was written.
+func (t *tailBuffer) String() string {
+	lines := t.lines
+	if t.partial != "" {
+		lines = append(lines, t.partial)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\nlast %d line(s) of stderr:\n%s", len(lines), strings.Join(lines, "\n"))
+}
+
+// killOnCancel terminates cmd (which must have been started) when ctx is
+// cancelled: SIGTERM first, so the user code can exit cleanly, then SIGKILL
+// if it hasn't exited after the grace period. The returned function must be
+// called once cmd has been waited on, so the watcher doesn't signal a
+// reaped process.
+func killOnCancel(ctx context.Context, cmd *exec.Cmd, logger *taggedLogger) func() {
+	waitDone := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			logger.Logf("terminating user code (%v)", ctx.Err())
+			if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+				cmd.Process.Kill()
+				return
+			}
+			select {
+			case <-time.After(userCodeKillGracePeriod):
+				logger.Logf("user code didn't exit within %v of SIGTERM; sending SIGKILL", userCodeKillGracePeriod)
+				cmd.Process.Kill()
+			case <-waitDone:
+			}
+		case <-waitDone:
+		}
+	}()
+	return func() {
+		close(waitDone)
+	}
+}
+
 // Run user code and return the combined output of stdout and stderr.
 func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, environ []string, stats *pps.ProcessStats, rawDatumTimeout *types.Duration) (retErr error) {
 	a.reportUserCodeStats(logger)
@@ -631,34 +816,36 @@ func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, envir
 	}
 
 	// Run user code
-	cmd := exec.CommandContext(ctx, a.pipelineInfo.Transform.Cmd[0], a.pipelineInfo.Transform.Cmd[1:]...)
+	cmd := exec.Command(a.pipelineInfo.Transform.Cmd[0], a.pipelineInfo.Transform.Cmd[1:]...)
 	if a.pipelineInfo.Transform.Stdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(a.pipelineInfo.Transform.Stdin, "\n") + "\n")
 	}
+	// Keep the tail of stderr so that if the datum fails its reason can
+	// include it
+	stderrTail := &tailBuffer{maxLines: maxStderrTailLines}
 	cmd.Stdout = logger.userLogger()
-	cmd.Stderr = logger.userLogger()
+	cmd.Stderr = io.MultiWriter(logger.userLogger(), stderrTail)
 	cmd.Env = environ
 	if a.uid != nil && a.gid != nil {
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			Credential: &syscall.Credential{
-				Uid: *a.uid,
-				Gid: *a.gid,
-			},
-		}
+		setUserCredentials(cmd, *a.uid, *a.gid)
 	}
 	cmd.Dir = a.pipelineInfo.Transform.WorkingDir
 	err := cmd.Start()
 	if err != nil {
 		return fmt.Errorf("error cmd.Start: %v", err)
 	}
-	// A context w a deadline will successfully cancel/kill
-	// the running process (minus zombies)
+	// Terminate the process promptly when the datum is cancelled (job killed
+	// or timeout hit), giving it a grace period to exit cleanly
+	defer killOnCancel(ctx, cmd, logger)()
 	state, err := cmd.Process.Wait()
 	if err != nil {
 		return fmt.Errorf("error cmd.Wait: %v", err)
 	}
 	if isDone(ctx) {
 		if err = ctx.Err(); err != nil {
+			if err == context.DeadlineExceeded {
+				return fmt.Errorf("datum timeout exceeded while running user code%v", stderrTail)
+			}
 			return err
 		}
 	}
@@ -682,6 +869,14 @@ func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, envir
 						return nil
 					}
 				}
+				// Explain how the user code died; this string becomes the
+				// failed datum's reason
+				if status.Signaled() && status.Signal() == syscall.SIGKILL {
+					return fmt.Errorf("user code killed by SIGKILL (likely OOM-killed; check the pipeline's memory limits)%v", stderrTail)
+				} else if status.Signaled() {
+					return fmt.Errorf("user code killed by signal %v%v", status.Signal(), stderrTail)
+				}
+				return fmt.Errorf("user code exited with code %d%v", status.ExitStatus(), stderrTail)
 			}
 		}
 		return fmt.Errorf("error cmd.WaitIO: %v", err)
@@ -700,7 +895,7 @@ func (a *APIServer) runUserErrorHandlingCode(ctx context.Context, logger *tagged
 		}
 	}(time.Now())
 
-	cmd := exec.CommandContext(ctx, a.pipelineInfo.Transform.ErrCmd[0], a.pipelineInfo.Transform.ErrCmd[1:]...)
+	cmd := exec.Command(a.pipelineInfo.Transform.ErrCmd[0], a.pipelineInfo.Transform.ErrCmd[1:]...)
 	if a.pipelineInfo.Transform.ErrStdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(a.pipelineInfo.Transform.ErrStdin, "\n") + "\n")
 	}
@@ -708,20 +903,16 @@ func (a *APIServer) runUserErrorHandlingCode(ctx context.Context, logger *tagged
 	cmd.Stderr = logger.userLogger()
 	cmd.Env = environ
 	if a.uid != nil && a.gid != nil {
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			Credential: &syscall.Credential{
-				Uid: *a.uid,
-				Gid: *a.gid,
-			},
-		}
+		setUserCredentials(cmd, *a.uid, *a.gid)
 	}
 	cmd.Dir = a.pipelineInfo.Transform.WorkingDir
 	err := cmd.Start()
 	if err != nil {
 		return fmt.Errorf("error cmd.Start: %v", err)
 	}
-	// A context w a deadline will successfully cancel/kill
-	// the running process (minus zombies)
+	// Terminate the process promptly when the datum is cancelled, giving it
+	// a grace period to exit cleanly
+	defer killOnCancel(ctx, cmd, logger)()
 	state, err := cmd.Process.Wait()
 	if err != nil {
 		return fmt.Errorf("error cmd.Wait: %v", err)
@@ -757,6 +948,69 @@ func (a *APIServer) runUserErrorHandlingCode(ctx context.Context, logger *tagged
 	return nil
 }
 
+// runUserHook runs one of the transform's hook commands (init_cmd or
+// teardown_cmd). Hooks run once per worker per job, without a datum mounted,
+// so they only see the transform's env vars plus the job's ID and output
+// commit.
+func (a *APIServer) runUserHook(ctx context.Context, logger *taggedLogger, name string, hookCmd []string, hookStdin []string, environ []string) (retErr error) {
+	logger.Logf("beginning to run user %s code", name)
+	defer func(start time.Time) {
+		if retErr != nil {
+			logger.Logf("errored running user %s code after %v: %v", name, time.Since(start), retErr)
+		} else {
+			logger.Logf("finished running user %s code after %v", name, time.Since(start))
+		}
+	}(time.Now())
+	// Guard against an empty command: validation rejects it, but specs written
+	// before that validation existed may still be running.
+	if len(hookCmd) == 0 {
+		return fmt.Errorf("empty %s_cmd", name)
+	}
+	cmd := exec.Command(hookCmd[0], hookCmd[1:]...)
+	if hookStdin != nil {
+		cmd.Stdin = strings.NewReader(strings.Join(hookStdin, "\n") + "\n")
+	}
+	cmd.Stdout = logger.userLogger()
+	cmd.Stderr = logger.userLogger()
+	cmd.Env = environ
+	if a.uid != nil && a.gid != nil {
+		setUserCredentials(cmd, *a.uid, *a.gid)
+	}
+	cmd.Dir = a.pipelineInfo.Transform.WorkingDir
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error cmd.Start: %v", err)
+	}
+	// Terminate the process promptly when the job is cancelled, giving it a
+	// grace period to exit cleanly
+	defer killOnCancel(ctx, cmd, logger)()
+	state, err := cmd.Process.Wait()
+	if err != nil {
+		return fmt.Errorf("error cmd.Wait: %v", err)
+	}
+	if isDone(ctx) {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+	}
+	// See runUserCode for why cmd.WaitIO is used instead of cmd.Wait
+	err = cmd.WaitIO(state, err)
+	if err != nil && !strings.Contains(err.Error(), "broken pipe") {
+		// (if err is an acceptable return code, don't return err)
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
+				for _, returnCode := range a.pipelineInfo.Transform.AcceptReturnCode {
+					if int(returnCode) == status.ExitStatus() {
+						return nil
+					}
+				}
+				return fmt.Errorf("user %s code exited with code %d", name, status.ExitStatus())
+			}
+		}
+		return fmt.Errorf("error cmd.WaitIO: %v", err)
+	}
+	return nil
+}
+
 func (a *APIServer) reportUploadStats(start time.Time, stats *pps.ProcessStats, logger *taggedLogger) {
 	duration := time.Since(start)
 	stats.UploadTime = types.DurationProto(duration)
@@ -784,6 +1038,29 @@ func (a *APIServer) reportUploadStats(start time.Time, stats *pps.ProcessStats,
 	}
 }
 
+// uploadEntry is one node of the datum's output tree, collected in walk
+// order so that the hashtree can be built after the files have been uploaded
+// concurrently.
+type uploadEntry struct {
+	path string
+	dir  bool
+	// filePath is the local path of a regular file that needs uploading
+	filePath string
+	// set by the upload goroutine (or upfront, for symlinks into the input)
+	hash []byte
+	size int64
+	node *hashtree.FileNodeProto
+}
+
+// blockSlot is one concurrent upload slot. Each slot owns an output block
+// (directly against the object store, or through the sidecar), created
+// lazily by the first upload that uses the slot.
+type blockSlot struct {
+	block  *pfs.Block
+	w      io.WriteCloser
+	offset uint64
+}
+
 func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag string, logger *taggedLogger, inputs []*Input, stats *pps.ProcessStats, statsTree *hashtree.Ordered, datumIdx int64) (retErr error) {
 	defer a.reportUploadStats(time.Now(), stats, logger)
 	logger.Logf("starting to upload output")
@@ -794,23 +1071,20 @@ func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag s
 			logger.Logf("finished uploading output after %v", time.Since(start))
 		}
 	}(time.Now())
-	// Setup client for writing file data
-	putObjsClient, err := pachClient.ObjectAPIClient.PutObjects(pachClient.Ctx())
+	outputPath := filepath.Join(dir, "out")
+	// Files the user code streamed through PutOutputFile; their content is
+	// already in the object store, they just need to be woven into the
+	// datum's hashtree at the right points (the tree must be built in walk
+	// order).
+	directFiles, err := a.finishDirectOutput()
 	if err != nil {
 		return err
 	}
-	block := &pfs.Block{Hash: uuid.NewWithoutDashes()}
-	if err := putObjsClient.Send(&pfs.PutObjectRequest{
-		Block: block,
-	}); err != nil {
-		return err
-	}
-	outputPath := filepath.Join(dir, "out")
-	buf := grpcutil.GetBuffer()
-	defer grpcutil.PutBuffer(buf)
-	var offset uint64
-	var tree *hashtree.Ordered
-	// Upload all files in output directory
+	// First pass: walk the output directory, collecting entries in walk order
+	// (the order the datum's hashtree must be built in). Regular files are
+	// uploaded below, uploadConcurrency at a time; the tree itself is built
+	// once their block refs are known.
+	var entries []*uploadEntry
 	if err := filepath.Walk(outputPath, func(filePath string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -819,7 +1093,6 @@ func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag s
 			return fmt.Errorf("file path is not valid utf-8: %s", filePath)
 		}
 		if filePath == outputPath {
-			tree = hashtree.NewOrdered("/")
 			return nil
 		}
 		relPath, err := filepath.Rel(outputPath, filePath)
@@ -831,10 +1104,7 @@ func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag s
 		// TODO(msteffen) write a test pipeline that outputs an empty directory and
 		// make sure it's preserved
 		if info.IsDir() {
-			tree.PutDir(relPath)
-			if statsTree != nil {
-				statsTree.PutDir(relPath)
-			}
+			entries = append(entries, &uploadEntry{path: relPath, dir: true})
 			return nil
 		}
 		// Under some circumstances, the user might have copied
@@ -891,10 +1161,7 @@ func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag s
 								return err
 							}
 							if info.IsDir() {
-								tree.PutDir(subRelPath)
-								if statsTree != nil {
-									statsTree.PutDir(subRelPath)
-								}
+								entries = append(entries, &uploadEntry{path: subRelPath, dir: true})
 								return nil
 							}
 							fc := input.FileInfo.File.Commit
@@ -911,69 +1178,161 @@ func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag s
 								blockRefs = append(blockRefs, objectInfo.BlockRef)
 							}
 							blockRefs = append(blockRefs, fileInfo.BlockRefs...)
-							n := &hashtree.FileNodeProto{BlockRefs: blockRefs}
-							tree.PutFile(subRelPath, fileInfo.Hash, int64(fileInfo.SizeBytes), n)
-							if statsTree != nil {
-								statsTree.PutFile(subRelPath, fileInfo.Hash, int64(fileInfo.SizeBytes), n)
-							}
+							entries = append(entries, &uploadEntry{
+								path: subRelPath,
+								hash: fileInfo.Hash,
+								size: int64(fileInfo.SizeBytes),
+								node: &hashtree.FileNodeProto{BlockRefs: blockRefs},
+							})
 							return nil
 						})
 					}
 				}
 			}
 		}
-		// Open local file that is being uploaded
-		f, err := os.Open(filePath)
-		if err != nil {
-			return fmt.Errorf("os.Open(%s): %v", filePath, err)
+		entries = append(entries, &uploadEntry{path: relPath, filePath: filePath})
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error walking output: %v", err)
+	}
+	// Upload the regular files, uploadConcurrency at a time. Each slot owns
+	// its own output block (created lazily) so that concurrent uploads don't
+	// serialize on a single stream.
+	uploadConcurrency := int(a.pipelineInfo.UploadConcurrency)
+	if uploadConcurrency <= 0 {
+		uploadConcurrency = 1
+	}
+	slots := make(chan *blockSlot, uploadConcurrency)
+	for i := 0; i < uploadConcurrency; i++ {
+		slots <- &blockSlot{}
+	}
+	var eg errgroup.Group
+	for _, entry := range entries {
+		if entry.dir || entry.node != nil {
+			continue
 		}
-		defer func() {
-			if err := f.Close(); err != nil && retErr == nil {
-				retErr = err
+		entry := entry
+		eg.Go(func() (retErr error) {
+			slot := <-slots
+			defer func() {
+				slots <- slot
+			}()
+			if slot.w == nil {
+				slot.block = &pfs.Block{Hash: uuid.NewWithoutDashes()}
+				w, err := a.newBlockWriter(pachClient, slot.block)
+				if err != nil {
+					return err
+				}
+				slot.w = w
 			}
-		}()
-		var size int64
-		h := pfs.NewHash()
-		r := io.TeeReader(f, h)
-		// Write local file to object storage block
-		for {
-			n, err := r.Read(buf)
-			if n == 0 && err != nil {
-				if err == io.EOF {
-					break
+			// Open local file that is being uploaded
+			f, err := os.Open(entry.filePath)
+			if err != nil {
+				return fmt.Errorf("os.Open(%s): %v", entry.filePath, err)
+			}
+			defer func() {
+				if err := f.Close(); err != nil && retErr == nil {
+					retErr = err
 				}
-				return err
+			}()
+			buf := grpcutil.GetBuffer()
+			defer grpcutil.PutBuffer(buf)
+			var size int64
+			h := pfs.NewHash()
+			r := io.TeeReader(f, h)
+			// Write local file to object storage block
+			for {
+				n, err := r.Read(buf)
+				if n == 0 && err != nil {
+					if err == io.EOF {
+						break
+					}
+					return err
+				}
+				if _, err := slot.w.Write(buf[:n]); err != nil {
+					return err
+				}
+				size += int64(n)
 			}
-			if err := putObjsClient.Send(&pfs.PutObjectRequest{
-				Value: buf[:n],
-			}); err != nil {
+			entry.hash = h.Sum(nil)
+			entry.size = size
+			entry.node = &hashtree.FileNodeProto{
+				BlockRefs: []*pfs.BlockRef{
+					&pfs.BlockRef{
+						Block: slot.block,
+						Range: &pfs.ByteRange{
+							Lower: slot.offset,
+							Upper: slot.offset + uint64(size),
+						},
+					},
+				},
+			}
+			slot.offset += uint64(size)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	// Close the slots' block writers
+	for i := 0; i < uploadConcurrency; i++ {
+		slot := <-slots
+		if slot.w != nil {
+			if err := slot.w.Close(); err != nil {
 				return err
 			}
-			size += int64(n)
 		}
-		n := &hashtree.FileNodeProto{
-			BlockRefs: []*pfs.BlockRef{
-				&pfs.BlockRef{
-					Block: block,
-					Range: &pfs.ByteRange{
-						Lower: offset,
-						Upper: offset + uint64(size),
-					},
-				},
-			},
+	}
+	// Second pass: build the datum's hashtree in walk order, weaving in the
+	// direct files at the right points.
+	tree := hashtree.NewOrdered("/")
+	dirs := make(map[string]bool)
+	putDirect := func(upTo string) error {
+		for len(directFiles) > 0 && (upTo == "" || pathLess(directFiles[0].path, upTo)) {
+			df := directFiles[0]
+			directFiles = directFiles[1:]
+			// Create any ancestor directories that don't exist on disk
+			missing := directFileParents(df, dirs)
+			for i := len(missing) - 1; i >= 0; i-- {
+				tree.PutDir(missing[i])
+				if statsTree != nil {
+					statsTree.PutDir(missing[i])
+				}
+				dirs[missing[i]] = true
+			}
+			tree.PutFile(df.path, df.hash, df.size, df.node)
+			if statsTree != nil {
+				statsTree.PutFile(df.path, df.hash, df.size, df.node)
+			}
+			stats.UploadBytes += uint64(df.size)
+		}
+		return nil
+	}
+	for _, entry := range entries {
+		if err := putDirect(entry.path); err != nil {
+			return err
+		}
+		if len(directFiles) > 0 && directFiles[0].path == entry.path {
+			return fmt.Errorf("%s was written both to /pfs/out and through PutOutputFile", entry.path)
+		}
+		if entry.dir {
+			tree.PutDir(entry.path)
+			if statsTree != nil {
+				statsTree.PutDir(entry.path)
+			}
+			dirs[entry.path] = true
+			continue
 		}
-		hash := h.Sum(nil)
-		tree.PutFile(relPath, hash, size, n)
+		tree.PutFile(entry.path, entry.hash, entry.size, entry.node)
 		if statsTree != nil {
-			statsTree.PutFile(relPath, hash, size, n)
+			statsTree.PutFile(entry.path, entry.hash, entry.size, entry.node)
+		}
+		if entry.filePath != "" {
+			stats.UploadBytes += uint64(entry.size)
 		}
-		offset += uint64(size)
-		stats.UploadBytes += uint64(size)
-		return nil
-	}); err != nil {
-		return fmt.Errorf("error walking output: %v", err)
 	}
-	if err := putObjsClient.CloseSend(); err != nil {
+	// Weave in the direct files that sort after everything in /pfs/out
+	if err := putDirect(""); err != nil {
 		return err
 	}
 	// Serialize datum hashtree
@@ -982,20 +1341,124 @@ func (a *APIServer) uploadOutput(pachClient *client.APIClient, dir string, tag s
 		return err
 	}
 	// Write datum hashtree to object storage
-	w, err := pachClient.PutObjectAsync([]*pfs.Tag{client.NewTag(tag)})
+	if objClient := a.directObjClient(); objClient != nil {
+		if err := a.putObjectDirect(pachClient.Ctx(), objClient, bytes.NewReader(b.Bytes()), []*pfs.Tag{client.NewTag(tag)}); err != nil {
+			return err
+		}
+	} else {
+		w, err := pachClient.PutObjectAsync([]*pfs.Tag{client.NewTag(tag)})
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := w.Close(); err != nil && retErr != nil {
+				retErr = err
+			}
+		}()
+		if _, err := w.Write(b.Bytes()); err != nil {
+			return err
+		}
+	}
+	// Cache datum hashtree locally
+	return a.datumCache.Put(datumIdx, bytes.NewReader(b.Bytes()))
+}
+
+// extraOutputCommits resolves the job's commits in its extra output repos.
+// Each extra output branch is provenant on the output branch, so the job's
+// output commit has one subvenant commit in each extra output repo (the same
+// way the stats commit is found).
+func (a *APIServer) extraOutputCommits(pachClient *client.APIClient, outputCommit *pfs.Commit) (map[string]*pfs.Commit, error) {
+	if len(a.pipelineInfo.ExtraOutputs) == 0 {
+		return nil, nil
+	}
+	ci, err := pachClient.InspectCommit(outputCommit.Repo.Name, outputCommit.ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func() {
-		if err := w.Close(); err != nil && retErr != nil {
-			retErr = err
+	result := make(map[string]*pfs.Commit)
+	for _, name := range a.pipelineInfo.ExtraOutputs {
+		repo := ppsutil.ExtraOutputRepo(a.pipelineInfo.Pipeline.Name, name)
+		for _, commitRange := range ci.Subvenance {
+			if commitRange.Lower.Repo.Name == repo && commitRange.Upper.Repo.Name == repo {
+				result[name] = commitRange.Lower
+			}
 		}
-	}()
-	if _, err := w.Write(b.Bytes()); err != nil {
+		if result[name] == nil {
+			return nil, fmt.Errorf("could not find the commit for extra output %q of output commit %s", name, outputCommit.ID)
+		}
+	}
+	return result, nil
+}
+
+// uploadExtraOutputs copies whatever the user code wrote under
+// /pfs/out-<name> into the job's commit in the corresponding extra output
+// repo. Unlike the main output, extra output files are written directly with
+// PutFile rather than merged through hashtrees, so datums that write the
+// same path append to the same file.
+func (a *APIServer) uploadExtraOutputs(pachClient *client.APIClient, jobInfo *pps.JobInfo, dir string) error {
+	if len(a.pipelineInfo.ExtraOutputs) == 0 {
+		return nil
+	}
+	commits, err := a.extraOutputCommits(pachClient, jobInfo.OutputCommit)
+	if err != nil {
 		return err
 	}
-	// Cache datum hashtree locally
-	return a.datumCache.Put(datumIdx, bytes.NewReader(b.Bytes()))
+	for _, name := range a.pipelineInfo.ExtraOutputs {
+		root := filepath.Join(dir, "out-"+name)
+		commit := commits[name]
+		if err := filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			relPath, err := filepath.Rel(root, filePath)
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(filePath)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = pachClient.PutFile(commit.Repo.Name, commit.ID, relPath, f)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// datumSize returns the total size of a datum's input files.
+func datumSize(data []*Input) uint64 {
+	var size uint64
+	for _, input := range data {
+		if input.FileInfo != nil {
+			size += input.FileInfo.SizeBytes
+		}
+	}
+	return size
+}
+
+// scaledDatumTimeout computes the size-scaled timeout for one datum: the
+// spec's base plus per_gb pro-rated over the datum's total input size.
+func scaledDatumTimeout(spec *pps.DatumTimeoutSpec, data []*Input) (*types.Duration, error) {
+	var base time.Duration
+	if spec.Base != nil {
+		var err error
+		base, err = types.DurationFromProto(spec.Base)
+		if err != nil {
+			return nil, err
+		}
+	}
+	perGB, err := types.DurationFromProto(spec.PerGB)
+	if err != nil {
+		return nil, err
+	}
+	timeout := base + time.Duration(float64(perGB)*float64(datumSize(data))/float64(1<<30))
+	return types.DurationProto(timeout), nil
 }
 
 // HashDatum computes and returns the hash of datum + pipeline, with a
@@ -1014,6 +1477,44 @@ func HashDatum(pipelineName string, pipelineSalt string, data []*Input) string {
 	return client.DatumTagPrefix(pipelineSalt) + hex.EncodeToString(hash.Sum(nil))
 }
 
+// TransformSalt returns a salt derived from a transform's image, cmd and
+// stdin. Pipelines with TransformCaching set use it in place of the pipeline
+// salt when tagging datum results, so pipeline versions that run the same
+// transform share tags (and therefore skip datums processed by prior
+// versions).
+func TransformSalt(transform *pps.Transform) string {
+	hash := sha256.New()
+	// Every string is written netstring-style ("<len>:<bytes>") and each list
+	// is preceded by its element count, so that distinct transforms can't
+	// feed the hash the same byte stream (e.g. cmd ["ab"] vs ["a", "b"], or
+	// an element moving from cmd to stdin) and silently share cached datum
+	// results.
+	writeString := func(s string) {
+		fmt.Fprintf(hash, "%d:", len(s))
+		hash.Write([]byte(s))
+	}
+	writeString(transform.Image)
+	fmt.Fprintf(hash, "%d:", len(transform.Cmd))
+	for _, cmd := range transform.Cmd {
+		writeString(cmd)
+	}
+	fmt.Fprintf(hash, "%d:", len(transform.Stdin))
+	for _, stdin := range transform.Stdin {
+		writeString(stdin)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// hashSalt returns the salt used to tag this pipeline's datum results: the
+// pipeline salt, or a salt derived from the transform when the pipeline opts
+// in to transform-keyed caching.
+func (a *APIServer) hashSalt() string {
+	if a.pipelineInfo.TransformCaching {
+		return TransformSalt(a.pipelineInfo.Transform)
+	}
+	return a.pipelineInfo.Salt
+}
+
 // HashDatum15 computes and returns the hash of datum + pipeline for version <= 1.5.0, with a
 // pipeline-specific prefix.
 func HashDatum15(pipelineInfo *pps.PipelineInfo, data []*Input) (string, error) {
@@ -1054,15 +1555,47 @@ func (a *APIServer) Status(ctx context.Context, _ *types.Empty) (*pps.WorkerStat
 		return nil, err
 	}
 	result := &pps.WorkerStatus{
-		JobID:     a.jobID,
-		WorkerID:  a.workerName,
-		Started:   started,
-		Data:      a.datum(),
-		QueueSize: atomic.LoadInt64(&a.queueSize),
+		JobID:           a.jobID,
+		WorkerID:        a.workerName,
+		Started:         started,
+		Data:            a.datum(),
+		QueueSize:       atomic.LoadInt64(&a.queueSize),
+		ChunksProcessed: atomic.LoadInt64(&a.chunksProcessed),
+	}
+	if a.jobID != "" {
+		if err := a.queueMetrics(ctx, a.jobID, result); err != nil {
+			// queue metrics are best-effort; don't fail Status over them
+			logger := a.getWorkerLogger()
+			logger.Logf("error getting queue metrics: %v", err)
+		}
 	}
 	return result, nil
 }
 
+// queueMetrics counts the chunks in 'jobID's datum queue by state and fills
+// in the corresponding fields of 'status'.
+func (a *APIServer) queueMetrics(ctx context.Context, jobID string, status *pps.WorkerStatus) error {
+	plan := &Plan{}
+	if err := a.plans.ReadOnly(ctx).Get(jobID, plan); err != nil {
+		return err
+	}
+	var claimed, finished int64
+	chunkState := &ChunkState{}
+	if err := a.chunks(jobID).ReadOnly(ctx).List(chunkState, col.DefaultOptions, func(string) error {
+		claimed++
+		if chunkState.State != State_RUNNING {
+			finished++
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	status.QueuedChunks = int64(len(plan.Chunks)) - claimed
+	status.RunningChunks = claimed - finished
+	status.FinishedChunks = finished
+	return nil
+}
+
 // Cancel cancels the currently running datum
 func (a *APIServer) Cancel(ctx context.Context, request *CancelRequest) (*CancelResponse, error) {
 	a.statusMu.Lock()
@@ -1125,17 +1658,35 @@ type processFunc func(low, high int64) (*processResult, error)
 
 func (a *APIServer) acquireDatums(ctx context.Context, jobID string, plan *Plan, logger *taggedLogger, process processFunc) error {
 	chunks := a.chunks(jobID)
-	watcher, err := chunks.ReadOnly(ctx).Watch(watch.WithFilterPut())
+	watcher, err := chunks.ReadOnly(ctx).Watch(watch.WithFilterPut(), watch.WithCoalesce())
 	if err != nil {
 		return fmt.Errorf("error creating chunk watcher: %v", err)
 	}
+	// Scan the chunk queue starting from a per-worker offset, so that workers
+	// spread out over the queue instead of all contending for the same next
+	// chunk, and so whichever worker frees up first steals the remaining
+	// chunks at the end of a job rather than leaving them to a statically
+	// assigned straggler. The offset is derived from the worker's name: it
+	// just needs to differ between workers, and the global math/rand source
+	// is never seeded, so it would hand every worker the same offset.
+	offset := 0
+	if len(plan.Chunks) > 0 {
+		h := fnv.New64a()
+		h.Write([]byte(a.workerName))
+		offset = int(h.Sum64() % uint64(len(plan.Chunks)))
+	}
 	var complete bool
 	for !complete {
 		// We set complete to true and then unset it if we find an incomplete chunk
 		complete = true
 		// Attempt to claim a chunk
-		low, high := int64(0), int64(0)
-		for _, high = range plan.Chunks {
+		for i := range plan.Chunks {
+			idx := (offset + i) % len(plan.Chunks)
+			low := int64(0)
+			if idx > 0 {
+				low = plan.Chunks[idx-1]
+			}
+			high := plan.Chunks[idx]
 			var chunkState ChunkState
 			if err := chunks.Claim(ctx, fmt.Sprint(high), &chunkState, func(ctx context.Context) error {
 				return a.processChunk(ctx, jobID, low, high, process)
@@ -1147,7 +1698,6 @@ func (a *APIServer) acquireDatums(ctx context.Context, jobID string, plan *Plan,
 			} else if err != nil {
 				return fmt.Errorf("error claiming/processing chunk: %v", err)
 			}
-			low = high
 		}
 		// Wait for a deletion event (ttl expired) before attempting to claim a chunk again
 		select {
@@ -1164,6 +1714,7 @@ func (a *APIServer) acquireDatums(ctx context.Context, jobID string, plan *Plan,
 }
 
 func (a *APIServer) processChunk(ctx context.Context, jobID string, low, high int64, process processFunc) error {
+	atomic.AddInt64(&a.chunksProcessed, 1)
 	processResult, err := process(low, high)
 	if err != nil {
 		return err
@@ -1249,7 +1800,7 @@ func (a *APIServer) mergeDatums(jobCtx context.Context, pachClient *client.APICl
 						return errutil.ErrBreak
 					}
 					return nil
-				}); err != nil {
+				}, watch.WithCoalesce()); err != nil {
 					return err
 				}
 				low = high
@@ -1381,7 +1932,7 @@ func (a *APIServer) computeTags(df DatumFactory, low, high int64, skip map[strin
 	var tags []*pfs.Tag
 	for i := low; i < high; i++ {
 		files := df.Datum(int(i))
-		datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, files)
+		datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.hashSalt(), files)
 		// Skip datum if it is in the parent hashtree and the parent hashtree is being used in the merge
 		if _, ok := skip[datumHash]; ok && useParentHashTree {
 			continue
@@ -1629,7 +2180,7 @@ func (a *APIServer) cancelCtxIfJobFails(jobCtx context.Context, jobCancel func()
 		}
 
 		// Start watching for job state changes
-		watcher, err := a.jobs.ReadOnly(jobCtx).WatchOne(jobID)
+		watcher, err := a.jobs.ReadOnly(jobCtx).WatchOne(jobID, watch.WithCoalesce())
 		if err != nil {
 			if col.IsErrNotFound(err) {
 				jobCancel() // job deleted before we started--cancel the job ctx
@@ -1758,9 +2309,9 @@ func (a *APIServer) worker() {
 			if err := a.plans.ReadOnly(jobCtx).GetBlock(jobInfo.Job.ID, plan); err != nil {
 				return err
 			}
-			df, err := NewDatumFactory(pachClient, jobInfo.Input)
+			df, err := NewSampledDatumFactory(pachClient, jobInfo.Input, a.pipelineInfo.CanarySamplePercent)
 			if err != nil {
-				return fmt.Errorf("error from NewDatumFactory: %v", err)
+				return fmt.Errorf("error from NewSampledDatumFactory: %v", err)
 			}
 
 			// Compute the datums to skip
@@ -1779,7 +2330,7 @@ func (a *APIServer) worker() {
 				var count int
 				for i := 0; i < df.Len(); i++ {
 					files := df.Datum(i)
-					datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, files)
+					datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.hashSalt(), files)
 					if _, ok := skip[datumHash]; ok {
 						count++
 					}
@@ -1794,6 +2345,28 @@ func (a *APIServer) worker() {
 			if err != nil {
 				return err
 			}
+			// Run the transform's init hook (if any) before this worker
+			// processes any of the job's datums. Errors restart the loop
+			// (with backoff), like any other per-job error.
+			if len(a.pipelineInfo.Transform.InitCmd) > 0 {
+				hookEnv := a.userCodeEnv(jobID, jobInfo.OutputCommit.ID, nil)
+				if err := a.runUserHook(jobCtx, logger, "init", a.pipelineInfo.Transform.InitCmd, a.pipelineInfo.Transform.InitStdin, hookEnv); err != nil {
+					return fmt.Errorf("error running init_cmd for job %s: %v", jobID, err)
+				}
+			}
+			// Run the transform's teardown hook (if any) once the job ends,
+			// however it ends. Teardown failures are logged but don't affect
+			// the job. The worker's own ctx is used so teardown still runs
+			// when the job's ctx was cancelled.
+			runTeardown := func() {
+				if len(a.pipelineInfo.Transform.TeardownCmd) == 0 {
+					return
+				}
+				hookEnv := a.userCodeEnv(jobID, jobInfo.OutputCommit.ID, nil)
+				if err := a.runUserHook(a.pachClient.Ctx(), logger, "teardown", a.pipelineInfo.Transform.TeardownCmd, a.pipelineInfo.Transform.TeardownStdin, hookEnv); err != nil {
+					logger.Logf("error running teardown_cmd for job %s: %v", jobID, err)
+				}
+			}
 			eg, ctx := errgroup.WithContext(jobCtx)
 			// If a datum fails, acquireDatums updates the relevant lock in
 			// etcd, which causes the master to fail the job (which is
@@ -1814,7 +2387,9 @@ func (a *APIServer) worker() {
 			eg.Go(func() error {
 				return a.mergeDatums(ctx, pachClient, jobInfo, jobID, plan, logger, df, skip, useParentHashTree)
 			})
-			if err := eg.Wait(); err != nil {
+			err = eg.Wait()
+			runTeardown()
+			if err != nil {
 				if jobCtx.Err() == context.Canceled {
 					continue NextJob // job cancelled--don't restart, just wait for next job
 				}
@@ -1829,7 +2404,7 @@ func (a *APIServer) worker() {
 }
 
 func (a *APIServer) claimShard(ctx context.Context) {
-	watcher, err := a.shards.ReadOnly(ctx).Watch(watch.WithFilterPut())
+	watcher, err := a.shards.ReadOnly(ctx).Watch(watch.WithFilterPut(), watch.WithCoalesce())
 	if err != nil {
 		log.Printf("error creating shard watcher: %v", err)
 		return
@@ -1899,8 +2474,13 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 			if err != nil {
 				return err
 			}
+			defer func() {
+				if err := logger.closePersist(); err != nil {
+					logger.stderrLog.Printf("could not persist logs for datum: %v", err)
+				}
+			}()
 			// Hash inputs
-			tag := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, data)
+			tag := HashDatum(a.pipelineInfo.Pipeline.Name, a.hashSalt(), data)
 			if _, ok := skip[tag]; ok {
 				if !useParentHashTree {
 					if err := a.cacheHashtree(pachClient, tag, datumIdx); err != nil {
@@ -2015,9 +2595,22 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 						return err
 					})
 				}
-				if err := a.runUserCode(ctx, logger, env, subStats, jobInfo.DatumTimeout); err != nil {
+				// Make PutOutputFile calls from the user code write into this
+				// datum's output
+				a.startDirectOutput(pachClient)
+				// Scale the datum timeout with the datum's input size, if the
+				// spec asks for that
+				datumTimeout := jobInfo.DatumTimeout
+				if a.pipelineInfo.DatumTimeoutSpec != nil {
+					datumTimeout, err = scaledDatumTimeout(a.pipelineInfo.DatumTimeoutSpec, data)
+					if err != nil {
+						return err
+					}
+					logger.Logf("datum timeout scaled to %v for %v bytes of input", datumTimeout, datumSize(data))
+				}
+				if err := a.runUserCode(ctx, logger, env, subStats, datumTimeout); err != nil {
 					if a.pipelineInfo.Transform.ErrCmd != nil && failures == jobInfo.DatumTries-1 {
-						if err = a.runUserErrorHandlingCode(ctx, logger, env, subStats, jobInfo.DatumTimeout); err != nil {
+						if err = a.runUserErrorHandlingCode(ctx, logger, env, subStats, datumTimeout); err != nil {
 							return fmt.Errorf("error runUserErrorHandlingCode: %v", err)
 						}
 						return errDatumRecovered
@@ -2037,7 +2630,10 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 				}
 				atomic.AddUint64(&subStats.DownloadBytes, uint64(downSize))
 				a.reportDownloadSizeStats(float64(downSize), logger)
-				return a.uploadOutput(pachClient, dir, tag, logger, data, subStats, outputTree, datumIdx)
+				if err := a.uploadOutput(pachClient, dir, tag, logger, data, subStats, outputTree, datumIdx); err != nil {
+					return err
+				}
+				return a.uploadExtraOutputs(pachClient, jobInfo, dir)
 			}, &backoff.ZeroBackOff{}, func(err error, d time.Duration) error {
 				if isDone(ctx) {
 					return ctx.Err() // timeout or cancelled job, err out and don't retry
@@ -2111,7 +2707,7 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 
 func (a *APIServer) cacheHashtree(pachClient *client.APIClient, tag string, datumIdx int64) (retErr error) {
 	buf := &bytes.Buffer{}
-	if err := pachClient.GetTag(tag, buf); err != nil {
+	if err := a.getTag(pachClient, tag, buf); err != nil {
 		return err
 	}
 	if err := a.datumCache.Put(datumIdx, buf); err != nil {
@@ -2119,7 +2715,7 @@ func (a *APIServer) cacheHashtree(pachClient *client.APIClient, tag string, datu
 	}
 	if a.pipelineInfo.EnableStats {
 		buf.Reset()
-		if err := pachClient.GetTag(tag+statsTagSuffix, buf); err != nil {
+		if err := a.getTag(pachClient, tag+statsTagSuffix, buf); err != nil {
 			return err
 		}
 		return a.datumStatsCache.Put(datumIdx, buf)
@@ -2127,6 +2723,20 @@ func (a *APIServer) cacheHashtree(pachClient *client.APIClient, tag string, datu
 	return nil
 }
 
+// getTag reads the object tagged with 'tag' into 'w', going directly to the
+// object store when direct access is enabled. Tags that have been compacted
+// into an index can only be resolved by the block server, so those fall back
+// to the sidecar.
+func (a *APIServer) getTag(pachClient *client.APIClient, tag string, w io.Writer) error {
+	if objClient := a.directObjClient(); objClient != nil {
+		err := a.getTagDirect(pachClient.Ctx(), objClient, client.NewTag(tag), w)
+		if err == nil || !isObjNotFoundErr(objClient, err) {
+			return err
+		}
+	}
+	return pachClient.GetTag(tag, w)
+}
+
 func (a *APIServer) writeStats(pachClient *client.APIClient, objClient obj.Client, tag string, stats *pps.ProcessStats, logger *taggedLogger, inputTree, outputTree *hashtree.Ordered, statsTree *hashtree.Unordered, datumIdx int64) (retErr error) {
 	// Store stats and add stats file
 	marshaler := &jsonpb.Marshaler{}
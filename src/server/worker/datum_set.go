@@ -2,6 +2,7 @@ package worker
 
 import (
 	"fmt"
+	"hash/fnv"
 	"io"
 	"sort"
 
@@ -191,6 +192,51 @@ func newCronDatumFactory(pachClient *client.APIClient, input *pps.CronInput) (Da
 	})
 }
 
+type sampleDatumFactory struct {
+	df      DatumFactory
+	indices []int
+}
+
+func (d *sampleDatumFactory) Len() int {
+	return len(d.indices)
+}
+
+func (d *sampleDatumFactory) Datum(i int) []*Input {
+	return d.df.Datum(d.indices[i])
+}
+
+// datumInSample deterministically assigns a datum to a percent-sized bucket
+// by hashing its file paths, so the same datum stays in (or out of) the
+// sample across jobs and pipeline versions.
+func datumInSample(data []*Input, percent int64) bool {
+	h := fnv.New64a()
+	for _, input := range data {
+		h.Write([]byte(input.FileInfo.File.Path))
+	}
+	return int64(h.Sum64()%100) < percent
+}
+
+// NewSampledDatumFactory creates a datumFactory for an input that yields only
+// 'percent'% of the input's datums. It backs canary pipelines that process a
+// sample of live data; with percent <= 0 or >= 100 it's equivalent to
+// NewDatumFactory.
+func NewSampledDatumFactory(pachClient *client.APIClient, input *pps.Input, percent int64) (DatumFactory, error) {
+	df, err := NewDatumFactory(pachClient, input)
+	if err != nil {
+		return nil, err
+	}
+	if percent <= 0 || percent >= 100 {
+		return df, nil
+	}
+	result := &sampleDatumFactory{df: df}
+	for i := 0; i < df.Len(); i++ {
+		if datumInSample(df.Datum(i), percent) {
+			result.indices = append(result.indices, i)
+		}
+	}
+	return result, nil
+}
+
 // NewDatumFactory creates a datumFactory for an input.
 func NewDatumFactory(pachClient *client.APIClient, input *pps.Input) (DatumFactory, error) {
 	switch {
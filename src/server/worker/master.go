@@ -15,6 +15,7 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	"github.com/montanaflynn/stats"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -25,12 +26,18 @@ import (
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/lineage"
+	"github.com/pachyderm/pachyderm/src/server/pkg/notify"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	pfs_sync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+	"github.com/robfig/cron"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -108,65 +115,205 @@ func (a *APIServer) master(masterType string, spawner func(*client.APIClient) er
 
 func (a *APIServer) jobSpawner(pachClient *client.APIClient) error {
 	logger := a.getMasterLogger()
+	ctx, cancel := context.WithCancel(pachClient.Ctx())
+	defer cancel()
+	pachClient = pachClient.WithCtx(ctx)
+	// The buffer needs to hold one commit more than max_queued_jobs so that a
+	// backlog over the limit is visible to the coalescing logic below.
+	capacity := 1
+	if a.pipelineInfo.MaxQueuedJobs > 0 {
+		capacity = int(a.pipelineInfo.MaxQueuedJobs) + 1
+	}
+	ciChan := make(chan *pfs.CommitInfo, capacity)
+	var eg errgroup.Group
 	// Listen for new commits, and create jobs when they arrive
-	commitIter, err := pachClient.SubscribeCommit(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.OutputBranch, "", pfs.CommitState_READY)
+	eg.Go(func() error {
+		defer cancel()
+		return pachClient.SubscribeCommitF(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.OutputBranch, "", pfs.CommitState_READY, func(ci *pfs.CommitInfo) error {
+			select {
+			case ciChan <- ci:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	})
+	eg.Go(func() error {
+		defer cancel()
+		for {
+			var commitInfo *pfs.CommitInfo
+			select {
+			case commitInfo = <-ciChan:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			queued := []*pfs.CommitInfo{commitInfo}
+		drain:
+			for {
+				select {
+				case ci := <-ciChan:
+					queued = append(queued, ci)
+				default:
+					break drain
+				}
+			}
+			// If more commits are queued than max_queued_jobs allows, the
+			// older ones are coalesced into the newest queued job: they're
+			// finished empty without running a job, and the newest job picks
+			// up their input changes, since nothing is skipped against an
+			// empty parent commit.
+			if max := int(a.pipelineInfo.MaxQueuedJobs); max > 0 {
+				for len(queued) > max {
+					logger.Logf("coalescing commit %s into a newer job (%d commits queued, max_queued_jobs is %d)", queued[0].Commit.ID, len(queued), max)
+					if err := a.coalesceCommit(pachClient, queued[0]); err != nil {
+						return err
+					}
+					queued = queued[1:]
+				}
+			}
+			for _, commitInfo := range queued {
+				// Queued commits only become jobs once the pipeline's pause
+				// window (if any) has closed.
+				if err := a.waitPauseWindow(ctx, logger); err != nil {
+					return err
+				}
+				if err := a.spawnJob(pachClient, commitInfo, logger); err != nil {
+					return err
+				}
+			}
+		}
+	})
+	return eg.Wait()
+}
+
+// waitPauseWindow blocks while the pipeline's pause_window (if any) is open,
+// so that queued commits only become jobs once the window closes.
+func (a *APIServer) waitPauseWindow(ctx context.Context, logger *taggedLogger) error {
+	pauseWindow := a.pipelineInfo.PauseWindow
+	if pauseWindow == nil {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(pauseWindow.Start)
 	if err != nil {
 		return err
 	}
-	defer commitIter.Close()
+	duration, err := types.DurationFromProto(pauseWindow.Duration)
+	if err != nil {
+		return err
+	}
+	// The spec's cron expression is interpreted in UTC
+	end, open := pauseWindowEnd(schedule, duration, time.Now().UTC())
+	if !open {
+		return nil
+	}
+	logger.Logf("pause window is open; waiting until %v to start jobs", end)
+	select {
+	case <-time.After(time.Until(end)):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pauseWindowEnd reports whether 'now' falls inside a window started by
+// 'schedule' and lasting 'duration', and if so when that window ends.
+func pauseWindowEnd(schedule cron.Schedule, duration time.Duration, now time.Time) (time.Time, bool) {
+	// Only windows that started after 'now - duration' can still be open.
+	t := now.Add(-duration)
 	for {
-		commitInfo, err := commitIter.Next()
-		if err != nil {
-			return err
+		start := schedule.Next(t)
+		if start.After(now) {
+			return time.Time{}, false
 		}
-		if commitInfo.Finished != nil {
-			continue
+		if end := start.Add(duration); now.Before(end) {
+			return end, true
+		}
+		t = start
+	}
+}
+
+// spawnJob runs the job for one output commit: it creates the job if one
+// wasn't previously created for the commit, and waits for it to finish.
+func (a *APIServer) spawnJob(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, logger *taggedLogger) error {
+	if commitInfo.Finished != nil {
+		return nil
+	}
+	// Inspect the commit and check again if it has been finished (it may have
+	// been closed since it was queued, e.g. by StopPipeline or StopJob)
+	commitInfo, err := pachClient.InspectCommit(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+	if err != nil {
+		return err
+	}
+	if commitInfo.Finished != nil {
+		return nil // commit finished after queueing
+	}
+	// Check if a job was previously created for this commit. If not, make one
+	var jobInfo *pps.JobInfo
+	jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	if len(jobInfos) > 0 {
+		if len(jobInfos) > 1 {
+			return fmt.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
 		}
-		// Inspect the commit and check again if it has been finished (it may have
-		// been closed since it was queued, e.g. by StopPipeline or StopJob)
-		commitInfo, err = pachClient.InspectCommit(commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+		jobInfo, err = pachClient.InspectJob(jobInfos[0].Job.ID, false)
 		if err != nil {
 			return err
 		}
-		if commitInfo.Finished != nil {
-			continue // commit finished after queueing
-		}
-		// Check if a job was previously created for this commit. If not, make one
-		var jobInfo *pps.JobInfo
-		jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit)
+	} else {
+		job, err := pachClient.CreateJob(a.pipelineInfo.Pipeline.Name, commitInfo.Commit)
 		if err != nil {
 			return err
 		}
-		if len(jobInfos) > 0 {
-			if len(jobInfos) > 1 {
-				return fmt.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
-			}
-			jobInfo, err = pachClient.InspectJob(jobInfos[0].Job.ID, false)
-			if err != nil {
-				return err
-			}
-		} else {
-			job, err := pachClient.CreateJob(a.pipelineInfo.Pipeline.Name, commitInfo.Commit)
-			if err != nil {
-				return err
-			}
-			jobInfo, err = pachClient.InspectJob(job.ID, false)
-			if err != nil {
-				return err
-			}
+		jobInfo, err = pachClient.InspectJob(job.ID, false)
+		if err != nil {
+			return err
 		}
+	}
+	if ppsutil.IsTerminal(jobInfo.State) {
+		// previously-created job has finished, but commit has not been closed yet
+		return nil
+	}
+
+	// Now that the jobInfo is persisted, wait until all input commits are
+	// ready, split the input datums into chunks and merge the results of
+	// chunks as they're processed
+	return a.waitJob(pachClient, jobInfo, logger)
+}
+
+// coalesceCommit finishes 'commitInfo' empty without running a job for it,
+// because more commits are queued than the pipeline's max_queued_jobs allows.
+// Any job that was already created for the commit (e.g. before a restart) is
+// marked KILLED first, so it doesn't linger in JOB_STARTING.
+func (a *APIServer) coalesceCommit(pachClient *client.APIClient, commitInfo *pfs.CommitInfo) error {
+	jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	for _, jobInfo := range jobInfos {
 		if ppsutil.IsTerminal(jobInfo.State) {
-			// previously-created job has finished, but commit has not been closed yet
 			continue
 		}
-
-		// Now that the jobInfo is persisted, wait until all input commits are
-		// ready, split the input datums into chunks and merge the results of
-		// chunks as they're processed
-		if err := a.waitJob(pachClient, jobInfo, logger); err != nil {
+		if _, err := col.NewSTM(pachClient.Ctx(), a.etcdClient, func(stm col.STM) error {
+			jobPtr := &pps.EtcdJobInfo{}
+			if err := a.jobs.ReadWrite(stm).Get(jobInfo.Job.ID, jobPtr); err != nil {
+				return err
+			}
+			if ppsutil.IsTerminal(jobPtr.State) {
+				return nil
+			}
+			return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, pps.JobState_JOB_KILLED, "coalesced into a newer job")
+		}); err != nil {
 			return err
 		}
 	}
+	_, err = pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(),
+		&pfs.FinishCommitRequest{
+			Commit: commitInfo.Commit,
+			Empty:  true,
+		})
+	return err
 }
 
 func (a *APIServer) spoutSpawner(pachClient *client.APIClient) error {
@@ -433,7 +580,9 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 						return err
 					}
 					if !ppsutil.IsTerminal(jobPtr.State) {
-						return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, pps.JobState_JOB_KILLED, "")
+						// Record that the job's partial results were thrown
+						// away, so inspect-job explains what happened
+						return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, pps.JobState_JOB_KILLED, "job stopped; partial results discarded")
 					}
 					return nil
 				}); err != nil {
@@ -460,6 +609,12 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		afterTime := startTime.Add(timeout).Sub(time.Now())
 		logger.Logf("cancelling job at: %+v", afterTime)
 		timer := time.AfterFunc(afterTime, func() {
+			// Mark the job TIMED_OUT before finishing the commit, so the
+			// empty-commit handling below doesn't mark it KILLED instead
+			reason := fmt.Sprintf("job exceeded timeout (%v)", jobInfo.JobTimeout)
+			if err := a.updateJobState(ctx, jobInfo, nil, pps.JobState_JOB_TIMED_OUT, reason); err != nil {
+				logger.Logf("error marking job %s timed out: %+v", jobInfo.Job.ID, err)
+			}
 			if _, err := pachClient.PfsAPIClient.FinishCommit(ctx,
 				&pfs.FinishCommitRequest{
 					Commit: jobInfo.OutputCommit,
@@ -467,9 +622,35 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 				}); err != nil {
 				logger.Logf("error from FinishCommit while timing out job: %+v", err)
 			}
+			a.finishExtraOutputCommits(ctx, pachClient, logger, jobInfo.OutputCommit, true)
 		})
 		defer timer.Stop()
 	}
+	if jobInfo.JobTimeoutWarning != nil {
+		startTime, err := types.TimestampFromProto(jobInfo.Started)
+		if err != nil {
+			return err
+		}
+		warning, err := types.DurationFromProto(jobInfo.JobTimeoutWarning)
+		if err != nil {
+			return err
+		}
+		// The soft budget only warns; the job keeps running
+		warnTimer := time.AfterFunc(startTime.Add(warning).Sub(time.Now()), func() {
+			reason := fmt.Sprintf("job has run longer than its soft budget (%v)", warning)
+			logger.Logf("%s", reason)
+			if err := notify.ForPipeline(a.pipelineInfo).Send(&notify.Message{
+				Event:    notify.EventJobTimeoutWarning,
+				Pipeline: a.pipelineInfo.Pipeline.Name,
+				JobID:    jobInfo.Job.ID,
+				State:    jobInfo.State.String(),
+				Reason:   reason,
+			}); err != nil {
+				log.Printf("error sending %s notification for job %s: %v", notify.EventJobTimeoutWarning, jobInfo.Job.ID, err)
+			}
+		})
+		defer warnTimer.Stop()
+	}
 
 	backoff.RetryNotify(func() (retErr error) {
 		// block until job inputs are ready
@@ -483,6 +664,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			if err := a.updateJobState(ctx, jobInfo, nil, pps.JobState_JOB_FAILURE, reason); err != nil {
 				return err
 			}
+			a.finishExtraOutputCommits(ctx, pachClient, logger, jobInfo.OutputCommit, true)
 			_, err := pachClient.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{
 				Commit: jobInfo.OutputCommit,
 				Empty:  true,
@@ -490,9 +672,9 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			return err
 		}
 
-		// Create a datum factory pointing at the job's inputs and split up the
-		// input data into chunks
-		df, err := NewDatumFactory(pachClient, jobInfo.Input)
+		// Create a datum factory pointing at the job's inputs (sampled, if this
+		// is a canary pipeline) and split up the input data into chunks
+		df, err := NewSampledDatumFactory(pachClient, jobInfo.Input, a.pipelineInfo.CanarySamplePercent)
 		if err != nil {
 			return err
 		}
@@ -573,6 +755,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 					return err
 				}
 			}
+			a.finishExtraOutputCommits(ctx, pachClient, logger, jobInfo.OutputCommit, true)
 			_, err := pachClient.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{
 				Commit: jobInfo.OutputCommit,
 				Empty:  true,
@@ -599,7 +782,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 					return errutil.ErrBreak
 				}
 				return nil
-			}); err != nil {
+			}, watch.WithCoalesce()); err != nil {
 				return err
 			}
 		}
@@ -629,7 +812,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 						return errutil.ErrBreak
 					}
 					return nil
-				}); err != nil {
+				}, watch.WithCoalesce()); err != nil {
 					return err
 				}
 			}
@@ -648,9 +831,15 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		// killed.
 		if failedDatumID != "" {
 			reason := fmt.Sprintf("failed to process datum: %v", failedDatumID)
+			// If a worker was OOM-killed or evicted, surface that instead of
+			// the generic datum failure--it's actionable
+			if podReason := a.podFailureReason(); podReason != "" {
+				reason = podReason
+			}
 			if err := a.updateJobState(ctx, jobInfo, statsCommit, pps.JobState_JOB_FAILURE, reason); err != nil {
 				return err
 			}
+			a.finishExtraOutputCommits(ctx, pachClient, logger, jobInfo.OutputCommit, true)
 			_, err = pachClient.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{
 				Commit: jobInfo.OutputCommit,
 				Empty:  true,
@@ -662,7 +851,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		pbw := pbutil.NewWriter(buf)
 		for i := 0; i < df.Len(); i++ {
 			files := df.Datum(i)
-			datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, files)
+			datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.hashSalt(), files)
 			if _, err := pbw.WriteBytes([]byte(datumHash)); err != nil {
 				return err
 			}
@@ -688,6 +877,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			}
 			return err
 		}
+		a.finishExtraOutputCommits(ctx, pachClient, logger, jobInfo.OutputCommit, false)
 		// Handle egress
 		if err := a.egress(pachClient, logger, jobInfo); err != nil {
 			reason := fmt.Sprintf("egress error: %v", err)
@@ -710,7 +900,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 						if err := jobs.Get(jobID, jobPtr); err != nil {
 							return err
 						}
-						err = ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, pps.JobState_JOB_FAILURE, reason)
+						err = ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, pps.JobState_JOB_TIMED_OUT, reason)
 						if err != nil {
 							return nil
 						}
@@ -744,6 +934,51 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 	return nil
 }
 
+// podFailureReason inspects this pipeline's worker pods for OOM-killed user
+// containers and node evictions, and returns an actionable job failure
+// reason, or "" if neither is found.
+func (a *APIServer) podFailureReason() string {
+	podList, err := a.kubeClient.CoreV1().Pods(a.namespace).List(metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+			map[string]string{
+				"app":       ppsutil.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version),
+				"component": "worker",
+			})),
+	})
+	if err != nil {
+		a.getMasterLogger().Logf("error listing worker pods: %v", err)
+		return ""
+	}
+	for _, pod := range podList.Items {
+		if pod.Status.Reason == "Evicted" {
+			return fmt.Sprintf("worker pod %s was evicted from its node: %s (consider lowering the pipeline's resource requests, or adding cluster capacity)", pod.Name, pod.Status.Message)
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != client.PPSWorkerUserContainerName {
+				continue
+			}
+			terminated := status.State.Terminated
+			if terminated == nil {
+				terminated = status.LastTerminationState.Terminated
+			}
+			if terminated == nil || terminated.Reason != "OOMKilled" {
+				continue
+			}
+			if a.pipelineInfo.ResourceLimits != nil && a.pipelineInfo.ResourceLimits.Memory != "" {
+				limit := a.pipelineInfo.ResourceLimits.Memory
+				suggestion := ""
+				if q, err := resource.ParseQuantity(limit); err == nil {
+					q.Add(q) // suggest double the current limit
+					suggestion = fmt.Sprintf(" (e.g. to %s)", q.String())
+				}
+				return fmt.Sprintf("user container exceeded its memory limit of %s (OOMKilled); consider raising resource_limits.memory in the pipeline spec%s", limit, suggestion)
+			}
+			return "user container was OOM-killed; consider setting resource_limits.memory in the pipeline spec so it's scheduled on a node with enough memory"
+		}
+	}
+	return ""
+}
+
 func (a *APIServer) updateJobState(ctx context.Context, info *pps.JobInfo, stats *pfs.Commit, state pps.JobState, reason string) error {
 	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
 		jobs := a.jobs.ReadWrite(stm)
@@ -757,9 +992,52 @@ func (a *APIServer) updateJobState(ctx context.Context, info *pps.JobInfo, stats
 		}
 		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, state, reason)
 	})
+	if err == nil {
+		a.notifyJobState(info.Job.ID, state, reason)
+		a.exportJobLineage(info, state)
+		a.trackJobInMLflow(info, state)
+	}
 	return err
 }
 
+// exportJobLineage posts an OpenLineage run event for terminal job states, if
+// the cluster has a lineage endpoint configured (see the OPENLINEAGE_URL
+// environment variable). Export failures are logged but never fail the
+// job-state update itself.
+func (a *APIServer) exportJobLineage(info *pps.JobInfo, state pps.JobState) {
+	exporter := lineage.FromEnv()
+	if exporter == nil {
+		return
+	}
+	if err := exporter.Emit(exporter.JobEvent(info, state)); err != nil {
+		log.Printf("error exporting lineage for job %s: %v", info.Job.ID, err)
+	}
+}
+
+// notifyJobState posts a webhook notification for terminal job states, if the
+// pipeline (or the cluster) has notifications configured. Notification
+// failures are logged but never fail the job-state update itself.
+func (a *APIServer) notifyJobState(jobID string, state pps.JobState, reason string) {
+	var event string
+	switch state {
+	case pps.JobState_JOB_FAILURE, pps.JobState_JOB_TIMED_OUT:
+		event = notify.EventJobFailure
+	case pps.JobState_JOB_SUCCESS:
+		event = notify.EventCommitFinish
+	default:
+		return
+	}
+	if err := notify.ForPipeline(a.pipelineInfo).Send(&notify.Message{
+		Event:    event,
+		Pipeline: a.pipelineInfo.Pipeline.Name,
+		JobID:    jobID,
+		State:    state.String(),
+		Reason:   reason,
+	}); err != nil {
+		log.Printf("error sending %s notification for job %s: %v", event, jobID, err)
+	}
+}
+
 // deleteJob is identical to updateJobState, except that jobPtr points to a job
 // that should be deleted rather than marked failed. Jobs may be deleted if
 // their output commit is deleted.
@@ -779,6 +1057,31 @@ func (a *APIServer) deleteJob(stm col.STM, jobPtr *pps.EtcdJobInfo) error {
 	return a.jobs.ReadWrite(stm).Delete(jobPtr.Job.ID)
 }
 
+// finishExtraOutputCommits finishes the job's commits in its extra output
+// repos (the data was written into them by the workers as the datums ran).
+// If empty is true (the job failed, timed out, or had no datums), they're
+// finished with an empty tree, mirroring the output commit. Errors are
+// logged rather than returned: a failure to close an extra output commit
+// shouldn't change the job's fate after the main output is already final.
+func (a *APIServer) finishExtraOutputCommits(ctx context.Context, pachClient *client.APIClient, logger *taggedLogger, outputCommit *pfs.Commit, empty bool) {
+	if len(a.pipelineInfo.ExtraOutputs) == 0 {
+		return
+	}
+	commits, err := a.extraOutputCommits(pachClient, outputCommit)
+	if err != nil {
+		logger.Logf("error resolving extra output commits: %v", err)
+		return
+	}
+	for name, commit := range commits {
+		if _, err := pachClient.PfsAPIClient.FinishCommit(ctx, &pfs.FinishCommitRequest{
+			Commit: commit,
+			Empty:  empty,
+		}); err != nil && !pfsserver.IsCommitFinishedErr(err) {
+			logger.Logf("error finishing commit for extra output %q: %v", name, err)
+		}
+	}
+}
+
 func (a *APIServer) egress(pachClient *client.APIClient, logger *taggedLogger, jobInfo *pps.JobInfo) error {
 	// copy the pach client (preserving auth info) so we can set a different
 	// number of concurrent streams
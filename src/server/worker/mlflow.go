@@ -0,0 +1,214 @@
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// MLflow integration: pipelines with an 'mlflow' spec get every job
+// registered as an MLflow run under the configured tracking server, with the
+// spec's essentials logged as params, any metrics the user code wrote to
+// /pfs/out/metrics.json logged as metrics, and the output commit recorded as
+// the run's artifact reference. So model training pipelines get experiment
+// tracking without custom glue code in every image.
+
+// metricsPath is the conventional output file that user code writes metrics
+// to: a flat JSON object mapping metric names to numbers.
+const metricsPath = "metrics.json"
+
+var mlflowHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// trackJobInMLflow registers a terminal job with the pipeline's MLflow
+// tracking server, if the pipeline has one configured. Tracking failures are
+// logged but never fail the job-state update itself.
+func (a *APIServer) trackJobInMLflow(info *pps.JobInfo, state pps.JobState) {
+	spec := a.pipelineInfo.MLflow
+	if spec == nil {
+		return
+	}
+	var status string
+	switch state {
+	case pps.JobState_JOB_SUCCESS:
+		status = "FINISHED"
+	case pps.JobState_JOB_FAILURE, pps.JobState_JOB_TIMED_OUT:
+		status = "FAILED"
+	case pps.JobState_JOB_KILLED:
+		status = "KILLED"
+	default:
+		return
+	}
+	if err := a.mlflowRegisterJob(spec, info, status); err != nil {
+		log.Printf("error registering job %s with MLflow: %v", info.Job.ID, err)
+	}
+}
+
+func (a *APIServer) mlflowRegisterJob(spec *pps.MLflowSpec, info *pps.JobInfo, status string) error {
+	mlflow := &mlflowClient{url: strings.TrimSuffix(spec.TrackingURI, "/")}
+	experiment := spec.Experiment
+	if experiment == "" {
+		experiment = a.pipelineInfo.Pipeline.Name
+	}
+	experimentID, err := mlflow.experimentID(experiment)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"pipeline": a.pipelineInfo.Pipeline.Name,
+		"image":    a.pipelineInfo.Transform.Image,
+		"cmd":      strings.Join(a.pipelineInfo.Transform.Cmd, " "),
+	}
+	pps.VisitInput(info.Input, func(input *pps.Input) {
+		if input.Pfs != nil && input.Pfs.Commit != "" {
+			params["input."+input.Pfs.Name] = fmt.Sprintf("%s@%s", input.Pfs.Repo, input.Pfs.Commit)
+		}
+	})
+	tags := map[string]string{
+		"pachyderm.job": info.Job.ID,
+	}
+	if info.OutputCommit != nil {
+		// the output commit is the run's (immutable) artifact reference
+		tags["mlflow.note.content"] = fmt.Sprintf("pfs://%s/%s", info.OutputCommit.Repo.Name, info.OutputCommit.ID)
+		params["output"] = fmt.Sprintf("%s@%s", info.OutputCommit.Repo.Name, info.OutputCommit.ID)
+	}
+
+	// metrics come from the conventional /pfs/out/metrics.json, if the user
+	// code wrote one (only readable once the output commit is finished)
+	metrics := make(map[string]float64)
+	if status == "FINISHED" && info.OutputCommit != nil {
+		var buf bytes.Buffer
+		if err := a.pachClient.GetFile(info.OutputCommit.Repo.Name, info.OutputCommit.ID, metricsPath, 0, 0, &buf); err == nil {
+			if err := json.Unmarshal(buf.Bytes(), &metrics); err != nil {
+				log.Printf("malformed %s in job %s (not logging metrics): %v", metricsPath, info.Job.ID, err)
+			}
+		}
+	}
+
+	runID, err := mlflow.createRun(experimentID, tags)
+	if err != nil {
+		return err
+	}
+	if err := mlflow.logBatch(runID, params, metrics); err != nil {
+		return err
+	}
+	return mlflow.updateRun(runID, status)
+}
+
+// mlflowClient is a minimal client for the MLflow REST API (2.0).
+type mlflowClient struct {
+	url string
+}
+
+// experimentID resolves an experiment name to its ID, creating the
+// experiment if it doesn't exist.
+func (m *mlflowClient) experimentID(name string) (string, error) {
+	var getResp struct {
+		Experiment struct {
+			ExperimentID string `json:"experiment_id"`
+		} `json:"experiment"`
+	}
+	err := m.get("experiments/get-by-name?experiment_name="+url.QueryEscape(name), &getResp)
+	if err == nil {
+		return getResp.Experiment.ExperimentID, nil
+	}
+	var createResp struct {
+		ExperimentID string `json:"experiment_id"`
+	}
+	if err := m.post("experiments/create", map[string]string{"name": name}, &createResp); err != nil {
+		return "", err
+	}
+	return createResp.ExperimentID, nil
+}
+
+func (m *mlflowClient) createRun(experimentID string, tags map[string]string) (string, error) {
+	var createResp struct {
+		Run struct {
+			Info struct {
+				RunID string `json:"run_id"`
+			} `json:"info"`
+		} `json:"run"`
+	}
+	if err := m.post("runs/create", map[string]interface{}{
+		"experiment_id": experimentID,
+		"start_time":    time.Now().UnixNano() / int64(time.Millisecond),
+		"tags":          keyValues(tags),
+	}, &createResp); err != nil {
+		return "", err
+	}
+	return createResp.Run.Info.RunID, nil
+}
+
+func (m *mlflowClient) logBatch(runID string, params map[string]string, metrics map[string]float64) error {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	var metricEntries []map[string]interface{}
+	for key, value := range metrics {
+		metricEntries = append(metricEntries, map[string]interface{}{
+			"key":       key,
+			"value":     value,
+			"timestamp": now,
+		})
+	}
+	return m.post("runs/log-batch", map[string]interface{}{
+		"run_id":  runID,
+		"params":  keyValues(params),
+		"metrics": metricEntries,
+	}, nil)
+}
+
+func (m *mlflowClient) updateRun(runID, status string) error {
+	return m.post("runs/update", map[string]interface{}{
+		"run_id":   runID,
+		"status":   status,
+		"end_time": time.Now().UnixNano() / int64(time.Millisecond),
+	}, nil)
+}
+
+func keyValues(kv map[string]string) []map[string]string {
+	var entries []map[string]string
+	for key, value := range kv {
+		entries = append(entries, map[string]string{
+			"key":   key,
+			"value": value,
+		})
+	}
+	return entries
+}
+
+func (m *mlflowClient) get(path string, response interface{}) error {
+	resp, err := mlflowHTTPClient.Get(m.url + "/api/2.0/mlflow/" + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("MLflow returned %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+func (m *mlflowClient) post(path string, request interface{}, response interface{}) error {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := mlflowHTTPClient.Post(m.url+"/api/2.0/mlflow/"+path, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("MLflow returned %s", resp.Status)
+	}
+	if response != nil {
+		return json.NewDecoder(resp.Body).Decode(response)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package worker
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+// DirectObjectAccessEnvVar is set to "true" in the worker container (by
+// pachd, when it creates the worker RC) to make the worker read and write
+// blocks against the object store itself--using the credentials pachd
+// injected into the pod--instead of proxying every byte through its pachd
+// sidecar. This removes pachd from the data path for large jobs; metadata
+// operations still go through the sidecar.
+const DirectObjectAccessEnvVar = "WORKER_DIRECT_OBJECT_ACCESS"
+
+// directObjClient returns an object-store client for the worker's direct
+// data path, or nil if direct object access isn't enabled (or the client
+// can't be created, in which case the worker falls back to its sidecar).
+func (a *APIServer) directObjClient() obj.Client {
+	a.directObjOnce.Do(func() {
+		if os.Getenv(DirectObjectAccessEnvVar) != "true" {
+			return
+		}
+		storageRoot, err := obj.StorageRootFromEnv()
+		if err != nil {
+			log.Printf("error getting storage root for direct object access (falling back to the pachd sidecar): %v", err)
+			return
+		}
+		objClient, err := obj.NewClientFromEnv(storageRoot)
+		if err != nil {
+			log.Printf("error creating direct object client (falling back to the pachd sidecar): %v", err)
+			return
+		}
+		a.directStorageRoot = storageRoot
+		a.directObj = objClient
+	})
+	return a.directObj
+}
+
+// The paths below mirror the block server's layout of the object store
+// (blockPath et al in obj_block_api_server.go).
+
+func (a *APIServer) directBlockPath(block *pfs.Block) string {
+	return filepath.Join(a.directStorageRoot, "block", block.Hash)
+}
+
+func (a *APIServer) directObjectPath(object *pfs.Object) string {
+	return filepath.Join(a.directStorageRoot, "object", object.Hash)
+}
+
+func (a *APIServer) directTagPath(tag *pfs.Tag) string {
+	return filepath.Join(a.directStorageRoot, "tag", tag.Name)
+}
+
+// newBlockWriter returns a writer for the raw bytes of 'block'. With direct
+// object access enabled it writes straight to the object store; otherwise it
+// relays the bytes through the worker's pachd sidecar.
+func (a *APIServer) newBlockWriter(pachClient *client.APIClient, block *pfs.Block) (io.WriteCloser, error) {
+	if objClient := a.directObjClient(); objClient != nil {
+		return objClient.Writer(pachClient.Ctx(), a.directBlockPath(block))
+	}
+	putObjsClient, err := pachClient.ObjectAPIClient.PutObjects(pachClient.Ctx())
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	if err := putObjsClient.Send(&pfs.PutObjectRequest{
+		Block: block,
+	}); err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return &sidecarBlockWriter{putObjsClient: putObjsClient}, nil
+}
+
+// sidecarBlockWriter writes a block by relaying its bytes through the
+// sidecar's PutObjects RPC.
+type sidecarBlockWriter struct {
+	putObjsClient pfs.ObjectAPI_PutObjectsClient
+}
+
+func (w *sidecarBlockWriter) Write(p []byte) (int, error) {
+	if err := w.putObjsClient.Send(&pfs.PutObjectRequest{
+		Value: p,
+	}); err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	return len(p), nil
+}
+
+func (w *sidecarBlockWriter) Close() error {
+	return grpcutil.ScrubGRPC(w.putObjsClient.CloseSend())
+}
+
+// putObjectDirect writes the contents of 'r' as an object (with 'tags')
+// directly to the object store, mirroring what the block server does for
+// PutObject: the data goes into a fresh block, which is deleted again if an
+// object with the same hash already exists.
+func (a *APIServer) putObjectDirect(ctx context.Context, objClient obj.Client, r io.Reader, tags []*pfs.Tag) (retErr error) {
+	hash := pfs.NewHash()
+	block := &pfs.Block{Hash: uuid.NewWithoutDashes()}
+	blockPath := a.directBlockPath(block)
+	var size int64
+	if err := func() (retErr error) {
+		w, err := objClient.Writer(ctx, blockPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := w.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		buf := grpcutil.GetBuffer()
+		defer grpcutil.PutBuffer(buf)
+		size, err = io.CopyBuffer(w, io.TeeReader(r, hash), buf)
+		return err
+	}(); err != nil {
+		objClient.Delete(ctx, blockPath)
+		return err
+	}
+	object := &pfs.Object{Hash: pfs.EncodeHash(hash.Sum(nil))}
+	if objClient.Exists(ctx, a.directObjectPath(object)) {
+		// the object already exists, so we delete the block we just put
+		if err := objClient.Delete(ctx, blockPath); err != nil {
+			return err
+		}
+	} else {
+		blockRef := &pfs.BlockRef{
+			Block: block,
+			Range: &pfs.ByteRange{
+				Lower: 0,
+				Upper: uint64(size),
+			},
+		}
+		if err := writeProtoDirect(ctx, objClient, a.directObjectPath(object), blockRef); err != nil {
+			return err
+		}
+	}
+	for _, tag := range tags {
+		index := &pfs.ObjectIndex{Tags: map[string]*pfs.Object{tag.Name: object}}
+		if err := writeProtoDirect(ctx, objClient, a.directTagPath(tag), index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTagDirect reads the object tagged with 'tag' directly from the object
+// store and writes its contents to 'w'. It returns a not-found error if the
+// tag (or its object) has been compacted into an index that the worker
+// doesn't read; callers should fall back to the sidecar in that case.
+func (a *APIServer) getTagDirect(ctx context.Context, objClient obj.Client, tag *pfs.Tag, w io.Writer) (retErr error) {
+	index := &pfs.ObjectIndex{}
+	if err := readProtoDirect(ctx, objClient, a.directTagPath(tag), index); err != nil {
+		return err
+	}
+	object, ok := index.Tags[tag.Name]
+	if !ok {
+		return errObjNotFound
+	}
+	blockRef := &pfs.BlockRef{}
+	if err := readProtoDirect(ctx, objClient, a.directObjectPath(object), blockRef); err != nil {
+		return err
+	}
+	r, err := objClient.Reader(ctx, a.directBlockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	buf := grpcutil.GetBuffer()
+	defer grpcutil.PutBuffer(buf)
+	_, err = io.CopyBuffer(w, r, buf)
+	return err
+}
+
+// errObjNotFound is returned by getTagDirect for tags whose objects can't be
+// resolved without the block server's index caches.
+var errObjNotFound = errors.New("not found")
+
+// isObjNotFoundErr mirrors the block server's isNotFoundErr: object store
+// clients don't agree on how they report missing objects.
+func isObjNotFoundErr(objClient obj.Client, err error) bool {
+	if err == errObjNotFound {
+		return true
+	}
+	patterns := []string{"not found", "not exist", "NotFound", "NotExist", "404"}
+	errstr := err.Error()
+	for _, pattern := range patterns {
+		if strings.Contains(errstr, pattern) {
+			return true
+		}
+	}
+	return objClient.IsNotExist(err) || objClient.IsIgnorable(err)
+}
+
+func readProtoDirect(ctx context.Context, objClient obj.Client, path string, pb proto.Unmarshaler) (retErr error) {
+	r, err := objClient.Reader(ctx, path, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return pb.Unmarshal(data)
+}
+
+func writeProtoDirect(ctx context.Context, objClient obj.Client, path string, pb proto.Marshaler) (retErr error) {
+	data, err := pb.Marshal()
+	if err != nil {
+		return err
+	}
+	w, err := objClient.Writer(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,251 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+// PutOutputFile streams the contents of r into the job's output as path
+// (relative to the output root), bypassing /pfs/out and the upload phase at
+// the end of the datum. It's for use by user code running in a pipeline: it
+// connects to the worker over its Unix socket, so it only works while a
+// datum is being processed.
+func PutOutputFile(path string, r io.Reader) (retErr error) {
+	conn, err := grpc.Dial(client.PPSWorkerSockPath,
+		append(client.DefaultDialOptions(), grpc.WithInsecure(),
+			grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+				return net.DialTimeout("unix", addr, timeout)
+			}))...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	putFileClient, err := NewWorkerClient(conn).PutOutputFile(context.Background())
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if err := putFileClient.Send(&PutOutputFileRequest{Path: path}); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	buf := grpcutil.GetBuffer()
+	defer grpcutil.PutBuffer(buf)
+	for {
+		n, err := r.Read(buf)
+		if n == 0 && err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if err := putFileClient.Send(&PutOutputFileRequest{Value: buf[:n]}); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+	if _, err := putFileClient.CloseAndRecv(); err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	return nil
+}
+
+// PutOutputFile writes a file directly into the output of the datum that's
+// currently being processed. It's only served on the worker's Unix socket,
+// so it can only be reached by user code running in the same pod.
+func (a *APIServer) PutOutputFile(server Worker_PutOutputFileServer) error {
+	out := func() *directOutput {
+		a.directOutputMu.Lock()
+		defer a.directOutputMu.Unlock()
+		return a.directOutput
+	}()
+	if out == nil {
+		return fmt.Errorf("PutOutputFile can only be called while a datum is being processed")
+	}
+	req, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	outputPath := strings.TrimPrefix(filepath.ToSlash(filepath.Join("/", req.Path)), "/")
+	if outputPath == "" {
+		return fmt.Errorf("must specify a path")
+	}
+	if err := out.putFile(outputPath, server, req.Value); err != nil {
+		return err
+	}
+	return server.SendAndClose(&types.Empty{})
+}
+
+// directOutput accumulates files that user code writes through the
+// PutOutputFile RPC while a datum runs, so that uploadOutput can weave them
+// into the datum's hashtree without them ever touching /pfs/out.
+type directOutput struct {
+	mu         sync.Mutex
+	a          *APIServer
+	pachClient *client.APIClient
+	block      *pfs.Block
+	blockW     io.WriteCloser
+	offset     uint64
+	files      []*directFile
+}
+
+// directFile is a single file written through PutOutputFile; its content is
+// already in the object store, the file just needs to be put into the
+// datum's hashtree.
+type directFile struct {
+	path string
+	hash []byte
+	size int64
+	node *hashtree.FileNodeProto
+}
+
+func (o *directOutput) putFile(path string, server Worker_PutOutputFileServer, first []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.blockW == nil {
+		o.block = &pfs.Block{Hash: uuid.NewWithoutDashes()}
+		blockW, err := o.a.newBlockWriter(o.pachClient, o.block)
+		if err != nil {
+			return err
+		}
+		o.blockW = blockW
+	}
+	lower := o.offset
+	h := pfs.NewHash()
+	write := func(value []byte) error {
+		if len(value) == 0 {
+			return nil
+		}
+		if _, err := o.blockW.Write(value); err != nil {
+			return err
+		}
+		h.Write(value)
+		// o.offset advances even if the stream errors out later, the next
+		// file's range must not overlap the partial write
+		o.offset += uint64(len(value))
+		return nil
+	}
+	if err := write(first); err != nil {
+		return err
+	}
+	for {
+		req, err := server.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := write(req.Value); err != nil {
+			return err
+		}
+	}
+	o.files = append(o.files, &directFile{
+		path: path,
+		hash: h.Sum(nil),
+		size: int64(o.offset - lower),
+		node: &hashtree.FileNodeProto{
+			BlockRefs: []*pfs.BlockRef{
+				&pfs.BlockRef{
+					Block: o.block,
+					Range: &pfs.ByteRange{
+						Lower: lower,
+						Upper: o.offset,
+					},
+				},
+			},
+		},
+	})
+	return nil
+}
+
+// startDirectOutput makes PutOutputFile calls write into a fresh set of
+// direct output files for the datum that's about to run. It discards
+// whatever a previous (failed) attempt may have left behind.
+func (a *APIServer) startDirectOutput(pachClient *client.APIClient) {
+	a.directOutputMu.Lock()
+	defer a.directOutputMu.Unlock()
+	a.directOutput = &directOutput{
+		a:          a,
+		pachClient: pachClient,
+	}
+}
+
+// finishDirectOutput closes out the current datum's direct output and
+// returns its files, sorted in the order hashtrees are built in (parents
+// before children). After it returns, PutOutputFile calls fail until the
+// next datum starts.
+func (a *APIServer) finishDirectOutput() ([]*directFile, error) {
+	out := func() *directOutput {
+		a.directOutputMu.Lock()
+		defer a.directOutputMu.Unlock()
+		out := a.directOutput
+		a.directOutput = nil
+		return out
+	}()
+	if out == nil {
+		return nil, nil
+	}
+	// Taking the lock waits out any stream that's still being received.
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	if out.blockW != nil {
+		if err := out.blockW.Close(); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(out.files, func(i, j int) bool {
+		return pathLess(out.files[i].path, out.files[j].path)
+	})
+	for i := 1; i < len(out.files); i++ {
+		if out.files[i].path == out.files[i-1].path {
+			return nil, fmt.Errorf("%s was written multiple times through PutOutputFile", out.files[i].path)
+		}
+	}
+	return out.files, nil
+}
+
+// pathLess orders paths the way filepath.Walk visits them (parents before
+// children, siblings in lexicographic order), which is the order Ordered
+// hashtrees must be built in.
+func pathLess(a, b string) bool {
+	as := strings.Split(a, "/")
+	bs := strings.Split(b, "/")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] != bs[i] {
+			return as[i] < bs[i]
+		}
+	}
+	return len(as) < len(bs)
+}
+
+// directFileParents returns the ancestor directories of df.path (deepest
+// first) that aren't in dirs yet.
+func directFileParents(df *directFile, dirs map[string]bool) []string {
+	var missing []string
+	for parent := path.Dir(df.path); parent != "." && parent != "/"; parent = path.Dir(parent) {
+		if dirs[parent] {
+			break
+		}
+		missing = append(missing, parent)
+	}
+	return missing
+}
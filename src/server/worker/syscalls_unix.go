@@ -0,0 +1,24 @@
+// +build !windows
+
+package worker
+
+import (
+	"syscall"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/exec"
+)
+
+// makeFifo creates a named pipe at path, spouts use one as /pfs/out.
+func makeFifo(path string) error {
+	return syscall.Mkfifo(path, 0666)
+}
+
+// setUserCredentials makes cmd run the user code as the given uid/gid.
+func setUserCredentials(cmd *exec.Cmd, uid uint32, gid uint32) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{
+			Uid: uid,
+			Gid: gid,
+		},
+	}
+}
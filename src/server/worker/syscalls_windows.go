@@ -0,0 +1,22 @@
+// +build windows
+
+package worker
+
+// Workers only ever run inside Linux containers; these stubs exist so that
+// pachctl, which imports this package to enumerate datums client-side, can be
+// built for Windows.
+
+import (
+	"fmt"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/exec"
+)
+
+// makeFifo returns an error, Windows has no named pipes in the filesystem
+// namespace.
+func makeFifo(path string) error {
+	return fmt.Errorf("spouts are not supported on Windows")
+}
+
+// setUserCredentials is a no-op, Windows has no notion of uid/gid.
+func setUserCredentials(cmd *exec.Cmd, uid uint32, gid uint32) {}
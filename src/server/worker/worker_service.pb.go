@@ -552,6 +552,63 @@ func (m *Plan) GetMerges() int64 {
 	return 0
 }
 
+type PutOutputFileRequest struct {
+	// Path of the file under the output root (i.e. relative to /pfs/out).
+	// Only set in the first message of the stream.
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutOutputFileRequest) Reset()         { *m = PutOutputFileRequest{} }
+func (m *PutOutputFileRequest) String() string { return proto.CompactTextString(m) }
+func (*PutOutputFileRequest) ProtoMessage()    {}
+func (*PutOutputFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff4b5163b7daa7, []int{8}
+}
+func (m *PutOutputFileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutOutputFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutOutputFileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PutOutputFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutOutputFileRequest.Merge(m, src)
+}
+func (m *PutOutputFileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutOutputFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutOutputFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutOutputFileRequest proto.InternalMessageInfo
+
+func (m *PutOutputFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PutOutputFileRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("worker.State", State_name, State_value)
 	proto.RegisterType((*Input)(nil), "worker.Input")
@@ -562,60 +619,81 @@ func init() {
 	proto.RegisterType((*MergeState)(nil), "worker.MergeState")
 	proto.RegisterType((*ShardInfo)(nil), "worker.ShardInfo")
 	proto.RegisterType((*Plan)(nil), "worker.Plan")
+	proto.RegisterType((*PutOutputFileRequest)(nil), "worker.PutOutputFileRequest")
 }
 
-func init() { proto.RegisterFile("server/worker/worker_service.proto", fileDescriptor_23ff4b5163b7daa7) }
+func init() {
+	proto.RegisterFile("server/worker/worker_service.proto", fileDescriptor_23ff4b5163b7daa7)
+}
 
 var fileDescriptor_23ff4b5163b7daa7 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0x9b, 0xc4, 0x49, 0x4e, 0x9a, 0x10, 0x46, 0x4b, 0xb1, 0xb2, 0x22, 0x09, 0x5e, 0x09,
-	0x45, 0xb9, 0x70, 0xaa, 0x22, 0x90, 0xb8, 0x24, 0x3f, 0xad, 0x8c, 0xda, 0xee, 0x6a, 0xda, 0x82,
-	0xc4, 0x8d, 0x35, 0xb6, 0x27, 0x8e, 0xbb, 0x8e, 0x6d, 0x66, 0xc6, 0xac, 0xb2, 0x4f, 0xc2, 0xe3,
-	0x70, 0x07, 0x97, 0x3c, 0x41, 0x85, 0xc2, 0x25, 0x2f, 0x81, 0x66, 0xc6, 0x66, 0x77, 0xdb, 0xab,
-	0xbd, 0xb0, 0x72, 0xce, 0x77, 0x3e, 0x7f, 0x3e, 0xe7, 0xcc, 0x37, 0x01, 0x9b, 0x53, 0xf6, 0x2b,
-	0x65, 0xf3, 0x37, 0x19, 0x7b, 0xfd, 0xff, 0x8f, 0x27, 0xc1, 0x38, 0xa0, 0x4e, 0xce, 0x32, 0x91,
-	0x21, 0x53, 0xa3, 0xc3, 0x67, 0x41, 0x12, 0xd3, 0x54, 0xcc, 0xf3, 0x0d, 0x97, 0x8f, 0xae, 0xbe,
-	0x43, 0x73, 0x2e, 0x9f, 0x0a, 0x8d, 0xb2, 0x28, 0x53, 0xe1, 0x5c, 0x46, 0x25, 0xfa, 0x3c, 0xca,
-	0xb2, 0x28, 0xa1, 0x73, 0x95, 0xf9, 0xc5, 0x66, 0x4e, 0x77, 0xb9, 0xd8, 0x97, 0xc5, 0xd1, 0xe3,
-	0xe2, 0x1b, 0x46, 0xf2, 0x9c, 0xb2, 0x52, 0xd2, 0xfe, 0xd7, 0x80, 0xa6, 0x9b, 0xe6, 0x85, 0x40,
-	0x33, 0xe8, 0x6c, 0xe2, 0x84, 0x7a, 0x71, 0xba, 0xc9, 0x2c, 0x63, 0x62, 0x4c, 0xbb, 0x67, 0x3d,
-	0x47, 0x76, 0x74, 0x1e, 0x27, 0xd4, 0x4d, 0x37, 0x19, 0x6e, 0x6f, 0xca, 0x08, 0x9d, 0x42, 0x2f,
-	0x27, 0x8c, 0xa6, 0xc2, 0x0b, 0xb2, 0xdd, 0x2e, 0x16, 0x56, 0x53, 0xf1, 0xbb, 0x8a, 0xbf, 0x54,
-	0x10, 0x3e, 0xd6, 0x0c, 0x9d, 0x21, 0x04, 0x8d, 0x94, 0xec, 0xa8, 0x75, 0x34, 0x31, 0xa6, 0x1d,
-	0xac, 0x62, 0x89, 0x25, 0xe4, 0xed, 0xde, 0xaa, 0x4f, 0x8c, 0x69, 0x1b, 0xab, 0x18, 0x9d, 0x80,
-	0xe9, 0x33, 0x92, 0x06, 0x5b, 0xab, 0xa1, 0x98, 0x65, 0x86, 0x5e, 0x40, 0x2b, 0x8a, 0x85, 0x57,
-	0xb0, 0xc4, 0x32, 0x65, 0x61, 0x01, 0x87, 0x87, 0xb1, 0x79, 0x11, 0x8b, 0x3b, 0x7c, 0x89, 0xcd,
-	0x28, 0x16, 0x77, 0x2c, 0x41, 0x63, 0xe8, 0xaa, 0xd9, 0x3d, 0xd9, 0x28, 0xb7, 0x5a, 0x4a, 0x17,
-	0x14, 0x24, 0x87, 0xe0, 0xf6, 0x2d, 0xf4, 0x96, 0x24, 0x0d, 0x68, 0x82, 0xe9, 0x2f, 0x05, 0xe5,
-	0x02, 0x4d, 0xc0, 0xbc, 0xcf, 0x7c, 0x2f, 0x0e, 0x75, 0x63, 0x8b, 0xce, 0xe1, 0x61, 0xdc, 0xfc,
-	0x21, 0xf3, 0xdd, 0x15, 0x6e, 0xde, 0x67, 0xbe, 0x1b, 0xa2, 0x2f, 0xe1, 0x38, 0x24, 0x82, 0x48,
-	0x49, 0x41, 0x19, 0xb7, 0x8c, 0x49, 0x7d, 0xda, 0xc1, 0x5d, 0x89, 0x9d, 0x6b, 0xc8, 0x9e, 0x41,
-	0xbf, 0x52, 0xe5, 0x79, 0x96, 0x72, 0x8a, 0x2c, 0x68, 0xf1, 0x22, 0x08, 0x28, 0xe7, 0x6a, 0x93,
-	0x6d, 0x5c, 0xa5, 0xf6, 0x15, 0x7c, 0x72, 0x41, 0xc5, 0x72, 0x5b, 0xa4, 0xaf, 0xab, 0x1e, 0xfa,
-	0x70, 0x14, 0x87, 0x8a, 0x57, 0xc7, 0x47, 0x71, 0x88, 0x9e, 0x41, 0x93, 0x6f, 0x09, 0xd3, 0x2d,
-	0xd5, 0xb1, 0x4e, 0x14, 0x2a, 0x88, 0xe0, 0xe5, 0xb6, 0x74, 0x62, 0x73, 0x00, 0xa5, 0x75, 0x23,
-	0x88, 0xa0, 0xe8, 0x85, 0xe6, 0x50, 0x25, 0xd6, 0x3f, 0xeb, 0x39, 0xda, 0x63, 0x8e, 0xaa, 0xea,
-	0x57, 0x28, 0xfa, 0x0a, 0xda, 0x21, 0x11, 0xc5, 0xee, 0xdd, 0xd0, 0xdd, 0xc3, 0xc3, 0xb8, 0xb5,
-	0x92, 0x98, 0xbb, 0xc2, 0x2d, 0x55, 0x74, 0x43, 0x39, 0x03, 0x09, 0x43, 0x26, 0x67, 0xa8, 0xab,
-	0xa3, 0xa8, 0x52, 0xfb, 0x0f, 0x03, 0xe0, 0x8a, 0xb2, 0x88, 0x7e, 0xc4, 0x57, 0xc7, 0xd0, 0x10,
-	0x8c, 0xea, 0xf3, 0xaf, 0x8c, 0xf2, 0xd2, 0xbf, 0xa7, 0x81, 0xc0, 0xaa, 0x80, 0xbe, 0x00, 0xe0,
-	0xf1, 0x5b, 0xea, 0xf9, 0x7b, 0x41, 0xf5, 0x17, 0x1b, 0xb8, 0x23, 0x91, 0x85, 0x04, 0xd0, 0x0c,
-	0x40, 0x4d, 0xec, 0x29, 0x95, 0xc6, 0x53, 0x95, 0x8e, 0x2a, 0xdf, 0x4a, 0xa9, 0x29, 0x0c, 0x34,
-	0xf7, 0x3d, 0xc1, 0xa6, 0x12, 0xec, 0x2b, 0xfc, 0xa6, 0x52, 0xb5, 0xbb, 0xd0, 0xb9, 0x91, 0xdb,
-	0x95, 0xa6, 0xb6, 0xbf, 0x85, 0xc6, 0xab, 0x84, 0xa4, 0xd2, 0x82, 0x81, 0xdc, 0xa9, 0x3e, 0xeb,
-	0x3a, 0x2e, 0x33, 0x89, 0xef, 0xe4, 0xd4, 0xbc, 0x3c, 0x98, 0x32, 0x9b, 0x39, 0xd0, 0xd4, 0x8b,
-	0xe8, 0x42, 0x0b, 0xdf, 0x5d, 0x5f, 0xbb, 0xd7, 0x17, 0x83, 0x1a, 0x3a, 0x86, 0xf6, 0xf2, 0xe5,
-	0xd5, 0xab, 0xcb, 0xf5, 0xed, 0x7a, 0x60, 0x20, 0x00, 0xf3, 0xfc, 0x7b, 0xf7, 0x72, 0xbd, 0x1a,
-	0xd4, 0xcf, 0x7e, 0x37, 0xc0, 0xfc, 0x49, 0xad, 0x08, 0x7d, 0x03, 0xa6, 0x7c, 0xb5, 0xe0, 0xe8,
-	0xc4, 0xd1, 0x17, 0xd5, 0xa9, 0x2e, 0xaa, 0xb3, 0x96, 0xb6, 0x1d, 0x7e, 0xea, 0xc8, 0xeb, 0xaf,
-	0xe9, 0x9a, 0x6a, 0xd7, 0xd0, 0x77, 0x60, 0x6a, 0xc3, 0xa1, 0xcf, 0xaa, 0x65, 0x7f, 0x60, 0xeb,
-	0xe1, 0xc9, 0x63, 0x58, 0xfb, 0xd2, 0xae, 0xa1, 0x15, 0xb4, 0x2b, 0xff, 0xa1, 0xcf, 0x2b, 0xd6,
-	0x23, 0x47, 0x0e, 0x9f, 0x3f, 0x69, 0x46, 0xad, 0xeb, 0x47, 0x92, 0x14, 0xd4, 0xae, 0x9d, 0x1a,
-	0x8b, 0xc5, 0x9f, 0x87, 0x91, 0xf1, 0xd7, 0x61, 0x64, 0xfc, 0x7d, 0x18, 0x19, 0xbf, 0xfd, 0x33,
-	0xaa, 0xfd, 0x7c, 0x1a, 0xc5, 0x62, 0x5b, 0xf8, 0x4e, 0x90, 0xed, 0xe6, 0x39, 0x09, 0xb6, 0xfb,
-	0x90, 0xb2, 0xf7, 0x23, 0xce, 0x82, 0xf9, 0x07, 0xff, 0x8a, 0xbe, 0xa9, 0xc4, 0xbf, 0xfe, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0x82, 0x9b, 0xc9, 0x2d, 0x05, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x54,
+	0xdb, 0x6e, 0xd3, 0x40, 0x10, 0x4d, 0x9a, 0xc4, 0x49, 0x26, 0x49, 0x09,
+	0xab, 0x52, 0xac, 0x14, 0xda, 0x62, 0x24, 0x54, 0xf5, 0xc1, 0xa9, 0x8a,
+	0x40, 0xe2, 0x0d, 0x72, 0x69, 0x15, 0xd4, 0x9b, 0xdc, 0x16, 0x24, 0x5e,
+	0x2c, 0xdb, 0xd9, 0x24, 0x6e, 0x1d, 0xdb, 0xec, 0xae, 0x5b, 0xa5, 0xbf,
+	0xc0, 0x0f, 0xf0, 0x47, 0xf0, 0xc8, 0x17, 0x20, 0x54, 0x1e, 0xf9, 0x09,
+	0xf6, 0x62, 0xd3, 0x1b, 0x3c, 0xf0, 0x60, 0x79, 0xe6, 0xcc, 0xf8, 0x78,
+	0xe6, 0xcc, 0xec, 0x82, 0x41, 0x31, 0x39, 0xc3, 0xa4, 0x7d, 0x1e, 0x91,
+	0xd3, 0x3f, 0x2f, 0x5b, 0x80, 0xbe, 0x87, 0xcd, 0x98, 0x44, 0x2c, 0x42,
+	0x9a, 0x42, 0x5b, 0x0b, 0x5e, 0xe0, 0xe3, 0x90, 0xb5, 0xe3, 0x11, 0x15,
+	0x8f, 0x8a, 0x5e, 0xa1, 0x31, 0x15, 0x4f, 0x86, 0x8e, 0xa3, 0x71, 0x24,
+	0xcd, 0xb6, 0xb0, 0x52, 0x74, 0x69, 0x1c, 0x45, 0xe3, 0x00, 0xb7, 0xa5,
+	0xe7, 0x26, 0xa3, 0x36, 0x9e, 0xc6, 0x6c, 0x96, 0x06, 0x97, 0x6f, 0x07,
+	0xcf, 0x89, 0x13, 0xc7, 0x98, 0xa4, 0x94, 0xc6, 0xaf, 0x3c, 0x94, 0x06,
+	0x61, 0x9c, 0x30, 0xb4, 0x0e, 0xd5, 0x91, 0x1f, 0x60, 0xdb, 0x0f, 0x47,
+	0x91, 0x9e, 0x5f, 0xcd, 0xaf, 0xd5, 0x36, 0x1b, 0xa6, 0xa8, 0x68, 0x8b,
+	0xa3, 0x03, 0x0e, 0x5a, 0x95, 0x51, 0x6a, 0xa1, 0x0d, 0x68, 0xc4, 0x0e,
+	0xe1, 0x05, 0xda, 0x5e, 0x34, 0x9d, 0xfa, 0x4c, 0x2f, 0xc9, 0xfc, 0x9a,
+	0xcc, 0xef, 0x4a, 0xc8, 0xaa, 0xab, 0x0c, 0xe5, 0x21, 0x04, 0xc5, 0xd0,
+	0x99, 0x62, 0x7d, 0x8e, 0x27, 0x56, 0x2d, 0x69, 0x0b, 0x2c, 0x70, 0x2e,
+	0x66, 0x7a, 0x81, 0x63, 0x15, 0x4b, 0xda, 0x68, 0x11, 0x34, 0x97, 0x38,
+	0xa1, 0x37, 0xd1, 0x8b, 0x32, 0x33, 0xf5, 0xd0, 0x53, 0x28, 0x8f, 0x7d,
+	0x66, 0x27, 0x24, 0xd0, 0x35, 0x11, 0xe8, 0xc0, 0xe5, 0xf7, 0x15, 0x6d,
+	0xdb, 0x67, 0xc7, 0xd6, 0x8e, 0xa5, 0xf1, 0xd0, 0x31, 0x09, 0xd0, 0x0a,
+	0xd4, 0x64, 0xef, 0xb6, 0x28, 0x94, 0xea, 0x65, 0xc9, 0x0b, 0x12, 0x12,
+	0x4d, 0x50, 0xe3, 0x08, 0x1a, 0x5d, 0x4e, 0x87, 0x03, 0x0b, 0x7f, 0x4c,
+	0x30, 0x65, 0x68, 0x15, 0xb4, 0x93, 0xc8, 0xb5, 0xfd, 0xa1, 0x2a, 0xac,
+	0x53, 0xe5, 0xac, 0xa5, 0xb7, 0x91, 0x3b, 0xe8, 0x59, 0x25, 0x1e, 0x18,
+	0x0c, 0xd1, 0x13, 0xa8, 0x0f, 0x1d, 0xe6, 0x08, 0x4a, 0xc6, 0x65, 0xe3,
+	0xca, 0x14, 0x78, 0x59, 0x35, 0x81, 0x6d, 0x29, 0xc8, 0x58, 0x87, 0xf9,
+	0x8c, 0x95, 0xc6, 0x51, 0x48, 0x31, 0xd2, 0xa1, 0x4c, 0x13, 0xcf, 0xc3,
+	0x94, 0x4a, 0x25, 0x2b, 0x56, 0xe6, 0x1a, 0xbb, 0x70, 0x6f, 0x1b, 0xb3,
+	0xee, 0x24, 0x09, 0x4f, 0xb3, 0x1a, 0xe6, 0x61, 0x8e, 0xff, 0x5f, 0xe4,
+	0x15, 0x2c, 0x6e, 0xa1, 0x05, 0x28, 0xd1, 0x89, 0x43, 0x54, 0x49, 0x05,
+	0x4b, 0x39, 0x12, 0x65, 0x0e, 0xa3, 0xa9, 0x5a, 0xca, 0x31, 0x28, 0x80,
+	0xe4, 0x3a, 0xe4, 0x1e, 0xe6, 0x22, 0x49, 0x18, 0x4b, 0xb2, 0x79, 0x3e,
+	0x3e, 0xb5, 0x63, 0xa6, 0x8c, 0xaa, 0x4f, 0x30, 0x7a, 0x06, 0x15, 0x5e,
+	0x7c, 0x32, 0xbd, 0x6a, 0xba, 0xc6, 0x9b, 0x2e, 0xf7, 0x04, 0xc6, 0xdb,
+	0x2e, 0xcb, 0x20, 0x6f, 0x9c, 0xf7, 0xe0, 0x0c, 0x87, 0x44, 0xf4, 0x50,
+	0x90, 0xa3, 0xc8, 0x5c, 0xe3, 0x4b, 0x1e, 0x60, 0x17, 0x93, 0x31, 0xfe,
+	0x8f, 0xbf, 0xae, 0x40, 0x91, 0x11, 0xac, 0xe6, 0x9f, 0x2d, 0xca, 0xbe,
+	0x7b, 0x82, 0x3d, 0x66, 0xc9, 0x00, 0x7a, 0x0c, 0x40, 0xfd, 0x0b, 0x6c,
+	0xbb, 0x33, 0x86, 0xd5, 0x1f, 0x8b, 0x56, 0x55, 0x20, 0x1d, 0x01, 0xf0,
+	0xed, 0x04, 0xd9, 0xb1, 0x2d, 0x59, 0x8a, 0x77, 0x59, 0xaa, 0x32, 0x7c,
+	0x24, 0xa8, 0xd6, 0xa0, 0xa9, 0x72, 0xaf, 0x11, 0x96, 0x24, 0xe1, 0xbc,
+	0xc4, 0x0f, 0x33, 0x56, 0xa3, 0x06, 0xd5, 0x43, 0xa1, 0xae, 0x58, 0x6a,
+	0xe3, 0x25, 0x14, 0x0f, 0x02, 0x27, 0x14, 0x2b, 0xe8, 0x09, 0x4d, 0xd5,
+	0xac, 0x0b, 0x56, 0xea, 0x09, 0x7c, 0x2a, 0xba, 0xa6, 0xe9, 0x60, 0x52,
+	0xcf, 0x78, 0x0d, 0x0b, 0x07, 0x09, 0xdb, 0x4f, 0x18, 0x3f, 0x45, 0x62,
+	0xcd, 0xb2, 0xb9, 0xf2, 0xf5, 0x8e, 0x1d, 0x36, 0x91, 0xb2, 0xf0, 0x95,
+	0x17, 0xb6, 0x98, 0xe2, 0x99, 0x13, 0x24, 0x4a, 0x87, 0xba, 0xa5, 0x9c,
+	0x75, 0x13, 0x4a, 0x4a, 0xca, 0x1a, 0x94, 0xad, 0xe3, 0xbd, 0xbd, 0xc1,
+	0xde, 0x76, 0x33, 0x87, 0xea, 0x50, 0xe9, 0xee, 0xef, 0x1e, 0xec, 0xf4,
+	0x8f, 0xfa, 0xcd, 0x3c, 0x02, 0xd0, 0xb6, 0xde, 0x0c, 0x76, 0xfa, 0xbd,
+	0x66, 0x61, 0xf3, 0xd3, 0x1c, 0x68, 0xef, 0xa5, 0xc8, 0xe8, 0x05, 0x68,
+	0xe2, 0xd3, 0x84, 0x97, 0x67, 0xaa, 0xa3, 0x6e, 0x66, 0x47, 0xdd, 0xec,
+	0x8b, 0xc5, 0x6f, 0xdd, 0x37, 0xc5, 0x05, 0xa2, 0xd2, 0x55, 0xaa, 0x91,
+	0x43, 0xaf, 0x40, 0x53, 0x2b, 0x8b, 0x1e, 0x64, 0xe3, 0xba, 0x71, 0x30,
+	0x5a, 0x8b, 0xb7, 0x61, 0xb5, 0xd9, 0xfc, 0xd3, 0x1e, 0x54, 0xb2, 0x0d,
+	0x46, 0x0f, 0xb3, 0xac, 0x5b, 0x3b, 0xdd, 0x5a, 0xba, 0x53, 0x8c, 0x14,
+	0xfc, 0x9d, 0x68, 0xd7, 0xc8, 0x6d, 0xe4, 0xd1, 0x00, 0x1a, 0x37, 0x44,
+	0x43, 0x8f, 0x32, 0xaa, 0xbf, 0x69, 0xd9, 0xfa, 0x47, 0x73, 0x46, 0x6e,
+	0x2d, 0xdf, 0xe9, 0x7c, 0xbd, 0x5c, 0xce, 0x7f, 0xe3, 0xcf, 0x0f, 0xfe,
+	0x7c, 0xfe, 0xb9, 0x9c, 0xfb, 0xb0, 0xc1, 0xef, 0x83, 0x49, 0xe2, 0x9a,
+	0xfc, 0x72, 0x6a, 0xc7, 0x8e, 0x37, 0x99, 0x0d, 0x31, 0xb9, 0x6e, 0x51,
+	0xe2, 0xb5, 0x6f, 0x5c, 0xd1, 0xae, 0x26, 0x79, 0x9f, 0xff, 0x06, 0xe9,
+	0x91, 0x4c, 0x38, 0xba, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -633,6 +711,11 @@ type WorkerClient interface {
 	Status(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error)
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
 	GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (Worker_GetChunkClient, error)
+	// PutOutputFile streams a file directly into the datum's output, skipping
+	// the /pfs/out staging directory and the upload phase at the end of the
+	// datum. It's served on the worker's Unix socket so only user code running
+	// in the same pod can call it, and only while the datum is being processed.
+	PutOutputFile(ctx context.Context, opts ...grpc.CallOption) (Worker_PutOutputFileClient, error)
 }
 
 type workerClient struct {
@@ -693,11 +776,50 @@ func (x *workerGetChunkClient) Recv() (*types.BytesValue, error) {
 	return m, nil
 }
 
+func (c *workerClient) PutOutputFile(ctx context.Context, opts ...grpc.CallOption) (Worker_PutOutputFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/worker.Worker/PutOutputFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerPutOutputFileClient{stream}
+	return x, nil
+}
+
+type Worker_PutOutputFileClient interface {
+	Send(*PutOutputFileRequest) error
+	CloseAndRecv() (*types.Empty, error)
+	grpc.ClientStream
+}
+
+type workerPutOutputFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerPutOutputFileClient) Send(m *PutOutputFileRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerPutOutputFileClient) CloseAndRecv() (*types.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WorkerServer is the server API for Worker service.
 type WorkerServer interface {
 	Status(context.Context, *types.Empty) (*pps.WorkerStatus, error)
 	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
 	GetChunk(*GetChunkRequest, Worker_GetChunkServer) error
+	// PutOutputFile streams a file directly into the datum's output, skipping
+	// the /pfs/out staging directory and the upload phase at the end of the
+	// datum. It's served on the worker's Unix socket so only user code running
+	// in the same pod can call it, and only while the datum is being processed.
+	PutOutputFile(Worker_PutOutputFileServer) error
 }
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
@@ -761,6 +883,32 @@ func (x *workerGetChunkServer) Send(m *types.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Worker_PutOutputFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).PutOutputFile(&workerPutOutputFileServer{stream})
+}
+
+type Worker_PutOutputFileServer interface {
+	SendAndClose(*types.Empty) error
+	Recv() (*PutOutputFileRequest, error)
+	grpc.ServerStream
+}
+
+type workerPutOutputFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerPutOutputFileServer) SendAndClose(m *types.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerPutOutputFileServer) Recv() (*PutOutputFileRequest, error) {
+	m := new(PutOutputFileRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "worker.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -780,6 +928,11 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Worker_GetChunk_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PutOutputFile",
+			Handler:       _Worker_PutOutputFile_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "server/worker/worker_service.proto",
 }
@@ -1136,6 +1289,39 @@ func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *PutOutputFileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutOutputFileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintWorkerService(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1327,6 +1513,26 @@ func (m *Plan) Size() (n int) {
 	return n
 }
 
+func (m *PutOutputFileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovWorkerService(x uint64) (n int) {
 	for {
 		n++
@@ -2429,6 +2635,127 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PutOutputFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkerService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutOutputFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutOutputFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkerService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipWorkerService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0